@@ -0,0 +1,112 @@
+//go:build wireinject
+// +build wireinject
+
+package comment
+
+import (
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/infrastructure/repository"
+	"poketier/apps/comment/internal/presentation/handler"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializePostCommentHandler はPostCommentHandlerとその依存関係を初期化します
+func InitializePostCommentHandler(queries db.Querier) *handler.PostCommentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentQuerier), new(db.Querier)),
+		repository.NewCommentRepository,
+		wire.Bind(new(usecase.PCCommentRepository), new(*repository.CommentRepository)),
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		wire.Bind(new(usecase.PCTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.PCDeckRepository), new(*repository.DeckRepository)),
+
+		// Usecase provider
+		usecase.NewPostCommentUsecase,
+		wire.Bind(new(handler.PostCommentUseCase), new(*usecase.PostCommentUsecase)),
+
+		// Handler provider
+		handler.NewPostCommentHandler,
+	)
+	return &handler.PostCommentHandler{}
+}
+
+// InitializeEditCommentHandler はEditCommentHandlerとその依存関係を初期化します
+func InitializeEditCommentHandler(queries db.Querier) *handler.EditCommentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentQuerier), new(db.Querier)),
+		repository.NewCommentRepository,
+		wire.Bind(new(usecase.ECCommentRepository), new(*repository.CommentRepository)),
+
+		// Usecase provider
+		usecase.NewEditCommentUsecase,
+		wire.Bind(new(handler.EditCommentUseCase), new(*usecase.EditCommentUsecase)),
+
+		// Handler provider
+		handler.NewEditCommentHandler,
+	)
+	return &handler.EditCommentHandler{}
+}
+
+// InitializeDeleteCommentHandler はDeleteCommentHandlerとその依存関係を初期化します
+func InitializeDeleteCommentHandler(queries db.Querier) *handler.DeleteCommentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentQuerier), new(db.Querier)),
+		repository.NewCommentRepository,
+		wire.Bind(new(usecase.DCCommentRepository), new(*repository.CommentRepository)),
+
+		// Usecase provider
+		usecase.NewDeleteCommentUsecase,
+		wire.Bind(new(handler.DeleteCommentUseCase), new(*usecase.DeleteCommentUsecase)),
+
+		// Handler provider
+		handler.NewDeleteCommentHandler,
+	)
+	return &handler.DeleteCommentHandler{}
+}
+
+// InitializeListCommentsHandler はListCommentsHandlerとその依存関係を初期化します
+func InitializeListCommentsHandler(queries db.Querier) *handler.ListCommentsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentQuerier), new(db.Querier)),
+		repository.NewCommentRepository,
+		wire.Bind(new(usecase.LCCommentRepository), new(*repository.CommentRepository)),
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		wire.Bind(new(usecase.LCTierListRepository), new(*repository.TierListRepository)),
+
+		// Usecase provider
+		usecase.NewListCommentsUsecase,
+		wire.Bind(new(handler.ListCommentsUseCase), new(*usecase.ListCommentsUsecase)),
+
+		// Handler provider
+		handler.NewListCommentsHandler,
+	)
+	return &handler.ListCommentsHandler{}
+}
+
+// InitializeListCommentRepliesHandler はListCommentRepliesHandlerとその依存関係を初期化します
+func InitializeListCommentRepliesHandler(queries db.Querier) *handler.ListCommentRepliesHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentQuerier), new(db.Querier)),
+		repository.NewCommentRepository,
+		wire.Bind(new(usecase.LCRCommentRepository), new(*repository.CommentRepository)),
+
+		// Usecase provider
+		usecase.NewListCommentRepliesUsecase,
+		wire.Bind(new(handler.ListCommentRepliesUseCase), new(*usecase.ListCommentRepliesUsecase)),
+
+		// Handler provider
+		handler.NewListCommentRepliesHandler,
+	)
+	return &handler.ListCommentRepliesHandler{}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// decodeCommentCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeCommentCursor(s string) (*entity.CommentCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.CommentCursor{
+		CreatedAt: time.UnixMicro(cursor.SortKey).UTC(),
+		CommentID: commentID,
+	}, nil
+}
+
+// encodeCommentCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeCommentCursor(next *entity.CommentCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.CreatedAt.UnixMicro(),
+		ID:      next.CommentID.String(),
+	})
+}
+
+// parseOptionalDeckID は任意指定の言及するデッキIDを変換。空文字列の場合は nil を返す
+func parseOptionalDeckID(s string) (*id.DeckID, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	deckID, err := id.DeckIDFromString(s)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid deck_id", err)
+	}
+	return &deckID, nil
+}
+
+// commentIDString は任意のコメントIDを文字列に変換。nil の場合は nil を返す
+func commentIDString(commentID *id.CommentID) *string {
+	if commentID == nil {
+		return nil
+	}
+	s := commentID.String()
+	return &s
+}
+
+// deckIDString は任意のデッキIDを文字列に変換。nil の場合は nil を返す
+func deckIDString(deckID *id.DeckID) *string {
+	if deckID == nil {
+		return nil
+	}
+	s := deckID.String()
+	return &s
+}
+
+// isNotFound はリソースが存在しないことを表すエラーかどうかを返す
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
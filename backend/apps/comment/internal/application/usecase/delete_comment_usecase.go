@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// DeleteCommentParams はコメントの削除の入力
+type DeleteCommentParams struct {
+	UserID    id.UserID
+	CommentID string
+}
+
+type DCCommentRepository interface {
+	FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error)
+	Update(ctx context.Context, comment *entity.Comment) error
+}
+
+type DeleteCommentUsecase struct {
+	commentRepo DCCommentRepository
+}
+
+func NewDeleteCommentUsecase(commentRepo DCCommentRepository) *DeleteCommentUsecase {
+	return &DeleteCommentUsecase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute はコメントを削除済みにする。削除できるのは投稿者のみ
+// 返信のスレッドを残すため、本文と言及したデッキを消した削除済みコメントとして一覧に残す
+// 既に削除済みの場合は何もしない
+func (u *DeleteCommentUsecase) Execute(ctx context.Context, params DeleteCommentParams) error {
+	commentID, err := id.CommentIDFromString(params.CommentID)
+	if err != nil {
+		return errs.NewValidationError("invalid comment_id", err)
+	}
+
+	comment, err := u.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("failed to find comment: %w", err)
+	}
+
+	alreadyDeleted := comment.IsDeleted()
+	if err := comment.Delete(params.UserID, time.Now()); err != nil {
+		if errors.Is(err, entity.ErrNotCommentAuthor) {
+			return errs.NewForbiddenError("only the author can delete the comment", err)
+		}
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if alreadyDeleted {
+		return nil
+	}
+
+	if err := u.commentRepo.Update(ctx, comment); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
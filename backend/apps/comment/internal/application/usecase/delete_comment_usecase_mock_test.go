@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/application/usecase/delete_comment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/application/usecase/delete_comment_usecase.go -destination=./apps/comment/internal/application/usecase/delete_comment_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/comment/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDCCommentRepository is a mock of DCCommentRepository interface.
+type MockDCCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDCCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDCCommentRepositoryMockRecorder is the mock recorder for MockDCCommentRepository.
+type MockDCCommentRepositoryMockRecorder struct {
+	mock *MockDCCommentRepository
+}
+
+// NewMockDCCommentRepository creates a new mock instance.
+func NewMockDCCommentRepository(ctrl *gomock.Controller) *MockDCCommentRepository {
+	mock := &MockDCCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockDCCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDCCommentRepository) EXPECT() *MockDCCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockDCCommentRepository) FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, commentID)
+	ret0, _ := ret[0].(*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDCCommentRepositoryMockRecorder) FindByID(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDCCommentRepository)(nil).FindByID), ctx, commentID)
+}
+
+// Update mocks base method.
+func (m *MockDCCommentRepository) Update(ctx context.Context, comment *entity.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDCCommentRepositoryMockRecorder) Update(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDCCommentRepository)(nil).Update), ctx, comment)
+}
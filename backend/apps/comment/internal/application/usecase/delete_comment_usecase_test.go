@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteCommentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	commentID := id.NewCommentID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Minute)
+
+	// newComment はテストケースごとに削除前のコメントを作成する
+	newComment := func(deletedAt *time.Time) *entity.Comment {
+		return entity.ReconstructComment(commentID, id.NewTierListID(), nil, authorID, &deckID, "SSだと思う", createdAt, nil, deletedAt)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.DeleteCommentParams
+		setupMock   func(*MockDCCommentRepository)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 投稿者が削除すると、本文と言及したデッキを消した削除済みコメントが保存される",
+			params:   usecase.DeleteCommentParams{UserID: authorID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockDCCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, comment *entity.Comment) error {
+					assert.True(t, comment.IsDeleted(), "comment should be deleted")
+					assert.Empty(t, comment.Body(), "body should be cleared")
+					assert.Nil(t, comment.DeckID(), "mentioned deck should be cleared")
+					return nil
+				})
+			},
+		},
+		{
+			caseName: "正常系: 削除済みの場合、何もしない",
+			params:   usecase.DeleteCommentParams{UserID: authorID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockDCCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(&deletedAt), nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なコメントIDの場合、バリデーションエラーを返す",
+			params:      usecase.DeleteCommentParams{UserID: authorID, CommentID: "invalid"},
+			setupMock:   func(*MockDCCommentRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 投稿者以外の場合、Forbiddenエラーを返す",
+			params:   usecase.DeleteCommentParams{UserID: id.NewUserID(), CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockDCCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.DeleteCommentParams{UserID: authorID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockDCCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(nil, errs.NewNotFoundError("comment not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: 保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.DeleteCommentParams{UserID: authorID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockDCCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockDCCommentRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewDeleteCommentUsecase(mockRepo)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
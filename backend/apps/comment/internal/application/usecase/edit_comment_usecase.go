@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// EditCommentParams はコメントの編集の入力
+type EditCommentParams struct {
+	UserID    id.UserID
+	CommentID string
+	Body      string
+}
+
+// EditCommentResult は編集後のコメント
+type EditCommentResult struct {
+	CommentID       string
+	TierListID      string
+	ParentCommentID *string
+	DeckID          *string
+	Body            string
+	CreatedAt       time.Time
+	EditedAt        time.Time
+}
+
+type ECCommentRepository interface {
+	FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error)
+	Update(ctx context.Context, comment *entity.Comment) error
+}
+
+type EditCommentUsecase struct {
+	commentRepo ECCommentRepository
+}
+
+func NewEditCommentUsecase(commentRepo ECCommentRepository) *EditCommentUsecase {
+	return &EditCommentUsecase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute はコメントの本文を編集する。編集できるのは投稿者のみで、削除済みのコメントは編集できない
+func (u *EditCommentUsecase) Execute(ctx context.Context, params EditCommentParams) (*EditCommentResult, error) {
+	commentID, err := id.CommentIDFromString(params.CommentID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid comment_id", err)
+	}
+
+	comment, err := u.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+
+	now := time.Now()
+	if err := comment.Edit(params.UserID, params.Body, now); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotCommentAuthor):
+			return nil, errs.NewForbiddenError("only the author can edit the comment", err)
+		case errors.Is(err, entity.ErrCommentDeleted):
+			return nil, errs.NewConflictError("comment is deleted", err)
+		default:
+			return nil, errs.NewValidationError("invalid body", err)
+		}
+	}
+
+	if err := u.commentRepo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return &EditCommentResult{
+		CommentID:       comment.ID().String(),
+		TierListID:      comment.TierListID().String(),
+		ParentCommentID: commentIDString(comment.ParentID()),
+		DeckID:          deckIDString(comment.DeckID()),
+		Body:            comment.Body(),
+		CreatedAt:       comment.CreatedAt(),
+		EditedAt:        now,
+	}, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/application/usecase/edit_comment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/application/usecase/edit_comment_usecase.go -destination=./apps/comment/internal/application/usecase/edit_comment_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/comment/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockECCommentRepository is a mock of ECCommentRepository interface.
+type MockECCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockECCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockECCommentRepositoryMockRecorder is the mock recorder for MockECCommentRepository.
+type MockECCommentRepositoryMockRecorder struct {
+	mock *MockECCommentRepository
+}
+
+// NewMockECCommentRepository creates a new mock instance.
+func NewMockECCommentRepository(ctrl *gomock.Controller) *MockECCommentRepository {
+	mock := &MockECCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockECCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockECCommentRepository) EXPECT() *MockECCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockECCommentRepository) FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, commentID)
+	ret0, _ := ret[0].(*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockECCommentRepositoryMockRecorder) FindByID(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockECCommentRepository)(nil).FindByID), ctx, commentID)
+}
+
+// Update mocks base method.
+func (m *MockECCommentRepository) Update(ctx context.Context, comment *entity.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockECCommentRepositoryMockRecorder) Update(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockECCommentRepository)(nil).Update), ctx, comment)
+}
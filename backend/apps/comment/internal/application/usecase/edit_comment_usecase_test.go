@@ -0,0 +1,135 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEditCommentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	commentID := id.NewCommentID()
+	tierListID := id.NewTierListID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Minute)
+
+	// newComment はテストケースごとに編集前のコメントを作成する
+	newComment := func(deletedAt *time.Time) *entity.Comment {
+		return entity.ReconstructComment(commentID, tierListID, nil, authorID, nil, "SSだと思う", createdAt, nil, deletedAt)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.EditCommentParams
+		setupMock   func(*MockECCommentRepository)
+		wantBody    string
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 投稿者は本文を編集できる",
+			params:   usecase.EditCommentParams{UserID: authorID, CommentID: commentID.String(), Body: "やっぱりS"},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantBody: "やっぱりS",
+		},
+		{
+			caseName:    "異常系: 不正なコメントIDの場合、バリデーションエラーを返す",
+			params:      usecase.EditCommentParams{UserID: authorID, CommentID: "invalid", Body: "やっぱりS"},
+			setupMock:   func(*MockECCommentRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.EditCommentParams{UserID: authorID, CommentID: commentID.String(), Body: "やっぱりS"},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(nil, errs.NewNotFoundError("comment not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: 投稿者以外の場合、Forbiddenエラーを返す",
+			params:   usecase.EditCommentParams{UserID: id.NewUserID(), CommentID: commentID.String(), Body: "やっぱりS"},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName: "異常系: 削除済みのコメントの場合、Conflictエラーを返す",
+			params:   usecase.EditCommentParams{UserID: authorID, CommentID: commentID.String(), Body: "やっぱりS"},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(&deletedAt), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: 本文が空の場合、バリデーションエラーを返す",
+			params:   usecase.EditCommentParams{UserID: authorID, CommentID: commentID.String(), Body: ""},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.EditCommentParams{UserID: authorID, CommentID: commentID.String(), Body: "やっぱりS"},
+			setupMock: func(mockRepo *MockECCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), commentID).Return(newComment(nil), nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockECCommentRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewEditCommentUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, commentID.String(), got.CommentID, "comment id should match")
+			assert.Equal(t, tt.wantBody, got.Body, "body should be edited")
+			assert.False(t, got.EditedAt.IsZero(), "edited at should be recorded")
+		})
+	}
+}
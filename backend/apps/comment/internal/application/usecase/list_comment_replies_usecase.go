@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListCommentRepliesParams はコメントへの返信一覧取得の入力
+type ListCommentRepliesParams struct {
+	CommentID string
+	Cursor    string
+	Limit     int
+}
+
+// ListCommentRepliesResult は古い順の返信一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListCommentRepliesResult struct {
+	Replies    []LCRReply
+	NextCursor string
+}
+
+// LCRReply は一覧の返信
+// 削除済みの場合は Deleted が true で、投稿者・本文・言及したデッキを返さない
+type LCRReply struct {
+	CommentID       string
+	ParentCommentID string
+	Author          *LCRAuthor
+	Deck            *LCRDeck
+	Body            string
+	LikeCount       int
+	Deleted         bool
+	CreatedAt       time.Time
+	EditedAt        *time.Time
+}
+
+type LCRAuthor struct {
+	UserID      string
+	DisplayName string
+}
+
+type LCRDeck struct {
+	DeckID   string
+	Nickname string
+	ImageURL string
+}
+
+type LCRCommentRepository interface {
+	FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error)
+	FindReplyPage(ctx context.Context, parentID id.CommentID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error)
+}
+
+type ListCommentRepliesUsecase struct {
+	commentRepo LCRCommentRepository
+}
+
+func NewListCommentRepliesUsecase(commentRepo LCRCommentRepository) *ListCommentRepliesUsecase {
+	return &ListCommentRepliesUsecase{
+		commentRepo: commentRepo,
+	}
+}
+
+// Execute はコメントへの返信を取得する。返信先のコメントが削除済みの場合も返信は取得できる
+func (u *ListCommentRepliesUsecase) Execute(ctx context.Context, params ListCommentRepliesParams) (*ListCommentRepliesResult, error) {
+	commentID, err := id.CommentIDFromString(params.CommentID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid comment_id", err)
+	}
+	after, err := decodeCommentCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.commentRepo.FindByID(ctx, commentID); err != nil {
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+
+	page, err := u.commentRepo.FindReplyPage(ctx, commentID, after, pagination.NormalizeLimit(params.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reply page: %w", err)
+	}
+
+	replies := make([]LCRReply, 0, len(page.Comments))
+	for _, view := range page.Comments {
+		reply := LCRReply{
+			CommentID:       view.CommentID.String(),
+			ParentCommentID: commentID.String(),
+			LikeCount:       view.LikeCount,
+			Deleted:         view.IsDeleted(),
+			CreatedAt:       view.CreatedAt,
+			EditedAt:        view.EditedAt,
+		}
+		if !view.IsDeleted() {
+			reply.Author = &LCRAuthor{UserID: view.AuthorUserID.String(), DisplayName: view.AuthorDisplayName}
+			reply.Body = view.Body
+			if view.Deck != nil {
+				reply.Deck = &LCRDeck{DeckID: view.Deck.DeckID.String(), Nickname: view.Deck.Nickname, ImageURL: view.Deck.ImageURL}
+			}
+		}
+		replies = append(replies, reply)
+	}
+
+	return &ListCommentRepliesResult{
+		Replies:    replies,
+		NextCursor: encodeCommentCursor(page.Next),
+	}, nil
+}
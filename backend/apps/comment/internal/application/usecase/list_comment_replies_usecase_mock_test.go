@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/application/usecase/list_comment_replies_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/application/usecase/list_comment_replies_usecase.go -destination=./apps/comment/internal/application/usecase/list_comment_replies_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/comment/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLCRCommentRepository is a mock of LCRCommentRepository interface.
+type MockLCRCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLCRCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLCRCommentRepositoryMockRecorder is the mock recorder for MockLCRCommentRepository.
+type MockLCRCommentRepositoryMockRecorder struct {
+	mock *MockLCRCommentRepository
+}
+
+// NewMockLCRCommentRepository creates a new mock instance.
+func NewMockLCRCommentRepository(ctrl *gomock.Controller) *MockLCRCommentRepository {
+	mock := &MockLCRCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockLCRCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLCRCommentRepository) EXPECT() *MockLCRCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockLCRCommentRepository) FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, commentID)
+	ret0, _ := ret[0].(*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLCRCommentRepositoryMockRecorder) FindByID(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLCRCommentRepository)(nil).FindByID), ctx, commentID)
+}
+
+// FindReplyPage mocks base method.
+func (m *MockLCRCommentRepository) FindReplyPage(ctx context.Context, parentID id.CommentID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReplyPage", ctx, parentID, after, limit)
+	ret0, _ := ret[0].(*entity.CommentPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReplyPage indicates an expected call of FindReplyPage.
+func (mr *MockLCRCommentRepositoryMockRecorder) FindReplyPage(ctx, parentID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReplyPage", reflect.TypeOf((*MockLCRCommentRepository)(nil).FindReplyPage), ctx, parentID, after, limit)
+}
@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCommentRepliesUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	parentID := id.NewCommentID()
+	replyID := id.NewCommentID()
+	deletedID := id.NewCommentID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Hour)
+	parent := entity.ReconstructComment(parentID, tierListID, nil, authorID, nil, "", createdAt, nil, &deletedAt)
+	cursor := &entity.CommentCursor{CreatedAt: createdAt, CommentID: replyID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: createdAt.UnixMicro(), ID: replyID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListCommentRepliesParams
+		setupMock   func(*MockLCRCommentRepository)
+		wantResult  *usecase.ListCommentRepliesResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 削除済みのコメントへの返信も取得でき、削除済みの返信は投稿者と本文を返さない",
+			params:   usecase.ListCommentRepliesParams{CommentID: parentID.String(), Cursor: encodedCursor, Limit: 500},
+			setupMock: func(mockRepo *MockLCRCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindReplyPage(gomock.Any(), parentID, cursor, pagination.MaxLimit).Return(&entity.CommentPage{
+					Comments: []entity.CommentView{
+						{
+							CommentID:         replyID,
+							TierListID:        tierListID,
+							ParentID:          &parentID,
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "解説花子",
+							Body:              "同意です",
+							LikeCount:         2,
+							CreatedAt:         createdAt,
+						},
+						{
+							CommentID:         deletedID,
+							TierListID:        tierListID,
+							ParentID:          &parentID,
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "解説花子",
+							CreatedAt:         createdAt,
+							DeletedAt:         &deletedAt,
+						},
+					},
+				}, nil)
+			},
+			wantResult: &usecase.ListCommentRepliesResult{
+				Replies: []usecase.LCRReply{
+					{
+						CommentID:       replyID.String(),
+						ParentCommentID: parentID.String(),
+						Author:          &usecase.LCRAuthor{UserID: authorID.String(), DisplayName: "解説花子"},
+						Body:            "同意です",
+						LikeCount:       2,
+						CreatedAt:       createdAt,
+					},
+					{
+						CommentID:       deletedID.String(),
+						ParentCommentID: parentID.String(),
+						Deleted:         true,
+						CreatedAt:       createdAt,
+					},
+				},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なコメントIDの場合、バリデーションエラーを返す",
+			params:      usecase.ListCommentRepliesParams{CommentID: "invalid"},
+			setupMock:   func(*MockLCRCommentRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ListCommentRepliesParams{CommentID: parentID.String()},
+			setupMock: func(mockRepo *MockLCRCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), parentID).Return(nil, errs.NewNotFoundError("comment not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListCommentRepliesParams{CommentID: parentID.String()},
+			setupMock: func(mockRepo *MockLCRCommentRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindReplyPage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLCRCommentRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListCommentRepliesUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
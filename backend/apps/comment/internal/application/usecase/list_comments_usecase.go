@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListCommentsParams はティアリストのコメント一覧取得の入力
+type ListCommentsParams struct {
+	TierListID string
+	Cursor     string
+	Limit      int
+}
+
+// ListCommentsResult は新しい順のトップレベルのコメント一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListCommentsResult struct {
+	Comments   []LCComment
+	NextCursor string
+}
+
+// LCComment は一覧のコメント
+// 削除済みの場合は Deleted が true で、投稿者・本文・言及したデッキを返さない
+type LCComment struct {
+	CommentID  string
+	Author     *LCAuthor
+	Deck       *LCDeck
+	Body       string
+	ReplyCount int
+	LikeCount  int
+	Deleted    bool
+	CreatedAt  time.Time
+	EditedAt   *time.Time
+}
+
+type LCAuthor struct {
+	UserID      string
+	DisplayName string
+}
+
+type LCDeck struct {
+	DeckID   string
+	Nickname string
+	ImageURL string
+}
+
+type LCCommentRepository interface {
+	FindThreadPage(ctx context.Context, tierListID id.TierListID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error)
+}
+
+type LCTierListRepository interface {
+	FindSeasonID(ctx context.Context, tierListID id.TierListID) (id.SeasonID, error)
+}
+
+type ListCommentsUsecase struct {
+	commentRepo  LCCommentRepository
+	tierListRepo LCTierListRepository
+}
+
+func NewListCommentsUsecase(commentRepo LCCommentRepository, tierListRepo LCTierListRepository) *ListCommentsUsecase {
+	return &ListCommentsUsecase{
+		commentRepo:  commentRepo,
+		tierListRepo: tierListRepo,
+	}
+}
+
+// Execute はティアリストのトップレベルのコメントを返信数とともに取得する
+func (u *ListCommentsUsecase) Execute(ctx context.Context, params ListCommentsParams) (*ListCommentsResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+	after, err := decodeCommentCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.tierListRepo.FindSeasonID(ctx, tierListID); err != nil {
+		return nil, fmt.Errorf("failed to find tier list: %w", err)
+	}
+
+	page, err := u.commentRepo.FindThreadPage(ctx, tierListID, after, pagination.NormalizeLimit(params.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment page: %w", err)
+	}
+
+	comments := make([]LCComment, 0, len(page.Comments))
+	for _, view := range page.Comments {
+		comment := LCComment{
+			CommentID:  view.CommentID.String(),
+			ReplyCount: view.ReplyCount,
+			LikeCount:  view.LikeCount,
+			Deleted:    view.IsDeleted(),
+			CreatedAt:  view.CreatedAt,
+			EditedAt:   view.EditedAt,
+		}
+		if !view.IsDeleted() {
+			comment.Author = &LCAuthor{UserID: view.AuthorUserID.String(), DisplayName: view.AuthorDisplayName}
+			comment.Body = view.Body
+			if view.Deck != nil {
+				comment.Deck = &LCDeck{DeckID: view.Deck.DeckID.String(), Nickname: view.Deck.Nickname, ImageURL: view.Deck.ImageURL}
+			}
+		}
+		comments = append(comments, comment)
+	}
+
+	return &ListCommentsResult{
+		Comments:   comments,
+		NextCursor: encodeCommentCursor(page.Next),
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/application/usecase/list_comments_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/application/usecase/list_comments_usecase.go -destination=./apps/comment/internal/application/usecase/list_comments_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/comment/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLCCommentRepository is a mock of LCCommentRepository interface.
+type MockLCCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLCCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLCCommentRepositoryMockRecorder is the mock recorder for MockLCCommentRepository.
+type MockLCCommentRepositoryMockRecorder struct {
+	mock *MockLCCommentRepository
+}
+
+// NewMockLCCommentRepository creates a new mock instance.
+func NewMockLCCommentRepository(ctrl *gomock.Controller) *MockLCCommentRepository {
+	mock := &MockLCCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockLCCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLCCommentRepository) EXPECT() *MockLCCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindThreadPage mocks base method.
+func (m *MockLCCommentRepository) FindThreadPage(ctx context.Context, tierListID id.TierListID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindThreadPage", ctx, tierListID, after, limit)
+	ret0, _ := ret[0].(*entity.CommentPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindThreadPage indicates an expected call of FindThreadPage.
+func (mr *MockLCCommentRepositoryMockRecorder) FindThreadPage(ctx, tierListID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindThreadPage", reflect.TypeOf((*MockLCCommentRepository)(nil).FindThreadPage), ctx, tierListID, after, limit)
+}
+
+// MockLCTierListRepository is a mock of LCTierListRepository interface.
+type MockLCTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLCTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLCTierListRepositoryMockRecorder is the mock recorder for MockLCTierListRepository.
+type MockLCTierListRepositoryMockRecorder struct {
+	mock *MockLCTierListRepository
+}
+
+// NewMockLCTierListRepository creates a new mock instance.
+func NewMockLCTierListRepository(ctrl *gomock.Controller) *MockLCTierListRepository {
+	mock := &MockLCTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockLCTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLCTierListRepository) EXPECT() *MockLCTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindSeasonID mocks base method.
+func (m *MockLCTierListRepository) FindSeasonID(ctx context.Context, tierListID id.TierListID) (id.SeasonID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSeasonID", ctx, tierListID)
+	ret0, _ := ret[0].(id.SeasonID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSeasonID indicates an expected call of FindSeasonID.
+func (mr *MockLCTierListRepositoryMockRecorder) FindSeasonID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSeasonID", reflect.TypeOf((*MockLCTierListRepository)(nil).FindSeasonID), ctx, tierListID)
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCommentsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	commentID := id.NewCommentID()
+	deletedID := id.NewCommentID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Minute)
+	deletedAt := createdAt.Add(time.Hour)
+	cursor := &entity.CommentCursor{CreatedAt: createdAt, CommentID: commentID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: createdAt.UnixMicro(), ID: commentID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListCommentsParams
+		setupMock   func(*MockLCCommentRepository, *MockLCTierListRepository)
+		wantResult  *usecase.ListCommentsResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: コメントと返信数を返し、削除済みのコメントは投稿者と本文を返さない",
+			params:   usecase.ListCommentsParams{TierListID: tierListID.String(), Limit: 2},
+			setupMock: func(mockComment *MockLCCommentRepository, mockTierList *MockLCTierListRepository) {
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(id.NewSeasonID(), nil)
+				mockComment.EXPECT().FindThreadPage(gomock.Any(), tierListID, (*entity.CommentCursor)(nil), 2).Return(&entity.CommentPage{
+					Comments: []entity.CommentView{
+						{
+							CommentID:         commentID,
+							TierListID:        tierListID,
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "解説花子",
+							Deck:              &entity.MentionedDeck{DeckID: deckID, Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+							Body:              "リザニンフはSS",
+							ReplyCount:        3,
+							LikeCount:         4,
+							CreatedAt:         createdAt,
+							EditedAt:          &editedAt,
+						},
+						{
+							CommentID:         deletedID,
+							TierListID:        tierListID,
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "解説花子",
+							ReplyCount:        1,
+							CreatedAt:         createdAt,
+							DeletedAt:         &deletedAt,
+						},
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListCommentsResult{
+				Comments: []usecase.LCComment{
+					{
+						CommentID:  commentID.String(),
+						Author:     &usecase.LCAuthor{UserID: authorID.String(), DisplayName: "解説花子"},
+						Deck:       &usecase.LCDeck{DeckID: deckID.String(), Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+						Body:       "リザニンフはSS",
+						ReplyCount: 3,
+						LikeCount:  4,
+						CreatedAt:  createdAt,
+						EditedAt:   &editedAt,
+					},
+					{
+						CommentID:  deletedID.String(),
+						ReplyCount: 1,
+						Deleted:    true,
+						CreatedAt:  createdAt,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、検索条件に変換される",
+			params:   usecase.ListCommentsParams{TierListID: tierListID.String(), Cursor: encodedCursor},
+			setupMock: func(mockComment *MockLCCommentRepository, mockTierList *MockLCTierListRepository) {
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(id.NewSeasonID(), nil)
+				mockComment.EXPECT().FindThreadPage(gomock.Any(), tierListID, cursor, pagination.DefaultLimit).
+					Return(&entity.CommentPage{Comments: []entity.CommentView{}}, nil)
+			},
+			wantResult: &usecase.ListCommentsResult{Comments: []usecase.LCComment{}},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDの場合、バリデーションエラーを返す",
+			params:      usecase.ListCommentsParams{TierListID: "invalid"},
+			setupMock:   func(*MockLCCommentRepository, *MockLCTierListRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName:    "異常系: 不正なカーソルの場合、バリデーションエラーを返す",
+			params:      usecase.ListCommentsParams{TierListID: tierListID.String(), Cursor: pagination.EncodeCursor(pagination.Cursor{ID: "invalid"})},
+			setupMock:   func(*MockLCCommentRepository, *MockLCTierListRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ListCommentsParams{TierListID: tierListID.String()},
+			setupMock: func(_ *MockLCCommentRepository, mockTierList *MockLCTierListRepository) {
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(id.SeasonID{}, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListCommentsParams{TierListID: tierListID.String()},
+			setupMock: func(mockComment *MockLCCommentRepository, mockTierList *MockLCTierListRepository) {
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(id.NewSeasonID(), nil)
+				mockComment.EXPECT().FindThreadPage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockComment := NewMockLCCommentRepository(ctrl)
+			mockTierList := NewMockLCTierListRepository(ctrl)
+			tt.setupMock(mockComment, mockTierList)
+
+			usecase := usecase.NewListCommentsUsecase(mockComment, mockTierList)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// PostCommentParams はティアリストへのコメント投稿の入力
+// ParentCommentID を指定した場合はそのコメントへの返信、DeckID を指定した場合はそのデッキに言及する
+type PostCommentParams struct {
+	UserID          id.UserID
+	TierListID      string
+	ParentCommentID string
+	DeckID          string
+	Body            string
+}
+
+// PostCommentResult は投稿したコメント
+// ParentCommentID は返信でない場合、DeckID はデッキに言及していない場合 nil
+type PostCommentResult struct {
+	CommentID       string
+	TierListID      string
+	ParentCommentID *string
+	DeckID          *string
+	Body            string
+	CreatedAt       time.Time
+}
+
+type PCCommentRepository interface {
+	FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error)
+	Create(ctx context.Context, comment *entity.Comment) error
+	CountByAuthorSince(ctx context.Context, userID id.UserID, since time.Time) (int, error)
+}
+
+type PCTierListRepository interface {
+	FindSeasonID(ctx context.Context, tierListID id.TierListID) (id.SeasonID, error)
+}
+
+type PCDeckRepository interface {
+	FindSeasonID(ctx context.Context, deckID id.DeckID) (id.SeasonID, error)
+}
+
+type PostCommentUsecase struct {
+	commentRepo  PCCommentRepository
+	tierListRepo PCTierListRepository
+	deckRepo     PCDeckRepository
+}
+
+func NewPostCommentUsecase(commentRepo PCCommentRepository, tierListRepo PCTierListRepository, deckRepo PCDeckRepository) *PostCommentUsecase {
+	return &PostCommentUsecase{
+		commentRepo:  commentRepo,
+		tierListRepo: tierListRepo,
+		deckRepo:     deckRepo,
+	}
+}
+
+// Execute はティアリストにコメント・返信を投稿する
+// 言及できるデッキはティアリストと同じシーズンのデッキのみ。投稿数が制限を超えている場合は TooManyRequests エラーを返す
+func (u *PostCommentUsecase) Execute(ctx context.Context, params PostCommentParams) (*PostCommentResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+	var parentID *id.CommentID
+	if params.ParentCommentID != "" {
+		parsed, err := id.CommentIDFromString(params.ParentCommentID)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid parent_comment_id", err)
+		}
+		parentID = &parsed
+	}
+	deckID, err := parseOptionalDeckID(params.DeckID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := u.checkRateLimits(ctx, params.UserID, now); err != nil {
+		return nil, err
+	}
+
+	seasonID, err := u.tierListRepo.FindSeasonID(ctx, tierListID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tier list: %w", err)
+	}
+
+	if deckID != nil {
+		deckSeasonID, err := u.deckRepo.FindSeasonID(ctx, *deckID)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, errs.NewValidationError("invalid deck_id", err)
+			}
+			return nil, fmt.Errorf("failed to find deck: %w", err)
+		}
+		if !deckSeasonID.Equals(seasonID) {
+			return nil, errs.NewValidationError("deck is not in the season of the tier list", nil)
+		}
+	}
+
+	comment, err := u.newComment(ctx, tierListID, parentID, params.UserID, params.Body, deckID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.commentRepo.Create(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return &PostCommentResult{
+		CommentID:       comment.ID().String(),
+		TierListID:      comment.TierListID().String(),
+		ParentCommentID: commentIDString(comment.ParentID()),
+		DeckID:          deckIDString(comment.DeckID()),
+		Body:            comment.Body(),
+		CreatedAt:       comment.CreatedAt(),
+	}, nil
+}
+
+// checkRateLimits はユーザーの直近の投稿数が制限に達していないかを確認する
+// 同時に投稿された場合は制限をわずかに超えることがあるが、連投の抑止が目的のため許容する
+func (u *PostCommentUsecase) checkRateLimits(ctx context.Context, userID id.UserID, now time.Time) error {
+	for _, limit := range entity.CommentRateLimits {
+		count, err := u.commentRepo.CountByAuthorSince(ctx, userID, now.Add(-limit.Window))
+		if err != nil {
+			return fmt.Errorf("failed to count recent comments: %w", err)
+		}
+		if count >= limit.Max {
+			return errs.NewTooManyRequestsError(fmt.Sprintf("comment rate limit exceeded: %d per %s", limit.Max, limit.Window), nil)
+		}
+	}
+	return nil
+}
+
+// newComment はトップレベルのコメント、または同じティアリストのコメントへの返信を作成する
+func (u *PostCommentUsecase) newComment(
+	ctx context.Context,
+	tierListID id.TierListID,
+	parentID *id.CommentID,
+	userID id.UserID,
+	body string,
+	deckID *id.DeckID,
+	now time.Time,
+) (*entity.Comment, error) {
+	if parentID == nil {
+		comment, err := entity.NewComment(tierListID, userID, body, deckID, now)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid body", err)
+		}
+		return comment, nil
+	}
+
+	parent, err := u.commentRepo.FindByID(ctx, *parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find parent comment: %w", err)
+	}
+	if !parent.TierListID().Equals(tierListID) {
+		return nil, errs.NewNotFoundError("parent comment not found", nil)
+	}
+
+	reply, err := entity.NewReply(parent, userID, body, deckID, now)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNestedReply):
+			return nil, errs.NewValidationError("replies cannot be nested", err)
+		case errors.Is(err, entity.ErrCommentDeleted):
+			return nil, errs.NewConflictError("parent comment is deleted", err)
+		default:
+			return nil, errs.NewValidationError("invalid body", err)
+		}
+	}
+	return reply, nil
+}
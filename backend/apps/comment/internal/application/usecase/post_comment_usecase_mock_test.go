@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/application/usecase/post_comment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/application/usecase/post_comment_usecase.go -destination=./apps/comment/internal/application/usecase/post_comment_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/comment/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPCCommentRepository is a mock of PCCommentRepository interface.
+type MockPCCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPCCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPCCommentRepositoryMockRecorder is the mock recorder for MockPCCommentRepository.
+type MockPCCommentRepositoryMockRecorder struct {
+	mock *MockPCCommentRepository
+}
+
+// NewMockPCCommentRepository creates a new mock instance.
+func NewMockPCCommentRepository(ctrl *gomock.Controller) *MockPCCommentRepository {
+	mock := &MockPCCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockPCCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPCCommentRepository) EXPECT() *MockPCCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByAuthorSince mocks base method.
+func (m *MockPCCommentRepository) CountByAuthorSince(ctx context.Context, userID id.UserID, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByAuthorSince", ctx, userID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByAuthorSince indicates an expected call of CountByAuthorSince.
+func (mr *MockPCCommentRepositoryMockRecorder) CountByAuthorSince(ctx, userID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByAuthorSince", reflect.TypeOf((*MockPCCommentRepository)(nil).CountByAuthorSince), ctx, userID, since)
+}
+
+// Create mocks base method.
+func (m *MockPCCommentRepository) Create(ctx context.Context, comment *entity.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPCCommentRepositoryMockRecorder) Create(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPCCommentRepository)(nil).Create), ctx, comment)
+}
+
+// FindByID mocks base method.
+func (m *MockPCCommentRepository) FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, commentID)
+	ret0, _ := ret[0].(*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPCCommentRepositoryMockRecorder) FindByID(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPCCommentRepository)(nil).FindByID), ctx, commentID)
+}
+
+// MockPCTierListRepository is a mock of PCTierListRepository interface.
+type MockPCTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPCTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPCTierListRepositoryMockRecorder is the mock recorder for MockPCTierListRepository.
+type MockPCTierListRepositoryMockRecorder struct {
+	mock *MockPCTierListRepository
+}
+
+// NewMockPCTierListRepository creates a new mock instance.
+func NewMockPCTierListRepository(ctrl *gomock.Controller) *MockPCTierListRepository {
+	mock := &MockPCTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockPCTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPCTierListRepository) EXPECT() *MockPCTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindSeasonID mocks base method.
+func (m *MockPCTierListRepository) FindSeasonID(ctx context.Context, tierListID id.TierListID) (id.SeasonID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSeasonID", ctx, tierListID)
+	ret0, _ := ret[0].(id.SeasonID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSeasonID indicates an expected call of FindSeasonID.
+func (mr *MockPCTierListRepositoryMockRecorder) FindSeasonID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSeasonID", reflect.TypeOf((*MockPCTierListRepository)(nil).FindSeasonID), ctx, tierListID)
+}
+
+// MockPCDeckRepository is a mock of PCDeckRepository interface.
+type MockPCDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPCDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPCDeckRepositoryMockRecorder is the mock recorder for MockPCDeckRepository.
+type MockPCDeckRepositoryMockRecorder struct {
+	mock *MockPCDeckRepository
+}
+
+// NewMockPCDeckRepository creates a new mock instance.
+func NewMockPCDeckRepository(ctrl *gomock.Controller) *MockPCDeckRepository {
+	mock := &MockPCDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockPCDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPCDeckRepository) EXPECT() *MockPCDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindSeasonID mocks base method.
+func (m *MockPCDeckRepository) FindSeasonID(ctx context.Context, deckID id.DeckID) (id.SeasonID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSeasonID", ctx, deckID)
+	ret0, _ := ret[0].(id.SeasonID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSeasonID indicates an expected call of FindSeasonID.
+func (mr *MockPCDeckRepositoryMockRecorder) FindSeasonID(ctx, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSeasonID", reflect.TypeOf((*MockPCDeckRepository)(nil).FindSeasonID), ctx, deckID)
+}
@@ -0,0 +1,226 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostCommentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	tierListID := id.NewTierListID()
+	seasonID := id.NewSeasonID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	parent := entity.ReconstructComment(id.NewCommentID(), tierListID, nil, id.NewUserID(), nil, "SSだと思う", createdAt, nil, nil)
+	deletedAt := createdAt.Add(time.Minute)
+	deletedParent := entity.ReconstructComment(id.NewCommentID(), tierListID, nil, id.NewUserID(), nil, "", createdAt, nil, &deletedAt)
+	parentID := parent.ID()
+	reply := entity.ReconstructComment(id.NewCommentID(), tierListID, &parentID, id.NewUserID(), nil, "同意です", createdAt, nil, nil)
+	otherTierListParent := entity.ReconstructComment(id.NewCommentID(), id.NewTierListID(), nil, id.NewUserID(), nil, "別のティアリスト", createdAt, nil, nil)
+
+	// withinLimits は投稿数の制限内であることを返す
+	withinLimits := func(mockComment *MockPCCommentRepository) {
+		mockComment.EXPECT().CountByAuthorSince(gomock.Any(), userID, gomock.Any()).Return(0, nil).Times(len(entity.CommentRateLimits))
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.PostCommentParams
+		setupMock   func(*MockPCCommentRepository, *MockPCTierListRepository, *MockPCDeckRepository)
+		wantParent  bool
+		wantDeck    bool
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: デッキに言及したトップレベルのコメントを投稿できる",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), DeckID: deckID.String(), Body: " リザニンフはSS "},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, mockDeck *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockDeck.EXPECT().FindSeasonID(gomock.Any(), deckID).Return(seasonID, nil)
+				mockComment.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, comment *entity.Comment) error {
+					assert.Equal(t, userID, comment.AuthorUserID(), "author should be the logged-in user")
+					assert.Equal(t, "リザニンフはSS", comment.Body(), "body should be trimmed")
+					return nil
+				})
+			},
+			wantDeck: true,
+		},
+		{
+			caseName: "正常系: トップレベルのコメントに返信できる",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), ParentCommentID: parent.ID().String(), Body: "同意です"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, mockDeck *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockComment.EXPECT().FindByID(gomock.Any(), parent.ID()).Return(parent, nil)
+				mockComment.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantParent: true,
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDの場合、バリデーションエラーを返す",
+			params:      usecase.PostCommentParams{UserID: userID, TierListID: "invalid", Body: "コメント"},
+			setupMock:   func(*MockPCCommentRepository, *MockPCTierListRepository, *MockPCDeckRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName:    "異常系: 不正なデッキIDの場合、バリデーションエラーを返す",
+			params:      usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), DeckID: "invalid", Body: "コメント"},
+			setupMock:   func(*MockPCCommentRepository, *MockPCTierListRepository, *MockPCDeckRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 直近の投稿数が制限に達している場合、TooManyRequestsエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, _ *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				mockComment.EXPECT().CountByAuthorSince(gomock.Any(), userID, gomock.Any()).Return(entity.CommentRateLimits[0].Max, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrTooManyRequests,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(id.SeasonID{}, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: 言及したデッキが存在しない場合、バリデーションエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), DeckID: deckID.String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, mockDeck *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockDeck.EXPECT().FindSeasonID(gomock.Any(), deckID).Return(id.SeasonID{}, errs.NewNotFoundError("deck not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 言及したデッキがティアリストと別のシーズンの場合、バリデーションエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), DeckID: deckID.String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, mockDeck *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockDeck.EXPECT().FindSeasonID(gomock.Any(), deckID).Return(id.NewSeasonID(), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 返信への返信の場合、バリデーションエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), ParentCommentID: reply.ID().String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockComment.EXPECT().FindByID(gomock.Any(), reply.ID()).Return(reply, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 削除済みのコメントへの返信の場合、Conflictエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), ParentCommentID: deletedParent.ID().String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockComment.EXPECT().FindByID(gomock.Any(), deletedParent.ID()).Return(deletedParent, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: 返信先が別のティアリストのコメントの場合、NotFoundエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), ParentCommentID: otherTierListParent.ID().String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockComment.EXPECT().FindByID(gomock.Any(), otherTierListParent.ID()).Return(otherTierListParent, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: 本文が空の場合、バリデーションエラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), Body: "  "},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.PostCommentParams{UserID: userID, TierListID: tierListID.String(), Body: "コメント"},
+			setupMock: func(mockComment *MockPCCommentRepository, mockTierList *MockPCTierListRepository, _ *MockPCDeckRepository) {
+				withinLimits(mockComment)
+				mockTierList.EXPECT().FindSeasonID(gomock.Any(), tierListID).Return(seasonID, nil)
+				mockComment.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockComment := NewMockPCCommentRepository(ctrl)
+			mockTierList := NewMockPCTierListRepository(ctrl)
+			mockDeck := NewMockPCDeckRepository(ctrl)
+			tt.setupMock(mockComment, mockTierList, mockDeck)
+
+			usecase := usecase.NewPostCommentUsecase(mockComment, mockTierList, mockDeck)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID.String(), got.TierListID, "tier list id should match")
+			assert.NotEmpty(t, got.CommentID, "comment id should be issued")
+			if tt.wantParent {
+				assert.Equal(t, parent.ID().String(), *got.ParentCommentID, "parent comment id should match")
+			} else {
+				assert.Nil(t, got.ParentCommentID, "top-level comment should not have a parent")
+			}
+			if tt.wantDeck {
+				assert.Equal(t, deckID.String(), *got.DeckID, "mentioned deck should match")
+			} else {
+				assert.Nil(t, got.DeckID, "comment should not mention a deck")
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"poketier/pkg/vo/id"
+)
+
+const maxBodyLength = 1000
+
+var (
+	// ErrNestedReply は返信への返信を表す。返信は1階層のみ
+	ErrNestedReply = errors.New("cannot reply to a reply")
+	// ErrCommentDeleted は削除済みのコメントへの返信・編集を表す
+	ErrCommentDeleted = errors.New("comment is deleted")
+	// ErrNotCommentAuthor は投稿者以外による編集・削除を表す
+	ErrNotCommentAuthor = errors.New("only the author can modify the comment")
+)
+
+// CommentRateLimit はユーザーごとのコメントの投稿数の制限
+// Window の期間内に Max 件投稿している場合は、それ以上投稿できない
+type CommentRateLimit struct {
+	Window time.Duration
+	Max    int
+}
+
+// CommentRateLimits は短時間の連投と1日の大量投稿をそれぞれ制限する
+// 削除したコメントも投稿数に含める（削除と投稿の繰り返しで制限を回避できないようにする）
+var CommentRateLimits = []CommentRateLimit{
+	{Window: time.Minute, Max: 5},
+	{Window: 24 * time.Hour, Max: 100},
+}
+
+// Comment はティアリストへのコメント
+// 返信は1階層のみで、削除は本文と言及したデッキを消した削除済みコメント（tombstone）として残す
+type Comment struct {
+	id           id.CommentID
+	tierListID   id.TierListID
+	parentID     *id.CommentID
+	authorUserID id.UserID
+	deckID       *id.DeckID
+	body         string
+	createdAt    time.Time
+	editedAt     *time.Time
+	deletedAt    *time.Time
+}
+
+// NewComment はティアリストへのトップレベルのコメントを作成する
+// deckID は言及するデッキで、言及しない場合は nil を渡す
+func NewComment(tierListID id.TierListID, authorUserID id.UserID, body string, deckID *id.DeckID, now time.Time) (*Comment, error) {
+	body, err := normalizeBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Comment{
+		id:           id.NewCommentID(),
+		tierListID:   tierListID,
+		authorUserID: authorUserID,
+		deckID:       deckID,
+		body:         body,
+		createdAt:    now,
+	}, nil
+}
+
+// NewReply は parent への返信を作成する
+// 返信への返信は ErrNestedReply、削除済みのコメントへの返信は ErrCommentDeleted を返す
+func NewReply(parent *Comment, authorUserID id.UserID, body string, deckID *id.DeckID, now time.Time) (*Comment, error) {
+	if parent.IsReply() {
+		return nil, ErrNestedReply
+	}
+	if parent.IsDeleted() {
+		return nil, ErrCommentDeleted
+	}
+
+	reply, err := NewComment(parent.tierListID, authorUserID, body, deckID, now)
+	if err != nil {
+		return nil, err
+	}
+	parentID := parent.id
+	reply.parentID = &parentID
+	return reply, nil
+}
+
+// ReconstructComment は永続化されたデータからCommentを復元する
+func ReconstructComment(
+	commentID id.CommentID,
+	tierListID id.TierListID,
+	parentID *id.CommentID,
+	authorUserID id.UserID,
+	deckID *id.DeckID,
+	body string,
+	createdAt time.Time,
+	editedAt, deletedAt *time.Time,
+) *Comment {
+	return &Comment{
+		id:           commentID,
+		tierListID:   tierListID,
+		parentID:     parentID,
+		authorUserID: authorUserID,
+		deckID:       deckID,
+		body:         body,
+		createdAt:    createdAt,
+		editedAt:     editedAt,
+		deletedAt:    deletedAt,
+	}
+}
+
+// ID はコメントIDを返す
+func (c *Comment) ID() id.CommentID {
+	return c.id
+}
+
+// TierListID はコメントしたティアリストのIDを返す
+func (c *Comment) TierListID() id.TierListID {
+	return c.tierListID
+}
+
+// ParentID は返信先のコメントIDを返す。トップレベルのコメントの場合は nil
+func (c *Comment) ParentID() *id.CommentID {
+	return c.parentID
+}
+
+// AuthorUserID は投稿したユーザーのIDを返す
+func (c *Comment) AuthorUserID() id.UserID {
+	return c.authorUserID
+}
+
+// DeckID は言及したデッキのIDを返す。言及していない場合は nil
+func (c *Comment) DeckID() *id.DeckID {
+	return c.deckID
+}
+
+// Body は本文を返す。削除済みの場合は空文字
+func (c *Comment) Body() string {
+	return c.body
+}
+
+// CreatedAt は投稿日時を返す
+func (c *Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// EditedAt は最後に編集した日時を返す。編集していない場合は nil
+func (c *Comment) EditedAt() *time.Time {
+	return c.editedAt
+}
+
+// DeletedAt は削除した日時を返す。削除していない場合は nil
+func (c *Comment) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+// IsReply は返信かどうかを返す
+func (c *Comment) IsReply() bool {
+	return c.parentID != nil
+}
+
+// IsDeleted は削除済みかどうかを返す
+func (c *Comment) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// Edit は投稿者による本文の編集を行う
+// 投稿者以外は ErrNotCommentAuthor、削除済みの場合は ErrCommentDeleted を返す
+func (c *Comment) Edit(userID id.UserID, body string, now time.Time) error {
+	if !c.authorUserID.Equals(userID) {
+		return ErrNotCommentAuthor
+	}
+	if c.IsDeleted() {
+		return ErrCommentDeleted
+	}
+
+	body, err := normalizeBody(body)
+	if err != nil {
+		return err
+	}
+	c.body = body
+	c.editedAt = &now
+	return nil
+}
+
+// Delete は投稿者によるコメントの削除を行う。本文と言及したデッキを消し、削除済みとして残す
+// 投稿者以外は ErrNotCommentAuthor を返す。既に削除済みの場合は何もしない
+func (c *Comment) Delete(userID id.UserID, now time.Time) error {
+	if !c.authorUserID.Equals(userID) {
+		return ErrNotCommentAuthor
+	}
+	if c.IsDeleted() {
+		return nil
+	}
+
+	c.body = ""
+	c.deckID = nil
+	c.deletedAt = &now
+	return nil
+}
+
+// normalizeBody は前後の空白を取り除いた本文を検証する
+func normalizeBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.New("body cannot be empty")
+	}
+	if utf8.RuneCountInString(body) > maxBodyLength {
+		return "", errors.New("body must be 1000 characters or less")
+	}
+	return body, nil
+}
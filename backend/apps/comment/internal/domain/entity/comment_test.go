@@ -0,0 +1,259 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName string
+		body     string
+		deckID   *id.DeckID
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 前後の空白を取り除いた本文でコメントが作成される",
+			body:     "  リザニンフはSSで良いと思う  ",
+			deckID:   &deckID,
+			wantBody: "リザニンフはSSで良いと思う",
+		},
+		{
+			caseName: "正常系: 1000文字の本文は作成できる",
+			body:     strings.Repeat("あ", 1000),
+			wantBody: strings.Repeat("あ", 1000),
+		},
+		{
+			caseName: "異常系: 空白のみの本文はエラーになる",
+			body:     "   ",
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 1001文字の本文はエラーになる",
+			body:     strings.Repeat("あ", 1001),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			comment, err := entity.NewComment(tierListID, authorID, tt.body, tt.deckID, now)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID, comment.TierListID(), "tier list id should match")
+			assert.Equal(t, authorID, comment.AuthorUserID(), "author should match")
+			assert.Equal(t, tt.deckID, comment.DeckID(), "mentioned deck should match")
+			assert.Equal(t, tt.wantBody, comment.Body(), "body should be trimmed")
+			assert.Equal(t, now, comment.CreatedAt(), "created at should match")
+			assert.False(t, comment.IsReply(), "comment should be top level")
+			assert.False(t, comment.IsDeleted(), "comment should not be deleted")
+		})
+	}
+}
+
+func TestNewReply(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	parentID := id.NewCommentID()
+	deletedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		caseName string
+		parent   *entity.Comment
+		wantErr  error
+	}{
+		{
+			caseName: "正常系: トップレベルのコメントに返信できる",
+			parent:   entity.ReconstructComment(parentID, tierListID, nil, id.NewUserID(), nil, "親コメント", now, nil, nil),
+		},
+		{
+			caseName: "異常系: 返信への返信はエラーになる",
+			parent:   entity.ReconstructComment(id.NewCommentID(), tierListID, &parentID, id.NewUserID(), nil, "返信", now, nil, nil),
+			wantErr:  entity.ErrNestedReply,
+		},
+		{
+			caseName: "異常系: 削除済みのコメントへの返信はエラーになる",
+			parent:   entity.ReconstructComment(parentID, tierListID, nil, id.NewUserID(), nil, "", now, nil, &deletedAt),
+			wantErr:  entity.ErrCommentDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			reply, err := entity.NewReply(tt.parent, authorID, "同意です", nil, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match expected")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.True(t, reply.IsReply(), "reply should have a parent")
+			assert.Equal(t, tt.parent.ID(), *reply.ParentID(), "parent id should match")
+			assert.Equal(t, tierListID, reply.TierListID(), "reply should belong to the parent's tier list")
+		})
+	}
+}
+
+func TestComment_Edit(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	deletedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		caseName   string
+		userID     id.UserID
+		deletedAt  *time.Time
+		body       string
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			caseName: "正常系: 投稿者は本文を編集できる",
+			userID:   authorID,
+			body:     "やっぱりSだと思う",
+		},
+		{
+			caseName: "異常系: 投稿者以外は編集できない",
+			userID:   id.NewUserID(),
+			body:     "やっぱりSだと思う",
+			wantErr:  entity.ErrNotCommentAuthor,
+		},
+		{
+			caseName:  "異常系: 削除済みのコメントは編集できない",
+			userID:    authorID,
+			deletedAt: &deletedAt,
+			body:      "やっぱりSだと思う",
+			wantErr:   entity.ErrCommentDeleted,
+		},
+		{
+			caseName:   "異常系: 空の本文には編集できない",
+			userID:     authorID,
+			body:       "",
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			comment := entity.ReconstructComment(id.NewCommentID(), id.NewTierListID(), nil, authorID, nil, "SSだと思う", createdAt, nil, tt.deletedAt)
+
+			// Act
+			err := comment.Edit(tt.userID, tt.body, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match expected")
+				return
+			}
+			if tt.wantAnyErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, "SSだと思う", comment.Body(), "body should not be changed")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.body, comment.Body(), "body should be edited")
+			assert.Equal(t, &now, comment.EditedAt(), "edited at should be recorded")
+		})
+	}
+}
+
+func TestComment_Delete(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	deletedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		caseName      string
+		userID        id.UserID
+		deletedAt     *time.Time
+		wantErr       error
+		wantDeletedAt *time.Time
+	}{
+		{
+			caseName:      "正常系: 投稿者が削除すると本文と言及したデッキが消え、削除済みになる",
+			userID:        authorID,
+			wantDeletedAt: &now,
+		},
+		{
+			caseName:      "正常系: 削除済みのコメントを再度削除しても最初の削除日時が残る",
+			userID:        authorID,
+			deletedAt:     &deletedAt,
+			wantDeletedAt: &deletedAt,
+		},
+		{
+			caseName: "異常系: 投稿者以外は削除できない",
+			userID:   id.NewUserID(),
+			wantErr:  entity.ErrNotCommentAuthor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			body := "SSだと思う"
+			mentioned := &deckID
+			if tt.deletedAt != nil {
+				body = ""
+				mentioned = nil
+			}
+			comment := entity.ReconstructComment(id.NewCommentID(), id.NewTierListID(), nil, authorID, mentioned, body, createdAt, nil, tt.deletedAt)
+
+			// Act
+			err := comment.Delete(tt.userID, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match expected")
+				assert.False(t, comment.IsDeleted(), "comment should not be deleted")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.True(t, comment.IsDeleted(), "comment should be deleted")
+			assert.Equal(t, tt.wantDeletedAt, comment.DeletedAt(), "deleted at should match")
+			assert.Empty(t, comment.Body(), "body should be cleared")
+			assert.Nil(t, comment.DeckID(), "mentioned deck should be cleared")
+		})
+	}
+}
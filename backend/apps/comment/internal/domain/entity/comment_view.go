@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+// CommentView は一覧に表示するコメント（投稿者の表示名・言及したデッキ・返信数・いいね数を含む）
+// 返信の場合 ReplyCount は常に0
+type CommentView struct {
+	CommentID         id.CommentID
+	TierListID        id.TierListID
+	ParentID          *id.CommentID
+	AuthorUserID      id.UserID
+	AuthorDisplayName string
+	Deck              *MentionedDeck
+	Body              string
+	ReplyCount        int
+	LikeCount         int
+	CreatedAt         time.Time
+	EditedAt          *time.Time
+	DeletedAt         *time.Time
+}
+
+// IsDeleted は削除済みかどうかを返す
+func (v CommentView) IsDeleted() bool {
+	return v.DeletedAt != nil
+}
+
+// MentionedDeck はコメントで言及したデッキ
+type MentionedDeck struct {
+	DeckID   id.DeckID
+	Nickname string
+	ImageURL string
+}
+
+// CommentCursor は投稿日時順のキーセットページネーションの位置
+type CommentCursor struct {
+	CreatedAt time.Time
+	CommentID uuid.UUID
+}
+
+// CommentPage はコメント一覧の1ページ。Next は次ページが存在しない場合 nil
+type CommentPage struct {
+	Comments []CommentView
+	Next     *CommentCursor
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// CommentQuerier はデータベースクエリを定義するインターフェース
+type CommentQuerier interface {
+	GetTierListComment(ctx context.Context, commentID pgtype.UUID) (db.TierListComment, error)
+	CreateTierListComment(ctx context.Context, arg db.CreateTierListCommentParams) error
+	UpdateTierListComment(ctx context.Context, arg db.UpdateTierListCommentParams) error
+	ListTierListComments(ctx context.Context, arg db.ListTierListCommentsParams) ([]db.ListTierListCommentsRow, error)
+	ListTierListCommentReplies(ctx context.Context, arg db.ListTierListCommentRepliesParams) ([]db.ListTierListCommentRepliesRow, error)
+	CountTierListCommentsByAuthorSince(ctx context.Context, arg db.CountTierListCommentsByAuthorSinceParams) (int64, error)
+}
+
+// CommentRepository はティアリストへのコメントの永続化を行う
+type CommentRepository struct {
+	queries CommentQuerier
+}
+
+// NewCommentRepository は新しいCommentRepositoryを作成
+func NewCommentRepository(queries CommentQuerier) *CommentRepository {
+	return &CommentRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのコメントを取得。存在しない場合はNotFoundエラーを返す
+func (r *CommentRepository) FindByID(ctx context.Context, commentID id.CommentID) (*entity.Comment, error) {
+	row, err := r.queries.GetTierListComment(ctx, pgtype.UUID{Bytes: commentID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("comment not found", err)
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return entity.ReconstructComment(
+		id.CommentIDFromUUID(row.CommentID.Bytes),
+		id.TierListIDFromUUID(row.TierListID.Bytes),
+		toCommentIDPtr(row.ParentCommentID),
+		id.UserIDFromUUID(row.AuthorUserID.Bytes),
+		toDeckIDPtr(row.DeckID),
+		row.Body,
+		row.CreatedAt.Time,
+		fromTimestamptz(row.EditedAt),
+		fromTimestamptz(row.DeletedAt),
+	), nil
+}
+
+// Create はコメントを保存
+func (r *CommentRepository) Create(ctx context.Context, comment *entity.Comment) error {
+	if err := r.queries.CreateTierListComment(ctx, db.CreateTierListCommentParams{
+		CommentID:       pgtype.UUID{Bytes: comment.ID().UUID(), Valid: true},
+		TierListID:      pgtype.UUID{Bytes: comment.TierListID().UUID(), Valid: true},
+		ParentCommentID: fromCommentIDPtr(comment.ParentID()),
+		AuthorUserID:    pgtype.UUID{Bytes: comment.AuthorUserID().UUID(), Valid: true},
+		DeckID:          fromDeckIDPtr(comment.DeckID()),
+		Body:            comment.Body(),
+		CreatedAt:       pgtype.Timestamptz{Time: comment.CreatedAt(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// Update はコメントの編集・削除を保存
+func (r *CommentRepository) Update(ctx context.Context, comment *entity.Comment) error {
+	if err := r.queries.UpdateTierListComment(ctx, db.UpdateTierListCommentParams{
+		CommentID: pgtype.UUID{Bytes: comment.ID().UUID(), Valid: true},
+		Body:      comment.Body(),
+		DeckID:    fromDeckIDPtr(comment.DeckID()),
+		EditedAt:  toTimestamptz(comment.EditedAt()),
+		DeletedAt: toTimestamptz(comment.DeletedAt()),
+	}); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
+
+// CountByAuthorSince は since 以降にユーザーが投稿したコメント数を取得（削除済みも含む）
+func (r *CommentRepository) CountByAuthorSince(ctx context.Context, userID id.UserID, since time.Time) (int, error) {
+	count, err := r.queries.CountTierListCommentsByAuthorSince(ctx, db.CountTierListCommentsByAuthorSinceParams{
+		AuthorUserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count comments by author: %w", err)
+	}
+	return int(count), nil
+}
+
+// FindThreadPage はティアリストのトップレベルのコメントを新しい順に1ページ分、返信数を含めて取得
+func (r *CommentRepository) FindThreadPage(ctx context.Context, tierListID id.TierListID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error) {
+	params := db.ListTierListCommentsParams{
+		TierListID: pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+		PageLimit:  int32(limit + 1), // #nosec G115 -- limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+		params.CursorCommentID = pgtype.UUID{Bytes: after.CommentID, Valid: true}
+	}
+
+	rows, err := r.queries.ListTierListComments(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	views := make([]entity.CommentView, 0, len(rows))
+	for _, row := range rows {
+		view := toCommentView(db.ListTierListCommentRepliesRow{
+			CommentID:         row.CommentID,
+			TierListID:        row.TierListID,
+			ParentCommentID:   row.ParentCommentID,
+			AuthorUserID:      row.AuthorUserID,
+			AuthorDisplayName: row.AuthorDisplayName,
+			DeckID:            row.DeckID,
+			DeckNickname:      row.DeckNickname,
+			DeckImageUrl:      row.DeckImageUrl,
+			Body:              row.Body,
+			CreatedAt:         row.CreatedAt,
+			EditedAt:          row.EditedAt,
+			DeletedAt:         row.DeletedAt,
+			LikeCount:         row.LikeCount,
+		})
+		view.ReplyCount = int(row.ReplyCount)
+		views = append(views, view)
+	}
+
+	return toCommentPage(views, limit), nil
+}
+
+// FindReplyPage はコメントへの返信を古い順に1ページ分取得
+func (r *CommentRepository) FindReplyPage(ctx context.Context, parentID id.CommentID, after *entity.CommentCursor, limit int) (*entity.CommentPage, error) {
+	params := db.ListTierListCommentRepliesParams{
+		ParentCommentID: pgtype.UUID{Bytes: parentID.UUID(), Valid: true},
+		PageLimit:       int32(limit + 1), // #nosec G115 -- limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+		params.CursorCommentID = pgtype.UUID{Bytes: after.CommentID, Valid: true}
+	}
+
+	rows, err := r.queries.ListTierListCommentReplies(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment replies: %w", err)
+	}
+
+	views := make([]entity.CommentView, 0, len(rows))
+	for _, row := range rows {
+		views = append(views, toCommentView(row))
+	}
+
+	return toCommentPage(views, limit), nil
+}
+
+// toCommentPage は1件多く取得したコメントを1ページ分に切り詰め、次ページのカーソルを設定
+func toCommentPage(views []entity.CommentView, limit int) *entity.CommentPage {
+	page := &entity.CommentPage{Comments: views}
+	if len(views) > limit {
+		page.Comments = views[:limit]
+		last := page.Comments[limit-1]
+		page.Next = &entity.CommentCursor{
+			CreatedAt: last.CreatedAt,
+			CommentID: last.CommentID.UUID(),
+		}
+	}
+	return page
+}
+
+// toCommentView はコメント一覧のクエリ結果を表示用のコメントに変換
+func toCommentView(row db.ListTierListCommentRepliesRow) entity.CommentView {
+	view := entity.CommentView{
+		CommentID:         id.CommentIDFromUUID(row.CommentID.Bytes),
+		TierListID:        id.TierListIDFromUUID(row.TierListID.Bytes),
+		ParentID:          toCommentIDPtr(row.ParentCommentID),
+		AuthorUserID:      id.UserIDFromUUID(row.AuthorUserID.Bytes),
+		AuthorDisplayName: row.AuthorDisplayName,
+		Body:              row.Body,
+		CreatedAt:         row.CreatedAt.Time,
+		EditedAt:          fromTimestamptz(row.EditedAt),
+		DeletedAt:         fromTimestamptz(row.DeletedAt),
+		LikeCount:         int(row.LikeCount),
+	}
+	if row.DeckID.Valid {
+		view.Deck = &entity.MentionedDeck{
+			DeckID:   id.DeckIDFromUUID(row.DeckID.Bytes),
+			Nickname: row.DeckNickname.String,
+			ImageURL: row.DeckImageUrl.String,
+		}
+	}
+	return view
+}
+
+// toCommentIDPtr は NULL を nil とするコメントIDに変換
+func toCommentIDPtr(u pgtype.UUID) *id.CommentID {
+	if !u.Valid {
+		return nil
+	}
+	commentID := id.CommentIDFromUUID(u.Bytes)
+	return &commentID
+}
+
+// fromCommentIDPtr は nil を NULL とするUUIDに変換
+func fromCommentIDPtr(commentID *id.CommentID) pgtype.UUID {
+	if commentID == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: commentID.UUID(), Valid: true}
+}
+
+// toDeckIDPtr は NULL を nil とするデッキIDに変換
+func toDeckIDPtr(u pgtype.UUID) *id.DeckID {
+	if !u.Valid {
+		return nil
+	}
+	deckID := id.DeckIDFromUUID(u.Bytes)
+	return &deckID
+}
+
+// fromDeckIDPtr は nil を NULL とするUUIDに変換
+func fromDeckIDPtr(deckID *id.DeckID) pgtype.UUID {
+	if deckID == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+}
+
+// toTimestamptz は nil を NULL とするタイムスタンプに変換
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// fromTimestamptz は NULL を nil とするタイムスタンプに変換
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/infrastructure/repository/comment_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/infrastructure/repository/comment_repository.go -destination=./apps/comment/internal/infrastructure/repository/comment_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentQuerier is a mock of CommentQuerier interface.
+type MockCommentQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentQuerierMockRecorder is the mock recorder for MockCommentQuerier.
+type MockCommentQuerierMockRecorder struct {
+	mock *MockCommentQuerier
+}
+
+// NewMockCommentQuerier creates a new mock instance.
+func NewMockCommentQuerier(ctrl *gomock.Controller) *MockCommentQuerier {
+	mock := &MockCommentQuerier{ctrl: ctrl}
+	mock.recorder = &MockCommentQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentQuerier) EXPECT() *MockCommentQuerierMockRecorder {
+	return m.recorder
+}
+
+// CountTierListCommentsByAuthorSince mocks base method.
+func (m *MockCommentQuerier) CountTierListCommentsByAuthorSince(ctx context.Context, arg db.CountTierListCommentsByAuthorSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListCommentsByAuthorSince", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListCommentsByAuthorSince indicates an expected call of CountTierListCommentsByAuthorSince.
+func (mr *MockCommentQuerierMockRecorder) CountTierListCommentsByAuthorSince(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListCommentsByAuthorSince", reflect.TypeOf((*MockCommentQuerier)(nil).CountTierListCommentsByAuthorSince), ctx, arg)
+}
+
+// CreateTierListComment mocks base method.
+func (m *MockCommentQuerier) CreateTierListComment(ctx context.Context, arg db.CreateTierListCommentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTierListComment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTierListComment indicates an expected call of CreateTierListComment.
+func (mr *MockCommentQuerierMockRecorder) CreateTierListComment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTierListComment", reflect.TypeOf((*MockCommentQuerier)(nil).CreateTierListComment), ctx, arg)
+}
+
+// GetTierListComment mocks base method.
+func (m *MockCommentQuerier) GetTierListComment(ctx context.Context, commentID pgtype.UUID) (db.TierListComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierListComment", ctx, commentID)
+	ret0, _ := ret[0].(db.TierListComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierListComment indicates an expected call of GetTierListComment.
+func (mr *MockCommentQuerierMockRecorder) GetTierListComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierListComment", reflect.TypeOf((*MockCommentQuerier)(nil).GetTierListComment), ctx, commentID)
+}
+
+// ListTierListCommentReplies mocks base method.
+func (m *MockCommentQuerier) ListTierListCommentReplies(ctx context.Context, arg db.ListTierListCommentRepliesParams) ([]db.ListTierListCommentRepliesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListCommentReplies", ctx, arg)
+	ret0, _ := ret[0].([]db.ListTierListCommentRepliesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListCommentReplies indicates an expected call of ListTierListCommentReplies.
+func (mr *MockCommentQuerierMockRecorder) ListTierListCommentReplies(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListCommentReplies", reflect.TypeOf((*MockCommentQuerier)(nil).ListTierListCommentReplies), ctx, arg)
+}
+
+// ListTierListComments mocks base method.
+func (m *MockCommentQuerier) ListTierListComments(ctx context.Context, arg db.ListTierListCommentsParams) ([]db.ListTierListCommentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListComments", ctx, arg)
+	ret0, _ := ret[0].([]db.ListTierListCommentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListComments indicates an expected call of ListTierListComments.
+func (mr *MockCommentQuerierMockRecorder) ListTierListComments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListComments", reflect.TypeOf((*MockCommentQuerier)(nil).ListTierListComments), ctx, arg)
+}
+
+// UpdateTierListComment mocks base method.
+func (m *MockCommentQuerier) UpdateTierListComment(ctx context.Context, arg db.UpdateTierListCommentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTierListComment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTierListComment indicates an expected call of UpdateTierListComment.
+func (mr *MockCommentQuerierMockRecorder) UpdateTierListComment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTierListComment", reflect.TypeOf((*MockCommentQuerier)(nil).UpdateTierListComment), ctx, arg)
+}
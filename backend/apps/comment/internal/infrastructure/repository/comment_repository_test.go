@@ -0,0 +1,443 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/comment/internal/domain/entity"
+	"poketier/apps/comment/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestCommentRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	commentID := id.NewCommentID()
+	parentID := id.NewCommentID()
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockCommentQuerier)
+		want         *entity.Comment
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 返信・言及したデッキ・編集日時を含むコメントが復元される事",
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgtype.UUID{Bytes: commentID.UUID(), Valid: true}).Return(db.TierListComment{
+					CommentID:       pgtype.UUID{Bytes: commentID.UUID(), Valid: true},
+					TierListID:      pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					ParentCommentID: pgtype.UUID{Bytes: parentID.UUID(), Valid: true},
+					AuthorUserID:    pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+					DeckID:          pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+					Body:            "同意です",
+					CreatedAt:       pgtype.Timestamptz{Time: createdAt, Valid: true},
+					EditedAt:        pgtype.Timestamptz{Time: editedAt, Valid: true},
+				}, nil)
+			},
+			want: entity.ReconstructComment(commentID, tierListID, &parentID, authorID, &deckID, "同意です", createdAt, &editedAt, nil),
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), gomock.Any()).Return(db.TierListComment{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), gomock.Any()).Return(db.TierListComment{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), commentID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "comment does not match")
+		})
+	}
+}
+
+func TestCommentRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	parent, err := entity.NewComment(tierListID, id.NewUserID(), "親コメント", nil, createdAt)
+	require.NoError(t, err, "failed to create parent comment")
+	reply, err := entity.NewReply(parent, authorID, "同意です", &deckID, createdAt)
+	require.NoError(t, err, "failed to create reply")
+
+	tests := []struct {
+		caseName    string
+		comment     *entity.Comment
+		setupMock   func(mockQuerier *MockCommentQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: トップレベルのコメントは返信先・言及したデッキをNULLで保存する事",
+			comment:  parent,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().CreateTierListComment(gomock.Any(), db.CreateTierListCommentParams{
+					CommentID:    pgtype.UUID{Bytes: parent.ID().UUID(), Valid: true},
+					TierListID:   pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					AuthorUserID: pgtype.UUID{Bytes: parent.AuthorUserID().UUID(), Valid: true},
+					Body:         "親コメント",
+					CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 返信は返信先と言及したデッキを保存する事",
+			comment:  reply,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().CreateTierListComment(gomock.Any(), db.CreateTierListCommentParams{
+					CommentID:       pgtype.UUID{Bytes: reply.ID().UUID(), Valid: true},
+					TierListID:      pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					ParentCommentID: pgtype.UUID{Bytes: parent.ID().UUID(), Valid: true},
+					AuthorUserID:    pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+					DeckID:          pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+					Body:            "同意です",
+					CreatedAt:       pgtype.Timestamptz{Time: createdAt, Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			comment:  parent,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().CreateTierListComment(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), tt.comment)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestCommentRepository_Update(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Hour)
+
+	// Arrange
+	comment := entity.ReconstructComment(id.NewCommentID(), id.NewTierListID(), nil, authorID, &deckID, "SSだと思う", createdAt, nil, nil)
+	require.NoError(t, comment.Delete(authorID, deletedAt), "failed to delete comment")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockQuerier := NewMockCommentQuerier(ctrl)
+	mockQuerier.EXPECT().UpdateTierListComment(gomock.Any(), db.UpdateTierListCommentParams{
+		CommentID: pgtype.UUID{Bytes: comment.ID().UUID(), Valid: true},
+		Body:      "",
+		DeletedAt: pgtype.Timestamptz{Time: deletedAt, Valid: true},
+	}).Return(nil)
+	repo := repository.NewCommentRepository(mockQuerier)
+
+	// Act
+	err := repo.Update(context.Background(), comment)
+
+	// Assert
+	assert.NoError(t, err, "deleted comment should be saved as a tombstone")
+}
+
+func TestCommentRepository_CountByAuthorSince(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	since := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockQuerier := NewMockCommentQuerier(ctrl)
+	mockQuerier.EXPECT().CountTierListCommentsByAuthorSince(gomock.Any(), db.CountTierListCommentsByAuthorSinceParams{
+		AuthorUserID: pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: since, Valid: true},
+	}).Return(int64(3), nil)
+	repo := repository.NewCommentRepository(mockQuerier)
+
+	// Act
+	got, err := repo.CountByAuthorSince(context.Background(), authorID, since)
+
+	// Assert
+	require.NoError(t, err, "unexpected error occurred")
+	assert.Equal(t, 3, got, "count does not match")
+}
+
+func TestCommentRepository_FindThreadPage(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	authorID := id.NewUserID()
+	deckID := id.NewDeckID()
+	newer := id.NewCommentID()
+	older := id.NewCommentID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Hour)
+	cursor := &entity.CommentCursor{CreatedAt: createdAt.Add(time.Hour), CommentID: id.NewCommentID().UUID()}
+
+	row := func(commentID id.CommentID, at time.Time) db.ListTierListCommentsRow {
+		return db.ListTierListCommentsRow{
+			CommentID:         pgtype.UUID{Bytes: commentID.UUID(), Valid: true},
+			TierListID:        pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+			AuthorUserID:      pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+			AuthorDisplayName: "解説花子",
+			CreatedAt:         pgtype.Timestamptz{Time: at, Valid: true},
+		}
+	}
+	newerRow := row(newer, createdAt.Add(time.Minute))
+	newerRow.DeckID = pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+	newerRow.DeckNickname = pgtype.Text{String: "リザニンフ", Valid: true}
+	newerRow.DeckImageUrl = pgtype.Text{String: "https://example.com/a.png", Valid: true}
+	newerRow.Body = "リザニンフはSS"
+	newerRow.ReplyCount = 2
+	newerRow.LikeCount = 5
+	olderRow := row(older, createdAt)
+	olderRow.DeletedAt = pgtype.Timestamptz{Time: deletedAt, Valid: true}
+
+	tests := []struct {
+		caseName    string
+		after       *entity.CommentCursor
+		limit       int
+		setupMock   func(mockQuerier *MockCommentQuerier)
+		want        *entity.CommentPage
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 1件多く取得できた場合、次ページのカーソルが設定される事",
+			after:    cursor,
+			limit:    1,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().ListTierListComments(gomock.Any(), db.ListTierListCommentsParams{
+					TierListID:      pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					CursorCreatedAt: pgtype.Timestamptz{Time: cursor.CreatedAt, Valid: true},
+					CursorCommentID: pgtype.UUID{Bytes: cursor.CommentID, Valid: true},
+					PageLimit:       2,
+				}).Return([]db.ListTierListCommentsRow{newerRow, olderRow}, nil)
+			},
+			want: &entity.CommentPage{
+				Comments: []entity.CommentView{
+					{
+						CommentID:         newer,
+						TierListID:        tierListID,
+						AuthorUserID:      authorID,
+						AuthorDisplayName: "解説花子",
+						Deck:              &entity.MentionedDeck{DeckID: deckID, Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+						Body:              "リザニンフはSS",
+						ReplyCount:        2,
+						LikeCount:         5,
+						CreatedAt:         createdAt.Add(time.Minute),
+					},
+				},
+				Next: &entity.CommentCursor{CreatedAt: createdAt.Add(time.Minute), CommentID: newer.UUID()},
+			},
+		},
+		{
+			caseName: "正常系: 削除済みのコメントも含めて最終ページを取得する事",
+			limit:    2,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().ListTierListComments(gomock.Any(), db.ListTierListCommentsParams{
+					TierListID: pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					PageLimit:  3,
+				}).Return([]db.ListTierListCommentsRow{olderRow}, nil)
+			},
+			want: &entity.CommentPage{
+				Comments: []entity.CommentView{
+					{
+						CommentID:         older,
+						TierListID:        tierListID,
+						AuthorUserID:      authorID,
+						AuthorDisplayName: "解説花子",
+						CreatedAt:         createdAt,
+						DeletedAt:         &deletedAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			limit:    1,
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().ListTierListComments(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindThreadPage(context.Background(), tierListID, tt.after, tt.limit)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "page does not match")
+		})
+	}
+}
+
+func TestCommentRepository_FindReplyPage(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	parentID := id.NewCommentID()
+	authorID := id.NewUserID()
+	replyID := id.NewCommentID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockCommentQuerier)
+		want        *entity.CommentPage
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 返信を古い順に取得する事",
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().ListTierListCommentReplies(gomock.Any(), db.ListTierListCommentRepliesParams{
+					ParentCommentID: pgtype.UUID{Bytes: parentID.UUID(), Valid: true},
+					PageLimit:       21,
+				}).Return([]db.ListTierListCommentRepliesRow{
+					{
+						CommentID:         pgtype.UUID{Bytes: replyID.UUID(), Valid: true},
+						TierListID:        pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+						ParentCommentID:   pgtype.UUID{Bytes: parentID.UUID(), Valid: true},
+						AuthorUserID:      pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+						AuthorDisplayName: "解説花子",
+						Body:              "同意です",
+						CreatedAt:         pgtype.Timestamptz{Time: createdAt, Valid: true},
+						LikeCount:         1,
+					},
+				}, nil)
+			},
+			want: &entity.CommentPage{
+				Comments: []entity.CommentView{
+					{
+						CommentID:         replyID,
+						TierListID:        tierListID,
+						ParentID:          &parentID,
+						AuthorUserID:      authorID,
+						AuthorDisplayName: "解説花子",
+						Body:              "同意です",
+						LikeCount:         1,
+						CreatedAt:         createdAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockCommentQuerier) {
+				mockQuerier.EXPECT().ListTierListCommentReplies(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindReplyPage(context.Background(), parentID, nil, 20)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "page does not match")
+		})
+	}
+}
+
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
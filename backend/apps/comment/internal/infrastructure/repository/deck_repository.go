@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// DeckQuerier はデータベースクエリを定義するインターフェース
+type DeckQuerier interface {
+	GetDeck(ctx context.Context, deckID pgtype.UUID) (db.Deck, error)
+}
+
+// DeckRepository はコメントで言及するデッキの参照を行う
+type DeckRepository struct {
+	queries DeckQuerier
+}
+
+// NewDeckRepository は新しいDeckRepositoryを作成
+func NewDeckRepository(queries DeckQuerier) *DeckRepository {
+	return &DeckRepository{
+		queries: queries,
+	}
+}
+
+// FindSeasonID はデッキが登録されたシーズンIDを取得。デッキが存在しない場合はNotFoundエラーを返す
+func (r *DeckRepository) FindSeasonID(ctx context.Context, deckID id.DeckID) (id.SeasonID, error) {
+	row, err := r.queries.GetDeck(ctx, pgtype.UUID{Bytes: deckID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return id.SeasonID{}, errs.NewNotFoundError("deck not found", err)
+		}
+		return id.SeasonID{}, fmt.Errorf("failed to get deck: %w", err)
+	}
+	return id.SeasonIDFromUUID(row.SeasonID.Bytes), nil
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/infrastructure/repository/deck_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/infrastructure/repository/deck_repository.go -destination=./apps/comment/internal/infrastructure/repository/deck_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckQuerier is a mock of DeckQuerier interface.
+type MockDeckQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckQuerierMockRecorder is the mock recorder for MockDeckQuerier.
+type MockDeckQuerierMockRecorder struct {
+	mock *MockDeckQuerier
+}
+
+// NewMockDeckQuerier creates a new mock instance.
+func NewMockDeckQuerier(ctrl *gomock.Controller) *MockDeckQuerier {
+	mock := &MockDeckQuerier{ctrl: ctrl}
+	mock.recorder = &MockDeckQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckQuerier) EXPECT() *MockDeckQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetDeck mocks base method.
+func (m *MockDeckQuerier) GetDeck(ctx context.Context, deckID pgtype.UUID) (db.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeck", ctx, deckID)
+	ret0, _ := ret[0].(db.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeck indicates an expected call of GetDeck.
+func (mr *MockDeckQuerierMockRecorder) GetDeck(ctx, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeck", reflect.TypeOf((*MockDeckQuerier)(nil).GetDeck), ctx, deckID)
+}
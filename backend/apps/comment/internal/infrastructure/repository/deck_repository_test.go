@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/comment/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestDeckRepository_FindSeasonID(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	seasonID := id.NewSeasonID()
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockDeckQuerier)
+		want         id.SeasonID
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: デッキが登録されたシーズンIDを取得する事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), pgtype.UUID{Bytes: deckID.UUID(), Valid: true}).
+					Return(db.Deck{SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}}, nil)
+			},
+			want: seasonID,
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), gomock.Any()).Return(db.Deck{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), gomock.Any()).Return(db.Deck{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindSeasonID(context.Background(), deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "season id does not match")
+		})
+	}
+}
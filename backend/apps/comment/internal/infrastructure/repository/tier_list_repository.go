@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// TierListQuerier はデータベースクエリを定義するインターフェース
+type TierListQuerier interface {
+	GetTierList(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error)
+}
+
+// TierListRepository はコメントするティアリストの参照を行う
+type TierListRepository struct {
+	queries TierListQuerier
+}
+
+// NewTierListRepository は新しいTierListRepositoryを作成
+func NewTierListRepository(queries TierListQuerier) *TierListRepository {
+	return &TierListRepository{
+		queries: queries,
+	}
+}
+
+// FindSeasonID はティアリストのシーズンIDを取得。ティアリストが存在しない場合はNotFoundエラーを返す
+func (r *TierListRepository) FindSeasonID(ctx context.Context, tierListID id.TierListID) (id.SeasonID, error) {
+	row, err := r.queries.GetTierList(ctx, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return id.SeasonID{}, errs.NewNotFoundError("tier list not found", err)
+		}
+		return id.SeasonID{}, fmt.Errorf("failed to get tier list: %w", err)
+	}
+	return id.SeasonIDFromUUID(row.SeasonID.Bytes), nil
+}
@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/comment/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestTierListRepository_FindSeasonID(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	seasonID := id.NewSeasonID()
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListQuerier)
+		want         id.SeasonID
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: ティアリストのシーズンIDを取得する事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}).
+					Return(db.TierList{SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}}, nil)
+			},
+			want: seasonID,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), gomock.Any()).Return(db.TierList{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), gomock.Any()).Return(db.TierList{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindSeasonID(context.Background(), tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "season id does not match")
+		})
+	}
+}
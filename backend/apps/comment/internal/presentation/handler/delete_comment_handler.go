@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteCommentHandler struct {
+	uc DeleteCommentUseCase
+}
+
+type DeleteCommentUseCase interface {
+	Execute(ctx context.Context, params usecase.DeleteCommentParams) error
+}
+
+func NewDeleteCommentHandler(uc DeleteCommentUseCase) *DeleteCommentHandler {
+	return &DeleteCommentHandler{
+		uc: uc,
+	}
+}
+
+func (h *DeleteCommentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.DeleteCommentParams{
+		UserID:    userID,
+		CommentID: ctx.Param("comment_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
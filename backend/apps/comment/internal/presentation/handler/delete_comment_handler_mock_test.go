@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/presentation/handler/delete_comment_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/presentation/handler/delete_comment_handler.go -destination=./apps/comment/internal/presentation/handler/delete_comment_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/comment/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteCommentUseCase is a mock of DeleteCommentUseCase interface.
+type MockDeleteCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteCommentUseCaseMockRecorder is the mock recorder for MockDeleteCommentUseCase.
+type MockDeleteCommentUseCaseMockRecorder struct {
+	mock *MockDeleteCommentUseCase
+}
+
+// NewMockDeleteCommentUseCase creates a new mock instance.
+func NewMockDeleteCommentUseCase(ctrl *gomock.Controller) *MockDeleteCommentUseCase {
+	mock := &MockDeleteCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteCommentUseCase) EXPECT() *MockDeleteCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteCommentUseCase) Execute(ctx context.Context, params usecase.DeleteCommentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteCommentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteCommentUseCase)(nil).Execute), ctx, params)
+}
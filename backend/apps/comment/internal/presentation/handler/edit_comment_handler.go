@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/request"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EditCommentHandler struct {
+	uc EditCommentUseCase
+}
+
+type EditCommentUseCase interface {
+	Execute(ctx context.Context, params usecase.EditCommentParams) (*usecase.EditCommentResult, error)
+}
+
+func NewEditCommentHandler(uc EditCommentUseCase) *EditCommentHandler {
+	return &EditCommentHandler{
+		uc: uc,
+	}
+}
+
+func (h *EditCommentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.EditCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.EditCommentParams{
+		UserID:    userID,
+		CommentID: ctx.Param("comment_id"),
+		Body:      req.Body,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewEditCommentResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/presentation/handler/edit_comment_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/presentation/handler/edit_comment_handler.go -destination=./apps/comment/internal/presentation/handler/edit_comment_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/comment/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEditCommentUseCase is a mock of EditCommentUseCase interface.
+type MockEditCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEditCommentUseCaseMockRecorder is the mock recorder for MockEditCommentUseCase.
+type MockEditCommentUseCaseMockRecorder struct {
+	mock *MockEditCommentUseCase
+}
+
+// NewMockEditCommentUseCase creates a new mock instance.
+func NewMockEditCommentUseCase(ctrl *gomock.Controller) *MockEditCommentUseCase {
+	mock := &MockEditCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockEditCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditCommentUseCase) EXPECT() *MockEditCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEditCommentUseCase) Execute(ctx context.Context, params usecase.EditCommentParams) (*usecase.EditCommentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.EditCommentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEditCommentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEditCommentUseCase)(nil).Execute), ctx, params)
+}
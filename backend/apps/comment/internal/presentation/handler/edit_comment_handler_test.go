@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/handler"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEditCommentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	tierListID := id.NewTierListID().String()
+	commentID := id.NewCommentID().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		body           string
+		mockSetup      func(*MockEditCommentUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: リクエストボディがユースケースに渡り、編集後のコメントが返される",
+			loggedIn: true,
+			body:     `{"body":"やっぱりS"}`,
+			mockSetup: func(mockUC *MockEditCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.EditCommentParams{
+					UserID:    userID,
+					CommentID: commentID,
+					Body:      "やっぱりS",
+				}).Return(&usecase.EditCommentResult{
+					CommentID:  commentID,
+					TierListID: tierListID,
+					Body:       "やっぱりS",
+					CreatedAt:  createdAt,
+					EditedAt:   editedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.CommentResponse{
+				CommentID:  commentID,
+				TierListID: tierListID,
+				Body:       "やっぱりS",
+				CreatedAt:  createdAt,
+				EditedAt:   &editedAt,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			body:           `{"body":"やっぱりS"}`,
+			mockSetup:      func(mockUC *MockEditCommentUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: 本文がない場合、400が返される",
+			loggedIn:       true,
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockEditCommentUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: 投稿者以外の場合、403が返される",
+			loggedIn: true,
+			body:     `{"body":"やっぱりS"}`,
+			mockSetup: func(mockUC *MockEditCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewForbiddenError("only the author can edit the comment", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Forbidden",
+				Status: http.StatusForbidden,
+				Detail: "You do not have permission to perform this action.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			body:     `{"body":"やっぱりS"}`,
+			mockSetup: func(mockUC *MockEditCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockEditCommentUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewEditCommentHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPatch, "/comments/"+commentID, strings.NewReader(tt.body))
+			c.Request = c.Request.WithContext(ctx)
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "comment_id", Value: commentID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
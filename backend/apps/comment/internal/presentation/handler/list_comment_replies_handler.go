@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/request"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListCommentRepliesHandler struct {
+	uc ListCommentRepliesUseCase
+}
+
+type ListCommentRepliesUseCase interface {
+	Execute(ctx context.Context, params usecase.ListCommentRepliesParams) (*usecase.ListCommentRepliesResult, error)
+}
+
+func NewListCommentRepliesHandler(uc ListCommentRepliesUseCase) *ListCommentRepliesHandler {
+	return &ListCommentRepliesHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListCommentRepliesHandler) Handle(ctx *gin.Context) {
+	var req request.ListCommentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListCommentRepliesParams{
+		CommentID: ctx.Param("comment_id"),
+		Cursor:    req.Cursor,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListCommentRepliesResponse(result))
+}
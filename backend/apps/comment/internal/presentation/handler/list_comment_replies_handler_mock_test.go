@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/presentation/handler/list_comment_replies_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/presentation/handler/list_comment_replies_handler.go -destination=./apps/comment/internal/presentation/handler/list_comment_replies_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/comment/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListCommentRepliesUseCase is a mock of ListCommentRepliesUseCase interface.
+type MockListCommentRepliesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListCommentRepliesUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListCommentRepliesUseCaseMockRecorder is the mock recorder for MockListCommentRepliesUseCase.
+type MockListCommentRepliesUseCaseMockRecorder struct {
+	mock *MockListCommentRepliesUseCase
+}
+
+// NewMockListCommentRepliesUseCase creates a new mock instance.
+func NewMockListCommentRepliesUseCase(ctrl *gomock.Controller) *MockListCommentRepliesUseCase {
+	mock := &MockListCommentRepliesUseCase{ctrl: ctrl}
+	mock.recorder = &MockListCommentRepliesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListCommentRepliesUseCase) EXPECT() *MockListCommentRepliesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListCommentRepliesUseCase) Execute(ctx context.Context, params usecase.ListCommentRepliesParams) (*usecase.ListCommentRepliesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListCommentRepliesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListCommentRepliesUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListCommentRepliesUseCase)(nil).Execute), ctx, params)
+}
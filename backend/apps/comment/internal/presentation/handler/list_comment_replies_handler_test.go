@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/handler"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCommentRepliesHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	commentID := id.NewCommentID().String()
+	replyID := id.NewCommentID().String()
+	userID := id.NewUserID().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		query          string
+		mockSetup      func(*MockListCommentRepliesUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 返信一覧が返され、次ページがない場合はカーソルがnullになる",
+			query:    "?limit=10",
+			mockSetup: func(mockUC *MockListCommentRepliesUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListCommentRepliesParams{
+					CommentID: commentID,
+					Limit:     10,
+				}).Return(&usecase.ListCommentRepliesResult{
+					Replies: []usecase.LCRReply{
+						{
+							CommentID:       replyID,
+							ParentCommentID: commentID,
+							Author:          &usecase.LCRAuthor{UserID: userID, DisplayName: "解説花子"},
+							Body:            "同意です",
+							CreatedAt:       createdAt,
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListCommentRepliesResponse{
+				Replies: []response.LCRReply{
+					{
+						CommentID:       replyID,
+						ParentCommentID: commentID,
+						Author:          &response.CommentAuthor{UserID: userID, DisplayName: "解説花子"},
+						Body:            "同意です",
+						CreatedAt:       createdAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、404が返される",
+			mockSetup: func(mockUC *MockListCommentRepliesUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("comment not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			mockSetup: func(mockUC *MockListCommentRepliesUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListCommentRepliesUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListCommentRepliesHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/comments/"+commentID+"/replies"+tt.query, nil)
+			c.Params = gin.Params{{Key: "comment_id", Value: commentID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
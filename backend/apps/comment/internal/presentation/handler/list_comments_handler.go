@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/request"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListCommentsHandler struct {
+	uc ListCommentsUseCase
+}
+
+type ListCommentsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListCommentsParams) (*usecase.ListCommentsResult, error)
+}
+
+func NewListCommentsHandler(uc ListCommentsUseCase) *ListCommentsHandler {
+	return &ListCommentsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListCommentsHandler) Handle(ctx *gin.Context) {
+	var req request.ListCommentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListCommentsParams{
+		TierListID: ctx.Param("tier_list_id"),
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListCommentsResponse(result))
+}
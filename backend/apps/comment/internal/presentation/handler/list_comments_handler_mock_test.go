@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/presentation/handler/list_comments_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/presentation/handler/list_comments_handler.go -destination=./apps/comment/internal/presentation/handler/list_comments_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/comment/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListCommentsUseCase is a mock of ListCommentsUseCase interface.
+type MockListCommentsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListCommentsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListCommentsUseCaseMockRecorder is the mock recorder for MockListCommentsUseCase.
+type MockListCommentsUseCaseMockRecorder struct {
+	mock *MockListCommentsUseCase
+}
+
+// NewMockListCommentsUseCase creates a new mock instance.
+func NewMockListCommentsUseCase(ctrl *gomock.Controller) *MockListCommentsUseCase {
+	mock := &MockListCommentsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListCommentsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListCommentsUseCase) EXPECT() *MockListCommentsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListCommentsUseCase) Execute(ctx context.Context, params usecase.ListCommentsParams) (*usecase.ListCommentsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListCommentsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListCommentsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListCommentsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,142 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/handler"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCommentsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tierListID := id.NewTierListID().String()
+	commentID := id.NewCommentID().String()
+	deletedID := id.NewCommentID().String()
+	userID := id.NewUserID().String()
+	deckID := id.NewDeckID().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	nextCursor := "next"
+
+	tests := []struct {
+		caseName       string
+		query          string
+		mockSetup      func(*MockListCommentsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: コメント一覧と次ページのカーソルが返され、削除済みのコメントは投稿者がnullになる",
+			query:    "?cursor=abc&limit=2",
+			mockSetup: func(mockUC *MockListCommentsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListCommentsParams{
+					TierListID: tierListID,
+					Cursor:     "abc",
+					Limit:      2,
+				}).Return(&usecase.ListCommentsResult{
+					Comments: []usecase.LCComment{
+						{
+							CommentID:  commentID,
+							Author:     &usecase.LCAuthor{UserID: userID, DisplayName: "解説花子"},
+							Deck:       &usecase.LCDeck{DeckID: deckID, Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+							Body:       "リザニンフはSS",
+							ReplyCount: 3,
+							LikeCount:  4,
+							CreatedAt:  createdAt,
+						},
+						{
+							CommentID:  deletedID,
+							ReplyCount: 1,
+							Deleted:    true,
+							CreatedAt:  createdAt,
+						},
+					},
+					NextCursor: nextCursor,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListCommentsResponse{
+				Comments: []response.LCComment{
+					{
+						CommentID:  commentID,
+						Author:     &response.CommentAuthor{UserID: userID, DisplayName: "解説花子"},
+						Deck:       &response.CommentDeck{DeckID: deckID, Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+						Body:       "リザニンフはSS",
+						ReplyCount: 3,
+						LikeCount:  4,
+						CreatedAt:  createdAt,
+					},
+					{
+						CommentID:  deletedID,
+						ReplyCount: 1,
+						Deleted:    true,
+						CreatedAt:  createdAt,
+					},
+				},
+				NextCursor: &nextCursor,
+			},
+		},
+		{
+			caseName:       "異常系: limitが上限を超える場合、400が返される",
+			query:          "?limit=101",
+			mockSetup:      func(mockUC *MockListCommentsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			mockSetup: func(mockUC *MockListCommentsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListCommentsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListCommentsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/tier-lists/"+tierListID+"/comments"+tt.query, nil)
+			c.Params = gin.Params{{Key: "tier_list_id", Value: tierListID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
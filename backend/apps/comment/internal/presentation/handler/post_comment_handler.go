@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/request"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PostCommentHandler struct {
+	uc PostCommentUseCase
+}
+
+type PostCommentUseCase interface {
+	Execute(ctx context.Context, params usecase.PostCommentParams) (*usecase.PostCommentResult, error)
+}
+
+func NewPostCommentHandler(uc PostCommentUseCase) *PostCommentHandler {
+	return &PostCommentHandler{
+		uc: uc,
+	}
+}
+
+func (h *PostCommentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.PostCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.PostCommentParams{
+		UserID:          userID,
+		TierListID:      ctx.Param("tier_list_id"),
+		ParentCommentID: req.ParentCommentID,
+		DeckID:          req.DeckID,
+		Body:            req.Body,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewPostCommentResponse(result))
+}
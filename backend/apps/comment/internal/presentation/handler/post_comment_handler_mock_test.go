@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/comment/internal/presentation/handler/post_comment_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/comment/internal/presentation/handler/post_comment_handler.go -destination=./apps/comment/internal/presentation/handler/post_comment_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/comment/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPostCommentUseCase is a mock of PostCommentUseCase interface.
+type MockPostCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPostCommentUseCaseMockRecorder is the mock recorder for MockPostCommentUseCase.
+type MockPostCommentUseCaseMockRecorder struct {
+	mock *MockPostCommentUseCase
+}
+
+// NewMockPostCommentUseCase creates a new mock instance.
+func NewMockPostCommentUseCase(ctrl *gomock.Controller) *MockPostCommentUseCase {
+	mock := &MockPostCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockPostCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostCommentUseCase) EXPECT() *MockPostCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockPostCommentUseCase) Execute(ctx context.Context, params usecase.PostCommentParams) (*usecase.PostCommentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.PostCommentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockPostCommentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPostCommentUseCase)(nil).Execute), ctx, params)
+}
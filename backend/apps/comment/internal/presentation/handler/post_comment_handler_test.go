@@ -0,0 +1,188 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/comment/internal/application/usecase"
+	"poketier/apps/comment/internal/presentation/handler"
+	"poketier/apps/comment/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostCommentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	tierListID := id.NewTierListID().String()
+	commentID := id.NewCommentID().String()
+	parentID := id.NewCommentID().String()
+	deckID := id.NewDeckID().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	badRequest := errs.ErrorResponse{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "The request is invalid.",
+	}
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		body           string
+		mockSetup      func(*MockPostCommentUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: リクエストボディがユースケースに渡り、201と投稿したコメントが返される",
+			loggedIn: true,
+			body:     `{"body":"同意です","parent_comment_id":"` + parentID + `","deck_id":"` + deckID + `"}`,
+			mockSetup: func(mockUC *MockPostCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.PostCommentParams{
+					UserID:          userID,
+					TierListID:      tierListID,
+					ParentCommentID: parentID,
+					DeckID:          deckID,
+					Body:            "同意です",
+				}).Return(&usecase.PostCommentResult{
+					CommentID:       commentID,
+					TierListID:      tierListID,
+					ParentCommentID: &parentID,
+					DeckID:          &deckID,
+					Body:            "同意です",
+					CreatedAt:       createdAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: response.CommentResponse{
+				CommentID:       commentID,
+				TierListID:      tierListID,
+				ParentCommentID: &parentID,
+				DeckID:          &deckID,
+				Body:            "同意です",
+				CreatedAt:       createdAt,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			body:           `{"body":"同意です"}`,
+			mockSetup:      func(mockUC *MockPostCommentUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: 本文がない場合、400が返される",
+			loggedIn:       true,
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockPostCommentUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName:       "異常系: 不正なJSONの場合、400が返される",
+			loggedIn:       true,
+			body:           `{"body":`,
+			mockSetup:      func(mockUC *MockPostCommentUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName: "異常系: 投稿数が上限に達している場合、429が返される",
+			loggedIn: true,
+			body:     `{"body":"同意です"}`,
+			mockSetup: func(mockUC *MockPostCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewTooManyRequestsError("comment rate limit exceeded", nil))
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Too Many Requests",
+				Status: http.StatusTooManyRequests,
+				Detail: "Too many requests. Please try again later.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			body:     `{"body":"同意です"}`,
+			mockSetup: func(mockUC *MockPostCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockPostCommentUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewPostCommentHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/tier-lists/"+tierListID+"/comments", strings.NewReader(tt.body))
+			c.Request = c.Request.WithContext(ctx)
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "tier_list_id", Value: tierListID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
+
+func assertJSONBody(t *testing.T, expected interface{}, actual []byte) {
+	t.Helper()
+
+	var actualBody interface{}
+	err := json.Unmarshal(actual, &actualBody)
+	assert.NoError(t, err, "response body should be valid JSON")
+
+	expectedJSON, err := json.Marshal(expected)
+	assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+	var expectedBody interface{}
+	err = json.Unmarshal(expectedJSON, &expectedBody)
+	assert.NoError(t, err, "expected body should be valid JSON")
+
+	assert.Equal(t, expectedBody, actualBody, "response body should match expected")
+}
@@ -0,0 +1,20 @@
+package request
+
+// PostCommentRequest はコメント投稿のリクエストボディ
+// ParentCommentID を指定した場合は返信、DeckID を指定した場合はデッキへの言及になる
+type PostCommentRequest struct {
+	Body            string `json:"body" binding:"required"`
+	ParentCommentID string `json:"parent_comment_id"`
+	DeckID          string `json:"deck_id"`
+}
+
+// EditCommentRequest はコメント編集のリクエストボディ
+type EditCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// ListCommentsRequest はコメント・返信一覧取得のクエリパラメータ
+type ListCommentsRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
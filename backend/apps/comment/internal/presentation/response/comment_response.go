@@ -0,0 +1,54 @@
+package response
+
+import (
+	"poketier/apps/comment/internal/application/usecase"
+	"time"
+)
+
+// CommentResponse は投稿・編集したコメント
+type CommentResponse struct {
+	CommentID       string     `json:"comment_id"`
+	TierListID      string     `json:"tier_list_id"`
+	ParentCommentID *string    `json:"parent_comment_id"`
+	DeckID          *string    `json:"deck_id"`
+	Body            string     `json:"body"`
+	CreatedAt       time.Time  `json:"created_at"`
+	EditedAt        *time.Time `json:"edited_at"`
+}
+
+func NewPostCommentResponse(result *usecase.PostCommentResult) CommentResponse {
+	return CommentResponse{
+		CommentID:       result.CommentID,
+		TierListID:      result.TierListID,
+		ParentCommentID: result.ParentCommentID,
+		DeckID:          result.DeckID,
+		Body:            result.Body,
+		CreatedAt:       result.CreatedAt,
+	}
+}
+
+func NewEditCommentResponse(result *usecase.EditCommentResult) CommentResponse {
+	editedAt := result.EditedAt
+	return CommentResponse{
+		CommentID:       result.CommentID,
+		TierListID:      result.TierListID,
+		ParentCommentID: result.ParentCommentID,
+		DeckID:          result.DeckID,
+		Body:            result.Body,
+		CreatedAt:       result.CreatedAt,
+		EditedAt:        &editedAt,
+	}
+}
+
+// CommentAuthor はコメントの投稿者
+type CommentAuthor struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+}
+
+// CommentDeck はコメントで言及したデッキ
+type CommentDeck struct {
+	DeckID   string `json:"deck_id"`
+	Nickname string `json:"nickname"`
+	ImageURL string `json:"image_url"`
+}
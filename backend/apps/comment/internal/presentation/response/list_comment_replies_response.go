@@ -0,0 +1,54 @@
+package response
+
+import (
+	"poketier/apps/comment/internal/application/usecase"
+	"time"
+)
+
+type ListCommentRepliesResponse struct {
+	Replies    []LCRReply `json:"replies"`
+	NextCursor *string    `json:"next_cursor"`
+}
+
+type LCRReply struct {
+	CommentID       string         `json:"comment_id"`
+	ParentCommentID string         `json:"parent_comment_id"`
+	Author          *CommentAuthor `json:"author"`
+	Deck            *CommentDeck   `json:"deck"`
+	Body            string         `json:"body"`
+	LikeCount       int            `json:"like_count"`
+	Deleted         bool           `json:"deleted"`
+	CreatedAt       time.Time      `json:"created_at"`
+	EditedAt        *time.Time     `json:"edited_at"`
+}
+
+func NewListCommentRepliesResponse(result *usecase.ListCommentRepliesResult) ListCommentRepliesResponse {
+	replies := make([]LCRReply, len(result.Replies))
+	for i, r := range result.Replies {
+		replies[i] = LCRReply{
+			CommentID:       r.CommentID,
+			ParentCommentID: r.ParentCommentID,
+			Body:            r.Body,
+			LikeCount:       r.LikeCount,
+			Deleted:         r.Deleted,
+			CreatedAt:       r.CreatedAt,
+			EditedAt:        r.EditedAt,
+		}
+		if r.Author != nil {
+			replies[i].Author = &CommentAuthor{UserID: r.Author.UserID, DisplayName: r.Author.DisplayName}
+		}
+		if r.Deck != nil {
+			replies[i].Deck = &CommentDeck{DeckID: r.Deck.DeckID, Nickname: r.Deck.Nickname, ImageURL: r.Deck.ImageURL}
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListCommentRepliesResponse{
+		Replies:    replies,
+		NextCursor: nextCursor,
+	}
+}
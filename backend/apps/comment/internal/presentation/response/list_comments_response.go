@@ -0,0 +1,54 @@
+package response
+
+import (
+	"poketier/apps/comment/internal/application/usecase"
+	"time"
+)
+
+type ListCommentsResponse struct {
+	Comments   []LCComment `json:"comments"`
+	NextCursor *string     `json:"next_cursor"`
+}
+
+type LCComment struct {
+	CommentID  string         `json:"comment_id"`
+	Author     *CommentAuthor `json:"author"`
+	Deck       *CommentDeck   `json:"deck"`
+	Body       string         `json:"body"`
+	ReplyCount int            `json:"reply_count"`
+	LikeCount  int            `json:"like_count"`
+	Deleted    bool           `json:"deleted"`
+	CreatedAt  time.Time      `json:"created_at"`
+	EditedAt   *time.Time     `json:"edited_at"`
+}
+
+func NewListCommentsResponse(result *usecase.ListCommentsResult) ListCommentsResponse {
+	comments := make([]LCComment, len(result.Comments))
+	for i, c := range result.Comments {
+		comments[i] = LCComment{
+			CommentID:  c.CommentID,
+			Body:       c.Body,
+			ReplyCount: c.ReplyCount,
+			LikeCount:  c.LikeCount,
+			Deleted:    c.Deleted,
+			CreatedAt:  c.CreatedAt,
+			EditedAt:   c.EditedAt,
+		}
+		if c.Author != nil {
+			comments[i].Author = &CommentAuthor{UserID: c.Author.UserID, DisplayName: c.Author.DisplayName}
+		}
+		if c.Deck != nil {
+			comments[i].Deck = &CommentDeck{DeckID: c.Deck.DeckID, Nickname: c.Deck.Nickname, ImageURL: c.Deck.ImageURL}
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListCommentsResponse{
+		Comments:   comments,
+		NextCursor: nextCursor,
+	}
+}
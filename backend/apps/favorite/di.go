@@ -0,0 +1,126 @@
+//go:build wireinject
+// +build wireinject
+
+package favorite
+
+import (
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/infrastructure/repository"
+	"poketier/apps/favorite/internal/presentation/handler"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeAddTierListFavoriteHandler はAddTierListFavoriteHandlerとその依存関係を初期化します
+func InitializeAddTierListFavoriteHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.AddTierListFavoriteHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListFavoriteQuerier), new(db.Querier)),
+		repository.NewTierListFavoriteRepository,
+		wire.Bind(new(usecase.ATFFavoriteRepository), new(*repository.TierListFavoriteRepository)),
+		wire.Bind(new(usecase.ATFTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewAddTierListFavoriteUsecase,
+		wire.Bind(new(handler.AddTierListFavoriteUseCase), new(*usecase.AddTierListFavoriteUsecase)),
+
+		// Handler provider
+		handler.NewAddTierListFavoriteHandler,
+	)
+	return &handler.AddTierListFavoriteHandler{}
+}
+
+// InitializeRemoveTierListFavoriteHandler はRemoveTierListFavoriteHandlerとその依存関係を初期化します
+func InitializeRemoveTierListFavoriteHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.RemoveTierListFavoriteHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListFavoriteQuerier), new(db.Querier)),
+		repository.NewTierListFavoriteRepository,
+		wire.Bind(new(usecase.RTFFavoriteRepository), new(*repository.TierListFavoriteRepository)),
+		wire.Bind(new(usecase.RTFTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewRemoveTierListFavoriteUsecase,
+		wire.Bind(new(handler.RemoveTierListFavoriteUseCase), new(*usecase.RemoveTierListFavoriteUsecase)),
+
+		// Handler provider
+		handler.NewRemoveTierListFavoriteHandler,
+	)
+	return &handler.RemoveTierListFavoriteHandler{}
+}
+
+// InitializeListFavoriteTierListsHandler はListFavoriteTierListsHandlerとその依存関係を初期化します
+func InitializeListFavoriteTierListsHandler(queries db.Querier) *handler.ListFavoriteTierListsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListFavoriteQuerier), new(db.Querier)),
+		repository.NewTierListFavoriteRepository,
+		wire.Bind(new(usecase.LFTFavoriteRepository), new(*repository.TierListFavoriteRepository)),
+
+		// Usecase provider
+		usecase.NewListFavoriteTierListsUsecase,
+		wire.Bind(new(handler.ListFavoriteTierListsUseCase), new(*usecase.ListFavoriteTierListsUsecase)),
+
+		// Handler provider
+		handler.NewListFavoriteTierListsHandler,
+	)
+	return &handler.ListFavoriteTierListsHandler{}
+}
+
+// InitializeAddDeckFavoriteHandler はAddDeckFavoriteHandlerとその依存関係を初期化します
+func InitializeAddDeckFavoriteHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.AddDeckFavoriteHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.DeckFavoriteQuerier), new(db.Querier)),
+		repository.NewDeckFavoriteRepository,
+		wire.Bind(new(usecase.ADFFavoriteRepository), new(*repository.DeckFavoriteRepository)),
+		wire.Bind(new(usecase.ADFTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewAddDeckFavoriteUsecase,
+		wire.Bind(new(handler.AddDeckFavoriteUseCase), new(*usecase.AddDeckFavoriteUsecase)),
+
+		// Handler provider
+		handler.NewAddDeckFavoriteHandler,
+	)
+	return &handler.AddDeckFavoriteHandler{}
+}
+
+// InitializeRemoveDeckFavoriteHandler はRemoveDeckFavoriteHandlerとその依存関係を初期化します
+func InitializeRemoveDeckFavoriteHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.RemoveDeckFavoriteHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.DeckFavoriteQuerier), new(db.Querier)),
+		repository.NewDeckFavoriteRepository,
+		wire.Bind(new(usecase.RDFFavoriteRepository), new(*repository.DeckFavoriteRepository)),
+		wire.Bind(new(usecase.RDFTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewRemoveDeckFavoriteUsecase,
+		wire.Bind(new(handler.RemoveDeckFavoriteUseCase), new(*usecase.RemoveDeckFavoriteUsecase)),
+
+		// Handler provider
+		handler.NewRemoveDeckFavoriteHandler,
+	)
+	return &handler.RemoveDeckFavoriteHandler{}
+}
+
+// InitializeListFavoriteDecksHandler はListFavoriteDecksHandlerとその依存関係を初期化します
+func InitializeListFavoriteDecksHandler(queries db.Querier) *handler.ListFavoriteDecksHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.DeckFavoriteQuerier), new(db.Querier)),
+		repository.NewDeckFavoriteRepository,
+		wire.Bind(new(usecase.LFDFavoriteRepository), new(*repository.DeckFavoriteRepository)),
+
+		// Usecase provider
+		usecase.NewListFavoriteDecksUsecase,
+		wire.Bind(new(handler.ListFavoriteDecksUseCase), new(*usecase.ListFavoriteDecksUsecase)),
+
+		// Handler provider
+		handler.NewListFavoriteDecksHandler,
+	)
+	return &handler.ListFavoriteDecksHandler{}
+}
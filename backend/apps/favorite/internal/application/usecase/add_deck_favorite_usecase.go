@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// AddDeckFavoriteParams はデッキのお気に入り登録の入力
+type AddDeckFavoriteParams struct {
+	UserID id.UserID
+	DeckID string
+}
+
+type ADFFavoriteRepository interface {
+	Add(ctx context.Context, userID id.UserID, deckID id.DeckID) error
+}
+
+type ADFTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type AddDeckFavoriteUsecase struct {
+	favoriteRepo ADFFavoriteRepository
+	txManager    ADFTxManager
+}
+
+func NewAddDeckFavoriteUsecase(favoriteRepo ADFFavoriteRepository, txManager ADFTxManager) *AddDeckFavoriteUsecase {
+	return &AddDeckFavoriteUsecase{
+		favoriteRepo: favoriteRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute はデッキをお気に入りに登録する。登録済みの場合は何もしない
+// お気に入りとお気に入り数は同一トランザクションで更新する
+func (u *AddDeckFavoriteUsecase) Execute(ctx context.Context, params AddDeckFavoriteParams) error {
+	deckID, err := id.DeckIDFromString(params.DeckID)
+	if err != nil {
+		return errs.NewValidationError("invalid deck_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.favoriteRepo.Add(ctx, params.UserID, deckID)
+	})
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/add_deck_favorite_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/add_deck_favorite_usecase.go -destination=./apps/favorite/internal/application/usecase/add_deck_favorite_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockADFFavoriteRepository is a mock of ADFFavoriteRepository interface.
+type MockADFFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockADFFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockADFFavoriteRepositoryMockRecorder is the mock recorder for MockADFFavoriteRepository.
+type MockADFFavoriteRepositoryMockRecorder struct {
+	mock *MockADFFavoriteRepository
+}
+
+// NewMockADFFavoriteRepository creates a new mock instance.
+func NewMockADFFavoriteRepository(ctrl *gomock.Controller) *MockADFFavoriteRepository {
+	mock := &MockADFFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockADFFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockADFFavoriteRepository) EXPECT() *MockADFFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockADFFavoriteRepository) Add(ctx context.Context, userID id.UserID, deckID id.DeckID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, deckID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockADFFavoriteRepositoryMockRecorder) Add(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockADFFavoriteRepository)(nil).Add), ctx, userID, deckID)
+}
+
+// MockADFTxManager is a mock of ADFTxManager interface.
+type MockADFTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockADFTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockADFTxManagerMockRecorder is the mock recorder for MockADFTxManager.
+type MockADFTxManagerMockRecorder struct {
+	mock *MockADFTxManager
+}
+
+// NewMockADFTxManager creates a new mock instance.
+func NewMockADFTxManager(ctrl *gomock.Controller) *MockADFTxManager {
+	mock := &MockADFTxManager{ctrl: ctrl}
+	mock.recorder = &MockADFTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockADFTxManager) EXPECT() *MockADFTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockADFTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockADFTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockADFTxManager)(nil).RunInTx), ctx, fn)
+}
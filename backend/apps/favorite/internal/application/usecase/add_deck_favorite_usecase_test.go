@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddDeckFavoriteUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	deckID := id.NewDeckID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.AddDeckFavoriteParams
+		setupMock   func(*MockADFFavoriteRepository, *MockADFTxManager)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: トランザクション内でデッキがお気に入りに登録される",
+			params:   usecase.AddDeckFavoriteParams{UserID: userID, DeckID: deckID.String()},
+			setupMock: func(mockRepo *MockADFFavoriteRepository, mockTx *MockADFTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, deckID).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なデッキIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.AddDeckFavoriteParams{UserID: userID, DeckID: "invalid"},
+			setupMock:   func(mockRepo *MockADFFavoriteRepository, mockTx *MockADFTxManager) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.AddDeckFavoriteParams{UserID: userID, DeckID: deckID.String()},
+			setupMock: func(mockRepo *MockADFFavoriteRepository, mockTx *MockADFTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, deckID).Return(errs.NewNotFoundError("deck not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.AddDeckFavoriteParams{UserID: userID, DeckID: deckID.String()},
+			setupMock: func(mockRepo *MockADFFavoriteRepository, mockTx *MockADFTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, deckID).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockADFFavoriteRepository(ctrl)
+			mockTx := NewMockADFTxManager(ctrl)
+			tt.setupMock(mockRepo, mockTx)
+
+			usecase := usecase.NewAddDeckFavoriteUsecase(mockRepo, mockTx)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// AddTierListFavoriteParams はティアリストのお気に入り登録の入力
+type AddTierListFavoriteParams struct {
+	UserID     id.UserID
+	TierListID string
+}
+
+type ATFFavoriteRepository interface {
+	Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error
+}
+
+type ATFTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type AddTierListFavoriteUsecase struct {
+	favoriteRepo ATFFavoriteRepository
+	txManager    ATFTxManager
+}
+
+func NewAddTierListFavoriteUsecase(favoriteRepo ATFFavoriteRepository, txManager ATFTxManager) *AddTierListFavoriteUsecase {
+	return &AddTierListFavoriteUsecase{
+		favoriteRepo: favoriteRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute はティアリストをお気に入りに登録する。登録済みの場合は何もしない
+// お気に入りとお気に入り数は同一トランザクションで更新する
+func (u *AddTierListFavoriteUsecase) Execute(ctx context.Context, params AddTierListFavoriteParams) error {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.favoriteRepo.Add(ctx, params.UserID, tierListID)
+	})
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/add_tier_list_favorite_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/add_tier_list_favorite_usecase.go -destination=./apps/favorite/internal/application/usecase/add_tier_list_favorite_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockATFFavoriteRepository is a mock of ATFFavoriteRepository interface.
+type MockATFFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockATFFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockATFFavoriteRepositoryMockRecorder is the mock recorder for MockATFFavoriteRepository.
+type MockATFFavoriteRepositoryMockRecorder struct {
+	mock *MockATFFavoriteRepository
+}
+
+// NewMockATFFavoriteRepository creates a new mock instance.
+func NewMockATFFavoriteRepository(ctrl *gomock.Controller) *MockATFFavoriteRepository {
+	mock := &MockATFFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockATFFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockATFFavoriteRepository) EXPECT() *MockATFFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockATFFavoriteRepository) Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockATFFavoriteRepositoryMockRecorder) Add(ctx, userID, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockATFFavoriteRepository)(nil).Add), ctx, userID, tierListID)
+}
+
+// MockATFTxManager is a mock of ATFTxManager interface.
+type MockATFTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockATFTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockATFTxManagerMockRecorder is the mock recorder for MockATFTxManager.
+type MockATFTxManagerMockRecorder struct {
+	mock *MockATFTxManager
+}
+
+// NewMockATFTxManager creates a new mock instance.
+func NewMockATFTxManager(ctrl *gomock.Controller) *MockATFTxManager {
+	mock := &MockATFTxManager{ctrl: ctrl}
+	mock.recorder = &MockATFTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockATFTxManager) EXPECT() *MockATFTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockATFTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockATFTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockATFTxManager)(nil).RunInTx), ctx, fn)
+}
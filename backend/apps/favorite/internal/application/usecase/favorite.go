@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// toFavoriteQuery は「自分のお気に入り」一覧の入力値を検証し、ドメインの検索条件に変換
+func toFavoriteQuery(userID id.UserID, seasonID, cursor string, limit int) (entity.FavoriteQuery, error) {
+	query := entity.FavoriteQuery{
+		UserID: userID,
+		Limit:  pagination.NormalizeLimit(limit),
+	}
+
+	if seasonID != "" {
+		parsed, err := id.SeasonIDFromString(seasonID)
+		if err != nil {
+			return entity.FavoriteQuery{}, errs.NewValidationError("invalid season_id", err)
+		}
+		query.SeasonID = &parsed
+	}
+
+	after, err := decodeFavoriteCursor(cursor)
+	if err != nil {
+		return entity.FavoriteQuery{}, err
+	}
+	query.After = after
+
+	return query, nil
+}
+
+// decodeFavoriteCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeFavoriteCursor(s string) (*entity.FavoriteCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.FavoriteCursor{
+		FavoritedAt: time.UnixMicro(cursor.SortKey).UTC(),
+		TargetID:    targetID,
+	}, nil
+}
+
+// encodeFavoriteCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeFavoriteCursor(next *entity.FavoriteCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.FavoritedAt.UnixMicro(),
+		ID:      next.TargetID.String(),
+	})
+}
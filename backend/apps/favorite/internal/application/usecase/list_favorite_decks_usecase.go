@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+// ListFavoriteDecksParams は「自分のお気に入り」のデッキ一覧取得の入力
+// SeasonID を指定した場合はそのシーズンのデッキのみに絞り込む
+type ListFavoriteDecksParams struct {
+	UserID   id.UserID
+	SeasonID string
+	Cursor   string
+	Limit    int
+}
+
+// ListFavoriteDecksResult はお気に入りの登録日時の新しい順のデッキ一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListFavoriteDecksResult struct {
+	Decks      []LFDDeck
+	NextCursor string
+}
+
+type LFDDeck struct {
+	DeckID        string
+	SeasonID      string
+	Nickname      string
+	ImageURL      string
+	FavoriteCount int
+	FavoritedAt   time.Time
+}
+
+type LFDFavoriteRepository interface {
+	FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteDeckPage, error)
+}
+
+type ListFavoriteDecksUsecase struct {
+	favoriteRepo LFDFavoriteRepository
+}
+
+func NewListFavoriteDecksUsecase(favoriteRepo LFDFavoriteRepository) *ListFavoriteDecksUsecase {
+	return &ListFavoriteDecksUsecase{
+		favoriteRepo: favoriteRepo,
+	}
+}
+
+// Execute はログイン中のユーザーがお気に入りに登録したデッキを取得
+func (u *ListFavoriteDecksUsecase) Execute(ctx context.Context, params ListFavoriteDecksParams) (*ListFavoriteDecksResult, error) {
+	query, err := toFavoriteQuery(params.UserID, params.SeasonID, params.Cursor, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.favoriteRepo.FindPage(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find favorite deck page: %w", err)
+	}
+
+	decks := make([]LFDDeck, 0, len(page.Decks))
+	for _, deck := range page.Decks {
+		decks = append(decks, LFDDeck{
+			DeckID:        deck.DeckID.String(),
+			SeasonID:      deck.SeasonID.String(),
+			Nickname:      deck.Nickname,
+			ImageURL:      deck.ImageURL,
+			FavoriteCount: deck.FavoriteCount,
+			FavoritedAt:   deck.FavoritedAt,
+		})
+	}
+
+	return &ListFavoriteDecksResult{
+		Decks:      decks,
+		NextCursor: encodeFavoriteCursor(page.Next),
+	}, nil
+}
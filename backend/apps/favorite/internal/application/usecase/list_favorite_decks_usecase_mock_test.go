@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/list_favorite_decks_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/list_favorite_decks_usecase.go -destination=./apps/favorite/internal/application/usecase/list_favorite_decks_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/favorite/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLFDFavoriteRepository is a mock of LFDFavoriteRepository interface.
+type MockLFDFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLFDFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLFDFavoriteRepositoryMockRecorder is the mock recorder for MockLFDFavoriteRepository.
+type MockLFDFavoriteRepositoryMockRecorder struct {
+	mock *MockLFDFavoriteRepository
+}
+
+// NewMockLFDFavoriteRepository creates a new mock instance.
+func NewMockLFDFavoriteRepository(ctrl *gomock.Controller) *MockLFDFavoriteRepository {
+	mock := &MockLFDFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockLFDFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLFDFavoriteRepository) EXPECT() *MockLFDFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPage mocks base method.
+func (m *MockLFDFavoriteRepository) FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteDeckPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.FavoriteDeckPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLFDFavoriteRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLFDFavoriteRepository)(nil).FindPage), ctx, query)
+}
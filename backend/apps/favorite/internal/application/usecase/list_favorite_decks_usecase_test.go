@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFavoriteDecksUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	seasonID := id.NewSeasonID()
+	deckID := id.NewDeckID()
+	favoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.FavoriteCursor{FavoritedAt: favoritedAt, TargetID: deckID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: favoritedAt.UnixMicro(), ID: deckID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListFavoriteDecksParams
+		setupMock   func(*MockLFDFavoriteRepository)
+		wantResult  *usecase.ListFavoriteDecksResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: シーズンで絞り込んだお気に入りのデッキと次ページのカーソルを返す",
+			params:   usecase.ListFavoriteDecksParams{UserID: userID, SeasonID: seasonID.String(), Limit: 1},
+			setupMock: func(mockRepo *MockLFDFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FavoriteQuery{
+					UserID:   userID,
+					SeasonID: &seasonID,
+					Limit:    1,
+				}).Return(&entity.FavoriteDeckPage{
+					Decks: []entity.FavoriteDeck{
+						{
+							DeckID:        deckID,
+							SeasonID:      seasonID,
+							Nickname:      "リザニンフ",
+							ImageURL:      "https://example.com/decks/a.png",
+							FavoriteCount: 3,
+							FavoritedAt:   favoritedAt,
+						},
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListFavoriteDecksResult{
+				Decks: []usecase.LFDDeck{
+					{
+						DeckID:        deckID.String(),
+						SeasonID:      seasonID.String(),
+						Nickname:      "リザニンフ",
+						ImageURL:      "https://example.com/decks/a.png",
+						FavoriteCount: 3,
+						FavoritedAt:   favoritedAt,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、検索条件に変換され、最終ページはカーソルが空になる",
+			params:   usecase.ListFavoriteDecksParams{UserID: userID, Cursor: encodedCursor, Limit: 500},
+			setupMock: func(mockRepo *MockLFDFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FavoriteQuery{
+					UserID: userID,
+					After:  cursor,
+					Limit:  pagination.MaxLimit,
+				}).Return(&entity.FavoriteDeckPage{Decks: []entity.FavoriteDeck{}}, nil)
+			},
+			wantResult: &usecase.ListFavoriteDecksResult{
+				Decks: []usecase.LFDDeck{},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListFavoriteDecksParams{UserID: userID, SeasonID: "invalid"},
+			setupMock:   func(mockRepo *MockLFDFavoriteRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName:    "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListFavoriteDecksParams{UserID: userID, Cursor: pagination.EncodeCursor(pagination.Cursor{ID: "invalid"})},
+			setupMock:   func(mockRepo *MockLFDFavoriteRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListFavoriteDecksParams{UserID: userID},
+			setupMock: func(mockRepo *MockLFDFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLFDFavoriteRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListFavoriteDecksUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+// ListFavoriteTierListsParams は「自分のお気に入り」のティアリスト一覧取得の入力
+// SeasonID を指定した場合はそのシーズンのティアリストのみに絞り込む
+type ListFavoriteTierListsParams struct {
+	UserID   id.UserID
+	SeasonID string
+	Cursor   string
+	Limit    int
+}
+
+// ListFavoriteTierListsResult はお気に入りの登録日時の新しい順のティアリスト一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListFavoriteTierListsResult struct {
+	TierLists  []LFTTierList
+	NextCursor string
+}
+
+type LFTTierList struct {
+	TierListID    string
+	SeasonID      string
+	Title         string
+	Description   string
+	AuthorName    string
+	ViewCount     int
+	ForkCount     int
+	FavoriteCount int
+	CreatedAt     time.Time
+	FavoritedAt   time.Time
+}
+
+type LFTFavoriteRepository interface {
+	FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteTierListPage, error)
+}
+
+type ListFavoriteTierListsUsecase struct {
+	favoriteRepo LFTFavoriteRepository
+}
+
+func NewListFavoriteTierListsUsecase(favoriteRepo LFTFavoriteRepository) *ListFavoriteTierListsUsecase {
+	return &ListFavoriteTierListsUsecase{
+		favoriteRepo: favoriteRepo,
+	}
+}
+
+// Execute はログイン中のユーザーがお気に入りに登録したティアリストを取得
+func (u *ListFavoriteTierListsUsecase) Execute(ctx context.Context, params ListFavoriteTierListsParams) (*ListFavoriteTierListsResult, error) {
+	query, err := toFavoriteQuery(params.UserID, params.SeasonID, params.Cursor, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.favoriteRepo.FindPage(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find favorite tier list page: %w", err)
+	}
+
+	tierLists := make([]LFTTierList, 0, len(page.TierLists))
+	for _, tierList := range page.TierLists {
+		tierLists = append(tierLists, LFTTierList{
+			TierListID:    tierList.TierListID.String(),
+			SeasonID:      tierList.SeasonID.String(),
+			Title:         tierList.Title,
+			Description:   tierList.Description,
+			AuthorName:    tierList.AuthorName,
+			ViewCount:     tierList.ViewCount,
+			ForkCount:     tierList.ForkCount,
+			FavoriteCount: tierList.FavoriteCount,
+			CreatedAt:     tierList.CreatedAt,
+			FavoritedAt:   tierList.FavoritedAt,
+		})
+	}
+
+	return &ListFavoriteTierListsResult{
+		TierLists:  tierLists,
+		NextCursor: encodeFavoriteCursor(page.Next),
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/list_favorite_tier_lists_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/list_favorite_tier_lists_usecase.go -destination=./apps/favorite/internal/application/usecase/list_favorite_tier_lists_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/favorite/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLFTFavoriteRepository is a mock of LFTFavoriteRepository interface.
+type MockLFTFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLFTFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLFTFavoriteRepositoryMockRecorder is the mock recorder for MockLFTFavoriteRepository.
+type MockLFTFavoriteRepositoryMockRecorder struct {
+	mock *MockLFTFavoriteRepository
+}
+
+// NewMockLFTFavoriteRepository creates a new mock instance.
+func NewMockLFTFavoriteRepository(ctrl *gomock.Controller) *MockLFTFavoriteRepository {
+	mock := &MockLFTFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockLFTFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLFTFavoriteRepository) EXPECT() *MockLFTFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPage mocks base method.
+func (m *MockLFTFavoriteRepository) FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteTierListPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.FavoriteTierListPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLFTFavoriteRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLFTFavoriteRepository)(nil).FindPage), ctx, query)
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFavoriteTierListsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	seasonID := id.NewSeasonID()
+	tierListID := id.NewTierListID()
+	createdAt := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	favoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.FavoriteCursor{FavoritedAt: favoritedAt, TargetID: tierListID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: favoritedAt.UnixMicro(), ID: tierListID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListFavoriteTierListsParams
+		setupMock   func(*MockLFTFavoriteRepository)
+		wantResult  *usecase.ListFavoriteTierListsResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: シーズンで絞り込んだお気に入りのティアリストと次ページのカーソルを返す",
+			params:   usecase.ListFavoriteTierListsParams{UserID: userID, SeasonID: seasonID.String(), Limit: 1},
+			setupMock: func(mockRepo *MockLFTFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FavoriteQuery{
+					UserID:   userID,
+					SeasonID: &seasonID,
+					Limit:    1,
+				}).Return(&entity.FavoriteTierListPage{
+					TierLists: []entity.FavoriteTierList{
+						{
+							TierListID:    tierListID,
+							SeasonID:      seasonID,
+							Title:         "A4環境ティアリスト",
+							AuthorName:    "配信者A",
+							ViewCount:     100,
+							ForkCount:     2,
+							FavoriteCount: 3,
+							CreatedAt:     createdAt,
+							FavoritedAt:   favoritedAt,
+						},
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListFavoriteTierListsResult{
+				TierLists: []usecase.LFTTierList{
+					{
+						TierListID:    tierListID.String(),
+						SeasonID:      seasonID.String(),
+						Title:         "A4環境ティアリスト",
+						AuthorName:    "配信者A",
+						ViewCount:     100,
+						ForkCount:     2,
+						FavoriteCount: 3,
+						CreatedAt:     createdAt,
+						FavoritedAt:   favoritedAt,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、検索条件に変換され、最終ページはカーソルが空になる",
+			params:   usecase.ListFavoriteTierListsParams{UserID: userID, Cursor: encodedCursor, Limit: 500},
+			setupMock: func(mockRepo *MockLFTFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FavoriteQuery{
+					UserID: userID,
+					After:  cursor,
+					Limit:  pagination.MaxLimit,
+				}).Return(&entity.FavoriteTierListPage{TierLists: []entity.FavoriteTierList{}}, nil)
+			},
+			wantResult: &usecase.ListFavoriteTierListsResult{
+				TierLists: []usecase.LFTTierList{},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListFavoriteTierListsParams{UserID: userID, SeasonID: "invalid"},
+			setupMock:   func(mockRepo *MockLFTFavoriteRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName:    "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListFavoriteTierListsParams{UserID: userID, Cursor: pagination.EncodeCursor(pagination.Cursor{ID: "invalid"})},
+			setupMock:   func(mockRepo *MockLFTFavoriteRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListFavoriteTierListsParams{UserID: userID},
+			setupMock: func(mockRepo *MockLFTFavoriteRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLFTFavoriteRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListFavoriteTierListsUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// RemoveDeckFavoriteParams はデッキのお気に入り解除の入力
+type RemoveDeckFavoriteParams struct {
+	UserID id.UserID
+	DeckID string
+}
+
+type RDFFavoriteRepository interface {
+	Remove(ctx context.Context, userID id.UserID, deckID id.DeckID) error
+}
+
+type RDFTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RemoveDeckFavoriteUsecase struct {
+	favoriteRepo RDFFavoriteRepository
+	txManager    RDFTxManager
+}
+
+func NewRemoveDeckFavoriteUsecase(favoriteRepo RDFFavoriteRepository, txManager RDFTxManager) *RemoveDeckFavoriteUsecase {
+	return &RemoveDeckFavoriteUsecase{
+		favoriteRepo: favoriteRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute はデッキをお気に入りから解除する。登録されていない場合は何もしない
+// お気に入りとお気に入り数は同一トランザクションで更新する
+func (u *RemoveDeckFavoriteUsecase) Execute(ctx context.Context, params RemoveDeckFavoriteParams) error {
+	deckID, err := id.DeckIDFromString(params.DeckID)
+	if err != nil {
+		return errs.NewValidationError("invalid deck_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.favoriteRepo.Remove(ctx, params.UserID, deckID)
+	})
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/remove_deck_favorite_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/remove_deck_favorite_usecase.go -destination=./apps/favorite/internal/application/usecase/remove_deck_favorite_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRDFFavoriteRepository is a mock of RDFFavoriteRepository interface.
+type MockRDFFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRDFFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRDFFavoriteRepositoryMockRecorder is the mock recorder for MockRDFFavoriteRepository.
+type MockRDFFavoriteRepositoryMockRecorder struct {
+	mock *MockRDFFavoriteRepository
+}
+
+// NewMockRDFFavoriteRepository creates a new mock instance.
+func NewMockRDFFavoriteRepository(ctrl *gomock.Controller) *MockRDFFavoriteRepository {
+	mock := &MockRDFFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockRDFFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRDFFavoriteRepository) EXPECT() *MockRDFFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockRDFFavoriteRepository) Remove(ctx context.Context, userID id.UserID, deckID id.DeckID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, deckID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockRDFFavoriteRepositoryMockRecorder) Remove(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockRDFFavoriteRepository)(nil).Remove), ctx, userID, deckID)
+}
+
+// MockRDFTxManager is a mock of RDFTxManager interface.
+type MockRDFTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRDFTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRDFTxManagerMockRecorder is the mock recorder for MockRDFTxManager.
+type MockRDFTxManagerMockRecorder struct {
+	mock *MockRDFTxManager
+}
+
+// NewMockRDFTxManager creates a new mock instance.
+func NewMockRDFTxManager(ctrl *gomock.Controller) *MockRDFTxManager {
+	mock := &MockRDFTxManager{ctrl: ctrl}
+	mock.recorder = &MockRDFTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRDFTxManager) EXPECT() *MockRDFTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRDFTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRDFTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRDFTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// RemoveTierListFavoriteParams はティアリストのお気に入り解除の入力
+type RemoveTierListFavoriteParams struct {
+	UserID     id.UserID
+	TierListID string
+}
+
+type RTFFavoriteRepository interface {
+	Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error
+}
+
+type RTFTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RemoveTierListFavoriteUsecase struct {
+	favoriteRepo RTFFavoriteRepository
+	txManager    RTFTxManager
+}
+
+func NewRemoveTierListFavoriteUsecase(favoriteRepo RTFFavoriteRepository, txManager RTFTxManager) *RemoveTierListFavoriteUsecase {
+	return &RemoveTierListFavoriteUsecase{
+		favoriteRepo: favoriteRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute はティアリストをお気に入りから解除する。登録されていない場合は何もしない
+// お気に入りとお気に入り数は同一トランザクションで更新する
+func (u *RemoveTierListFavoriteUsecase) Execute(ctx context.Context, params RemoveTierListFavoriteParams) error {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.favoriteRepo.Remove(ctx, params.UserID, tierListID)
+	})
+}
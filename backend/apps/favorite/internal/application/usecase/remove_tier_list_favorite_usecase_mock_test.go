@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/application/usecase/remove_tier_list_favorite_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/application/usecase/remove_tier_list_favorite_usecase.go -destination=./apps/favorite/internal/application/usecase/remove_tier_list_favorite_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRTFFavoriteRepository is a mock of RTFFavoriteRepository interface.
+type MockRTFFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTFFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTFFavoriteRepositoryMockRecorder is the mock recorder for MockRTFFavoriteRepository.
+type MockRTFFavoriteRepositoryMockRecorder struct {
+	mock *MockRTFFavoriteRepository
+}
+
+// NewMockRTFFavoriteRepository creates a new mock instance.
+func NewMockRTFFavoriteRepository(ctrl *gomock.Controller) *MockRTFFavoriteRepository {
+	mock := &MockRTFFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockRTFFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTFFavoriteRepository) EXPECT() *MockRTFFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockRTFFavoriteRepository) Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockRTFFavoriteRepositoryMockRecorder) Remove(ctx, userID, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockRTFFavoriteRepository)(nil).Remove), ctx, userID, tierListID)
+}
+
+// MockRTFTxManager is a mock of RTFTxManager interface.
+type MockRTFTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTFTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRTFTxManagerMockRecorder is the mock recorder for MockRTFTxManager.
+type MockRTFTxManagerMockRecorder struct {
+	mock *MockRTFTxManager
+}
+
+// NewMockRTFTxManager creates a new mock instance.
+func NewMockRTFTxManager(ctrl *gomock.Controller) *MockRTFTxManager {
+	mock := &MockRTFTxManager{ctrl: ctrl}
+	mock.recorder = &MockRTFTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTFTxManager) EXPECT() *MockRTFTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRTFTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRTFTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRTFTxManager)(nil).RunInTx), ctx, fn)
+}
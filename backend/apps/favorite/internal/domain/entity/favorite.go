@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+// FavoriteCountShards はお気に入り数のカウンターを分散させるシャード数
+// 人気のティアリスト・デッキへの登録・解除が同じ行の更新待ちにならないよう、シャードごとに差分を加算して読み取り時に合計する
+const FavoriteCountShards = 16
+
+// PickFavoriteCountShard はお気に入り数の差分を加算するシャードをランダムに選ぶ
+func PickFavoriteCountShard() int16 {
+	return int16(rand.IntN(FavoriteCountShards)) // #nosec G115 G404 -- シャード数はint16の範囲内で、暗号学的な乱数は不要
+}
+
+// FavoriteQuery は「自分のお気に入り」一覧の検索条件
+// SeasonID を指定した場合はそのシーズンのティアリスト・デッキのみに絞り込む
+type FavoriteQuery struct {
+	UserID   id.UserID
+	SeasonID *id.SeasonID
+	After    *FavoriteCursor
+	Limit    int
+}
+
+// FavoriteCursor はお気に入りの登録日時の新しい順のキーセットページネーションの位置
+// TargetID は同じ日時に登録したお気に入りの並びを一意にするティアリスト・デッキのID
+type FavoriteCursor struct {
+	FavoritedAt time.Time
+	TargetID    uuid.UUID
+}
+
+// FavoriteTierList はお気に入りに登録したティアリスト
+type FavoriteTierList struct {
+	TierListID    id.TierListID
+	SeasonID      id.SeasonID
+	Title         string
+	Description   string
+	AuthorName    string
+	ViewCount     int
+	ForkCount     int
+	FavoriteCount int
+	CreatedAt     time.Time
+	FavoritedAt   time.Time
+}
+
+// FavoriteTierListPage はお気に入りのティアリスト一覧の1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type FavoriteTierListPage struct {
+	TierLists []FavoriteTierList
+	Next      *FavoriteCursor
+}
+
+// FavoriteDeck はお気に入りに登録したデッキ
+type FavoriteDeck struct {
+	DeckID        id.DeckID
+	SeasonID      id.SeasonID
+	Nickname      string
+	ImageURL      string
+	FavoriteCount int
+	FavoritedAt   time.Time
+}
+
+// FavoriteDeckPage はお気に入りのデッキ一覧の1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type FavoriteDeckPage struct {
+	Decks []FavoriteDeck
+	Next  *FavoriteCursor
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// DeckFavoriteQuerier はデータベースクエリを定義するインターフェース
+type DeckFavoriteQuerier interface {
+	AddDeckFavorite(ctx context.Context, arg db.AddDeckFavoriteParams) (int64, error)
+	RemoveDeckFavorite(ctx context.Context, arg db.RemoveDeckFavoriteParams) (int64, error)
+	ListFavoriteDecksByUser(ctx context.Context, arg db.ListFavoriteDecksByUserParams) ([]db.ListFavoriteDecksByUserRow, error)
+	AddDeckFavoriteCount(ctx context.Context, arg db.AddDeckFavoriteCountParams) error
+	ListDeckFavoriteCounts(ctx context.Context, deckIds []pgtype.UUID) ([]db.ListDeckFavoriteCountsRow, error)
+}
+
+// DeckFavoriteRepository はデッキのお気に入りの永続化を行う
+type DeckFavoriteRepository struct {
+	queries DeckFavoriteQuerier
+}
+
+// NewDeckFavoriteRepository は新しいDeckFavoriteRepositoryを作成
+func NewDeckFavoriteRepository(queries DeckFavoriteQuerier) *DeckFavoriteRepository {
+	return &DeckFavoriteRepository{
+		queries: queries,
+	}
+}
+
+// Add はデッキをお気に入りに登録し、新たに登録した場合のみお気に入り数を1増やす
+// デッキが存在しない場合はNotFoundエラーを返す
+func (r *DeckFavoriteRepository) Add(ctx context.Context, userID id.UserID, deckID id.DeckID) error {
+	pgDeckID := pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+
+	rows, err := r.queries.AddDeckFavorite(ctx, db.AddDeckFavoriteParams{
+		UserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		DeckID: pgDeckID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.NewNotFoundError("deck not found", err)
+		}
+		return fmt.Errorf("failed to add deck favorite: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgDeckID, 1)
+}
+
+// Remove はデッキをお気に入りから解除し、登録されていた場合のみお気に入り数を1減らす
+func (r *DeckFavoriteRepository) Remove(ctx context.Context, userID id.UserID, deckID id.DeckID) error {
+	pgDeckID := pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+
+	rows, err := r.queries.RemoveDeckFavorite(ctx, db.RemoveDeckFavoriteParams{
+		UserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		DeckID: pgDeckID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove deck favorite: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgDeckID, -1)
+}
+
+// addCount はランダムに選んだシャードのお気に入り数に差分を加算
+func (r *DeckFavoriteRepository) addCount(ctx context.Context, deckID pgtype.UUID, delta int32) error {
+	if err := r.queries.AddDeckFavoriteCount(ctx, db.AddDeckFavoriteCountParams{
+		DeckID:        deckID,
+		Shard:         entity.PickFavoriteCountShard(),
+		FavoriteCount: delta,
+	}); err != nil {
+		return fmt.Errorf("failed to add deck favorite count: %w", err)
+	}
+	return nil
+}
+
+// FindPage はユーザーのお気に入りのデッキを1ページ分、お気に入り数を含めて取得
+func (r *DeckFavoriteRepository) FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteDeckPage, error) {
+	params := db.ListFavoriteDecksByUserParams{
+		UserID:    pgtype.UUID{Bytes: query.UserID.UUID(), Valid: true},
+		SeasonID:  toSeasonUUID(query.SeasonID),
+		PageLimit: int32(query.Limit + 1), // #nosec G115 -- Limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if query.After != nil {
+		params.CursorFavoritedAt = pgtype.Timestamptz{Time: query.After.FavoritedAt, Valid: true}
+		params.CursorDeckID = pgtype.UUID{Bytes: query.After.TargetID, Valid: true}
+	}
+
+	rows, err := r.queries.ListFavoriteDecksByUser(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorite decks: %w", err)
+	}
+
+	page := &entity.FavoriteDeckPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[query.Limit-1]
+		page.Next = &entity.FavoriteCursor{
+			FavoritedAt: last.FavoritedAt.Time,
+			TargetID:    last.DeckID.Bytes,
+		}
+	}
+
+	deckIDs := make([]pgtype.UUID, 0, len(rows))
+	for _, row := range rows {
+		deckIDs = append(deckIDs, row.DeckID)
+	}
+	counts, err := r.countByIDs(ctx, deckIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Decks = make([]entity.FavoriteDeck, 0, len(rows))
+	for _, row := range rows {
+		page.Decks = append(page.Decks, entity.FavoriteDeck{
+			DeckID:        id.DeckIDFromUUID(row.DeckID.Bytes),
+			SeasonID:      id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			Nickname:      row.Nickname,
+			ImageURL:      row.ImageUrl,
+			FavoriteCount: counts[row.DeckID.Bytes],
+			FavoritedAt:   row.FavoritedAt.Time,
+		})
+	}
+
+	return page, nil
+}
+
+// countByIDs はシャードを合計したお気に入り数をデッキごとに取得
+func (r *DeckFavoriteRepository) countByIDs(ctx context.Context, deckIDs []pgtype.UUID) (map[uuid.UUID]int, error) {
+	if len(deckIDs) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	rows, err := r.queries.ListDeckFavoriteCounts(ctx, deckIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deck favorite counts: %w", err)
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.DeckID.Bytes] = int(row.FavoriteCount)
+	}
+	return counts, nil
+}
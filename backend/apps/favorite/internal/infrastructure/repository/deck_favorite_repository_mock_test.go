@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/infrastructure/repository/deck_favorite_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/infrastructure/repository/deck_favorite_repository.go -destination=./apps/favorite/internal/infrastructure/repository/deck_favorite_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckFavoriteQuerier is a mock of DeckFavoriteQuerier interface.
+type MockDeckFavoriteQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckFavoriteQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckFavoriteQuerierMockRecorder is the mock recorder for MockDeckFavoriteQuerier.
+type MockDeckFavoriteQuerierMockRecorder struct {
+	mock *MockDeckFavoriteQuerier
+}
+
+// NewMockDeckFavoriteQuerier creates a new mock instance.
+func NewMockDeckFavoriteQuerier(ctrl *gomock.Controller) *MockDeckFavoriteQuerier {
+	mock := &MockDeckFavoriteQuerier{ctrl: ctrl}
+	mock.recorder = &MockDeckFavoriteQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckFavoriteQuerier) EXPECT() *MockDeckFavoriteQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddDeckFavorite mocks base method.
+func (m *MockDeckFavoriteQuerier) AddDeckFavorite(ctx context.Context, arg db.AddDeckFavoriteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeckFavorite", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeckFavorite indicates an expected call of AddDeckFavorite.
+func (mr *MockDeckFavoriteQuerierMockRecorder) AddDeckFavorite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeckFavorite", reflect.TypeOf((*MockDeckFavoriteQuerier)(nil).AddDeckFavorite), ctx, arg)
+}
+
+// AddDeckFavoriteCount mocks base method.
+func (m *MockDeckFavoriteQuerier) AddDeckFavoriteCount(ctx context.Context, arg db.AddDeckFavoriteCountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeckFavoriteCount", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeckFavoriteCount indicates an expected call of AddDeckFavoriteCount.
+func (mr *MockDeckFavoriteQuerierMockRecorder) AddDeckFavoriteCount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeckFavoriteCount", reflect.TypeOf((*MockDeckFavoriteQuerier)(nil).AddDeckFavoriteCount), ctx, arg)
+}
+
+// ListDeckFavoriteCounts mocks base method.
+func (m *MockDeckFavoriteQuerier) ListDeckFavoriteCounts(ctx context.Context, deckIds []pgtype.UUID) ([]db.ListDeckFavoriteCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeckFavoriteCounts", ctx, deckIds)
+	ret0, _ := ret[0].([]db.ListDeckFavoriteCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeckFavoriteCounts indicates an expected call of ListDeckFavoriteCounts.
+func (mr *MockDeckFavoriteQuerierMockRecorder) ListDeckFavoriteCounts(ctx, deckIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeckFavoriteCounts", reflect.TypeOf((*MockDeckFavoriteQuerier)(nil).ListDeckFavoriteCounts), ctx, deckIds)
+}
+
+// ListFavoriteDecksByUser mocks base method.
+func (m *MockDeckFavoriteQuerier) ListFavoriteDecksByUser(ctx context.Context, arg db.ListFavoriteDecksByUserParams) ([]db.ListFavoriteDecksByUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoriteDecksByUser", ctx, arg)
+	ret0, _ := ret[0].([]db.ListFavoriteDecksByUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoriteDecksByUser indicates an expected call of ListFavoriteDecksByUser.
+func (mr *MockDeckFavoriteQuerierMockRecorder) ListFavoriteDecksByUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoriteDecksByUser", reflect.TypeOf((*MockDeckFavoriteQuerier)(nil).ListFavoriteDecksByUser), ctx, arg)
+}
+
+// RemoveDeckFavorite mocks base method.
+func (m *MockDeckFavoriteQuerier) RemoveDeckFavorite(ctx context.Context, arg db.RemoveDeckFavoriteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeckFavorite", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDeckFavorite indicates an expected call of RemoveDeckFavorite.
+func (mr *MockDeckFavoriteQuerierMockRecorder) RemoveDeckFavorite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeckFavorite", reflect.TypeOf((*MockDeckFavoriteQuerier)(nil).RemoveDeckFavorite), ctx, arg)
+}
@@ -0,0 +1,261 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/apps/favorite/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestDeckFavoriteRepository_Add(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	params := db.AddDeckFavoriteParams{
+		UserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		DeckID: pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockDeckFavoriteQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 新たに登録した場合、お気に入り数に1加算される事",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().AddDeckFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddDeckFavoriteCount(gomock.Any(), countDelta(1)).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 登録済みの場合、お気に入り数は変わらない事",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().AddDeckFavorite(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().AddDeckFavorite(gomock.Any(), params).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: お気に入り数の加算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().AddDeckFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddDeckFavoriteCount(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckFavoriteRepository(mockQuerier)
+
+			// Act
+			err := repo.Add(context.Background(), userID, deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestDeckFavoriteRepository_Remove(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	params := db.RemoveDeckFavoriteParams{
+		UserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		DeckID: pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckFavoriteQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 登録されていた場合、お気に入り数から1減算される事",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveDeckFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddDeckFavoriteCount(gomock.Any(), countDelta(-1)).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 登録されていない場合、お気に入り数は変わらない事",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveDeckFavorite(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveDeckFavorite(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckFavoriteRepository(mockQuerier)
+
+			// Act
+			err := repo.Remove(context.Background(), userID, deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestDeckFavoriteRepository_FindPage(t *testing.T) {
+	t.Parallel()
+
+	firstID, secondID := id.NewDeckID(), id.NewDeckID()
+	firstFavoritedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	secondFavoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	row := func(deckID id.DeckID, favoritedAt time.Time) db.ListFavoriteDecksByUserRow {
+		return db.ListFavoriteDecksByUserRow{
+			DeckID:      pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+			SeasonID:    pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+			Nickname:    "リザニンフ",
+			ImageUrl:    "https://example.com/decks/a.png",
+			FavoritedAt: pgtype.Timestamptz{Time: favoritedAt, Valid: true},
+		}
+	}
+
+	tests := []struct {
+		caseName    string
+		query       entity.FavoriteQuery
+		setupMock   func(mockQuerier *MockDeckFavoriteQuerier)
+		want        *entity.FavoriteDeckPage
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 次ページがある場合、1件多く取得した結果から次ページのカーソルとお気に入り数を含むページが作成される事",
+			query:    entity.FavoriteQuery{UserID: userID, SeasonID: &seasonID, Limit: 1},
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteDecksByUser(gomock.Any(), db.ListFavoriteDecksByUserParams{
+					UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 2,
+				}).Return([]db.ListFavoriteDecksByUserRow{row(firstID, firstFavoritedAt), row(secondID, secondFavoritedAt)}, nil)
+				mockQuerier.EXPECT().ListDeckFavoriteCounts(gomock.Any(), []pgtype.UUID{{Bytes: firstID.UUID(), Valid: true}}).
+					Return([]db.ListDeckFavoriteCountsRow{{DeckID: pgtype.UUID{Bytes: firstID.UUID(), Valid: true}, FavoriteCount: 3}}, nil)
+			},
+			want: &entity.FavoriteDeckPage{
+				Decks: []entity.FavoriteDeck{
+					{
+						DeckID:        firstID,
+						SeasonID:      seasonID,
+						Nickname:      "リザニンフ",
+						ImageURL:      "https://example.com/decks/a.png",
+						FavoriteCount: 3,
+						FavoritedAt:   firstFavoritedAt,
+					},
+				},
+				Next: &entity.FavoriteCursor{FavoritedAt: firstFavoritedAt, TargetID: firstID.UUID()},
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、クエリのパラメータに変換され、空のページではお気に入り数を取得しない事",
+			query: entity.FavoriteQuery{
+				UserID: userID,
+				After:  &entity.FavoriteCursor{FavoritedAt: firstFavoritedAt, TargetID: firstID.UUID()},
+				Limit:  20,
+			},
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteDecksByUser(gomock.Any(), db.ListFavoriteDecksByUserParams{
+					UserID:            pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					CursorFavoritedAt: pgtype.Timestamptz{Time: firstFavoritedAt, Valid: true},
+					CursorDeckID:      pgtype.UUID{Bytes: firstID.UUID(), Valid: true},
+					PageLimit:         21,
+				}).Return([]db.ListFavoriteDecksByUserRow{}, nil)
+			},
+			want: &entity.FavoriteDeckPage{Decks: []entity.FavoriteDeck{}},
+		},
+		{
+			caseName: "異常系: お気に入り数の取得でDBエラーが発生した場合",
+			query:    entity.FavoriteQuery{UserID: userID, Limit: 20},
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteDecksByUser(gomock.Any(), gomock.Any()).
+					Return([]db.ListFavoriteDecksByUserRow{row(firstID, firstFavoritedAt)}, nil)
+				mockQuerier.EXPECT().ListDeckFavoriteCounts(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			query:    entity.FavoriteQuery{UserID: userID, Limit: 20},
+			setupMock: func(mockQuerier *MockDeckFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteDecksByUser(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckFavoriteRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindPage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "page does not match")
+		})
+	}
+}
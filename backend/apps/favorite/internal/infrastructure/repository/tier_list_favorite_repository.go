@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// foreignKeyViolation は外部キー制約違反のエラーコード（お気に入りの対象が存在しない場合）
+const foreignKeyViolation = "23503"
+
+// TierListFavoriteQuerier はデータベースクエリを定義するインターフェース
+type TierListFavoriteQuerier interface {
+	AddTierListFavorite(ctx context.Context, arg db.AddTierListFavoriteParams) (int64, error)
+	RemoveTierListFavorite(ctx context.Context, arg db.RemoveTierListFavoriteParams) (int64, error)
+	ListFavoriteTierListsByUser(ctx context.Context, arg db.ListFavoriteTierListsByUserParams) ([]db.ListFavoriteTierListsByUserRow, error)
+	AddTierListFavoriteCount(ctx context.Context, arg db.AddTierListFavoriteCountParams) error
+	ListTierListFavoriteCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListFavoriteCountsRow, error)
+}
+
+// TierListFavoriteRepository はティアリストのお気に入りの永続化を行う
+type TierListFavoriteRepository struct {
+	queries TierListFavoriteQuerier
+}
+
+// NewTierListFavoriteRepository は新しいTierListFavoriteRepositoryを作成
+func NewTierListFavoriteRepository(queries TierListFavoriteQuerier) *TierListFavoriteRepository {
+	return &TierListFavoriteRepository{
+		queries: queries,
+	}
+}
+
+// Add はティアリストをお気に入りに登録し、新たに登録した場合のみお気に入り数を1増やす
+// ティアリストが存在しない場合はNotFoundエラーを返す
+func (r *TierListFavoriteRepository) Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	rows, err := r.queries.AddTierListFavorite(ctx, db.AddTierListFavoriteParams{
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		TierListID: pgTierListID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.NewNotFoundError("tier list not found", err)
+		}
+		return fmt.Errorf("failed to add tier list favorite: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgTierListID, 1)
+}
+
+// Remove はティアリストをお気に入りから解除し、登録されていた場合のみお気に入り数を1減らす
+func (r *TierListFavoriteRepository) Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	rows, err := r.queries.RemoveTierListFavorite(ctx, db.RemoveTierListFavoriteParams{
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		TierListID: pgTierListID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove tier list favorite: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgTierListID, -1)
+}
+
+// addCount はランダムに選んだシャードのお気に入り数に差分を加算
+func (r *TierListFavoriteRepository) addCount(ctx context.Context, tierListID pgtype.UUID, delta int32) error {
+	if err := r.queries.AddTierListFavoriteCount(ctx, db.AddTierListFavoriteCountParams{
+		TierListID:    tierListID,
+		Shard:         entity.PickFavoriteCountShard(),
+		FavoriteCount: delta,
+	}); err != nil {
+		return fmt.Errorf("failed to add tier list favorite count: %w", err)
+	}
+	return nil
+}
+
+// FindPage はユーザーのお気に入りのティアリストを1ページ分、お気に入り数を含めて取得
+func (r *TierListFavoriteRepository) FindPage(ctx context.Context, query entity.FavoriteQuery) (*entity.FavoriteTierListPage, error) {
+	params := db.ListFavoriteTierListsByUserParams{
+		UserID:    pgtype.UUID{Bytes: query.UserID.UUID(), Valid: true},
+		SeasonID:  toSeasonUUID(query.SeasonID),
+		PageLimit: int32(query.Limit + 1), // #nosec G115 -- Limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if query.After != nil {
+		params.CursorFavoritedAt = pgtype.Timestamptz{Time: query.After.FavoritedAt, Valid: true}
+		params.CursorTierListID = pgtype.UUID{Bytes: query.After.TargetID, Valid: true}
+	}
+
+	rows, err := r.queries.ListFavoriteTierListsByUser(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorite tier lists: %w", err)
+	}
+
+	page := &entity.FavoriteTierListPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[query.Limit-1]
+		page.Next = &entity.FavoriteCursor{
+			FavoritedAt: last.FavoritedAt.Time,
+			TargetID:    last.TierListID.Bytes,
+		}
+	}
+
+	tierListIDs := make([]pgtype.UUID, 0, len(rows))
+	for _, row := range rows {
+		tierListIDs = append(tierListIDs, row.TierListID)
+	}
+	counts, err := r.countByIDs(ctx, tierListIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	page.TierLists = make([]entity.FavoriteTierList, 0, len(rows))
+	for _, row := range rows {
+		page.TierLists = append(page.TierLists, entity.FavoriteTierList{
+			TierListID:    id.TierListIDFromUUID(row.TierListID.Bytes),
+			SeasonID:      id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			Title:         row.Title,
+			Description:   row.Description,
+			AuthorName:    row.AuthorName,
+			ViewCount:     int(row.ViewCount),
+			ForkCount:     int(row.ForkCount),
+			FavoriteCount: counts[row.TierListID.Bytes],
+			CreatedAt:     row.CreatedAt.Time,
+			FavoritedAt:   row.FavoritedAt.Time,
+		})
+	}
+
+	return page, nil
+}
+
+// countByIDs はシャードを合計したお気に入り数をティアリストごとに取得
+func (r *TierListFavoriteRepository) countByIDs(ctx context.Context, tierListIDs []pgtype.UUID) (map[uuid.UUID]int, error) {
+	if len(tierListIDs) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	rows, err := r.queries.ListTierListFavoriteCounts(ctx, tierListIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier list favorite counts: %w", err)
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.TierListID.Bytes] = int(row.FavoriteCount)
+	}
+	return counts, nil
+}
+
+// toSeasonUUID は任意指定のシーズンIDをNULL許容のUUIDに変換
+func toSeasonUUID(seasonID *id.SeasonID) pgtype.UUID {
+	if seasonID == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/infrastructure/repository/tier_list_favorite_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/infrastructure/repository/tier_list_favorite_repository.go -destination=./apps/favorite/internal/infrastructure/repository/tier_list_favorite_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListFavoriteQuerier is a mock of TierListFavoriteQuerier interface.
+type MockTierListFavoriteQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListFavoriteQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListFavoriteQuerierMockRecorder is the mock recorder for MockTierListFavoriteQuerier.
+type MockTierListFavoriteQuerierMockRecorder struct {
+	mock *MockTierListFavoriteQuerier
+}
+
+// NewMockTierListFavoriteQuerier creates a new mock instance.
+func NewMockTierListFavoriteQuerier(ctrl *gomock.Controller) *MockTierListFavoriteQuerier {
+	mock := &MockTierListFavoriteQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListFavoriteQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListFavoriteQuerier) EXPECT() *MockTierListFavoriteQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddTierListFavorite mocks base method.
+func (m *MockTierListFavoriteQuerier) AddTierListFavorite(ctx context.Context, arg db.AddTierListFavoriteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListFavorite", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTierListFavorite indicates an expected call of AddTierListFavorite.
+func (mr *MockTierListFavoriteQuerierMockRecorder) AddTierListFavorite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListFavorite", reflect.TypeOf((*MockTierListFavoriteQuerier)(nil).AddTierListFavorite), ctx, arg)
+}
+
+// AddTierListFavoriteCount mocks base method.
+func (m *MockTierListFavoriteQuerier) AddTierListFavoriteCount(ctx context.Context, arg db.AddTierListFavoriteCountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListFavoriteCount", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTierListFavoriteCount indicates an expected call of AddTierListFavoriteCount.
+func (mr *MockTierListFavoriteQuerierMockRecorder) AddTierListFavoriteCount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListFavoriteCount", reflect.TypeOf((*MockTierListFavoriteQuerier)(nil).AddTierListFavoriteCount), ctx, arg)
+}
+
+// ListFavoriteTierListsByUser mocks base method.
+func (m *MockTierListFavoriteQuerier) ListFavoriteTierListsByUser(ctx context.Context, arg db.ListFavoriteTierListsByUserParams) ([]db.ListFavoriteTierListsByUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoriteTierListsByUser", ctx, arg)
+	ret0, _ := ret[0].([]db.ListFavoriteTierListsByUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoriteTierListsByUser indicates an expected call of ListFavoriteTierListsByUser.
+func (mr *MockTierListFavoriteQuerierMockRecorder) ListFavoriteTierListsByUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoriteTierListsByUser", reflect.TypeOf((*MockTierListFavoriteQuerier)(nil).ListFavoriteTierListsByUser), ctx, arg)
+}
+
+// ListTierListFavoriteCounts mocks base method.
+func (m *MockTierListFavoriteQuerier) ListTierListFavoriteCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListFavoriteCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListFavoriteCounts", ctx, tierListIds)
+	ret0, _ := ret[0].([]db.ListTierListFavoriteCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListFavoriteCounts indicates an expected call of ListTierListFavoriteCounts.
+func (mr *MockTierListFavoriteQuerierMockRecorder) ListTierListFavoriteCounts(ctx, tierListIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListFavoriteCounts", reflect.TypeOf((*MockTierListFavoriteQuerier)(nil).ListTierListFavoriteCounts), ctx, tierListIds)
+}
+
+// RemoveTierListFavorite mocks base method.
+func (m *MockTierListFavoriteQuerier) RemoveTierListFavorite(ctx context.Context, arg db.RemoveTierListFavoriteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTierListFavorite", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTierListFavorite indicates an expected call of RemoveTierListFavorite.
+func (mr *MockTierListFavoriteQuerierMockRecorder) RemoveTierListFavorite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTierListFavorite", reflect.TypeOf((*MockTierListFavoriteQuerier)(nil).RemoveTierListFavorite), ctx, arg)
+}
@@ -0,0 +1,296 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/favorite/internal/domain/entity"
+	"poketier/apps/favorite/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+var (
+	userID   = id.NewUserID()
+	seasonID = id.NewSeasonID()
+)
+
+// countDelta はランダムに選ばれたシャードに指定した差分を加算するパラメータに一致する
+func countDelta(delta int32) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		var shard int16
+		var count int32
+		switch p := x.(type) {
+		case db.AddTierListFavoriteCountParams:
+			shard, count = p.Shard, p.FavoriteCount
+		case db.AddDeckFavoriteCountParams:
+			shard, count = p.Shard, p.FavoriteCount
+		default:
+			return false
+		}
+		return shard >= 0 && shard < entity.FavoriteCountShards && count == delta
+	})
+}
+
+func TestTierListFavoriteRepository_Add(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	params := db.AddTierListFavoriteParams{
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		TierListID: pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListFavoriteQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 新たに登録した場合、お気に入り数に1加算される事",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().AddTierListFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListFavoriteCount(gomock.Any(), countDelta(1)).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 登録済みの場合、お気に入り数は変わらない事",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().AddTierListFavorite(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().AddTierListFavorite(gomock.Any(), params).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: お気に入り数の加算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().AddTierListFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListFavoriteCount(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListFavoriteRepository(mockQuerier)
+
+			// Act
+			err := repo.Add(context.Background(), userID, tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListFavoriteRepository_Remove(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	params := db.RemoveTierListFavoriteParams{
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		TierListID: pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListFavoriteQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 登録されていた場合、お気に入り数から1減算される事",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveTierListFavorite(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListFavoriteCount(gomock.Any(), countDelta(-1)).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 登録されていない場合、お気に入り数は変わらない事",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveTierListFavorite(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().RemoveTierListFavorite(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListFavoriteRepository(mockQuerier)
+
+			// Act
+			err := repo.Remove(context.Background(), userID, tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListFavoriteRepository_FindPage(t *testing.T) {
+	t.Parallel()
+
+	firstID, secondID := id.NewTierListID(), id.NewTierListID()
+	createdAt := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	firstFavoritedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	secondFavoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	row := func(tierListID id.TierListID, favoritedAt time.Time) db.ListFavoriteTierListsByUserRow {
+		return db.ListFavoriteTierListsByUserRow{
+			TierListID:  pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+			SeasonID:    pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+			Title:       "A4環境ティアリスト",
+			AuthorName:  "配信者A",
+			ViewCount:   100,
+			ForkCount:   2,
+			CreatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+			FavoritedAt: pgtype.Timestamptz{Time: favoritedAt, Valid: true},
+		}
+	}
+
+	tests := []struct {
+		caseName    string
+		query       entity.FavoriteQuery
+		setupMock   func(mockQuerier *MockTierListFavoriteQuerier)
+		want        *entity.FavoriteTierListPage
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 次ページがある場合、1件多く取得した結果から次ページのカーソルとお気に入り数を含むページが作成される事",
+			query:    entity.FavoriteQuery{UserID: userID, SeasonID: &seasonID, Limit: 1},
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteTierListsByUser(gomock.Any(), db.ListFavoriteTierListsByUserParams{
+					UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 2,
+				}).Return([]db.ListFavoriteTierListsByUserRow{row(firstID, firstFavoritedAt), row(secondID, secondFavoritedAt)}, nil)
+				mockQuerier.EXPECT().ListTierListFavoriteCounts(gomock.Any(), []pgtype.UUID{{Bytes: firstID.UUID(), Valid: true}}).
+					Return([]db.ListTierListFavoriteCountsRow{{TierListID: pgtype.UUID{Bytes: firstID.UUID(), Valid: true}, FavoriteCount: 3}}, nil)
+			},
+			want: &entity.FavoriteTierListPage{
+				TierLists: []entity.FavoriteTierList{
+					{
+						TierListID:    firstID,
+						SeasonID:      seasonID,
+						Title:         "A4環境ティアリスト",
+						AuthorName:    "配信者A",
+						ViewCount:     100,
+						ForkCount:     2,
+						FavoriteCount: 3,
+						CreatedAt:     createdAt,
+						FavoritedAt:   firstFavoritedAt,
+					},
+				},
+				Next: &entity.FavoriteCursor{FavoritedAt: firstFavoritedAt, TargetID: firstID.UUID()},
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、クエリのパラメータに変換され、空のページではお気に入り数を取得しない事",
+			query: entity.FavoriteQuery{
+				UserID: userID,
+				After:  &entity.FavoriteCursor{FavoritedAt: firstFavoritedAt, TargetID: firstID.UUID()},
+				Limit:  20,
+			},
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteTierListsByUser(gomock.Any(), db.ListFavoriteTierListsByUserParams{
+					UserID:            pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					CursorFavoritedAt: pgtype.Timestamptz{Time: firstFavoritedAt, Valid: true},
+					CursorTierListID:  pgtype.UUID{Bytes: firstID.UUID(), Valid: true},
+					PageLimit:         21,
+				}).Return([]db.ListFavoriteTierListsByUserRow{}, nil)
+			},
+			want: &entity.FavoriteTierListPage{TierLists: []entity.FavoriteTierList{}},
+		},
+		{
+			caseName: "異常系: お気に入り数の取得でDBエラーが発生した場合",
+			query:    entity.FavoriteQuery{UserID: userID, Limit: 20},
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteTierListsByUser(gomock.Any(), gomock.Any()).
+					Return([]db.ListFavoriteTierListsByUserRow{row(firstID, firstFavoritedAt)}, nil)
+				mockQuerier.EXPECT().ListTierListFavoriteCounts(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			query:    entity.FavoriteQuery{UserID: userID, Limit: 20},
+			setupMock: func(mockQuerier *MockTierListFavoriteQuerier) {
+				mockQuerier.EXPECT().ListFavoriteTierListsByUser(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListFavoriteQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListFavoriteRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindPage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "page does not match")
+		})
+	}
+}
+
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
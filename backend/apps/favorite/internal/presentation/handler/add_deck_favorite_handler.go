@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AddDeckFavoriteHandler struct {
+	uc AddDeckFavoriteUseCase
+}
+
+type AddDeckFavoriteUseCase interface {
+	Execute(ctx context.Context, params usecase.AddDeckFavoriteParams) error
+}
+
+func NewAddDeckFavoriteHandler(uc AddDeckFavoriteUseCase) *AddDeckFavoriteHandler {
+	return &AddDeckFavoriteHandler{
+		uc: uc,
+	}
+}
+
+func (h *AddDeckFavoriteHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.AddDeckFavoriteParams{
+		UserID: userID,
+		DeckID: ctx.Param("deck_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/add_deck_favorite_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/add_deck_favorite_handler.go -destination=./apps/favorite/internal/presentation/handler/add_deck_favorite_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAddDeckFavoriteUseCase is a mock of AddDeckFavoriteUseCase interface.
+type MockAddDeckFavoriteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddDeckFavoriteUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAddDeckFavoriteUseCaseMockRecorder is the mock recorder for MockAddDeckFavoriteUseCase.
+type MockAddDeckFavoriteUseCaseMockRecorder struct {
+	mock *MockAddDeckFavoriteUseCase
+}
+
+// NewMockAddDeckFavoriteUseCase creates a new mock instance.
+func NewMockAddDeckFavoriteUseCase(ctrl *gomock.Controller) *MockAddDeckFavoriteUseCase {
+	mock := &MockAddDeckFavoriteUseCase{ctrl: ctrl}
+	mock.recorder = &MockAddDeckFavoriteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddDeckFavoriteUseCase) EXPECT() *MockAddDeckFavoriteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAddDeckFavoriteUseCase) Execute(ctx context.Context, params usecase.AddDeckFavoriteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAddDeckFavoriteUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddDeckFavoriteUseCase)(nil).Execute), ctx, params)
+}
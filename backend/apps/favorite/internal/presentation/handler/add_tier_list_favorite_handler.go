@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AddTierListFavoriteHandler struct {
+	uc AddTierListFavoriteUseCase
+}
+
+type AddTierListFavoriteUseCase interface {
+	Execute(ctx context.Context, params usecase.AddTierListFavoriteParams) error
+}
+
+func NewAddTierListFavoriteHandler(uc AddTierListFavoriteUseCase) *AddTierListFavoriteHandler {
+	return &AddTierListFavoriteHandler{
+		uc: uc,
+	}
+}
+
+func (h *AddTierListFavoriteHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.AddTierListFavoriteParams{
+		UserID:     userID,
+		TierListID: ctx.Param("tier_list_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
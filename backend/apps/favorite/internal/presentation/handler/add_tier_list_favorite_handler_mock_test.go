@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/add_tier_list_favorite_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/add_tier_list_favorite_handler.go -destination=./apps/favorite/internal/presentation/handler/add_tier_list_favorite_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAddTierListFavoriteUseCase is a mock of AddTierListFavoriteUseCase interface.
+type MockAddTierListFavoriteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddTierListFavoriteUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAddTierListFavoriteUseCaseMockRecorder is the mock recorder for MockAddTierListFavoriteUseCase.
+type MockAddTierListFavoriteUseCaseMockRecorder struct {
+	mock *MockAddTierListFavoriteUseCase
+}
+
+// NewMockAddTierListFavoriteUseCase creates a new mock instance.
+func NewMockAddTierListFavoriteUseCase(ctrl *gomock.Controller) *MockAddTierListFavoriteUseCase {
+	mock := &MockAddTierListFavoriteUseCase{ctrl: ctrl}
+	mock.recorder = &MockAddTierListFavoriteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddTierListFavoriteUseCase) EXPECT() *MockAddTierListFavoriteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAddTierListFavoriteUseCase) Execute(ctx context.Context, params usecase.AddTierListFavoriteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAddTierListFavoriteUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddTierListFavoriteUseCase)(nil).Execute), ctx, params)
+}
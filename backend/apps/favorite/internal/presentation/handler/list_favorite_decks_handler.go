@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/presentation/request"
+	"poketier/apps/favorite/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListFavoriteDecksHandler struct {
+	uc ListFavoriteDecksUseCase
+}
+
+type ListFavoriteDecksUseCase interface {
+	Execute(ctx context.Context, params usecase.ListFavoriteDecksParams) (*usecase.ListFavoriteDecksResult, error)
+}
+
+func NewListFavoriteDecksHandler(uc ListFavoriteDecksUseCase) *ListFavoriteDecksHandler {
+	return &ListFavoriteDecksHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListFavoriteDecksHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.ListFavoritesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListFavoriteDecksParams{
+		UserID:   userID,
+		SeasonID: req.SeasonID,
+		Cursor:   req.Cursor,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListFavoriteDecksResponse(result))
+}
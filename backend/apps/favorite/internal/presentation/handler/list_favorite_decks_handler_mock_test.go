@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/list_favorite_decks_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/list_favorite_decks_handler.go -destination=./apps/favorite/internal/presentation/handler/list_favorite_decks_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListFavoriteDecksUseCase is a mock of ListFavoriteDecksUseCase interface.
+type MockListFavoriteDecksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListFavoriteDecksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListFavoriteDecksUseCaseMockRecorder is the mock recorder for MockListFavoriteDecksUseCase.
+type MockListFavoriteDecksUseCaseMockRecorder struct {
+	mock *MockListFavoriteDecksUseCase
+}
+
+// NewMockListFavoriteDecksUseCase creates a new mock instance.
+func NewMockListFavoriteDecksUseCase(ctrl *gomock.Controller) *MockListFavoriteDecksUseCase {
+	mock := &MockListFavoriteDecksUseCase{ctrl: ctrl}
+	mock.recorder = &MockListFavoriteDecksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListFavoriteDecksUseCase) EXPECT() *MockListFavoriteDecksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListFavoriteDecksUseCase) Execute(ctx context.Context, params usecase.ListFavoriteDecksParams) (*usecase.ListFavoriteDecksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListFavoriteDecksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListFavoriteDecksUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListFavoriteDecksUseCase)(nil).Execute), ctx, params)
+}
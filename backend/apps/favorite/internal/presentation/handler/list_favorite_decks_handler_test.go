@@ -0,0 +1,160 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFavoriteDecksHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	seasonID := id.NewSeasonID().String()
+	deckID := id.NewDeckID().String()
+	favoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		query          string
+		mockSetup      func(*MockListFavoriteDecksUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: お気に入りのデッキ一覧と次ページのカーソルが返される",
+			loggedIn: true,
+			query:    "?season_id=" + seasonID + "&cursor=abc&limit=1",
+			mockSetup: func(mockUC *MockListFavoriteDecksUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFavoriteDecksParams{
+					UserID:   userID,
+					SeasonID: seasonID,
+					Cursor:   "abc",
+					Limit:    1,
+				}).Return(&usecase.ListFavoriteDecksResult{
+					Decks: []usecase.LFDDeck{
+						{
+							DeckID:        deckID,
+							SeasonID:      seasonID,
+							Nickname:      "リザニンフ",
+							ImageURL:      "https://example.com/decks/a.png",
+							FavoriteCount: 3,
+							FavoritedAt:   favoritedAt,
+						},
+					},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"decks": []map[string]interface{}{
+					{
+						"deck_id":        deckID,
+						"season_id":      seasonID,
+						"nickname":       "リザニンフ",
+						"image_url":      "https://example.com/decks/a.png",
+						"favorite_count": 3,
+						"favorited_at":   "2025-08-01T12:00:00Z",
+					},
+				},
+				"next_cursor": "next",
+			},
+		},
+		{
+			caseName: "正常系: お気に入りがない場合、空の一覧が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFavoriteDecksUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFavoriteDecksParams{UserID: userID}).
+					Return(&usecase.ListFavoriteDecksResult{Decks: []usecase.LFDDeck{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"decks":       []interface{}{},
+				"next_cursor": nil,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockListFavoriteDecksUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: limitが範囲外の場合、400が返される",
+			loggedIn:       true,
+			query:          "?limit=101",
+			mockSetup:      func(mockUC *MockListFavoriteDecksUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFavoriteDecksUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListFavoriteDecksUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListFavoriteDecksHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, "/users/me/favorites/decks"+tt.query, nil)
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
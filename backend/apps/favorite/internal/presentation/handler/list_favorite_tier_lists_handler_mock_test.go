@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/list_favorite_tier_lists_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/list_favorite_tier_lists_handler.go -destination=./apps/favorite/internal/presentation/handler/list_favorite_tier_lists_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListFavoriteTierListsUseCase is a mock of ListFavoriteTierListsUseCase interface.
+type MockListFavoriteTierListsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListFavoriteTierListsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListFavoriteTierListsUseCaseMockRecorder is the mock recorder for MockListFavoriteTierListsUseCase.
+type MockListFavoriteTierListsUseCaseMockRecorder struct {
+	mock *MockListFavoriteTierListsUseCase
+}
+
+// NewMockListFavoriteTierListsUseCase creates a new mock instance.
+func NewMockListFavoriteTierListsUseCase(ctrl *gomock.Controller) *MockListFavoriteTierListsUseCase {
+	mock := &MockListFavoriteTierListsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListFavoriteTierListsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListFavoriteTierListsUseCase) EXPECT() *MockListFavoriteTierListsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListFavoriteTierListsUseCase) Execute(ctx context.Context, params usecase.ListFavoriteTierListsParams) (*usecase.ListFavoriteTierListsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListFavoriteTierListsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListFavoriteTierListsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListFavoriteTierListsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,169 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/apps/favorite/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFavoriteTierListsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	seasonID := id.NewSeasonID().String()
+	tierListID := id.NewTierListID().String()
+	createdAt := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	favoritedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		query          string
+		mockSetup      func(*MockListFavoriteTierListsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: お気に入りのティアリスト一覧と次ページのカーソルが返される",
+			loggedIn: true,
+			query:    "?season_id=" + seasonID + "&cursor=abc&limit=1",
+			mockSetup: func(mockUC *MockListFavoriteTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFavoriteTierListsParams{
+					UserID:   userID,
+					SeasonID: seasonID,
+					Cursor:   "abc",
+					Limit:    1,
+				}).Return(&usecase.ListFavoriteTierListsResult{
+					TierLists: []usecase.LFTTierList{
+						{
+							TierListID:    tierListID,
+							SeasonID:      seasonID,
+							Title:         "A4環境ティアリスト",
+							Description:   "説明",
+							AuthorName:    "配信者A",
+							ViewCount:     100,
+							ForkCount:     2,
+							FavoriteCount: 3,
+							CreatedAt:     createdAt,
+							FavoritedAt:   favoritedAt,
+						},
+					},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tier_lists": []map[string]interface{}{
+					{
+						"tier_list_id":   tierListID,
+						"season_id":      seasonID,
+						"title":          "A4環境ティアリスト",
+						"description":    "説明",
+						"author_name":    "配信者A",
+						"view_count":     100,
+						"fork_count":     2,
+						"favorite_count": 3,
+						"created_at":     "2025-07-01T12:00:00Z",
+						"favorited_at":   "2025-08-01T12:00:00Z",
+					},
+				},
+				"next_cursor": "next",
+			},
+		},
+		{
+			caseName: "正常系: お気に入りがない場合、空の一覧が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFavoriteTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFavoriteTierListsParams{UserID: userID}).
+					Return(&usecase.ListFavoriteTierListsResult{TierLists: []usecase.LFTTierList{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tier_lists":  []interface{}{},
+				"next_cursor": nil,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockListFavoriteTierListsUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: limitが範囲外の場合、400が返される",
+			loggedIn:       true,
+			query:          "?limit=101",
+			mockSetup:      func(mockUC *MockListFavoriteTierListsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFavoriteTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListFavoriteTierListsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListFavoriteTierListsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, "/users/me/favorites/tier-lists"+tt.query, nil)
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/remove_deck_favorite_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/remove_deck_favorite_handler.go -destination=./apps/favorite/internal/presentation/handler/remove_deck_favorite_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRemoveDeckFavoriteUseCase is a mock of RemoveDeckFavoriteUseCase interface.
+type MockRemoveDeckFavoriteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveDeckFavoriteUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRemoveDeckFavoriteUseCaseMockRecorder is the mock recorder for MockRemoveDeckFavoriteUseCase.
+type MockRemoveDeckFavoriteUseCaseMockRecorder struct {
+	mock *MockRemoveDeckFavoriteUseCase
+}
+
+// NewMockRemoveDeckFavoriteUseCase creates a new mock instance.
+func NewMockRemoveDeckFavoriteUseCase(ctrl *gomock.Controller) *MockRemoveDeckFavoriteUseCase {
+	mock := &MockRemoveDeckFavoriteUseCase{ctrl: ctrl}
+	mock.recorder = &MockRemoveDeckFavoriteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveDeckFavoriteUseCase) EXPECT() *MockRemoveDeckFavoriteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveDeckFavoriteUseCase) Execute(ctx context.Context, params usecase.RemoveDeckFavoriteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveDeckFavoriteUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveDeckFavoriteUseCase)(nil).Execute), ctx, params)
+}
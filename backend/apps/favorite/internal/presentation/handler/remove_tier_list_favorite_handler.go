@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/favorite/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RemoveTierListFavoriteHandler struct {
+	uc RemoveTierListFavoriteUseCase
+}
+
+type RemoveTierListFavoriteUseCase interface {
+	Execute(ctx context.Context, params usecase.RemoveTierListFavoriteParams) error
+}
+
+func NewRemoveTierListFavoriteHandler(uc RemoveTierListFavoriteUseCase) *RemoveTierListFavoriteHandler {
+	return &RemoveTierListFavoriteHandler{
+		uc: uc,
+	}
+}
+
+func (h *RemoveTierListFavoriteHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.RemoveTierListFavoriteParams{
+		UserID:     userID,
+		TierListID: ctx.Param("tier_list_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
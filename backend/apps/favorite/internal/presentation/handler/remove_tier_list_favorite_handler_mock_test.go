@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/favorite/internal/presentation/handler/remove_tier_list_favorite_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/favorite/internal/presentation/handler/remove_tier_list_favorite_handler.go -destination=./apps/favorite/internal/presentation/handler/remove_tier_list_favorite_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/favorite/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRemoveTierListFavoriteUseCase is a mock of RemoveTierListFavoriteUseCase interface.
+type MockRemoveTierListFavoriteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveTierListFavoriteUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRemoveTierListFavoriteUseCaseMockRecorder is the mock recorder for MockRemoveTierListFavoriteUseCase.
+type MockRemoveTierListFavoriteUseCaseMockRecorder struct {
+	mock *MockRemoveTierListFavoriteUseCase
+}
+
+// NewMockRemoveTierListFavoriteUseCase creates a new mock instance.
+func NewMockRemoveTierListFavoriteUseCase(ctrl *gomock.Controller) *MockRemoveTierListFavoriteUseCase {
+	mock := &MockRemoveTierListFavoriteUseCase{ctrl: ctrl}
+	mock.recorder = &MockRemoveTierListFavoriteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveTierListFavoriteUseCase) EXPECT() *MockRemoveTierListFavoriteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveTierListFavoriteUseCase) Execute(ctx context.Context, params usecase.RemoveTierListFavoriteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveTierListFavoriteUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveTierListFavoriteUseCase)(nil).Execute), ctx, params)
+}
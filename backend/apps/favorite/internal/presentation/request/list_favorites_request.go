@@ -0,0 +1,8 @@
+package request
+
+// ListFavoritesRequest は「自分のお気に入り」一覧取得のクエリパラメータ
+type ListFavoritesRequest struct {
+	SeasonID string `form:"season_id"`
+	Cursor   string `form:"cursor"`
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
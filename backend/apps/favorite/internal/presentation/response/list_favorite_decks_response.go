@@ -0,0 +1,44 @@
+package response
+
+import (
+	"poketier/apps/favorite/internal/application/usecase"
+	"time"
+)
+
+type ListFavoriteDecksResponse struct {
+	Decks      []LFDDeck `json:"decks"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+type LFDDeck struct {
+	DeckID        string    `json:"deck_id"`
+	SeasonID      string    `json:"season_id"`
+	Nickname      string    `json:"nickname"`
+	ImageURL      string    `json:"image_url"`
+	FavoriteCount int       `json:"favorite_count"`
+	FavoritedAt   time.Time `json:"favorited_at"`
+}
+
+func NewListFavoriteDecksResponse(result *usecase.ListFavoriteDecksResult) ListFavoriteDecksResponse {
+	decks := make([]LFDDeck, len(result.Decks))
+	for i, d := range result.Decks {
+		decks[i] = LFDDeck{
+			DeckID:        d.DeckID,
+			SeasonID:      d.SeasonID,
+			Nickname:      d.Nickname,
+			ImageURL:      d.ImageURL,
+			FavoriteCount: d.FavoriteCount,
+			FavoritedAt:   d.FavoritedAt,
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListFavoriteDecksResponse{
+		Decks:      decks,
+		NextCursor: nextCursor,
+	}
+}
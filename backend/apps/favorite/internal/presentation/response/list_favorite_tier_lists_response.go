@@ -0,0 +1,52 @@
+package response
+
+import (
+	"poketier/apps/favorite/internal/application/usecase"
+	"time"
+)
+
+type ListFavoriteTierListsResponse struct {
+	TierLists  []LFTTierList `json:"tier_lists"`
+	NextCursor *string       `json:"next_cursor"`
+}
+
+type LFTTierList struct {
+	TierListID    string    `json:"tier_list_id"`
+	SeasonID      string    `json:"season_id"`
+	Title         string    `json:"title"`
+	Description   string    `json:"description"`
+	AuthorName    string    `json:"author_name"`
+	ViewCount     int       `json:"view_count"`
+	ForkCount     int       `json:"fork_count"`
+	FavoriteCount int       `json:"favorite_count"`
+	CreatedAt     time.Time `json:"created_at"`
+	FavoritedAt   time.Time `json:"favorited_at"`
+}
+
+func NewListFavoriteTierListsResponse(result *usecase.ListFavoriteTierListsResult) ListFavoriteTierListsResponse {
+	tierLists := make([]LFTTierList, len(result.TierLists))
+	for i, tl := range result.TierLists {
+		tierLists[i] = LFTTierList{
+			TierListID:    tl.TierListID,
+			SeasonID:      tl.SeasonID,
+			Title:         tl.Title,
+			Description:   tl.Description,
+			AuthorName:    tl.AuthorName,
+			ViewCount:     tl.ViewCount,
+			ForkCount:     tl.ForkCount,
+			FavoriteCount: tl.FavoriteCount,
+			CreatedAt:     tl.CreatedAt,
+			FavoritedAt:   tl.FavoritedAt,
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListFavoriteTierListsResponse{
+		TierLists:  tierLists,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,67 @@
+//go:build wireinject
+// +build wireinject
+
+package follow
+
+import (
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/apps/follow/internal/infrastructure/repository"
+	"poketier/apps/follow/internal/presentation/handler"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeFollowUserHandler はFollowUserHandlerとその依存関係を初期化します
+func InitializeFollowUserHandler(queries db.Querier) *handler.FollowUserHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.FollowQuerier), new(db.Querier)),
+		repository.NewFollowRepository,
+		wire.Bind(new(usecase.FUFollowRepository), new(*repository.FollowRepository)),
+
+		// Usecase provider
+		usecase.NewFollowUserUsecase,
+		wire.Bind(new(handler.FollowUserUseCase), new(*usecase.FollowUserUsecase)),
+
+		// Handler provider
+		handler.NewFollowUserHandler,
+	)
+	return &handler.FollowUserHandler{}
+}
+
+// InitializeUnfollowUserHandler はUnfollowUserHandlerとその依存関係を初期化します
+func InitializeUnfollowUserHandler(queries db.Querier) *handler.UnfollowUserHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.FollowQuerier), new(db.Querier)),
+		repository.NewFollowRepository,
+		wire.Bind(new(usecase.UUFollowRepository), new(*repository.FollowRepository)),
+
+		// Usecase provider
+		usecase.NewUnfollowUserUsecase,
+		wire.Bind(new(handler.UnfollowUserUseCase), new(*usecase.UnfollowUserUsecase)),
+
+		// Handler provider
+		handler.NewUnfollowUserHandler,
+	)
+	return &handler.UnfollowUserHandler{}
+}
+
+// InitializeListFeedHandler はListFeedHandlerとその依存関係を初期化します
+func InitializeListFeedHandler(queries db.Querier) *handler.ListFeedHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.FeedQuerier), new(db.Querier)),
+		repository.NewFeedRepository,
+		wire.Bind(new(usecase.LFFeedRepository), new(*repository.FeedRepository)),
+
+		// Usecase provider
+		usecase.NewListFeedUsecase,
+		wire.Bind(new(handler.ListFeedUseCase), new(*usecase.ListFeedUsecase)),
+
+		// Handler provider
+		handler.NewListFeedHandler,
+	)
+	return &handler.ListFeedHandler{}
+}
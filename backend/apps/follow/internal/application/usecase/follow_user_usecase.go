@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// FollowUserParams はユーザーのフォローの入力
+type FollowUserParams struct {
+	UserID     id.UserID
+	FolloweeID string
+}
+
+type FUFollowRepository interface {
+	Add(ctx context.Context, followerID, followeeID id.UserID) error
+}
+
+type FollowUserUsecase struct {
+	followRepo FUFollowRepository
+}
+
+func NewFollowUserUsecase(followRepo FUFollowRepository) *FollowUserUsecase {
+	return &FollowUserUsecase{
+		followRepo: followRepo,
+	}
+}
+
+// Execute はユーザーをフォローする。フォロー済みの場合は何もしない
+// 自分自身はフォローできない
+func (u *FollowUserUsecase) Execute(ctx context.Context, params FollowUserParams) error {
+	followeeID, err := id.UserIDFromString(params.FolloweeID)
+	if err != nil {
+		return errs.NewValidationError("invalid user_id", err)
+	}
+	if followeeID == params.UserID {
+		return errs.NewValidationError("cannot follow yourself", nil)
+	}
+
+	return u.followRepo.Add(ctx, params.UserID, followeeID)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/application/usecase/follow_user_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/application/usecase/follow_user_usecase.go -destination=./apps/follow/internal/application/usecase/follow_user_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFUFollowRepository is a mock of FUFollowRepository interface.
+type MockFUFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFUFollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFUFollowRepositoryMockRecorder is the mock recorder for MockFUFollowRepository.
+type MockFUFollowRepositoryMockRecorder struct {
+	mock *MockFUFollowRepository
+}
+
+// NewMockFUFollowRepository creates a new mock instance.
+func NewMockFUFollowRepository(ctrl *gomock.Controller) *MockFUFollowRepository {
+	mock := &MockFUFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockFUFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFUFollowRepository) EXPECT() *MockFUFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockFUFollowRepository) Add(ctx context.Context, followerID, followeeID id.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockFUFollowRepositoryMockRecorder) Add(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockFUFollowRepository)(nil).Add), ctx, followerID, followeeID)
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/follow/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListFeedParams はフィード取得の入力
+type ListFeedParams struct {
+	UserID id.UserID
+	Cursor string
+	Limit  int
+}
+
+// ListFeedResult はフォロー中のユーザーが作成したティアリスト・デッキの作成日時の新しい順の一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListFeedResult struct {
+	Items      []LFItem
+	NextCursor string
+}
+
+// LFItem はフィードの1件。Type に応じて TierList か Deck のどちらかが設定される
+type LFItem struct {
+	Type      string
+	Author    LFAuthor
+	TierList  *LFTierList
+	Deck      *LFDeck
+	CreatedAt time.Time
+}
+
+type LFAuthor struct {
+	UserID      string
+	DisplayName string
+}
+
+type LFTierList struct {
+	TierListID  string
+	SeasonID    string
+	Title       string
+	Description string
+}
+
+type LFDeck struct {
+	DeckID   string
+	SeasonID string
+	Nickname string
+	ImageURL string
+}
+
+type LFFeedRepository interface {
+	FindPage(ctx context.Context, query entity.FeedQuery) (*entity.FeedPage, error)
+}
+
+type ListFeedUsecase struct {
+	feedRepo LFFeedRepository
+}
+
+func NewListFeedUsecase(feedRepo LFFeedRepository) *ListFeedUsecase {
+	return &ListFeedUsecase{
+		feedRepo: feedRepo,
+	}
+}
+
+// Execute はログイン中のユーザーがフォローしているユーザーの新着のティアリスト・デッキを取得
+func (u *ListFeedUsecase) Execute(ctx context.Context, params ListFeedParams) (*ListFeedResult, error) {
+	after, err := decodeFeedCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.feedRepo.FindPage(ctx, entity.FeedQuery{
+		UserID: params.UserID,
+		After:  after,
+		Limit:  pagination.NormalizeLimit(params.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find feed page: %w", err)
+	}
+
+	items := make([]LFItem, 0, len(page.Items))
+	for _, feedItem := range page.Items {
+		item := LFItem{
+			Type: string(feedItem.Type),
+			Author: LFAuthor{
+				UserID:      feedItem.AuthorUserID.String(),
+				DisplayName: feedItem.AuthorDisplayName,
+			},
+			CreatedAt: feedItem.CreatedAt,
+		}
+		switch feedItem.Type {
+		case entity.FeedItemTierList:
+			item.TierList = &LFTierList{
+				TierListID:  feedItem.ItemID.String(),
+				SeasonID:    feedItem.SeasonID.String(),
+				Title:       feedItem.Title,
+				Description: feedItem.Description,
+			}
+		case entity.FeedItemDeck:
+			item.Deck = &LFDeck{
+				DeckID:   feedItem.ItemID.String(),
+				SeasonID: feedItem.SeasonID.String(),
+				Nickname: feedItem.Title,
+				ImageURL: feedItem.ImageURL,
+			}
+		}
+		items = append(items, item)
+	}
+
+	return &ListFeedResult{
+		Items:      items,
+		NextCursor: encodeFeedCursor(page.Next),
+	}, nil
+}
+
+// decodeFeedCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeFeedCursor(s string) (*entity.FeedCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	itemID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.FeedCursor{
+		CreatedAt: time.UnixMicro(cursor.SortKey).UTC(),
+		ItemID:    itemID,
+	}, nil
+}
+
+// encodeFeedCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeFeedCursor(next *entity.FeedCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.CreatedAt.UnixMicro(),
+		ID:      next.ItemID.String(),
+	})
+}
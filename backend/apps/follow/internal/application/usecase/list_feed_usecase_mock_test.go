@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/application/usecase/list_feed_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/application/usecase/list_feed_usecase.go -destination=./apps/follow/internal/application/usecase/list_feed_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/follow/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLFFeedRepository is a mock of LFFeedRepository interface.
+type MockLFFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLFFeedRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLFFeedRepositoryMockRecorder is the mock recorder for MockLFFeedRepository.
+type MockLFFeedRepositoryMockRecorder struct {
+	mock *MockLFFeedRepository
+}
+
+// NewMockLFFeedRepository creates a new mock instance.
+func NewMockLFFeedRepository(ctrl *gomock.Controller) *MockLFFeedRepository {
+	mock := &MockLFFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockLFFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLFFeedRepository) EXPECT() *MockLFFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPage mocks base method.
+func (m *MockLFFeedRepository) FindPage(ctx context.Context, query entity.FeedQuery) (*entity.FeedPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.FeedPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLFFeedRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLFFeedRepository)(nil).FindPage), ctx, query)
+}
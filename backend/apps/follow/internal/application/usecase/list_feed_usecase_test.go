@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/apps/follow/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFeedUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	authorID := id.NewUserID()
+	seasonID := id.NewSeasonID()
+	tierListID := id.NewTierListID()
+	deckID := id.NewDeckID()
+	tierListCreatedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	deckCreatedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.FeedCursor{CreatedAt: deckCreatedAt, ItemID: deckID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: deckCreatedAt.UnixMicro(), ID: deckID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListFeedParams
+		setupMock   func(*MockLFFeedRepository)
+		wantResult  *usecase.ListFeedResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: ティアリストとデッキが種類ごとの項目に変換され、次ページのカーソルを返す",
+			params:   usecase.ListFeedParams{UserID: userID, Limit: 2},
+			setupMock: func(mockRepo *MockLFFeedRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FeedQuery{
+					UserID: userID,
+					Limit:  2,
+				}).Return(&entity.FeedPage{
+					Items: []entity.FeedItem{
+						{
+							Type:              entity.FeedItemTierList,
+							ItemID:            tierListID.UUID(),
+							SeasonID:          seasonID,
+							Title:             "A4環境ティアリスト",
+							Description:       "大会結果から作成",
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "配信者A",
+							CreatedAt:         tierListCreatedAt,
+						},
+						{
+							Type:              entity.FeedItemDeck,
+							ItemID:            deckID.UUID(),
+							SeasonID:          seasonID,
+							Title:             "リザニンフ",
+							ImageURL:          "https://example.com/a.png",
+							AuthorUserID:      authorID,
+							AuthorDisplayName: "配信者A",
+							CreatedAt:         deckCreatedAt,
+						},
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListFeedResult{
+				Items: []usecase.LFItem{
+					{
+						Type:   "tier_list",
+						Author: usecase.LFAuthor{UserID: authorID.String(), DisplayName: "配信者A"},
+						TierList: &usecase.LFTierList{
+							TierListID:  tierListID.String(),
+							SeasonID:    seasonID.String(),
+							Title:       "A4環境ティアリスト",
+							Description: "大会結果から作成",
+						},
+						CreatedAt: tierListCreatedAt,
+					},
+					{
+						Type:   "deck",
+						Author: usecase.LFAuthor{UserID: authorID.String(), DisplayName: "配信者A"},
+						Deck: &usecase.LFDeck{
+							DeckID:   deckID.String(),
+							SeasonID: seasonID.String(),
+							Nickname: "リザニンフ",
+							ImageURL: "https://example.com/a.png",
+						},
+						CreatedAt: deckCreatedAt,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、検索条件に変換され、最終ページはカーソルが空になる",
+			params:   usecase.ListFeedParams{UserID: userID, Cursor: encodedCursor, Limit: 500},
+			setupMock: func(mockRepo *MockLFFeedRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.FeedQuery{
+					UserID: userID,
+					After:  cursor,
+					Limit:  pagination.MaxLimit,
+				}).Return(&entity.FeedPage{Items: []entity.FeedItem{}}, nil)
+			},
+			wantResult: &usecase.ListFeedResult{
+				Items: []usecase.LFItem{},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListFeedParams{UserID: userID, Cursor: pagination.EncodeCursor(pagination.Cursor{ID: "invalid"})},
+			setupMock:   func(mockRepo *MockLFFeedRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListFeedParams{UserID: userID},
+			setupMock: func(mockRepo *MockLFFeedRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLFFeedRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListFeedUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
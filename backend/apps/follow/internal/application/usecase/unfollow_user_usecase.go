@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// UnfollowUserParams はユーザーのフォロー解除の入力
+type UnfollowUserParams struct {
+	UserID     id.UserID
+	FolloweeID string
+}
+
+type UUFollowRepository interface {
+	Remove(ctx context.Context, followerID, followeeID id.UserID) error
+}
+
+type UnfollowUserUsecase struct {
+	followRepo UUFollowRepository
+}
+
+func NewUnfollowUserUsecase(followRepo UUFollowRepository) *UnfollowUserUsecase {
+	return &UnfollowUserUsecase{
+		followRepo: followRepo,
+	}
+}
+
+// Execute はユーザーのフォローを解除する。フォローしていない場合は何もしない
+func (u *UnfollowUserUsecase) Execute(ctx context.Context, params UnfollowUserParams) error {
+	followeeID, err := id.UserIDFromString(params.FolloweeID)
+	if err != nil {
+		return errs.NewValidationError("invalid user_id", err)
+	}
+
+	return u.followRepo.Remove(ctx, params.UserID, followeeID)
+}
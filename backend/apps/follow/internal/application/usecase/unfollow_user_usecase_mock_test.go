@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/application/usecase/unfollow_user_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/application/usecase/unfollow_user_usecase.go -destination=./apps/follow/internal/application/usecase/unfollow_user_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUUFollowRepository is a mock of UUFollowRepository interface.
+type MockUUFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUUFollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUUFollowRepositoryMockRecorder is the mock recorder for MockUUFollowRepository.
+type MockUUFollowRepositoryMockRecorder struct {
+	mock *MockUUFollowRepository
+}
+
+// NewMockUUFollowRepository creates a new mock instance.
+func NewMockUUFollowRepository(ctrl *gomock.Controller) *MockUUFollowRepository {
+	mock := &MockUUFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockUUFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUUFollowRepository) EXPECT() *MockUUFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockUUFollowRepository) Remove(ctx context.Context, followerID, followeeID id.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUUFollowRepositoryMockRecorder) Remove(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUUFollowRepository)(nil).Remove), ctx, followerID, followeeID)
+}
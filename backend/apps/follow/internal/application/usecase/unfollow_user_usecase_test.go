@@ -0,0 +1,81 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnfollowUserUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	followeeID := id.NewUserID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.UnfollowUserParams
+		setupMock   func(*MockUUFollowRepository)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: ユーザーのフォローを解除する",
+			params:   usecase.UnfollowUserParams{UserID: userID, FolloweeID: followeeID.String()},
+			setupMock: func(mockRepo *MockUUFollowRepository) {
+				mockRepo.EXPECT().Remove(gomock.Any(), userID, followeeID).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なユーザーIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.UnfollowUserParams{UserID: userID, FolloweeID: "invalid"},
+			setupMock:   func(mockRepo *MockUUFollowRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.UnfollowUserParams{UserID: userID, FolloweeID: followeeID.String()},
+			setupMock: func(mockRepo *MockUUFollowRepository) {
+				mockRepo.EXPECT().Remove(gomock.Any(), userID, followeeID).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockUUFollowRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewUnfollowUserUsecase(mockRepo)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+// FeedItemType はフィードに並ぶ作成物の種類
+type FeedItemType string
+
+const (
+	// FeedItemTierList はフォロー中のユーザーが作成したティアリスト
+	FeedItemTierList FeedItemType = "tier_list"
+	// FeedItemDeck はフォロー中のユーザーが作成したデッキ
+	FeedItemDeck FeedItemType = "deck"
+)
+
+// FeedQuery はフィードの検索条件
+type FeedQuery struct {
+	UserID id.UserID
+	After  *FeedCursor
+	Limit  int
+}
+
+// FeedCursor はフィードの作成日時の新しい順のキーセットページネーションの位置
+// ItemID は同じ日時に作成されたティアリスト・デッキの並びを一意にするID
+type FeedCursor struct {
+	CreatedAt time.Time
+	ItemID    uuid.UUID
+}
+
+// FeedItem はフィードに並ぶティアリスト・デッキ
+// デッキの場合 Title はデッキのニックネームで Description は空、ティアリストの場合 ImageURL は空となる
+type FeedItem struct {
+	Type              FeedItemType
+	ItemID            uuid.UUID
+	SeasonID          id.SeasonID
+	Title             string
+	Description       string
+	ImageURL          string
+	AuthorUserID      id.UserID
+	AuthorDisplayName string
+	CreatedAt         time.Time
+}
+
+// FeedPage はフィードの1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type FeedPage struct {
+	Items []FeedItem
+	Next  *FeedCursor
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/follow/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// FeedQuerier はデータベースクエリを定義するインターフェース
+type FeedQuerier interface {
+	ListFeedItems(ctx context.Context, arg db.ListFeedItemsParams) ([]db.ListFeedItemsRow, error)
+}
+
+// FeedRepository はフォロー中のユーザーの新着を集めたフィードの取得を行う
+type FeedRepository struct {
+	queries FeedQuerier
+}
+
+// NewFeedRepository は新しいFeedRepositoryを作成
+func NewFeedRepository(queries FeedQuerier) *FeedRepository {
+	return &FeedRepository{
+		queries: queries,
+	}
+}
+
+// FindPage はフォロー中のユーザーが作成したティアリスト・デッキを新しい順に1ページ分取得
+func (r *FeedRepository) FindPage(ctx context.Context, query entity.FeedQuery) (*entity.FeedPage, error) {
+	params := db.ListFeedItemsParams{
+		UserID:    pgtype.UUID{Bytes: query.UserID.UUID(), Valid: true},
+		PageLimit: int32(query.Limit + 1), // #nosec G115 -- Limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if query.After != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: query.After.CreatedAt, Valid: true}
+		params.CursorItemID = pgtype.UUID{Bytes: query.After.ItemID, Valid: true}
+	}
+
+	rows, err := r.queries.ListFeedItems(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feed items: %w", err)
+	}
+
+	page := &entity.FeedPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[query.Limit-1]
+		page.Next = &entity.FeedCursor{
+			CreatedAt: last.CreatedAt.Time,
+			ItemID:    last.ItemID.Bytes,
+		}
+	}
+
+	page.Items = make([]entity.FeedItem, 0, len(rows))
+	for _, row := range rows {
+		page.Items = append(page.Items, entity.FeedItem{
+			Type:              entity.FeedItemType(row.ItemType),
+			ItemID:            row.ItemID.Bytes,
+			SeasonID:          id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			Title:             row.Title,
+			Description:       row.Description,
+			ImageURL:          row.ImageUrl,
+			AuthorUserID:      id.UserIDFromUUID(row.AuthorUserID.Bytes),
+			AuthorDisplayName: row.AuthorDisplayName,
+			CreatedAt:         row.CreatedAt.Time,
+		})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/infrastructure/repository/feed_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/infrastructure/repository/feed_repository.go -destination=./apps/follow/internal/infrastructure/repository/feed_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedQuerier is a mock of FeedQuerier interface.
+type MockFeedQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedQuerierMockRecorder is the mock recorder for MockFeedQuerier.
+type MockFeedQuerierMockRecorder struct {
+	mock *MockFeedQuerier
+}
+
+// NewMockFeedQuerier creates a new mock instance.
+func NewMockFeedQuerier(ctrl *gomock.Controller) *MockFeedQuerier {
+	mock := &MockFeedQuerier{ctrl: ctrl}
+	mock.recorder = &MockFeedQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedQuerier) EXPECT() *MockFeedQuerierMockRecorder {
+	return m.recorder
+}
+
+// ListFeedItems mocks base method.
+func (m *MockFeedQuerier) ListFeedItems(ctx context.Context, arg db.ListFeedItemsParams) ([]db.ListFeedItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeedItems", ctx, arg)
+	ret0, _ := ret[0].([]db.ListFeedItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeedItems indicates an expected call of ListFeedItems.
+func (mr *MockFeedQuerierMockRecorder) ListFeedItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeedItems", reflect.TypeOf((*MockFeedQuerier)(nil).ListFeedItems), ctx, arg)
+}
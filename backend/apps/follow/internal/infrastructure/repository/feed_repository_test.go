@@ -0,0 +1,142 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/follow/internal/domain/entity"
+	"poketier/apps/follow/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestFeedRepository_FindPage(t *testing.T) {
+	t.Parallel()
+
+	tierListID, deckID := id.NewTierListID(), id.NewDeckID()
+	tierListCreatedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	deckCreatedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	tierListRow := db.ListFeedItemsRow{
+		ItemType:          "tier_list",
+		ItemID:            pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+		SeasonID:          pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		Title:             "A4環境ティアリスト",
+		Description:       "大会結果から作成",
+		AuthorUserID:      pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+		AuthorDisplayName: "配信者A",
+		CreatedAt:         pgtype.Timestamptz{Time: tierListCreatedAt, Valid: true},
+	}
+	deckRow := db.ListFeedItemsRow{
+		ItemType:          "deck",
+		ItemID:            pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+		SeasonID:          pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		Title:             "リザニンフ",
+		ImageUrl:          "https://example.com/a.png",
+		AuthorUserID:      pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+		AuthorDisplayName: "配信者A",
+		CreatedAt:         pgtype.Timestamptz{Time: deckCreatedAt, Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		query       entity.FeedQuery
+		setupMock   func(mockQuerier *MockFeedQuerier)
+		want        *entity.FeedPage
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 次ページがある場合、1件多く取得した結果から次ページのカーソルを含むページが作成される事",
+			query:    entity.FeedQuery{UserID: userID, Limit: 1},
+			setupMock: func(mockQuerier *MockFeedQuerier) {
+				mockQuerier.EXPECT().ListFeedItems(gomock.Any(), db.ListFeedItemsParams{
+					UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					PageLimit: 2,
+				}).Return([]db.ListFeedItemsRow{tierListRow, deckRow}, nil)
+			},
+			want: &entity.FeedPage{
+				Items: []entity.FeedItem{
+					{
+						Type:              entity.FeedItemTierList,
+						ItemID:            tierListID.UUID(),
+						SeasonID:          seasonID,
+						Title:             "A4環境ティアリスト",
+						Description:       "大会結果から作成",
+						AuthorUserID:      followeeID,
+						AuthorDisplayName: "配信者A",
+						CreatedAt:         tierListCreatedAt,
+					},
+				},
+				Next: &entity.FeedCursor{CreatedAt: tierListCreatedAt, ItemID: tierListID.UUID()},
+			},
+		},
+		{
+			caseName: "正常系: カーソルが指定された場合、クエリのパラメータに変換され、デッキは画像URLを含む事",
+			query: entity.FeedQuery{
+				UserID: userID,
+				After:  &entity.FeedCursor{CreatedAt: tierListCreatedAt, ItemID: tierListID.UUID()},
+				Limit:  20,
+			},
+			setupMock: func(mockQuerier *MockFeedQuerier) {
+				mockQuerier.EXPECT().ListFeedItems(gomock.Any(), db.ListFeedItemsParams{
+					UserID:          pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					CursorCreatedAt: pgtype.Timestamptz{Time: tierListCreatedAt, Valid: true},
+					CursorItemID:    pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+					PageLimit:       21,
+				}).Return([]db.ListFeedItemsRow{deckRow}, nil)
+			},
+			want: &entity.FeedPage{
+				Items: []entity.FeedItem{
+					{
+						Type:              entity.FeedItemDeck,
+						ItemID:            deckID.UUID(),
+						SeasonID:          seasonID,
+						Title:             "リザニンフ",
+						ImageURL:          "https://example.com/a.png",
+						AuthorUserID:      followeeID,
+						AuthorDisplayName: "配信者A",
+						CreatedAt:         deckCreatedAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			query:    entity.FeedQuery{UserID: userID, Limit: 20},
+			setupMock: func(mockQuerier *MockFeedQuerier) {
+				mockQuerier.EXPECT().ListFeedItems(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockFeedQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewFeedRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindPage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "page does not match")
+		})
+	}
+}
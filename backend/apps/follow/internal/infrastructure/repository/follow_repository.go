@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// foreignKeyViolation は外部キー制約違反のエラーコード（フォローするユーザーが存在しない場合）
+const foreignKeyViolation = "23503"
+
+// FollowQuerier はデータベースクエリを定義するインターフェース
+type FollowQuerier interface {
+	FollowUser(ctx context.Context, arg db.FollowUserParams) (int64, error)
+	UnfollowUser(ctx context.Context, arg db.UnfollowUserParams) (int64, error)
+}
+
+// FollowRepository はユーザー間のフォローの永続化を行う
+type FollowRepository struct {
+	queries FollowQuerier
+}
+
+// NewFollowRepository は新しいFollowRepositoryを作成
+func NewFollowRepository(queries FollowQuerier) *FollowRepository {
+	return &FollowRepository{
+		queries: queries,
+	}
+}
+
+// Add はユーザーをフォローする。フォロー済みの場合は何もしない
+// フォローするユーザーが存在しない場合はNotFoundエラーを返す
+func (r *FollowRepository) Add(ctx context.Context, followerID, followeeID id.UserID) error {
+	if _, err := r.queries.FollowUser(ctx, db.FollowUserParams{
+		FollowerUserID: pgtype.UUID{Bytes: followerID.UUID(), Valid: true},
+		FolloweeUserID: pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.NewNotFoundError("user not found", err)
+		}
+		return fmt.Errorf("failed to follow user: %w", err)
+	}
+	return nil
+}
+
+// Remove はユーザーのフォローを解除する。フォローしていない場合は何もしない
+func (r *FollowRepository) Remove(ctx context.Context, followerID, followeeID id.UserID) error {
+	if _, err := r.queries.UnfollowUser(ctx, db.UnfollowUserParams{
+		FollowerUserID: pgtype.UUID{Bytes: followerID.UUID(), Valid: true},
+		FolloweeUserID: pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/infrastructure/repository/follow_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/infrastructure/repository/follow_repository.go -destination=./apps/follow/internal/infrastructure/repository/follow_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowQuerier is a mock of FollowQuerier interface.
+type MockFollowQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockFollowQuerierMockRecorder is the mock recorder for MockFollowQuerier.
+type MockFollowQuerierMockRecorder struct {
+	mock *MockFollowQuerier
+}
+
+// NewMockFollowQuerier creates a new mock instance.
+func NewMockFollowQuerier(ctrl *gomock.Controller) *MockFollowQuerier {
+	mock := &MockFollowQuerier{ctrl: ctrl}
+	mock.recorder = &MockFollowQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowQuerier) EXPECT() *MockFollowQuerierMockRecorder {
+	return m.recorder
+}
+
+// FollowUser mocks base method.
+func (m *MockFollowQuerier) FollowUser(ctx context.Context, arg db.FollowUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockFollowQuerierMockRecorder) FollowUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockFollowQuerier)(nil).FollowUser), ctx, arg)
+}
+
+// UnfollowUser mocks base method.
+func (m *MockFollowQuerier) UnfollowUser(ctx context.Context, arg db.UnfollowUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockFollowQuerierMockRecorder) UnfollowUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockFollowQuerier)(nil).UnfollowUser), ctx, arg)
+}
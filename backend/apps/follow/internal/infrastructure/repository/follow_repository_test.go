@@ -0,0 +1,154 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/follow/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+var (
+	userID     = id.NewUserID()
+	followeeID = id.NewUserID()
+	seasonID   = id.NewSeasonID()
+)
+
+func TestFollowRepository_Add(t *testing.T) {
+	t.Parallel()
+
+	params := db.FollowUserParams{
+		FollowerUserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		FolloweeUserID: pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockFollowQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: ユーザーをフォローできる事",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().FollowUser(gomock.Any(), params).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "正常系: フォロー済みの場合もエラーにならない事",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().FollowUser(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: フォローするユーザーが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().FollowUser(gomock.Any(), params).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().FollowUser(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockFollowQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewFollowRepository(mockQuerier)
+
+			// Act
+			err := repo.Add(context.Background(), userID, followeeID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestFollowRepository_Remove(t *testing.T) {
+	t.Parallel()
+
+	params := db.UnfollowUserParams{
+		FollowerUserID: pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		FolloweeUserID: pgtype.UUID{Bytes: followeeID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockFollowQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: フォローを解除できる事",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().UnfollowUser(gomock.Any(), params).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "正常系: フォローしていない場合もエラーにならない事",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().UnfollowUser(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockFollowQuerier) {
+				mockQuerier.EXPECT().UnfollowUser(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockFollowQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewFollowRepository(mockQuerier)
+
+			// Act
+			err := repo.Remove(context.Background(), userID, followeeID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
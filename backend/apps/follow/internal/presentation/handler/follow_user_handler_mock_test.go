@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/presentation/handler/follow_user_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/presentation/handler/follow_user_handler.go -destination=./apps/follow/internal/presentation/handler/follow_user_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/follow/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowUserUseCase is a mock of FollowUserUseCase interface.
+type MockFollowUserUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowUserUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockFollowUserUseCaseMockRecorder is the mock recorder for MockFollowUserUseCase.
+type MockFollowUserUseCaseMockRecorder struct {
+	mock *MockFollowUserUseCase
+}
+
+// NewMockFollowUserUseCase creates a new mock instance.
+func NewMockFollowUserUseCase(ctrl *gomock.Controller) *MockFollowUserUseCase {
+	mock := &MockFollowUserUseCase{ctrl: ctrl}
+	mock.recorder = &MockFollowUserUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowUserUseCase) EXPECT() *MockFollowUserUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockFollowUserUseCase) Execute(ctx context.Context, params usecase.FollowUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockFollowUserUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFollowUserUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/apps/follow/internal/presentation/request"
+	"poketier/apps/follow/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListFeedHandler struct {
+	uc ListFeedUseCase
+}
+
+type ListFeedUseCase interface {
+	Execute(ctx context.Context, params usecase.ListFeedParams) (*usecase.ListFeedResult, error)
+}
+
+func NewListFeedHandler(uc ListFeedUseCase) *ListFeedHandler {
+	return &ListFeedHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListFeedHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.ListFeedRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListFeedParams{
+		UserID: userID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListFeedResponse(result))
+}
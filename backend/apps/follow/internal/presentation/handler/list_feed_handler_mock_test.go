@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/presentation/handler/list_feed_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/presentation/handler/list_feed_handler.go -destination=./apps/follow/internal/presentation/handler/list_feed_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/follow/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListFeedUseCase is a mock of ListFeedUseCase interface.
+type MockListFeedUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListFeedUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListFeedUseCaseMockRecorder is the mock recorder for MockListFeedUseCase.
+type MockListFeedUseCaseMockRecorder struct {
+	mock *MockListFeedUseCase
+}
+
+// NewMockListFeedUseCase creates a new mock instance.
+func NewMockListFeedUseCase(ctrl *gomock.Controller) *MockListFeedUseCase {
+	mock := &MockListFeedUseCase{ctrl: ctrl}
+	mock.recorder = &MockListFeedUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListFeedUseCase) EXPECT() *MockListFeedUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListFeedUseCase) Execute(ctx context.Context, params usecase.ListFeedParams) (*usecase.ListFeedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListFeedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListFeedUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListFeedUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/apps/follow/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFeedHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	authorID := id.NewUserID().String()
+	seasonID := id.NewSeasonID().String()
+	tierListID := id.NewTierListID().String()
+	deckID := id.NewDeckID().String()
+	tierListCreatedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	deckCreatedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		query          string
+		mockSetup      func(*MockListFeedUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: フォロー中のユーザーのティアリスト・デッキと次ページのカーソルが返される",
+			loggedIn: true,
+			query:    "?cursor=abc&limit=2",
+			mockSetup: func(mockUC *MockListFeedUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFeedParams{
+					UserID: userID,
+					Cursor: "abc",
+					Limit:  2,
+				}).Return(&usecase.ListFeedResult{
+					Items: []usecase.LFItem{
+						{
+							Type:      "tier_list",
+							Author:    usecase.LFAuthor{UserID: authorID, DisplayName: "配信者A"},
+							TierList:  &usecase.LFTierList{TierListID: tierListID, SeasonID: seasonID, Title: "A4環境ティアリスト", Description: "説明"},
+							CreatedAt: tierListCreatedAt,
+						},
+						{
+							Type:      "deck",
+							Author:    usecase.LFAuthor{UserID: authorID, DisplayName: "配信者A"},
+							Deck:      &usecase.LFDeck{DeckID: deckID, SeasonID: seasonID, Nickname: "リザニンフ", ImageURL: "https://example.com/a.png"},
+							CreatedAt: deckCreatedAt,
+						},
+					},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"items": []map[string]interface{}{
+					{
+						"type":   "tier_list",
+						"author": map[string]interface{}{"user_id": authorID, "display_name": "配信者A"},
+						"tier_list": map[string]interface{}{
+							"tier_list_id": tierListID,
+							"season_id":    seasonID,
+							"title":        "A4環境ティアリスト",
+							"description":  "説明",
+						},
+						"deck":       nil,
+						"created_at": "2025-08-02T12:00:00Z",
+					},
+					{
+						"type":      "deck",
+						"author":    map[string]interface{}{"user_id": authorID, "display_name": "配信者A"},
+						"tier_list": nil,
+						"deck": map[string]interface{}{
+							"deck_id":   deckID,
+							"season_id": seasonID,
+							"nickname":  "リザニンフ",
+							"image_url": "https://example.com/a.png",
+						},
+						"created_at": "2025-08-01T12:00:00Z",
+					},
+				},
+				"next_cursor": "next",
+			},
+		},
+		{
+			caseName: "正常系: フォロー中のユーザーの新着がない場合、空の一覧が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFeedUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListFeedParams{UserID: userID}).
+					Return(&usecase.ListFeedResult{Items: []usecase.LFItem{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"items":       []interface{}{},
+				"next_cursor": nil,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockListFeedUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: limitが範囲外の場合、400が返される",
+			loggedIn:       true,
+			query:          "?limit=101",
+			mockSetup:      func(mockUC *MockListFeedUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListFeedUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListFeedUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListFeedHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, "/feed"+tt.query, nil)
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
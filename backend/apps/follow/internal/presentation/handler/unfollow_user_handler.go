@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/follow/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UnfollowUserHandler struct {
+	uc UnfollowUserUseCase
+}
+
+type UnfollowUserUseCase interface {
+	Execute(ctx context.Context, params usecase.UnfollowUserParams) error
+}
+
+func NewUnfollowUserHandler(uc UnfollowUserUseCase) *UnfollowUserHandler {
+	return &UnfollowUserHandler{
+		uc: uc,
+	}
+}
+
+func (h *UnfollowUserHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.UnfollowUserParams{
+		UserID:     userID,
+		FolloweeID: ctx.Param("user_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/follow/internal/presentation/handler/unfollow_user_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/follow/internal/presentation/handler/unfollow_user_handler.go -destination=./apps/follow/internal/presentation/handler/unfollow_user_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/follow/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnfollowUserUseCase is a mock of UnfollowUserUseCase interface.
+type MockUnfollowUserUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnfollowUserUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnfollowUserUseCaseMockRecorder is the mock recorder for MockUnfollowUserUseCase.
+type MockUnfollowUserUseCaseMockRecorder struct {
+	mock *MockUnfollowUserUseCase
+}
+
+// NewMockUnfollowUserUseCase creates a new mock instance.
+func NewMockUnfollowUserUseCase(ctrl *gomock.Controller) *MockUnfollowUserUseCase {
+	mock := &MockUnfollowUserUseCase{ctrl: ctrl}
+	mock.recorder = &MockUnfollowUserUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnfollowUserUseCase) EXPECT() *MockUnfollowUserUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnfollowUserUseCase) Execute(ctx context.Context, params usecase.UnfollowUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnfollowUserUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnfollowUserUseCase)(nil).Execute), ctx, params)
+}
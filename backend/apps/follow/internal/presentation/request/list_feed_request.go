@@ -0,0 +1,7 @@
+package request
+
+// ListFeedRequest はフィード取得のクエリパラメータ
+type ListFeedRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
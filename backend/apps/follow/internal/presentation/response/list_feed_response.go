@@ -0,0 +1,79 @@
+package response
+
+import (
+	"poketier/apps/follow/internal/application/usecase"
+	"time"
+)
+
+type ListFeedResponse struct {
+	Items      []LFItem `json:"items"`
+	NextCursor *string  `json:"next_cursor"`
+}
+
+// LFItem はフィードの1件。type が tier_list の場合は tier_list、deck の場合は deck が設定され、もう一方は null となる
+type LFItem struct {
+	Type      string      `json:"type"`
+	Author    LFAuthor    `json:"author"`
+	TierList  *LFTierList `json:"tier_list"`
+	Deck      *LFDeck     `json:"deck"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type LFAuthor struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+}
+
+type LFTierList struct {
+	TierListID  string `json:"tier_list_id"`
+	SeasonID    string `json:"season_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type LFDeck struct {
+	DeckID   string `json:"deck_id"`
+	SeasonID string `json:"season_id"`
+	Nickname string `json:"nickname"`
+	ImageURL string `json:"image_url"`
+}
+
+func NewListFeedResponse(result *usecase.ListFeedResult) ListFeedResponse {
+	items := make([]LFItem, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = LFItem{
+			Type: item.Type,
+			Author: LFAuthor{
+				UserID:      item.Author.UserID,
+				DisplayName: item.Author.DisplayName,
+			},
+			CreatedAt: item.CreatedAt,
+		}
+		if item.TierList != nil {
+			items[i].TierList = &LFTierList{
+				TierListID:  item.TierList.TierListID,
+				SeasonID:    item.TierList.SeasonID,
+				Title:       item.TierList.Title,
+				Description: item.TierList.Description,
+			}
+		}
+		if item.Deck != nil {
+			items[i].Deck = &LFDeck{
+				DeckID:   item.Deck.DeckID,
+				SeasonID: item.Deck.SeasonID,
+				Nickname: item.Deck.Nickname,
+				ImageURL: item.Deck.ImageURL,
+			}
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListFeedResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+}
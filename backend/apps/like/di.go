@@ -0,0 +1,88 @@
+//go:build wireinject
+// +build wireinject
+
+package like
+
+import (
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/apps/like/internal/infrastructure/repository"
+	"poketier/apps/like/internal/presentation/handler"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeLikeTierListHandler はLikeTierListHandlerとその依存関係を初期化します
+func InitializeLikeTierListHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.LikeTierListHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListLikeQuerier), new(db.Querier)),
+		repository.NewTierListLikeRepository,
+		wire.Bind(new(usecase.LTLLikeRepository), new(*repository.TierListLikeRepository)),
+		wire.Bind(new(usecase.LTLTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewLikeTierListUsecase,
+		wire.Bind(new(handler.LikeTierListUseCase), new(*usecase.LikeTierListUsecase)),
+
+		// Handler provider
+		handler.NewLikeTierListHandler,
+	)
+	return &handler.LikeTierListHandler{}
+}
+
+// InitializeUnlikeTierListHandler はUnlikeTierListHandlerとその依存関係を初期化します
+func InitializeUnlikeTierListHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.UnlikeTierListHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListLikeQuerier), new(db.Querier)),
+		repository.NewTierListLikeRepository,
+		wire.Bind(new(usecase.UTLLikeRepository), new(*repository.TierListLikeRepository)),
+		wire.Bind(new(usecase.UTLTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewUnlikeTierListUsecase,
+		wire.Bind(new(handler.UnlikeTierListUseCase), new(*usecase.UnlikeTierListUsecase)),
+
+		// Handler provider
+		handler.NewUnlikeTierListHandler,
+	)
+	return &handler.UnlikeTierListHandler{}
+}
+
+// InitializeLikeCommentHandler はLikeCommentHandlerとその依存関係を初期化します
+func InitializeLikeCommentHandler(queries db.Querier) *handler.LikeCommentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentLikeQuerier), new(db.Querier)),
+		repository.NewCommentLikeRepository,
+		wire.Bind(new(usecase.LCLikeRepository), new(*repository.CommentLikeRepository)),
+
+		// Usecase provider
+		usecase.NewLikeCommentUsecase,
+		wire.Bind(new(handler.LikeCommentUseCase), new(*usecase.LikeCommentUsecase)),
+
+		// Handler provider
+		handler.NewLikeCommentHandler,
+	)
+	return &handler.LikeCommentHandler{}
+}
+
+// InitializeUnlikeCommentHandler はUnlikeCommentHandlerとその依存関係を初期化します
+func InitializeUnlikeCommentHandler(queries db.Querier) *handler.UnlikeCommentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CommentLikeQuerier), new(db.Querier)),
+		repository.NewCommentLikeRepository,
+		wire.Bind(new(usecase.UCLikeRepository), new(*repository.CommentLikeRepository)),
+
+		// Usecase provider
+		usecase.NewUnlikeCommentUsecase,
+		wire.Bind(new(handler.UnlikeCommentUseCase), new(*usecase.UnlikeCommentUsecase)),
+
+		// Handler provider
+		handler.NewUnlikeCommentHandler,
+	)
+	return &handler.UnlikeCommentHandler{}
+}
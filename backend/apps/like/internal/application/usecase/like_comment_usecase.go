@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// LikeCommentParams はコメントへのいいねの入力
+type LikeCommentParams struct {
+	UserID    id.UserID
+	CommentID string
+}
+
+type LCLikeRepository interface {
+	Add(ctx context.Context, userID id.UserID, commentID id.CommentID) error
+}
+
+type LikeCommentUsecase struct {
+	likeRepo LCLikeRepository
+}
+
+func NewLikeCommentUsecase(likeRepo LCLikeRepository) *LikeCommentUsecase {
+	return &LikeCommentUsecase{
+		likeRepo: likeRepo,
+	}
+}
+
+// Execute はコメントにいいねする。いいね済みの場合は何もしない
+// 削除済みのコメントにはいいねできない
+func (u *LikeCommentUsecase) Execute(ctx context.Context, params LikeCommentParams) error {
+	commentID, err := id.CommentIDFromString(params.CommentID)
+	if err != nil {
+		return errs.NewValidationError("invalid comment_id", err)
+	}
+
+	return u.likeRepo.Add(ctx, params.UserID, commentID)
+}
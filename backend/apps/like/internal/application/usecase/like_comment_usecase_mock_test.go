@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/application/usecase/like_comment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/application/usecase/like_comment_usecase.go -destination=./apps/like/internal/application/usecase/like_comment_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLCLikeRepository is a mock of LCLikeRepository interface.
+type MockLCLikeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLCLikeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLCLikeRepositoryMockRecorder is the mock recorder for MockLCLikeRepository.
+type MockLCLikeRepositoryMockRecorder struct {
+	mock *MockLCLikeRepository
+}
+
+// NewMockLCLikeRepository creates a new mock instance.
+func NewMockLCLikeRepository(ctrl *gomock.Controller) *MockLCLikeRepository {
+	mock := &MockLCLikeRepository{ctrl: ctrl}
+	mock.recorder = &MockLCLikeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLCLikeRepository) EXPECT() *MockLCLikeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockLCLikeRepository) Add(ctx context.Context, userID id.UserID, commentID id.CommentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockLCLikeRepositoryMockRecorder) Add(ctx, userID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockLCLikeRepository)(nil).Add), ctx, userID, commentID)
+}
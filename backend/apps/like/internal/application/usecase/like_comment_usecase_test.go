@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLikeCommentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	commentID := id.NewCommentID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.LikeCommentParams
+		setupMock   func(*MockLCLikeRepository)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: コメントのいいねされる",
+			params:   usecase.LikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockLCLikeRepository) {
+				mockRepo.EXPECT().Add(gomock.Any(), userID, commentID).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なコメントIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.LikeCommentParams{UserID: userID, CommentID: "invalid"},
+			setupMock:   func(mockRepo *MockLCLikeRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.LikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockLCLikeRepository) {
+				mockRepo.EXPECT().Add(gomock.Any(), userID, commentID).Return(errs.NewNotFoundError("comment not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: コメントが削除済みの場合、Conflictエラーを返す",
+			params:   usecase.LikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockLCLikeRepository) {
+				mockRepo.EXPECT().Add(gomock.Any(), userID, commentID).Return(errs.NewConflictError("comment is deleted", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.LikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockLCLikeRepository) {
+				mockRepo.EXPECT().Add(gomock.Any(), userID, commentID).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLCLikeRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewLikeCommentUsecase(mockRepo)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
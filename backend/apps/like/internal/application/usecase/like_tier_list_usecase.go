@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// LikeTierListParams はティアリストへのいいねの入力
+type LikeTierListParams struct {
+	UserID     id.UserID
+	TierListID string
+}
+
+type LTLLikeRepository interface {
+	Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error
+}
+
+type LTLTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type LikeTierListUsecase struct {
+	likeRepo  LTLLikeRepository
+	txManager LTLTxManager
+}
+
+func NewLikeTierListUsecase(likeRepo LTLLikeRepository, txManager LTLTxManager) *LikeTierListUsecase {
+	return &LikeTierListUsecase{
+		likeRepo:  likeRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute はティアリストにいいねする。いいね済みの場合は何もしない
+// いいねといいね数は同一トランザクションで更新する
+func (u *LikeTierListUsecase) Execute(ctx context.Context, params LikeTierListParams) error {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.likeRepo.Add(ctx, params.UserID, tierListID)
+	})
+}
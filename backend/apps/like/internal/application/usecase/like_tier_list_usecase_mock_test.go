@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/application/usecase/like_tier_list_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/application/usecase/like_tier_list_usecase.go -destination=./apps/like/internal/application/usecase/like_tier_list_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLTLLikeRepository is a mock of LTLLikeRepository interface.
+type MockLTLLikeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTLLikeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTLLikeRepositoryMockRecorder is the mock recorder for MockLTLLikeRepository.
+type MockLTLLikeRepositoryMockRecorder struct {
+	mock *MockLTLLikeRepository
+}
+
+// NewMockLTLLikeRepository creates a new mock instance.
+func NewMockLTLLikeRepository(ctrl *gomock.Controller) *MockLTLLikeRepository {
+	mock := &MockLTLLikeRepository{ctrl: ctrl}
+	mock.recorder = &MockLTLLikeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTLLikeRepository) EXPECT() *MockLTLLikeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockLTLLikeRepository) Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockLTLLikeRepositoryMockRecorder) Add(ctx, userID, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockLTLLikeRepository)(nil).Add), ctx, userID, tierListID)
+}
+
+// MockLTLTxManager is a mock of LTLTxManager interface.
+type MockLTLTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTLTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockLTLTxManagerMockRecorder is the mock recorder for MockLTLTxManager.
+type MockLTLTxManagerMockRecorder struct {
+	mock *MockLTLTxManager
+}
+
+// NewMockLTLTxManager creates a new mock instance.
+func NewMockLTLTxManager(ctrl *gomock.Controller) *MockLTLTxManager {
+	mock := &MockLTLTxManager{ctrl: ctrl}
+	mock.recorder = &MockLTLTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTLTxManager) EXPECT() *MockLTLTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockLTLTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockLTLTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockLTLTxManager)(nil).RunInTx), ctx, fn)
+}
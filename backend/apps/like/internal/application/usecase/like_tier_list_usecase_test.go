@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLikeTierListUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	tierListID := id.NewTierListID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.LikeTierListParams
+		setupMock   func(*MockLTLLikeRepository, *MockLTLTxManager)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: トランザクション内でティアリストのいいねされる",
+			params:   usecase.LikeTierListParams{UserID: userID, TierListID: tierListID.String()},
+			setupMock: func(mockRepo *MockLTLLikeRepository, mockTx *MockLTLTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, tierListID).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.LikeTierListParams{UserID: userID, TierListID: "invalid"},
+			setupMock:   func(mockRepo *MockLTLLikeRepository, mockTx *MockLTLTxManager) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.LikeTierListParams{UserID: userID, TierListID: tierListID.String()},
+			setupMock: func(mockRepo *MockLTLLikeRepository, mockTx *MockLTLTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, tierListID).Return(errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.LikeTierListParams{UserID: userID, TierListID: tierListID.String()},
+			setupMock: func(mockRepo *MockLTLLikeRepository, mockTx *MockLTLTxManager) {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				mockRepo.EXPECT().Add(gomock.Any(), userID, tierListID).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLTLLikeRepository(ctrl)
+			mockTx := NewMockLTLTxManager(ctrl)
+			tt.setupMock(mockRepo, mockTx)
+
+			usecase := usecase.NewLikeTierListUsecase(mockRepo, mockTx)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
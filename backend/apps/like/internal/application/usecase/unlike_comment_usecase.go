@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// UnlikeCommentParams はコメントのいいねの取り消しの入力
+type UnlikeCommentParams struct {
+	UserID    id.UserID
+	CommentID string
+}
+
+type UCLikeRepository interface {
+	Remove(ctx context.Context, userID id.UserID, commentID id.CommentID) error
+}
+
+type UnlikeCommentUsecase struct {
+	likeRepo UCLikeRepository
+}
+
+func NewUnlikeCommentUsecase(likeRepo UCLikeRepository) *UnlikeCommentUsecase {
+	return &UnlikeCommentUsecase{
+		likeRepo: likeRepo,
+	}
+}
+
+// Execute はコメントのいいねを取り消す。いいねしていない場合は何もしない
+// 削除済みのコメントでも取り消せる
+func (u *UnlikeCommentUsecase) Execute(ctx context.Context, params UnlikeCommentParams) error {
+	commentID, err := id.CommentIDFromString(params.CommentID)
+	if err != nil {
+		return errs.NewValidationError("invalid comment_id", err)
+	}
+
+	return u.likeRepo.Remove(ctx, params.UserID, commentID)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/application/usecase/unlike_comment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/application/usecase/unlike_comment_usecase.go -destination=./apps/like/internal/application/usecase/unlike_comment_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUCLikeRepository is a mock of UCLikeRepository interface.
+type MockUCLikeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUCLikeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUCLikeRepositoryMockRecorder is the mock recorder for MockUCLikeRepository.
+type MockUCLikeRepositoryMockRecorder struct {
+	mock *MockUCLikeRepository
+}
+
+// NewMockUCLikeRepository creates a new mock instance.
+func NewMockUCLikeRepository(ctrl *gomock.Controller) *MockUCLikeRepository {
+	mock := &MockUCLikeRepository{ctrl: ctrl}
+	mock.recorder = &MockUCLikeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUCLikeRepository) EXPECT() *MockUCLikeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockUCLikeRepository) Remove(ctx context.Context, userID id.UserID, commentID id.CommentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUCLikeRepositoryMockRecorder) Remove(ctx, userID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUCLikeRepository)(nil).Remove), ctx, userID, commentID)
+}
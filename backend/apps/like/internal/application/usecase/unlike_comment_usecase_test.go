@@ -0,0 +1,81 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnlikeCommentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	commentID := id.NewCommentID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.UnlikeCommentParams
+		setupMock   func(*MockUCLikeRepository)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: コメントのいいねが取り消される",
+			params:   usecase.UnlikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockUCLikeRepository) {
+				mockRepo.EXPECT().Remove(gomock.Any(), userID, commentID).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なコメントIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.UnlikeCommentParams{UserID: userID, CommentID: "invalid"},
+			setupMock:   func(mockRepo *MockUCLikeRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.UnlikeCommentParams{UserID: userID, CommentID: commentID.String()},
+			setupMock: func(mockRepo *MockUCLikeRepository) {
+				mockRepo.EXPECT().Remove(gomock.Any(), userID, commentID).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockUCLikeRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewUnlikeCommentUsecase(mockRepo)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
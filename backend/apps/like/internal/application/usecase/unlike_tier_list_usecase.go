@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// UnlikeTierListParams はティアリストのいいねの取り消しの入力
+type UnlikeTierListParams struct {
+	UserID     id.UserID
+	TierListID string
+}
+
+type UTLLikeRepository interface {
+	Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error
+}
+
+type UTLTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type UnlikeTierListUsecase struct {
+	likeRepo  UTLLikeRepository
+	txManager UTLTxManager
+}
+
+func NewUnlikeTierListUsecase(likeRepo UTLLikeRepository, txManager UTLTxManager) *UnlikeTierListUsecase {
+	return &UnlikeTierListUsecase{
+		likeRepo:  likeRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute はティアリストのいいねを取り消す。いいねしていない場合は何もしない
+// いいねといいね数は同一トランザクションで更新する
+func (u *UnlikeTierListUsecase) Execute(ctx context.Context, params UnlikeTierListParams) error {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.likeRepo.Remove(ctx, params.UserID, tierListID)
+	})
+}
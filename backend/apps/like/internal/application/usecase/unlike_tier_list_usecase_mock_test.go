@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/application/usecase/unlike_tier_list_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/application/usecase/unlike_tier_list_usecase.go -destination=./apps/like/internal/application/usecase/unlike_tier_list_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUTLLikeRepository is a mock of UTLLikeRepository interface.
+type MockUTLLikeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUTLLikeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUTLLikeRepositoryMockRecorder is the mock recorder for MockUTLLikeRepository.
+type MockUTLLikeRepositoryMockRecorder struct {
+	mock *MockUTLLikeRepository
+}
+
+// NewMockUTLLikeRepository creates a new mock instance.
+func NewMockUTLLikeRepository(ctrl *gomock.Controller) *MockUTLLikeRepository {
+	mock := &MockUTLLikeRepository{ctrl: ctrl}
+	mock.recorder = &MockUTLLikeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUTLLikeRepository) EXPECT() *MockUTLLikeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockUTLLikeRepository) Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUTLLikeRepositoryMockRecorder) Remove(ctx, userID, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUTLLikeRepository)(nil).Remove), ctx, userID, tierListID)
+}
+
+// MockUTLTxManager is a mock of UTLTxManager interface.
+type MockUTLTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockUTLTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockUTLTxManagerMockRecorder is the mock recorder for MockUTLTxManager.
+type MockUTLTxManagerMockRecorder struct {
+	mock *MockUTLTxManager
+}
+
+// NewMockUTLTxManager creates a new mock instance.
+func NewMockUTLTxManager(ctrl *gomock.Controller) *MockUTLTxManager {
+	mock := &MockUTLTxManager{ctrl: ctrl}
+	mock.recorder = &MockUTLTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUTLTxManager) EXPECT() *MockUTLTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockUTLTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockUTLTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockUTLTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "math/rand/v2"
+
+// LikeCountShards はティアリストのいいね数のカウンターを分散させるシャード数
+// 人気のティアリストへのいいね・取り消しが同じ行の更新待ちにならないよう、シャードごとに差分を加算して読み取り時に合計する
+const LikeCountShards = 16
+
+// PickLikeCountShard はいいね数の差分を加算するシャードをランダムに選ぶ
+func PickLikeCountShard() int16 {
+	return int16(rand.IntN(LikeCountShards)) // #nosec G115 G404 -- シャード数はint16の範囲内で、暗号学的な乱数は不要
+}
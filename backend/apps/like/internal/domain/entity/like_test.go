@@ -0,0 +1,26 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"poketier/apps/like/internal/domain/entity"
+)
+
+func TestPickLikeCountShard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: シャードが 0 〜 シャード数-1 の範囲で選ばれる事", func(t *testing.T) {
+		t.Parallel()
+
+		for range 1000 {
+			// Act
+			shard := entity.PickLikeCountShard()
+
+			// Assert
+			assert.GreaterOrEqual(t, shard, int16(0), "shard should not be negative")
+			assert.Less(t, shard, int16(entity.LikeCountShards), "shard should be less than the number of shards")
+		}
+	})
+}
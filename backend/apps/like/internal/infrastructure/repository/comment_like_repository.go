@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// CommentLikeQuerier はデータベースクエリを定義するインターフェース
+type CommentLikeQuerier interface {
+	GetTierListComment(ctx context.Context, commentID pgtype.UUID) (db.TierListComment, error)
+	AddCommentLike(ctx context.Context, arg db.AddCommentLikeParams) (int64, error)
+	RemoveCommentLike(ctx context.Context, arg db.RemoveCommentLikeParams) (int64, error)
+}
+
+// CommentLikeRepository はコメントのいいねの永続化を行う
+type CommentLikeRepository struct {
+	queries CommentLikeQuerier
+}
+
+// NewCommentLikeRepository は新しいCommentLikeRepositoryを作成
+func NewCommentLikeRepository(queries CommentLikeQuerier) *CommentLikeRepository {
+	return &CommentLikeRepository{
+		queries: queries,
+	}
+}
+
+// Add はコメントにいいねする。いいね済みの場合は何もしない
+// コメントが存在しない場合はNotFoundエラー、削除済みの場合はConflictエラーを返す
+func (r *CommentLikeRepository) Add(ctx context.Context, userID id.UserID, commentID id.CommentID) error {
+	pgCommentID := pgtype.UUID{Bytes: commentID.UUID(), Valid: true}
+
+	comment, err := r.queries.GetTierListComment(ctx, pgCommentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NewNotFoundError("comment not found", err)
+		}
+		return fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment.DeletedAt.Valid {
+		return errs.NewConflictError("comment is deleted", nil)
+	}
+
+	if _, err := r.queries.AddCommentLike(ctx, db.AddCommentLikeParams{
+		CommentID: pgCommentID,
+		UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to add comment like: %w", err)
+	}
+	return nil
+}
+
+// Remove はコメントのいいねを取り消す。いいねしていない場合は何もしない
+func (r *CommentLikeRepository) Remove(ctx context.Context, userID id.UserID, commentID id.CommentID) error {
+	if _, err := r.queries.RemoveCommentLike(ctx, db.RemoveCommentLikeParams{
+		CommentID: pgtype.UUID{Bytes: commentID.UUID(), Valid: true},
+		UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to remove comment like: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/infrastructure/repository/comment_like_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/infrastructure/repository/comment_like_repository.go -destination=./apps/like/internal/infrastructure/repository/comment_like_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentLikeQuerier is a mock of CommentLikeQuerier interface.
+type MockCommentLikeQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentLikeQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentLikeQuerierMockRecorder is the mock recorder for MockCommentLikeQuerier.
+type MockCommentLikeQuerierMockRecorder struct {
+	mock *MockCommentLikeQuerier
+}
+
+// NewMockCommentLikeQuerier creates a new mock instance.
+func NewMockCommentLikeQuerier(ctrl *gomock.Controller) *MockCommentLikeQuerier {
+	mock := &MockCommentLikeQuerier{ctrl: ctrl}
+	mock.recorder = &MockCommentLikeQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentLikeQuerier) EXPECT() *MockCommentLikeQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddCommentLike mocks base method.
+func (m *MockCommentLikeQuerier) AddCommentLike(ctx context.Context, arg db.AddCommentLikeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCommentLike", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCommentLike indicates an expected call of AddCommentLike.
+func (mr *MockCommentLikeQuerierMockRecorder) AddCommentLike(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCommentLike", reflect.TypeOf((*MockCommentLikeQuerier)(nil).AddCommentLike), ctx, arg)
+}
+
+// GetTierListComment mocks base method.
+func (m *MockCommentLikeQuerier) GetTierListComment(ctx context.Context, commentID pgtype.UUID) (db.TierListComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierListComment", ctx, commentID)
+	ret0, _ := ret[0].(db.TierListComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierListComment indicates an expected call of GetTierListComment.
+func (mr *MockCommentLikeQuerierMockRecorder) GetTierListComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierListComment", reflect.TypeOf((*MockCommentLikeQuerier)(nil).GetTierListComment), ctx, commentID)
+}
+
+// RemoveCommentLike mocks base method.
+func (m *MockCommentLikeQuerier) RemoveCommentLike(ctx context.Context, arg db.RemoveCommentLikeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCommentLike", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCommentLike indicates an expected call of RemoveCommentLike.
+func (mr *MockCommentLikeQuerierMockRecorder) RemoveCommentLike(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCommentLike", reflect.TypeOf((*MockCommentLikeQuerier)(nil).RemoveCommentLike), ctx, arg)
+}
@@ -0,0 +1,164 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/like/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestCommentLikeRepository_Add(t *testing.T) {
+	t.Parallel()
+
+	commentID := id.NewCommentID()
+	pgCommentID := pgtype.UUID{Bytes: commentID.UUID(), Valid: true}
+	params := db.AddCommentLikeParams{
+		CommentID: pgCommentID,
+		UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockCommentLikeQuerier)
+		wantErrType error
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 削除されていないコメントにいいねできる事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgCommentID).Return(db.TierListComment{CommentID: pgCommentID}, nil)
+				mockQuerier.EXPECT().AddCommentLike(gomock.Any(), params).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "正常系: いいね済みの場合もエラーにならない事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgCommentID).Return(db.TierListComment{CommentID: pgCommentID}, nil)
+				mockQuerier.EXPECT().AddCommentLike(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgCommentID).Return(db.TierListComment{}, pgx.ErrNoRows)
+			},
+			wantErrType: errs.ErrNotFound,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 削除済みのコメントの場合、Conflictエラーになる事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgCommentID).Return(db.TierListComment{
+					CommentID: pgCommentID,
+					DeletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				}, nil)
+			},
+			wantErrType: errs.ErrConflict,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: いいねの登録でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().GetTierListComment(gomock.Any(), pgCommentID).Return(db.TierListComment{CommentID: pgCommentID}, nil)
+				mockQuerier.EXPECT().AddCommentLike(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentLikeQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentLikeRepository(mockQuerier)
+
+			// Act
+			err := repo.Add(context.Background(), userID, commentID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestCommentLikeRepository_Remove(t *testing.T) {
+	t.Parallel()
+
+	commentID := id.NewCommentID()
+	params := db.RemoveCommentLikeParams{
+		CommentID: pgtype.UUID{Bytes: commentID.UUID(), Valid: true},
+		UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockCommentLikeQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: いいねを取り消せる事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().RemoveCommentLike(gomock.Any(), params).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "正常系: いいねしていない場合もエラーにならない事",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().RemoveCommentLike(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockCommentLikeQuerier) {
+				mockQuerier.EXPECT().RemoveCommentLike(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCommentLikeQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCommentLikeRepository(mockQuerier)
+
+			// Act
+			err := repo.Remove(context.Background(), userID, commentID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
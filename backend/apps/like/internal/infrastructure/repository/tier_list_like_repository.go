@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/like/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// foreignKeyViolation は外部キー制約違反のエラーコード（いいねの対象が存在しない場合）
+const foreignKeyViolation = "23503"
+
+// TierListLikeQuerier はデータベースクエリを定義するインターフェース
+type TierListLikeQuerier interface {
+	AddTierListLike(ctx context.Context, arg db.AddTierListLikeParams) (int64, error)
+	RemoveTierListLike(ctx context.Context, arg db.RemoveTierListLikeParams) (int64, error)
+	AddTierListLikeCount(ctx context.Context, arg db.AddTierListLikeCountParams) error
+	LockTierListForHotScore(ctx context.Context, tierListID pgtype.UUID) error
+	RefreshTierListHotScore(ctx context.Context, tierListID pgtype.UUID) error
+}
+
+// TierListLikeRepository はティアリストのいいねの永続化を行う
+type TierListLikeRepository struct {
+	queries TierListLikeQuerier
+}
+
+// NewTierListLikeRepository は新しいTierListLikeRepositoryを作成
+func NewTierListLikeRepository(queries TierListLikeQuerier) *TierListLikeRepository {
+	return &TierListLikeRepository{
+		queries: queries,
+	}
+}
+
+// Add はティアリストにいいねし、新たにいいねした場合のみいいね数を1増やしてホットスコアを再計算する
+// ティアリストが存在しない場合はNotFoundエラーを返す
+func (r *TierListLikeRepository) Add(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	rows, err := r.queries.AddTierListLike(ctx, db.AddTierListLikeParams{
+		TierListID: pgTierListID,
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.NewNotFoundError("tier list not found", err)
+		}
+		return fmt.Errorf("failed to add tier list like: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgTierListID, 1)
+}
+
+// Remove はティアリストのいいねを取り消し、いいねしていた場合のみいいね数を1減らしてホットスコアを再計算する
+func (r *TierListLikeRepository) Remove(ctx context.Context, userID id.UserID, tierListID id.TierListID) error {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	rows, err := r.queries.RemoveTierListLike(ctx, db.RemoveTierListLikeParams{
+		TierListID: pgTierListID,
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove tier list like: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	return r.addCount(ctx, pgTierListID, -1)
+}
+
+// addCount はランダムに選んだシャードのいいね数に差分を加算し、ホットスコアを再計算する
+// 再計算の前にティアリストの行ロックを取得し、同時に加算された他のシャードのいいね数も再計算に含める
+func (r *TierListLikeRepository) addCount(ctx context.Context, tierListID pgtype.UUID, delta int32) error {
+	if err := r.queries.AddTierListLikeCount(ctx, db.AddTierListLikeCountParams{
+		TierListID: tierListID,
+		Shard:      entity.PickLikeCountShard(),
+		LikeCount:  delta,
+	}); err != nil {
+		return fmt.Errorf("failed to add tier list like count: %w", err)
+	}
+
+	if err := r.queries.LockTierListForHotScore(ctx, tierListID); err != nil {
+		return fmt.Errorf("failed to lock tier list for hot score: %w", err)
+	}
+	if err := r.queries.RefreshTierListHotScore(ctx, tierListID); err != nil {
+		return fmt.Errorf("failed to refresh tier list hot score: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/infrastructure/repository/tier_list_like_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/infrastructure/repository/tier_list_like_repository.go -destination=./apps/like/internal/infrastructure/repository/tier_list_like_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListLikeQuerier is a mock of TierListLikeQuerier interface.
+type MockTierListLikeQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListLikeQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListLikeQuerierMockRecorder is the mock recorder for MockTierListLikeQuerier.
+type MockTierListLikeQuerierMockRecorder struct {
+	mock *MockTierListLikeQuerier
+}
+
+// NewMockTierListLikeQuerier creates a new mock instance.
+func NewMockTierListLikeQuerier(ctrl *gomock.Controller) *MockTierListLikeQuerier {
+	mock := &MockTierListLikeQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListLikeQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListLikeQuerier) EXPECT() *MockTierListLikeQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddTierListLike mocks base method.
+func (m *MockTierListLikeQuerier) AddTierListLike(ctx context.Context, arg db.AddTierListLikeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListLike", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTierListLike indicates an expected call of AddTierListLike.
+func (mr *MockTierListLikeQuerierMockRecorder) AddTierListLike(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListLike", reflect.TypeOf((*MockTierListLikeQuerier)(nil).AddTierListLike), ctx, arg)
+}
+
+// AddTierListLikeCount mocks base method.
+func (m *MockTierListLikeQuerier) AddTierListLikeCount(ctx context.Context, arg db.AddTierListLikeCountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListLikeCount", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTierListLikeCount indicates an expected call of AddTierListLikeCount.
+func (mr *MockTierListLikeQuerierMockRecorder) AddTierListLikeCount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListLikeCount", reflect.TypeOf((*MockTierListLikeQuerier)(nil).AddTierListLikeCount), ctx, arg)
+}
+
+// LockTierListForHotScore mocks base method.
+func (m *MockTierListLikeQuerier) LockTierListForHotScore(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTierListForHotScore", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTierListForHotScore indicates an expected call of LockTierListForHotScore.
+func (mr *MockTierListLikeQuerierMockRecorder) LockTierListForHotScore(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTierListForHotScore", reflect.TypeOf((*MockTierListLikeQuerier)(nil).LockTierListForHotScore), ctx, tierListID)
+}
+
+// RefreshTierListHotScore mocks base method.
+func (m *MockTierListLikeQuerier) RefreshTierListHotScore(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTierListHotScore", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTierListHotScore indicates an expected call of RefreshTierListHotScore.
+func (mr *MockTierListLikeQuerierMockRecorder) RefreshTierListHotScore(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTierListHotScore", reflect.TypeOf((*MockTierListLikeQuerier)(nil).RefreshTierListHotScore), ctx, tierListID)
+}
+
+// RemoveTierListLike mocks base method.
+func (m *MockTierListLikeQuerier) RemoveTierListLike(ctx context.Context, arg db.RemoveTierListLikeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTierListLike", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTierListLike indicates an expected call of RemoveTierListLike.
+func (mr *MockTierListLikeQuerierMockRecorder) RemoveTierListLike(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTierListLike", reflect.TypeOf((*MockTierListLikeQuerier)(nil).RemoveTierListLike), ctx, arg)
+}
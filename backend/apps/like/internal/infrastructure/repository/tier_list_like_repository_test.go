@@ -0,0 +1,182 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/like/internal/domain/entity"
+	"poketier/apps/like/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+var userID = id.NewUserID()
+
+// countDelta はランダムに選ばれたシャードに指定した差分を加算するパラメータに一致する
+func countDelta(delta int32) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		p, ok := x.(db.AddTierListLikeCountParams)
+		return ok && p.Shard >= 0 && p.Shard < entity.LikeCountShards && p.LikeCount == delta
+	})
+}
+
+func TestTierListLikeRepository_Add(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+	params := db.AddTierListLikeParams{
+		TierListID: pgTierListID,
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListLikeQuerier)
+		wantErrType error
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 新たにいいねした場合、いいね数に1加算され、行ロックの取得後にホットスコアが再計算される事",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().AddTierListLike(gomock.Any(), params).Return(int64(1), nil),
+					mockQuerier.EXPECT().AddTierListLikeCount(gomock.Any(), countDelta(1)).Return(nil),
+					mockQuerier.EXPECT().LockTierListForHotScore(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().RefreshTierListHotScore(gomock.Any(), pgTierListID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "正常系: いいね済みの場合、いいね数は変わらない事",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().AddTierListLike(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().AddTierListLike(gomock.Any(), params).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			wantErrType: errs.ErrNotFound,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: いいね数の加算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().AddTierListLike(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListLikeCount(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: ホットスコアの再計算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().AddTierListLike(gomock.Any(), params).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListLikeCount(gomock.Any(), countDelta(1)).Return(nil)
+				mockQuerier.EXPECT().LockTierListForHotScore(gomock.Any(), pgTierListID).Return(nil)
+				mockQuerier.EXPECT().RefreshTierListHotScore(gomock.Any(), pgTierListID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListLikeQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListLikeRepository(mockQuerier)
+
+			// Act
+			err := repo.Add(context.Background(), userID, tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListLikeRepository_Remove(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+	params := db.RemoveTierListLikeParams{
+		TierListID: pgTierListID,
+		UserID:     pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListLikeQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: いいねしていた場合、いいね数から1減算され、ホットスコアが再計算される事",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().RemoveTierListLike(gomock.Any(), params).Return(int64(1), nil),
+					mockQuerier.EXPECT().AddTierListLikeCount(gomock.Any(), countDelta(-1)).Return(nil),
+					mockQuerier.EXPECT().LockTierListForHotScore(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().RefreshTierListHotScore(gomock.Any(), pgTierListID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "正常系: いいねしていない場合、いいね数は変わらない事",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().RemoveTierListLike(gomock.Any(), params).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListLikeQuerier) {
+				mockQuerier.EXPECT().RemoveTierListLike(gomock.Any(), params).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListLikeQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListLikeRepository(mockQuerier)
+
+			// Act
+			err := repo.Remove(context.Background(), userID, tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
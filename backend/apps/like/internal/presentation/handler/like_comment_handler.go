@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LikeCommentHandler struct {
+	uc LikeCommentUseCase
+}
+
+type LikeCommentUseCase interface {
+	Execute(ctx context.Context, params usecase.LikeCommentParams) error
+}
+
+func NewLikeCommentHandler(uc LikeCommentUseCase) *LikeCommentHandler {
+	return &LikeCommentHandler{
+		uc: uc,
+	}
+}
+
+func (h *LikeCommentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.LikeCommentParams{
+		UserID:    userID,
+		CommentID: ctx.Param("comment_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
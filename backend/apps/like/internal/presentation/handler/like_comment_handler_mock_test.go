@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/presentation/handler/like_comment_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/presentation/handler/like_comment_handler.go -destination=./apps/like/internal/presentation/handler/like_comment_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/like/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLikeCommentUseCase is a mock of LikeCommentUseCase interface.
+type MockLikeCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLikeCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLikeCommentUseCaseMockRecorder is the mock recorder for MockLikeCommentUseCase.
+type MockLikeCommentUseCaseMockRecorder struct {
+	mock *MockLikeCommentUseCase
+}
+
+// NewMockLikeCommentUseCase creates a new mock instance.
+func NewMockLikeCommentUseCase(ctrl *gomock.Controller) *MockLikeCommentUseCase {
+	mock := &MockLikeCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockLikeCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLikeCommentUseCase) EXPECT() *MockLikeCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLikeCommentUseCase) Execute(ctx context.Context, params usecase.LikeCommentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLikeCommentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLikeCommentUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/apps/like/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLikeCommentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	targetID := id.NewCommentID().String()
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		mockSetup      func(*MockLikeCommentUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: コメントにいいねし、204が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.LikeCommentParams{
+					UserID:    userID,
+					CommentID: targetID,
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockLikeCommentUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: コメントが存在しない場合、404が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("comment not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockLikeCommentUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewLikeCommentHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPut, "/comments/"+targetID+"/like", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "comment_id", Value: targetID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LikeTierListHandler struct {
+	uc LikeTierListUseCase
+}
+
+type LikeTierListUseCase interface {
+	Execute(ctx context.Context, params usecase.LikeTierListParams) error
+}
+
+func NewLikeTierListHandler(uc LikeTierListUseCase) *LikeTierListHandler {
+	return &LikeTierListHandler{
+		uc: uc,
+	}
+}
+
+func (h *LikeTierListHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.LikeTierListParams{
+		UserID:     userID,
+		TierListID: ctx.Param("tier_list_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/presentation/handler/like_tier_list_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/presentation/handler/like_tier_list_handler.go -destination=./apps/like/internal/presentation/handler/like_tier_list_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/like/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLikeTierListUseCase is a mock of LikeTierListUseCase interface.
+type MockLikeTierListUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLikeTierListUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLikeTierListUseCaseMockRecorder is the mock recorder for MockLikeTierListUseCase.
+type MockLikeTierListUseCaseMockRecorder struct {
+	mock *MockLikeTierListUseCase
+}
+
+// NewMockLikeTierListUseCase creates a new mock instance.
+func NewMockLikeTierListUseCase(ctrl *gomock.Controller) *MockLikeTierListUseCase {
+	mock := &MockLikeTierListUseCase{ctrl: ctrl}
+	mock.recorder = &MockLikeTierListUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLikeTierListUseCase) EXPECT() *MockLikeTierListUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLikeTierListUseCase) Execute(ctx context.Context, params usecase.LikeTierListParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLikeTierListUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLikeTierListUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,139 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/apps/like/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLikeTierListHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	targetID := id.NewTierListID().String()
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		mockSetup      func(*MockLikeTierListUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: ティアリストにいいねし、204が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.LikeTierListParams{
+					UserID:     userID,
+					TierListID: targetID,
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockLikeTierListUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、404が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockLikeTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockLikeTierListUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewLikeTierListHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPut, "/tier-lists/"+targetID+"/like", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "tier_list_id", Value: targetID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
+
+func assertJSONBody(t *testing.T, expected interface{}, actual []byte) {
+	t.Helper()
+
+	var actualBody interface{}
+	err := json.Unmarshal(actual, &actualBody)
+	assert.NoError(t, err, "response body should be valid JSON")
+
+	expectedJSON, err := json.Marshal(expected)
+	assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+	var expectedBody interface{}
+	err = json.Unmarshal(expectedJSON, &expectedBody)
+	assert.NoError(t, err, "expected body should be valid JSON")
+
+	assert.Equal(t, expectedBody, actualBody, "response body should match expected")
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UnlikeCommentHandler struct {
+	uc UnlikeCommentUseCase
+}
+
+type UnlikeCommentUseCase interface {
+	Execute(ctx context.Context, params usecase.UnlikeCommentParams) error
+}
+
+func NewUnlikeCommentHandler(uc UnlikeCommentUseCase) *UnlikeCommentHandler {
+	return &UnlikeCommentHandler{
+		uc: uc,
+	}
+}
+
+func (h *UnlikeCommentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.UnlikeCommentParams{
+		UserID:    userID,
+		CommentID: ctx.Param("comment_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/presentation/handler/unlike_comment_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/presentation/handler/unlike_comment_handler.go -destination=./apps/like/internal/presentation/handler/unlike_comment_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/like/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnlikeCommentUseCase is a mock of UnlikeCommentUseCase interface.
+type MockUnlikeCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnlikeCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnlikeCommentUseCaseMockRecorder is the mock recorder for MockUnlikeCommentUseCase.
+type MockUnlikeCommentUseCaseMockRecorder struct {
+	mock *MockUnlikeCommentUseCase
+}
+
+// NewMockUnlikeCommentUseCase creates a new mock instance.
+func NewMockUnlikeCommentUseCase(ctrl *gomock.Controller) *MockUnlikeCommentUseCase {
+	mock := &MockUnlikeCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockUnlikeCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnlikeCommentUseCase) EXPECT() *MockUnlikeCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnlikeCommentUseCase) Execute(ctx context.Context, params usecase.UnlikeCommentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnlikeCommentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnlikeCommentUseCase)(nil).Execute), ctx, params)
+}
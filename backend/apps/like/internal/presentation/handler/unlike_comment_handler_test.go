@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/apps/like/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnlikeCommentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	targetID := id.NewCommentID().String()
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		mockSetup      func(*MockUnlikeCommentUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: コメントのいいねを取り消し、204が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockUnlikeCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.UnlikeCommentParams{
+					UserID:    userID,
+					CommentID: targetID,
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockUnlikeCommentUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockUnlikeCommentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockUnlikeCommentUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewUnlikeCommentHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodDelete, "/comments/"+targetID+"/like", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "comment_id", Value: targetID}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/like/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UnlikeTierListHandler struct {
+	uc UnlikeTierListUseCase
+}
+
+type UnlikeTierListUseCase interface {
+	Execute(ctx context.Context, params usecase.UnlikeTierListParams) error
+}
+
+func NewUnlikeTierListHandler(uc UnlikeTierListUseCase) *UnlikeTierListHandler {
+	return &UnlikeTierListHandler{
+		uc: uc,
+	}
+}
+
+func (h *UnlikeTierListHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.UnlikeTierListParams{
+		UserID:     userID,
+		TierListID: ctx.Param("tier_list_id"),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/like/internal/presentation/handler/unlike_tier_list_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/like/internal/presentation/handler/unlike_tier_list_handler.go -destination=./apps/like/internal/presentation/handler/unlike_tier_list_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/like/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnlikeTierListUseCase is a mock of UnlikeTierListUseCase interface.
+type MockUnlikeTierListUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnlikeTierListUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUnlikeTierListUseCaseMockRecorder is the mock recorder for MockUnlikeTierListUseCase.
+type MockUnlikeTierListUseCaseMockRecorder struct {
+	mock *MockUnlikeTierListUseCase
+}
+
+// NewMockUnlikeTierListUseCase creates a new mock instance.
+func NewMockUnlikeTierListUseCase(ctrl *gomock.Controller) *MockUnlikeTierListUseCase {
+	mock := &MockUnlikeTierListUseCase{ctrl: ctrl}
+	mock.recorder = &MockUnlikeTierListUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnlikeTierListUseCase) EXPECT() *MockUnlikeTierListUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnlikeTierListUseCase) Execute(ctx context.Context, params usecase.UnlikeTierListParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnlikeTierListUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnlikeTierListUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,99 @@
+//go:build wireinject
+// +build wireinject
+
+package moderation
+
+import (
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/infrastructure/repository"
+	"poketier/apps/moderation/internal/presentation/handler"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeReportContentHandler はReportContentHandlerとその依存関係を初期化します
+func InitializeReportContentHandler(queries db.Querier) *handler.ReportContentHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.ContentQuerier), new(db.Querier)),
+		repository.NewContentRepository,
+		wire.Bind(new(usecase.RCContentRepository), new(*repository.ContentRepository)),
+		wire.Bind(new(repository.ReportQuerier), new(db.Querier)),
+		repository.NewReportRepository,
+		wire.Bind(new(usecase.RCReportRepository), new(*repository.ReportRepository)),
+
+		// Usecase provider
+		usecase.NewReportContentUsecase,
+		wire.Bind(new(handler.ReportContentUseCase), new(*usecase.ReportContentUsecase)),
+
+		// Handler provider
+		handler.NewReportContentHandler,
+	)
+	return &handler.ReportContentHandler{}
+}
+
+// InitializeListReportQueueHandler はListReportQueueHandlerとその依存関係を初期化します
+func InitializeListReportQueueHandler(queries db.Querier) *handler.ListReportQueueHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.ReportQuerier), new(db.Querier)),
+		repository.NewReportRepository,
+		wire.Bind(new(usecase.LRQReportRepository), new(*repository.ReportRepository)),
+
+		// Usecase provider
+		usecase.NewListReportQueueUsecase,
+		wire.Bind(new(handler.ListReportQueueUseCase), new(*usecase.ListReportQueueUsecase)),
+
+		// Handler provider
+		handler.NewListReportQueueHandler,
+	)
+	return &handler.ListReportQueueHandler{}
+}
+
+// InitializeTakeModerationActionHandler はTakeModerationActionHandlerとその依存関係を初期化します
+func InitializeTakeModerationActionHandler(queries db.Querier, txManager *sqlc.TxManager, consensusCache usecase.TMAConsensusCache) *handler.TakeModerationActionHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.ContentQuerier), new(db.Querier)),
+		repository.NewContentRepository,
+		wire.Bind(new(usecase.TMAContentRepository), new(*repository.ContentRepository)),
+		wire.Bind(new(repository.ReportQuerier), new(db.Querier)),
+		repository.NewReportRepository,
+		wire.Bind(new(usecase.TMAReportRepository), new(*repository.ReportRepository)),
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		wire.Bind(new(usecase.TMAUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(repository.ModerationActionQuerier), new(db.Querier)),
+		repository.NewModerationActionRepository,
+		wire.Bind(new(usecase.TMAModerationActionRepository), new(*repository.ModerationActionRepository)),
+		wire.Bind(new(usecase.TMATxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewTakeModerationActionUsecase,
+		wire.Bind(new(handler.TakeModerationActionUseCase), new(*usecase.TakeModerationActionUsecase)),
+
+		// Handler provider
+		handler.NewTakeModerationActionHandler,
+	)
+	return &handler.TakeModerationActionHandler{}
+}
+
+// InitializeListModerationActionsHandler はListModerationActionsHandlerとその依存関係を初期化します
+func InitializeListModerationActionsHandler(queries db.Querier) *handler.ListModerationActionsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.ModerationActionQuerier), new(db.Querier)),
+		repository.NewModerationActionRepository,
+		wire.Bind(new(usecase.LMAModerationActionRepository), new(*repository.ModerationActionRepository)),
+
+		// Usecase provider
+		usecase.NewListModerationActionsUsecase,
+		wire.Bind(new(handler.ListModerationActionsUseCase), new(*usecase.ListModerationActionsUsecase)),
+
+		// Handler provider
+		handler.NewListModerationActionsHandler,
+	)
+	return &handler.ListModerationActionsHandler{}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/role"
+)
+
+// ListModerationActionsParams は監査ログ取得の入力
+// TargetType・TargetID は空文字列の場合は絞り込まない
+// ActorRole は操作する利用者の権限で、モデレーター以上でなければ取得できない
+type ListModerationActionsParams struct {
+	TargetType string
+	TargetID   string
+	Cursor     string
+	Limit      int
+	ActorRole  role.Role
+}
+
+// ListModerationActionsResult はモデレーターの操作の操作日時の新しい順の一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListModerationActionsResult struct {
+	Actions    []LMAAction
+	NextCursor string
+}
+
+// LMAAction は監査ログの1件。ModeratorUserID は管理用トークンでの操作・操作したユーザーが削除された場合 nil
+type LMAAction struct {
+	ActionID        string
+	TargetType      string
+	TargetID        string
+	Action          string
+	ModeratorUserID *string
+	Note            string
+	CreatedAt       time.Time
+}
+
+type LMAModerationActionRepository interface {
+	FindPage(ctx context.Context, query entity.ModerationActionQuery) (*entity.ModerationActionPage, error)
+}
+
+type ListModerationActionsUsecase struct {
+	actionRepo LMAModerationActionRepository
+}
+
+func NewListModerationActionsUsecase(actionRepo LMAModerationActionRepository) *ListModerationActionsUsecase {
+	return &ListModerationActionsUsecase{
+		actionRepo: actionRepo,
+	}
+}
+
+// Execute はモデレーターの操作の監査ログを取得
+func (u *ListModerationActionsUsecase) Execute(ctx context.Context, params ListModerationActionsParams) (*ListModerationActionsResult, error) {
+	// モデレーターの操作の記録を含むため、ルートの保護に加えてユースケースでも権限を確認する
+	if err := policy.Authorize(params.ActorRole, policy.ModerateContent); err != nil {
+		return nil, err
+	}
+
+	query := entity.ModerationActionQuery{
+		Limit: pagination.NormalizeLimit(params.Limit),
+	}
+	if params.TargetType != "" {
+		targetType, err := entity.ParseTargetType(params.TargetType)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid target_type", err)
+		}
+		query.TargetType = &targetType
+	}
+	if params.TargetID != "" {
+		targetID, err := uuid.Parse(params.TargetID)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid target_id", err)
+		}
+		query.TargetID = &targetID
+	}
+	after, err := decodeModerationActionCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	query.After = after
+
+	page, err := u.actionRepo.FindPage(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find moderation action page: %w", err)
+	}
+
+	actions := make([]LMAAction, 0, len(page.Actions))
+	for _, action := range page.Actions {
+		item := LMAAction{
+			ActionID:   action.ID().String(),
+			TargetType: action.TargetType().String(),
+			TargetID:   action.TargetID().String(),
+			Action:     action.Action().String(),
+			Note:       action.Note(),
+			CreatedAt:  action.CreatedAt(),
+		}
+		if moderatorUserID := action.ModeratorUserID(); moderatorUserID != nil {
+			s := moderatorUserID.String()
+			item.ModeratorUserID = &s
+		}
+		actions = append(actions, item)
+	}
+
+	return &ListModerationActionsResult{
+		Actions:    actions,
+		NextCursor: encodeModerationActionCursor(page.Next),
+	}, nil
+}
+
+// decodeModerationActionCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeModerationActionCursor(s string) (*entity.ModerationActionCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	actionID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.ModerationActionCursor{
+		CreatedAt: time.UnixMicro(cursor.SortKey).UTC(),
+		ActionID:  actionID,
+	}, nil
+}
+
+// encodeModerationActionCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeModerationActionCursor(next *entity.ModerationActionCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.CreatedAt.UnixMicro(),
+		ID:      next.ActionID.String(),
+	})
+}
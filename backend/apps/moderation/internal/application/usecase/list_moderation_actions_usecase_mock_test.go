@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/application/usecase/list_moderation_actions_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/application/usecase/list_moderation_actions_usecase.go -destination=./apps/moderation/internal/application/usecase/list_moderation_actions_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/moderation/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLMAModerationActionRepository is a mock of LMAModerationActionRepository interface.
+type MockLMAModerationActionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLMAModerationActionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLMAModerationActionRepositoryMockRecorder is the mock recorder for MockLMAModerationActionRepository.
+type MockLMAModerationActionRepositoryMockRecorder struct {
+	mock *MockLMAModerationActionRepository
+}
+
+// NewMockLMAModerationActionRepository creates a new mock instance.
+func NewMockLMAModerationActionRepository(ctrl *gomock.Controller) *MockLMAModerationActionRepository {
+	mock := &MockLMAModerationActionRepository{ctrl: ctrl}
+	mock.recorder = &MockLMAModerationActionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLMAModerationActionRepository) EXPECT() *MockLMAModerationActionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPage mocks base method.
+func (m *MockLMAModerationActionRepository) FindPage(ctx context.Context, query entity.ModerationActionQuery) (*entity.ModerationActionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.ModerationActionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLMAModerationActionRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLMAModerationActionRepository)(nil).FindPage), ctx, query)
+}
@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListModerationActionsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	moderatorID := id.NewUserID()
+	moderatorIDString := moderatorID.String()
+	actionID := id.NewModerationActionID()
+	banID := id.NewModerationActionID()
+	commentID := uuid.New()
+	bannedUserID := uuid.New()
+	createdAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	bannedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.ModerationActionCursor{CreatedAt: bannedAt, ActionID: banID.UUID()}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: bannedAt.UnixMicro(), ID: banID.String()})
+	targetType := entity.TargetComment
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListModerationActionsParams
+		setupMock   func(*MockLMAModerationActionRepository)
+		wantResult  *usecase.ListModerationActionsResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 監査ログが変換され、次ページのカーソルを返す",
+			params:   usecase.ListModerationActionsParams{Limit: 2, ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLMAModerationActionRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.ModerationActionQuery{Limit: 2}).Return(&entity.ModerationActionPage{
+					Actions: []*entity.ModerationAction{
+						entity.ReconstructModerationAction(actionID, entity.TargetComment, commentID, entity.ActionHide, &moderatorID, "暴言", createdAt),
+						entity.ReconstructModerationAction(banID, entity.TargetUser, bannedUserID, entity.ActionBan, nil, "", bannedAt),
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListModerationActionsResult{
+				Actions: []usecase.LMAAction{
+					{
+						ActionID:        actionID.String(),
+						TargetType:      "comment",
+						TargetID:        commentID.String(),
+						Action:          "hide",
+						ModeratorUserID: &moderatorIDString,
+						Note:            "暴言",
+						CreatedAt:       createdAt,
+					},
+					{
+						ActionID:   banID.String(),
+						TargetType: "user",
+						TargetID:   bannedUserID.String(),
+						Action:     "ban",
+						CreatedAt:  bannedAt,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: 対象とカーソルが検索条件に変換され、最終ページはカーソルが空になる",
+			params:   usecase.ListModerationActionsParams{TargetType: "comment", TargetID: commentID.String(), Cursor: encodedCursor, ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLMAModerationActionRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), entity.ModerationActionQuery{
+					TargetType: &targetType,
+					TargetID:   &commentID,
+					After:      cursor,
+					Limit:      pagination.NormalizeLimit(0),
+				}).Return(&entity.ModerationActionPage{Actions: []*entity.ModerationAction{}}, nil)
+			},
+			wantResult: &usecase.ListModerationActionsResult{
+				Actions: []usecase.LMAAction{},
+			},
+		},
+		{
+			caseName:    "異常系: モデレーター未満の権限で取得した場合、Forbiddenエラーを返す",
+			params:      usecase.ListModerationActionsParams{ActorRole: role.User},
+			setupMock:   func(mockRepo *MockLMAModerationActionRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName:    "異常系: 不正な対象IDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListModerationActionsParams{TargetID: "invalid", ActorRole: role.Moderator},
+			setupMock:   func(mockRepo *MockLMAModerationActionRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListModerationActionsParams{ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLMAModerationActionRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLMAModerationActionRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListModerationActionsUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
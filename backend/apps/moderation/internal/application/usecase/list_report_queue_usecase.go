@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/role"
+)
+
+// ListReportQueueParams はモデレーションキュー取得の入力
+// TargetType・Reason は空文字列の場合は絞り込まない
+// ActorRole は操作する利用者の権限で、モデレーター以上でなければ取得できない
+type ListReportQueueParams struct {
+	TargetType string
+	Reason     string
+	Cursor     string
+	Limit      int
+	ActorRole  role.Role
+}
+
+// ListReportQueueResult は未対応の通報を対象ごとに集約した、最初に通報された日時の古い順の一覧
+// NextCursor は次ページが存在しない場合は空文字列
+type ListReportQueueResult struct {
+	Items      []LRQItem
+	NextCursor string
+}
+
+// LRQItem はモデレーションキューの1件
+// AuthorUserID は匿名で作成されたティアリスト・削除された対象の場合 nil
+type LRQItem struct {
+	TargetType      string
+	TargetID        string
+	ReportCount     int
+	Reasons         []string
+	FirstReportedAt time.Time
+	LastReportedAt  time.Time
+	Content         string
+	AuthorUserID    *string
+	Hidden          bool
+}
+
+type LRQReportRepository interface {
+	FindQueuePage(ctx context.Context, query entity.ReportQueueQuery) (*entity.ReportQueuePage, error)
+}
+
+type ListReportQueueUsecase struct {
+	reportRepo LRQReportRepository
+}
+
+func NewListReportQueueUsecase(reportRepo LRQReportRepository) *ListReportQueueUsecase {
+	return &ListReportQueueUsecase{
+		reportRepo: reportRepo,
+	}
+}
+
+// Execute は未対応の通報を対象ごとに集約したモデレーションキューを取得
+func (u *ListReportQueueUsecase) Execute(ctx context.Context, params ListReportQueueParams) (*ListReportQueueResult, error) {
+	// 通報された内容と作成者を含むため、ルートの保護に加えてユースケースでも権限を確認する
+	if err := policy.Authorize(params.ActorRole, policy.ModerateContent); err != nil {
+		return nil, err
+	}
+
+	query := entity.ReportQueueQuery{
+		Limit: pagination.NormalizeLimit(params.Limit),
+	}
+	if params.TargetType != "" {
+		targetType, err := entity.ParseContentType(params.TargetType)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid target_type", err)
+		}
+		query.TargetType = &targetType
+	}
+	if params.Reason != "" {
+		reason, err := entity.ParseReportReason(params.Reason)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid reason", err)
+		}
+		query.Reason = &reason
+	}
+	after, err := decodeReportQueueCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	query.After = after
+
+	page, err := u.reportRepo.FindQueuePage(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report queue page: %w", err)
+	}
+
+	items := make([]LRQItem, 0, len(page.Items))
+	for _, queueItem := range page.Items {
+		reasons := make([]string, 0, len(queueItem.Reasons))
+		for _, reason := range queueItem.Reasons {
+			reasons = append(reasons, reason.String())
+		}
+		item := LRQItem{
+			TargetType:      queueItem.TargetType.String(),
+			TargetID:        queueItem.TargetID.String(),
+			ReportCount:     queueItem.ReportCount,
+			Reasons:         reasons,
+			FirstReportedAt: queueItem.FirstReportedAt,
+			LastReportedAt:  queueItem.LastReportedAt,
+			Content:         queueItem.Content,
+			Hidden:          queueItem.Hidden,
+		}
+		if queueItem.AuthorUserID != nil {
+			authorUserID := queueItem.AuthorUserID.String()
+			item.AuthorUserID = &authorUserID
+		}
+		items = append(items, item)
+	}
+
+	return &ListReportQueueResult{
+		Items:      items,
+		NextCursor: encodeReportQueueCursor(page.Next),
+	}, nil
+}
+
+// decodeReportQueueCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeReportQueueCursor(s string) (*entity.ReportQueueCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.ReportQueueCursor{
+		FirstReportedAt: time.UnixMicro(cursor.SortKey).UTC(),
+		TargetID:        targetID,
+	}, nil
+}
+
+// encodeReportQueueCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeReportQueueCursor(next *entity.ReportQueueCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.FirstReportedAt.UnixMicro(),
+		ID:      next.TargetID.String(),
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/application/usecase/list_report_queue_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/application/usecase/list_report_queue_usecase.go -destination=./apps/moderation/internal/application/usecase/list_report_queue_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/moderation/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLRQReportRepository is a mock of LRQReportRepository interface.
+type MockLRQReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLRQReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLRQReportRepositoryMockRecorder is the mock recorder for MockLRQReportRepository.
+type MockLRQReportRepositoryMockRecorder struct {
+	mock *MockLRQReportRepository
+}
+
+// NewMockLRQReportRepository creates a new mock instance.
+func NewMockLRQReportRepository(ctrl *gomock.Controller) *MockLRQReportRepository {
+	mock := &MockLRQReportRepository{ctrl: ctrl}
+	mock.recorder = &MockLRQReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLRQReportRepository) EXPECT() *MockLRQReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindQueuePage mocks base method.
+func (m *MockLRQReportRepository) FindQueuePage(ctx context.Context, query entity.ReportQueueQuery) (*entity.ReportQueuePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQueuePage", ctx, query)
+	ret0, _ := ret[0].(*entity.ReportQueuePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQueuePage indicates an expected call of FindQueuePage.
+func (mr *MockLRQReportRepositoryMockRecorder) FindQueuePage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQueuePage", reflect.TypeOf((*MockLRQReportRepository)(nil).FindQueuePage), ctx, query)
+}
@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListReportQueueUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	authorIDString := authorID.String()
+	tierListID := uuid.New()
+	commentID := uuid.New()
+	firstReportedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	lastReportedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	cursor := &entity.ReportQueueCursor{FirstReportedAt: firstReportedAt, TargetID: commentID}
+	encodedCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: firstReportedAt.UnixMicro(), ID: commentID.String()})
+	targetType := entity.TargetComment
+	reason := entity.ReportReasonHarassment
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListReportQueueParams
+		setupMock   func(*MockLRQReportRepository)
+		wantResult  *usecase.ListReportQueueResult
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 対象ごとに集約された通報が変換され、次ページのカーソルを返す",
+			params:   usecase.ListReportQueueParams{Limit: 2, ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLRQReportRepository) {
+				mockRepo.EXPECT().FindQueuePage(gomock.Any(), entity.ReportQueueQuery{Limit: 2}).Return(&entity.ReportQueuePage{
+					Items: []entity.ReportQueueItem{
+						{
+							TargetType:      entity.TargetTierList,
+							TargetID:        tierListID,
+							ReportCount:     3,
+							Reasons:         []entity.ReportReason{entity.ReportReasonInappropriate, entity.ReportReasonSpam},
+							FirstReportedAt: firstReportedAt,
+							LastReportedAt:  lastReportedAt,
+							Content:         "最強デッキランキング",
+							Hidden:          true,
+						},
+						{
+							TargetType:      entity.TargetComment,
+							TargetID:        commentID,
+							ReportCount:     1,
+							Reasons:         []entity.ReportReason{entity.ReportReasonHarassment},
+							FirstReportedAt: firstReportedAt,
+							LastReportedAt:  firstReportedAt,
+							Content:         "暴言",
+							AuthorUserID:    &authorID,
+						},
+					},
+					Next: cursor,
+				}, nil)
+			},
+			wantResult: &usecase.ListReportQueueResult{
+				Items: []usecase.LRQItem{
+					{
+						TargetType:      "tier_list",
+						TargetID:        tierListID.String(),
+						ReportCount:     3,
+						Reasons:         []string{"inappropriate", "spam"},
+						FirstReportedAt: firstReportedAt,
+						LastReportedAt:  lastReportedAt,
+						Content:         "最強デッキランキング",
+						Hidden:          true,
+					},
+					{
+						TargetType:      "comment",
+						TargetID:        commentID.String(),
+						ReportCount:     1,
+						Reasons:         []string{"harassment"},
+						FirstReportedAt: firstReportedAt,
+						LastReportedAt:  firstReportedAt,
+						Content:         "暴言",
+						AuthorUserID:    &authorIDString,
+					},
+				},
+				NextCursor: encodedCursor,
+			},
+		},
+		{
+			caseName: "正常系: 絞り込み条件とカーソルが検索条件に変換され、最終ページはカーソルが空になる",
+			params:   usecase.ListReportQueueParams{TargetType: "comment", Reason: "harassment", Cursor: encodedCursor, Limit: 500, ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLRQReportRepository) {
+				mockRepo.EXPECT().FindQueuePage(gomock.Any(), entity.ReportQueueQuery{
+					TargetType: &targetType,
+					Reason:     &reason,
+					After:      cursor,
+					Limit:      pagination.MaxLimit,
+				}).Return(&entity.ReportQueuePage{Items: []entity.ReportQueueItem{}}, nil)
+			},
+			wantResult: &usecase.ListReportQueueResult{
+				Items: []usecase.LRQItem{},
+			},
+		},
+		{
+			caseName:    "異常系: モデレーター未満の権限で取得した場合、Forbiddenエラーを返す",
+			params:      usecase.ListReportQueueParams{ActorRole: role.User},
+			setupMock:   func(mockRepo *MockLRQReportRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName:    "異常系: 不正な対象の種類が指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListReportQueueParams{TargetType: "user", ActorRole: role.Moderator},
+			setupMock:   func(mockRepo *MockLRQReportRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName:    "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListReportQueueParams{Cursor: pagination.EncodeCursor(pagination.Cursor{ID: "invalid"}), ActorRole: role.Moderator},
+			setupMock:   func(mockRepo *MockLRQReportRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListReportQueueParams{ActorRole: role.Moderator},
+			setupMock: func(mockRepo *MockLRQReportRepository) {
+				mockRepo.EXPECT().FindQueuePage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLRQReportRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			usecase := usecase.NewListReportQueueUsecase(mockRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
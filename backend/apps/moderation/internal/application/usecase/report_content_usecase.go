@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// ReportContentParams はティアリスト・コメント・デッキの通報の入力
+type ReportContentParams struct {
+	UserID     id.UserID
+	TargetType string
+	TargetID   string
+	Reason     string
+	Detail     string
+}
+
+// ReportContentResult は作成した通報
+type ReportContentResult struct {
+	ReportID   string
+	TargetType string
+	TargetID   string
+	Reason     string
+	Detail     string
+	CreatedAt  time.Time
+}
+
+type RCContentRepository interface {
+	FindByID(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error)
+}
+
+type RCReportRepository interface {
+	Create(ctx context.Context, report *entity.Report) error
+}
+
+type ReportContentUsecase struct {
+	contentRepo RCContentRepository
+	reportRepo  RCReportRepository
+}
+
+func NewReportContentUsecase(contentRepo RCContentRepository, reportRepo RCReportRepository) *ReportContentUsecase {
+	return &ReportContentUsecase{
+		contentRepo: contentRepo,
+		reportRepo:  reportRepo,
+	}
+}
+
+// Execute はティアリスト・コメント・デッキを通報する
+// 自分が作成したコンテンツは通報できない。同じ対象に未対応の通報をしている場合は Conflict エラーを返す
+func (u *ReportContentUsecase) Execute(ctx context.Context, params ReportContentParams) (*ReportContentResult, error) {
+	targetType, err := entity.ParseContentType(params.TargetType)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid target_type", err)
+	}
+	targetID, err := uuid.Parse(params.TargetID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid target_id", err)
+	}
+	reason, err := entity.ParseReportReason(params.Reason)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid reason", err)
+	}
+
+	content, err := u.contentRepo.FindByID(ctx, targetType, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find content: %w", err)
+	}
+
+	report, err := entity.NewReport(content, params.UserID, reason, params.Detail, time.Now())
+	if err != nil {
+		if errors.Is(err, entity.ErrReportOwnContent) {
+			return nil, errs.NewValidationError("cannot report your own content", err)
+		}
+		return nil, errs.NewValidationError("invalid detail", err)
+	}
+
+	if err := u.reportRepo.Create(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return &ReportContentResult{
+		ReportID:   report.ID().String(),
+		TargetType: report.TargetType().String(),
+		TargetID:   report.TargetID().String(),
+		Reason:     report.Reason().String(),
+		Detail:     report.Detail(),
+		CreatedAt:  report.CreatedAt(),
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/application/usecase/report_content_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/application/usecase/report_content_usecase.go -destination=./apps/moderation/internal/application/usecase/report_content_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/moderation/internal/domain/entity"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRCContentRepository is a mock of RCContentRepository interface.
+type MockRCContentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRCContentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRCContentRepositoryMockRecorder is the mock recorder for MockRCContentRepository.
+type MockRCContentRepositoryMockRecorder struct {
+	mock *MockRCContentRepository
+}
+
+// NewMockRCContentRepository creates a new mock instance.
+func NewMockRCContentRepository(ctrl *gomock.Controller) *MockRCContentRepository {
+	mock := &MockRCContentRepository{ctrl: ctrl}
+	mock.recorder = &MockRCContentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRCContentRepository) EXPECT() *MockRCContentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockRCContentRepository) FindByID(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, targetType, contentID)
+	ret0, _ := ret[0].(*entity.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRCContentRepositoryMockRecorder) FindByID(ctx, targetType, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRCContentRepository)(nil).FindByID), ctx, targetType, contentID)
+}
+
+// MockRCReportRepository is a mock of RCReportRepository interface.
+type MockRCReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRCReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRCReportRepositoryMockRecorder is the mock recorder for MockRCReportRepository.
+type MockRCReportRepositoryMockRecorder struct {
+	mock *MockRCReportRepository
+}
+
+// NewMockRCReportRepository creates a new mock instance.
+func NewMockRCReportRepository(ctrl *gomock.Controller) *MockRCReportRepository {
+	mock := &MockRCReportRepository{ctrl: ctrl}
+	mock.recorder = &MockRCReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRCReportRepository) EXPECT() *MockRCReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRCReportRepository) Create(ctx context.Context, report *entity.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRCReportRepositoryMockRecorder) Create(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRCReportRepository)(nil).Create), ctx, report)
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportContentUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	authorID := id.NewUserID()
+	commentID := uuid.New()
+	comment := entity.ReconstructContent(entity.TargetComment, commentID, &authorID, nil, nil)
+	ownComment := entity.ReconstructContent(entity.TargetComment, commentID, &userID, nil, nil)
+	params := usecase.ReportContentParams{
+		UserID:     userID,
+		TargetType: "comment",
+		TargetID:   commentID.String(),
+		Reason:     "harassment",
+		Detail:     "  他のユーザーへの暴言  ",
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ReportContentParams
+		setupMock   func(*MockRCContentRepository, *MockRCReportRepository)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: コメントが通報される",
+			params:   params,
+			setupMock: func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {
+				mockContentRepo.EXPECT().FindByID(gomock.Any(), entity.TargetComment, commentID).Return(comment, nil)
+				mockReportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, report *entity.Report) error {
+					assert.Equal(t, userID, report.ReporterUserID(), "reporter should match")
+					return nil
+				})
+			},
+		},
+		{
+			caseName: "異常系: ユーザーは通報の対象にならず、バリデーションエラーを返す",
+			params: usecase.ReportContentParams{
+				UserID: userID, TargetType: "user", TargetID: authorID.String(), Reason: "spam",
+			},
+			setupMock:   func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 不正な理由が指定された場合、バリデーションエラーを返す",
+			params: usecase.ReportContentParams{
+				UserID: userID, TargetType: "comment", TargetID: commentID.String(), Reason: "boring",
+			},
+			setupMock:   func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 自分のコメントを通報した場合、バリデーションエラーを返す",
+			params:   params,
+			setupMock: func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {
+				mockContentRepo.EXPECT().FindByID(gomock.Any(), entity.TargetComment, commentID).Return(ownComment, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 対象が存在しない場合、NotFoundエラーを返す",
+			params:   params,
+			setupMock: func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {
+				mockContentRepo.EXPECT().FindByID(gomock.Any(), entity.TargetComment, commentID).Return(nil, errs.NewNotFoundError("comment not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: 同じ対象に未対応の通報をしている場合、Conflictエラーを返す",
+			params:   params,
+			setupMock: func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {
+				mockContentRepo.EXPECT().FindByID(gomock.Any(), entity.TargetComment, commentID).Return(comment, nil)
+				mockReportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errs.NewConflictError("already reported", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   params,
+			setupMock: func(mockContentRepo *MockRCContentRepository, mockReportRepo *MockRCReportRepository) {
+				mockContentRepo.EXPECT().FindByID(gomock.Any(), entity.TargetComment, commentID).Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockContentRepo := NewMockRCContentRepository(ctrl)
+			mockReportRepo := NewMockRCReportRepository(ctrl)
+			tt.setupMock(mockContentRepo, mockReportRepo)
+
+			usecase := usecase.NewReportContentUsecase(mockContentRepo, mockReportRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.NotEmpty(t, got.ReportID, "report id should be set")
+			assert.Equal(t, "comment", got.TargetType, "target type should match")
+			assert.Equal(t, commentID.String(), got.TargetID, "target id should match")
+			assert.Equal(t, "harassment", got.Reason, "reason should match")
+			assert.Equal(t, "他のユーザーへの暴言", got.Detail, "detail should be trimmed")
+		})
+	}
+}
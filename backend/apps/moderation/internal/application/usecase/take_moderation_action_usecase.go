@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// TakeModerationActionParams はモデレーターの操作の入力
+// ModeratorUserID は管理用トークンでの操作の場合 nil
+// ActorRole は操作する利用者の権限で、モデレーター以上でなければ実行できない
+type TakeModerationActionParams struct {
+	ModeratorUserID *id.UserID
+	ActorRole       role.Role
+	TargetType      string
+	TargetID        string
+	Action          string
+	Note            string
+}
+
+// TakeModerationActionResult は監査ログに記録した操作
+// ResolvedReportCount は操作によって対応済みになった未対応の通報の件数
+type TakeModerationActionResult struct {
+	ActionID            string
+	TargetType          string
+	TargetID            string
+	Action              string
+	ModeratorUserID     *string
+	Note                string
+	ResolvedReportCount int
+	CreatedAt           time.Time
+}
+
+type TMAContentRepository interface {
+	FindByIDForUpdate(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error)
+	UpdateHidden(ctx context.Context, content *entity.Content) error
+}
+
+type TMAReportRepository interface {
+	Resolve(ctx context.Context, targetType entity.TargetType, targetID uuid.UUID, resolution entity.ReportResolution, now time.Time) (int, error)
+}
+
+type TMAUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+	SaveBan(ctx context.Context, user *entity.User) error
+}
+
+type TMAModerationActionRepository interface {
+	Create(ctx context.Context, action *entity.ModerationAction) error
+}
+
+type TMATxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type TMAConsensusCache interface {
+	InvalidateSeason(seasonID id.SeasonID)
+}
+
+type TakeModerationActionUsecase struct {
+	contentRepo TMAContentRepository
+	reportRepo  TMAReportRepository
+	userRepo    TMAUserRepository
+	actionRepo  TMAModerationActionRepository
+	txManager   TMATxManager
+	cache       TMAConsensusCache
+}
+
+func NewTakeModerationActionUsecase(
+	contentRepo TMAContentRepository,
+	reportRepo TMAReportRepository,
+	userRepo TMAUserRepository,
+	actionRepo TMAModerationActionRepository,
+	txManager TMATxManager,
+	cache TMAConsensusCache,
+) *TakeModerationActionUsecase {
+	return &TakeModerationActionUsecase{
+		contentRepo: contentRepo,
+		reportRepo:  reportRepo,
+		userRepo:    userRepo,
+		actionRepo:  actionRepo,
+		txManager:   txManager,
+		cache:       cache,
+	}
+}
+
+// Execute はコンテンツの非表示・復元、通報の却下、ユーザーの利用停止を行い、監査ログに記録する
+// 操作と監査ログの記録は同一トランザクションで行う
+func (u *TakeModerationActionUsecase) Execute(ctx context.Context, params TakeModerationActionParams) (*TakeModerationActionResult, error) {
+	if err := policy.Authorize(params.ActorRole, policy.ModerateContent); err != nil {
+		return nil, err
+	}
+
+	targetType, err := entity.ParseTargetType(params.TargetType)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid target_type", err)
+	}
+	targetID, err := uuid.Parse(params.TargetID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid target_id", err)
+	}
+	actionType, err := entity.ParseActionType(params.Action)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid action", err)
+	}
+
+	now := time.Now()
+	action, err := entity.NewModerationAction(targetType, targetID, actionType, params.ModeratorUserID, params.Note, now)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidActionTarget) {
+			return nil, errs.NewValidationError("action cannot be applied to the target_type", err)
+		}
+		return nil, errs.NewValidationError("invalid note", err)
+	}
+
+	var (
+		content       *entity.Content
+		resolvedCount int
+	)
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		switch actionType {
+		case entity.ActionBan:
+			if err := u.ban(ctx, id.UserIDFromUUID(targetID), now); err != nil {
+				return err
+			}
+		default:
+			// 同時に保存された配置と統計の減算・加算が食い違わないよう、行ロックを取得してから非表示状態を確認する
+			content, err = u.contentRepo.FindByIDForUpdate(ctx, targetType, targetID)
+			if err != nil {
+				return fmt.Errorf("failed to find content: %w", err)
+			}
+			resolvedCount, err = u.moderateContent(ctx, content, actionType, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := u.actionRepo.Create(ctx, action); err != nil {
+			return fmt.Errorf("failed to create moderation action: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 非表示・復元したティアリスト・デッキのシーズンの集計結果は再計算させる
+	if content != nil && actionType != entity.ActionDismiss && content.AffectsConsensus() && content.SeasonID() != nil {
+		u.cache.InvalidateSeason(*content.SeasonID())
+	}
+
+	result := &TakeModerationActionResult{
+		ActionID:            action.ID().String(),
+		TargetType:          action.TargetType().String(),
+		TargetID:            action.TargetID().String(),
+		Action:              action.Action().String(),
+		Note:                action.Note(),
+		ResolvedReportCount: resolvedCount,
+		CreatedAt:           action.CreatedAt(),
+	}
+	if moderatorUserID := action.ModeratorUserID(); moderatorUserID != nil {
+		s := moderatorUserID.String()
+		result.ModeratorUserID = &s
+	}
+	return result, nil
+}
+
+// moderateContent はコンテンツの非表示・復元・通報の却下を行い、対応済みにした通報の件数を返す
+// 非表示にした場合は未対応の通報を対応済みにする。却下する未対応の通報がない場合は Conflict エラーを返す
+func (u *TakeModerationActionUsecase) moderateContent(ctx context.Context, content *entity.Content, actionType entity.ActionType, now time.Time) (int, error) {
+	switch actionType {
+	case entity.ActionHide:
+		if err := content.Hide(now); err != nil {
+			return 0, errs.NewConflictError("content is already hidden", err)
+		}
+		if err := u.contentRepo.UpdateHidden(ctx, content); err != nil {
+			return 0, fmt.Errorf("failed to hide content: %w", err)
+		}
+		resolved, err := u.reportRepo.Resolve(ctx, content.Type(), content.ID(), entity.ReportResolutionHidden, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to resolve reports: %w", err)
+		}
+		return resolved, nil
+	case entity.ActionRestore:
+		if err := content.Restore(); err != nil {
+			return 0, errs.NewConflictError("content is not hidden", err)
+		}
+		if err := u.contentRepo.UpdateHidden(ctx, content); err != nil {
+			return 0, fmt.Errorf("failed to restore content: %w", err)
+		}
+		return 0, nil
+	case entity.ActionDismiss:
+		resolved, err := u.reportRepo.Resolve(ctx, content.Type(), content.ID(), entity.ReportResolutionDismissed, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to resolve reports: %w", err)
+		}
+		if resolved == 0 {
+			return 0, errs.NewConflictError("no open reports to dismiss", nil)
+		}
+		return resolved, nil
+	default:
+		return 0, fmt.Errorf("unsupported content action: %q", actionType)
+	}
+}
+
+// ban はユーザーを利用停止にし、セッションをすべて失効させる
+// モデレーター・管理者は利用停止できず Forbidden エラー、利用停止済みの場合は Conflict エラーを返す
+func (u *TakeModerationActionUsecase) ban(ctx context.Context, userID id.UserID, now time.Time) error {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if err := user.Ban(now); err != nil {
+		if errors.Is(err, entity.ErrCannotBanStaff) {
+			return errs.NewForbiddenError("cannot ban a moderator or admin", err)
+		}
+		return errs.NewConflictError("user is already banned", err)
+	}
+
+	if err := u.userRepo.SaveBan(ctx, user); err != nil {
+		return fmt.Errorf("failed to ban user: %w", err)
+	}
+	return nil
+}
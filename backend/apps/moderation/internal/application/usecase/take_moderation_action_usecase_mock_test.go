@@ -0,0 +1,278 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/application/usecase/take_moderation_action_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/application/usecase/take_moderation_action_usecase.go -destination=./apps/moderation/internal/application/usecase/take_moderation_action_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/moderation/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTMAContentRepository is a mock of TMAContentRepository interface.
+type MockTMAContentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMAContentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTMAContentRepositoryMockRecorder is the mock recorder for MockTMAContentRepository.
+type MockTMAContentRepositoryMockRecorder struct {
+	mock *MockTMAContentRepository
+}
+
+// NewMockTMAContentRepository creates a new mock instance.
+func NewMockTMAContentRepository(ctrl *gomock.Controller) *MockTMAContentRepository {
+	mock := &MockTMAContentRepository{ctrl: ctrl}
+	mock.recorder = &MockTMAContentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMAContentRepository) EXPECT() *MockTMAContentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockTMAContentRepository) FindByIDForUpdate(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, targetType, contentID)
+	ret0, _ := ret[0].(*entity.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockTMAContentRepositoryMockRecorder) FindByIDForUpdate(ctx, targetType, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockTMAContentRepository)(nil).FindByIDForUpdate), ctx, targetType, contentID)
+}
+
+// UpdateHidden mocks base method.
+func (m *MockTMAContentRepository) UpdateHidden(ctx context.Context, content *entity.Content) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHidden", ctx, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHidden indicates an expected call of UpdateHidden.
+func (mr *MockTMAContentRepositoryMockRecorder) UpdateHidden(ctx, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHidden", reflect.TypeOf((*MockTMAContentRepository)(nil).UpdateHidden), ctx, content)
+}
+
+// MockTMAReportRepository is a mock of TMAReportRepository interface.
+type MockTMAReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMAReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTMAReportRepositoryMockRecorder is the mock recorder for MockTMAReportRepository.
+type MockTMAReportRepositoryMockRecorder struct {
+	mock *MockTMAReportRepository
+}
+
+// NewMockTMAReportRepository creates a new mock instance.
+func NewMockTMAReportRepository(ctrl *gomock.Controller) *MockTMAReportRepository {
+	mock := &MockTMAReportRepository{ctrl: ctrl}
+	mock.recorder = &MockTMAReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMAReportRepository) EXPECT() *MockTMAReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockTMAReportRepository) Resolve(ctx context.Context, targetType entity.TargetType, targetID uuid.UUID, resolution entity.ReportResolution, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, targetType, targetID, resolution, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockTMAReportRepositoryMockRecorder) Resolve(ctx, targetType, targetID, resolution, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockTMAReportRepository)(nil).Resolve), ctx, targetType, targetID, resolution, now)
+}
+
+// MockTMAUserRepository is a mock of TMAUserRepository interface.
+type MockTMAUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMAUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTMAUserRepositoryMockRecorder is the mock recorder for MockTMAUserRepository.
+type MockTMAUserRepositoryMockRecorder struct {
+	mock *MockTMAUserRepository
+}
+
+// NewMockTMAUserRepository creates a new mock instance.
+func NewMockTMAUserRepository(ctrl *gomock.Controller) *MockTMAUserRepository {
+	mock := &MockTMAUserRepository{ctrl: ctrl}
+	mock.recorder = &MockTMAUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMAUserRepository) EXPECT() *MockTMAUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockTMAUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTMAUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTMAUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// SaveBan mocks base method.
+func (m *MockTMAUserRepository) SaveBan(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBan", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBan indicates an expected call of SaveBan.
+func (mr *MockTMAUserRepositoryMockRecorder) SaveBan(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBan", reflect.TypeOf((*MockTMAUserRepository)(nil).SaveBan), ctx, user)
+}
+
+// MockTMAModerationActionRepository is a mock of TMAModerationActionRepository interface.
+type MockTMAModerationActionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMAModerationActionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTMAModerationActionRepositoryMockRecorder is the mock recorder for MockTMAModerationActionRepository.
+type MockTMAModerationActionRepositoryMockRecorder struct {
+	mock *MockTMAModerationActionRepository
+}
+
+// NewMockTMAModerationActionRepository creates a new mock instance.
+func NewMockTMAModerationActionRepository(ctrl *gomock.Controller) *MockTMAModerationActionRepository {
+	mock := &MockTMAModerationActionRepository{ctrl: ctrl}
+	mock.recorder = &MockTMAModerationActionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMAModerationActionRepository) EXPECT() *MockTMAModerationActionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTMAModerationActionRepository) Create(ctx context.Context, action *entity.ModerationAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTMAModerationActionRepositoryMockRecorder) Create(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTMAModerationActionRepository)(nil).Create), ctx, action)
+}
+
+// MockTMATxManager is a mock of TMATxManager interface.
+type MockTMATxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMATxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTMATxManagerMockRecorder is the mock recorder for MockTMATxManager.
+type MockTMATxManagerMockRecorder struct {
+	mock *MockTMATxManager
+}
+
+// NewMockTMATxManager creates a new mock instance.
+func NewMockTMATxManager(ctrl *gomock.Controller) *MockTMATxManager {
+	mock := &MockTMATxManager{ctrl: ctrl}
+	mock.recorder = &MockTMATxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMATxManager) EXPECT() *MockTMATxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockTMATxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockTMATxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockTMATxManager)(nil).RunInTx), ctx, fn)
+}
+
+// MockTMAConsensusCache is a mock of TMAConsensusCache interface.
+type MockTMAConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockTMAConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockTMAConsensusCacheMockRecorder is the mock recorder for MockTMAConsensusCache.
+type MockTMAConsensusCacheMockRecorder struct {
+	mock *MockTMAConsensusCache
+}
+
+// NewMockTMAConsensusCache creates a new mock instance.
+func NewMockTMAConsensusCache(ctrl *gomock.Controller) *MockTMAConsensusCache {
+	mock := &MockTMAConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockTMAConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTMAConsensusCache) EXPECT() *MockTMAConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateSeason mocks base method.
+func (m *MockTMAConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateSeason", seasonID)
+}
+
+// InvalidateSeason indicates an expected call of InvalidateSeason.
+func (mr *MockTMAConsensusCacheMockRecorder) InvalidateSeason(seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSeason", reflect.TypeOf((*MockTMAConsensusCache)(nil).InvalidateSeason), seasonID)
+}
@@ -0,0 +1,249 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type tmaMocks struct {
+	contentRepo *MockTMAContentRepository
+	reportRepo  *MockTMAReportRepository
+	userRepo    *MockTMAUserRepository
+	actionRepo  *MockTMAModerationActionRepository
+	txManager   *MockTMATxManager
+	cache       *MockTMAConsensusCache
+}
+
+func (m *tmaMocks) expectTx() {
+	m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func TestTakeModerationActionUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	moderatorID := id.NewUserID()
+	moderatorIDString := moderatorID.String()
+	seasonID := id.NewSeasonID()
+	tierListID := uuid.New()
+	commentID := uuid.New()
+	userID := id.NewUserID()
+	hiddenAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	visibleTierList := func() *entity.Content {
+		return entity.ReconstructContent(entity.TargetTierList, tierListID, nil, &seasonID, nil)
+	}
+	hiddenTierList := func() *entity.Content {
+		return entity.ReconstructContent(entity.TargetTierList, tierListID, nil, &seasonID, &hiddenAt)
+	}
+	visibleComment := func() *entity.Content {
+		return entity.ReconstructContent(entity.TargetComment, commentID, nil, nil, nil)
+	}
+
+	tests := []struct {
+		caseName     string
+		params       usecase.TakeModerationActionParams
+		setupMock    func(*tmaMocks)
+		wantResolved int
+		wantErr      bool
+		wantErrType  error
+	}{
+		{
+			caseName: "正常系: ティアリストを非表示にし、未対応の通報を対応済みにして集計結果を再計算させる",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "tier_list", TargetID: tierListID.String(), Action: "hide", Note: "タイトルが誹謗中傷",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetTierList, tierListID).Return(visibleTierList(), nil)
+				m.contentRepo.EXPECT().UpdateHidden(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, content *entity.Content) error {
+					assert.True(t, content.IsHidden(), "content should be hidden")
+					return nil
+				})
+				m.reportRepo.EXPECT().Resolve(gomock.Any(), entity.TargetTierList, tierListID, entity.ReportResolutionHidden, gomock.Any()).Return(2, nil)
+				m.actionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+			wantResolved: 2,
+		},
+		{
+			caseName: "正常系: 非表示にしたティアリストを復元し、集計結果を再計算させる",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "tier_list", TargetID: tierListID.String(), Action: "restore",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetTierList, tierListID).Return(hiddenTierList(), nil)
+				m.contentRepo.EXPECT().UpdateHidden(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, content *entity.Content) error {
+					assert.False(t, content.IsHidden(), "content should be visible")
+					return nil
+				})
+				m.actionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+		},
+		{
+			caseName: "正常系: コメントへの通報を却下し、集計結果は再計算させない",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "comment", TargetID: commentID.String(), Action: "dismiss",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetComment, commentID).Return(visibleComment(), nil)
+				m.reportRepo.EXPECT().Resolve(gomock.Any(), entity.TargetComment, commentID, entity.ReportResolutionDismissed, gomock.Any()).Return(1, nil)
+				m.actionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantResolved: 1,
+		},
+		{
+			caseName: "正常系: ユーザーを利用停止にする",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "user", TargetID: userID.String(), Action: "ban",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(entity.ReconstructUser(userID, "荒らし", role.User, nil), nil)
+				m.userRepo.EXPECT().SaveBan(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, user *entity.User) error {
+					assert.NotNil(t, user.DisabledAt(), "user should be banned")
+					return nil
+				})
+				m.actionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: モデレーター未満の権限で操作した場合、Forbiddenエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &userID, ActorRole: role.User, TargetType: "tier_list", TargetID: tierListID.String(), Action: "hide",
+			},
+			setupMock:   func(m *tmaMocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName: "異常系: コメントを利用停止の対象にした場合、バリデーションエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "comment", TargetID: commentID.String(), Action: "ban",
+			},
+			setupMock:   func(m *tmaMocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 不正な操作が指定された場合、バリデーションエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "comment", TargetID: commentID.String(), Action: "delete",
+			},
+			setupMock:   func(m *tmaMocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 非表示済みのコンテンツを非表示にした場合、Conflictエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "tier_list", TargetID: tierListID.String(), Action: "hide",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetTierList, tierListID).Return(hiddenTierList(), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: 却下する未対応の通報がない場合、Conflictエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "comment", TargetID: commentID.String(), Action: "dismiss",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetComment, commentID).Return(visibleComment(), nil)
+				m.reportRepo.EXPECT().Resolve(gomock.Any(), entity.TargetComment, commentID, entity.ReportResolutionDismissed, gomock.Any()).Return(0, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+		{
+			caseName: "異常系: モデレーターを利用停止にした場合、Forbiddenエラーを返す",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "user", TargetID: userID.String(), Action: "ban",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(entity.ReconstructUser(userID, "モデレーター", role.Moderator, nil), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName: "異常系: 監査ログの記録でエラーが発生した場合、集計結果は再計算させない",
+			params: usecase.TakeModerationActionParams{
+				ModeratorUserID: &moderatorID, ActorRole: role.Moderator, TargetType: "tier_list", TargetID: tierListID.String(), Action: "hide",
+			},
+			setupMock: func(m *tmaMocks) {
+				m.expectTx()
+				m.contentRepo.EXPECT().FindByIDForUpdate(gomock.Any(), entity.TargetTierList, tierListID).Return(visibleTierList(), nil)
+				m.contentRepo.EXPECT().UpdateHidden(gomock.Any(), gomock.Any()).Return(nil)
+				m.reportRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil)
+				m.actionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &tmaMocks{
+				contentRepo: NewMockTMAContentRepository(ctrl),
+				reportRepo:  NewMockTMAReportRepository(ctrl),
+				userRepo:    NewMockTMAUserRepository(ctrl),
+				actionRepo:  NewMockTMAModerationActionRepository(ctrl),
+				txManager:   NewMockTMATxManager(ctrl),
+				cache:       NewMockTMAConsensusCache(ctrl),
+			}
+			tt.setupMock(m)
+
+			usecase := usecase.NewTakeModerationActionUsecase(m.contentRepo, m.reportRepo, m.userRepo, m.actionRepo, m.txManager, m.cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.NotEmpty(t, got.ActionID, "action id should be set")
+			assert.Equal(t, tt.params.TargetType, got.TargetType, "target type should match")
+			assert.Equal(t, tt.params.TargetID, got.TargetID, "target id should match")
+			assert.Equal(t, tt.params.Action, got.Action, "action should match")
+			assert.Equal(t, &moderatorIDString, got.ModeratorUserID, "moderator should match")
+			assert.Equal(t, tt.params.Note, got.Note, "note should match")
+			assert.Equal(t, tt.wantResolved, got.ResolvedReportCount, "resolved report count should match")
+		})
+	}
+}
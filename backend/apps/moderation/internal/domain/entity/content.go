@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+var (
+	// ErrContentAlreadyHidden は非表示済みのコンテンツの非表示を表す
+	ErrContentAlreadyHidden = errors.New("content is already hidden")
+	// ErrContentNotHidden は非表示にしていないコンテンツの復元を表す
+	ErrContentNotHidden = errors.New("content is not hidden")
+)
+
+// TargetType は通報・モデレーションの対象の種類
+type TargetType string
+
+const (
+	// TargetTierList はティアリスト
+	TargetTierList TargetType = "tier_list"
+	// TargetComment はティアリストへのコメント・返信
+	TargetComment TargetType = "comment"
+	// TargetDeck はユーザーが作成したデッキ
+	TargetDeck TargetType = "deck"
+	// TargetUser はユーザー（利用停止の対象で、通報・非表示の対象にはならない）
+	TargetUser TargetType = "user"
+)
+
+// ParseTargetType は文字列をTargetTypeに変換する
+func ParseTargetType(s string) (TargetType, error) {
+	targetType := TargetType(s)
+	switch targetType {
+	case TargetTierList, TargetComment, TargetDeck, TargetUser:
+		return targetType, nil
+	default:
+		return "", fmt.Errorf("invalid target type: %q", s)
+	}
+}
+
+// ParseContentType は文字列を通報・非表示の対象となるコンテンツの種類に変換する
+func ParseContentType(s string) (TargetType, error) {
+	targetType, err := ParseTargetType(s)
+	if err != nil {
+		return "", err
+	}
+	if !targetType.IsContent() {
+		return "", fmt.Errorf("invalid content type: %q", s)
+	}
+	return targetType, nil
+}
+
+// IsContent は通報・非表示の対象となるティアリスト・コメント・デッキかどうかを返す
+func (t TargetType) IsContent() bool {
+	return t == TargetTierList || t == TargetComment || t == TargetDeck
+}
+
+// String はTargetTypeの文字列表現を返す
+func (t TargetType) String() string {
+	return string(t)
+}
+
+// Content は通報・非表示の対象となるティアリスト・コメント・デッキ
+// 非表示のコンテンツは公開の一覧と集計ティアリストの入力から除外される
+type Content struct {
+	targetType   TargetType
+	id           uuid.UUID
+	authorUserID *id.UserID
+	seasonID     *id.SeasonID
+	hiddenAt     *time.Time
+}
+
+// ReconstructContent は永続化されたデータからContentを復元する
+// authorUserID は匿名で作成されたティアリストの場合、seasonID はコメントの場合 nil
+func ReconstructContent(targetType TargetType, contentID uuid.UUID, authorUserID *id.UserID, seasonID *id.SeasonID, hiddenAt *time.Time) *Content {
+	return &Content{
+		targetType:   targetType,
+		id:           contentID,
+		authorUserID: authorUserID,
+		seasonID:     seasonID,
+		hiddenAt:     hiddenAt,
+	}
+}
+
+// Type はコンテンツの種類を返す
+func (c *Content) Type() TargetType {
+	return c.targetType
+}
+
+// ID はコンテンツのIDを返す
+func (c *Content) ID() uuid.UUID {
+	return c.id
+}
+
+// AuthorUserID は作成したユーザーのIDを返す。匿名で作成された場合は nil
+func (c *Content) AuthorUserID() *id.UserID {
+	return c.authorUserID
+}
+
+// SeasonID はティアリスト・デッキのシーズンIDを返す。コメントの場合は nil
+func (c *Content) SeasonID() *id.SeasonID {
+	return c.seasonID
+}
+
+// HiddenAt は非表示にした日時を返す。非表示にしていない場合は nil
+func (c *Content) HiddenAt() *time.Time {
+	return c.hiddenAt
+}
+
+// IsHidden は非表示かどうかを返す
+func (c *Content) IsHidden() bool {
+	return c.hiddenAt != nil
+}
+
+// IsAuthoredBy は userID のユーザーが作成したコンテンツかどうかを返す
+func (c *Content) IsAuthoredBy(userID id.UserID) bool {
+	return c.authorUserID != nil && c.authorUserID.Equals(userID)
+}
+
+// AffectsConsensus は非表示・復元によって集計ティアリストが変わるコンテンツ（ティアリスト・デッキ）かどうかを返す
+func (c *Content) AffectsConsensus() bool {
+	return c.targetType == TargetTierList || c.targetType == TargetDeck
+}
+
+// Hide はコンテンツを非表示にする。非表示済みの場合は ErrContentAlreadyHidden を返す
+func (c *Content) Hide(now time.Time) error {
+	if c.IsHidden() {
+		return ErrContentAlreadyHidden
+	}
+	c.hiddenAt = &now
+	return nil
+}
+
+// Restore は非表示にしたコンテンツを復元する。非表示にしていない場合は ErrContentNotHidden を返す
+func (c *Content) Restore() error {
+	if !c.IsHidden() {
+		return ErrContentNotHidden
+	}
+	c.hiddenAt = nil
+	return nil
+}
@@ -0,0 +1,124 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+func TestParseContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		input    string
+		want     entity.TargetType
+		wantErr  bool
+	}{
+		{caseName: "正常系: ティアリスト", input: "tier_list", want: entity.TargetTierList},
+		{caseName: "正常系: コメント", input: "comment", want: entity.TargetComment},
+		{caseName: "正常系: デッキ", input: "deck", want: entity.TargetDeck},
+		{caseName: "異常系: ユーザーは通報・非表示の対象にならない", input: "user", wantErr: true},
+		{caseName: "異常系: 未知の種類はエラーになる", input: "season", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.ParseContentType(tt.input)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "target type should match")
+		})
+	}
+}
+
+func TestContent_HideAndRestore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 非表示にしたコンテンツを復元できる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		content := entity.ReconstructContent(entity.TargetComment, uuid.New(), nil, nil, nil)
+
+		// Act & Assert
+		assert.NoError(t, content.Hide(now), "hide should succeed")
+		assert.True(t, content.IsHidden(), "content should be hidden")
+		assert.Equal(t, &now, content.HiddenAt(), "hidden at should match")
+
+		assert.NoError(t, content.Restore(), "restore should succeed")
+		assert.False(t, content.IsHidden(), "content should be visible")
+		assert.Nil(t, content.HiddenAt(), "hidden at should be cleared")
+	})
+
+	t.Run("異常系: 非表示済みのコンテンツは非表示にできない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		content := entity.ReconstructContent(entity.TargetTierList, uuid.New(), nil, nil, &now)
+
+		// Act
+		err := content.Hide(now.Add(time.Hour))
+
+		// Assert
+		assert.ErrorIs(t, err, entity.ErrContentAlreadyHidden, "error should be already hidden")
+		assert.Equal(t, &now, content.HiddenAt(), "hidden at should not change")
+	})
+
+	t.Run("異常系: 非表示にしていないコンテンツは復元できない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		content := entity.ReconstructContent(entity.TargetDeck, uuid.New(), nil, nil, nil)
+
+		// Act
+		err := content.Restore()
+
+		// Assert
+		assert.ErrorIs(t, err, entity.ErrContentNotHidden, "error should be not hidden")
+	})
+}
+
+func TestContent_IsAuthoredBy(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+
+	tests := []struct {
+		caseName     string
+		authorUserID *id.UserID
+		userID       id.UserID
+		want         bool
+	}{
+		{caseName: "正常系: 作成したユーザーの場合は true", authorUserID: &authorID, userID: authorID, want: true},
+		{caseName: "正常系: 他のユーザーの場合は false", authorUserID: &authorID, userID: id.NewUserID(), want: false},
+		{caseName: "正常系: 匿名で作成されたコンテンツの場合は false", authorUserID: nil, userID: authorID, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			content := entity.ReconstructContent(entity.TargetTierList, uuid.New(), tt.authorUserID, nil, nil)
+
+			// Act & Assert
+			assert.Equal(t, tt.want, content.IsAuthoredBy(tt.userID), "authored by should match")
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+const maxActionNoteLength = 1000
+
+var (
+	// ErrInvalidActionTarget は操作と対象の種類の組み合わせが不正なことを表す
+	// 利用停止はユーザー、それ以外の操作はティアリスト・コメント・デッキのみを対象にできる
+	ErrInvalidActionTarget = errors.New("action cannot be applied to the target type")
+	// ErrActionNoteTooLong はメモが長すぎる操作を表す
+	ErrActionNoteTooLong = errors.New("note must be 1000 characters or less")
+)
+
+// ActionType はモデレーターの操作の種類
+type ActionType string
+
+const (
+	// ActionHide はコンテンツの非表示（未対応の通報は対応済みになる）
+	ActionHide ActionType = "hide"
+	// ActionRestore は非表示にしたコンテンツの復元
+	ActionRestore ActionType = "restore"
+	// ActionDismiss は問題なしとしての通報の却下
+	ActionDismiss ActionType = "dismiss"
+	// ActionBan はユーザーの利用停止（ログインできなくなり、セッションはすべて失効する）
+	ActionBan ActionType = "ban"
+)
+
+// ParseActionType は文字列をActionTypeに変換する
+func ParseActionType(s string) (ActionType, error) {
+	action := ActionType(s)
+	switch action {
+	case ActionHide, ActionRestore, ActionDismiss, ActionBan:
+		return action, nil
+	default:
+		return "", fmt.Errorf("invalid action type: %q", s)
+	}
+}
+
+// String はActionTypeの文字列表現を返す
+func (a ActionType) String() string {
+	return string(a)
+}
+
+// ModerationAction は監査ログに記録するモデレーターの操作
+// ModeratorUserID は管理用トークンでの操作の場合 nil となる
+type ModerationAction struct {
+	id              id.ModerationActionID
+	targetType      TargetType
+	targetID        uuid.UUID
+	action          ActionType
+	moderatorUserID *id.UserID
+	note            string
+	createdAt       time.Time
+}
+
+// NewModerationAction は監査ログに記録する操作を作成する
+// 操作と対象の種類の組み合わせが不正な場合は ErrInvalidActionTarget、メモが長すぎる場合は ErrActionNoteTooLong を返す
+func NewModerationAction(targetType TargetType, targetID uuid.UUID, action ActionType, moderatorUserID *id.UserID, note string, now time.Time) (*ModerationAction, error) {
+	if (action == ActionBan) != (targetType == TargetUser) {
+		return nil, ErrInvalidActionTarget
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxActionNoteLength {
+		return nil, ErrActionNoteTooLong
+	}
+
+	return &ModerationAction{
+		id:              id.NewModerationActionID(),
+		targetType:      targetType,
+		targetID:        targetID,
+		action:          action,
+		moderatorUserID: moderatorUserID,
+		note:            note,
+		createdAt:       now,
+	}, nil
+}
+
+// ReconstructModerationAction は永続化されたデータからModerationActionを復元する
+func ReconstructModerationAction(
+	actionID id.ModerationActionID,
+	targetType TargetType,
+	targetID uuid.UUID,
+	action ActionType,
+	moderatorUserID *id.UserID,
+	note string,
+	createdAt time.Time,
+) *ModerationAction {
+	return &ModerationAction{
+		id:              actionID,
+		targetType:      targetType,
+		targetID:        targetID,
+		action:          action,
+		moderatorUserID: moderatorUserID,
+		note:            note,
+		createdAt:       createdAt,
+	}
+}
+
+// ID は操作IDを返す
+func (a *ModerationAction) ID() id.ModerationActionID {
+	return a.id
+}
+
+// TargetType は操作の対象の種類を返す
+func (a *ModerationAction) TargetType() TargetType {
+	return a.targetType
+}
+
+// TargetID は操作の対象のIDを返す
+func (a *ModerationAction) TargetID() uuid.UUID {
+	return a.targetID
+}
+
+// Action は操作の種類を返す
+func (a *ModerationAction) Action() ActionType {
+	return a.action
+}
+
+// ModeratorUserID は操作したユーザーのIDを返す。管理用トークンでの操作の場合は nil
+func (a *ModerationAction) ModeratorUserID() *id.UserID {
+	return a.moderatorUserID
+}
+
+// Note は操作の理由などのメモを返す。メモがない場合は空文字
+func (a *ModerationAction) Note() string {
+	return a.note
+}
+
+// CreatedAt は操作日時を返す
+func (a *ModerationAction) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// ModerationActionQuery は監査ログの検索条件
+// TargetType・TargetID は nil の場合は絞り込まない
+type ModerationActionQuery struct {
+	TargetType *TargetType
+	TargetID   *uuid.UUID
+	After      *ModerationActionCursor
+	Limit      int
+}
+
+// ModerationActionCursor は監査ログの操作日時の新しい順のキーセットページネーションの位置
+type ModerationActionCursor struct {
+	CreatedAt time.Time
+	ActionID  uuid.UUID
+}
+
+// ModerationActionPage は監査ログの1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type ModerationActionPage struct {
+	Actions []*ModerationAction
+	Next    *ModerationActionCursor
+}
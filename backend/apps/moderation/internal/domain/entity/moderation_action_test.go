@@ -0,0 +1,92 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+func TestNewModerationAction(t *testing.T) {
+	t.Parallel()
+
+	moderatorID := id.NewUserID()
+	targetID := uuid.New()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName   string
+		targetType entity.TargetType
+		action     entity.ActionType
+		note       string
+		wantNote   string
+		wantErr    error
+	}{
+		{
+			caseName:   "正常系: コンテンツの非表示が前後の空白を取り除いたメモで作成される",
+			targetType: entity.TargetTierList,
+			action:     entity.ActionHide,
+			note:       "  タイトルが誹謗中傷  ",
+			wantNote:   "タイトルが誹謗中傷",
+		},
+		{
+			caseName:   "正常系: ユーザーの利用停止が作成される",
+			targetType: entity.TargetUser,
+			action:     entity.ActionBan,
+		},
+		{
+			caseName:   "正常系: 1000文字のメモは作成できる",
+			targetType: entity.TargetDeck,
+			action:     entity.ActionDismiss,
+			note:       strings.Repeat("あ", 1000),
+			wantNote:   strings.Repeat("あ", 1000),
+		},
+		{
+			caseName:   "異常系: コンテンツは利用停止の対象にならない",
+			targetType: entity.TargetComment,
+			action:     entity.ActionBan,
+			wantErr:    entity.ErrInvalidActionTarget,
+		},
+		{
+			caseName:   "異常系: ユーザーは非表示の対象にならない",
+			targetType: entity.TargetUser,
+			action:     entity.ActionHide,
+			wantErr:    entity.ErrInvalidActionTarget,
+		},
+		{
+			caseName:   "異常系: 1001文字のメモはエラーになる",
+			targetType: entity.TargetComment,
+			action:     entity.ActionRestore,
+			note:       strings.Repeat("あ", 1001),
+			wantErr:    entity.ErrActionNoteTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			action, err := entity.NewModerationAction(tt.targetType, targetID, tt.action, &moderatorID, tt.note, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.targetType, action.TargetType(), "target type should match")
+			assert.Equal(t, targetID, action.TargetID(), "target id should match")
+			assert.Equal(t, tt.action, action.Action(), "action should match")
+			assert.Equal(t, &moderatorID, action.ModeratorUserID(), "moderator should match")
+			assert.Equal(t, tt.wantNote, action.Note(), "note should be trimmed")
+			assert.Equal(t, now, action.CreatedAt(), "created at should match")
+		})
+	}
+}
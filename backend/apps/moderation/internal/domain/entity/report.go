@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+const maxReportDetailLength = 500
+
+var (
+	// ErrReportOwnContent は自分が作成したコンテンツの通報を表す
+	ErrReportOwnContent = errors.New("cannot report your own content")
+	// ErrReportDetailTooLong は補足の説明が長すぎる通報を表す
+	ErrReportDetailTooLong = errors.New("detail must be 500 characters or less")
+)
+
+// ReportReason は通報の理由
+type ReportReason string
+
+const (
+	// ReportReasonSpam は宣伝・無関係な内容の連投
+	ReportReasonSpam ReportReason = "spam"
+	// ReportReasonHarassment は他のユーザーへの嫌がらせ・誹謗中傷
+	ReportReasonHarassment ReportReason = "harassment"
+	// ReportReasonInappropriate は不適切な表現
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	// ReportReasonOther はその他（補足の説明に理由を書く）
+	ReportReasonOther ReportReason = "other"
+)
+
+// ParseReportReason は文字列をReportReasonに変換する
+func ParseReportReason(s string) (ReportReason, error) {
+	reason := ReportReason(s)
+	switch reason {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonInappropriate, ReportReasonOther:
+		return reason, nil
+	default:
+		return "", fmt.Errorf("invalid report reason: %q", s)
+	}
+}
+
+// String はReportReasonの文字列表現を返す
+func (r ReportReason) String() string {
+	return string(r)
+}
+
+// ReportResolution はモデレーターによる通報への対応結果
+type ReportResolution string
+
+const (
+	// ReportResolutionHidden は対象を非表示にした
+	ReportResolutionHidden ReportResolution = "hidden"
+	// ReportResolutionDismissed は問題なしとして却下した
+	ReportResolutionDismissed ReportResolution = "dismissed"
+)
+
+// String はReportResolutionの文字列表現を返す
+func (r ReportResolution) String() string {
+	return string(r)
+}
+
+// Report はユーザーによるティアリスト・コメント・デッキの通報
+// 同じユーザーは未対応の通報を同じ対象に重ねて作成できない
+type Report struct {
+	id             id.ReportID
+	targetType     TargetType
+	targetID       uuid.UUID
+	reporterUserID id.UserID
+	reason         ReportReason
+	detail         string
+	createdAt      time.Time
+}
+
+// NewReport は content への通報を作成する
+// 自分が作成したコンテンツは ErrReportOwnContent、補足の説明が長すぎる場合は ErrReportDetailTooLong を返す
+func NewReport(content *Content, reporterUserID id.UserID, reason ReportReason, detail string, now time.Time) (*Report, error) {
+	if content.IsAuthoredBy(reporterUserID) {
+		return nil, ErrReportOwnContent
+	}
+	detail = strings.TrimSpace(detail)
+	if utf8.RuneCountInString(detail) > maxReportDetailLength {
+		return nil, ErrReportDetailTooLong
+	}
+
+	return &Report{
+		id:             id.NewReportID(),
+		targetType:     content.Type(),
+		targetID:       content.ID(),
+		reporterUserID: reporterUserID,
+		reason:         reason,
+		detail:         detail,
+		createdAt:      now,
+	}, nil
+}
+
+// ID は通報IDを返す
+func (r *Report) ID() id.ReportID {
+	return r.id
+}
+
+// TargetType は通報した対象の種類を返す
+func (r *Report) TargetType() TargetType {
+	return r.targetType
+}
+
+// TargetID は通報した対象のIDを返す
+func (r *Report) TargetID() uuid.UUID {
+	return r.targetID
+}
+
+// ReporterUserID は通報したユーザーのIDを返す
+func (r *Report) ReporterUserID() id.UserID {
+	return r.reporterUserID
+}
+
+// Reason は通報の理由を返す
+func (r *Report) Reason() ReportReason {
+	return r.reason
+}
+
+// Detail は補足の説明を返す。説明がない場合は空文字
+func (r *Report) Detail() string {
+	return r.detail
+}
+
+// CreatedAt は通報日時を返す
+func (r *Report) CreatedAt() time.Time {
+	return r.createdAt
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"poketier/pkg/vo/id"
+)
+
+// ReportQueueQuery はモデレーションキューの検索条件
+// TargetType・Reason は nil の場合は絞り込まない
+type ReportQueueQuery struct {
+	TargetType *TargetType
+	Reason     *ReportReason
+	After      *ReportQueueCursor
+	Limit      int
+}
+
+// ReportQueueCursor はモデレーションキューの最初に通報された日時の古い順のキーセットページネーションの位置
+type ReportQueueCursor struct {
+	FirstReportedAt time.Time
+	TargetID        uuid.UUID
+}
+
+// ReportQueueItem は未対応の通報を対象ごとに集約したモデレーションキューの1件
+// Content はティアリストのタイトル・コメントの本文・デッキのニックネームで、対象が削除されている場合は空となる
+type ReportQueueItem struct {
+	TargetType      TargetType
+	TargetID        uuid.UUID
+	ReportCount     int
+	Reasons         []ReportReason
+	FirstReportedAt time.Time
+	LastReportedAt  time.Time
+	Content         string
+	AuthorUserID    *id.UserID
+	Hidden          bool
+}
+
+// ReportQueuePage はモデレーションキューの1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type ReportQueuePage struct {
+	Items []ReportQueueItem
+	Next  *ReportQueueCursor
+}
@@ -0,0 +1,78 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+	reporterID := id.NewUserID()
+	contentID := uuid.New()
+	content := entity.ReconstructContent(entity.TargetComment, contentID, &authorID, nil, nil)
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName   string
+		reporterID id.UserID
+		detail     string
+		wantDetail string
+		wantErr    error
+	}{
+		{
+			caseName:   "正常系: 前後の空白を取り除いた補足の説明で通報が作成される",
+			reporterID: reporterID,
+			detail:     "  宣伝のリンクが貼られている  ",
+			wantDetail: "宣伝のリンクが貼られている",
+		},
+		{
+			caseName:   "正常系: 500文字の補足の説明は作成できる",
+			reporterID: reporterID,
+			detail:     strings.Repeat("あ", 500),
+			wantDetail: strings.Repeat("あ", 500),
+		},
+		{
+			caseName:   "異常系: 501文字の補足の説明はエラーになる",
+			reporterID: reporterID,
+			detail:     strings.Repeat("あ", 501),
+			wantErr:    entity.ErrReportDetailTooLong,
+		},
+		{
+			caseName:   "異常系: 自分が作成したコンテンツは通報できない",
+			reporterID: authorID,
+			wantErr:    entity.ErrReportOwnContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			report, err := entity.NewReport(content, tt.reporterID, entity.ReportReasonSpam, tt.detail, now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, entity.TargetComment, report.TargetType(), "target type should match")
+			assert.Equal(t, contentID, report.TargetID(), "target id should match")
+			assert.Equal(t, tt.reporterID, report.ReporterUserID(), "reporter should match")
+			assert.Equal(t, entity.ReportReasonSpam, report.Reason(), "reason should match")
+			assert.Equal(t, tt.wantDetail, report.Detail(), "detail should be trimmed")
+			assert.Equal(t, now, report.CreatedAt(), "created at should match")
+		})
+	}
+}
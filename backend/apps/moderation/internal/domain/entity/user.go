@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+var (
+	// ErrUserAlreadyBanned は利用停止済みのユーザーの利用停止を表す
+	ErrUserAlreadyBanned = errors.New("user is already banned")
+	// ErrCannotBanStaff はモデレーター・管理者の利用停止を表す（権限の変更は管理者が行う）
+	ErrCannotBanStaff = errors.New("cannot ban a moderator or admin")
+)
+
+// User は利用停止の対象となるユーザー
+// 利用停止はユーザーの無効化として記録し、無効化されたユーザーはログイン・トークンの更新ができなくなる
+type User struct {
+	id          id.UserID
+	displayName string
+	role        role.Role
+	disabledAt  *time.Time
+}
+
+// ReconstructUser は永続化されたデータからUserを復元する
+func ReconstructUser(userID id.UserID, displayName string, r role.Role, disabledAt *time.Time) *User {
+	return &User{
+		id:          userID,
+		displayName: displayName,
+		role:        r,
+		disabledAt:  disabledAt,
+	}
+}
+
+// ID はユーザーIDを返す
+func (u *User) ID() id.UserID {
+	return u.id
+}
+
+// DisplayName は表示名を返す
+func (u *User) DisplayName() string {
+	return u.displayName
+}
+
+// Role は権限を返す
+func (u *User) Role() role.Role {
+	return u.role
+}
+
+// DisabledAt は利用停止した日時を返す。利用停止していない場合は nil
+func (u *User) DisabledAt() *time.Time {
+	return u.disabledAt
+}
+
+// Ban はユーザーを利用停止にする
+// モデレーター・管理者は ErrCannotBanStaff、利用停止済みの場合は ErrUserAlreadyBanned を返す
+func (u *User) Ban(now time.Time) error {
+	if u.role != role.User {
+		return ErrCannotBanStaff
+	}
+	if u.disabledAt != nil {
+		return ErrUserAlreadyBanned
+	}
+	u.disabledAt = &now
+	return nil
+}
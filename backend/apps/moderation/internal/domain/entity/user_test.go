@@ -0,0 +1,52 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+func TestUser_Ban(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	bannedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		caseName   string
+		role       role.Role
+		disabledAt *time.Time
+		wantErr    error
+	}{
+		{caseName: "正常系: 一般ユーザーを利用停止にできる", role: role.User},
+		{caseName: "異常系: モデレーターは利用停止にできない", role: role.Moderator, wantErr: entity.ErrCannotBanStaff},
+		{caseName: "異常系: 管理者は利用停止にできない", role: role.Admin, wantErr: entity.ErrCannotBanStaff},
+		{caseName: "異常系: 利用停止済みのユーザーは利用停止にできない", role: role.User, disabledAt: &bannedAt, wantErr: entity.ErrUserAlreadyBanned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			user := entity.ReconstructUser(id.NewUserID(), "ユーザー", tt.role, tt.disabledAt)
+
+			// Act
+			err := user.Ban(now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "error should match")
+				assert.Equal(t, tt.disabledAt, user.DisabledAt(), "disabled at should not change")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, &now, user.DisabledAt(), "disabled at should be set")
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// ContentQuerier はデータベースクエリを定義するインターフェース
+type ContentQuerier interface {
+	GetModerationTarget(ctx context.Context, arg db.GetModerationTargetParams) (db.GetModerationTargetRow, error)
+	GetModerationTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (db.GetModerationTierListForUpdateRow, error)
+	UpdateTierListHiddenAt(ctx context.Context, arg db.UpdateTierListHiddenAtParams) error
+	UpdateTierListCommentHiddenAt(ctx context.Context, arg db.UpdateTierListCommentHiddenAtParams) error
+	UpdateDeckHiddenAt(ctx context.Context, arg db.UpdateDeckHiddenAtParams) error
+	AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error
+	SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error
+}
+
+// ContentRepository は通報・非表示の対象となるティアリスト・コメント・デッキの取得と非表示状態の保存を行う
+type ContentRepository struct {
+	queries ContentQuerier
+}
+
+// NewContentRepository は新しいContentRepositoryを作成
+func NewContentRepository(queries ContentQuerier) *ContentRepository {
+	return &ContentRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定した種類・IDのコンテンツを取得
+func (r *ContentRepository) FindByID(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error) {
+	row, err := r.queries.GetModerationTarget(ctx, db.GetModerationTargetParams{
+		TargetType: targetType.String(),
+		TargetID:   pgtype.UUID{Bytes: contentID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError(targetType.String()+" not found", err)
+		}
+		return nil, fmt.Errorf("failed to get moderation target: %w", err)
+	}
+
+	return toContent(targetType, contentID, row.AuthorUserID, row.SeasonID, row.HiddenAt), nil
+}
+
+// FindByIDForUpdate は指定した種類・IDのコンテンツを取得する（トランザクション内で呼び出す）
+// ティアリストの場合は行ロックを取得し、同時に保存された配置を統計から二重に減算しないようにする
+func (r *ContentRepository) FindByIDForUpdate(ctx context.Context, targetType entity.TargetType, contentID uuid.UUID) (*entity.Content, error) {
+	if targetType != entity.TargetTierList {
+		return r.FindByID(ctx, targetType, contentID)
+	}
+
+	row, err := r.queries.GetModerationTierListForUpdate(ctx, pgtype.UUID{Bytes: contentID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError(targetType.String()+" not found", err)
+		}
+		return nil, fmt.Errorf("failed to get tier list for update: %w", err)
+	}
+
+	return toContent(targetType, contentID, row.AuthorUserID, row.SeasonID, row.HiddenAt), nil
+}
+
+// toContent はDBの列からコンテンツを復元する
+func toContent(targetType entity.TargetType, contentID uuid.UUID, authorUserIDCol, seasonIDCol pgtype.UUID, hiddenAtCol pgtype.Timestamptz) *entity.Content {
+	var authorUserID *id.UserID
+	if authorUserIDCol.Valid {
+		userID := id.UserIDFromUUID(authorUserIDCol.Bytes)
+		authorUserID = &userID
+	}
+	var seasonID *id.SeasonID
+	if seasonIDCol.Valid {
+		parsed := id.SeasonIDFromUUID(seasonIDCol.Bytes)
+		seasonID = &parsed
+	}
+	var hiddenAt *time.Time
+	if hiddenAtCol.Valid {
+		hiddenAt = &hiddenAtCol.Time
+	}
+
+	return entity.ReconstructContent(targetType, contentID, authorUserID, seasonID, hiddenAt)
+}
+
+// UpdateHidden は非表示・復元した後のコンテンツの非表示状態を保存する
+// ティアリストの場合、ティア統計は非表示にする前に配置を減算し、復元した後に加算して集計ティアリストの入力から除外する
+// ティアリストは FindByIDForUpdate で行ロックを取得してから呼び出す
+// 非表示のティアリストは統計の加算・減算の対象外のため、非表示状態が変わった場合にのみ呼び出す
+func (r *ContentRepository) UpdateHidden(ctx context.Context, content *entity.Content) error {
+	pgID := pgtype.UUID{Bytes: content.ID(), Valid: true}
+	var hiddenAt pgtype.Timestamptz
+	if at := content.HiddenAt(); at != nil {
+		hiddenAt = pgtype.Timestamptz{Time: *at, Valid: true}
+	}
+
+	switch content.Type() {
+	case entity.TargetTierList:
+		if content.IsHidden() {
+			if err := r.queries.SubtractTierListFromStatistics(ctx, pgID); err != nil {
+				return fmt.Errorf("failed to subtract tier statistics: %w", err)
+			}
+		}
+		if err := r.queries.UpdateTierListHiddenAt(ctx, db.UpdateTierListHiddenAtParams{TierListID: pgID, HiddenAt: hiddenAt}); err != nil {
+			return fmt.Errorf("failed to update tier list hidden at: %w", err)
+		}
+		if !content.IsHidden() {
+			if err := r.queries.AddTierListToStatistics(ctx, pgID); err != nil {
+				return fmt.Errorf("failed to add tier statistics: %w", err)
+			}
+		}
+	case entity.TargetComment:
+		if err := r.queries.UpdateTierListCommentHiddenAt(ctx, db.UpdateTierListCommentHiddenAtParams{CommentID: pgID, HiddenAt: hiddenAt}); err != nil {
+			return fmt.Errorf("failed to update comment hidden at: %w", err)
+		}
+	case entity.TargetDeck:
+		if err := r.queries.UpdateDeckHiddenAt(ctx, db.UpdateDeckHiddenAtParams{DeckID: pgID, HiddenAt: hiddenAt}); err != nil {
+			return fmt.Errorf("failed to update deck hidden at: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported content type: %q", content.Type())
+	}
+
+	return nil
+}
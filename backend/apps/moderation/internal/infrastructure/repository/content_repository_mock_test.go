@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/infrastructure/repository/content_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/infrastructure/repository/content_repository.go -destination=./apps/moderation/internal/infrastructure/repository/content_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentQuerier is a mock of ContentQuerier interface.
+type MockContentQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockContentQuerierMockRecorder is the mock recorder for MockContentQuerier.
+type MockContentQuerierMockRecorder struct {
+	mock *MockContentQuerier
+}
+
+// NewMockContentQuerier creates a new mock instance.
+func NewMockContentQuerier(ctrl *gomock.Controller) *MockContentQuerier {
+	mock := &MockContentQuerier{ctrl: ctrl}
+	mock.recorder = &MockContentQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentQuerier) EXPECT() *MockContentQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddTierListToStatistics mocks base method.
+func (m *MockContentQuerier) AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListToStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTierListToStatistics indicates an expected call of AddTierListToStatistics.
+func (mr *MockContentQuerierMockRecorder) AddTierListToStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListToStatistics", reflect.TypeOf((*MockContentQuerier)(nil).AddTierListToStatistics), ctx, tierListID)
+}
+
+// GetModerationTarget mocks base method.
+func (m *MockContentQuerier) GetModerationTarget(ctx context.Context, arg db.GetModerationTargetParams) (db.GetModerationTargetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationTarget", ctx, arg)
+	ret0, _ := ret[0].(db.GetModerationTargetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationTarget indicates an expected call of GetModerationTarget.
+func (mr *MockContentQuerierMockRecorder) GetModerationTarget(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationTarget", reflect.TypeOf((*MockContentQuerier)(nil).GetModerationTarget), ctx, arg)
+}
+
+// GetModerationTierListForUpdate mocks base method.
+func (m *MockContentQuerier) GetModerationTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (db.GetModerationTierListForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationTierListForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(db.GetModerationTierListForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationTierListForUpdate indicates an expected call of GetModerationTierListForUpdate.
+func (mr *MockContentQuerierMockRecorder) GetModerationTierListForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationTierListForUpdate", reflect.TypeOf((*MockContentQuerier)(nil).GetModerationTierListForUpdate), ctx, tierListID)
+}
+
+// SubtractTierListFromStatistics mocks base method.
+func (m *MockContentQuerier) SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubtractTierListFromStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubtractTierListFromStatistics indicates an expected call of SubtractTierListFromStatistics.
+func (mr *MockContentQuerierMockRecorder) SubtractTierListFromStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubtractTierListFromStatistics", reflect.TypeOf((*MockContentQuerier)(nil).SubtractTierListFromStatistics), ctx, tierListID)
+}
+
+// UpdateDeckHiddenAt mocks base method.
+func (m *MockContentQuerier) UpdateDeckHiddenAt(ctx context.Context, arg db.UpdateDeckHiddenAtParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeckHiddenAt", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeckHiddenAt indicates an expected call of UpdateDeckHiddenAt.
+func (mr *MockContentQuerierMockRecorder) UpdateDeckHiddenAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeckHiddenAt", reflect.TypeOf((*MockContentQuerier)(nil).UpdateDeckHiddenAt), ctx, arg)
+}
+
+// UpdateTierListCommentHiddenAt mocks base method.
+func (m *MockContentQuerier) UpdateTierListCommentHiddenAt(ctx context.Context, arg db.UpdateTierListCommentHiddenAtParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTierListCommentHiddenAt", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTierListCommentHiddenAt indicates an expected call of UpdateTierListCommentHiddenAt.
+func (mr *MockContentQuerierMockRecorder) UpdateTierListCommentHiddenAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTierListCommentHiddenAt", reflect.TypeOf((*MockContentQuerier)(nil).UpdateTierListCommentHiddenAt), ctx, arg)
+}
+
+// UpdateTierListHiddenAt mocks base method.
+func (m *MockContentQuerier) UpdateTierListHiddenAt(ctx context.Context, arg db.UpdateTierListHiddenAtParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTierListHiddenAt", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTierListHiddenAt indicates an expected call of UpdateTierListHiddenAt.
+func (mr *MockContentQuerierMockRecorder) UpdateTierListHiddenAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTierListHiddenAt", reflect.TypeOf((*MockContentQuerier)(nil).UpdateTierListHiddenAt), ctx, arg)
+}
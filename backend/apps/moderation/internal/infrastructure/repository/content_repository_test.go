@@ -0,0 +1,302 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/apps/moderation/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestContentRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	contentID := uuid.New()
+	authorID := id.NewUserID()
+	seasonID := id.NewSeasonID()
+	hiddenAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	params := db.GetModerationTargetParams{
+		TargetType: "tier_list",
+		TargetID:   pgtype.UUID{Bytes: contentID, Valid: true},
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockContentQuerier)
+		wantAuthorID *id.UserID
+		wantSeasonID *id.SeasonID
+		wantHidden   bool
+		wantErrType  error
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 非表示のティアリストが作成者・シーズンと共に取得される事",
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTarget(gomock.Any(), params).Return(db.GetModerationTargetRow{
+					AuthorUserID: pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+					SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					HiddenAt:     pgtype.Timestamptz{Time: hiddenAt, Valid: true},
+				}, nil)
+			},
+			wantAuthorID: &authorID,
+			wantSeasonID: &seasonID,
+			wantHidden:   true,
+		},
+		{
+			caseName: "正常系: 匿名で作成されたティアリストは作成者が nil になる事",
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTarget(gomock.Any(), params).Return(db.GetModerationTargetRow{
+					SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+				}, nil)
+			},
+			wantSeasonID: &seasonID,
+		},
+		{
+			caseName: "異常系: 対象が存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTarget(gomock.Any(), params).Return(db.GetModerationTargetRow{}, pgx.ErrNoRows)
+			},
+			wantErrType: errs.ErrNotFound,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTarget(gomock.Any(), params).Return(db.GetModerationTargetRow{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockContentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewContentRepository(mockQuerier)
+
+			// Act
+			content, err := repo.FindByID(context.Background(), entity.TargetTierList, contentID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, entity.TargetTierList, content.Type(), "target type should match")
+			assert.Equal(t, contentID, content.ID(), "content id should match")
+			assert.Equal(t, tt.wantAuthorID, content.AuthorUserID(), "author should match")
+			assert.Equal(t, tt.wantSeasonID, content.SeasonID(), "season should match")
+			assert.Equal(t, tt.wantHidden, content.IsHidden(), "hidden should match")
+		})
+	}
+}
+
+func TestContentRepository_FindByIDForUpdate(t *testing.T) {
+	t.Parallel()
+
+	contentID := uuid.New()
+	pgID := pgtype.UUID{Bytes: contentID, Valid: true}
+	authorID := id.NewUserID()
+	seasonID := id.NewSeasonID()
+	hiddenAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		targetType   entity.TargetType
+		setupMock    func(mockQuerier *MockContentQuerier)
+		wantAuthorID *id.UserID
+		wantSeasonID *id.SeasonID
+		wantHidden   bool
+		wantErrType  error
+		expectError  bool
+	}{
+		{
+			caseName:   "正常系: ティアリストは行ロックを取得して取得される事",
+			targetType: entity.TargetTierList,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTierListForUpdate(gomock.Any(), pgID).Return(db.GetModerationTierListForUpdateRow{
+					AuthorUserID: pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+					SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					HiddenAt:     pgtype.Timestamptz{Time: hiddenAt, Valid: true},
+				}, nil)
+			},
+			wantAuthorID: &authorID,
+			wantSeasonID: &seasonID,
+			wantHidden:   true,
+		},
+		{
+			caseName:   "正常系: コメントは統計に関係しないため行ロックを取得せずに取得される事",
+			targetType: entity.TargetComment,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTarget(gomock.Any(), db.GetModerationTargetParams{TargetType: "comment", TargetID: pgID}).Return(db.GetModerationTargetRow{
+					AuthorUserID: pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+				}, nil)
+			},
+			wantAuthorID: &authorID,
+		},
+		{
+			caseName:   "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			targetType: entity.TargetTierList,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTierListForUpdate(gomock.Any(), pgID).Return(db.GetModerationTierListForUpdateRow{}, pgx.ErrNoRows)
+			},
+			wantErrType: errs.ErrNotFound,
+			expectError: true,
+		},
+		{
+			caseName:   "異常系: DBエラーが発生した場合",
+			targetType: entity.TargetTierList,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().GetModerationTierListForUpdate(gomock.Any(), pgID).Return(db.GetModerationTierListForUpdateRow{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockContentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewContentRepository(mockQuerier)
+
+			// Act
+			content, err := repo.FindByIDForUpdate(context.Background(), tt.targetType, contentID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.targetType, content.Type(), "target type should match")
+			assert.Equal(t, contentID, content.ID(), "content id should match")
+			assert.Equal(t, tt.wantAuthorID, content.AuthorUserID(), "author should match")
+			assert.Equal(t, tt.wantSeasonID, content.SeasonID(), "season should match")
+			assert.Equal(t, tt.wantHidden, content.IsHidden(), "hidden should match")
+		})
+	}
+}
+
+func TestContentRepository_UpdateHidden(t *testing.T) {
+	t.Parallel()
+
+	contentID := uuid.New()
+	pgID := pgtype.UUID{Bytes: contentID, Valid: true}
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	hiddenAt := pgtype.Timestamptz{Time: now, Valid: true}
+
+	tests := []struct {
+		caseName    string
+		targetType  entity.TargetType
+		hidden      bool
+		setupMock   func(mockQuerier *MockContentQuerier)
+		expectError bool
+	}{
+		{
+			caseName:   "正常系: ティアリストを非表示にする場合、統計から減算した後に非表示にする事",
+			targetType: entity.TargetTierList,
+			hidden:     true,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(nil),
+					mockQuerier.EXPECT().UpdateTierListHiddenAt(gomock.Any(), db.UpdateTierListHiddenAtParams{TierListID: pgID, HiddenAt: hiddenAt}).Return(nil),
+				)
+			},
+		},
+		{
+			caseName:   "正常系: ティアリストを復元する場合、復元した後に統計に加算する事",
+			targetType: entity.TargetTierList,
+			hidden:     false,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().UpdateTierListHiddenAt(gomock.Any(), db.UpdateTierListHiddenAtParams{TierListID: pgID}).Return(nil),
+					mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), pgID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName:   "正常系: コメントを非表示にする事",
+			targetType: entity.TargetComment,
+			hidden:     true,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().UpdateTierListCommentHiddenAt(gomock.Any(), db.UpdateTierListCommentHiddenAtParams{CommentID: pgID, HiddenAt: hiddenAt}).Return(nil)
+			},
+		},
+		{
+			caseName:   "正常系: デッキを復元する事",
+			targetType: entity.TargetDeck,
+			hidden:     false,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().UpdateDeckHiddenAt(gomock.Any(), db.UpdateDeckHiddenAtParams{DeckID: pgID}).Return(nil)
+			},
+		},
+		{
+			caseName:   "異常系: 統計の減算でDBエラーが発生した場合、非表示にしない事",
+			targetType: entity.TargetTierList,
+			hidden:     true,
+			setupMock: func(mockQuerier *MockContentQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockContentQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewContentRepository(mockQuerier)
+
+			var contentHiddenAt *time.Time
+			if tt.hidden {
+				contentHiddenAt = &now
+			}
+			content := entity.ReconstructContent(tt.targetType, contentID, nil, nil, contentHiddenAt)
+
+			// Act
+			err := repo.UpdateHidden(context.Background(), content)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// ModerationActionQuerier はデータベースクエリを定義するインターフェース
+type ModerationActionQuerier interface {
+	CreateModerationAction(ctx context.Context, arg db.CreateModerationActionParams) error
+	ListModerationActions(ctx context.Context, arg db.ListModerationActionsParams) ([]db.ModerationAction, error)
+}
+
+// ModerationActionRepository はモデレーターの操作の監査ログの永続化を行う
+type ModerationActionRepository struct {
+	queries ModerationActionQuerier
+}
+
+// NewModerationActionRepository は新しいModerationActionRepositoryを作成
+func NewModerationActionRepository(queries ModerationActionQuerier) *ModerationActionRepository {
+	return &ModerationActionRepository{
+		queries: queries,
+	}
+}
+
+// Create は操作を監査ログに記録する
+func (r *ModerationActionRepository) Create(ctx context.Context, action *entity.ModerationAction) error {
+	params := db.CreateModerationActionParams{
+		ActionID:   pgtype.UUID{Bytes: action.ID().UUID(), Valid: true},
+		TargetType: action.TargetType().String(),
+		TargetID:   pgtype.UUID{Bytes: action.TargetID(), Valid: true},
+		Action:     action.Action().String(),
+		Note:       action.Note(),
+		CreatedAt:  pgtype.Timestamptz{Time: action.CreatedAt(), Valid: true},
+	}
+	if moderatorUserID := action.ModeratorUserID(); moderatorUserID != nil {
+		params.ModeratorUserID = pgtype.UUID{Bytes: moderatorUserID.UUID(), Valid: true}
+	}
+
+	if err := r.queries.CreateModerationAction(ctx, params); err != nil {
+		return fmt.Errorf("failed to create moderation action: %w", err)
+	}
+	return nil
+}
+
+// FindPage は監査ログを操作日時の新しい順に1ページ分取得
+func (r *ModerationActionRepository) FindPage(ctx context.Context, query entity.ModerationActionQuery) (*entity.ModerationActionPage, error) {
+	params := db.ListModerationActionsParams{
+		PageLimit: int32(query.Limit + 1), // #nosec G115 -- Limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if query.TargetType != nil {
+		params.TargetType = pgtype.Text{String: query.TargetType.String(), Valid: true}
+	}
+	if query.TargetID != nil {
+		params.TargetID = pgtype.UUID{Bytes: *query.TargetID, Valid: true}
+	}
+	if query.After != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: query.After.CreatedAt, Valid: true}
+		params.CursorActionID = pgtype.UUID{Bytes: query.After.ActionID, Valid: true}
+	}
+
+	rows, err := r.queries.ListModerationActions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+
+	page := &entity.ModerationActionPage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[query.Limit-1]
+		page.Next = &entity.ModerationActionCursor{
+			CreatedAt: last.CreatedAt.Time,
+			ActionID:  last.ActionID.Bytes,
+		}
+	}
+
+	page.Actions = make([]*entity.ModerationAction, 0, len(rows))
+	for _, row := range rows {
+		action, err := r.toEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		page.Actions = append(page.Actions, action)
+	}
+
+	return page, nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *ModerationActionRepository) toEntity(row db.ModerationAction) (*entity.ModerationAction, error) {
+	targetType, err := entity.ParseTargetType(row.TargetType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse target type: %w", err)
+	}
+	action, err := entity.ParseActionType(row.Action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse action type: %w", err)
+	}
+	var moderatorUserID *id.UserID
+	if row.ModeratorUserID.Valid {
+		userID := id.UserIDFromUUID(row.ModeratorUserID.Bytes)
+		moderatorUserID = &userID
+	}
+
+	return entity.ReconstructModerationAction(
+		id.ModerationActionIDFromUUID(row.ActionID.Bytes),
+		targetType,
+		row.TargetID.Bytes,
+		action,
+		moderatorUserID,
+		row.Note,
+		row.CreatedAt.Time,
+	), nil
+}
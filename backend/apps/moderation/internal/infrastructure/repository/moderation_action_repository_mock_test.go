@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/infrastructure/repository/moderation_action_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/infrastructure/repository/moderation_action_repository.go -destination=./apps/moderation/internal/infrastructure/repository/moderation_action_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModerationActionQuerier is a mock of ModerationActionQuerier interface.
+type MockModerationActionQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationActionQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockModerationActionQuerierMockRecorder is the mock recorder for MockModerationActionQuerier.
+type MockModerationActionQuerierMockRecorder struct {
+	mock *MockModerationActionQuerier
+}
+
+// NewMockModerationActionQuerier creates a new mock instance.
+func NewMockModerationActionQuerier(ctrl *gomock.Controller) *MockModerationActionQuerier {
+	mock := &MockModerationActionQuerier{ctrl: ctrl}
+	mock.recorder = &MockModerationActionQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationActionQuerier) EXPECT() *MockModerationActionQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateModerationAction mocks base method.
+func (m *MockModerationActionQuerier) CreateModerationAction(ctx context.Context, arg db.CreateModerationActionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModerationAction", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateModerationAction indicates an expected call of CreateModerationAction.
+func (mr *MockModerationActionQuerierMockRecorder) CreateModerationAction(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModerationAction", reflect.TypeOf((*MockModerationActionQuerier)(nil).CreateModerationAction), ctx, arg)
+}
+
+// ListModerationActions mocks base method.
+func (m *MockModerationActionQuerier) ListModerationActions(ctx context.Context, arg db.ListModerationActionsParams) ([]db.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModerationActions", ctx, arg)
+	ret0, _ := ret[0].([]db.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModerationActions indicates an expected call of ListModerationActions.
+func (mr *MockModerationActionQuerierMockRecorder) ListModerationActions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModerationActions", reflect.TypeOf((*MockModerationActionQuerier)(nil).ListModerationActions), ctx, arg)
+}
@@ -0,0 +1,116 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/apps/moderation/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestModerationActionRepository_FindPage(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	moderatorID := id.NewUserID()
+	targetID := uuid.New()
+	row := func(actionID uuid.UUID, at time.Time, moderator pgtype.UUID) db.ModerationAction {
+		return db.ModerationAction{
+			ActionID:        pgtype.UUID{Bytes: actionID, Valid: true},
+			TargetType:      "comment",
+			TargetID:        pgtype.UUID{Bytes: targetID, Valid: true},
+			Action:          "hide",
+			ModeratorUserID: moderator,
+			Note:            "誹謗中傷",
+			CreatedAt:       pgtype.Timestamptz{Time: at, Valid: true},
+		}
+	}
+	firstID, secondID := uuid.New(), uuid.New()
+	targetType := entity.TargetComment
+
+	tests := []struct {
+		caseName      string
+		query         entity.ModerationActionQuery
+		setupMock     func(mockQuerier *MockModerationActionQuerier)
+		wantModerator *id.UserID
+		wantNext      *entity.ModerationActionCursor
+		expectError   bool
+	}{
+		{
+			caseName: "正常系: 対象で絞り込み、1件多く取得できた場合は次ページのカーソルが返される事",
+			query:    entity.ModerationActionQuery{TargetType: &targetType, TargetID: &targetID, Limit: 1},
+			setupMock: func(mockQuerier *MockModerationActionQuerier) {
+				mockQuerier.EXPECT().ListModerationActions(gomock.Any(), db.ListModerationActionsParams{
+					TargetType: pgtype.Text{String: "comment", Valid: true},
+					TargetID:   pgtype.UUID{Bytes: targetID, Valid: true},
+					PageLimit:  2,
+				}).Return([]db.ModerationAction{
+					row(firstID, createdAt, pgtype.UUID{Bytes: moderatorID.UUID(), Valid: true}),
+					row(secondID, createdAt.Add(-time.Minute), pgtype.UUID{}),
+				}, nil)
+			},
+			wantModerator: &moderatorID,
+			wantNext:      &entity.ModerationActionCursor{CreatedAt: createdAt, ActionID: firstID},
+		},
+		{
+			caseName: "正常系: 管理用トークンでの操作は操作したユーザーが nil になる事",
+			query:    entity.ModerationActionQuery{After: &entity.ModerationActionCursor{CreatedAt: createdAt, ActionID: firstID}, Limit: 20},
+			setupMock: func(mockQuerier *MockModerationActionQuerier) {
+				mockQuerier.EXPECT().ListModerationActions(gomock.Any(), db.ListModerationActionsParams{
+					CursorCreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+					CursorActionID:  pgtype.UUID{Bytes: firstID, Valid: true},
+					PageLimit:       21,
+				}).Return([]db.ModerationAction{row(secondID, createdAt.Add(-time.Minute), pgtype.UUID{})}, nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			query:    entity.ModerationActionQuery{Limit: 20},
+			setupMock: func(mockQuerier *MockModerationActionQuerier) {
+				mockQuerier.EXPECT().ListModerationActions(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockModerationActionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewModerationActionRepository(mockQuerier)
+
+			// Act
+			page, err := repo.FindPage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			require.Len(t, page.Actions, 1, "action count should match")
+			assert.Equal(t, tt.wantNext, page.Next, "next cursor should match")
+
+			action := page.Actions[0]
+			assert.Equal(t, entity.TargetComment, action.TargetType(), "target type should match")
+			assert.Equal(t, entity.ActionHide, action.Action(), "action should match")
+			assert.Equal(t, tt.wantModerator, action.ModeratorUserID(), "moderator should match")
+			assert.Equal(t, "誹謗中傷", action.Note(), "note should match")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// uniqueViolation は一意制約違反のエラーコード（同じ対象に未対応の通報をしている場合）
+const uniqueViolation = "23505"
+
+// ReportQuerier はデータベースクエリを定義するインターフェース
+type ReportQuerier interface {
+	CreateContentReport(ctx context.Context, arg db.CreateContentReportParams) error
+	ResolveContentReports(ctx context.Context, arg db.ResolveContentReportsParams) (int64, error)
+	ListOpenReportTargets(ctx context.Context, arg db.ListOpenReportTargetsParams) ([]db.ListOpenReportTargetsRow, error)
+}
+
+// ReportRepository は通報の永続化とモデレーションキューの取得を行う
+type ReportRepository struct {
+	queries ReportQuerier
+}
+
+// NewReportRepository は新しいReportRepositoryを作成
+func NewReportRepository(queries ReportQuerier) *ReportRepository {
+	return &ReportRepository{
+		queries: queries,
+	}
+}
+
+// Create は通報を保存する
+// 同じユーザーが同じ対象に未対応の通報をしている場合はConflictエラーを返す
+func (r *ReportRepository) Create(ctx context.Context, report *entity.Report) error {
+	if err := r.queries.CreateContentReport(ctx, db.CreateContentReportParams{
+		ReportID:       pgtype.UUID{Bytes: report.ID().UUID(), Valid: true},
+		TargetType:     report.TargetType().String(),
+		TargetID:       pgtype.UUID{Bytes: report.TargetID(), Valid: true},
+		ReporterUserID: pgtype.UUID{Bytes: report.ReporterUserID().UUID(), Valid: true},
+		Reason:         report.Reason().String(),
+		Detail:         report.Detail(),
+		CreatedAt:      pgtype.Timestamptz{Time: report.CreatedAt(), Valid: true},
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return errs.NewConflictError("already reported", err)
+		}
+		return fmt.Errorf("failed to create content report: %w", err)
+	}
+	return nil
+}
+
+// Resolve は対象への未対応の通報をすべて対応済みにし、対応済みにした件数を返す
+func (r *ReportRepository) Resolve(ctx context.Context, targetType entity.TargetType, targetID uuid.UUID, resolution entity.ReportResolution, now time.Time) (int, error) {
+	affected, err := r.queries.ResolveContentReports(ctx, db.ResolveContentReportsParams{
+		TargetType: targetType.String(),
+		TargetID:   pgtype.UUID{Bytes: targetID, Valid: true},
+		Resolution: pgtype.Text{String: resolution.String(), Valid: true},
+		ResolvedAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve content reports: %w", err)
+	}
+	return int(affected), nil
+}
+
+// FindQueuePage は未対応の通報を対象ごとに集約し、最初に通報された日時の古い順に1ページ分取得
+func (r *ReportRepository) FindQueuePage(ctx context.Context, query entity.ReportQueueQuery) (*entity.ReportQueuePage, error) {
+	params := db.ListOpenReportTargetsParams{
+		PageLimit: int32(query.Limit + 1), // #nosec G115 -- Limitはusecaseで上限を丸めている。次ページの有無を判定するため1件多く取得する
+	}
+	if query.TargetType != nil {
+		params.TargetType = pgtype.Text{String: query.TargetType.String(), Valid: true}
+	}
+	if query.Reason != nil {
+		params.Reason = pgtype.Text{String: query.Reason.String(), Valid: true}
+	}
+	if query.After != nil {
+		params.CursorFirstReportedAt = pgtype.Timestamptz{Time: query.After.FirstReportedAt, Valid: true}
+		params.CursorTargetID = pgtype.UUID{Bytes: query.After.TargetID, Valid: true}
+	}
+
+	rows, err := r.queries.ListOpenReportTargets(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open report targets: %w", err)
+	}
+
+	page := &entity.ReportQueuePage{}
+	if len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[query.Limit-1]
+		page.Next = &entity.ReportQueueCursor{
+			FirstReportedAt: last.FirstReportedAt.Time,
+			TargetID:        last.TargetID.Bytes,
+		}
+	}
+
+	page.Items = make([]entity.ReportQueueItem, 0, len(rows))
+	for _, row := range rows {
+		item, err := r.toQueueItem(row)
+		if err != nil {
+			return nil, err
+		}
+		page.Items = append(page.Items, item)
+	}
+
+	return page, nil
+}
+
+// toQueueItem はデータベースの行からモデレーションキューの1件に変換
+func (r *ReportRepository) toQueueItem(row db.ListOpenReportTargetsRow) (entity.ReportQueueItem, error) {
+	targetType, err := entity.ParseContentType(row.TargetType)
+	if err != nil {
+		return entity.ReportQueueItem{}, fmt.Errorf("failed to parse target type: %w", err)
+	}
+	reasons := make([]entity.ReportReason, 0, len(row.Reasons))
+	for _, s := range row.Reasons {
+		reason, err := entity.ParseReportReason(s)
+		if err != nil {
+			return entity.ReportQueueItem{}, fmt.Errorf("failed to parse report reason: %w", err)
+		}
+		reasons = append(reasons, reason)
+	}
+	var authorUserID *id.UserID
+	if row.AuthorUserID.Valid {
+		userID := id.UserIDFromUUID(row.AuthorUserID.Bytes)
+		authorUserID = &userID
+	}
+
+	return entity.ReportQueueItem{
+		TargetType:      targetType,
+		TargetID:        row.TargetID.Bytes,
+		ReportCount:     int(row.ReportCount),
+		Reasons:         reasons,
+		FirstReportedAt: row.FirstReportedAt.Time,
+		LastReportedAt:  row.LastReportedAt.Time,
+		Content:         row.Content,
+		AuthorUserID:    authorUserID,
+		Hidden:          row.HiddenAt.Valid,
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/infrastructure/repository/report_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/infrastructure/repository/report_repository.go -destination=./apps/moderation/internal/infrastructure/repository/report_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportQuerier is a mock of ReportQuerier interface.
+type MockReportQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockReportQuerierMockRecorder is the mock recorder for MockReportQuerier.
+type MockReportQuerierMockRecorder struct {
+	mock *MockReportQuerier
+}
+
+// NewMockReportQuerier creates a new mock instance.
+func NewMockReportQuerier(ctrl *gomock.Controller) *MockReportQuerier {
+	mock := &MockReportQuerier{ctrl: ctrl}
+	mock.recorder = &MockReportQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportQuerier) EXPECT() *MockReportQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateContentReport mocks base method.
+func (m *MockReportQuerier) CreateContentReport(ctx context.Context, arg db.CreateContentReportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContentReport", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateContentReport indicates an expected call of CreateContentReport.
+func (mr *MockReportQuerierMockRecorder) CreateContentReport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContentReport", reflect.TypeOf((*MockReportQuerier)(nil).CreateContentReport), ctx, arg)
+}
+
+// ListOpenReportTargets mocks base method.
+func (m *MockReportQuerier) ListOpenReportTargets(ctx context.Context, arg db.ListOpenReportTargetsParams) ([]db.ListOpenReportTargetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenReportTargets", ctx, arg)
+	ret0, _ := ret[0].([]db.ListOpenReportTargetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenReportTargets indicates an expected call of ListOpenReportTargets.
+func (mr *MockReportQuerierMockRecorder) ListOpenReportTargets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenReportTargets", reflect.TypeOf((*MockReportQuerier)(nil).ListOpenReportTargets), ctx, arg)
+}
+
+// ResolveContentReports mocks base method.
+func (m *MockReportQuerier) ResolveContentReports(ctx context.Context, arg db.ResolveContentReportsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveContentReports", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveContentReports indicates an expected call of ResolveContentReports.
+func (mr *MockReportQuerierMockRecorder) ResolveContentReports(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveContentReports", reflect.TypeOf((*MockReportQuerier)(nil).ResolveContentReports), ctx, arg)
+}
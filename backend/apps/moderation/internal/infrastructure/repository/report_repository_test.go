@@ -0,0 +1,191 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/apps/moderation/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestReportRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	content := entity.ReconstructContent(entity.TargetComment, uuid.New(), nil, nil, nil)
+	report, err := entity.NewReport(content, id.NewUserID(), entity.ReportReasonSpam, "宣伝", time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err, "failed to create report")
+	params := db.CreateContentReportParams{
+		ReportID:       pgtype.UUID{Bytes: report.ID().UUID(), Valid: true},
+		TargetType:     "comment",
+		TargetID:       pgtype.UUID{Bytes: content.ID(), Valid: true},
+		ReporterUserID: pgtype.UUID{Bytes: report.ReporterUserID().UUID(), Valid: true},
+		Reason:         "spam",
+		Detail:         "宣伝",
+		CreatedAt:      pgtype.Timestamptz{Time: report.CreatedAt(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockReportQuerier)
+		wantErrType error
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 通報が保存される事",
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().CreateContentReport(gomock.Any(), params).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: 同じ対象に未対応の通報をしている場合、Conflictエラーになる事",
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().CreateContentReport(gomock.Any(), params).Return(&pgconn.PgError{Code: "23505"})
+			},
+			wantErrType: errs.ErrConflict,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().CreateContentReport(gomock.Any(), params).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockReportQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewReportRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), report)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestReportRepository_FindQueuePage(t *testing.T) {
+	t.Parallel()
+
+	firstReportedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	authorID := id.NewUserID()
+	row := func(targetID uuid.UUID, reportedAt time.Time) db.ListOpenReportTargetsRow {
+		return db.ListOpenReportTargetsRow{
+			TargetType:      "tier_list",
+			TargetID:        pgtype.UUID{Bytes: targetID, Valid: true},
+			ReportCount:     2,
+			Reasons:         []string{"harassment", "spam"},
+			FirstReportedAt: pgtype.Timestamptz{Time: reportedAt, Valid: true},
+			LastReportedAt:  pgtype.Timestamptz{Time: reportedAt.Add(time.Hour), Valid: true},
+			Content:         "最強デッキランキング",
+			AuthorUserID:    pgtype.UUID{Bytes: authorID.UUID(), Valid: true},
+		}
+	}
+	firstID, secondID := uuid.New(), uuid.New()
+	targetType := entity.TargetTierList
+	reason := entity.ReportReasonSpam
+
+	tests := []struct {
+		caseName    string
+		query       entity.ReportQueueQuery
+		setupMock   func(mockQuerier *MockReportQuerier)
+		wantCount   int
+		wantNext    *entity.ReportQueueCursor
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 1件多く取得できた場合、次ページのカーソルが返される事",
+			query:    entity.ReportQueueQuery{TargetType: &targetType, Reason: &reason, Limit: 1},
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().ListOpenReportTargets(gomock.Any(), db.ListOpenReportTargetsParams{
+					TargetType: pgtype.Text{String: "tier_list", Valid: true},
+					Reason:     pgtype.Text{String: "spam", Valid: true},
+					PageLimit:  2,
+				}).Return([]db.ListOpenReportTargetsRow{row(firstID, firstReportedAt), row(secondID, firstReportedAt.Add(time.Minute))}, nil)
+			},
+			wantCount: 1,
+			wantNext:  &entity.ReportQueueCursor{FirstReportedAt: firstReportedAt, TargetID: firstID},
+		},
+		{
+			caseName: "正常系: カーソル以降を取得し、最後のページでは次ページのカーソルが nil になる事",
+			query:    entity.ReportQueueQuery{After: &entity.ReportQueueCursor{FirstReportedAt: firstReportedAt, TargetID: firstID}, Limit: 20},
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().ListOpenReportTargets(gomock.Any(), db.ListOpenReportTargetsParams{
+					CursorFirstReportedAt: pgtype.Timestamptz{Time: firstReportedAt, Valid: true},
+					CursorTargetID:        pgtype.UUID{Bytes: firstID, Valid: true},
+					PageLimit:             21,
+				}).Return([]db.ListOpenReportTargetsRow{row(secondID, firstReportedAt.Add(time.Minute))}, nil)
+			},
+			wantCount: 1,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			query:    entity.ReportQueueQuery{Limit: 20},
+			setupMock: func(mockQuerier *MockReportQuerier) {
+				mockQuerier.EXPECT().ListOpenReportTargets(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockReportQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewReportRepository(mockQuerier)
+
+			// Act
+			page, err := repo.FindQueuePage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			require.Len(t, page.Items, tt.wantCount, "item count should match")
+			assert.Equal(t, tt.wantNext, page.Next, "next cursor should match")
+
+			item := page.Items[0]
+			assert.Equal(t, entity.TargetTierList, item.TargetType, "target type should match")
+			assert.Equal(t, 2, item.ReportCount, "report count should match")
+			assert.Equal(t, []entity.ReportReason{entity.ReportReasonHarassment, entity.ReportReasonSpam}, item.Reasons, "reasons should match")
+			assert.Equal(t, &authorID, item.AuthorUserID, "author should match")
+			assert.False(t, item.Hidden, "item should not be hidden")
+		})
+	}
+}
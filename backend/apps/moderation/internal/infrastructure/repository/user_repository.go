@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"poketier/sqlc/db"
+)
+
+// sessionRevokeReasonAdmin は利用停止によるセッションの失効の理由（管理者による強制ログアウトとして記録する）
+const sessionRevokeReasonAdmin = "admin"
+
+// UserQuerier はデータベースクエリを定義するインターフェース
+type UserQuerier interface {
+	GetUser(ctx context.Context, userID pgtype.UUID) (db.User, error)
+	UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error)
+	RevokeUserSessionsByUser(ctx context.Context, arg db.RevokeUserSessionsByUserParams) (int64, error)
+}
+
+// UserRepository は利用停止の対象となるユーザーの取得と利用停止の保存を行う
+type UserRepository struct {
+	queries UserQuerier
+}
+
+// NewUserRepository は新しいUserRepositoryを作成
+func NewUserRepository(queries UserQuerier) *UserRepository {
+	return &UserRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのユーザーを取得
+func (r *UserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	row, err := r.queries.GetUser(ctx, pgtype.UUID{Bytes: userID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("user not found", err)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	userRole, err := role.ParseRole(row.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user role: %w", err)
+	}
+	var disabledAt *time.Time
+	if row.DisabledAt.Valid {
+		disabledAt = &row.DisabledAt.Time
+	}
+
+	return entity.ReconstructUser(id.UserIDFromUUID(row.UserID.Bytes), row.DisplayName, userRole, disabledAt), nil
+}
+
+// SaveBan は利用停止したユーザーを無効化して保存し、セッションをすべて失効させる
+// 発行済みのアクセストークンは有効期限まで使えるが、トークンの更新・再ログインはできなくなる
+func (r *UserRepository) SaveBan(ctx context.Context, user *entity.User) error {
+	disabledAt := user.DisabledAt()
+	if disabledAt == nil {
+		return fmt.Errorf("user is not banned: %s", user.ID())
+	}
+	pgID := pgtype.UUID{Bytes: user.ID().UUID(), Valid: true}
+
+	if _, err := r.queries.UpdateUser(ctx, db.UpdateUserParams{
+		UserID:      pgID,
+		DisplayName: user.DisplayName(),
+		Role:        user.Role().String(),
+		DisabledAt:  pgtype.Timestamptz{Time: *disabledAt, Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NewNotFoundError("user not found", err)
+		}
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if _, err := r.queries.RevokeUserSessionsByUser(ctx, db.RevokeUserSessionsByUserParams{
+		UserID:        pgID,
+		RevokedAt:     pgtype.Timestamptz{Time: *disabledAt, Valid: true},
+		RevokedReason: pgtype.Text{String: sessionRevokeReasonAdmin, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/infrastructure/repository/user_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/infrastructure/repository/user_repository.go -destination=./apps/moderation/internal/infrastructure/repository/user_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserQuerier is a mock of UserQuerier interface.
+type MockUserQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockUserQuerierMockRecorder is the mock recorder for MockUserQuerier.
+type MockUserQuerierMockRecorder struct {
+	mock *MockUserQuerier
+}
+
+// NewMockUserQuerier creates a new mock instance.
+func NewMockUserQuerier(ctrl *gomock.Controller) *MockUserQuerier {
+	mock := &MockUserQuerier{ctrl: ctrl}
+	mock.recorder = &MockUserQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserQuerier) EXPECT() *MockUserQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetUser mocks base method.
+func (m *MockUserQuerier) GetUser(ctx context.Context, userID pgtype.UUID) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockUserQuerierMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserQuerier)(nil).GetUser), ctx, userID)
+}
+
+// RevokeUserSessionsByUser mocks base method.
+func (m *MockUserQuerier) RevokeUserSessionsByUser(ctx context.Context, arg db.RevokeUserSessionsByUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessionsByUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserSessionsByUser indicates an expected call of RevokeUserSessionsByUser.
+func (mr *MockUserQuerierMockRecorder) RevokeUserSessionsByUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessionsByUser", reflect.TypeOf((*MockUserQuerier)(nil).RevokeUserSessionsByUser), ctx, arg)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserQuerier) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserQuerierMockRecorder) UpdateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserQuerier)(nil).UpdateUser), ctx, arg)
+}
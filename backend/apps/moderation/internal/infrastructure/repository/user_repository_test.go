@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/moderation/internal/domain/entity"
+	"poketier/apps/moderation/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"poketier/sqlc/db"
+)
+
+func TestUserRepository_SaveBan(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	updateParams := db.UpdateUserParams{
+		UserID:      pgID,
+		DisplayName: "荒らし",
+		Role:        "user",
+		DisabledAt:  pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	revokeParams := db.RevokeUserSessionsByUserParams{
+		UserID:        pgID,
+		RevokedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+		RevokedReason: pgtype.Text{String: "admin", Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockUserQuerier)
+		wantErrType error
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ユーザーを無効化し、セッションがすべて失効される事",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().UpdateUser(gomock.Any(), updateParams).Return(db.User{}, nil),
+					mockQuerier.EXPECT().RevokeUserSessionsByUser(gomock.Any(), revokeParams).Return(int64(2), nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().UpdateUser(gomock.Any(), updateParams).Return(db.User{}, pgx.ErrNoRows)
+			},
+			wantErrType: errs.ErrNotFound,
+			expectError: true,
+		},
+		{
+			caseName: "異常系: セッションの失効でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().UpdateUser(gomock.Any(), updateParams).Return(db.User{}, nil)
+				mockQuerier.EXPECT().RevokeUserSessionsByUser(gomock.Any(), revokeParams).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockUserQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewUserRepository(mockQuerier)
+			user := entity.ReconstructUser(userID, "荒らし", role.User, &now)
+
+			// Act
+			err := repo.SaveBan(context.Background(), user)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
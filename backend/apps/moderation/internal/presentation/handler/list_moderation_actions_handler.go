@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/request"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListModerationActionsHandler struct {
+	uc ListModerationActionsUseCase
+}
+
+type ListModerationActionsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListModerationActionsParams) (*usecase.ListModerationActionsResult, error)
+}
+
+func NewListModerationActionsHandler(uc ListModerationActionsUseCase) *ListModerationActionsHandler {
+	return &ListModerationActionsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListModerationActionsHandler) Handle(ctx *gin.Context) {
+	var req request.ListModerationActionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListModerationActionsParams{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+		ActorRole:  auth.RoleFromContext(ctx.Request.Context()),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListModerationActionsResponse(result))
+}
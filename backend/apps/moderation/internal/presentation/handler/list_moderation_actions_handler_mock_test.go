@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/presentation/handler/list_moderation_actions_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/presentation/handler/list_moderation_actions_handler.go -destination=./apps/moderation/internal/presentation/handler/list_moderation_actions_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/moderation/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListModerationActionsUseCase is a mock of ListModerationActionsUseCase interface.
+type MockListModerationActionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListModerationActionsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListModerationActionsUseCaseMockRecorder is the mock recorder for MockListModerationActionsUseCase.
+type MockListModerationActionsUseCaseMockRecorder struct {
+	mock *MockListModerationActionsUseCase
+}
+
+// NewMockListModerationActionsUseCase creates a new mock instance.
+func NewMockListModerationActionsUseCase(ctrl *gomock.Controller) *MockListModerationActionsUseCase {
+	mock := &MockListModerationActionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListModerationActionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListModerationActionsUseCase) EXPECT() *MockListModerationActionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListModerationActionsUseCase) Execute(ctx context.Context, params usecase.ListModerationActionsParams) (*usecase.ListModerationActionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListModerationActionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListModerationActionsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListModerationActionsUseCase)(nil).Execute), ctx, params)
+}
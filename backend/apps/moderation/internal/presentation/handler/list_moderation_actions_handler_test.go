@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/handler"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListModerationActionsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	actionID := id.NewModerationActionID().String()
+	userID := uuid.New().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		query          string
+		mockSetup      func(*MockListModerationActionsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 対象を渡し、200で監査ログが返される",
+			query:    "?target_type=user&target_id=" + userID,
+			mockSetup: func(mockUC *MockListModerationActionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListModerationActionsParams{
+					TargetType: "user",
+					TargetID:   userID,
+					ActorRole:  role.Admin,
+				}).Return(&usecase.ListModerationActionsResult{
+					Actions: []usecase.LMAAction{
+						{
+							ActionID:   actionID,
+							TargetType: "user",
+							TargetID:   userID,
+							Action:     "ban",
+							CreatedAt:  createdAt,
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListModerationActionsResponse{
+				Actions: []response.ModerationActionResponse{
+					{
+						ActionID:   actionID,
+						TargetType: "user",
+						TargetID:   userID,
+						Action:     "ban",
+						CreatedAt:  createdAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: 不正な対象IDが指定された場合、400が返される",
+			query:    "?target_id=invalid",
+			mockSetup: func(mockUC *MockListModerationActionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewValidationError("invalid target_id", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			query:    "",
+			mockSetup: func(mockUC *MockListModerationActionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListModerationActionsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListModerationActionsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/moderation-actions"+tt.query, nil)
+			// 管理用トークンでの取得
+			c.Request = c.Request.WithContext(auth.WithService(c.Request.Context(), role.Admin))
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/request"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListReportQueueHandler struct {
+	uc ListReportQueueUseCase
+}
+
+type ListReportQueueUseCase interface {
+	Execute(ctx context.Context, params usecase.ListReportQueueParams) (*usecase.ListReportQueueResult, error)
+}
+
+func NewListReportQueueHandler(uc ListReportQueueUseCase) *ListReportQueueHandler {
+	return &ListReportQueueHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListReportQueueHandler) Handle(ctx *gin.Context) {
+	var req request.ListReportQueueRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListReportQueueParams{
+		TargetType: req.TargetType,
+		Reason:     req.Reason,
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+		ActorRole:  auth.RoleFromContext(ctx.Request.Context()),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListReportQueueResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/presentation/handler/list_report_queue_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/presentation/handler/list_report_queue_handler.go -destination=./apps/moderation/internal/presentation/handler/list_report_queue_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/moderation/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListReportQueueUseCase is a mock of ListReportQueueUseCase interface.
+type MockListReportQueueUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListReportQueueUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListReportQueueUseCaseMockRecorder is the mock recorder for MockListReportQueueUseCase.
+type MockListReportQueueUseCaseMockRecorder struct {
+	mock *MockListReportQueueUseCase
+}
+
+// NewMockListReportQueueUseCase creates a new mock instance.
+func NewMockListReportQueueUseCase(ctrl *gomock.Controller) *MockListReportQueueUseCase {
+	mock := &MockListReportQueueUseCase{ctrl: ctrl}
+	mock.recorder = &MockListReportQueueUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListReportQueueUseCase) EXPECT() *MockListReportQueueUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListReportQueueUseCase) Execute(ctx context.Context, params usecase.ListReportQueueParams) (*usecase.ListReportQueueResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListReportQueueResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListReportQueueUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListReportQueueUseCase)(nil).Execute), ctx, params)
+}
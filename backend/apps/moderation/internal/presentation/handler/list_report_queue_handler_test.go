@@ -0,0 +1,132 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/handler"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListReportQueueHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	commentID := uuid.New().String()
+	reportedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	nextCursor := "next-cursor"
+
+	tests := []struct {
+		caseName       string
+		query          string
+		mockSetup      func(*MockListReportQueueUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 絞り込み条件を渡し、200でモデレーションキューが返される",
+			query:    "?target_type=comment&reason=harassment&cursor=cursor&limit=10",
+			mockSetup: func(mockUC *MockListReportQueueUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListReportQueueParams{
+					TargetType: "comment",
+					Reason:     "harassment",
+					Cursor:     "cursor",
+					Limit:      10,
+					ActorRole:  role.Admin,
+				}).Return(&usecase.ListReportQueueResult{
+					Items: []usecase.LRQItem{
+						{
+							TargetType:      "comment",
+							TargetID:        commentID,
+							ReportCount:     1,
+							Reasons:         []string{"harassment"},
+							FirstReportedAt: reportedAt,
+							LastReportedAt:  reportedAt,
+							Content:         "暴言",
+						},
+					},
+					NextCursor: nextCursor,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListReportQueueResponse{
+				Items: []response.LRQItem{
+					{
+						TargetType:      "comment",
+						TargetID:        commentID,
+						ReportCount:     1,
+						Reasons:         []string{"harassment"},
+						FirstReportedAt: reportedAt,
+						LastReportedAt:  reportedAt,
+						Content:         "暴言",
+					},
+				},
+				NextCursor: &nextCursor,
+			},
+		},
+		{
+			caseName:       "異常系: 上限を超える件数が指定された場合、400が返される",
+			query:          "?limit=101",
+			mockSetup:      func(mockUC *MockListReportQueueUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			query:    "",
+			mockSetup: func(mockUC *MockListReportQueueUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListReportQueueUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListReportQueueHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/reports"+tt.query, nil)
+			// 管理用トークンでの取得
+			c.Request = c.Request.WithContext(auth.WithService(c.Request.Context(), role.Admin))
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
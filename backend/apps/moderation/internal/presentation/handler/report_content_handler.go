@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/request"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportContentHandler struct {
+	uc ReportContentUseCase
+}
+
+type ReportContentUseCase interface {
+	Execute(ctx context.Context, params usecase.ReportContentParams) (*usecase.ReportContentResult, error)
+}
+
+func NewReportContentHandler(uc ReportContentUseCase) *ReportContentHandler {
+	return &ReportContentHandler{
+		uc: uc,
+	}
+}
+
+func (h *ReportContentHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.ReportContentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ReportContentParams{
+		UserID:     userID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Detail:     req.Detail,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewReportResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/presentation/handler/report_content_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/presentation/handler/report_content_handler.go -destination=./apps/moderation/internal/presentation/handler/report_content_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/moderation/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportContentUseCase is a mock of ReportContentUseCase interface.
+type MockReportContentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportContentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReportContentUseCaseMockRecorder is the mock recorder for MockReportContentUseCase.
+type MockReportContentUseCaseMockRecorder struct {
+	mock *MockReportContentUseCase
+}
+
+// NewMockReportContentUseCase creates a new mock instance.
+func NewMockReportContentUseCase(ctrl *gomock.Controller) *MockReportContentUseCase {
+	mock := &MockReportContentUseCase{ctrl: ctrl}
+	mock.recorder = &MockReportContentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportContentUseCase) EXPECT() *MockReportContentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReportContentUseCase) Execute(ctx context.Context, params usecase.ReportContentParams) (*usecase.ReportContentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ReportContentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReportContentUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReportContentUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,174 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/handler"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportContentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	reportID := id.NewReportID().String()
+	commentID := uuid.New().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	body := `{"target_type":"comment","target_id":"` + commentID + `","reason":"harassment","detail":"暴言"}`
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		body           string
+		mockSetup      func(*MockReportContentUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: コメントを通報し、201で作成した通報が返される",
+			loggedIn: true,
+			body:     body,
+			mockSetup: func(mockUC *MockReportContentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ReportContentParams{
+					UserID:     userID,
+					TargetType: "comment",
+					TargetID:   commentID,
+					Reason:     "harassment",
+					Detail:     "暴言",
+				}).Return(&usecase.ReportContentResult{
+					ReportID:   reportID,
+					TargetType: "comment",
+					TargetID:   commentID,
+					Reason:     "harassment",
+					Detail:     "暴言",
+					CreatedAt:  createdAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: response.ReportResponse{
+				ReportID:   reportID,
+				TargetType: "comment",
+				TargetID:   commentID,
+				Reason:     "harassment",
+				Detail:     "暴言",
+				CreatedAt:  createdAt,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			body:           body,
+			mockSetup:      func(mockUC *MockReportContentUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: 理由が指定されていない場合、400が返される",
+			loggedIn:       true,
+			body:           `{"target_type":"comment","target_id":"` + commentID + `"}`,
+			mockSetup:      func(mockUC *MockReportContentUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: 同じ対象に未対応の通報をしている場合、409が返される",
+			loggedIn: true,
+			body:     body,
+			mockSetup: func(mockUC *MockReportContentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewConflictError("already reported", nil))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			body:     body,
+			mockSetup: func(mockUC *MockReportContentUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockReportContentUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewReportContentHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/reports", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody != nil {
+				assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+			}
+		})
+	}
+}
+
+func assertJSONBody(t *testing.T, expected interface{}, actual []byte) {
+	t.Helper()
+
+	var actualBody interface{}
+	err := json.Unmarshal(actual, &actualBody)
+	assert.NoError(t, err, "response body should be valid JSON")
+
+	expectedJSON, err := json.Marshal(expected)
+	assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+	var expectedBody interface{}
+	err = json.Unmarshal(expectedJSON, &expectedBody)
+	assert.NoError(t, err, "expected body should be valid JSON")
+
+	assert.Equal(t, expectedBody, actualBody, "response body should match expected")
+}
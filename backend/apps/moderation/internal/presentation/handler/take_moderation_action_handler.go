@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/request"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TakeModerationActionHandler struct {
+	uc TakeModerationActionUseCase
+}
+
+type TakeModerationActionUseCase interface {
+	Execute(ctx context.Context, params usecase.TakeModerationActionParams) (*usecase.TakeModerationActionResult, error)
+}
+
+func NewTakeModerationActionHandler(uc TakeModerationActionUseCase) *TakeModerationActionHandler {
+	return &TakeModerationActionHandler{
+		uc: uc,
+	}
+}
+
+func (h *TakeModerationActionHandler) Handle(ctx *gin.Context) {
+	var req request.TakeModerationActionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	// 管理用トークンでの操作はユーザーを持たないため、操作したユーザーは記録しない
+	var moderatorUserID *id.UserID
+	if userID, ok := auth.UserIDFromContext(ctx.Request.Context()); ok {
+		moderatorUserID = &userID
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.TakeModerationActionParams{
+		ModeratorUserID: moderatorUserID,
+		ActorRole:       auth.RoleFromContext(ctx.Request.Context()),
+		TargetType:      req.TargetType,
+		TargetID:        req.TargetID,
+		Action:          req.Action,
+		Note:            req.Note,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewTakeModerationActionResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/moderation/internal/presentation/handler/take_moderation_action_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/moderation/internal/presentation/handler/take_moderation_action_handler.go -destination=./apps/moderation/internal/presentation/handler/take_moderation_action_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/moderation/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTakeModerationActionUseCase is a mock of TakeModerationActionUseCase interface.
+type MockTakeModerationActionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTakeModerationActionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTakeModerationActionUseCaseMockRecorder is the mock recorder for MockTakeModerationActionUseCase.
+type MockTakeModerationActionUseCaseMockRecorder struct {
+	mock *MockTakeModerationActionUseCase
+}
+
+// NewMockTakeModerationActionUseCase creates a new mock instance.
+func NewMockTakeModerationActionUseCase(ctrl *gomock.Controller) *MockTakeModerationActionUseCase {
+	mock := &MockTakeModerationActionUseCase{ctrl: ctrl}
+	mock.recorder = &MockTakeModerationActionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTakeModerationActionUseCase) EXPECT() *MockTakeModerationActionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockTakeModerationActionUseCase) Execute(ctx context.Context, params usecase.TakeModerationActionParams) (*usecase.TakeModerationActionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.TakeModerationActionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockTakeModerationActionUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockTakeModerationActionUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,176 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/moderation/internal/application/usecase"
+	"poketier/apps/moderation/internal/presentation/handler"
+	"poketier/apps/moderation/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTakeModerationActionHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	moderatorID := id.NewUserID()
+	moderatorIDString := moderatorID.String()
+	actionID := id.NewModerationActionID().String()
+	tierListID := uuid.New().String()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	body := `{"target_type":"tier_list","target_id":"` + tierListID + `","action":"hide","note":"誹謗中傷"}`
+	result := &usecase.TakeModerationActionResult{
+		ActionID:            actionID,
+		TargetType:          "tier_list",
+		TargetID:            tierListID,
+		Action:              "hide",
+		ModeratorUserID:     &moderatorIDString,
+		Note:                "誹謗中傷",
+		ResolvedReportCount: 2,
+		CreatedAt:           createdAt,
+	}
+
+	tests := []struct {
+		caseName       string
+		moderator      bool
+		body           string
+		mockSetup      func(*MockTakeModerationActionUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName:  "正常系: ティアリストを非表示にし、201で監査ログに記録した操作が返される",
+			moderator: true,
+			body:      body,
+			mockSetup: func(mockUC *MockTakeModerationActionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.TakeModerationActionParams{
+					ModeratorUserID: &moderatorID,
+					ActorRole:       role.Moderator,
+					TargetType:      "tier_list",
+					TargetID:        tierListID,
+					Action:          "hide",
+					Note:            "誹謗中傷",
+				}).Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: response.TakeModerationActionResponse{
+				ModerationActionResponse: response.ModerationActionResponse{
+					ActionID:        actionID,
+					TargetType:      "tier_list",
+					TargetID:        tierListID,
+					Action:          "hide",
+					ModeratorUserID: &moderatorIDString,
+					Note:            "誹謗中傷",
+					CreatedAt:       createdAt,
+				},
+				ResolvedReportCount: 2,
+			},
+		},
+		{
+			caseName:  "正常系: 管理用トークンでの操作は操作したユーザーを渡さない",
+			moderator: false,
+			body:      body,
+			mockSetup: func(mockUC *MockTakeModerationActionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.TakeModerationActionParams{
+					ActorRole:  role.Admin,
+					TargetType: "tier_list",
+					TargetID:   tierListID,
+					Action:     "hide",
+					Note:       "誹謗中傷",
+				}).Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			caseName:       "異常系: 操作が指定されていない場合、400が返される",
+			moderator:      true,
+			body:           `{"target_type":"tier_list","target_id":"` + tierListID + `"}`,
+			mockSetup:      func(mockUC *MockTakeModerationActionUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName:  "異常系: モデレーターを利用停止にした場合、403が返される",
+			moderator: true,
+			body:      `{"target_type":"user","target_id":"` + moderatorIDString + `","action":"ban"}`,
+			mockSetup: func(mockUC *MockTakeModerationActionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewForbiddenError("cannot ban a moderator or admin", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Forbidden",
+				Status: http.StatusForbidden,
+				Detail: "You do not have permission to perform this action.",
+			},
+		},
+		{
+			caseName:  "異常系: UseCaseでエラーが発生した場合、500が返される",
+			moderator: true,
+			body:      body,
+			mockSetup: func(mockUC *MockTakeModerationActionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockTakeModerationActionUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewTakeModerationActionHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.moderator {
+				ctx = auth.WithUser(ctx, moderatorID, role.Moderator)
+			} else {
+				// 管理用トークンでの操作
+				ctx = auth.WithService(ctx, role.Admin)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/moderation-actions", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody != nil {
+				assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package request
+
+// ReportContentRequest は通報のリクエストボディ
+type ReportContentRequest struct {
+	TargetType string `json:"target_type" binding:"required"`
+	TargetID   string `json:"target_id" binding:"required"`
+	Reason     string `json:"reason" binding:"required"`
+	Detail     string `json:"detail"`
+}
+
+// ListReportQueueRequest はモデレーションキュー取得のクエリパラメータ
+type ListReportQueueRequest struct {
+	TargetType string `form:"target_type"`
+	Reason     string `form:"reason"`
+	Cursor     string `form:"cursor"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// TakeModerationActionRequest はモデレーターの操作のリクエストボディ
+type TakeModerationActionRequest struct {
+	TargetType string `json:"target_type" binding:"required"`
+	TargetID   string `json:"target_id" binding:"required"`
+	Action     string `json:"action" binding:"required"`
+	Note       string `json:"note"`
+}
+
+// ListModerationActionsRequest は監査ログ取得のクエリパラメータ
+type ListModerationActionsRequest struct {
+	TargetType string `form:"target_type"`
+	TargetID   string `form:"target_id"`
+	Cursor     string `form:"cursor"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
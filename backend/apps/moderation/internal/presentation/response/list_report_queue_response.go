@@ -0,0 +1,50 @@
+package response
+
+import (
+	"poketier/apps/moderation/internal/application/usecase"
+	"time"
+)
+
+type ListReportQueueResponse struct {
+	Items      []LRQItem `json:"items"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+type LRQItem struct {
+	TargetType      string    `json:"target_type"`
+	TargetID        string    `json:"target_id"`
+	ReportCount     int       `json:"report_count"`
+	Reasons         []string  `json:"reasons"`
+	FirstReportedAt time.Time `json:"first_reported_at"`
+	LastReportedAt  time.Time `json:"last_reported_at"`
+	Content         string    `json:"content"`
+	AuthorUserID    *string   `json:"author_user_id"`
+	Hidden          bool      `json:"hidden"`
+}
+
+func NewListReportQueueResponse(result *usecase.ListReportQueueResult) ListReportQueueResponse {
+	items := make([]LRQItem, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = LRQItem{
+			TargetType:      item.TargetType,
+			TargetID:        item.TargetID,
+			ReportCount:     item.ReportCount,
+			Reasons:         item.Reasons,
+			FirstReportedAt: item.FirstReportedAt,
+			LastReportedAt:  item.LastReportedAt,
+			Content:         item.Content,
+			AuthorUserID:    item.AuthorUserID,
+			Hidden:          item.Hidden,
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListReportQueueResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,68 @@
+package response
+
+import (
+	"poketier/apps/moderation/internal/application/usecase"
+	"time"
+)
+
+// ModerationActionResponse は監査ログに記録したモデレーターの操作
+type ModerationActionResponse struct {
+	ActionID        string    `json:"action_id"`
+	TargetType      string    `json:"target_type"`
+	TargetID        string    `json:"target_id"`
+	Action          string    `json:"action"`
+	ModeratorUserID *string   `json:"moderator_user_id"`
+	Note            string    `json:"note"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// TakeModerationActionResponse は操作の結果。resolved_report_count は操作によって対応済みになった通報の件数
+type TakeModerationActionResponse struct {
+	ModerationActionResponse
+	ResolvedReportCount int `json:"resolved_report_count"`
+}
+
+type ListModerationActionsResponse struct {
+	Actions    []ModerationActionResponse `json:"actions"`
+	NextCursor *string                    `json:"next_cursor"`
+}
+
+func NewTakeModerationActionResponse(result *usecase.TakeModerationActionResult) TakeModerationActionResponse {
+	return TakeModerationActionResponse{
+		ModerationActionResponse: ModerationActionResponse{
+			ActionID:        result.ActionID,
+			TargetType:      result.TargetType,
+			TargetID:        result.TargetID,
+			Action:          result.Action,
+			ModeratorUserID: result.ModeratorUserID,
+			Note:            result.Note,
+			CreatedAt:       result.CreatedAt,
+		},
+		ResolvedReportCount: result.ResolvedReportCount,
+	}
+}
+
+func NewListModerationActionsResponse(result *usecase.ListModerationActionsResult) ListModerationActionsResponse {
+	actions := make([]ModerationActionResponse, len(result.Actions))
+	for i, action := range result.Actions {
+		actions[i] = ModerationActionResponse{
+			ActionID:        action.ActionID,
+			TargetType:      action.TargetType,
+			TargetID:        action.TargetID,
+			Action:          action.Action,
+			ModeratorUserID: action.ModeratorUserID,
+			Note:            action.Note,
+			CreatedAt:       action.CreatedAt,
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListModerationActionsResponse{
+		Actions:    actions,
+		NextCursor: nextCursor,
+	}
+}
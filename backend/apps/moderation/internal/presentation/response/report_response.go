@@ -0,0 +1,27 @@
+package response
+
+import (
+	"poketier/apps/moderation/internal/application/usecase"
+	"time"
+)
+
+// ReportResponse は作成した通報
+type ReportResponse struct {
+	ReportID   string    `json:"report_id"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	Reason     string    `json:"reason"`
+	Detail     string    `json:"detail"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewReportResponse(result *usecase.ReportContentResult) ReportResponse {
+	return ReportResponse{
+		ReportID:   result.ReportID,
+		TargetType: result.TargetType,
+		TargetID:   result.TargetID,
+		Reason:     result.Reason,
+		Detail:     result.Detail,
+		CreatedAt:  result.CreatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package statistics
+
+import (
+	"time"
+
+	"poketier/apps/statistics/internal/infrastructure/cache"
+)
+
+// ConsensusCache は集計ティアリストのプロセス内キャッシュ
+// サーバー内で1つのインスタンスを共有し、ティアリストの配置が変更されたシーズンの集計結果を無効化する
+type ConsensusCache = cache.ConsensusCache
+
+// NewConsensusCache は有効期限と、期限切れ後に古い集計結果を返す期間を指定してConsensusCacheを作成します
+func NewConsensusCache(ttl, staleTTL time.Duration) *ConsensusCache {
+	return cache.NewConsensusCache(ttl, staleTTL)
+}
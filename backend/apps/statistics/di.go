@@ -0,0 +1,272 @@
+//go:build wireinject
+// +build wireinject
+
+package statistics
+
+import (
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/infrastructure/cache"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/apps/statistics/internal/presentation/command"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/apps/statistics/internal/presentation/job"
+	"poketier/pkg/log"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeGetConsensusTierListHandler はGetConsensusTierListHandlerとその依存関係を初期化します
+func InitializeGetConsensusTierListHandler(queries db.Querier, consensusCache *cache.ConsensusCache) *handler.GetConsensusTierListHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierStatisticQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewPlacementRepository,
+		repository.NewTierStatisticRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.GCTSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.GCTPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.GCTStatisticRepository), new(*repository.TierStatisticRepository)),
+		wire.Bind(new(usecase.GCTDeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.GCTConsensusCache), new(*cache.ConsensusCache)),
+
+		// Usecase provider
+		usecase.NewGetConsensusTierListUsecase,
+		wire.Bind(new(handler.GetConsensusTierListUseCase), new(*usecase.GetConsensusTierListUsecase)),
+
+		// Handler provider
+		handler.NewGetConsensusTierListHandler,
+	)
+	return &handler.GetConsensusTierListHandler{}
+}
+
+// InitializeGetDeckTrendHandler はGetDeckTrendHandlerとその依存関係を初期化します
+func InitializeGetDeckTrendHandler(queries db.Querier) *handler.GetDeckTrendHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckTrendQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewDeckTrendRepository,
+		wire.Bind(new(usecase.GDTSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.GDTDeckTrendRepository), new(*repository.DeckTrendRepository)),
+
+		// Usecase provider
+		usecase.NewGetDeckTrendUsecase,
+		wire.Bind(new(handler.GetDeckTrendUseCase), new(*usecase.GetDeckTrendUsecase)),
+
+		// Handler provider
+		handler.NewGetDeckTrendHandler,
+	)
+	return &handler.GetDeckTrendHandler{}
+}
+
+// InitializeListDeckMoversHandler はListDeckMoversHandlerとその依存関係を初期化します
+func InitializeListDeckMoversHandler(queries db.Querier) *handler.ListDeckMoversHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckTrendQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewDeckTrendRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.LDMSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.LDMDeckTrendRepository), new(*repository.DeckTrendRepository)),
+		wire.Bind(new(usecase.LDMDeckRepository), new(*repository.DeckRepository)),
+
+		// Usecase provider
+		usecase.NewListDeckMoversUsecase,
+		wire.Bind(new(handler.ListDeckMoversUseCase), new(*usecase.ListDeckMoversUsecase)),
+
+		// Handler provider
+		handler.NewListDeckMoversHandler,
+	)
+	return &handler.ListDeckMoversHandler{}
+}
+
+// InitializeCompareSeasonConsensusHandler はCompareSeasonConsensusHandlerとその依存関係を初期化します
+func InitializeCompareSeasonConsensusHandler(queries db.Querier, consensusCache *cache.ConsensusCache) *handler.CompareSeasonConsensusHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierStatisticQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewPlacementRepository,
+		repository.NewTierStatisticRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.CSCSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.CSCPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.CSCStatisticRepository), new(*repository.TierStatisticRepository)),
+		wire.Bind(new(usecase.CSCDeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.CSCConsensusCache), new(*cache.ConsensusCache)),
+
+		// Usecase provider
+		usecase.NewCompareSeasonConsensusUsecase,
+		wire.Bind(new(handler.CompareSeasonConsensusUseCase), new(*usecase.CompareSeasonConsensusUsecase)),
+
+		// Handler provider
+		handler.NewCompareSeasonConsensusHandler,
+	)
+	return &handler.CompareSeasonConsensusHandler{}
+}
+
+// InitializeGetDeckTierStatisticsHandler はGetDeckTierStatisticsHandlerとその依存関係を初期化します
+func InitializeGetDeckTierStatisticsHandler(queries db.Querier) *handler.GetDeckTierStatisticsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.FavoriteCountQuerier), new(db.Querier)),
+		repository.NewDeckRepository,
+		repository.NewPlacementRepository,
+		repository.NewFavoriteCountRepository,
+		wire.Bind(new(usecase.GDSDeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.GDSPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.GDSFavoriteCountRepository), new(*repository.FavoriteCountRepository)),
+
+		// Usecase provider
+		usecase.NewGetDeckTierStatisticsUsecase,
+		wire.Bind(new(handler.GetDeckTierStatisticsUseCase), new(*usecase.GetDeckTierStatisticsUsecase)),
+
+		// Handler provider
+		handler.NewGetDeckTierStatisticsHandler,
+	)
+	return &handler.GetDeckTierStatisticsHandler{}
+}
+
+// InitializeGetTierListAgreementHandler はGetTierListAgreementHandlerとその依存関係を初期化します
+func InitializeGetTierListAgreementHandler(queries db.Querier, consensusCache *cache.ConsensusCache) *handler.GetTierListAgreementHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierStatisticQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewPlacementRepository,
+		repository.NewTierStatisticRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.GTATierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.GTAPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.GTAStatisticRepository), new(*repository.TierStatisticRepository)),
+		wire.Bind(new(usecase.GTADeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.GTAConsensusCache), new(*cache.ConsensusCache)),
+
+		// Usecase provider
+		usecase.NewGetTierListAgreementUsecase,
+		wire.Bind(new(handler.GetTierListAgreementUseCase), new(*usecase.GetTierListAgreementUsecase)),
+
+		// Handler provider
+		handler.NewGetTierListAgreementHandler,
+	)
+	return &handler.GetTierListAgreementHandler{}
+}
+
+// InitializeDeckTrendSnapshotJob はDeckTrendSnapshotJobとその依存関係を初期化します
+func InitializeDeckTrendSnapshotJob(queries db.Querier, logger log.Logger) *job.DeckTrendSnapshotJob {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckTrendQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewDeckTrendRepository,
+		wire.Bind(new(usecase.SDTSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.SDTDeckTrendRepository), new(*repository.DeckTrendRepository)),
+
+		// Usecase provider
+		usecase.NewSnapshotDeckTrendsUsecase,
+		wire.Bind(new(job.SnapshotDeckTrendsUseCase), new(*usecase.SnapshotDeckTrendsUsecase)),
+
+		// Job provider
+		job.NewDeckTrendSnapshotJob,
+	)
+	return &job.DeckTrendSnapshotJob{}
+}
+
+// InitializeTrustEvaluationJob はTrustEvaluationJobとその依存関係を初期化します
+func InitializeTrustEvaluationJob(queries db.Querier, txManager *sqlc.TxManager, consensusCache *cache.ConsensusCache, logger log.Logger) *job.TrustEvaluationJob {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TrustScoreQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewPlacementRepository,
+		repository.NewTrustScoreRepository,
+		wire.Bind(new(usecase.ETTSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.ETTPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.ETTTrustScoreRepository), new(*repository.TrustScoreRepository)),
+		wire.Bind(new(usecase.ETTTxManager), new(*sqlc.TxManager)),
+		wire.Bind(new(usecase.ETTConsensusCache), new(*cache.ConsensusCache)),
+
+		// Usecase provider
+		usecase.NewEvaluateTierListTrustUsecase,
+		wire.Bind(new(job.EvaluateTierListTrustUseCase), new(*usecase.EvaluateTierListTrustUsecase)),
+
+		// Job provider
+		job.NewTrustEvaluationJob,
+	)
+	return &job.TrustEvaluationJob{}
+}
+
+// InitializeListFlaggedTierListsHandler はListFlaggedTierListsHandlerとその依存関係を初期化します
+func InitializeListFlaggedTierListsHandler(queries db.Querier) *handler.ListFlaggedTierListsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TrustScoreQuerier), new(db.Querier)),
+		repository.NewTrustScoreRepository,
+		wire.Bind(new(usecase.LFTTrustScoreRepository), new(*repository.TrustScoreRepository)),
+
+		// Usecase provider
+		usecase.NewListFlaggedTierListsUsecase,
+		wire.Bind(new(handler.ListFlaggedTierListsUseCase), new(*usecase.ListFlaggedTierListsUsecase)),
+
+		// Handler provider
+		handler.NewListFlaggedTierListsHandler,
+	)
+	return &handler.ListFlaggedTierListsHandler{}
+}
+
+// InitializeTierStatisticsCommand はTierStatisticsCommandとその依存関係を初期化します
+func InitializeTierStatisticsCommand(queries db.Querier, txManager *sqlc.TxManager, consensusCache *cache.ConsensusCache) *command.TierStatisticsCommand {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.PlacementQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierStatisticQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TrustScoreQuerier), new(db.Querier)),
+		repository.NewSeasonRepository,
+		repository.NewPlacementRepository,
+		repository.NewTierStatisticRepository,
+		repository.NewTrustScoreRepository,
+		wire.Bind(new(usecase.RTSStatisticRepository), new(*repository.TierStatisticRepository)),
+		wire.Bind(new(usecase.CTSStatisticRepository), new(*repository.TierStatisticRepository)),
+		wire.Bind(new(usecase.RTSTxManager), new(*sqlc.TxManager)),
+		wire.Bind(new(usecase.CTSTxManager), new(*sqlc.TxManager)),
+		wire.Bind(new(usecase.ETTSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.ETTPlacementRepository), new(*repository.PlacementRepository)),
+		wire.Bind(new(usecase.ETTTrustScoreRepository), new(*repository.TrustScoreRepository)),
+		wire.Bind(new(usecase.ETTTxManager), new(*sqlc.TxManager)),
+		wire.Bind(new(usecase.ETTConsensusCache), new(*cache.ConsensusCache)),
+
+		// Usecase provider
+		usecase.NewRebuildTierStatisticsUsecase,
+		usecase.NewCheckTierStatisticsUsecase,
+		usecase.NewEvaluateTierListTrustUsecase,
+		wire.Bind(new(command.RebuildTierStatisticsUseCase), new(*usecase.RebuildTierStatisticsUsecase)),
+		wire.Bind(new(command.CheckTierStatisticsUseCase), new(*usecase.CheckTierStatisticsUsecase)),
+		wire.Bind(new(command.EvaluateTierListTrustUseCase), new(*usecase.EvaluateTierListTrustUsecase)),
+
+		// Command provider
+		command.NewTierStatisticsCommand,
+	)
+	return &command.TierStatisticsCommand{}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// CheckTierStatisticsParams はティア統計の整合性チェックの入力
+// SeasonID が空の場合は全シーズンを対象とする
+type CheckTierStatisticsParams struct {
+	SeasonID string
+}
+
+// CheckTierStatisticsResult はティア統計の整合性チェック結果
+type CheckTierStatisticsResult struct {
+	CheckedCount int
+	Mismatches   []CTSMismatch
+}
+
+// CTSMismatch は差分更新された統計と再計算した統計の不一致
+type CTSMismatch struct {
+	DeckID                           string
+	SeasonID                         string
+	IncrementalRankSum               int64
+	IncrementalPlacementCount        int
+	IncrementalWeightedRankSum       float64
+	IncrementalWeightSum             float64
+	IncrementalWeightedRankSquareSum float64
+	IncrementalWeightSquareSum       float64
+	RecomputedRankSum                int64
+	RecomputedPlacementCount         int
+	RecomputedWeightedRankSum        float64
+	RecomputedWeightSum              float64
+	RecomputedWeightedRankSquareSum  float64
+	RecomputedWeightSquareSum        float64
+}
+
+type CTSStatisticRepository interface {
+	FindAll(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error)
+	Recompute(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error)
+}
+
+type CTSTxManager interface {
+	RunInReadOnlySnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type CheckTierStatisticsUsecase struct {
+	statisticRepo CTSStatisticRepository
+	txManager     CTSTxManager
+}
+
+func NewCheckTierStatisticsUsecase(statisticRepo CTSStatisticRepository, txManager CTSTxManager) *CheckTierStatisticsUsecase {
+	return &CheckTierStatisticsUsecase{
+		statisticRepo: statisticRepo,
+		txManager:     txManager,
+	}
+}
+
+// Execute は差分更新された統計を配置からの再計算結果と比較する
+// 統計と配置は同じスナップショットから読み取るため、比較中のティアリストの更新は不一致として検出されない
+func (u *CheckTierStatisticsUsecase) Execute(ctx context.Context, params CheckTierStatisticsParams) (*CheckTierStatisticsResult, error) {
+	seasonID, err := parseOptionalSeasonID(params.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	var incremental, recomputed []entity.TierStatistic
+	err = u.txManager.RunInReadOnlySnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		incremental, err = u.statisticRepo.FindAll(ctx, seasonID)
+		if err != nil {
+			return fmt.Errorf("failed to find tier statistics: %w", err)
+		}
+
+		recomputed, err = u.statisticRepo.Recompute(ctx, seasonID)
+		if err != nil {
+			return fmt.Errorf("failed to recompute tier statistics: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := entity.CompareTierStatistics(incremental, recomputed)
+	result := &CheckTierStatisticsResult{
+		CheckedCount: len(recomputed),
+		Mismatches:   make([]CTSMismatch, 0, len(mismatches)),
+	}
+	for _, m := range mismatches {
+		result.Mismatches = append(result.Mismatches, CTSMismatch{
+			DeckID:                           m.DeckID.String(),
+			SeasonID:                         m.SeasonID.String(),
+			IncrementalRankSum:               m.Incremental.RankSum,
+			IncrementalPlacementCount:        m.Incremental.PlacementCount,
+			IncrementalWeightedRankSum:       m.Incremental.WeightedRankSum,
+			IncrementalWeightSum:             m.Incremental.WeightSum,
+			IncrementalWeightedRankSquareSum: m.Incremental.WeightedRankSquareSum,
+			IncrementalWeightSquareSum:       m.Incremental.WeightSquareSum,
+			RecomputedRankSum:                m.Recomputed.RankSum,
+			RecomputedPlacementCount:         m.Recomputed.PlacementCount,
+			RecomputedWeightedRankSum:        m.Recomputed.WeightedRankSum,
+			RecomputedWeightSum:              m.Recomputed.WeightSum,
+			RecomputedWeightedRankSquareSum:  m.Recomputed.WeightedRankSquareSum,
+			RecomputedWeightSquareSum:        m.Recomputed.WeightSquareSum,
+		})
+	}
+
+	return result, nil
+}
+
+// parseOptionalSeasonID は任意指定のシーズンIDを変換する（空の場合はnil）
+func parseOptionalSeasonID(s string) (*id.SeasonID, error) {
+	if s == "" {
+		return nil, nil
+	}
+	seasonID, err := id.SeasonIDFromString(s)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid season_id", err)
+	}
+	return &seasonID, nil
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/check_tier_statistics_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/check_tier_statistics_usecase.go -destination=./apps/statistics/internal/application/usecase/check_tier_statistics_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCTSStatisticRepository is a mock of CTSStatisticRepository interface.
+type MockCTSStatisticRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCTSStatisticRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCTSStatisticRepositoryMockRecorder is the mock recorder for MockCTSStatisticRepository.
+type MockCTSStatisticRepositoryMockRecorder struct {
+	mock *MockCTSStatisticRepository
+}
+
+// NewMockCTSStatisticRepository creates a new mock instance.
+func NewMockCTSStatisticRepository(ctrl *gomock.Controller) *MockCTSStatisticRepository {
+	mock := &MockCTSStatisticRepository{ctrl: ctrl}
+	mock.recorder = &MockCTSStatisticRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCTSStatisticRepository) EXPECT() *MockCTSStatisticRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockCTSStatisticRepository) FindAll(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCTSStatisticRepositoryMockRecorder) FindAll(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCTSStatisticRepository)(nil).FindAll), ctx, seasonID)
+}
+
+// Recompute mocks base method.
+func (m *MockCTSStatisticRepository) Recompute(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompute", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recompute indicates an expected call of Recompute.
+func (mr *MockCTSStatisticRepositoryMockRecorder) Recompute(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompute", reflect.TypeOf((*MockCTSStatisticRepository)(nil).Recompute), ctx, seasonID)
+}
+
+// MockCTSTxManager is a mock of CTSTxManager interface.
+type MockCTSTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCTSTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCTSTxManagerMockRecorder is the mock recorder for MockCTSTxManager.
+type MockCTSTxManagerMockRecorder struct {
+	mock *MockCTSTxManager
+}
+
+// NewMockCTSTxManager creates a new mock instance.
+func NewMockCTSTxManager(ctrl *gomock.Controller) *MockCTSTxManager {
+	mock := &MockCTSTxManager{ctrl: ctrl}
+	mock.recorder = &MockCTSTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCTSTxManager) EXPECT() *MockCTSTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInReadOnlySnapshot mocks base method.
+func (m *MockCTSTxManager) RunInReadOnlySnapshot(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInReadOnlySnapshot", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInReadOnlySnapshot indicates an expected call of RunInReadOnlySnapshot.
+func (mr *MockCTSTxManagerMockRecorder) RunInReadOnlySnapshot(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInReadOnlySnapshot", reflect.TypeOf((*MockCTSTxManager)(nil).RunInReadOnlySnapshot), ctx, fn)
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckTierStatisticsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	recomputed := []entity.TierStatistic{
+		{DeckID: deckA, SeasonID: seasonID, RankSum: 20, PlacementCount: 3},
+		{DeckID: deckB, SeasonID: seasonID, RankSum: 8, PlacementCount: 2},
+	}
+
+	// 統計の読み取りと再計算は同じスナップショットで行う
+	expectSnapshot := func(txManager *MockCTSTxManager) {
+		txManager.EXPECT().RunInReadOnlySnapshot(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.CheckTierStatisticsParams
+		setupMock   func(statisticRepo *MockCTSStatisticRepository, txManager *MockCTSTxManager)
+		want        *usecase.CheckTierStatisticsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 差分更新された統計が再計算結果と一致する場合、不一致は空",
+			params:   usecase.CheckTierStatisticsParams{},
+			setupMock: func(statisticRepo *MockCTSStatisticRepository, txManager *MockCTSTxManager) {
+				expectSnapshot(txManager)
+				statisticRepo.EXPECT().FindAll(gomock.Any(), nil).Return(recomputed, nil)
+				statisticRepo.EXPECT().Recompute(gomock.Any(), nil).Return(recomputed, nil)
+			},
+			want: &usecase.CheckTierStatisticsResult{
+				CheckedCount: 2,
+				Mismatches:   []usecase.CTSMismatch{},
+			},
+		},
+		{
+			caseName: "正常系: 差分更新された統計がずれている場合、不一致が返される",
+			params:   usecase.CheckTierStatisticsParams{SeasonID: testSeasonID},
+			setupMock: func(statisticRepo *MockCTSStatisticRepository, txManager *MockCTSTxManager) {
+				expectSnapshot(txManager)
+				statisticRepo.EXPECT().FindAll(gomock.Any(), &seasonID).Return([]entity.TierStatistic{
+					{DeckID: deckA, SeasonID: seasonID, RankSum: 20, PlacementCount: 3},
+					{DeckID: deckB, SeasonID: seasonID, RankSum: 9, PlacementCount: 2},
+				}, nil)
+				statisticRepo.EXPECT().Recompute(gomock.Any(), &seasonID).Return(recomputed, nil)
+			},
+			want: &usecase.CheckTierStatisticsResult{
+				CheckedCount: 2,
+				Mismatches: []usecase.CTSMismatch{
+					{
+						DeckID:                    deckB.String(),
+						SeasonID:                  testSeasonID,
+						IncrementalRankSum:        9,
+						IncrementalPlacementCount: 2,
+						RecomputedRankSum:         8,
+						RecomputedPlacementCount:  2,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.CheckTierStatisticsParams{SeasonID: "invalid"},
+			setupMock: func(statisticRepo *MockCTSStatisticRepository, txManager *MockCTSTxManager) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: 再計算でエラーが発生した場合、エラーを返す",
+			params:   usecase.CheckTierStatisticsParams{},
+			setupMock: func(statisticRepo *MockCTSStatisticRepository, txManager *MockCTSTxManager) {
+				expectSnapshot(txManager)
+				statisticRepo.EXPECT().FindAll(gomock.Any(), nil).Return(recomputed, nil)
+				statisticRepo.EXPECT().Recompute(gomock.Any(), nil).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to recompute tier statistics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			statisticRepo := NewMockCTSStatisticRepository(ctrl)
+			txManager := NewMockCTSTxManager(ctrl)
+			tt.setupMock(statisticRepo, txManager)
+
+			usecase := usecase.NewCheckTierStatisticsUsecase(statisticRepo, txManager)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
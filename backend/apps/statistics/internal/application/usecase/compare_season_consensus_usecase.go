@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// CompareSeasonConsensusParams はシーズン間の集計ティアリスト比較の入力
+// Method が空の場合、MinPlacementCount が0の場合はそれぞれ既定値を使用する
+type CompareSeasonConsensusParams struct {
+	FromSeasonID      string
+	ToSeasonID        string
+	Method            string
+	MinPlacementCount int
+}
+
+// CompareSeasonConsensusResult はシーズン間の集計ティアリストの比較結果
+type CompareSeasonConsensusResult struct {
+	FromSeasonID string
+	ToSeasonID   string
+	Method       string
+	Archetypes   []CSCArchetype
+	NewEntries   []CSCDeck
+	Dropped      []CSCDeck
+}
+
+// CSCArchetype は両シーズンの集計ティアリストに掲載されたアーキタイプの変化
+// AverageTierRankChange と TierChange は正の値が上昇を表す
+type CSCArchetype struct {
+	ArchetypeKey          string
+	From                  CSCDeck
+	To                    CSCDeck
+	AverageTierRankChange float64
+	TierChange            int
+}
+
+// CSCDeck はシーズンの集計ティアリストでのデッキの位置
+type CSCDeck struct {
+	DeckID          string
+	Nickname        string
+	ImageURL        string
+	Tier            string
+	AverageTierRank float64
+	PlacementCount  int
+}
+
+type CSCSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+}
+
+type CSCPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+	CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error)
+}
+
+type CSCStatisticRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error)
+}
+
+type CSCDeckRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error)
+}
+
+type CSCConsensusCache interface {
+	Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error)
+}
+
+type CompareSeasonConsensusUsecase struct {
+	seasonRepo    CSCSeasonRepository
+	placementRepo CSCPlacementRepository
+	statisticRepo CSCStatisticRepository
+	deckRepo      CSCDeckRepository
+	cache         CSCConsensusCache
+}
+
+func NewCompareSeasonConsensusUsecase(
+	seasonRepo CSCSeasonRepository,
+	placementRepo CSCPlacementRepository,
+	statisticRepo CSCStatisticRepository,
+	deckRepo CSCDeckRepository,
+	cache CSCConsensusCache,
+) *CompareSeasonConsensusUsecase {
+	return &CompareSeasonConsensusUsecase{
+		seasonRepo:    seasonRepo,
+		placementRepo: placementRepo,
+		statisticRepo: statisticRepo,
+		deckRepo:      deckRepo,
+		cache:         cache,
+	}
+}
+
+// Execute は2つのシーズンの集計ティアリストをデッキのアーキタイプで対応付けて比較する
+func (u *CompareSeasonConsensusUsecase) Execute(ctx context.Context, params CompareSeasonConsensusParams) (*CompareSeasonConsensusResult, error) {
+	fromSeasonID, err := id.SeasonIDFromString(params.FromSeasonID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid from_season_id", err)
+	}
+	toSeasonID, err := id.SeasonIDFromString(params.ToSeasonID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid to_season_id", err)
+	}
+	if fromSeasonID == toSeasonID {
+		return nil, errs.NewValidationError("from_season_id and to_season_id must be different", nil)
+	}
+
+	method, minPlacementCount, err := parseConsensusOptions(params.Method, params.MinPlacementCount)
+	if err != nil {
+		return nil, err
+	}
+
+	var decks []*entity.Deck
+	consensuses := make([]*entity.ConsensusTierList, 0, 2)
+	for _, seasonID := range []id.SeasonID{fromSeasonID, toSeasonID} {
+		exists, err := u.seasonRepo.Exists(ctx, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check season: %w", err)
+		}
+		if !exists {
+			return nil, errs.NewNotFoundError("season not found", nil)
+		}
+
+		consensus, err := cachedConsensus(ctx, u.cache, u.placementRepo, u.statisticRepo, seasonID, method, minPlacementCount)
+		if err != nil {
+			return nil, err
+		}
+		consensuses = append(consensuses, consensus)
+
+		seasonDecks, err := u.deckRepo.FindBySeason(ctx, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find decks: %w", err)
+		}
+		decks = append(decks, seasonDecks...)
+	}
+
+	comparison := entity.CompareConsensus(consensuses[0], consensuses[1], decks)
+
+	decksByID := make(map[id.DeckID]*entity.Deck, len(decks))
+	for _, deck := range decks {
+		decksByID[deck.ID()] = deck
+	}
+	toDeck := func(entry entity.ConsensusEntry) CSCDeck {
+		deck := CSCDeck{
+			DeckID:          entry.DeckID.String(),
+			Tier:            entry.TierRank.String(),
+			AverageTierRank: entry.Score,
+			PlacementCount:  entry.PlacementCount,
+		}
+		// 集計後に削除されたデッキなど参照情報がない場合はIDのみを返す
+		if d, ok := decksByID[entry.DeckID]; ok {
+			deck.Nickname = d.Nickname()
+			deck.ImageURL = d.ImageURL()
+		}
+		return deck
+	}
+
+	result := &CompareSeasonConsensusResult{
+		FromSeasonID: fromSeasonID.String(),
+		ToSeasonID:   toSeasonID.String(),
+		Method:       string(method),
+		Archetypes:   make([]CSCArchetype, 0, len(comparison.Continuing)),
+		NewEntries:   make([]CSCDeck, 0, len(comparison.NewEntries)),
+		Dropped:      make([]CSCDeck, 0, len(comparison.Dropped)),
+	}
+	for _, c := range comparison.Continuing {
+		result.Archetypes = append(result.Archetypes, CSCArchetype{
+			ArchetypeKey:          c.ArchetypeKey,
+			From:                  toDeck(c.From),
+			To:                    toDeck(c.To),
+			AverageTierRankChange: c.ScoreChange,
+			TierChange:            c.TierChange,
+		})
+	}
+	for _, e := range comparison.NewEntries {
+		result.NewEntries = append(result.NewEntries, toDeck(e))
+	}
+	for _, e := range comparison.Dropped {
+		result.Dropped = append(result.Dropped, toDeck(e))
+	}
+	return result, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/compare_season_consensus_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/compare_season_consensus_usecase.go -destination=./apps/statistics/internal/application/usecase/compare_season_consensus_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCSCSeasonRepository is a mock of CSCSeasonRepository interface.
+type MockCSCSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSCSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCSCSeasonRepositoryMockRecorder is the mock recorder for MockCSCSeasonRepository.
+type MockCSCSeasonRepositoryMockRecorder struct {
+	mock *MockCSCSeasonRepository
+}
+
+// NewMockCSCSeasonRepository creates a new mock instance.
+func NewMockCSCSeasonRepository(ctrl *gomock.Controller) *MockCSCSeasonRepository {
+	mock := &MockCSCSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockCSCSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSCSeasonRepository) EXPECT() *MockCSCSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockCSCSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockCSCSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockCSCSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// MockCSCPlacementRepository is a mock of CSCPlacementRepository interface.
+type MockCSCPlacementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSCPlacementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCSCPlacementRepositoryMockRecorder is the mock recorder for MockCSCPlacementRepository.
+type MockCSCPlacementRepositoryMockRecorder struct {
+	mock *MockCSCPlacementRepository
+}
+
+// NewMockCSCPlacementRepository creates a new mock instance.
+func NewMockCSCPlacementRepository(ctrl *gomock.Controller) *MockCSCPlacementRepository {
+	mock := &MockCSCPlacementRepository{ctrl: ctrl}
+	mock.recorder = &MockCSCPlacementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSCPlacementRepository) EXPECT() *MockCSCPlacementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTierListsBySeason mocks base method.
+func (m *MockCSCPlacementRepository) CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListsBySeason indicates an expected call of CountTierListsBySeason.
+func (mr *MockCSCPlacementRepositoryMockRecorder) CountTierListsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListsBySeason", reflect.TypeOf((*MockCSCPlacementRepository)(nil).CountTierListsBySeason), ctx, seasonID)
+}
+
+// FindBySeason mocks base method.
+func (m *MockCSCPlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockCSCPlacementRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockCSCPlacementRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockCSCStatisticRepository is a mock of CSCStatisticRepository interface.
+type MockCSCStatisticRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSCStatisticRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCSCStatisticRepositoryMockRecorder is the mock recorder for MockCSCStatisticRepository.
+type MockCSCStatisticRepositoryMockRecorder struct {
+	mock *MockCSCStatisticRepository
+}
+
+// NewMockCSCStatisticRepository creates a new mock instance.
+func NewMockCSCStatisticRepository(ctrl *gomock.Controller) *MockCSCStatisticRepository {
+	mock := &MockCSCStatisticRepository{ctrl: ctrl}
+	mock.recorder = &MockCSCStatisticRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSCStatisticRepository) EXPECT() *MockCSCStatisticRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockCSCStatisticRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockCSCStatisticRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockCSCStatisticRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockCSCDeckRepository is a mock of CSCDeckRepository interface.
+type MockCSCDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSCDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCSCDeckRepositoryMockRecorder is the mock recorder for MockCSCDeckRepository.
+type MockCSCDeckRepositoryMockRecorder struct {
+	mock *MockCSCDeckRepository
+}
+
+// NewMockCSCDeckRepository creates a new mock instance.
+func NewMockCSCDeckRepository(ctrl *gomock.Controller) *MockCSCDeckRepository {
+	mock := &MockCSCDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockCSCDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSCDeckRepository) EXPECT() *MockCSCDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockCSCDeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockCSCDeckRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockCSCDeckRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockCSCConsensusCache is a mock of CSCConsensusCache interface.
+type MockCSCConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCSCConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockCSCConsensusCacheMockRecorder is the mock recorder for MockCSCConsensusCache.
+type MockCSCConsensusCacheMockRecorder struct {
+	mock *MockCSCConsensusCache
+}
+
+// NewMockCSCConsensusCache creates a new mock instance.
+func NewMockCSCConsensusCache(ctrl *gomock.Controller) *MockCSCConsensusCache {
+	mock := &MockCSCConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockCSCConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCSCConsensusCache) EXPECT() *MockCSCConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCSCConsensusCache) Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, seasonID, method, load)
+	ret0, _ := ret[0].(*entity.ConsensusTierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCSCConsensusCacheMockRecorder) Get(ctx, seasonID, method, load any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCSCConsensusCache)(nil).Get), ctx, seasonID, method, load)
+}
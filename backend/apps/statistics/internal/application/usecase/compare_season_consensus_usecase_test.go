@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompareSeasonConsensusUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	fromSeasonID, _ := id.SeasonIDFromString(testSeasonID)
+	toSeasonID := id.NewSeasonID()
+	fromCharizard, fromMewtwo := id.NewDeckID(), id.NewDeckID()
+	toCharizard, toNewDeck := id.NewDeckID(), id.NewDeckID()
+
+	fromStatistics := []entity.TierStatistic{
+		{DeckID: fromCharizard, SeasonID: fromSeasonID, RankSum: 15, PlacementCount: 3, WeightedRankSum: 15, WeightSum: 3},
+		{DeckID: fromMewtwo, SeasonID: fromSeasonID, RankSum: 21, PlacementCount: 3, WeightedRankSum: 21, WeightSum: 3},
+	}
+	toStatistics := []entity.TierStatistic{
+		{DeckID: toCharizard, SeasonID: toSeasonID, RankSum: 21, PlacementCount: 3, WeightedRankSum: 21, WeightSum: 3},
+		{DeckID: toNewDeck, SeasonID: toSeasonID, RankSum: 18, PlacementCount: 3, WeightedRankSum: 18, WeightSum: 3},
+	}
+	fromDecks := []*entity.Deck{
+		entity.ReconstructDeck(fromCharizard, fromSeasonID, "リザードンex", "https://example.com/decks/charizard-1.png", "charizard"),
+		entity.ReconstructDeck(fromMewtwo, fromSeasonID, "ミュウツーex", "", "mewtwo"),
+	}
+	toDecks := []*entity.Deck{
+		entity.ReconstructDeck(toCharizard, toSeasonID, "リザードンex", "https://example.com/decks/charizard-2.png", "charizard"),
+		entity.ReconstructDeck(toNewDeck, toSeasonID, "新デッキ", "", "new"),
+	}
+
+	type mocks struct {
+		seasonRepo    *MockCSCSeasonRepository
+		placementRepo *MockCSCPlacementRepository
+		statisticRepo *MockCSCStatisticRepository
+		deckRepo      *MockCSCDeckRepository
+	}
+	expectSeason := func(m mocks, seasonID id.SeasonID, statistics []entity.TierStatistic, decks []*entity.Deck) {
+		m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+		m.placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+		m.statisticRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(statistics, nil)
+		m.deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.CompareSeasonConsensusParams
+		setupMock   func(m mocks)
+		want        *usecase.CompareSeasonConsensusResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: アーキタイプで対応付けられ、変化・新規・脱落が返される",
+			params:   usecase.CompareSeasonConsensusParams{FromSeasonID: testSeasonID, ToSeasonID: toSeasonID.String()},
+			setupMock: func(m mocks) {
+				expectSeason(m, fromSeasonID, fromStatistics, fromDecks)
+				expectSeason(m, toSeasonID, toStatistics, toDecks)
+			},
+			want: &usecase.CompareSeasonConsensusResult{
+				FromSeasonID: testSeasonID,
+				ToSeasonID:   toSeasonID.String(),
+				Method:       "mean",
+				Archetypes: []usecase.CSCArchetype{
+					{
+						ArchetypeKey: "charizard",
+						From: usecase.CSCDeck{
+							DeckID:          fromCharizard.String(),
+							Nickname:        "リザードンex",
+							ImageURL:        "https://example.com/decks/charizard-1.png",
+							Tier:            "A",
+							AverageTierRank: 5,
+							PlacementCount:  3,
+						},
+						To: usecase.CSCDeck{
+							DeckID:          toCharizard.String(),
+							Nickname:        "リザードンex",
+							ImageURL:        "https://example.com/decks/charizard-2.png",
+							Tier:            "SS",
+							AverageTierRank: 7,
+							PlacementCount:  3,
+						},
+						AverageTierRankChange: 2,
+						TierChange:            2,
+					},
+				},
+				NewEntries: []usecase.CSCDeck{
+					{DeckID: toNewDeck.String(), Nickname: "新デッキ", Tier: "S", AverageTierRank: 6, PlacementCount: 3},
+				},
+				Dropped: []usecase.CSCDeck{
+					{DeckID: fromMewtwo.String(), Nickname: "ミュウツーex", Tier: "SS", AverageTierRank: 7, PlacementCount: 3},
+				},
+			},
+		},
+		{
+			caseName:    "異常系: 同じシーズンが指定された場合、バリデーションエラーを返す",
+			params:      usecase.CompareSeasonConsensusParams{FromSeasonID: testSeasonID, ToSeasonID: testSeasonID},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			errContains: "must be different",
+		},
+		{
+			caseName:    "異常系: 不正な算出方式が指定された場合、バリデーションエラーを返す",
+			params:      usecase.CompareSeasonConsensusParams{FromSeasonID: testSeasonID, ToSeasonID: toSeasonID.String(), Method: "unknown"},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			errContains: "invalid method",
+		},
+		{
+			caseName: "異常系: 比較先のシーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.CompareSeasonConsensusParams{FromSeasonID: testSeasonID, ToSeasonID: toSeasonID.String()},
+			setupMock: func(m mocks) {
+				expectSeason(m, fromSeasonID, fromStatistics, fromDecks)
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), toSeasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: デッキの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.CompareSeasonConsensusParams{FromSeasonID: testSeasonID, ToSeasonID: toSeasonID.String()},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), fromSeasonID).Return(true, nil)
+				m.placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), fromSeasonID).Return(3, nil)
+				m.statisticRepo.EXPECT().FindBySeason(gomock.Any(), fromSeasonID).Return(fromStatistics, nil)
+				m.deckRepo.EXPECT().FindBySeason(gomock.Any(), fromSeasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find decks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				seasonRepo:    NewMockCSCSeasonRepository(ctrl),
+				placementRepo: NewMockCSCPlacementRepository(ctrl),
+				statisticRepo: NewMockCSCStatisticRepository(ctrl),
+				deckRepo:      NewMockCSCDeckRepository(ctrl),
+			}
+			tt.setupMock(m)
+			cache := NewMockCSCConsensusCache(ctrl)
+			passThroughConsensusCache(cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()))
+
+			usecase := usecase.NewCompareSeasonConsensusUsecase(m.seasonRepo, m.placementRepo, m.statisticRepo, m.deckRepo, cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// parseConsensusOptions は算出方式と最小配置数を変換する（未指定の場合は既定値）
+func parseConsensusOptions(m string, minPlacementCount int) (entity.ConsensusMethod, int, error) {
+	method := entity.DefaultConsensusMethod
+	if m != "" {
+		var err error
+		if method, err = entity.ParseConsensusMethod(m); err != nil {
+			return "", 0, errs.NewValidationError("invalid method", err)
+		}
+	}
+
+	if minPlacementCount == 0 {
+		minPlacementCount = entity.DefaultMinPlacementCount
+	}
+	if minPlacementCount < 1 {
+		return "", 0, errs.NewValidationError("min_placement_count must be at least 1", nil)
+	}
+	return method, minPlacementCount, nil
+}
+
+type consensusPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+	CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error)
+}
+
+type consensusStatisticRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error)
+}
+
+type consensusCache interface {
+	Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error)
+}
+
+// cachedConsensus はキャッシュを経由してシーズンの集計結果を取得し、最小配置数で絞り込む
+// キャッシュにない場合や有効期限が切れた場合のみ calculateConsensus で算出する
+func cachedConsensus(ctx context.Context, cache consensusCache, placementRepo consensusPlacementRepository, statisticRepo consensusStatisticRepository, seasonID id.SeasonID, method entity.ConsensusMethod, minPlacementCount int) (*entity.ConsensusTierList, error) {
+	consensus, err := cache.Get(ctx, seasonID, method, func(ctx context.Context) (*entity.ConsensusTierList, error) {
+		return calculateConsensus(ctx, placementRepo, statisticRepo, seasonID, method)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consensus.FilterByPlacementCount(minPlacementCount), nil
+}
+
+// calculateConsensus は算出方式に応じてシーズンの全デッキの集計結果を算出する（最小配置数は取得後に適用する）
+// 平均方式は差分更新されたティア統計を使い、それ以外の方式は配置を全件取得して算出する
+func calculateConsensus(ctx context.Context, placementRepo consensusPlacementRepository, statisticRepo consensusStatisticRepository, seasonID id.SeasonID, method entity.ConsensusMethod) (*entity.ConsensusTierList, error) {
+	totalTierLists, err := placementRepo.CountTierListsBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tier lists: %w", err)
+	}
+
+	if method == entity.ConsensusMethodMean {
+		statistics, err := statisticRepo.FindBySeason(ctx, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find tier statistics: %w", err)
+		}
+		consensus, err := entity.ConsensusFromStatistics(seasonID, totalTierLists, statistics, 1, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate consensus: %w", err)
+		}
+		return consensus, nil
+	}
+
+	algorithm, err := entity.NewConsensusAlgorithm(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consensus algorithm: %w", err)
+	}
+
+	placements, err := placementRepo.FindBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find placements: %w", err)
+	}
+
+	consensus, err := entity.CalculateConsensus(seasonID, totalTierLists, placements, algorithm, 1, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate consensus: %w", err)
+	}
+	return consensus, nil
+}
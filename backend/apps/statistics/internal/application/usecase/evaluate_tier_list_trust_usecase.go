@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// trustWeightTolerance は信頼度が変化したとみなさない誤差
+const trustWeightTolerance = 1e-9
+
+// EvaluateTierListTrustParams はティアリストの信頼度の評価の入力
+// SeasonID が空の場合は全シーズンを対象とする
+type EvaluateTierListTrustParams struct {
+	SeasonID string
+}
+
+// EvaluateTierListTrustResult はティアリストの信頼度の評価結果
+// ReweightedCount は信頼度が変化し、ティア統計の重み付きの累計を更新したティアリストの数
+type EvaluateTierListTrustResult struct {
+	EvaluatedCount  int
+	FlaggedCount    int
+	ReweightedCount int
+}
+
+type ETTSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+	FindAllIDs(ctx context.Context) ([]id.SeasonID, error)
+}
+
+type ETTPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+}
+
+type ETTTrustScoreRepository interface {
+	FindAuthorsBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierListAuthor, error)
+	FindWeightForUpdate(ctx context.Context, tierListID id.TierListID) (float64, error)
+	Save(ctx context.Context, score entity.TrustScore) error
+	SaveAndReweight(ctx context.Context, score entity.TrustScore) error
+}
+
+type ETTTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	RunInReadOnlySnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+	RunWithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) error
+}
+
+type ETTConsensusCache interface {
+	InvalidateSeason(seasonID id.SeasonID)
+}
+
+type EvaluateTierListTrustUsecase struct {
+	seasonRepo     ETTSeasonRepository
+	placementRepo  ETTPlacementRepository
+	trustScoreRepo ETTTrustScoreRepository
+	txManager      ETTTxManager
+	cache          ETTConsensusCache
+	evaluator      entity.TrustEvaluator
+}
+
+func NewEvaluateTierListTrustUsecase(
+	seasonRepo ETTSeasonRepository,
+	placementRepo ETTPlacementRepository,
+	trustScoreRepo ETTTrustScoreRepository,
+	txManager ETTTxManager,
+	cache ETTConsensusCache,
+) *EvaluateTierListTrustUsecase {
+	return &EvaluateTierListTrustUsecase{
+		seasonRepo:     seasonRepo,
+		placementRepo:  placementRepo,
+		trustScoreRepo: trustScoreRepo,
+		txManager:      txManager,
+		cache:          cache,
+		evaluator:      entity.NewTrustEvaluator(),
+	}
+}
+
+// Execute はシーズン内のティアリストを集計ティアリストと比較して信頼度を評価し、保存する
+// 信頼度が変化したティアリストはティア統計の重み付きの累計にも反映し、そのシーズンの集計結果を再計算させる
+func (u *EvaluateTierListTrustUsecase) Execute(ctx context.Context, params EvaluateTierListTrustParams) (*EvaluateTierListTrustResult, error) {
+	seasonIDs, err := u.resolveSeasonIDs(ctx, params.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EvaluateTierListTrustResult{}
+	for _, seasonID := range seasonIDs {
+		if err := u.evaluateSeason(ctx, seasonID, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// resolveSeasonIDs は評価対象のシーズンIDを返す（未指定の場合は全シーズン）
+func (u *EvaluateTierListTrustUsecase) resolveSeasonIDs(ctx context.Context, s string) ([]id.SeasonID, error) {
+	seasonID, err := parseOptionalSeasonID(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if seasonID == nil {
+		seasonIDs, err := u.seasonRepo.FindAllIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find seasons: %w", err)
+		}
+		return seasonIDs, nil
+	}
+
+	exists, err := u.seasonRepo.Exists(ctx, *seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check season existence: %w", err)
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError("season not found", nil)
+	}
+	return []id.SeasonID{*seasonID}, nil
+}
+
+// evaluateSeason は1シーズン分の信頼度を評価し、結果を result に加算する
+// サーバーのジョブとコマンドから同時に実行されないよう、シーズンごとのアドバイザリロックを保持して評価する
+func (u *EvaluateTierListTrustUsecase) evaluateSeason(ctx context.Context, seasonID id.SeasonID, result *EvaluateTierListTrustResult) error {
+	return u.txManager.RunWithAdvisoryLock(ctx, trustEvaluationLockKey(seasonID), func(ctx context.Context) error {
+		// 投稿者情報と配置は同じスナップショットから読み取る
+		var (
+			authors    []entity.TierListAuthor
+			placements []entity.Placement
+		)
+		err := u.txManager.RunInReadOnlySnapshot(ctx, func(ctx context.Context) error {
+			var err error
+			authors, err = u.trustScoreRepo.FindAuthorsBySeason(ctx, seasonID)
+			if err != nil {
+				return fmt.Errorf("failed to find tier list authors: %w", err)
+			}
+
+			placements, err = u.placementRepo.FindBySeason(ctx, seasonID)
+			if err != nil {
+				return fmt.Errorf("failed to find placements: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		scores := u.evaluator.Evaluate(seasonID, authors, placements)
+
+		reweighted := 0
+		for _, score := range scores {
+			changed, err := u.saveScore(ctx, score)
+			if err != nil {
+				if isNotFound(err) {
+					// 評価中に削除されたティアリストは統計に含まれないため、評価結果を保存しない
+					continue
+				}
+				return err
+			}
+			if changed {
+				reweighted++
+			}
+
+			result.EvaluatedCount++
+			if score.Flagged() {
+				result.FlaggedCount++
+			}
+		}
+
+		// 重みが変わったシーズンの集計結果は再計算させる
+		if reweighted > 0 {
+			result.ReweightedCount += reweighted
+			u.cache.InvalidateSeason(seasonID)
+		}
+		return nil
+	})
+}
+
+// saveScore は1件のティアリストの信頼度を保存し、重みが変化した場合はティア統計に反映する
+// ティアリストの行をロックしてから保存済みの重みを読み直し、配置の保存・削除・非表示と競合しないようにする
+// 信頼度の保存とティア統計の重みの付け替えは同一トランザクションで行う
+func (u *EvaluateTierListTrustUsecase) saveScore(ctx context.Context, score entity.TrustScore) (reweighted bool, err error) {
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		currentWeight, err := u.trustScoreRepo.FindWeightForUpdate(ctx, score.TierListID)
+		if err != nil {
+			return fmt.Errorf("failed to find trust weight: %w", err)
+		}
+
+		if math.Abs(currentWeight-score.TrustWeight) <= trustWeightTolerance {
+			if err := u.trustScoreRepo.Save(ctx, score); err != nil {
+				return fmt.Errorf("failed to save trust score: %w", err)
+			}
+			return nil
+		}
+
+		if err := u.trustScoreRepo.SaveAndReweight(ctx, score); err != nil {
+			return fmt.Errorf("failed to save trust score: %w", err)
+		}
+		reweighted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return reweighted, nil
+}
+
+// trustEvaluationLockKey はシーズンの信頼度の評価を直列化するアドバイザリロックのキーを返す
+func trustEvaluationLockKey(seasonID id.SeasonID) string {
+	return "tier_list_trust:" + seasonID.String()
+}
+
+// isNotFound はリソースが存在しないことを表すエラーかどうかを返す
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
@@ -0,0 +1,296 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/evaluate_tier_list_trust_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/evaluate_tier_list_trust_usecase.go -destination=./apps/statistics/internal/application/usecase/evaluate_tier_list_trust_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockETTSeasonRepository is a mock of ETTSeasonRepository interface.
+type MockETTSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockETTSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockETTSeasonRepositoryMockRecorder is the mock recorder for MockETTSeasonRepository.
+type MockETTSeasonRepositoryMockRecorder struct {
+	mock *MockETTSeasonRepository
+}
+
+// NewMockETTSeasonRepository creates a new mock instance.
+func NewMockETTSeasonRepository(ctrl *gomock.Controller) *MockETTSeasonRepository {
+	mock := &MockETTSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockETTSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETTSeasonRepository) EXPECT() *MockETTSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockETTSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockETTSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockETTSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// FindAllIDs mocks base method.
+func (m *MockETTSeasonRepository) FindAllIDs(ctx context.Context) ([]id.SeasonID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllIDs", ctx)
+	ret0, _ := ret[0].([]id.SeasonID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllIDs indicates an expected call of FindAllIDs.
+func (mr *MockETTSeasonRepositoryMockRecorder) FindAllIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllIDs", reflect.TypeOf((*MockETTSeasonRepository)(nil).FindAllIDs), ctx)
+}
+
+// MockETTPlacementRepository is a mock of ETTPlacementRepository interface.
+type MockETTPlacementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockETTPlacementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockETTPlacementRepositoryMockRecorder is the mock recorder for MockETTPlacementRepository.
+type MockETTPlacementRepositoryMockRecorder struct {
+	mock *MockETTPlacementRepository
+}
+
+// NewMockETTPlacementRepository creates a new mock instance.
+func NewMockETTPlacementRepository(ctrl *gomock.Controller) *MockETTPlacementRepository {
+	mock := &MockETTPlacementRepository{ctrl: ctrl}
+	mock.recorder = &MockETTPlacementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETTPlacementRepository) EXPECT() *MockETTPlacementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockETTPlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockETTPlacementRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockETTPlacementRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockETTTrustScoreRepository is a mock of ETTTrustScoreRepository interface.
+type MockETTTrustScoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockETTTrustScoreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockETTTrustScoreRepositoryMockRecorder is the mock recorder for MockETTTrustScoreRepository.
+type MockETTTrustScoreRepositoryMockRecorder struct {
+	mock *MockETTTrustScoreRepository
+}
+
+// NewMockETTTrustScoreRepository creates a new mock instance.
+func NewMockETTTrustScoreRepository(ctrl *gomock.Controller) *MockETTTrustScoreRepository {
+	mock := &MockETTTrustScoreRepository{ctrl: ctrl}
+	mock.recorder = &MockETTTrustScoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETTTrustScoreRepository) EXPECT() *MockETTTrustScoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAuthorsBySeason mocks base method.
+func (m *MockETTTrustScoreRepository) FindAuthorsBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierListAuthor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuthorsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierListAuthor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuthorsBySeason indicates an expected call of FindAuthorsBySeason.
+func (mr *MockETTTrustScoreRepositoryMockRecorder) FindAuthorsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuthorsBySeason", reflect.TypeOf((*MockETTTrustScoreRepository)(nil).FindAuthorsBySeason), ctx, seasonID)
+}
+
+// FindWeightForUpdate mocks base method.
+func (m *MockETTTrustScoreRepository) FindWeightForUpdate(ctx context.Context, tierListID id.TierListID) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWeightForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWeightForUpdate indicates an expected call of FindWeightForUpdate.
+func (mr *MockETTTrustScoreRepositoryMockRecorder) FindWeightForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWeightForUpdate", reflect.TypeOf((*MockETTTrustScoreRepository)(nil).FindWeightForUpdate), ctx, tierListID)
+}
+
+// Save mocks base method.
+func (m *MockETTTrustScoreRepository) Save(ctx context.Context, score entity.TrustScore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, score)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockETTTrustScoreRepositoryMockRecorder) Save(ctx, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockETTTrustScoreRepository)(nil).Save), ctx, score)
+}
+
+// SaveAndReweight mocks base method.
+func (m *MockETTTrustScoreRepository) SaveAndReweight(ctx context.Context, score entity.TrustScore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAndReweight", ctx, score)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAndReweight indicates an expected call of SaveAndReweight.
+func (mr *MockETTTrustScoreRepositoryMockRecorder) SaveAndReweight(ctx, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAndReweight", reflect.TypeOf((*MockETTTrustScoreRepository)(nil).SaveAndReweight), ctx, score)
+}
+
+// MockETTTxManager is a mock of ETTTxManager interface.
+type MockETTTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockETTTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockETTTxManagerMockRecorder is the mock recorder for MockETTTxManager.
+type MockETTTxManagerMockRecorder struct {
+	mock *MockETTTxManager
+}
+
+// NewMockETTTxManager creates a new mock instance.
+func NewMockETTTxManager(ctrl *gomock.Controller) *MockETTTxManager {
+	mock := &MockETTTxManager{ctrl: ctrl}
+	mock.recorder = &MockETTTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETTTxManager) EXPECT() *MockETTTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInReadOnlySnapshot mocks base method.
+func (m *MockETTTxManager) RunInReadOnlySnapshot(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInReadOnlySnapshot", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInReadOnlySnapshot indicates an expected call of RunInReadOnlySnapshot.
+func (mr *MockETTTxManagerMockRecorder) RunInReadOnlySnapshot(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInReadOnlySnapshot", reflect.TypeOf((*MockETTTxManager)(nil).RunInReadOnlySnapshot), ctx, fn)
+}
+
+// RunInTx mocks base method.
+func (m *MockETTTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockETTTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockETTTxManager)(nil).RunInTx), ctx, fn)
+}
+
+// RunWithAdvisoryLock mocks base method.
+func (m *MockETTTxManager) RunWithAdvisoryLock(ctx context.Context, key string, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunWithAdvisoryLock", ctx, key, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunWithAdvisoryLock indicates an expected call of RunWithAdvisoryLock.
+func (mr *MockETTTxManagerMockRecorder) RunWithAdvisoryLock(ctx, key, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWithAdvisoryLock", reflect.TypeOf((*MockETTTxManager)(nil).RunWithAdvisoryLock), ctx, key, fn)
+}
+
+// MockETTConsensusCache is a mock of ETTConsensusCache interface.
+type MockETTConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockETTConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockETTConsensusCacheMockRecorder is the mock recorder for MockETTConsensusCache.
+type MockETTConsensusCacheMockRecorder struct {
+	mock *MockETTConsensusCache
+}
+
+// NewMockETTConsensusCache creates a new mock instance.
+func NewMockETTConsensusCache(ctrl *gomock.Controller) *MockETTConsensusCache {
+	mock := &MockETTConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockETTConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETTConsensusCache) EXPECT() *MockETTConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateSeason mocks base method.
+func (m *MockETTConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateSeason", seasonID)
+}
+
+// InvalidateSeason indicates an expected call of InvalidateSeason.
+func (mr *MockETTConsensusCacheMockRecorder) InvalidateSeason(seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSeason", reflect.TypeOf((*MockETTConsensusCache)(nil).InvalidateSeason), seasonID)
+}
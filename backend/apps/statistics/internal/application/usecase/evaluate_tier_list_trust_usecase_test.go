@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEvaluateTierListTrustUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	originalID, duplicateID := id.NewTierListID(), id.NewTierListID()
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	// 同じ作成者が同じ配置のティアリストを2件投稿している
+	authors := []entity.TierListAuthor{
+		{TierListID: originalID, AuthorName: "荒らし", CreatedAt: createdAt},
+		{TierListID: duplicateID, AuthorName: "荒らし", CreatedAt: createdAt.Add(time.Minute)},
+	}
+	placements := []entity.Placement{
+		entity.NewPlacement(originalID, deckA, rank.TierSS),
+		entity.NewPlacement(originalID, deckB, rank.TierE),
+		entity.NewPlacement(duplicateID, deckA, rank.TierSS),
+		entity.NewPlacement(duplicateID, deckB, rank.TierE),
+	}
+
+	type mocks struct {
+		seasonRepo     *MockETTSeasonRepository
+		placementRepo  *MockETTPlacementRepository
+		trustScoreRepo *MockETTTrustScoreRepository
+		txManager      *MockETTTxManager
+		cache          *MockETTConsensusCache
+	}
+	// シーズンの評価はアドバイザリロックを保持して行い、投稿者情報と配置は同じスナップショットから読み取る
+	lockAndReadSnapshot := func(m mocks) {
+		m.txManager.EXPECT().RunWithAdvisoryLock(gomock.Any(), "tier_list_trust:"+testSeasonID, gomock.Any()).DoAndReturn(func(ctx context.Context, key string, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+		m.txManager.EXPECT().RunInReadOnlySnapshot(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+	// 信頼度の保存はティアリストごとのトランザクションで行う
+	runInTx := func(m mocks) *gomock.Call {
+		return m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+	isTierList := func(tierListID id.TierListID) gomock.Matcher {
+		return gomock.Cond(func(s entity.TrustScore) bool {
+			return s.TierListID == tierListID
+		})
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.EvaluateTierListTrustParams
+		setupMock   func(m mocks)
+		want        *usecase.EvaluateTierListTrustResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 信頼度が変化したティアリストのみティア統計の重みが付け替えられ、シーズンの集計結果が無効化される",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				lockAndReadSnapshot(m)
+				m.trustScoreRepo.EXPECT().FindAuthorsBySeason(gomock.Any(), seasonID).Return(authors, nil)
+				m.placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				runInTx(m).Times(2)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), originalID).Return(1.0, nil)
+				m.trustScoreRepo.EXPECT().Save(gomock.Any(), isTierList(originalID)).Return(nil)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), duplicateID).Return(1.0, nil)
+				m.trustScoreRepo.EXPECT().SaveAndReweight(gomock.Any(), isTierList(duplicateID)).Return(nil)
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+			want: &usecase.EvaluateTierListTrustResult{EvaluatedCount: 2, FlaggedCount: 1, ReweightedCount: 1},
+		},
+		{
+			caseName: "正常系: ロックの取得後に読み直した重みが既に付け替え済みの場合、ティア統計を更新せずに信頼度のみ保存する",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				lockAndReadSnapshot(m)
+				m.trustScoreRepo.EXPECT().FindAuthorsBySeason(gomock.Any(), seasonID).Return(authors, nil)
+				m.placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				runInTx(m).Times(2)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), originalID).Return(1.0, nil)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), duplicateID).Return(0.0, nil)
+				m.trustScoreRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			want: &usecase.EvaluateTierListTrustResult{EvaluatedCount: 2, FlaggedCount: 1},
+		},
+		{
+			caseName: "正常系: 評価中に削除されたティアリストは、信頼度を保存せずに評価件数から除外する",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				lockAndReadSnapshot(m)
+				m.trustScoreRepo.EXPECT().FindAuthorsBySeason(gomock.Any(), seasonID).Return(authors, nil)
+				m.placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				runInTx(m).Times(2)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), originalID).Return(1.0, nil)
+				m.trustScoreRepo.EXPECT().Save(gomock.Any(), isTierList(originalID)).Return(nil)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), duplicateID).Return(0.0, errs.NewNotFoundError("tier list not found", nil))
+			},
+			want: &usecase.EvaluateTierListTrustResult{EvaluatedCount: 1},
+		},
+		{
+			caseName: "正常系: シーズン未指定の場合、全シーズンが評価され、重みが変わらないシーズンの集計結果は無効化されない",
+			params:   usecase.EvaluateTierListTrustParams{},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().FindAllIDs(gomock.Any()).Return([]id.SeasonID{seasonID}, nil)
+				lockAndReadSnapshot(m)
+				m.trustScoreRepo.EXPECT().FindAuthorsBySeason(gomock.Any(), seasonID).Return([]entity.TierListAuthor{}, nil)
+				m.placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return([]entity.Placement{}, nil)
+			},
+			want: &usecase.EvaluateTierListTrustResult{},
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: "invalid"},
+			setupMock: func(m mocks) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: 信頼度の保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.EvaluateTierListTrustParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				lockAndReadSnapshot(m)
+				m.trustScoreRepo.EXPECT().FindAuthorsBySeason(gomock.Any(), seasonID).Return(authors, nil)
+				m.placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				runInTx(m)
+				m.trustScoreRepo.EXPECT().FindWeightForUpdate(gomock.Any(), gomock.Any()).Return(1.0, nil)
+				m.trustScoreRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("repository error")).AnyTimes()
+				m.trustScoreRepo.EXPECT().SaveAndReweight(gomock.Any(), gomock.Any()).Return(errors.New("repository error")).AnyTimes()
+			},
+			wantErr:     true,
+			errContains: "failed to save trust score",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				seasonRepo:     NewMockETTSeasonRepository(ctrl),
+				placementRepo:  NewMockETTPlacementRepository(ctrl),
+				trustScoreRepo: NewMockETTTrustScoreRepository(ctrl),
+				txManager:      NewMockETTTxManager(ctrl),
+				cache:          NewMockETTConsensusCache(ctrl),
+			}
+			tt.setupMock(m)
+
+			usecase := usecase.NewEvaluateTierListTrustUsecase(m.seasonRepo, m.placementRepo, m.trustScoreRepo, m.txManager, m.cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
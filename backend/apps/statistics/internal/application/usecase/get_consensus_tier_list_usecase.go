@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// GetConsensusTierListParams は集計ティアリスト取得の入力
+// Method が空の場合、MinPlacementCount が0の場合はそれぞれ既定値を使用する
+type GetConsensusTierListParams struct {
+	SeasonID          string
+	Method            string
+	MinPlacementCount int
+}
+
+// GetConsensusTierListResult は集計ティアリスト取得結果
+type GetConsensusTierListResult struct {
+	SeasonID       string
+	Method         string
+	GeneratedAt    time.Time
+	TotalTierLists int
+	Tiers          []GCTTier
+}
+
+// GCTTier はティアごとの集計結果（SS → E の順）
+type GCTTier struct {
+	Label string
+	Decks []GCTDeck
+}
+
+// GCTDeck はティアに振り分けられたデッキの集計結果
+// AverageTierRank は算出方式によるスコアをTierRankの尺度（E=1 〜 SS=7）で表したもの
+// AverageTierRankLower / AverageTierRankUpper はその95%信頼区間、TierProbability は振り分けられたティアに属する確率
+type GCTDeck struct {
+	DeckID               string
+	Nickname             string
+	ImageURL             string
+	AverageTierRank      float64
+	AverageTierRankLower float64
+	AverageTierRankUpper float64
+	TierProbability      float64
+	PlacementCount       int
+}
+
+type GCTSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+}
+
+type GCTPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+	CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error)
+}
+
+type GCTStatisticRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error)
+}
+
+type GCTDeckRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error)
+}
+
+type GCTConsensusCache interface {
+	Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error)
+}
+
+type GetConsensusTierListUsecase struct {
+	seasonRepo    GCTSeasonRepository
+	placementRepo GCTPlacementRepository
+	statisticRepo GCTStatisticRepository
+	deckRepo      GCTDeckRepository
+	cache         GCTConsensusCache
+}
+
+func NewGetConsensusTierListUsecase(
+	seasonRepo GCTSeasonRepository,
+	placementRepo GCTPlacementRepository,
+	statisticRepo GCTStatisticRepository,
+	deckRepo GCTDeckRepository,
+	cache GCTConsensusCache,
+) *GetConsensusTierListUsecase {
+	return &GetConsensusTierListUsecase{
+		seasonRepo:    seasonRepo,
+		placementRepo: placementRepo,
+		statisticRepo: statisticRepo,
+		deckRepo:      deckRepo,
+		cache:         cache,
+	}
+}
+
+// Execute は集計ティアリスト取得を実行
+func (u *GetConsensusTierListUsecase) Execute(ctx context.Context, params GetConsensusTierListParams) (*GetConsensusTierListResult, error) {
+	seasonID, err := id.SeasonIDFromString(params.SeasonID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid season_id", err)
+	}
+
+	method, minPlacementCount, err := parseConsensusOptions(params.Method, params.MinPlacementCount)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := u.seasonRepo.Exists(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check season: %w", err)
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError("season not found", nil)
+	}
+
+	consensus, err := cachedConsensus(ctx, u.cache, u.placementRepo, u.statisticRepo, seasonID, method, minPlacementCount)
+	if err != nil {
+		return nil, err
+	}
+
+	decks, err := u.deckRepo.FindBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decks: %w", err)
+	}
+	decksByID := make(map[id.DeckID]*entity.Deck, len(decks))
+	for _, deck := range decks {
+		decksByID[deck.ID()] = deck
+	}
+
+	result := &GetConsensusTierListResult{
+		SeasonID:       consensus.SeasonID().String(),
+		Method:         string(consensus.Method()),
+		GeneratedAt:    consensus.GeneratedAt(),
+		TotalTierLists: consensus.TotalTierLists(),
+		Tiers:          make([]GCTTier, 0, len(rank.AllTierRanks())),
+	}
+	for _, tierRank := range rank.AllTierRanks() {
+		entries := consensus.EntriesByTier(tierRank)
+		tier := GCTTier{
+			Label: tierRank.String(),
+			Decks: make([]GCTDeck, 0, len(entries)),
+		}
+		for _, entry := range entries {
+			deck := GCTDeck{
+				DeckID:               entry.DeckID.String(),
+				AverageTierRank:      entry.Score,
+				AverageTierRankLower: entry.ScoreLower,
+				AverageTierRankUpper: entry.ScoreUpper,
+				TierProbability:      entry.TierProbability,
+				PlacementCount:       entry.PlacementCount,
+			}
+			// 集計後に削除されたデッキなど参照情報がない場合はIDのみを返す
+			if d, ok := decksByID[entry.DeckID]; ok {
+				deck.Nickname = d.Nickname()
+				deck.ImageURL = d.ImageURL()
+			}
+			tier.Decks = append(tier.Decks, deck)
+		}
+		result.Tiers = append(result.Tiers, tier)
+	}
+
+	return result, nil
+}
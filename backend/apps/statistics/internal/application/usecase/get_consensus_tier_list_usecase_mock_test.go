@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/get_consensus_tier_list_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/get_consensus_tier_list_usecase.go -destination=./apps/statistics/internal/application/usecase/get_consensus_tier_list_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGCTSeasonRepository is a mock of GCTSeasonRepository interface.
+type MockGCTSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCTSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGCTSeasonRepositoryMockRecorder is the mock recorder for MockGCTSeasonRepository.
+type MockGCTSeasonRepositoryMockRecorder struct {
+	mock *MockGCTSeasonRepository
+}
+
+// NewMockGCTSeasonRepository creates a new mock instance.
+func NewMockGCTSeasonRepository(ctrl *gomock.Controller) *MockGCTSeasonRepository {
+	mock := &MockGCTSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockGCTSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCTSeasonRepository) EXPECT() *MockGCTSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockGCTSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockGCTSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockGCTSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// MockGCTPlacementRepository is a mock of GCTPlacementRepository interface.
+type MockGCTPlacementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCTPlacementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGCTPlacementRepositoryMockRecorder is the mock recorder for MockGCTPlacementRepository.
+type MockGCTPlacementRepositoryMockRecorder struct {
+	mock *MockGCTPlacementRepository
+}
+
+// NewMockGCTPlacementRepository creates a new mock instance.
+func NewMockGCTPlacementRepository(ctrl *gomock.Controller) *MockGCTPlacementRepository {
+	mock := &MockGCTPlacementRepository{ctrl: ctrl}
+	mock.recorder = &MockGCTPlacementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCTPlacementRepository) EXPECT() *MockGCTPlacementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTierListsBySeason mocks base method.
+func (m *MockGCTPlacementRepository) CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListsBySeason indicates an expected call of CountTierListsBySeason.
+func (mr *MockGCTPlacementRepositoryMockRecorder) CountTierListsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListsBySeason", reflect.TypeOf((*MockGCTPlacementRepository)(nil).CountTierListsBySeason), ctx, seasonID)
+}
+
+// FindBySeason mocks base method.
+func (m *MockGCTPlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGCTPlacementRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGCTPlacementRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGCTStatisticRepository is a mock of GCTStatisticRepository interface.
+type MockGCTStatisticRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCTStatisticRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGCTStatisticRepositoryMockRecorder is the mock recorder for MockGCTStatisticRepository.
+type MockGCTStatisticRepositoryMockRecorder struct {
+	mock *MockGCTStatisticRepository
+}
+
+// NewMockGCTStatisticRepository creates a new mock instance.
+func NewMockGCTStatisticRepository(ctrl *gomock.Controller) *MockGCTStatisticRepository {
+	mock := &MockGCTStatisticRepository{ctrl: ctrl}
+	mock.recorder = &MockGCTStatisticRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCTStatisticRepository) EXPECT() *MockGCTStatisticRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockGCTStatisticRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGCTStatisticRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGCTStatisticRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGCTDeckRepository is a mock of GCTDeckRepository interface.
+type MockGCTDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCTDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGCTDeckRepositoryMockRecorder is the mock recorder for MockGCTDeckRepository.
+type MockGCTDeckRepositoryMockRecorder struct {
+	mock *MockGCTDeckRepository
+}
+
+// NewMockGCTDeckRepository creates a new mock instance.
+func NewMockGCTDeckRepository(ctrl *gomock.Controller) *MockGCTDeckRepository {
+	mock := &MockGCTDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockGCTDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCTDeckRepository) EXPECT() *MockGCTDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockGCTDeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGCTDeckRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGCTDeckRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGCTConsensusCache is a mock of GCTConsensusCache interface.
+type MockGCTConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCTConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockGCTConsensusCacheMockRecorder is the mock recorder for MockGCTConsensusCache.
+type MockGCTConsensusCacheMockRecorder struct {
+	mock *MockGCTConsensusCache
+}
+
+// NewMockGCTConsensusCache creates a new mock instance.
+func NewMockGCTConsensusCache(ctrl *gomock.Controller) *MockGCTConsensusCache {
+	mock := &MockGCTConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockGCTConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCTConsensusCache) EXPECT() *MockGCTConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGCTConsensusCache) Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, seasonID, method, load)
+	ret0, _ := ret[0].(*entity.ConsensusTierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGCTConsensusCacheMockRecorder) Get(ctx, seasonID, method, load any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGCTConsensusCache)(nil).Get), ctx, seasonID, method, load)
+}
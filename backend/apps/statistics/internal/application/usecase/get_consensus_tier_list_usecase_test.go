@@ -0,0 +1,287 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testSeasonID = "550e8400-e29b-41d4-a716-446655440000"
+
+// createTestTiers は全ティアが空の集計結果を作成し、指定したティアにデッキを設定する
+func createTestTiers(t *testing.T, decks map[string][]usecase.GCTDeck) []usecase.GCTTier {
+	t.Helper()
+
+	tiers := make([]usecase.GCTTier, 0, len(rank.AllTierRanks()))
+	for _, tierRank := range rank.AllTierRanks() {
+		tierDecks := decks[tierRank.String()]
+		if tierDecks == nil {
+			tierDecks = []usecase.GCTDeck{}
+		}
+		tiers = append(tiers, usecase.GCTTier{Label: tierRank.String(), Decks: tierDecks})
+	}
+	return tiers
+}
+
+// withUncertainty は集計結果から該当デッキの信頼区間とティアに属する確率を設定する
+func withUncertainty(t *testing.T, consensus *entity.ConsensusTierList, deck usecase.GCTDeck) usecase.GCTDeck {
+	t.Helper()
+
+	for _, e := range consensus.Entries() {
+		if e.DeckID.String() == deck.DeckID {
+			deck.AverageTierRankLower = e.ScoreLower
+			deck.AverageTierRankUpper = e.ScoreUpper
+			deck.TierProbability = e.TierProbability
+			return deck
+		}
+	}
+	t.Fatalf("deck %s not found in consensus", deck.DeckID)
+	return deck
+}
+
+// passThroughConsensusCache はキャッシュのモックが常に集計結果を算出するよう設定する
+func passThroughConsensusCache(call *gomock.Call) {
+	call.DoAndReturn(func(ctx context.Context, _ id.SeasonID, _ entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error) {
+		return load(ctx)
+	}).AnyTimes()
+}
+
+func TestGetConsensusTierListUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	listA, listB, listC := id.NewTierListID(), id.NewTierListID(), id.NewTierListID()
+	deckA, deckB, deckC := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	placements := []entity.Placement{
+		entity.NewPlacement(listA, deckA, rank.TierSS),
+		entity.NewPlacement(listB, deckA, rank.TierSS),
+		entity.NewPlacement(listC, deckA, rank.TierS),
+		entity.NewPlacement(listA, deckB, rank.TierC),
+		entity.NewPlacement(listB, deckB, rank.TierC),
+		entity.NewPlacement(listC, deckB, rank.TierD),
+		entity.NewPlacement(listA, deckC, rank.TierA),
+	}
+	statistics := []entity.TierStatistic{
+		{DeckID: deckA, SeasonID: seasonID, RankSum: 20, PlacementCount: 3, WeightedRankSum: 20, WeightSum: 3, WeightedRankSquareSum: 134, WeightSquareSum: 3},
+		{DeckID: deckB, SeasonID: seasonID, RankSum: 8, PlacementCount: 3, WeightedRankSum: 8, WeightSum: 3, WeightedRankSquareSum: 22, WeightSquareSum: 3},
+		{DeckID: deckC, SeasonID: seasonID, RankSum: 5, PlacementCount: 1, WeightedRankSum: 5, WeightSum: 1, WeightedRankSquareSum: 25, WeightSquareSum: 1},
+	}
+	// 信頼区間の値はエンティティのテストで検証するため、同じ入力からの集計結果を期待値に使う
+	meanConsensus, err := entity.ConsensusFromStatistics(seasonID, 3, statistics, 1, time.Now())
+	require.NoError(t, err, "failed to calculate mean consensus")
+	medianConsensus, err := entity.CalculateConsensus(seasonID, 3, placements, entity.MedianAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "failed to calculate median consensus")
+	decks := []*entity.Deck{
+		entity.ReconstructDeck(deckA, seasonID, "リザニンフ", "https://example.com/decks/a.png", ""),
+		entity.ReconstructDeck(deckC, seasonID, "ピカチュウex", "", ""),
+	}
+
+	tests := []struct {
+		caseName  string
+		params    usecase.GetConsensusTierListParams
+		setupMock func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository)
+		// setupCache が未指定の場合、キャッシュは常に集計結果を算出する
+		setupCache  func(cache *MockGCTConsensusCache)
+		want        *usecase.GetConsensusTierListResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 既定の方式ではティア統計から既定の最小配置数で集計され、全ティアが返される",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				statisticRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(statistics, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			want: &usecase.GetConsensusTierListResult{
+				SeasonID:       testSeasonID,
+				Method:         "mean",
+				TotalTierLists: 3,
+				Tiers: createTestTiers(t, map[string][]usecase.GCTDeck{
+					"SS": {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckA.String(), Nickname: "リザニンフ", ImageURL: "https://example.com/decks/a.png", AverageTierRank: 20.0 / 3, PlacementCount: 3})},
+					"C":  {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckB.String(), AverageTierRank: 8.0 / 3, PlacementCount: 3})},
+				}),
+			},
+		},
+		{
+			caseName: "正常系: キャッシュに集計結果がある場合、ティア統計を取得せずにキャッシュの集計結果が最小配置数で絞り込まれて返される",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			setupCache: func(cache *MockGCTConsensusCache) {
+				cache.EXPECT().Get(gomock.Any(), seasonID, entity.ConsensusMethodMean, gomock.Any()).Return(meanConsensus, nil)
+			},
+			want: &usecase.GetConsensusTierListResult{
+				SeasonID:       testSeasonID,
+				Method:         "mean",
+				TotalTierLists: 3,
+				Tiers: createTestTiers(t, map[string][]usecase.GCTDeck{
+					"SS": {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckA.String(), Nickname: "リザニンフ", ImageURL: "https://example.com/decks/a.png", AverageTierRank: 20.0 / 3, PlacementCount: 3})},
+					"C":  {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckB.String(), AverageTierRank: 8.0 / 3, PlacementCount: 3})},
+				}),
+			},
+		},
+		{
+			caseName: "正常系: 最小配置数を指定した場合、配置数の少ないデッキも含まれる",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID, MinPlacementCount: 1},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				statisticRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(statistics, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			want: &usecase.GetConsensusTierListResult{
+				SeasonID:       testSeasonID,
+				Method:         "mean",
+				TotalTierLists: 3,
+				Tiers: createTestTiers(t, map[string][]usecase.GCTDeck{
+					"SS": {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckA.String(), Nickname: "リザニンフ", ImageURL: "https://example.com/decks/a.png", AverageTierRank: 20.0 / 3, PlacementCount: 3})},
+					"A":  {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckC.String(), Nickname: "ピカチュウex", AverageTierRank: 5, PlacementCount: 1})},
+					"C":  {withUncertainty(t, meanConsensus, usecase.GCTDeck{DeckID: deckB.String(), AverageTierRank: 8.0 / 3, PlacementCount: 3})},
+				}),
+			},
+		},
+		{
+			caseName: "正常系: 算出方式を指定した場合、配置から指定した方式で集計される",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID, Method: "median"},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			want: &usecase.GetConsensusTierListResult{
+				SeasonID:       testSeasonID,
+				Method:         "median",
+				TotalTierLists: 3,
+				Tiers: createTestTiers(t, map[string][]usecase.GCTDeck{
+					"SS": {withUncertainty(t, medianConsensus, usecase.GCTDeck{DeckID: deckA.String(), Nickname: "リザニンフ", ImageURL: "https://example.com/decks/a.png", AverageTierRank: 7, PlacementCount: 3})},
+					"C":  {withUncertainty(t, medianConsensus, usecase.GCTDeck{DeckID: deckB.String(), AverageTierRank: 3, PlacementCount: 3})},
+				}),
+			},
+		},
+		{
+			caseName: "異常系: 未定義の算出方式が指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID, Method: "average"},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid method",
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: "invalid"},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: 最小配置数が負の場合、バリデーションエラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID, MinPlacementCount: -1},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+			},
+			wantErr:     true,
+			errContains: "min_placement_count must be at least 1",
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: ティア統計の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				statisticRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find tier statistics",
+		},
+		{
+			caseName: "異常系: 配置の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID, Method: "borda"},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find placements",
+		},
+		{
+			caseName: "異常系: デッキの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetConsensusTierListParams{SeasonID: testSeasonID},
+			setupMock: func(seasonRepo *MockGCTSeasonRepository, placementRepo *MockGCTPlacementRepository, statisticRepo *MockGCTStatisticRepository, deckRepo *MockGCTDeckRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(3, nil)
+				statisticRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(statistics, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find decks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seasonRepo := NewMockGCTSeasonRepository(ctrl)
+			placementRepo := NewMockGCTPlacementRepository(ctrl)
+			statisticRepo := NewMockGCTStatisticRepository(ctrl)
+			deckRepo := NewMockGCTDeckRepository(ctrl)
+			tt.setupMock(seasonRepo, placementRepo, statisticRepo, deckRepo)
+			cache := NewMockGCTConsensusCache(ctrl)
+			if tt.setupCache != nil {
+				tt.setupCache(cache)
+			} else {
+				passThroughConsensusCache(cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()))
+			}
+
+			usecase := usecase.NewGetConsensusTierListUsecase(seasonRepo, placementRepo, statisticRepo, deckRepo, cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.WithinDuration(t, time.Now(), got.GeneratedAt, time.Minute, "generated at should be current time")
+			got.GeneratedAt = time.Time{}
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// GetDeckTierStatisticsParams はデッキのティア統計取得の入力
+type GetDeckTierStatisticsParams struct {
+	DeckID string
+}
+
+// GetDeckTierStatisticsResult はデッキが登録されたシーズンでのティア統計
+// MeanTierRank / MedianTierRank は信頼度で重み付けしたランク（E=1 〜 SS=7、信頼度のある配置がない場合は0）
+// Percentile はシーズン内のデッキのうち平均ランクがこのデッキ以下の割合（0 〜 100）
+// PlacementShare はシーズン内のティアリストのうちデッキを配置した割合（0 〜 1）
+// FavoriteCount はデッキをお気に入りに登録したユーザー数
+type GetDeckTierStatisticsResult struct {
+	SeasonID       string
+	DeckID         string
+	Nickname       string
+	ImageURL       string
+	Distribution   []GDSTierCount
+	PlacementCount int
+	TierListCount  int
+	TotalTierLists int
+	MeanTierRank   float64
+	MedianTierRank float64
+	Percentile     float64
+	PlacementShare float64
+	FavoriteCount  int
+}
+
+// GDSTierCount はティアごとの配置数（SS → E の順）
+type GDSTierCount struct {
+	Label string
+	Count int
+}
+
+type GDSDeckRepository interface {
+	FindByID(ctx context.Context, deckID id.DeckID) (*entity.Deck, error)
+}
+
+type GDSPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+	CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error)
+}
+
+type GDSFavoriteCountRepository interface {
+	CountByDeckID(ctx context.Context, deckID id.DeckID) (int, error)
+}
+
+type GetDeckTierStatisticsUsecase struct {
+	deckRepo          GDSDeckRepository
+	placementRepo     GDSPlacementRepository
+	favoriteCountRepo GDSFavoriteCountRepository
+}
+
+func NewGetDeckTierStatisticsUsecase(deckRepo GDSDeckRepository, placementRepo GDSPlacementRepository, favoriteCountRepo GDSFavoriteCountRepository) *GetDeckTierStatisticsUsecase {
+	return &GetDeckTierStatisticsUsecase{
+		deckRepo:          deckRepo,
+		placementRepo:     placementRepo,
+		favoriteCountRepo: favoriteCountRepo,
+	}
+}
+
+// Execute はデッキが登録されたシーズンの配置からデッキのティア統計を算出
+// 存在しないデッキはNotFoundエラーを返す
+func (u *GetDeckTierStatisticsUsecase) Execute(ctx context.Context, params GetDeckTierStatisticsParams) (*GetDeckTierStatisticsResult, error) {
+	deckID, err := id.DeckIDFromString(params.DeckID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid deck_id", err)
+	}
+
+	deck, err := u.deckRepo.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTierLists, err := u.placementRepo.CountTierListsBySeason(ctx, deck.SeasonID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tier lists: %w", err)
+	}
+
+	placements, err := u.placementRepo.FindBySeason(ctx, deck.SeasonID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find placements: %w", err)
+	}
+
+	favoriteCount, err := u.favoriteCountRepo.CountByDeckID(ctx, deckID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count favorites: %w", err)
+	}
+
+	summary := entity.SummarizeDeckPlacements(deckID, placements, totalTierLists)
+
+	result := &GetDeckTierStatisticsResult{
+		SeasonID:       deck.SeasonID().String(),
+		DeckID:         deck.ID().String(),
+		Nickname:       deck.Nickname(),
+		ImageURL:       deck.ImageURL(),
+		Distribution:   make([]GDSTierCount, 0, len(rank.AllTierRanks())),
+		PlacementCount: summary.PlacementCount,
+		TierListCount:  summary.TierListCount,
+		TotalTierLists: summary.TotalTierLists,
+		MeanTierRank:   summary.MeanTierRank,
+		MedianTierRank: summary.MedianTierRank,
+		Percentile:     summary.Percentile,
+		PlacementShare: summary.PlacementShare,
+		FavoriteCount:  favoriteCount,
+	}
+	for _, tierRank := range rank.AllTierRanks() {
+		result.Distribution = append(result.Distribution, GDSTierCount{
+			Label: tierRank.String(),
+			Count: summary.Distribution[tierRank],
+		})
+	}
+	return result, nil
+}
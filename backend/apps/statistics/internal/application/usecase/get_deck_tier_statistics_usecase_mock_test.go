@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/get_deck_tier_statistics_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/get_deck_tier_statistics_usecase.go -destination=./apps/statistics/internal/application/usecase/get_deck_tier_statistics_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGDSDeckRepository is a mock of GDSDeckRepository interface.
+type MockGDSDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGDSDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGDSDeckRepositoryMockRecorder is the mock recorder for MockGDSDeckRepository.
+type MockGDSDeckRepositoryMockRecorder struct {
+	mock *MockGDSDeckRepository
+}
+
+// NewMockGDSDeckRepository creates a new mock instance.
+func NewMockGDSDeckRepository(ctrl *gomock.Controller) *MockGDSDeckRepository {
+	mock := &MockGDSDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockGDSDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGDSDeckRepository) EXPECT() *MockGDSDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGDSDeckRepository) FindByID(ctx context.Context, deckID id.DeckID) (*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, deckID)
+	ret0, _ := ret[0].(*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGDSDeckRepositoryMockRecorder) FindByID(ctx, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGDSDeckRepository)(nil).FindByID), ctx, deckID)
+}
+
+// MockGDSPlacementRepository is a mock of GDSPlacementRepository interface.
+type MockGDSPlacementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGDSPlacementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGDSPlacementRepositoryMockRecorder is the mock recorder for MockGDSPlacementRepository.
+type MockGDSPlacementRepositoryMockRecorder struct {
+	mock *MockGDSPlacementRepository
+}
+
+// NewMockGDSPlacementRepository creates a new mock instance.
+func NewMockGDSPlacementRepository(ctrl *gomock.Controller) *MockGDSPlacementRepository {
+	mock := &MockGDSPlacementRepository{ctrl: ctrl}
+	mock.recorder = &MockGDSPlacementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGDSPlacementRepository) EXPECT() *MockGDSPlacementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTierListsBySeason mocks base method.
+func (m *MockGDSPlacementRepository) CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListsBySeason indicates an expected call of CountTierListsBySeason.
+func (mr *MockGDSPlacementRepositoryMockRecorder) CountTierListsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListsBySeason", reflect.TypeOf((*MockGDSPlacementRepository)(nil).CountTierListsBySeason), ctx, seasonID)
+}
+
+// FindBySeason mocks base method.
+func (m *MockGDSPlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGDSPlacementRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGDSPlacementRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGDSFavoriteCountRepository is a mock of GDSFavoriteCountRepository interface.
+type MockGDSFavoriteCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGDSFavoriteCountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGDSFavoriteCountRepositoryMockRecorder is the mock recorder for MockGDSFavoriteCountRepository.
+type MockGDSFavoriteCountRepositoryMockRecorder struct {
+	mock *MockGDSFavoriteCountRepository
+}
+
+// NewMockGDSFavoriteCountRepository creates a new mock instance.
+func NewMockGDSFavoriteCountRepository(ctrl *gomock.Controller) *MockGDSFavoriteCountRepository {
+	mock := &MockGDSFavoriteCountRepository{ctrl: ctrl}
+	mock.recorder = &MockGDSFavoriteCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGDSFavoriteCountRepository) EXPECT() *MockGDSFavoriteCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByDeckID mocks base method.
+func (m *MockGDSFavoriteCountRepository) CountByDeckID(ctx context.Context, deckID id.DeckID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDeckID", ctx, deckID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDeckID indicates an expected call of CountByDeckID.
+func (mr *MockGDSFavoriteCountRepositoryMockRecorder) CountByDeckID(ctx, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDeckID", reflect.TypeOf((*MockGDSFavoriteCountRepository)(nil).CountByDeckID), ctx, deckID)
+}
@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDeckTierStatisticsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	listA, listB, listC := id.NewTierListID(), id.NewTierListID(), id.NewTierListID()
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	deck := entity.ReconstructDeck(deckA, seasonID, "リザニンフ", "https://example.com/decks/a.png", "")
+	placements := []entity.Placement{
+		entity.NewPlacement(listA, deckA, rank.TierSS),
+		entity.NewPlacement(listB, deckA, rank.TierS),
+		entity.NewPlacement(listC, deckA, rank.TierS),
+		entity.NewPlacement(listA, deckB, rank.TierB),
+		entity.NewPlacement(listB, deckB, rank.TierB),
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.GetDeckTierStatisticsParams
+		setupMock   func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository)
+		want        *usecase.GetDeckTierStatisticsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: デッキが登録されたシーズンの配置から、全ティアの分布と順位が返される",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: deckA.String()},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+				deckRepo.EXPECT().FindByID(gomock.Any(), deckA).Return(deck, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(4, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				favoriteRepo.EXPECT().CountByDeckID(gomock.Any(), deckA).Return(8, nil)
+			},
+			want: &usecase.GetDeckTierStatisticsResult{
+				SeasonID: testSeasonID,
+				DeckID:   deckA.String(),
+				Nickname: "リザニンフ",
+				ImageURL: "https://example.com/decks/a.png",
+				Distribution: []usecase.GDSTierCount{
+					{Label: "SS", Count: 1},
+					{Label: "S", Count: 2},
+					{Label: "A", Count: 0},
+					{Label: "B", Count: 0},
+					{Label: "C", Count: 0},
+					{Label: "D", Count: 0},
+					{Label: "E", Count: 0},
+				},
+				PlacementCount: 3,
+				TierListCount:  3,
+				TotalTierLists: 4,
+				MeanTierRank:   19.0 / 3,
+				MedianTierRank: 6,
+				Percentile:     75,
+				PlacementShare: 0.75,
+				FavoriteCount:  8,
+			},
+		},
+		{
+			caseName: "異常系: 不正なデッキIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: "invalid"},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid deck_id",
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: deckA.String()},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+				deckRepo.EXPECT().FindByID(gomock.Any(), deckA).Return(nil, errs.NewNotFoundError("deck not found", nil))
+			},
+			wantErr:     true,
+			errContains: "deck not found",
+		},
+		{
+			caseName: "異常系: ティアリスト数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: deckA.String()},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+				deckRepo.EXPECT().FindByID(gomock.Any(), deckA).Return(deck, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(0, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to count tier lists",
+		},
+		{
+			caseName: "異常系: 配置の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: deckA.String()},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+				deckRepo.EXPECT().FindByID(gomock.Any(), deckA).Return(deck, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(4, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find placements",
+		},
+		{
+			caseName: "異常系: お気に入り数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetDeckTierStatisticsParams{DeckID: deckA.String()},
+			setupMock: func(deckRepo *MockGDSDeckRepository, placementRepo *MockGDSPlacementRepository, favoriteRepo *MockGDSFavoriteCountRepository) {
+				deckRepo.EXPECT().FindByID(gomock.Any(), deckA).Return(deck, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(4, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				favoriteRepo.EXPECT().CountByDeckID(gomock.Any(), deckA).Return(0, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to count favorites",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deckRepo := NewMockGDSDeckRepository(ctrl)
+			placementRepo := NewMockGDSPlacementRepository(ctrl)
+			favoriteRepo := NewMockGDSFavoriteCountRepository(ctrl)
+			tt.setupMock(deckRepo, placementRepo, favoriteRepo)
+
+			usecase := usecase.NewGetDeckTierStatisticsUsecase(deckRepo, placementRepo, favoriteRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// GetDeckTrendParams はデッキの推移取得の入力
+type GetDeckTrendParams struct {
+	SeasonID string
+	DeckID   string
+}
+
+// GetDeckTrendResult はデッキの推移（記録日の古い順）
+type GetDeckTrendResult struct {
+	SeasonID string
+	DeckID   string
+	Points   []GDTPoint
+}
+
+// GDTPoint は記録日ごとの集計ティアリストでのデッキの位置
+// AverageTierRank は重み付き平均ティアランク（E=1 〜 SS=7）
+type GDTPoint struct {
+	SnapshotDate    time.Time
+	Tier            string
+	AverageTierRank float64
+	PlacementCount  int
+}
+
+type GDTSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+}
+
+type GDTDeckTrendRepository interface {
+	FindByDeck(ctx context.Context, seasonID id.SeasonID, deckID id.DeckID) ([]entity.DeckTrendPoint, error)
+}
+
+type GetDeckTrendUsecase struct {
+	seasonRepo    GDTSeasonRepository
+	deckTrendRepo GDTDeckTrendRepository
+}
+
+func NewGetDeckTrendUsecase(seasonRepo GDTSeasonRepository, deckTrendRepo GDTDeckTrendRepository) *GetDeckTrendUsecase {
+	return &GetDeckTrendUsecase{
+		seasonRepo:    seasonRepo,
+		deckTrendRepo: deckTrendRepo,
+	}
+}
+
+// Execute はデッキの集計ティアリストでの推移を取得
+// 記録がないデッキは空の推移を返す
+func (u *GetDeckTrendUsecase) Execute(ctx context.Context, params GetDeckTrendParams) (*GetDeckTrendResult, error) {
+	seasonID, err := id.SeasonIDFromString(params.SeasonID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid season_id", err)
+	}
+
+	deckID, err := id.DeckIDFromString(params.DeckID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid deck_id", err)
+	}
+
+	exists, err := u.seasonRepo.Exists(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check season: %w", err)
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError("season not found", nil)
+	}
+
+	points, err := u.deckTrendRepo.FindByDeck(ctx, seasonID, deckID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deck trend: %w", err)
+	}
+
+	result := &GetDeckTrendResult{
+		SeasonID: seasonID.String(),
+		DeckID:   deckID.String(),
+		Points:   make([]GDTPoint, 0, len(points)),
+	}
+	for _, p := range points {
+		result.Points = append(result.Points, GDTPoint{
+			SnapshotDate:    p.SnapshotDate,
+			Tier:            p.Tier().String(),
+			AverageTierRank: p.TierRank,
+			PlacementCount:  p.PlacementCount,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/get_deck_trend_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/get_deck_trend_usecase.go -destination=./apps/statistics/internal/application/usecase/get_deck_trend_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGDTSeasonRepository is a mock of GDTSeasonRepository interface.
+type MockGDTSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGDTSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGDTSeasonRepositoryMockRecorder is the mock recorder for MockGDTSeasonRepository.
+type MockGDTSeasonRepositoryMockRecorder struct {
+	mock *MockGDTSeasonRepository
+}
+
+// NewMockGDTSeasonRepository creates a new mock instance.
+func NewMockGDTSeasonRepository(ctrl *gomock.Controller) *MockGDTSeasonRepository {
+	mock := &MockGDTSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockGDTSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGDTSeasonRepository) EXPECT() *MockGDTSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockGDTSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockGDTSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockGDTSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// MockGDTDeckTrendRepository is a mock of GDTDeckTrendRepository interface.
+type MockGDTDeckTrendRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGDTDeckTrendRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGDTDeckTrendRepositoryMockRecorder is the mock recorder for MockGDTDeckTrendRepository.
+type MockGDTDeckTrendRepositoryMockRecorder struct {
+	mock *MockGDTDeckTrendRepository
+}
+
+// NewMockGDTDeckTrendRepository creates a new mock instance.
+func NewMockGDTDeckTrendRepository(ctrl *gomock.Controller) *MockGDTDeckTrendRepository {
+	mock := &MockGDTDeckTrendRepository{ctrl: ctrl}
+	mock.recorder = &MockGDTDeckTrendRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGDTDeckTrendRepository) EXPECT() *MockGDTDeckTrendRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByDeck mocks base method.
+func (m *MockGDTDeckTrendRepository) FindByDeck(ctx context.Context, seasonID id.SeasonID, deckID id.DeckID) ([]entity.DeckTrendPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDeck", ctx, seasonID, deckID)
+	ret0, _ := ret[0].([]entity.DeckTrendPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDeck indicates an expected call of FindByDeck.
+func (mr *MockGDTDeckTrendRepositoryMockRecorder) FindByDeck(ctx, seasonID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDeck", reflect.TypeOf((*MockGDTDeckTrendRepository)(nil).FindByDeck), ctx, seasonID, deckID)
+}
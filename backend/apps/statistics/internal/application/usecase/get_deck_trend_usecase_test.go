@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDeckTrendUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	deckID := id.NewDeckID()
+	day1 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	tests := []struct {
+		caseName    string
+		params      usecase.GetDeckTrendParams
+		setupMock   func(seasonRepo *MockGDTSeasonRepository, deckTrendRepo *MockGDTDeckTrendRepository)
+		want        *usecase.GetDeckTrendResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 記録日ごとのティアと平均ティアランクが返される",
+			params:   usecase.GetDeckTrendParams{SeasonID: testSeasonID, DeckID: deckID.String()},
+			setupMock: func(seasonRepo *MockGDTSeasonRepository, deckTrendRepo *MockGDTDeckTrendRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				deckTrendRepo.EXPECT().FindByDeck(gomock.Any(), seasonID, deckID).Return([]entity.DeckTrendPoint{
+					{DeckID: deckID, SnapshotDate: day1, TierRank: 4.4, PlacementCount: 5},
+					{DeckID: deckID, SnapshotDate: day2, TierRank: 5.6, PlacementCount: 9},
+				}, nil)
+			},
+			want: &usecase.GetDeckTrendResult{
+				SeasonID: testSeasonID,
+				DeckID:   deckID.String(),
+				Points: []usecase.GDTPoint{
+					{SnapshotDate: day1, Tier: "B", AverageTierRank: 4.4, PlacementCount: 5},
+					{SnapshotDate: day2, Tier: "S", AverageTierRank: 5.6, PlacementCount: 9},
+				},
+			},
+		},
+		{
+			caseName: "異常系: 不正なデッキIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetDeckTrendParams{SeasonID: testSeasonID, DeckID: "invalid"},
+			setupMock: func(seasonRepo *MockGDTSeasonRepository, deckTrendRepo *MockGDTDeckTrendRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid deck_id",
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.GetDeckTrendParams{SeasonID: testSeasonID, DeckID: deckID.String()},
+			setupMock: func(seasonRepo *MockGDTSeasonRepository, deckTrendRepo *MockGDTDeckTrendRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: 取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetDeckTrendParams{SeasonID: testSeasonID, DeckID: deckID.String()},
+			setupMock: func(seasonRepo *MockGDTSeasonRepository, deckTrendRepo *MockGDTDeckTrendRepository) {
+				seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				deckTrendRepo.EXPECT().FindByDeck(gomock.Any(), seasonID, deckID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find deck trend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seasonRepo := NewMockGDTSeasonRepository(ctrl)
+			deckTrendRepo := NewMockGDTDeckTrendRepository(ctrl)
+			tt.setupMock(seasonRepo, deckTrendRepo)
+
+			usecase := usecase.NewGetDeckTrendUsecase(seasonRepo, deckTrendRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
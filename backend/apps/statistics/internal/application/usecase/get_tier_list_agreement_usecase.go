@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// GetTierListAgreementParams はティアリストと集計結果の一致度取得の入力
+// Method が空の場合、MinPlacementCount / Limit が0の場合はそれぞれ既定値を使用する
+type GetTierListAgreementParams struct {
+	TierListID        string
+	Method            string
+	MinPlacementCount int
+	Limit             int
+}
+
+// GetTierListAgreementResult はティアリストとシーズンの集計ティアリストの一致度
+// KendallTauB / Spearman は順位相関係数（-1 〜 1）で、算出できない場合は nil
+// MeanAbsoluteTierDistance は集計結果とのティアの差の絶対値の平均
+type GetTierListAgreementResult struct {
+	TierListID               string
+	SeasonID                 string
+	Method                   string
+	PlacementCount           int
+	ComparedDeckCount        int
+	KendallTauB              *float64
+	Spearman                 *float64
+	MeanAbsoluteTierDistance float64
+	Disagreements            []GTADisagreement
+}
+
+// GTADisagreement は集計結果とティアが食い違うデッキ（食い違いの大きい順）
+// ScoreDifference はティアリストのランクと集計スコアの差で、正の値は集計結果より高く評価していることを表す
+type GTADisagreement struct {
+	DeckID          string
+	Nickname        string
+	ImageURL        string
+	Tier            string
+	ConsensusTier   string
+	AverageTierRank float64
+	ScoreDifference float64
+}
+
+type GTATierListRepository interface {
+	FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+}
+
+type GTAPlacementRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error)
+	CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error)
+}
+
+type GTAStatisticRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error)
+}
+
+type GTADeckRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error)
+}
+
+type GTAConsensusCache interface {
+	Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error)
+}
+
+type GetTierListAgreementUsecase struct {
+	tierListRepo  GTATierListRepository
+	placementRepo GTAPlacementRepository
+	statisticRepo GTAStatisticRepository
+	deckRepo      GTADeckRepository
+	cache         GTAConsensusCache
+}
+
+func NewGetTierListAgreementUsecase(
+	tierListRepo GTATierListRepository,
+	placementRepo GTAPlacementRepository,
+	statisticRepo GTAStatisticRepository,
+	deckRepo GTADeckRepository,
+	cache GTAConsensusCache,
+) *GetTierListAgreementUsecase {
+	return &GetTierListAgreementUsecase{
+		tierListRepo:  tierListRepo,
+		placementRepo: placementRepo,
+		statisticRepo: statisticRepo,
+		deckRepo:      deckRepo,
+		cache:         cache,
+	}
+}
+
+// Execute はティアリストが作成されたシーズンの集計結果と比較し、一致度と食い違いの大きいデッキを Limit 件まで返す
+// 存在しないティアリストはNotFoundエラーを返す
+func (u *GetTierListAgreementUsecase) Execute(ctx context.Context, params GetTierListAgreementParams) (*GetTierListAgreementResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	method, minPlacementCount, err := parseConsensusOptions(params.Method, params.MinPlacementCount)
+	if err != nil {
+		return nil, err
+	}
+	limit := pagination.NormalizeLimit(params.Limit)
+
+	tierList, err := u.tierListRepo.FindByID(ctx, tierListID)
+	if err != nil {
+		return nil, err
+	}
+
+	consensus, err := cachedConsensus(ctx, u.cache, u.placementRepo, u.statisticRepo, tierList.SeasonID(), method, minPlacementCount)
+	if err != nil {
+		return nil, err
+	}
+
+	decks, err := u.deckRepo.FindBySeason(ctx, tierList.SeasonID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decks: %w", err)
+	}
+	decksByID := make(map[id.DeckID]*entity.Deck, len(decks))
+	for _, deck := range decks {
+		decksByID[deck.ID()] = deck
+	}
+
+	agreement := entity.MeasureAgreement(tierList, consensus)
+
+	result := &GetTierListAgreementResult{
+		TierListID:               tierList.ID().String(),
+		SeasonID:                 tierList.SeasonID().String(),
+		Method:                   string(consensus.Method()),
+		PlacementCount:           len(tierList.Placements()),
+		ComparedDeckCount:        agreement.ComparedDeckCount,
+		KendallTauB:              agreement.KendallTauB,
+		Spearman:                 agreement.Spearman,
+		MeanAbsoluteTierDistance: agreement.MeanAbsoluteTierDistance,
+		Disagreements:            make([]GTADisagreement, 0, min(limit, len(agreement.Disagreements))),
+	}
+	for _, d := range agreement.Disagreements[:min(limit, len(agreement.Disagreements))] {
+		disagreement := GTADisagreement{
+			DeckID:          d.DeckID.String(),
+			Tier:            d.TierRank.String(),
+			ConsensusTier:   d.Consensus.TierRank.String(),
+			AverageTierRank: d.Consensus.Score,
+			ScoreDifference: d.ScoreDifference,
+		}
+		// 集計後に削除されたデッキなど参照情報がない場合はIDのみを返す
+		if deck, ok := decksByID[d.DeckID]; ok {
+			disagreement.Nickname = deck.Nickname()
+			disagreement.ImageURL = deck.ImageURL()
+		}
+		result.Disagreements = append(result.Disagreements, disagreement)
+	}
+	return result, nil
+}
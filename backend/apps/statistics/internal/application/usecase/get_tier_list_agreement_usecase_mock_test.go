@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/get_tier_list_agreement_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/get_tier_list_agreement_usecase.go -destination=./apps/statistics/internal/application/usecase/get_tier_list_agreement_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGTATierListRepository is a mock of GTATierListRepository interface.
+type MockGTATierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTATierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTATierListRepositoryMockRecorder is the mock recorder for MockGTATierListRepository.
+type MockGTATierListRepositoryMockRecorder struct {
+	mock *MockGTATierListRepository
+}
+
+// NewMockGTATierListRepository creates a new mock instance.
+func NewMockGTATierListRepository(ctrl *gomock.Controller) *MockGTATierListRepository {
+	mock := &MockGTATierListRepository{ctrl: ctrl}
+	mock.recorder = &MockGTATierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTATierListRepository) EXPECT() *MockGTATierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGTATierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGTATierListRepositoryMockRecorder) FindByID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGTATierListRepository)(nil).FindByID), ctx, tierListID)
+}
+
+// MockGTAPlacementRepository is a mock of GTAPlacementRepository interface.
+type MockGTAPlacementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTAPlacementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTAPlacementRepositoryMockRecorder is the mock recorder for MockGTAPlacementRepository.
+type MockGTAPlacementRepositoryMockRecorder struct {
+	mock *MockGTAPlacementRepository
+}
+
+// NewMockGTAPlacementRepository creates a new mock instance.
+func NewMockGTAPlacementRepository(ctrl *gomock.Controller) *MockGTAPlacementRepository {
+	mock := &MockGTAPlacementRepository{ctrl: ctrl}
+	mock.recorder = &MockGTAPlacementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTAPlacementRepository) EXPECT() *MockGTAPlacementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTierListsBySeason mocks base method.
+func (m *MockGTAPlacementRepository) CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListsBySeason indicates an expected call of CountTierListsBySeason.
+func (mr *MockGTAPlacementRepositoryMockRecorder) CountTierListsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListsBySeason", reflect.TypeOf((*MockGTAPlacementRepository)(nil).CountTierListsBySeason), ctx, seasonID)
+}
+
+// FindBySeason mocks base method.
+func (m *MockGTAPlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGTAPlacementRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGTAPlacementRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGTAStatisticRepository is a mock of GTAStatisticRepository interface.
+type MockGTAStatisticRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTAStatisticRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTAStatisticRepositoryMockRecorder is the mock recorder for MockGTAStatisticRepository.
+type MockGTAStatisticRepositoryMockRecorder struct {
+	mock *MockGTAStatisticRepository
+}
+
+// NewMockGTAStatisticRepository creates a new mock instance.
+func NewMockGTAStatisticRepository(ctrl *gomock.Controller) *MockGTAStatisticRepository {
+	mock := &MockGTAStatisticRepository{ctrl: ctrl}
+	mock.recorder = &MockGTAStatisticRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTAStatisticRepository) EXPECT() *MockGTAStatisticRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockGTAStatisticRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]entity.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGTAStatisticRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGTAStatisticRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGTADeckRepository is a mock of GTADeckRepository interface.
+type MockGTADeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTADeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTADeckRepositoryMockRecorder is the mock recorder for MockGTADeckRepository.
+type MockGTADeckRepositoryMockRecorder struct {
+	mock *MockGTADeckRepository
+}
+
+// NewMockGTADeckRepository creates a new mock instance.
+func NewMockGTADeckRepository(ctrl *gomock.Controller) *MockGTADeckRepository {
+	mock := &MockGTADeckRepository{ctrl: ctrl}
+	mock.recorder = &MockGTADeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTADeckRepository) EXPECT() *MockGTADeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockGTADeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockGTADeckRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockGTADeckRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockGTAConsensusCache is a mock of GTAConsensusCache interface.
+type MockGTAConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTAConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockGTAConsensusCacheMockRecorder is the mock recorder for MockGTAConsensusCache.
+type MockGTAConsensusCacheMockRecorder struct {
+	mock *MockGTAConsensusCache
+}
+
+// NewMockGTAConsensusCache creates a new mock instance.
+func NewMockGTAConsensusCache(ctrl *gomock.Controller) *MockGTAConsensusCache {
+	mock := &MockGTAConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockGTAConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTAConsensusCache) EXPECT() *MockGTAConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGTAConsensusCache) Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, seasonID, method, load)
+	ret0, _ := ret[0].(*entity.ConsensusTierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGTAConsensusCacheMockRecorder) Get(ctx, seasonID, method, load any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGTAConsensusCache)(nil).Get), ctx, seasonID, method, load)
+}
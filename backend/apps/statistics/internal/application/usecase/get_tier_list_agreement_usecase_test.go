@@ -0,0 +1,167 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTierListAgreementUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, reference := id.NewTierListID(), id.NewTierListID()
+	deckA, deckB, deckX := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	tierList := entity.ReconstructTierList(tierListID, seasonID, []entity.Placement{
+		entity.NewPlacement(tierListID, deckA, rank.TierB),
+		entity.NewPlacement(tierListID, deckB, rank.TierSS),
+		entity.NewPlacement(tierListID, deckX, rank.TierS),
+	})
+	// 集計結果: A=SS(7)、B=A(5)、Xは配置数が不足して掲載されない
+	placements := []entity.Placement{
+		entity.NewPlacement(reference, deckA, rank.TierSS),
+		entity.NewPlacement(reference, deckB, rank.TierA),
+	}
+	decks := []*entity.Deck{
+		entity.ReconstructDeck(deckA, seasonID, "リザニンフ", "https://example.com/decks/a.png", ""),
+	}
+	minusOne := -1.0
+
+	tests := []struct {
+		caseName    string
+		params      usecase.GetTierListAgreementParams
+		setupMock   func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository)
+		want        *usecase.GetTierListAgreementResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: ティアリストが作成されたシーズンの集計結果と比較し、食い違いの大きいデッキが件数分返される",
+			params: usecase.GetTierListAgreementParams{
+				TierListID:        tierListID.String(),
+				Method:            "median",
+				MinPlacementCount: 1,
+				Limit:             1,
+			},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(2, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			want: &usecase.GetTierListAgreementResult{
+				TierListID:               tierListID.String(),
+				SeasonID:                 testSeasonID,
+				Method:                   "median",
+				PlacementCount:           3,
+				ComparedDeckCount:        2,
+				KendallTauB:              &minusOne,
+				Spearman:                 &minusOne,
+				MeanAbsoluteTierDistance: 2.5,
+				Disagreements: []usecase.GTADisagreement{
+					{
+						DeckID:          deckA.String(),
+						Nickname:        "リザニンフ",
+						ImageURL:        "https://example.com/decks/a.png",
+						Tier:            "B",
+						ConsensusTier:   "SS",
+						AverageTierRank: 7,
+						ScoreDifference: -3,
+					},
+				},
+			},
+		},
+		{
+			caseName: "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetTierListAgreementParams{TierListID: "invalid"},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid tier_list_id",
+		},
+		{
+			caseName: "異常系: 不正な算出方式が指定された場合、バリデーションエラーを返す",
+			params:   usecase.GetTierListAgreementParams{TierListID: tierListID.String(), Method: "unknown"},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid method",
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.GetTierListAgreementParams{TierListID: tierListID.String()},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			errContains: "tier list not found",
+		},
+		{
+			caseName: "異常系: 配置の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetTierListAgreementParams{TierListID: tierListID.String(), Method: "median"},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(2, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find placements",
+		},
+		{
+			caseName: "異常系: デッキの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetTierListAgreementParams{TierListID: tierListID.String(), Method: "median"},
+			setupMock: func(tierListRepo *MockGTATierListRepository, placementRepo *MockGTAPlacementRepository, statisticRepo *MockGTAStatisticRepository, deckRepo *MockGTADeckRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				placementRepo.EXPECT().CountTierListsBySeason(gomock.Any(), seasonID).Return(2, nil)
+				placementRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(placements, nil)
+				deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find decks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tierListRepo := NewMockGTATierListRepository(ctrl)
+			placementRepo := NewMockGTAPlacementRepository(ctrl)
+			statisticRepo := NewMockGTAStatisticRepository(ctrl)
+			deckRepo := NewMockGTADeckRepository(ctrl)
+			tt.setupMock(tierListRepo, placementRepo, statisticRepo, deckRepo)
+			cache := NewMockGTAConsensusCache(ctrl)
+			passThroughConsensusCache(cache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()))
+
+			usecase := usecase.NewGetTierListAgreementUsecase(tierListRepo, placementRepo, statisticRepo, deckRepo, cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
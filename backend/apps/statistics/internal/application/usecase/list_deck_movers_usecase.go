@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListDeckMoversParams は急上昇・急下降デッキ一覧取得の入力
+// Days が0の場合は既定の比較期間を使用する
+type ListDeckMoversParams struct {
+	SeasonID string
+	Days     int
+	Limit    int
+}
+
+// ListDeckMoversResult は急上昇・急下降デッキ一覧
+// スナップショットが2日分に満たない場合、FromDate/ToDate はnilで一覧は空となる
+type ListDeckMoversResult struct {
+	SeasonID string
+	Days     int
+	FromDate *time.Time
+	ToDate   *time.Time
+	Risers   []LDMDeck
+	Fallers  []LDMDeck
+}
+
+// LDMDeck は比較期間でのデッキの平均ティアランクの変化
+type LDMDeck struct {
+	DeckID              string
+	Nickname            string
+	ImageURL            string
+	FromTier            string
+	ToTier              string
+	FromAverageTierRank float64
+	ToAverageTierRank   float64
+	Change              float64
+	PlacementCount      int
+}
+
+type LDMSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+}
+
+type LDMDeckTrendRepository interface {
+	FindDates(ctx context.Context, seasonID id.SeasonID) ([]time.Time, error)
+	FindByDate(ctx context.Context, seasonID id.SeasonID, date time.Time) ([]entity.DeckTrendPoint, error)
+}
+
+type LDMDeckRepository interface {
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error)
+}
+
+type ListDeckMoversUsecase struct {
+	seasonRepo    LDMSeasonRepository
+	deckTrendRepo LDMDeckTrendRepository
+	deckRepo      LDMDeckRepository
+}
+
+func NewListDeckMoversUsecase(seasonRepo LDMSeasonRepository, deckTrendRepo LDMDeckTrendRepository, deckRepo LDMDeckRepository) *ListDeckMoversUsecase {
+	return &ListDeckMoversUsecase{
+		seasonRepo:    seasonRepo,
+		deckTrendRepo: deckTrendRepo,
+		deckRepo:      deckRepo,
+	}
+}
+
+// Execute は最新のスナップショットと Days 日前のスナップショットを比較し、
+// 平均ティアランクの変化が大きいデッキを上昇・下降それぞれ Limit 件まで取得する
+func (u *ListDeckMoversUsecase) Execute(ctx context.Context, params ListDeckMoversParams) (*ListDeckMoversResult, error) {
+	seasonID, err := id.SeasonIDFromString(params.SeasonID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid season_id", err)
+	}
+
+	days := params.Days
+	if days == 0 {
+		days = entity.DefaultMoversDays
+	}
+	if days < 1 {
+		return nil, errs.NewValidationError("days must be at least 1", nil)
+	}
+	limit := pagination.NormalizeLimit(params.Limit)
+
+	exists, err := u.seasonRepo.Exists(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check season: %w", err)
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError("season not found", nil)
+	}
+
+	result := &ListDeckMoversResult{
+		SeasonID: seasonID.String(),
+		Days:     days,
+		Risers:   []LDMDeck{},
+		Fallers:  []LDMDeck{},
+	}
+
+	dates, err := u.deckTrendRepo.FindDates(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find snapshot dates: %w", err)
+	}
+	fromDate, toDate, ok := entity.SelectMoverDates(dates, days)
+	if !ok {
+		return result, nil
+	}
+	result.FromDate, result.ToDate = &fromDate, &toDate
+
+	from, err := u.deckTrendRepo.FindByDate(ctx, seasonID, fromDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deck trends: %w", err)
+	}
+	to, err := u.deckTrendRepo.FindByDate(ctx, seasonID, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deck trends: %w", err)
+	}
+	risers, fallers := entity.CalculateMovers(from, to, entity.DefaultMinPlacementCount)
+
+	decks, err := u.deckRepo.FindBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decks: %w", err)
+	}
+	decksByID := make(map[id.DeckID]*entity.Deck, len(decks))
+	for _, deck := range decks {
+		decksByID[deck.ID()] = deck
+	}
+
+	result.Risers = toLDMDecks(risers[:min(limit, len(risers))], decksByID)
+	result.Fallers = toLDMDecks(fallers[:min(limit, len(fallers))], decksByID)
+	return result, nil
+}
+
+// toLDMDecks はデッキの変化に参照情報を付与して変換する
+func toLDMDecks(movers []entity.DeckMover, decksByID map[id.DeckID]*entity.Deck) []LDMDeck {
+	decks := make([]LDMDeck, 0, len(movers))
+	for _, m := range movers {
+		deck := LDMDeck{
+			DeckID:              m.DeckID.String(),
+			FromTier:            m.From.Tier().String(),
+			ToTier:              m.To.Tier().String(),
+			FromAverageTierRank: m.From.TierRank,
+			ToAverageTierRank:   m.To.TierRank,
+			Change:              m.Change,
+			PlacementCount:      m.PlacementCount,
+		}
+		// 記録後に削除されたデッキなど参照情報がない場合はIDのみを返す
+		if d, ok := decksByID[m.DeckID]; ok {
+			deck.Nickname = d.Nickname()
+			deck.ImageURL = d.ImageURL()
+		}
+		decks = append(decks, deck)
+	}
+	return decks
+}
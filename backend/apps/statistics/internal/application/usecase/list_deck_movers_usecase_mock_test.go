@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/list_deck_movers_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/list_deck_movers_usecase.go -destination=./apps/statistics/internal/application/usecase/list_deck_movers_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLDMSeasonRepository is a mock of LDMSeasonRepository interface.
+type MockLDMSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLDMSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLDMSeasonRepositoryMockRecorder is the mock recorder for MockLDMSeasonRepository.
+type MockLDMSeasonRepositoryMockRecorder struct {
+	mock *MockLDMSeasonRepository
+}
+
+// NewMockLDMSeasonRepository creates a new mock instance.
+func NewMockLDMSeasonRepository(ctrl *gomock.Controller) *MockLDMSeasonRepository {
+	mock := &MockLDMSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockLDMSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLDMSeasonRepository) EXPECT() *MockLDMSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockLDMSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockLDMSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockLDMSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// MockLDMDeckTrendRepository is a mock of LDMDeckTrendRepository interface.
+type MockLDMDeckTrendRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLDMDeckTrendRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLDMDeckTrendRepositoryMockRecorder is the mock recorder for MockLDMDeckTrendRepository.
+type MockLDMDeckTrendRepositoryMockRecorder struct {
+	mock *MockLDMDeckTrendRepository
+}
+
+// NewMockLDMDeckTrendRepository creates a new mock instance.
+func NewMockLDMDeckTrendRepository(ctrl *gomock.Controller) *MockLDMDeckTrendRepository {
+	mock := &MockLDMDeckTrendRepository{ctrl: ctrl}
+	mock.recorder = &MockLDMDeckTrendRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLDMDeckTrendRepository) EXPECT() *MockLDMDeckTrendRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByDate mocks base method.
+func (m *MockLDMDeckTrendRepository) FindByDate(ctx context.Context, seasonID id.SeasonID, date time.Time) ([]entity.DeckTrendPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDate", ctx, seasonID, date)
+	ret0, _ := ret[0].([]entity.DeckTrendPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDate indicates an expected call of FindByDate.
+func (mr *MockLDMDeckTrendRepositoryMockRecorder) FindByDate(ctx, seasonID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDate", reflect.TypeOf((*MockLDMDeckTrendRepository)(nil).FindByDate), ctx, seasonID, date)
+}
+
+// FindDates mocks base method.
+func (m *MockLDMDeckTrendRepository) FindDates(ctx context.Context, seasonID id.SeasonID) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDates", ctx, seasonID)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDates indicates an expected call of FindDates.
+func (mr *MockLDMDeckTrendRepositoryMockRecorder) FindDates(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDates", reflect.TypeOf((*MockLDMDeckTrendRepository)(nil).FindDates), ctx, seasonID)
+}
+
+// MockLDMDeckRepository is a mock of LDMDeckRepository interface.
+type MockLDMDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLDMDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLDMDeckRepositoryMockRecorder is the mock recorder for MockLDMDeckRepository.
+type MockLDMDeckRepositoryMockRecorder struct {
+	mock *MockLDMDeckRepository
+}
+
+// NewMockLDMDeckRepository creates a new mock instance.
+func NewMockLDMDeckRepository(ctrl *gomock.Controller) *MockLDMDeckRepository {
+	mock := &MockLDMDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockLDMDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLDMDeckRepository) EXPECT() *MockLDMDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBySeason mocks base method.
+func (m *MockLDMDeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockLDMDeckRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockLDMDeckRepository)(nil).FindBySeason), ctx, seasonID)
+}
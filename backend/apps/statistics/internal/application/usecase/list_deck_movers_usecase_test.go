@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDeckMoversUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	deckA, deckB, deckC := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	day := func(d int) time.Time {
+		return time.Date(2025, 8, d, 0, 0, 0, 0, time.UTC)
+	}
+	fromDate, toDate := day(3), day(10)
+	dates := []time.Time{day(1), day(3), day(5), day(10)}
+
+	from := []entity.DeckTrendPoint{
+		{DeckID: deckA, SnapshotDate: fromDate, TierRank: 4, PlacementCount: 10},
+		{DeckID: deckB, SnapshotDate: fromDate, TierRank: 5, PlacementCount: 10},
+		{DeckID: deckC, SnapshotDate: fromDate, TierRank: 6, PlacementCount: 10},
+	}
+	to := []entity.DeckTrendPoint{
+		{DeckID: deckA, SnapshotDate: toDate, TierRank: 6.5, PlacementCount: 12},
+		{DeckID: deckB, SnapshotDate: toDate, TierRank: 5.5, PlacementCount: 11},
+		{DeckID: deckC, SnapshotDate: toDate, TierRank: 4, PlacementCount: 10},
+	}
+	decks := []*entity.Deck{
+		entity.ReconstructDeck(deckA, seasonID, "リザニンフ", "https://example.com/decks/a.png", ""),
+		entity.ReconstructDeck(deckC, seasonID, "ピカチュウex", "", ""),
+	}
+
+	type mocks struct {
+		seasonRepo    *MockLDMSeasonRepository
+		deckTrendRepo *MockLDMDeckTrendRepository
+		deckRepo      *MockLDMDeckRepository
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListDeckMoversParams
+		setupMock   func(m mocks)
+		want        *usecase.ListDeckMoversResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 上昇・下降それぞれ変化の大きい順にlimit件まで返される",
+			params:   usecase.ListDeckMoversParams{SeasonID: testSeasonID, Limit: 1},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				m.deckTrendRepo.EXPECT().FindDates(gomock.Any(), seasonID).Return(dates, nil)
+				m.deckTrendRepo.EXPECT().FindByDate(gomock.Any(), seasonID, fromDate).Return(from, nil)
+				m.deckTrendRepo.EXPECT().FindByDate(gomock.Any(), seasonID, toDate).Return(to, nil)
+				m.deckRepo.EXPECT().FindBySeason(gomock.Any(), seasonID).Return(decks, nil)
+			},
+			want: &usecase.ListDeckMoversResult{
+				SeasonID: testSeasonID,
+				Days:     entity.DefaultMoversDays,
+				FromDate: &fromDate,
+				ToDate:   &toDate,
+				Risers: []usecase.LDMDeck{
+					{
+						DeckID:              deckA.String(),
+						Nickname:            "リザニンフ",
+						ImageURL:            "https://example.com/decks/a.png",
+						FromTier:            "B",
+						ToTier:              "SS",
+						FromAverageTierRank: 4,
+						ToAverageTierRank:   6.5,
+						Change:              2.5,
+						PlacementCount:      12,
+					},
+				},
+				Fallers: []usecase.LDMDeck{
+					{
+						DeckID:              deckC.String(),
+						Nickname:            "ピカチュウex",
+						FromTier:            "S",
+						ToTier:              "B",
+						FromAverageTierRank: 6,
+						ToAverageTierRank:   4,
+						Change:              -2,
+						PlacementCount:      10,
+					},
+				},
+			},
+		},
+		{
+			caseName: "正常系: スナップショットが2日分に満たない場合、空の一覧が返される",
+			params:   usecase.ListDeckMoversParams{SeasonID: testSeasonID, Days: 3},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				m.deckTrendRepo.EXPECT().FindDates(gomock.Any(), seasonID).Return([]time.Time{day(10)}, nil)
+			},
+			want: &usecase.ListDeckMoversResult{
+				SeasonID: testSeasonID,
+				Days:     3,
+				Risers:   []usecase.LDMDeck{},
+				Fallers:  []usecase.LDMDeck{},
+			},
+		},
+		{
+			caseName: "異常系: daysが負の場合、バリデーションエラーを返す",
+			params:   usecase.ListDeckMoversParams{SeasonID: testSeasonID, Days: -1},
+			setupMock: func(m mocks) {
+			},
+			wantErr:     true,
+			errContains: "days must be at least 1",
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ListDeckMoversParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: スナップショットの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListDeckMoversParams{SeasonID: testSeasonID},
+			setupMock: func(m mocks) {
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), seasonID).Return(true, nil)
+				m.deckTrendRepo.EXPECT().FindDates(gomock.Any(), seasonID).Return(dates, nil)
+				m.deckTrendRepo.EXPECT().FindByDate(gomock.Any(), seasonID, fromDate).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find deck trends",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				seasonRepo:    NewMockLDMSeasonRepository(ctrl),
+				deckTrendRepo: NewMockLDMDeckTrendRepository(ctrl),
+				deckRepo:      NewMockLDMDeckRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			usecase := usecase.NewListDeckMoversUsecase(m.seasonRepo, m.deckTrendRepo, m.deckRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/pagination"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// ListFlaggedTierListsParams はフラグ付きティアリスト一覧取得の入力
+// SeasonID が空の場合は全シーズンを対象とする
+// ActorRole は操作する利用者の権限で、モデレーター以上でなければ取得できない
+type ListFlaggedTierListsParams struct {
+	SeasonID  string
+	Limit     int
+	ActorRole role.Role
+}
+
+// ListFlaggedTierListsResult はフラグ付きティアリスト一覧（信頼度の低い順）
+type ListFlaggedTierListsResult struct {
+	TierLists []LFTTierList
+}
+
+type LFTTierList struct {
+	TierListID            string
+	SeasonID              string
+	Title                 string
+	AuthorName            string
+	AuthorIP              string
+	TrustWeight           float64
+	RankDistance          float64
+	ZScore                float64
+	DuplicateOfTierListID string
+	FlagReasons           []string
+	EvaluatedAt           time.Time
+}
+
+type LFTTrustScoreRepository interface {
+	FindFlagged(ctx context.Context, seasonID *id.SeasonID, limit int) ([]entity.FlaggedTierList, error)
+}
+
+type ListFlaggedTierListsUsecase struct {
+	trustScoreRepo LFTTrustScoreRepository
+}
+
+func NewListFlaggedTierListsUsecase(trustScoreRepo LFTTrustScoreRepository) *ListFlaggedTierListsUsecase {
+	return &ListFlaggedTierListsUsecase{
+		trustScoreRepo: trustScoreRepo,
+	}
+}
+
+// Execute はモデレーター向けにフラグ付きのティアリストを取得
+func (u *ListFlaggedTierListsUsecase) Execute(ctx context.Context, params ListFlaggedTierListsParams) (*ListFlaggedTierListsResult, error) {
+	// 作成者のIPアドレスを含むため、ルートの保護に加えてユースケースでも権限を確認する
+	if err := policy.Authorize(params.ActorRole, policy.ModerateTierLists); err != nil {
+		return nil, err
+	}
+
+	seasonID, err := parseOptionalSeasonID(params.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	flagged, err := u.trustScoreRepo.FindFlagged(ctx, seasonID, pagination.NormalizeLimit(params.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find flagged tier lists: %w", err)
+	}
+
+	result := &ListFlaggedTierListsResult{
+		TierLists: make([]LFTTierList, 0, len(flagged)),
+	}
+	for _, f := range flagged {
+		tierList := LFTTierList{
+			TierListID:   f.Score.TierListID.String(),
+			SeasonID:     f.Score.SeasonID.String(),
+			Title:        f.Title,
+			AuthorName:   f.AuthorName,
+			AuthorIP:     f.AuthorIP,
+			TrustWeight:  f.Score.TrustWeight,
+			RankDistance: f.Score.RankDistance,
+			ZScore:       f.Score.ZScore,
+			FlagReasons:  make([]string, 0, len(f.Score.FlagReasons)),
+			EvaluatedAt:  f.EvaluatedAt,
+		}
+		if f.Score.DuplicateOf != nil {
+			tierList.DuplicateOfTierListID = f.Score.DuplicateOf.String()
+		}
+		for _, reason := range f.Score.FlagReasons {
+			tierList.FlagReasons = append(tierList.FlagReasons, string(reason))
+		}
+		result.TierLists = append(result.TierLists, tierList)
+	}
+
+	return result, nil
+}
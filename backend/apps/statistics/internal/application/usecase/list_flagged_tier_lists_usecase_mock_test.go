@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/list_flagged_tier_lists_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/list_flagged_tier_lists_usecase.go -destination=./apps/statistics/internal/application/usecase/list_flagged_tier_lists_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/statistics/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLFTTrustScoreRepository is a mock of LFTTrustScoreRepository interface.
+type MockLFTTrustScoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLFTTrustScoreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLFTTrustScoreRepositoryMockRecorder is the mock recorder for MockLFTTrustScoreRepository.
+type MockLFTTrustScoreRepositoryMockRecorder struct {
+	mock *MockLFTTrustScoreRepository
+}
+
+// NewMockLFTTrustScoreRepository creates a new mock instance.
+func NewMockLFTTrustScoreRepository(ctrl *gomock.Controller) *MockLFTTrustScoreRepository {
+	mock := &MockLFTTrustScoreRepository{ctrl: ctrl}
+	mock.recorder = &MockLFTTrustScoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLFTTrustScoreRepository) EXPECT() *MockLFTTrustScoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindFlagged mocks base method.
+func (m *MockLFTTrustScoreRepository) FindFlagged(ctx context.Context, seasonID *id.SeasonID, limit int) ([]entity.FlaggedTierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlagged", ctx, seasonID, limit)
+	ret0, _ := ret[0].([]entity.FlaggedTierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlagged indicates an expected call of FindFlagged.
+func (mr *MockLFTTrustScoreRepositoryMockRecorder) FindFlagged(ctx, seasonID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlagged", reflect.TypeOf((*MockLFTTrustScoreRepository)(nil).FindFlagged), ctx, seasonID, limit)
+}
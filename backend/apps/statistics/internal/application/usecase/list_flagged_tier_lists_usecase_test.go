@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFlaggedTierListsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, originalID := id.NewTierListID(), id.NewTierListID()
+	evaluatedAt := time.Date(2025, 8, 2, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListFlaggedTierListsParams
+		setupMock   func(trustScoreRepo *MockLFTTrustScoreRepository)
+		want        *usecase.ListFlaggedTierListsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: シーズンを指定した場合、フラグ付きのティアリストが返される",
+			params:   usecase.ListFlaggedTierListsParams{SeasonID: testSeasonID, Limit: 10, ActorRole: role.Moderator},
+			setupMock: func(trustScoreRepo *MockLFTTrustScoreRepository) {
+				trustScoreRepo.EXPECT().FindFlagged(gomock.Any(), &seasonID, 10).Return([]entity.FlaggedTierList{
+					{
+						Score: entity.TrustScore{
+							TierListID:   tierListID,
+							SeasonID:     seasonID,
+							TrustWeight:  0,
+							RankDistance: 2.5,
+							ZScore:       3,
+							DuplicateOf:  &originalID,
+							FlagReasons:  []entity.FlagReason{entity.FlagReasonOutlier, entity.FlagReasonNearDuplicate},
+						},
+						Title:       "荒らしのティアリスト",
+						AuthorName:  "荒らし",
+						AuthorIP:    "198.51.100.7",
+						EvaluatedAt: evaluatedAt,
+					},
+				}, nil)
+			},
+			want: &usecase.ListFlaggedTierListsResult{
+				TierLists: []usecase.LFTTierList{
+					{
+						TierListID:            tierListID.String(),
+						SeasonID:              testSeasonID,
+						Title:                 "荒らしのティアリスト",
+						AuthorName:            "荒らし",
+						AuthorIP:              "198.51.100.7",
+						TrustWeight:           0,
+						RankDistance:          2.5,
+						ZScore:                3,
+						DuplicateOfTierListID: originalID.String(),
+						FlagReasons:           []string{"outlier", "near_duplicate"},
+						EvaluatedAt:           evaluatedAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "正常系: シーズンと件数を省略した場合、全シーズンから既定の件数で取得する",
+			params:   usecase.ListFlaggedTierListsParams{ActorRole: role.Admin},
+			setupMock: func(trustScoreRepo *MockLFTTrustScoreRepository) {
+				trustScoreRepo.EXPECT().FindFlagged(gomock.Any(), nil, pagination.DefaultLimit).Return([]entity.FlaggedTierList{}, nil)
+			},
+			want: &usecase.ListFlaggedTierListsResult{TierLists: []usecase.LFTTierList{}},
+		},
+		{
+			caseName: "異常系: モデレーター未満の権限の場合、取得せずに認可エラーを返す",
+			params:   usecase.ListFlaggedTierListsParams{ActorRole: role.User},
+			setupMock: func(trustScoreRepo *MockLFTTrustScoreRepository) {
+			},
+			wantErr:     true,
+			errContains: "permission denied",
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListFlaggedTierListsParams{SeasonID: "invalid", ActorRole: role.Moderator},
+			setupMock: func(trustScoreRepo *MockLFTTrustScoreRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: 取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListFlaggedTierListsParams{ActorRole: role.Moderator},
+			setupMock: func(trustScoreRepo *MockLFTTrustScoreRepository) {
+				trustScoreRepo.EXPECT().FindFlagged(gomock.Any(), nil, pagination.DefaultLimit).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find flagged tier lists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			trustScoreRepo := NewMockLFTTrustScoreRepository(ctrl)
+			tt.setupMock(trustScoreRepo)
+
+			usecase := usecase.NewListFlaggedTierListsUsecase(trustScoreRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/pkg/vo/id"
+)
+
+// RebuildTierStatisticsParams はティア統計の再作成の入力
+// SeasonID が空の場合は全シーズンを対象とする
+type RebuildTierStatisticsParams struct {
+	SeasonID string
+}
+
+type RTSStatisticRepository interface {
+	Rebuild(ctx context.Context, seasonID *id.SeasonID) error
+}
+
+type RTSTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RebuildTierStatisticsUsecase struct {
+	statisticRepo RTSStatisticRepository
+	txManager     RTSTxManager
+}
+
+func NewRebuildTierStatisticsUsecase(statisticRepo RTSStatisticRepository, txManager RTSTxManager) *RebuildTierStatisticsUsecase {
+	return &RebuildTierStatisticsUsecase{
+		statisticRepo: statisticRepo,
+		txManager:     txManager,
+	}
+}
+
+// Execute はティア統計を配置から作り直す（差分更新の不整合を修復する際に使用）
+// 再作成中の配置の保存・削除による差分更新は、リポジトリが取得するロックによりトランザクションの終了まで待たされる
+func (u *RebuildTierStatisticsUsecase) Execute(ctx context.Context, params RebuildTierStatisticsParams) error {
+	seasonID, err := parseOptionalSeasonID(params.SeasonID)
+	if err != nil {
+		return err
+	}
+
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.statisticRepo.Rebuild(ctx, seasonID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rebuild tier statistics: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/rebuild_tier_statistics_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/rebuild_tier_statistics_usecase.go -destination=./apps/statistics/internal/application/usecase/rebuild_tier_statistics_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRTSStatisticRepository is a mock of RTSStatisticRepository interface.
+type MockRTSStatisticRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTSStatisticRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTSStatisticRepositoryMockRecorder is the mock recorder for MockRTSStatisticRepository.
+type MockRTSStatisticRepositoryMockRecorder struct {
+	mock *MockRTSStatisticRepository
+}
+
+// NewMockRTSStatisticRepository creates a new mock instance.
+func NewMockRTSStatisticRepository(ctrl *gomock.Controller) *MockRTSStatisticRepository {
+	mock := &MockRTSStatisticRepository{ctrl: ctrl}
+	mock.recorder = &MockRTSStatisticRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTSStatisticRepository) EXPECT() *MockRTSStatisticRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Rebuild mocks base method.
+func (m *MockRTSStatisticRepository) Rebuild(ctx context.Context, seasonID *id.SeasonID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", ctx, seasonID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockRTSStatisticRepositoryMockRecorder) Rebuild(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockRTSStatisticRepository)(nil).Rebuild), ctx, seasonID)
+}
+
+// MockRTSTxManager is a mock of RTSTxManager interface.
+type MockRTSTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTSTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRTSTxManagerMockRecorder is the mock recorder for MockRTSTxManager.
+type MockRTSTxManagerMockRecorder struct {
+	mock *MockRTSTxManager
+}
+
+// NewMockRTSTxManager creates a new mock instance.
+func NewMockRTSTxManager(ctrl *gomock.Controller) *MockRTSTxManager {
+	mock := &MockRTSTxManager{ctrl: ctrl}
+	mock.recorder = &MockRTSTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTSTxManager) EXPECT() *MockRTSTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRTSTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRTSTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRTSTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRebuildTierStatisticsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	runInTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.RebuildTierStatisticsParams
+		setupMock   func(statisticRepo *MockRTSStatisticRepository, txManager *MockRTSTxManager)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: シーズン未指定の場合、全シーズンの統計がトランザクション内で作り直される",
+			params:   usecase.RebuildTierStatisticsParams{},
+			setupMock: func(statisticRepo *MockRTSStatisticRepository, txManager *MockRTSTxManager) {
+				txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+				statisticRepo.EXPECT().Rebuild(gomock.Any(), nil).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: シーズンを指定した場合、そのシーズンの統計が作り直される",
+			params:   usecase.RebuildTierStatisticsParams{SeasonID: testSeasonID},
+			setupMock: func(statisticRepo *MockRTSStatisticRepository, txManager *MockRTSTxManager) {
+				txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+				statisticRepo.EXPECT().Rebuild(gomock.Any(), &seasonID).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.RebuildTierStatisticsParams{SeasonID: "invalid"},
+			setupMock: func(statisticRepo *MockRTSStatisticRepository, txManager *MockRTSTxManager) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: 作り直しでエラーが発生した場合、エラーを返す",
+			params:   usecase.RebuildTierStatisticsParams{},
+			setupMock: func(statisticRepo *MockRTSStatisticRepository, txManager *MockRTSTxManager) {
+				txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+				statisticRepo.EXPECT().Rebuild(gomock.Any(), nil).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to rebuild tier statistics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			statisticRepo := NewMockRTSStatisticRepository(ctrl)
+			txManager := NewMockRTSTxManager(ctrl)
+			tt.setupMock(statisticRepo, txManager)
+
+			usecase := usecase.NewRebuildTierStatisticsUsecase(statisticRepo, txManager)
+
+			// Act
+			err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
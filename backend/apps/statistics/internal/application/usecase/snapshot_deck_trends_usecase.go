@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/pkg/vo/id"
+)
+
+// SnapshotDeckTrendsParams はデッキの推移の記録の入力
+// Now の日付を記録日とし、その日付が期間内のシーズンを対象とする
+type SnapshotDeckTrendsParams struct {
+	Now time.Time
+}
+
+// SnapshotDeckTrendsResult はデッキの推移の記録結果
+type SnapshotDeckTrendsResult struct {
+	SnapshotDate time.Time
+	SeasonCount  int
+	DeckCount    int
+}
+
+type SDTSeasonRepository interface {
+	FindActiveIDs(ctx context.Context, now time.Time) ([]id.SeasonID, error)
+}
+
+type SDTDeckTrendRepository interface {
+	Snapshot(ctx context.Context, seasonID id.SeasonID, date time.Time) (int, error)
+}
+
+type SnapshotDeckTrendsUsecase struct {
+	seasonRepo    SDTSeasonRepository
+	deckTrendRepo SDTDeckTrendRepository
+}
+
+func NewSnapshotDeckTrendsUsecase(seasonRepo SDTSeasonRepository, deckTrendRepo SDTDeckTrendRepository) *SnapshotDeckTrendsUsecase {
+	return &SnapshotDeckTrendsUsecase{
+		seasonRepo:    seasonRepo,
+		deckTrendRepo: deckTrendRepo,
+	}
+}
+
+// Execute は開催中のシーズンのティア統計をその日のスナップショットとして記録する
+// 同じ日に複数回実行した場合は最新のティア統計で置き換える
+func (u *SnapshotDeckTrendsUsecase) Execute(ctx context.Context, params SnapshotDeckTrendsParams) (*SnapshotDeckTrendsResult, error) {
+	snapshotDate := time.Date(params.Now.Year(), params.Now.Month(), params.Now.Day(), 0, 0, 0, 0, time.UTC)
+
+	seasonIDs, err := u.seasonRepo.FindActiveIDs(ctx, params.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active seasons: %w", err)
+	}
+
+	result := &SnapshotDeckTrendsResult{SnapshotDate: snapshotDate}
+	for _, seasonID := range seasonIDs {
+		count, err := u.deckTrendRepo.Snapshot(ctx, seasonID, snapshotDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot deck trends: %w", err)
+		}
+		result.SeasonCount++
+		result.DeckCount += count
+	}
+	return result, nil
+}
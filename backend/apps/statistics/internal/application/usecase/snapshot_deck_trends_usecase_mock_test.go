@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/application/usecase/snapshot_deck_trends_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/application/usecase/snapshot_deck_trends_usecase.go -destination=./apps/statistics/internal/application/usecase/snapshot_deck_trends_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSDTSeasonRepository is a mock of SDTSeasonRepository interface.
+type MockSDTSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSDTSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSDTSeasonRepositoryMockRecorder is the mock recorder for MockSDTSeasonRepository.
+type MockSDTSeasonRepositoryMockRecorder struct {
+	mock *MockSDTSeasonRepository
+}
+
+// NewMockSDTSeasonRepository creates a new mock instance.
+func NewMockSDTSeasonRepository(ctrl *gomock.Controller) *MockSDTSeasonRepository {
+	mock := &MockSDTSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSDTSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSDTSeasonRepository) EXPECT() *MockSDTSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindActiveIDs mocks base method.
+func (m *MockSDTSeasonRepository) FindActiveIDs(ctx context.Context, now time.Time) ([]id.SeasonID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveIDs", ctx, now)
+	ret0, _ := ret[0].([]id.SeasonID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveIDs indicates an expected call of FindActiveIDs.
+func (mr *MockSDTSeasonRepositoryMockRecorder) FindActiveIDs(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveIDs", reflect.TypeOf((*MockSDTSeasonRepository)(nil).FindActiveIDs), ctx, now)
+}
+
+// MockSDTDeckTrendRepository is a mock of SDTDeckTrendRepository interface.
+type MockSDTDeckTrendRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSDTDeckTrendRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSDTDeckTrendRepositoryMockRecorder is the mock recorder for MockSDTDeckTrendRepository.
+type MockSDTDeckTrendRepositoryMockRecorder struct {
+	mock *MockSDTDeckTrendRepository
+}
+
+// NewMockSDTDeckTrendRepository creates a new mock instance.
+func NewMockSDTDeckTrendRepository(ctrl *gomock.Controller) *MockSDTDeckTrendRepository {
+	mock := &MockSDTDeckTrendRepository{ctrl: ctrl}
+	mock.recorder = &MockSDTDeckTrendRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSDTDeckTrendRepository) EXPECT() *MockSDTDeckTrendRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Snapshot mocks base method.
+func (m *MockSDTDeckTrendRepository) Snapshot(ctx context.Context, seasonID id.SeasonID, date time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", ctx, seasonID, date)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockSDTDeckTrendRepositoryMockRecorder) Snapshot(ctx, seasonID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockSDTDeckTrendRepository)(nil).Snapshot), ctx, seasonID, date)
+}
@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSnapshotDeckTrendsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	otherSeasonID := id.NewSeasonID()
+	now := time.Date(2025, 8, 10, 3, 0, 0, 0, time.UTC)
+	snapshotDate := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(seasonRepo *MockSDTSeasonRepository, deckTrendRepo *MockSDTDeckTrendRepository)
+		want        *usecase.SnapshotDeckTrendsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 開催中の全シーズンがその日の日付で記録される",
+			setupMock: func(seasonRepo *MockSDTSeasonRepository, deckTrendRepo *MockSDTDeckTrendRepository) {
+				seasonRepo.EXPECT().FindActiveIDs(gomock.Any(), now).Return([]id.SeasonID{seasonID, otherSeasonID}, nil)
+				deckTrendRepo.EXPECT().Snapshot(gomock.Any(), seasonID, snapshotDate).Return(12, nil)
+				deckTrendRepo.EXPECT().Snapshot(gomock.Any(), otherSeasonID, snapshotDate).Return(3, nil)
+			},
+			want: &usecase.SnapshotDeckTrendsResult{SnapshotDate: snapshotDate, SeasonCount: 2, DeckCount: 15},
+		},
+		{
+			caseName: "正常系: 開催中のシーズンがない場合、何も記録しない",
+			setupMock: func(seasonRepo *MockSDTSeasonRepository, deckTrendRepo *MockSDTDeckTrendRepository) {
+				seasonRepo.EXPECT().FindActiveIDs(gomock.Any(), now).Return([]id.SeasonID{}, nil)
+			},
+			want: &usecase.SnapshotDeckTrendsResult{SnapshotDate: snapshotDate},
+		},
+		{
+			caseName: "異常系: 記録でエラーが発生した場合、エラーを返す",
+			setupMock: func(seasonRepo *MockSDTSeasonRepository, deckTrendRepo *MockSDTDeckTrendRepository) {
+				seasonRepo.EXPECT().FindActiveIDs(gomock.Any(), now).Return([]id.SeasonID{seasonID}, nil)
+				deckTrendRepo.EXPECT().Snapshot(gomock.Any(), seasonID, snapshotDate).Return(0, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to snapshot deck trends",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seasonRepo := NewMockSDTSeasonRepository(ctrl)
+			deckTrendRepo := NewMockSDTDeckTrendRepository(ctrl)
+			tt.setupMock(seasonRepo, deckTrendRepo)
+
+			params := usecase.SnapshotDeckTrendsParams{Now: now}
+			usecase := usecase.NewSnapshotDeckTrendsUsecase(seasonRepo, deckTrendRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"poketier/pkg/vo/id"
+)
+
+// ConsensusMethod は集計ティアリストの算出方式
+type ConsensusMethod string
+
+const (
+	// ConsensusMethodMean は配置ランクの加重平均
+	ConsensusMethodMean ConsensusMethod = "mean"
+	// ConsensusMethodTrimmedMean は上下の外れ値を除いた加重平均
+	ConsensusMethodTrimmedMean ConsensusMethod = "trimmed_mean"
+	// ConsensusMethodMedian は配置ランクの加重中央値
+	ConsensusMethodMedian ConsensusMethod = "median"
+	// ConsensusMethodBorda はティアリスト内の相対順位によるボルダ得点
+	ConsensusMethodBorda ConsensusMethod = "borda"
+	// ConsensusMethodBradleyTerry はティアリスト内の一対比較から推定するBradley–Terryモデル
+	ConsensusMethodBradleyTerry ConsensusMethod = "bradley_terry"
+)
+
+// DefaultConsensusMethod は既定の算出方式
+const DefaultConsensusMethod = ConsensusMethodMean
+
+// AllConsensusMethods は全ての算出方式を返す
+func AllConsensusMethods() []ConsensusMethod {
+	return []ConsensusMethod{
+		ConsensusMethodMean,
+		ConsensusMethodTrimmedMean,
+		ConsensusMethodMedian,
+		ConsensusMethodBorda,
+		ConsensusMethodBradleyTerry,
+	}
+}
+
+// ParseConsensusMethod は文字列から算出方式を作成する
+func ParseConsensusMethod(s string) (ConsensusMethod, error) {
+	method := ConsensusMethod(s)
+	if !slices.Contains(AllConsensusMethods(), method) {
+		return "", fmt.Errorf("unknown consensus method: %s", s)
+	}
+	return method, nil
+}
+
+// ConsensusAlgorithm は配置からデッキごとのスコアを算出する戦略
+// スコアはTierRankと同じ尺度（E=1 〜 SS=7）で返し、最も近いTierRankへの振り分けに使用される
+type ConsensusAlgorithm interface {
+	Method() ConsensusMethod
+	Score(placements []Placement) map[id.DeckID]float64
+}
+
+// NewConsensusAlgorithm は算出方式に対応するアルゴリズムを返す
+func NewConsensusAlgorithm(method ConsensusMethod) (ConsensusAlgorithm, error) {
+	switch method {
+	case ConsensusMethodMean:
+		return MeanAlgorithm{}, nil
+	case ConsensusMethodTrimmedMean:
+		return TrimmedMeanAlgorithm{TrimRatio: DefaultTrimRatio}, nil
+	case ConsensusMethodMedian:
+		return MedianAlgorithm{}, nil
+	case ConsensusMethodBorda:
+		return BordaAlgorithm{}, nil
+	case ConsensusMethodBradleyTerry:
+		return BradleyTerryAlgorithm{}, nil
+	}
+	return nil, fmt.Errorf("unknown consensus method: %s", method)
+}
+
+// groupByDeck はデッキごとに配置をランクの昇順でまとめる
+func groupByDeck(placements []Placement) map[id.DeckID][]Placement {
+	grouped := make(map[id.DeckID][]Placement)
+	for _, p := range placements {
+		grouped[p.DeckID] = append(grouped[p.DeckID], p)
+	}
+	for _, ps := range grouped {
+		slices.SortStableFunc(ps, func(a, b Placement) int {
+			return cmp.Compare(a.TierRank, b.TierRank)
+		})
+	}
+	return grouped
+}
+
+// groupByTierList はティアリストごとに配置をまとめ、ティアリストID順で返す
+func groupByTierList(placements []Placement) [][]Placement {
+	grouped := make(map[id.TierListID][]Placement)
+	for _, p := range placements {
+		grouped[p.TierListID] = append(grouped[p.TierListID], p)
+	}
+	keys := make([]id.TierListID, 0, len(grouped))
+	for k := range grouped {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b id.TierListID) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	lists := make([][]Placement, 0, len(keys))
+	for _, k := range keys {
+		lists = append(lists, grouped[k])
+	}
+	return lists
+}
+
+// sortedDeckIDs はスコア算出の順序を固定するため、デッキIDを昇順で返す
+func sortedDeckIDs(placements []Placement) []id.DeckID {
+	seen := make(map[id.DeckID]struct{})
+	deckIDs := make([]id.DeckID, 0)
+	for _, p := range placements {
+		if _, ok := seen[p.DeckID]; ok {
+			continue
+		}
+		seen[p.DeckID] = struct{}{}
+		deckIDs = append(deckIDs, p.DeckID)
+	}
+	slices.SortFunc(deckIDs, func(a, b id.DeckID) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	return deckIDs
+}
+
+// scaleToTierRank は0〜1の相対スコアをTierRankの尺度（1〜7）に変換する
+func scaleToTierRank(ratio float64) float64 {
+	return 1 + ratio*6
+}
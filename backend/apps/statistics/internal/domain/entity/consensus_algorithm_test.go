@@ -0,0 +1,231 @@
+package entity_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// consensusInput は算出方式のゴールデンテストに使用する合成データ
+type consensusInput struct {
+	Decks []struct {
+		Name   string `json:"name"`
+		DeckID string `json:"deck_id"`
+	} `json:"decks"`
+	TierLists []struct {
+		TierListID string  `json:"tier_list_id"`
+		Weight     float64 `json:"weight"`
+		Placements []struct {
+			Deck string `json:"deck"`
+			Tier string `json:"tier"`
+		} `json:"placements"`
+	} `json:"tier_lists"`
+}
+
+// consensusGolden は算出方式ごとの期待する集計結果
+type consensusGolden struct {
+	Deck           string  `json:"deck"`
+	Score          float64 `json:"score"`
+	PlacementCount int     `json:"placement_count"`
+	Tier           string  `json:"tier"`
+}
+
+// createTestPlacements は合成データから配置とデッキ名の対応を作成する
+func createTestPlacements(t *testing.T) ([]entity.Placement, map[id.DeckID]string) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "consensus", "input.json"))
+	require.NoError(t, err, "failed to read input")
+	var input consensusInput
+	require.NoError(t, json.Unmarshal(data, &input), "failed to parse input")
+
+	deckIDs := make(map[string]id.DeckID, len(input.Decks))
+	names := make(map[id.DeckID]string, len(input.Decks))
+	for _, d := range input.Decks {
+		deckID, err := id.DeckIDFromString(d.DeckID)
+		require.NoError(t, err, "failed to parse deck ID")
+		deckIDs[d.Name] = deckID
+		names[deckID] = d.Name
+	}
+
+	placements := make([]entity.Placement, 0)
+	for _, l := range input.TierLists {
+		tierListID, err := id.TierListIDFromString(l.TierListID)
+		require.NoError(t, err, "failed to parse tier list ID")
+		for _, p := range l.Placements {
+			tierRank, err := rank.ParseTierRank(p.Tier)
+			require.NoError(t, err, "failed to parse tier rank")
+			placements = append(placements, entity.Placement{
+				TierListID: tierListID,
+				DeckID:     deckIDs[p.Deck],
+				TierRank:   tierRank,
+				Weight:     l.Weight,
+			})
+		}
+	}
+	return placements, names
+}
+
+func TestConsensusAlgorithm_Golden(t *testing.T) {
+	t.Parallel()
+
+	placements, names := createTestPlacements(t)
+
+	for _, method := range entity.AllConsensusMethods() {
+		t.Run(string(method), func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			algorithm, err := entity.NewConsensusAlgorithm(method)
+			require.NoError(t, err, "failed to create algorithm")
+
+			// Act
+			consensus, err := entity.CalculateConsensus(id.NewSeasonID(), 8, placements, algorithm, 1, time.Now())
+			require.NoError(t, err, "no error should be returned")
+
+			// Assert
+			got := make([]consensusGolden, 0, len(consensus.Entries()))
+			for _, e := range consensus.Entries() {
+				got = append(got, consensusGolden{
+					Deck:           names[e.DeckID],
+					Score:          math.Round(e.Score*10000) / 10000,
+					PlacementCount: e.PlacementCount,
+					Tier:           e.TierRank.String(),
+				})
+			}
+			assert.Equal(t, method, consensus.Method(), "method should match")
+
+			path := filepath.Join("testdata", "consensus", string(method)+".golden.json")
+			if *update {
+				data, err := json.MarshalIndent(got, "", "  ")
+				require.NoError(t, err, "failed to marshal golden")
+				require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o600), "failed to write golden")
+			}
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err, "failed to read golden")
+			var want []consensusGolden
+			require.NoError(t, json.Unmarshal(data, &want), "failed to parse golden")
+			assert.Equal(t, want, got, "consensus should match golden file")
+		})
+	}
+}
+
+func TestParseConsensusMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		input    string
+		want     entity.ConsensusMethod
+		wantErr  bool
+	}{
+		{caseName: "正常系: 平均", input: "mean", want: entity.ConsensusMethodMean},
+		{caseName: "正常系: Bradley–Terry", input: "bradley_terry", want: entity.ConsensusMethodBradleyTerry},
+		{caseName: "異常系: 未定義の方式", input: "average", wantErr: true},
+		{caseName: "異常系: 空文字", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.ParseConsensusMethod(tt.input)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "error should be returned")
+				return
+			}
+			assert.NoError(t, err, "no error should be returned")
+			assert.Equal(t, tt.want, got, "method should match")
+		})
+	}
+}
+
+func TestMedianAlgorithm_Score(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	tests := []struct {
+		caseName string
+		ranks    []rank.TierRank
+		want     float64
+	}{
+		{caseName: "正常系: 奇数件の場合は中央の値", ranks: []rank.TierRank{rank.TierE, rank.TierA, rank.TierSS}, want: 5},
+		{caseName: "正常系: 偶数件の場合は中央2件の平均", ranks: []rank.TierRank{rank.TierC, rank.TierB, rank.TierS, rank.TierSS}, want: 5},
+		{caseName: "正常系: 1件の場合はその値", ranks: []rank.TierRank{rank.TierD}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			placements := make([]entity.Placement, 0, len(tt.ranks))
+			for _, r := range tt.ranks {
+				placements = append(placements, entity.NewPlacement(id.NewTierListID(), deckID, r))
+			}
+
+			// Act
+			got := entity.MedianAlgorithm{}.Score(placements)
+
+			// Assert
+			assert.InDelta(t, tt.want, got[deckID], 1e-9, "median should match")
+		})
+	}
+}
+
+func TestTrimmedMeanAlgorithm_Score(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deckID := id.NewDeckID()
+	placements := make([]entity.Placement, 0, 5)
+	for _, r := range []rank.TierRank{rank.TierE, rank.TierA, rank.TierA, rank.TierA, rank.TierSS} {
+		placements = append(placements, entity.NewPlacement(id.NewTierListID(), deckID, r))
+	}
+
+	// Act
+	got := entity.TrimmedMeanAlgorithm{TrimRatio: 0.2}.Score(placements)
+
+	// Assert
+	assert.InDelta(t, 5, got[deckID], 1e-9, "the lowest and highest placements should be trimmed")
+}
+
+func TestBradleyTerryAlgorithm_Score(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	strong, weak := id.NewDeckID(), id.NewDeckID()
+	placements := make([]entity.Placement, 0)
+	for range 5 {
+		tierListID := id.NewTierListID()
+		placements = append(placements,
+			entity.NewPlacement(tierListID, strong, rank.TierS),
+			entity.NewPlacement(tierListID, weak, rank.TierB),
+		)
+	}
+
+	// Act
+	got := entity.BradleyTerryAlgorithm{}.Score(placements)
+
+	// Assert
+	assert.Greater(t, got[strong], got[weak], "the deck placed higher should be stronger")
+	assert.False(t, math.IsInf(got[strong], 0) || math.IsNaN(got[strong]), "undefeated deck should have a finite score")
+	assert.InDelta(t, 8, got[strong]+got[weak], 1e-9, "scores of two decks should be symmetric around 4")
+}
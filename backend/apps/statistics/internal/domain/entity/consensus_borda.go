@@ -0,0 +1,51 @@
+package entity
+
+import "poketier/pkg/vo/id"
+
+// BordaAlgorithm はティアリスト内の相対順位によるボルダ得点をスコアとする
+// 各ティアリストで自分より下位に置かれたデッキの割合（同ランクは0.5）を得点とし、その加重平均を用いる
+// 絶対的なランクではなく相対順位のみを扱うため、一部のデッキしか配置していないティアリストの影響を受けにくい
+type BordaAlgorithm struct{}
+
+// Method は算出方式を返す
+func (BordaAlgorithm) Method() ConsensusMethod {
+	return ConsensusMethodBorda
+}
+
+// Score はデッキごとのボルダ得点をTierRankの尺度に変換して算出する
+// 他のデッキと比較できるティアリストがないデッキは中間の得点とする
+func (BordaAlgorithm) Score(placements []Placement) map[id.DeckID]float64 {
+	weightedSums := make(map[id.DeckID]float64)
+	weightSums := make(map[id.DeckID]float64)
+	for _, list := range groupByTierList(placements) {
+		if len(list) < 2 {
+			continue
+		}
+		for _, p := range list {
+			var points float64
+			for _, other := range list {
+				if other.DeckID == p.DeckID {
+					continue
+				}
+				switch {
+				case p.TierRank > other.TierRank:
+					points++
+				case p.TierRank == other.TierRank:
+					points += 0.5
+				}
+			}
+			weightedSums[p.DeckID] += p.Weight * points / float64(len(list)-1)
+			weightSums[p.DeckID] += p.Weight
+		}
+	}
+
+	scores := make(map[id.DeckID]float64)
+	for _, deckID := range sortedDeckIDs(placements) {
+		ratio := 0.5
+		if weightSums[deckID] > 0 {
+			ratio = weightedSums[deckID] / weightSums[deckID]
+		}
+		scores[deckID] = scaleToTierRank(ratio)
+	}
+	return scores
+}
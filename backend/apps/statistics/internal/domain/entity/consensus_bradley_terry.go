@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"math"
+
+	"poketier/pkg/vo/id"
+)
+
+const (
+	// bradleyTerryPriorWins は各デッキが仮想の基準デッキ（強さ1）に対して持つ勝ち数・負け数
+	// 全勝・全敗のデッキの強さが発散しないようにするための正則化
+	bradleyTerryPriorWins = 0.5
+	// bradleyTerryMaxIterations はMMアルゴリズムの最大反復回数
+	bradleyTerryMaxIterations = 1000
+	// bradleyTerryTolerance は収束と判定する強さの相対変化量
+	bradleyTerryTolerance = 1e-9
+)
+
+// BradleyTerryAlgorithm はティアリスト内の一対比較から推定したBradley–Terryモデルの強さをスコアとする
+// 同じティアリストで上位に置かれたデッキを勝ち（同ランクは0.5勝ずつ）とし、MMアルゴリズムで強さを推定する
+type BradleyTerryAlgorithm struct{}
+
+// Method は算出方式を返す
+func (BradleyTerryAlgorithm) Method() ConsensusMethod {
+	return ConsensusMethodBradleyTerry
+}
+
+// Score はデッキごとの強さから他の全デッキに対する平均勝率を求め、TierRankの尺度に変換して算出する
+func (BradleyTerryAlgorithm) Score(placements []Placement) map[id.DeckID]float64 {
+	deckIDs := sortedDeckIDs(placements)
+	n := len(deckIDs)
+	index := make(map[id.DeckID]int, n)
+	for i, deckID := range deckIDs {
+		index[deckID] = i
+	}
+
+	// wins[i] はデッキiの総勝ち数、comparisons[i][j] はデッキiとjの比較回数（重み付き）
+	wins := make([]float64, n)
+	comparisons := make([][]float64, n)
+	for i := range comparisons {
+		comparisons[i] = make([]float64, n)
+	}
+	for _, list := range groupByTierList(placements) {
+		for x := 0; x < len(list); x++ {
+			for y := x + 1; y < len(list); y++ {
+				a, b := list[x], list[y]
+				if a.DeckID == b.DeckID {
+					continue
+				}
+				i, j := index[a.DeckID], index[b.DeckID]
+				// 一対比較の重みは両デッキの配置の重みの平均とする
+				weight := (a.Weight + b.Weight) / 2
+				comparisons[i][j] += weight
+				comparisons[j][i] += weight
+				switch {
+				case a.TierRank > b.TierRank:
+					wins[i] += weight
+				case a.TierRank < b.TierRank:
+					wins[j] += weight
+				default:
+					wins[i] += weight / 2
+					wins[j] += weight / 2
+				}
+			}
+		}
+	}
+
+	strengths := make([]float64, n)
+	for i := range strengths {
+		strengths[i] = 1
+	}
+	for range bradleyTerryMaxIterations {
+		next := make([]float64, n)
+		var maxChange float64
+		for i := range n {
+			// 仮想の基準デッキとの比較（2 × 事前勝ち数）を分母に含める
+			denominator := 2 * bradleyTerryPriorWins / (strengths[i] + 1)
+			for j := range n {
+				if comparisons[i][j] > 0 {
+					denominator += comparisons[i][j] / (strengths[i] + strengths[j])
+				}
+			}
+			next[i] = (wins[i] + bradleyTerryPriorWins) / denominator
+			maxChange = math.Max(maxChange, math.Abs(next[i]-strengths[i])/strengths[i])
+		}
+		strengths = next
+		if maxChange < bradleyTerryTolerance {
+			break
+		}
+	}
+
+	scores := make(map[id.DeckID]float64, n)
+	for i, deckID := range deckIDs {
+		ratio := 0.5
+		if n > 1 {
+			var sum float64
+			for j := range n {
+				if i != j {
+					sum += strengths[i] / (strengths[i] + strengths[j])
+				}
+			}
+			ratio = sum / float64(n-1)
+		}
+		scores[deckID] = scaleToTierRank(ratio)
+	}
+	return scores
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"math"
+
+	"poketier/pkg/vo/id"
+)
+
+// DefaultTrimRatio はトリム平均で上下それぞれから除外する重みの割合
+const DefaultTrimRatio = 0.2
+
+// MeanAlgorithm は配置ランクの加重平均をスコアとする
+type MeanAlgorithm struct{}
+
+// Method は算出方式を返す
+func (MeanAlgorithm) Method() ConsensusMethod {
+	return ConsensusMethodMean
+}
+
+// Score はデッキごとの加重平均ランクを算出する
+func (MeanAlgorithm) Score(placements []Placement) map[id.DeckID]float64 {
+	return TrimmedMeanAlgorithm{TrimRatio: 0}.Score(placements)
+}
+
+// TrimmedMeanAlgorithm は上下の外れ値を重みの割合で除外した加重平均をスコアとする
+// 少数の極端な評価によって平均が引きずられるのを抑える
+type TrimmedMeanAlgorithm struct {
+	TrimRatio float64
+}
+
+// Method は算出方式を返す
+func (TrimmedMeanAlgorithm) Method() ConsensusMethod {
+	return ConsensusMethodTrimmedMean
+}
+
+// Score はデッキごとのトリム平均ランクを算出する
+// 除外する境界にまたがる配置は重みの一部のみを採用する
+func (a TrimmedMeanAlgorithm) Score(placements []Placement) map[id.DeckID]float64 {
+	scores := make(map[id.DeckID]float64)
+	for deckID, ps := range groupByDeck(placements) {
+		var total float64
+		for _, p := range ps {
+			total += p.Weight
+		}
+		lower, upper := total*a.TrimRatio, total*(1-a.TrimRatio)
+
+		var cumulative, weightedSum, weightSum float64
+		for _, p := range ps {
+			start, end := cumulative, cumulative+p.Weight
+			cumulative = end
+			kept := math.Min(end, upper) - math.Max(start, lower)
+			if kept <= 0 {
+				continue
+			}
+			weightedSum += kept * float64(p.TierRank.Int())
+			weightSum += kept
+		}
+		if weightSum > 0 {
+			scores[deckID] = weightedSum / weightSum
+		}
+	}
+	return scores
+}
+
+// MedianAlgorithm は配置ランクの加重中央値をスコアとする
+type MedianAlgorithm struct{}
+
+// Method は算出方式を返す
+func (MedianAlgorithm) Method() ConsensusMethod {
+	return ConsensusMethodMedian
+}
+
+// Score はデッキごとの加重中央値ランクを算出する
+// 累積の重みがちょうど半分で分かれる場合は前後のランクの平均とする
+func (MedianAlgorithm) Score(placements []Placement) map[id.DeckID]float64 {
+	const epsilon = 1e-9
+
+	scores := make(map[id.DeckID]float64)
+	for deckID, ps := range groupByDeck(placements) {
+		var total float64
+		for _, p := range ps {
+			total += p.Weight
+		}
+		half := total / 2
+
+		var cumulative float64
+		for i, p := range ps {
+			cumulative += p.Weight
+			if cumulative < half-epsilon {
+				continue
+			}
+			if math.Abs(cumulative-half) <= epsilon && i+1 < len(ps) {
+				scores[deckID] = float64(p.TierRank.Int()+ps[i+1].TierRank.Int()) / 2
+			} else {
+				scores[deckID] = float64(p.TierRank.Int())
+			}
+			break
+		}
+	}
+	return scores
+}
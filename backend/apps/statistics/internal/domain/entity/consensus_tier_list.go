@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// DefaultMinPlacementCount は集計ティアリストに掲載するために必要な既定の最小配置数
+const DefaultMinPlacementCount = 3
+
+// ConsensusEntry は集計ティアリストにおける1デッキの集計結果
+// Score は算出方式によるスコアをTierRankと同じ尺度（E=1 〜 SS=7）で表したもの
+// ScoreLower / ScoreUpper はスコアの95%信頼区間、TierProbability は真のスコアが TierRank に振り分けられる確率
+type ConsensusEntry struct {
+	DeckID          id.DeckID
+	Score           float64
+	StandardError   float64
+	ScoreLower      float64
+	ScoreUpper      float64
+	PlacementCount  int
+	TierRank        rank.TierRank
+	TierProbability float64
+}
+
+// ConsensusTierList はシーズン内の全ティアリストを集計したティアリスト
+type ConsensusTierList struct {
+	seasonID       id.SeasonID
+	method         ConsensusMethod
+	totalTierLists int
+	entries        []ConsensusEntry
+	generatedAt    time.Time
+}
+
+// CalculateConsensus は算出方式に従って配置からデッキごとの集計結果を算出する
+func CalculateConsensus(seasonID id.SeasonID, totalTierLists int, placements []Placement, algorithm ConsensusAlgorithm, minPlacementCount int, generatedAt time.Time) (*ConsensusTierList, error) {
+	if algorithm == nil {
+		return nil, errors.New("consensus algorithm is required")
+	}
+	if minPlacementCount < 1 {
+		return nil, errors.New("min placement count must be at least 1")
+	}
+
+	// 重みが0以下の配置（信頼度のないティアリストなど）は集計に含めない
+	counted := make([]Placement, 0, len(placements))
+	counts := make(map[id.DeckID]int)
+	for _, p := range placements {
+		if p.Weight <= 0 {
+			continue
+		}
+		counted = append(counted, p)
+		counts[p.DeckID]++
+	}
+
+	// 平均方式は標準誤差を解析的に求め、それ以外の方式は再標本化で求める
+	var standardErrors map[id.DeckID]float64
+	if algorithm.Method() == ConsensusMethodMean {
+		standardErrors = meanStandardErrors(counted)
+	} else {
+		standardErrors = bootstrapStandardErrors(counted, algorithm)
+	}
+
+	return newConsensusTierList(seasonID, algorithm.Method(), totalTierLists, algorithm.Score(counted), standardErrors, counts, minPlacementCount, generatedAt), nil
+}
+
+// ConsensusFromStatistics はティア統計の累計から平均方式（mean）の集計結果を算出する
+// 配置を全件走査せずに済むため、平均方式ではこちらを使用する
+// スコアは信頼度で重み付けした平均で、信頼度のあるティアリストに配置されていないデッキは除外する
+func ConsensusFromStatistics(seasonID id.SeasonID, totalTierLists int, statistics []TierStatistic, minPlacementCount int, generatedAt time.Time) (*ConsensusTierList, error) {
+	if minPlacementCount < 1 {
+		return nil, errors.New("min placement count must be at least 1")
+	}
+
+	scores := make(map[id.DeckID]float64, len(statistics))
+	standardErrors := make(map[id.DeckID]float64, len(statistics))
+	counts := make(map[id.DeckID]int, len(statistics))
+	for _, s := range statistics {
+		if s.PlacementCount <= 0 || s.WeightSum <= 0 {
+			continue
+		}
+		scores[s.DeckID] = s.WeightedAverageTierRank()
+		standardErrors[s.DeckID] = s.WeightedAverageTierRankStandardError()
+		counts[s.DeckID] = s.PlacementCount
+	}
+
+	return newConsensusTierList(seasonID, ConsensusMethodMean, totalTierLists, scores, standardErrors, counts, minPlacementCount, generatedAt), nil
+}
+
+// newConsensusTierList はデッキごとのスコアと標準誤差、配置数から集計結果を作成する
+// 配置数が minPlacementCount に満たないデッキは除外し、スコアに最も近いTierRankへ振り分ける
+func newConsensusTierList(seasonID id.SeasonID, method ConsensusMethod, totalTierLists int, scores, standardErrors map[id.DeckID]float64, counts map[id.DeckID]int, minPlacementCount int, generatedAt time.Time) *ConsensusTierList {
+	entries := make([]ConsensusEntry, 0, len(scores))
+	for deckID, score := range scores {
+		if counts[deckID] < minPlacementCount {
+			continue
+		}
+		standardError, ok := standardErrors[deckID]
+		if !ok {
+			standardError = math.Inf(1)
+		}
+		tierRank := NearestTierRank(score)
+		lower, upper := confidenceInterval(score, standardError)
+		entries = append(entries, ConsensusEntry{
+			DeckID:          deckID,
+			Score:           score,
+			StandardError:   standardError,
+			ScoreLower:      lower,
+			ScoreUpper:      upper,
+			PlacementCount:  counts[deckID],
+			TierRank:        tierRank,
+			TierProbability: tierProbability(score, standardError, tierRank),
+		})
+	}
+
+	// スコアの高い順、同値の場合は配置数の多い順に並べる
+	slices.SortFunc(entries, func(a, b ConsensusEntry) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.PlacementCount, a.PlacementCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.DeckID.String(), b.DeckID.String())
+	})
+
+	return &ConsensusTierList{
+		seasonID:       seasonID,
+		method:         method,
+		totalTierLists: totalTierLists,
+		entries:        entries,
+		generatedAt:    generatedAt,
+	}
+}
+
+// NearestTierRank はスコアに最も近いTierRankを返す（範囲外の値は SS/E に丸める）
+func NearestTierRank(score float64) rank.TierRank {
+	rounded := int(math.Round(score))
+	return rank.TierRank(min(max(rounded, rank.TierE.Int()), rank.TierSS.Int()))
+}
+
+// SeasonID は集計対象のシーズンIDを返す
+func (c *ConsensusTierList) SeasonID() id.SeasonID {
+	return c.seasonID
+}
+
+// Method は集計に使用した算出方式を返す
+func (c *ConsensusTierList) Method() ConsensusMethod {
+	return c.method
+}
+
+// TotalTierLists は集計対象となったティアリストの数を返す
+func (c *ConsensusTierList) TotalTierLists() int {
+	return c.totalTierLists
+}
+
+// Entries は集計結果をスコアの高い順で返す
+func (c *ConsensusTierList) Entries() []ConsensusEntry {
+	return slices.Clone(c.entries)
+}
+
+// FilterByPlacementCount は配置数が minPlacementCount 以上のデッキのみを掲載した集計結果を返す
+// スコアは最小配置数によらないため、全デッキを対象に算出した集計結果から最小配置数ごとの集計結果を作成できる
+func (c *ConsensusTierList) FilterByPlacementCount(minPlacementCount int) *ConsensusTierList {
+	entries := make([]ConsensusEntry, 0, len(c.entries))
+	for _, e := range c.entries {
+		if e.PlacementCount >= minPlacementCount {
+			entries = append(entries, e)
+		}
+	}
+	return &ConsensusTierList{
+		seasonID:       c.seasonID,
+		method:         c.method,
+		totalTierLists: c.totalTierLists,
+		entries:        entries,
+		generatedAt:    c.generatedAt,
+	}
+}
+
+// EntriesByTier は指定したTierRankに振り分けられた集計結果を返す
+func (c *ConsensusTierList) EntriesByTier(tierRank rank.TierRank) []ConsensusEntry {
+	entries := make([]ConsensusEntry, 0)
+	for _, e := range c.entries {
+		if e.TierRank == tierRank {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// GeneratedAt は集計日時を返す
+func (c *ConsensusTierList) GeneratedAt() time.Time {
+	return c.generatedAt
+}
@@ -0,0 +1,180 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateConsensus(t *testing.T) {
+	t.Parallel()
+
+	seasonID := id.NewSeasonID()
+	generatedAt := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	listA, listB, listC := id.NewTierListID(), id.NewTierListID(), id.NewTierListID()
+	deckA, deckB, deckC := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	tests := []struct {
+		caseName          string
+		placements        []entity.Placement
+		minPlacementCount int
+		want              []entity.ConsensusEntry
+		wantErr           bool
+	}{
+		{
+			caseName: "正常系: 平均ランクの高い順に最も近いTierRankへ振り分けられる",
+			placements: []entity.Placement{
+				entity.NewPlacement(listA, deckA, rank.TierSS),
+				entity.NewPlacement(listB, deckA, rank.TierS),
+				entity.NewPlacement(listC, deckA, rank.TierSS),
+				entity.NewPlacement(listA, deckB, rank.TierB),
+				entity.NewPlacement(listB, deckB, rank.TierC),
+			},
+			minPlacementCount: 1,
+			want: []entity.ConsensusEntry{
+				{DeckID: deckA, Score: 20.0 / 3, PlacementCount: 3, TierRank: rank.TierSS},
+				{DeckID: deckB, Score: 3.5, PlacementCount: 2, TierRank: rank.TierB},
+			},
+		},
+		{
+			caseName: "正常系: 最小配置数に満たないデッキは除外される",
+			placements: []entity.Placement{
+				entity.NewPlacement(listA, deckA, rank.TierA),
+				entity.NewPlacement(listB, deckA, rank.TierA),
+				entity.NewPlacement(listA, deckB, rank.TierSS),
+			},
+			minPlacementCount: 2,
+			want: []entity.ConsensusEntry{
+				{DeckID: deckA, Score: 5, PlacementCount: 2, TierRank: rank.TierA},
+			},
+		},
+		{
+			caseName: "正常系: 重みを考慮した加重平均で算出され、重みが0以下の配置は無視される",
+			placements: []entity.Placement{
+				{TierListID: listA, DeckID: deckC, TierRank: rank.TierSS, Weight: 3},
+				{TierListID: listB, DeckID: deckC, TierRank: rank.TierE, Weight: 1},
+				{TierListID: listC, DeckID: deckC, TierRank: rank.TierE, Weight: 0},
+			},
+			minPlacementCount: 1,
+			want: []entity.ConsensusEntry{
+				{DeckID: deckC, Score: 5.5, PlacementCount: 2, TierRank: rank.TierS},
+			},
+		},
+		{
+			caseName:          "正常系: 配置がない場合は空の結果を返す",
+			placements:        nil,
+			minPlacementCount: 1,
+			want:              []entity.ConsensusEntry{},
+		},
+		{
+			caseName:          "異常系: 最小配置数が1未満の場合はエラー",
+			minPlacementCount: 0,
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.CalculateConsensus(seasonID, 3, tt.placements, entity.MeanAlgorithm{}, tt.minPlacementCount, generatedAt)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "error should be returned")
+				return
+			}
+			require.NoError(t, err, "no error should be returned")
+			assert.Equal(t, seasonID, got.SeasonID(), "season ID should match")
+			assert.Equal(t, entity.ConsensusMethodMean, got.Method(), "method should match")
+			assert.Equal(t, 3, got.TotalTierLists(), "total tier lists should match")
+			assert.Equal(t, generatedAt, got.GeneratedAt(), "generated at should match")
+			assert.Len(t, got.Entries(), len(tt.want), "entry count should match")
+			for i, want := range tt.want {
+				assert.Equal(t, want.DeckID, got.Entries()[i].DeckID, "deck ID should match")
+				assert.InDelta(t, want.Score, got.Entries()[i].Score, 1e-9, "score should match")
+				assert.Equal(t, want.PlacementCount, got.Entries()[i].PlacementCount, "placement count should match")
+				assert.Equal(t, want.TierRank, got.Entries()[i].TierRank, "tier rank should match")
+			}
+		})
+	}
+}
+
+func TestNearestTierRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		average  float64
+		want     rank.TierRank
+	}{
+		{caseName: "正常系: 切り上げ側に近い値", average: 6.6, want: rank.TierSS},
+		{caseName: "正常系: 中間値は上位ランクに丸める", average: 4.5, want: rank.TierA},
+		{caseName: "正常系: 切り捨て側に近い値", average: 2.4, want: rank.TierD},
+		{caseName: "正常系: 下限未満はEに丸める", average: 0.2, want: rank.TierE},
+		{caseName: "正常系: 上限超過はSSに丸める", average: 7.8, want: rank.TierSS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.NearestTierRank(tt.average)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "tier rank should match")
+		})
+	}
+}
+
+func TestConsensusTierList_EntriesByTier(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	listA, listB := id.NewTierListID(), id.NewTierListID()
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	consensus, err := entity.CalculateConsensus(id.NewSeasonID(), 2, []entity.Placement{
+		entity.NewPlacement(listA, deckA, rank.TierS),
+		entity.NewPlacement(listB, deckA, rank.TierS),
+		entity.NewPlacement(listA, deckB, rank.TierD),
+	}, entity.MeanAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+
+	// Act & Assert
+	assert.Len(t, consensus.EntriesByTier(rank.TierS), 1, "S tier should have one deck")
+	assert.Equal(t, deckA, consensus.EntriesByTier(rank.TierS)[0].DeckID, "S tier deck should match")
+	assert.Len(t, consensus.EntriesByTier(rank.TierD), 1, "D tier should have one deck")
+	assert.Empty(t, consensus.EntriesByTier(rank.TierSS), "SS tier should be empty")
+}
+
+func TestConsensusTierList_FilterByPlacementCount(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	listA, listB := id.NewTierListID(), id.NewTierListID()
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	placements := []entity.Placement{
+		entity.NewPlacement(listA, deckA, rank.TierS),
+		entity.NewPlacement(listB, deckA, rank.TierA),
+		entity.NewPlacement(listA, deckB, rank.TierD),
+	}
+	all, err := entity.CalculateConsensus(id.NewSeasonID(), 2, placements, entity.MeanAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+	want, err := entity.CalculateConsensus(all.SeasonID(), 2, placements, entity.MeanAlgorithm{}, 2, all.GeneratedAt())
+	require.NoError(t, err, "no error should be returned")
+
+	// Act
+	got := all.FilterByPlacementCount(2)
+
+	// Assert
+	assert.Equal(t, want, got, "filtered consensus should match consensus calculated with the same min placement count")
+	assert.Len(t, all.Entries(), 2, "original consensus should not be modified")
+}
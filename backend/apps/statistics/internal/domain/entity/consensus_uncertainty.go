@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+const (
+	// confidenceZ は95%信頼区間に対応する標準正規分布の分位点
+	confidenceZ = 1.959963984540054
+	// uncertaintyPriorVariance はランクの事前分散（E=1 〜 SS=7 の一様分布の分散）
+	uncertaintyPriorVariance = 4
+	// uncertaintyPriorWeight は事前分散を配置何件分の重みとして扱うか
+	// 配置が少なく評価が揃っているデッキの信頼区間が過度に狭くならないようにする
+	uncertaintyPriorWeight = 1
+	// bootstrapSamples はブートストラップ法での再標本化の回数
+	bootstrapSamples = 100
+	// bootstrapSeed は再標本化の乱数の種（同じ配置からは同じ結果を返すよう固定する）
+	bootstrapSeed = 20250801
+)
+
+// placementWeights はデッキの配置の重みの合計と二乗の合計
+type placementWeights struct {
+	sum       float64
+	squareSum float64
+}
+
+// effectiveCount は重みの偏りを考慮した実効的な配置数を返す
+func (w placementWeights) effectiveCount() float64 {
+	if w.squareSum <= 0 {
+		return 0
+	}
+	return w.sum * w.sum / w.squareSum
+}
+
+// priorVariance は事前分散によるスコアの分散の下限を返す
+// 配置の評価が揃っていても、実効的な配置数が少ないうちは不確かさが残るものとする
+func (w placementWeights) priorVariance() float64 {
+	return uncertaintyPriorWeight * uncertaintyPriorVariance / ((w.sum + uncertaintyPriorWeight) * w.effectiveCount())
+}
+
+// meanStandardError は加重平均ランクの標準誤差を重み付きの累計から解析的に算出する
+// 配置の分散に事前分散を加えて平滑化し、実効的な配置数で割る
+func meanStandardError(weights placementWeights, weightedRankSum, weightedRankSquareSum float64) float64 {
+	n := weights.effectiveCount()
+	if weights.sum <= 0 || n <= 0 {
+		return math.Inf(1)
+	}
+	mean := weightedRankSum / weights.sum
+	squaredDeviation := math.Max(weightedRankSquareSum-weights.sum*mean*mean, 0)
+	variance := (squaredDeviation + uncertaintyPriorWeight*uncertaintyPriorVariance) / (weights.sum + uncertaintyPriorWeight)
+	return math.Sqrt(variance / n)
+}
+
+// meanStandardErrors は配置からデッキごとの加重平均ランクの標準誤差を算出する
+func meanStandardErrors(placements []Placement) map[id.DeckID]float64 {
+	standardErrors := make(map[id.DeckID]float64)
+	for deckID, ps := range groupByDeck(placements) {
+		var weights placementWeights
+		var weightedRankSum, weightedRankSquareSum float64
+		for _, p := range ps {
+			r := float64(p.TierRank.Int())
+			weights.sum += p.Weight
+			weights.squareSum += p.Weight * p.Weight
+			weightedRankSum += p.Weight * r
+			weightedRankSquareSum += p.Weight * r * r
+		}
+		standardErrors[deckID] = meanStandardError(weights, weightedRankSum, weightedRankSquareSum)
+	}
+	return standardErrors
+}
+
+// bootstrapStandardErrors はティアリスト単位の再標本化によってデッキごとのスコアの標準誤差を算出する
+// 再標本化はティアリストごとの抽出回数を重みに掛けることで行い、同じティアリスト内の配置の相関を保つ
+// 再標本化のばらつきに事前分散による下限を加え、配置の少ないデッキの信頼区間が過度に狭くならないようにする
+func bootstrapStandardErrors(placements []Placement, algorithm ConsensusAlgorithm) map[id.DeckID]float64 {
+	lists := groupByTierList(placements)
+
+	type moments struct {
+		n, sum, squareSum float64
+	}
+	samples := make(map[id.DeckID]*moments)
+	rng := rand.New(rand.NewPCG(bootstrapSeed, bootstrapSeed))
+	counts := make([]int, len(lists))
+	for range bootstrapSamples {
+		clear(counts)
+		for range lists {
+			counts[rng.IntN(len(lists))]++
+		}
+		resampled := make([]Placement, 0, len(placements))
+		for i, list := range lists {
+			if counts[i] == 0 {
+				continue
+			}
+			for _, p := range list {
+				p.Weight *= float64(counts[i])
+				resampled = append(resampled, p)
+			}
+		}
+		for deckID, score := range algorithm.Score(resampled) {
+			m, ok := samples[deckID]
+			if !ok {
+				m = &moments{}
+				samples[deckID] = m
+			}
+			m.n++
+			m.sum += score
+			m.squareSum += score * score
+		}
+	}
+
+	standardErrors := make(map[id.DeckID]float64)
+	for deckID, ps := range groupByDeck(placements) {
+		var weights placementWeights
+		for _, p := range ps {
+			weights.sum += p.Weight
+			weights.squareSum += p.Weight * p.Weight
+		}
+		if weights.effectiveCount() <= 0 {
+			standardErrors[deckID] = math.Inf(1)
+			continue
+		}
+
+		var variance float64
+		if m, ok := samples[deckID]; ok && m.n >= 2 {
+			mean := m.sum / m.n
+			variance = math.Max(m.squareSum-m.n*mean*mean, 0) / (m.n - 1)
+		}
+		standardErrors[deckID] = math.Sqrt(variance + weights.priorVariance())
+	}
+	return standardErrors
+}
+
+// confidenceInterval はスコアの95%信頼区間をTierRankの尺度（1〜7）の範囲で返す
+func confidenceInterval(score, standardError float64) (float64, float64) {
+	lower := math.Max(score-confidenceZ*standardError, float64(rank.TierE.Int()))
+	upper := math.Min(score+confidenceZ*standardError, float64(rank.TierSS.Int()))
+	return lower, upper
+}
+
+// tierProbability は真のスコアが指定したTierRankに振り分けられる確率を正規近似で返す
+// SS と E は範囲外のスコアも含める
+func tierProbability(score, standardError float64, tierRank rank.TierRank) float64 {
+	if standardError <= 0 {
+		if NearestTierRank(score) == tierRank {
+			return 1
+		}
+		return 0
+	}
+
+	lower, upper := 0.0, 1.0
+	if tierRank != rank.TierE {
+		lower = normalCDF((float64(tierRank.Int()) - 0.5 - score) / standardError)
+	}
+	if tierRank != rank.TierSS {
+		upper = normalCDF((float64(tierRank.Int()) + 0.5 - score) / standardError)
+	}
+	return math.Max(upper-lower, 0)
+}
+
+// normalCDF は標準正規分布の累積分布関数
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
@@ -0,0 +1,140 @@
+package entity_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createUniformPlacements は別々のティアリストで同じランクに配置された count 件の配置を作成する
+func createUniformPlacements(t *testing.T, deckID id.DeckID, tierRank rank.TierRank, count int) []entity.Placement {
+	t.Helper()
+
+	placements := make([]entity.Placement, 0, count)
+	for range count {
+		placements = append(placements, entity.NewPlacement(id.NewTierListID(), deckID, tierRank))
+	}
+	return placements
+}
+
+func TestCalculateConsensus_Uncertainty(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range entity.AllConsensusMethods() {
+		t.Run(string(method), func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			algorithm, err := entity.NewConsensusAlgorithm(method)
+			require.NoError(t, err, "failed to create algorithm")
+
+			// 配置の少ないデッキと多いデッキを、同じティアリストで上下に配置する
+			fewDeck, manyDeck, otherDeck := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+			placements := make([]entity.Placement, 0)
+			for i := range 60 {
+				listID := id.NewTierListID()
+				placements = append(placements,
+					entity.NewPlacement(listID, manyDeck, rank.TierS),
+					entity.NewPlacement(listID, otherDeck, rank.TierC),
+				)
+				if i < 3 {
+					placements = append(placements, entity.NewPlacement(listID, fewDeck, rank.TierS))
+				}
+			}
+
+			// Act
+			consensus, err := entity.CalculateConsensus(id.NewSeasonID(), 60, placements, algorithm, 1, time.Now())
+			require.NoError(t, err, "no error should be returned")
+
+			// Assert
+			entries := make(map[id.DeckID]entity.ConsensusEntry)
+			for _, e := range consensus.Entries() {
+				assert.LessOrEqual(t, e.ScoreLower, e.Score, "lower bound should not exceed score")
+				assert.GreaterOrEqual(t, e.ScoreUpper, e.Score, "upper bound should not be below score")
+				assert.GreaterOrEqual(t, e.ScoreLower, 1.0, "lower bound should be within tier rank scale")
+				assert.LessOrEqual(t, e.ScoreUpper, 7.0, "upper bound should be within tier rank scale")
+				assert.True(t, e.TierProbability >= 0 && e.TierProbability <= 1, "tier probability should be between 0 and 1")
+				entries[e.DeckID] = e
+			}
+			few, many := entries[fewDeck], entries[manyDeck]
+			assert.Greater(t, few.ScoreUpper-few.ScoreLower, many.ScoreUpper-many.ScoreLower, "deck with few placements should have wider interval")
+			assert.Greater(t, many.TierProbability, few.TierProbability, "deck with many placements should be more likely to belong to its tier")
+		})
+	}
+}
+
+func TestCalculateConsensus_UncertaintyIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	placements := make([]entity.Placement, 0)
+	for i := range 10 {
+		listID := id.NewTierListID()
+		placements = append(placements,
+			entity.NewPlacement(listID, deckA, rank.TierRank(3+i%3)),
+			entity.NewPlacement(listID, deckB, rank.TierRank(2+i%4)),
+		)
+	}
+
+	// Act
+	first, err := entity.CalculateConsensus(id.NewSeasonID(), 10, placements, entity.BradleyTerryAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+	second, err := entity.CalculateConsensus(id.NewSeasonID(), 10, placements, entity.BradleyTerryAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+
+	// Assert
+	assert.Equal(t, first.Entries(), second.Entries(), "resampling should return the same result for the same placements")
+}
+
+func TestConsensusFromStatistics_Uncertainty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	seasonID := id.NewSeasonID()
+	deckID := id.NewDeckID()
+	// 信頼度1のティアリスト2件でSS、信頼度0.5のティアリストでS
+	placements := []entity.Placement{
+		entity.NewPlacement(id.NewTierListID(), deckID, rank.TierSS),
+		entity.NewPlacement(id.NewTierListID(), deckID, rank.TierSS),
+		{TierListID: id.NewTierListID(), DeckID: deckID, TierRank: rank.TierS, Weight: 0.5},
+	}
+	statistics := []entity.TierStatistic{
+		{
+			DeckID:                deckID,
+			SeasonID:              seasonID,
+			RankSum:               20,
+			PlacementCount:        3,
+			WeightedRankSum:       17,
+			WeightSum:             2.5,
+			WeightedRankSquareSum: 116,
+			WeightSquareSum:       2.25,
+		},
+	}
+
+	// Act
+	fromPlacements, err := entity.CalculateConsensus(seasonID, 3, placements, entity.MeanAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+	fromStatistics, err := entity.ConsensusFromStatistics(seasonID, 3, statistics, 1, time.Now())
+	require.NoError(t, err, "no error should be returned")
+
+	// Assert
+	require.Len(t, fromStatistics.Entries(), 1, "entry count should match")
+	got, want := fromStatistics.Entries()[0], fromPlacements.Entries()[0]
+	// 分散 = (Σw(x-m)² + 事前分散4) / (Σw + 1)、実効的な配置数 = (Σw)² / Σw²
+	mean := 17 / 2.5
+	variance := (116 - 2.5*mean*mean + 4) / 3.5
+	standardError := math.Sqrt(variance / (2.5 * 2.5 / 2.25))
+	assert.InDelta(t, standardError, got.StandardError, 1e-9, "standard error should match")
+	assert.InDelta(t, mean-1.959963984540054*standardError, got.ScoreLower, 1e-9, "lower bound should match")
+	assert.InDelta(t, 7.0, got.ScoreUpper, 1e-9, "upper bound should be clamped to SS")
+	assert.InDelta(t, want.StandardError, got.StandardError, 1e-9, "statistics and placements should give the same standard error")
+	assert.InDelta(t, want.TierProbability, got.TierProbability, 1e-9, "statistics and placements should give the same tier probability")
+}
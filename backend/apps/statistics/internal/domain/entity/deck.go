@@ -0,0 +1,49 @@
+package entity
+
+import "poketier/pkg/vo/id"
+
+// Deck は集計ティアリストに表示するデッキの参照情報
+type Deck struct {
+	id           id.DeckID
+	seasonID     id.SeasonID
+	nickname     string
+	imageURL     string
+	archetypeKey string
+}
+
+// ReconstructDeck は永続化されたデータからDeckを復元する
+func ReconstructDeck(id id.DeckID, seasonID id.SeasonID, nickname, imageURL, archetypeKey string) *Deck {
+	return &Deck{
+		id:           id,
+		seasonID:     seasonID,
+		nickname:     nickname,
+		imageURL:     imageURL,
+		archetypeKey: archetypeKey,
+	}
+}
+
+// ID はDeckのIDを返す
+func (d *Deck) ID() id.DeckID {
+	return d.id
+}
+
+// SeasonID はデッキが登録されたシーズンのIDを返す（デッキはシーズンごとに登録される）
+func (d *Deck) SeasonID() id.SeasonID {
+	return d.seasonID
+}
+
+// Nickname はデッキのニックネームを返す
+func (d *Deck) Nickname() string {
+	return d.nickname
+}
+
+// ImageURL はデッキのサムネイル画像のURLを返す（未設定の場合は空文字）
+func (d *Deck) ImageURL() string {
+	return d.imageURL
+}
+
+// ArchetypeKey はシーズンをまたいで同じデッキを対応付けるためのキーを返す
+// カード構成を正規化したもので、シーズンが異なってもカード構成が同じであれば一致する
+func (d *Deck) ArchetypeKey() string {
+	return d.archetypeKey
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// DeckTierSummary はシーズン内での1デッキの配置の分布と位置
+type DeckTierSummary struct {
+	DeckID id.DeckID
+	// Distribution はティアごとの配置数（信頼度に関わらず配置した件数）
+	Distribution map[rank.TierRank]int
+	// PlacementCount はデッキの配置数
+	PlacementCount int
+	// TierListCount はデッキを配置したティアリスト数
+	TierListCount int
+	// TotalTierLists はシーズン内のティアリスト数
+	TotalTierLists int
+	// MeanTierRank / MedianTierRank は信頼度で重み付けした平均・中央値ランク（信頼度のある配置がない場合は0）
+	MeanTierRank   float64
+	MedianTierRank float64
+	// Percentile はシーズン内で配置のあるデッキのうち、平均ランクがこのデッキ以下のデッキの割合（0 〜 100、同値は半数として数える）
+	Percentile float64
+	// PlacementShare はシーズン内のティアリストのうち、デッキを配置したティアリストの割合（0 〜 1）
+	PlacementShare float64
+}
+
+// SummarizeDeckPlacements はシーズン内の配置から指定したデッキの分布と位置を算出する
+// 平均・中央値・パーセンタイルは集計ティアリストと同様に信頼度で重み付けし、重みが0以下の配置は含めない
+func SummarizeDeckPlacements(deckID id.DeckID, placements []Placement, totalTierLists int) DeckTierSummary {
+	summary := DeckTierSummary{
+		DeckID:         deckID,
+		Distribution:   make(map[rank.TierRank]int, len(rank.AllTierRanks())),
+		TotalTierLists: totalTierLists,
+	}
+	for _, tierRank := range rank.AllTierRanks() {
+		summary.Distribution[tierRank] = 0
+	}
+
+	tierLists := make(map[id.TierListID]struct{})
+	weighted := make([]Placement, 0, len(placements))
+	for _, p := range placements {
+		if p.DeckID == deckID {
+			summary.Distribution[p.TierRank]++
+			summary.PlacementCount++
+			tierLists[p.TierListID] = struct{}{}
+		}
+		if p.Weight > 0 {
+			weighted = append(weighted, p)
+		}
+	}
+	summary.TierListCount = len(tierLists)
+	if totalTierLists > 0 {
+		summary.PlacementShare = float64(summary.TierListCount) / float64(totalTierLists)
+	}
+
+	means := MeanAlgorithm{}.Score(weighted)
+	mean, ok := means[deckID]
+	if !ok {
+		return summary
+	}
+	summary.MeanTierRank = mean
+	summary.MedianTierRank = MedianAlgorithm{}.Score(weighted)[deckID]
+
+	var below, equal float64
+	for _, other := range means {
+		switch {
+		case other < mean:
+			below++
+		case other == mean:
+			equal++
+		}
+	}
+	summary.Percentile = (below + equal/2) / float64(len(means)) * 100
+
+	return summary
+}
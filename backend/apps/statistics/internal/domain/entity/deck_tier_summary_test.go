@@ -0,0 +1,101 @@
+package entity_test
+
+import (
+	"testing"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeDeckPlacements(t *testing.T) {
+	t.Parallel()
+
+	listA, listB, listC, listD := id.NewTierListID(), id.NewTierListID(), id.NewTierListID(), id.NewTierListID()
+	deckA, deckB, deckC, deckD := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	placements := []entity.Placement{
+		entity.NewPlacement(listA, deckA, rank.TierSS),
+		entity.NewPlacement(listB, deckA, rank.TierS),
+		entity.NewPlacement(listC, deckA, rank.TierS),
+		// 信頼度0のティアリストの配置は分布にのみ含まれる
+		{TierListID: listD, DeckID: deckA, TierRank: rank.TierE, Weight: 0},
+		entity.NewPlacement(listA, deckB, rank.TierB),
+		entity.NewPlacement(listB, deckB, rank.TierC),
+		entity.NewPlacement(listA, deckC, rank.TierS),
+	}
+
+	tests := []struct {
+		caseName string
+		deckID   id.DeckID
+		want     entity.DeckTierSummary
+	}{
+		{
+			caseName: "正常系: 分布は全配置、平均・中央値・パーセンタイルは信頼度のある配置から算出される",
+			deckID:   deckA,
+			want: entity.DeckTierSummary{
+				DeckID: deckA,
+				Distribution: map[rank.TierRank]int{
+					rank.TierSS: 1, rank.TierS: 2, rank.TierA: 0, rank.TierB: 0, rank.TierC: 0, rank.TierD: 0, rank.TierE: 1,
+				},
+				PlacementCount: 4,
+				TierListCount:  4,
+				TotalTierLists: 5,
+				MeanTierRank:   19.0 / 3,
+				MedianTierRank: 6,
+				// 平均ランクが以下のデッキ: B(3.5)、C(6)、A自身（半数）
+				Percentile:     (2 + 0.5) / 3 * 100,
+				PlacementShare: 4.0 / 5,
+			},
+		},
+		{
+			caseName: "正常系: 平均ランクが最も低いデッキは下位のパーセンタイルになる",
+			deckID:   deckB,
+			want: entity.DeckTierSummary{
+				DeckID: deckB,
+				Distribution: map[rank.TierRank]int{
+					rank.TierSS: 0, rank.TierS: 0, rank.TierA: 0, rank.TierB: 1, rank.TierC: 1, rank.TierD: 0, rank.TierE: 0,
+				},
+				PlacementCount: 2,
+				TierListCount:  2,
+				TotalTierLists: 5,
+				MeanTierRank:   3.5,
+				MedianTierRank: 3.5,
+				Percentile:     0.5 / 3 * 100,
+				PlacementShare: 2.0 / 5,
+			},
+		},
+		{
+			caseName: "正常系: 配置のないデッキは全ての値が0になる",
+			deckID:   deckD,
+			want: entity.DeckTierSummary{
+				DeckID: deckD,
+				Distribution: map[rank.TierRank]int{
+					rank.TierSS: 0, rank.TierS: 0, rank.TierA: 0, rank.TierB: 0, rank.TierC: 0, rank.TierD: 0, rank.TierE: 0,
+				},
+				TotalTierLists: 5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.SummarizeDeckPlacements(tt.deckID, placements, 5)
+
+			// Assert
+			assert.Equal(t, tt.want.DeckID, got.DeckID, "deck ID should match")
+			assert.Equal(t, tt.want.Distribution, got.Distribution, "distribution should match")
+			assert.Equal(t, tt.want.PlacementCount, got.PlacementCount, "placement count should match")
+			assert.Equal(t, tt.want.TierListCount, got.TierListCount, "tier list count should match")
+			assert.Equal(t, tt.want.TotalTierLists, got.TotalTierLists, "total tier lists should match")
+			assert.InDelta(t, tt.want.MeanTierRank, got.MeanTierRank, 1e-9, "mean tier rank should match")
+			assert.InDelta(t, tt.want.MedianTierRank, got.MedianTierRank, 1e-9, "median tier rank should match")
+			assert.InDelta(t, tt.want.Percentile, got.Percentile, 1e-9, "percentile should match")
+			assert.InDelta(t, tt.want.PlacementShare, got.PlacementShare, 1e-9, "placement share should match")
+		})
+	}
+}
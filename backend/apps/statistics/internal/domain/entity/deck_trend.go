@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// DefaultMoversDays は急上昇・急下降の比較期間の既定の日数
+const DefaultMoversDays = 7
+
+// DeckTrendPoint はデッキの集計ティアリストの日次スナップショット
+// TierRank は記録時点の重み付き平均ティアランク（E=1 〜 SS=7）
+type DeckTrendPoint struct {
+	DeckID         id.DeckID
+	SnapshotDate   time.Time
+	TierRank       float64
+	PlacementCount int
+}
+
+// Tier は記録時点で振り分けられるティアを返す
+func (p DeckTrendPoint) Tier() rank.TierRank {
+	return NearestTierRank(p.TierRank)
+}
+
+// DeckMover は比較期間での平均ティアランクの変化
+// Change は To - From で、正の値は上昇を表す
+type DeckMover struct {
+	DeckID         id.DeckID
+	From           DeckTrendPoint
+	To             DeckTrendPoint
+	Change         float64
+	PlacementCount int
+}
+
+// SelectMoverDates は記録日（昇順）から比較に使う2つの日付を選ぶ
+// 比較先は最新の記録日、比較元は最新から days 日前以前で最も新しい記録日とし、
+// それより前の記録がない場合は最も古い記録日を使う。記録日が2日未満の場合は ok=false を返す
+func SelectMoverDates(dates []time.Time, days int) (from, to time.Time, ok bool) {
+	if len(dates) < 2 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	to = dates[len(dates)-1]
+	threshold := to.AddDate(0, 0, -days)
+	from = dates[0]
+	for _, d := range dates[:len(dates)-1] {
+		if d.After(threshold) {
+			break
+		}
+		from = d
+	}
+	return from, to, true
+}
+
+// CalculateMovers は2つの日付のスナップショットからデッキごとの変化を算出する
+// 両方の日付に記録があり、いずれも配置数が minPlacementCount 以上のデッキのみを対象とし、
+// 上昇したデッキは上昇幅の大きい順、下降したデッキは下降幅の大きい順で返す
+func CalculateMovers(from, to []DeckTrendPoint, minPlacementCount int) (risers, fallers []DeckMover) {
+	fromByDeck := make(map[id.DeckID]DeckTrendPoint, len(from))
+	for _, p := range from {
+		fromByDeck[p.DeckID] = p
+	}
+
+	risers, fallers = []DeckMover{}, []DeckMover{}
+	for _, p := range to {
+		base, ok := fromByDeck[p.DeckID]
+		if !ok || base.PlacementCount < minPlacementCount || p.PlacementCount < minPlacementCount {
+			continue
+		}
+
+		mover := DeckMover{
+			DeckID:         p.DeckID,
+			From:           base,
+			To:             p,
+			Change:         p.TierRank - base.TierRank,
+			PlacementCount: p.PlacementCount,
+		}
+		switch {
+		case mover.Change > 0:
+			risers = append(risers, mover)
+		case mover.Change < 0:
+			fallers = append(fallers, mover)
+		}
+	}
+
+	slices.SortFunc(risers, func(a, b DeckMover) int {
+		return compareMovers(b.Change, a.Change, a, b)
+	})
+	slices.SortFunc(fallers, func(a, b DeckMover) int {
+		return compareMovers(a.Change, b.Change, a, b)
+	})
+	return risers, fallers
+}
+
+// compareMovers は変化量で比較し、同値の場合は配置数の多い順に並べる
+func compareMovers(x, y float64, a, b DeckMover) int {
+	if c := cmp.Compare(x, y); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.PlacementCount, a.PlacementCount); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.DeckID.String(), b.DeckID.String())
+}
@@ -0,0 +1,103 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectMoverDates(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2025, 8, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		caseName string
+		dates    []time.Time
+		days     int
+		wantFrom time.Time
+		wantTo   time.Time
+		wantOK   bool
+	}{
+		{
+			caseName: "正常系: 最新からdays日前以前で最も新しい記録日が比較元となる",
+			dates:    []time.Time{day(1), day(2), day(3), day(5), day(10)},
+			days:     7,
+			wantFrom: day(3),
+			wantTo:   day(10),
+			wantOK:   true,
+		},
+		{
+			caseName: "正常系: days日前以前の記録がない場合、最も古い記録日が比較元となる",
+			dates:    []time.Time{day(8), day(9), day(10)},
+			days:     7,
+			wantFrom: day(8),
+			wantTo:   day(10),
+			wantOK:   true,
+		},
+		{
+			caseName: "異常系: 記録日が1日しかない場合、ok=falseを返す",
+			dates:    []time.Time{day(10)},
+			days:     7,
+			wantOK:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			from, to, ok := entity.SelectMoverDates(tt.dates, tt.days)
+
+			// Assert
+			assert.Equal(t, tt.wantOK, ok, "ok should match expected")
+			assert.Equal(t, tt.wantFrom, from, "from date should match expected")
+			assert.Equal(t, tt.wantTo, to, "to date should match expected")
+		})
+	}
+}
+
+func TestCalculateMovers(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deckA, deckB, deckC, deckD, deckE := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	fromDate := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	toDate := fromDate.AddDate(0, 0, 7)
+	point := func(deckID id.DeckID, date time.Time, tierRank float64, placementCount int) entity.DeckTrendPoint {
+		return entity.DeckTrendPoint{DeckID: deckID, SnapshotDate: date, TierRank: tierRank, PlacementCount: placementCount}
+	}
+
+	from := []entity.DeckTrendPoint{
+		point(deckA, fromDate, 4, 10),
+		point(deckB, fromDate, 5, 10),
+		point(deckC, fromDate, 6, 10),
+		point(deckD, fromDate, 3, 1),
+	}
+	to := []entity.DeckTrendPoint{
+		point(deckA, toDate, 6.5, 12), // +2.5
+		point(deckB, toDate, 5.5, 11), // +0.5
+		point(deckC, toDate, 4, 10),   // -2
+		point(deckD, toDate, 7, 10),   // 比較元の配置数が不足
+		point(deckE, toDate, 7, 10),   // 比較元に記録がない
+	}
+
+	// Act
+	risers, fallers := entity.CalculateMovers(from, to, 3)
+
+	// Assert
+	assert.Equal(t, []entity.DeckMover{
+		{DeckID: deckA, From: from[0], To: to[0], Change: 2.5, PlacementCount: 12},
+		{DeckID: deckB, From: from[1], To: to[1], Change: 0.5, PlacementCount: 11},
+	}, risers, "risers should be sorted by change descending")
+	assert.Equal(t, []entity.DeckMover{
+		{DeckID: deckC, From: from[2], To: to[2], Change: -2, PlacementCount: 10},
+	}, fallers, "fallers should be sorted by change ascending")
+}
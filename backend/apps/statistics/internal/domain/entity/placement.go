@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// DefaultPlacementWeight は配置の既定の重み
+const DefaultPlacementWeight = 1.0
+
+// Placement は集計対象となるティアリスト内の1件の配置
+// Weight は集計時の重みで、ティアリストの信頼度などで調整される
+type Placement struct {
+	TierListID id.TierListID
+	DeckID     id.DeckID
+	TierRank   rank.TierRank
+	Weight     float64
+}
+
+// NewPlacement は既定の重みを持つPlacementを作成する
+func NewPlacement(tierListID id.TierListID, deckID id.DeckID, tierRank rank.TierRank) Placement {
+	return Placement{
+		TierListID: tierListID,
+		DeckID:     deckID,
+		TierRank:   tierRank,
+		Weight:     DefaultPlacementWeight,
+	}
+}
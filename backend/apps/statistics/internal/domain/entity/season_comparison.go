@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"cmp"
+	"slices"
+
+	"poketier/pkg/vo/id"
+)
+
+// ArchetypeComparison は両シーズンの集計ティアリストに掲載されたアーキタイプの変化
+// ScoreChange は To.Score - From.Score、TierChange は To.TierRank - From.TierRank で、正の値は上昇を表す
+type ArchetypeComparison struct {
+	ArchetypeKey string
+	From         ConsensusEntry
+	To           ConsensusEntry
+	ScoreChange  float64
+	TierChange   int
+}
+
+// SeasonComparison は2つのシーズンの集計ティアリストをアーキタイプで対応付けた比較結果
+type SeasonComparison struct {
+	// Continuing は両シーズンに掲載されたアーキタイプ（スコアの上昇幅の大きい順）
+	Continuing []ArchetypeComparison
+	// NewEntries は比較先のシーズンにのみ掲載されたデッキ（比較先の集計ティアリストの順）
+	NewEntries []ConsensusEntry
+	// Dropped は比較元のシーズンにのみ掲載されたデッキ（比較元の集計ティアリストの順）
+	Dropped []ConsensusEntry
+}
+
+// CompareConsensus は比較元と比較先の集計ティアリストをデッキのアーキタイプキーで対応付けて比較する
+// 同じシーズン内に同じアーキタイプのデッキが複数ある場合は配置数の最も多いデッキで代表させ、それ以外は比較から除外する
+// 参照情報のないデッキはアーキタイプを特定できないため、他のシーズンのデッキとは対応付けない
+func CompareConsensus(from, to *ConsensusTierList, decks []*Deck) SeasonComparison {
+	archetypeKeys := make(map[id.DeckID]string, len(decks))
+	for _, d := range decks {
+		if d.ArchetypeKey() != "" {
+			archetypeKeys[d.ID()] = d.ArchetypeKey()
+		}
+	}
+	keyOf := func(deckID id.DeckID) string {
+		if key, ok := archetypeKeys[deckID]; ok {
+			return key
+		}
+		return "deck:" + deckID.String()
+	}
+
+	fromEntries, fromKeys := representativeEntries(from.Entries(), keyOf)
+	toEntries, toKeys := representativeEntries(to.Entries(), keyOf)
+
+	comparison := SeasonComparison{
+		Continuing: []ArchetypeComparison{},
+		NewEntries: []ConsensusEntry{},
+		Dropped:    []ConsensusEntry{},
+	}
+	for _, key := range toKeys {
+		toEntry := toEntries[key]
+		fromEntry, ok := fromEntries[key]
+		if !ok {
+			comparison.NewEntries = append(comparison.NewEntries, toEntry)
+			continue
+		}
+		comparison.Continuing = append(comparison.Continuing, ArchetypeComparison{
+			ArchetypeKey: key,
+			From:         fromEntry,
+			To:           toEntry,
+			ScoreChange:  toEntry.Score - fromEntry.Score,
+			TierChange:   toEntry.TierRank.Int() - fromEntry.TierRank.Int(),
+		})
+	}
+	for _, key := range fromKeys {
+		if _, ok := toEntries[key]; !ok {
+			comparison.Dropped = append(comparison.Dropped, fromEntries[key])
+		}
+	}
+
+	// スコアの上昇幅の大きい順、同値の場合は比較先のスコアの高い順に並べる
+	slices.SortStableFunc(comparison.Continuing, func(a, b ArchetypeComparison) int {
+		if c := cmp.Compare(b.ScoreChange, a.ScoreChange); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.To.Score, a.To.Score)
+	})
+	return comparison
+}
+
+// representativeEntries はアーキタイプごとに配置数の最も多い集計結果を選び、
+// 集計ティアリストでの出現順のアーキタイプキーとともに返す
+func representativeEntries(entries []ConsensusEntry, keyOf func(id.DeckID) string) (map[string]ConsensusEntry, []string) {
+	byKey := make(map[string]ConsensusEntry, len(entries))
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		key := keyOf(e.DeckID)
+		current, ok := byKey[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if !ok || e.PlacementCount > current.PlacementCount {
+			byKey[key] = e
+		}
+	}
+	return byKey, keys
+}
@@ -0,0 +1,77 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareConsensus(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	fromSeasonID, toSeasonID := id.NewSeasonID(), id.NewSeasonID()
+	generatedAt := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	// 比較元のシーズン: リザードン(A)、ピカチュウ(B)、ミュウツー(C)
+	fromCharizard, fromPikachu, fromMewtwo := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	// 比較先のシーズン: リザードン(A)、ピカチュウ(B、2デッキ)、新デッキ(D)
+	toCharizard, toPikachu, toPikachuMinor, toNewDeck := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	decks := []*entity.Deck{
+		entity.ReconstructDeck(fromCharizard, fromSeasonID, "リザードンex", "", "charizard"),
+		entity.ReconstructDeck(fromPikachu, fromSeasonID, "ピカチュウex", "", "pikachu"),
+		entity.ReconstructDeck(fromMewtwo, fromSeasonID, "ミュウツーex", "", "mewtwo"),
+		entity.ReconstructDeck(toCharizard, toSeasonID, "リザードンex", "", "charizard"),
+		entity.ReconstructDeck(toPikachu, toSeasonID, "ピカチュウex", "", "pikachu"),
+		entity.ReconstructDeck(toPikachuMinor, toSeasonID, "ピカチュウex（別構築）", "", "pikachu"),
+		entity.ReconstructDeck(toNewDeck, toSeasonID, "新デッキ", "", "new"),
+	}
+
+	placements := func(deckID id.DeckID, tierRank rank.TierRank, count int) []entity.Placement {
+		ps := make([]entity.Placement, 0, count)
+		for range count {
+			ps = append(ps, entity.NewPlacement(id.NewTierListID(), deckID, tierRank))
+		}
+		return ps
+	}
+	var fromPlacements, toPlacements []entity.Placement
+	fromPlacements = append(fromPlacements, placements(fromCharizard, rank.TierA, 3)...)
+	fromPlacements = append(fromPlacements, placements(fromPikachu, rank.TierS, 3)...)
+	fromPlacements = append(fromPlacements, placements(fromMewtwo, rank.TierSS, 3)...)
+	toPlacements = append(toPlacements, placements(toCharizard, rank.TierSS, 3)...)
+	toPlacements = append(toPlacements, placements(toPikachu, rank.TierB, 5)...)
+	toPlacements = append(toPlacements, placements(toPikachuMinor, rank.TierSS, 3)...)
+	toPlacements = append(toPlacements, placements(toNewDeck, rank.TierS, 3)...)
+
+	from, err := entity.CalculateConsensus(fromSeasonID, 3, fromPlacements, entity.MeanAlgorithm{}, 3, generatedAt)
+	require.NoError(t, err, "no error should be returned")
+	to, err := entity.CalculateConsensus(toSeasonID, 5, toPlacements, entity.MeanAlgorithm{}, 3, generatedAt)
+	require.NoError(t, err, "no error should be returned")
+
+	// Act
+	got := entity.CompareConsensus(from, to, decks)
+
+	// Assert
+	require.Len(t, got.Continuing, 2, "continuing archetype count should match")
+	assert.Equal(t, "charizard", got.Continuing[0].ArchetypeKey, "rising archetype should come first")
+	assert.Equal(t, fromCharizard, got.Continuing[0].From.DeckID, "from deck should match")
+	assert.Equal(t, toCharizard, got.Continuing[0].To.DeckID, "to deck should match")
+	assert.InDelta(t, 2.0, got.Continuing[0].ScoreChange, 1e-9, "score change should match")
+	assert.Equal(t, 2, got.Continuing[0].TierChange, "tier change should match")
+
+	assert.Equal(t, "pikachu", got.Continuing[1].ArchetypeKey, "falling archetype should come last")
+	assert.Equal(t, toPikachu, got.Continuing[1].To.DeckID, "deck with most placements should represent archetype")
+	assert.InDelta(t, -2.0, got.Continuing[1].ScoreChange, 1e-9, "score change should match")
+	assert.Equal(t, -2, got.Continuing[1].TierChange, "tier change should match")
+
+	require.Len(t, got.NewEntries, 1, "new entry count should match")
+	assert.Equal(t, toNewDeck, got.NewEntries[0].DeckID, "new entry should match")
+	require.Len(t, got.Dropped, 1, "dropped count should match")
+	assert.Equal(t, fromMewtwo, got.Dropped[0].DeckID, "dropped deck should match")
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "poketier/pkg/vo/id"
+
+// TierList は集計結果と比較するティアリストの参照情報
+type TierList struct {
+	id         id.TierListID
+	seasonID   id.SeasonID
+	placements []Placement
+}
+
+// ReconstructTierList は永続化されたデータからTierListを復元する
+func ReconstructTierList(id id.TierListID, seasonID id.SeasonID, placements []Placement) *TierList {
+	return &TierList{
+		id:         id,
+		seasonID:   seasonID,
+		placements: placements,
+	}
+}
+
+// ID はTierListのIDを返す
+func (t *TierList) ID() id.TierListID {
+	return t.id
+}
+
+// SeasonID はティアリストが作成されたシーズンのIDを返す
+func (t *TierList) SeasonID() id.SeasonID {
+	return t.seasonID
+}
+
+// Placements はティアリスト内の配置を返す
+func (t *TierList) Placements() []Placement {
+	return t.placements
+}
@@ -0,0 +1,174 @@
+package entity
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// DeckDisagreement はティアリストと集計結果でティアが食い違うデッキ
+// ScoreDifference は TierRank - Consensus.Score で、正の値はティアリストが集計結果より高く評価していることを表す
+type DeckDisagreement struct {
+	DeckID          id.DeckID
+	TierRank        rank.TierRank
+	Consensus       ConsensusEntry
+	ScoreDifference float64
+}
+
+// TierListAgreement はティアリストと集計ティアリストの一致度
+// 比較対象は両方に掲載されたデッキで、集計結果に掲載されていないデッキ（配置数が不足しているものなど）は含めない
+type TierListAgreement struct {
+	ComparedDeckCount int
+	// KendallTauB / Spearman は順位相関係数（-1 〜 1）
+	// 比較できるデッキが2件未満、またはどちらかの順位が全て同じ場合は算出できないため nil
+	KendallTauB *float64
+	Spearman    *float64
+	// MeanAbsoluteTierDistance はティアの差の絶対値の平均（比較できるデッキがない場合は0）
+	MeanAbsoluteTierDistance float64
+	// Disagreements はティアが食い違うデッキ（スコアの差の絶対値が大きい順）
+	Disagreements []DeckDisagreement
+}
+
+// MeasureAgreement はティアリストの配置と集計ティアリストの一致度を算出する
+// 順位相関はティアリストのティアと集計スコアの間で求め、ティアの差は集計結果のティアとの間で求める
+func MeasureAgreement(tierList *TierList, consensus *ConsensusTierList) TierListAgreement {
+	entries := make(map[id.DeckID]ConsensusEntry, len(consensus.entries))
+	for _, e := range consensus.entries {
+		entries[e.DeckID] = e
+	}
+
+	// 同じデッキが複数回配置されている場合は最初の配置を使用する
+	seen := make(map[id.DeckID]bool, len(tierList.placements))
+	listRanks := make([]float64, 0, len(tierList.placements))
+	scores := make([]float64, 0, len(tierList.placements))
+	totalDistance := 0
+	agreement := TierListAgreement{Disagreements: []DeckDisagreement{}}
+	for _, p := range tierList.placements {
+		entry, ok := entries[p.DeckID]
+		if !ok || seen[p.DeckID] {
+			continue
+		}
+		seen[p.DeckID] = true
+
+		listRanks = append(listRanks, float64(p.TierRank.Int()))
+		scores = append(scores, entry.Score)
+		distance := p.TierRank.Int() - entry.TierRank.Int()
+		totalDistance += max(distance, -distance)
+		if distance != 0 {
+			agreement.Disagreements = append(agreement.Disagreements, DeckDisagreement{
+				DeckID:          p.DeckID,
+				TierRank:        p.TierRank,
+				Consensus:       entry,
+				ScoreDifference: float64(p.TierRank.Int()) - entry.Score,
+			})
+		}
+	}
+
+	agreement.ComparedDeckCount = len(listRanks)
+	if agreement.ComparedDeckCount > 0 {
+		agreement.MeanAbsoluteTierDistance = float64(totalDistance) / float64(agreement.ComparedDeckCount)
+	}
+	agreement.KendallTauB = kendallTauB(listRanks, scores)
+	agreement.Spearman = spearman(listRanks, scores)
+
+	slices.SortFunc(agreement.Disagreements, func(a, b DeckDisagreement) int {
+		if c := cmp.Compare(math.Abs(b.ScoreDifference), math.Abs(a.ScoreDifference)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.DeckID.String(), b.DeckID.String())
+	})
+	return agreement
+}
+
+// kendallTauB は同順位を補正したケンドールの順位相関係数を返す（算出できない場合は nil）
+func kendallTauB(xs, ys []float64) *float64 {
+	var concordant, discordant, tiedX, tiedY int
+	for i := range xs {
+		for j := i + 1; j < len(xs); j++ {
+			dx := cmp.Compare(xs[i], xs[j])
+			dy := cmp.Compare(ys[i], ys[j])
+			switch {
+			case dx == 0 && dy == 0:
+				tiedX++
+				tiedY++
+			case dx == 0:
+				tiedX++
+			case dy == 0:
+				tiedY++
+			case dx == dy:
+				concordant++
+			default:
+				discordant++
+			}
+		}
+	}
+
+	pairs := len(xs) * (len(xs) - 1) / 2
+	denominator := math.Sqrt(float64(pairs-tiedX) * float64(pairs-tiedY))
+	if denominator == 0 {
+		return nil
+	}
+	tau := float64(concordant-discordant) / denominator
+	return &tau
+}
+
+// spearman は同順位に平均順位を割り当てたスピアマンの順位相関係数を返す（算出できない場合は nil）
+func spearman(xs, ys []float64) *float64 {
+	return pearson(averageRanks(xs), averageRanks(ys))
+}
+
+// averageRanks は値の小さい順に1から順位を付け、同じ値には平均順位を割り当てる
+func averageRanks(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(values[a], values[b])
+	})
+
+	ranks := make([]float64, len(values))
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+		// 順位 start+1 〜 end の平均
+		average := float64(start+1+end) / 2
+		for _, i := range order[start:end] {
+			ranks[i] = average
+		}
+		start = end
+	}
+	return ranks
+}
+
+// pearson はピアソンの積率相関係数を返す（どちらかの分散が0の場合は nil）
+func pearson(xs, ys []float64) *float64 {
+	if len(xs) < 2 {
+		return nil
+	}
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var covariance, varianceX, varianceY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+	if varianceX == 0 || varianceY == 0 {
+		return nil
+	}
+	r := covariance / math.Sqrt(varianceX*varianceY)
+	return &r
+}
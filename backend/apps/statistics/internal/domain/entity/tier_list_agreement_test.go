@@ -0,0 +1,144 @@
+package entity_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasureAgreement(t *testing.T) {
+	t.Parallel()
+
+	seasonID := id.NewSeasonID()
+	reference := id.NewTierListID()
+	deckA, deckB, deckC, deckD, deckX := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	// 集計結果のスコア: A=7(SS)、B=5(A)、C=4(B)、D=2(D)
+	consensus, err := entity.CalculateConsensus(seasonID, 1, []entity.Placement{
+		entity.NewPlacement(reference, deckA, rank.TierSS),
+		entity.NewPlacement(reference, deckB, rank.TierA),
+		entity.NewPlacement(reference, deckC, rank.TierB),
+		entity.NewPlacement(reference, deckD, rank.TierD),
+	}, entity.MeanAlgorithm{}, 1, time.Now())
+	require.NoError(t, err, "failed to calculate consensus")
+
+	newTierList := func(tierRanks map[id.DeckID]rank.TierRank) *entity.TierList {
+		tierListID := id.NewTierListID()
+		placements := make([]entity.Placement, 0, len(tierRanks))
+		for _, deckID := range []id.DeckID{deckA, deckB, deckC, deckD, deckX} {
+			if tierRank, ok := tierRanks[deckID]; ok {
+				placements = append(placements, entity.NewPlacement(tierListID, deckID, tierRank))
+			}
+		}
+		return entity.ReconstructTierList(tierListID, seasonID, placements)
+	}
+
+	type disagreement struct {
+		deckID          id.DeckID
+		tierRank        rank.TierRank
+		consensusTier   rank.TierRank
+		scoreDifference float64
+	}
+	tests := []struct {
+		caseName                 string
+		tierList                 *entity.TierList
+		wantComparedDeckCount    int
+		wantKendallTauB          *float64
+		wantSpearman             *float64
+		wantMeanAbsoluteDistance float64
+		wantDisagreements        []disagreement
+	}{
+		{
+			caseName: "正常系: 集計結果に掲載されたデッキのみで一致度が算出され、食い違いの大きい順に並ぶ事",
+			tierList: newTierList(map[id.DeckID]rank.TierRank{
+				deckA: rank.TierS, deckB: rank.TierSS, deckC: rank.TierB, deckD: rank.TierD, deckX: rank.TierSS,
+			}),
+			wantComparedDeckCount: 4,
+			// A と B の組のみ順序が逆転する
+			wantKendallTauB:          ptr(4.0 / 6),
+			wantSpearman:             ptr(1 - 6*2.0/(4*15)),
+			wantMeanAbsoluteDistance: 3.0 / 4,
+			wantDisagreements: []disagreement{
+				{deckID: deckB, tierRank: rank.TierSS, consensusTier: rank.TierA, scoreDifference: 2},
+				{deckID: deckA, tierRank: rank.TierS, consensusTier: rank.TierSS, scoreDifference: -1},
+			},
+		},
+		{
+			caseName: "正常系: 同じティアのデッキがある場合は同順位を補正する事",
+			tierList: newTierList(map[id.DeckID]rank.TierRank{
+				deckA: rank.TierA, deckB: rank.TierA, deckC: rank.TierB, deckD: rank.TierD,
+			}),
+			wantComparedDeckCount:    4,
+			wantKendallTauB:          ptr(5 / math.Sqrt(30)),
+			wantSpearman:             ptr(4.5 / math.Sqrt(22.5)),
+			wantMeanAbsoluteDistance: 2.0 / 4,
+			wantDisagreements: []disagreement{
+				{deckID: deckA, tierRank: rank.TierA, consensusTier: rank.TierSS, scoreDifference: -2},
+			},
+		},
+		{
+			caseName: "正常系: 全てのデッキが同じティアの場合は順位相関を算出しない事",
+			tierList: newTierList(map[id.DeckID]rank.TierRank{
+				deckA: rank.TierB, deckC: rank.TierB,
+			}),
+			wantComparedDeckCount:    2,
+			wantMeanAbsoluteDistance: 3.0 / 2,
+			wantDisagreements: []disagreement{
+				{deckID: deckA, tierRank: rank.TierB, consensusTier: rank.TierSS, scoreDifference: -3},
+			},
+		},
+		{
+			caseName:              "正常系: 集計結果に掲載されたデッキがない場合は比較しない事",
+			tierList:              newTierList(map[id.DeckID]rank.TierRank{deckX: rank.TierSS}),
+			wantComparedDeckCount: 0,
+			wantDisagreements:     []disagreement{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.MeasureAgreement(tt.tierList, consensus)
+
+			// Assert
+			assert.Equal(t, tt.wantComparedDeckCount, got.ComparedDeckCount, "compared deck count mismatch")
+			assertOptionalInDelta(t, tt.wantKendallTauB, got.KendallTauB, "kendall tau-b mismatch")
+			assertOptionalInDelta(t, tt.wantSpearman, got.Spearman, "spearman mismatch")
+			assert.InDelta(t, tt.wantMeanAbsoluteDistance, got.MeanAbsoluteTierDistance, 1e-9, "mean absolute tier distance mismatch")
+
+			gotDisagreements := make([]disagreement, 0, len(got.Disagreements))
+			for _, d := range got.Disagreements {
+				gotDisagreements = append(gotDisagreements, disagreement{
+					deckID:          d.DeckID,
+					tierRank:        d.TierRank,
+					consensusTier:   d.Consensus.TierRank,
+					scoreDifference: d.ScoreDifference,
+				})
+			}
+			assert.Equal(t, tt.wantDisagreements, gotDisagreements, "disagreements mismatch")
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+func assertOptionalInDelta(t *testing.T, want, got *float64, msg string) {
+	t.Helper()
+	if want == nil {
+		assert.Nil(t, got, msg)
+		return
+	}
+	if assert.NotNil(t, got, msg) {
+		assert.InDelta(t, *want, *got, 1e-9, msg)
+	}
+}
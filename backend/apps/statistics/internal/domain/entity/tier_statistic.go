@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"poketier/pkg/vo/id"
+)
+
+// tierStatisticTolerance は重み付きの累計を比較する際の許容誤差（差分更新による浮動小数点の誤差を許容する）
+const tierStatisticTolerance = 1e-6
+
+// TierStatistic はデッキ×シーズンごとの配置ランクの累計
+// ティアリストの作成・配置の更新時に差分で更新され、全件の再計算と一致することが期待される
+// WeightedRankSum と WeightSum はティアリストの信頼度で重み付けした累計
+// WeightedRankSquareSum と WeightSquareSum は信頼区間の算出に使用する二乗の累計
+type TierStatistic struct {
+	DeckID                id.DeckID
+	SeasonID              id.SeasonID
+	RankSum               int64
+	PlacementCount        int
+	WeightedRankSum       float64
+	WeightSum             float64
+	WeightedRankSquareSum float64
+	WeightSquareSum       float64
+}
+
+// WeightedAverageTierRank は信頼度で重み付けした平均ティアランクを返す（重みの合計が0以下の場合は0）
+func (s TierStatistic) WeightedAverageTierRank() float64 {
+	if s.PlacementCount <= 0 || s.WeightSum <= 0 {
+		return 0
+	}
+	return s.WeightedRankSum / s.WeightSum
+}
+
+// WeightedAverageTierRankStandardError は重み付き平均ティアランクの標準誤差を返す（重みの合計が0以下の場合は無限大）
+func (s TierStatistic) WeightedAverageTierRankStandardError() float64 {
+	if s.PlacementCount <= 0 {
+		return math.Inf(1)
+	}
+	return meanStandardError(placementWeights{sum: s.WeightSum, squareSum: s.WeightSquareSum}, s.WeightedRankSum, s.WeightedRankSquareSum)
+}
+
+// matches は累計が一致するかどうかを返す（重み付きの累計は許容誤差の範囲で比較する）
+func (s TierStatistic) matches(other TierStatistic) bool {
+	return s.RankSum == other.RankSum &&
+		s.PlacementCount == other.PlacementCount &&
+		math.Abs(s.WeightedRankSum-other.WeightedRankSum) <= tierStatisticTolerance &&
+		math.Abs(s.WeightSum-other.WeightSum) <= tierStatisticTolerance &&
+		math.Abs(s.WeightedRankSquareSum-other.WeightedRankSquareSum) <= tierStatisticTolerance &&
+		math.Abs(s.WeightSquareSum-other.WeightSquareSum) <= tierStatisticTolerance
+}
+
+// TierStatisticMismatch は差分更新された統計と再計算した統計の不一致
+// どちらかに存在しない場合は累計0として扱う
+type TierStatisticMismatch struct {
+	DeckID      id.DeckID
+	SeasonID    id.SeasonID
+	Incremental TierStatistic
+	Recomputed  TierStatistic
+}
+
+// CompareTierStatistics は差分更新された統計と再計算した統計を比較し、不一致をデッキ×シーズン順で返す
+// 配置がなくなり累計0のまま残っている統計は、再計算結果に存在しなくても一致とみなす
+func CompareTierStatistics(incremental, recomputed []TierStatistic) []TierStatisticMismatch {
+	type key struct {
+		deckID   id.DeckID
+		seasonID id.SeasonID
+	}
+	pairs := make(map[key]*TierStatisticMismatch)
+	pair := func(s TierStatistic) *TierStatisticMismatch {
+		k := key{deckID: s.DeckID, seasonID: s.SeasonID}
+		p, ok := pairs[k]
+		if !ok {
+			p = &TierStatisticMismatch{
+				DeckID:      s.DeckID,
+				SeasonID:    s.SeasonID,
+				Incremental: TierStatistic{DeckID: s.DeckID, SeasonID: s.SeasonID},
+				Recomputed:  TierStatistic{DeckID: s.DeckID, SeasonID: s.SeasonID},
+			}
+			pairs[k] = p
+		}
+		return p
+	}
+	for _, s := range incremental {
+		pair(s).Incremental = s
+	}
+	for _, s := range recomputed {
+		pair(s).Recomputed = s
+	}
+
+	mismatches := make([]TierStatisticMismatch, 0)
+	for _, p := range pairs {
+		if !p.Incremental.matches(p.Recomputed) {
+			mismatches = append(mismatches, *p)
+		}
+	}
+	slices.SortFunc(mismatches, func(a, b TierStatisticMismatch) int {
+		if c := cmp.Compare(a.SeasonID.String(), b.SeasonID.String()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.DeckID.String(), b.DeckID.String())
+	})
+	return mismatches
+}
@@ -0,0 +1,128 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTierStatistics(t *testing.T) {
+	t.Parallel()
+
+	seasonID := id.NewSeasonID()
+	deckA, deckB, deckC, deckD := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	tests := []struct {
+		caseName    string
+		incremental []entity.TierStatistic
+		recomputed  []entity.TierStatistic
+		want        []entity.TierStatisticMismatch
+	}{
+		{
+			caseName: "正常系: 全て一致し、累計0の統計は再計算結果になくても一致とみなす",
+			incremental: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2},
+				{DeckID: deckB, SeasonID: seasonID, RankSum: 0, PlacementCount: 0},
+			},
+			recomputed: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2},
+			},
+			want: []entity.TierStatisticMismatch{},
+		},
+		{
+			caseName: "正常系: 重み付きの累計は許容誤差の範囲であれば一致とみなし、超える場合は不一致となる",
+			incremental: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2, WeightedRankSum: 9.000000000001, WeightSum: 1.5},
+				{DeckID: deckB, SeasonID: seasonID, RankSum: 6, PlacementCount: 1, WeightedRankSum: 6, WeightSum: 1},
+			},
+			recomputed: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2, WeightedRankSum: 9, WeightSum: 1.5},
+				{DeckID: deckB, SeasonID: seasonID, RankSum: 6, PlacementCount: 1, WeightedRankSum: 3, WeightSum: 0.5},
+			},
+			want: []entity.TierStatisticMismatch{
+				{
+					DeckID:      deckB,
+					SeasonID:    seasonID,
+					Incremental: entity.TierStatistic{DeckID: deckB, SeasonID: seasonID, RankSum: 6, PlacementCount: 1, WeightedRankSum: 6, WeightSum: 1},
+					Recomputed:  entity.TierStatistic{DeckID: deckB, SeasonID: seasonID, RankSum: 6, PlacementCount: 1, WeightedRankSum: 3, WeightSum: 0.5},
+				},
+			},
+		},
+		{
+			caseName: "正常系: 累計の不一致と片方にしかない統計が不一致として返される",
+			incremental: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 13, PlacementCount: 2},
+				{DeckID: deckC, SeasonID: seasonID, RankSum: 5, PlacementCount: 1},
+			},
+			recomputed: []entity.TierStatistic{
+				{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2},
+				{DeckID: deckD, SeasonID: seasonID, RankSum: 7, PlacementCount: 1},
+			},
+			want: []entity.TierStatisticMismatch{
+				{
+					DeckID:      deckA,
+					SeasonID:    seasonID,
+					Incremental: entity.TierStatistic{DeckID: deckA, SeasonID: seasonID, RankSum: 13, PlacementCount: 2},
+					Recomputed:  entity.TierStatistic{DeckID: deckA, SeasonID: seasonID, RankSum: 12, PlacementCount: 2},
+				},
+				{
+					DeckID:      deckC,
+					SeasonID:    seasonID,
+					Incremental: entity.TierStatistic{DeckID: deckC, SeasonID: seasonID, RankSum: 5, PlacementCount: 1},
+					Recomputed:  entity.TierStatistic{DeckID: deckC, SeasonID: seasonID},
+				},
+				{
+					DeckID:      deckD,
+					SeasonID:    seasonID,
+					Incremental: entity.TierStatistic{DeckID: deckD, SeasonID: seasonID},
+					Recomputed:  entity.TierStatistic{DeckID: deckD, SeasonID: seasonID, RankSum: 7, PlacementCount: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.CompareTierStatistics(tt.incremental, tt.recomputed)
+
+			// Assert
+			assert.ElementsMatch(t, tt.want, got, "mismatches should match")
+		})
+	}
+}
+
+func TestConsensusFromStatistics(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	seasonID := id.NewSeasonID()
+	deckA, deckB, deckC, deckD := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	statistics := []entity.TierStatistic{
+		// 信頼度0.5のティアリストでランク1、信頼度1のティアリスト2件でランク7に配置
+		{DeckID: deckA, SeasonID: seasonID, RankSum: 15, PlacementCount: 3, WeightedRankSum: 14.5, WeightSum: 2.5},
+		{DeckID: deckB, SeasonID: seasonID, RankSum: 4, PlacementCount: 1, WeightedRankSum: 4, WeightSum: 1},
+		{DeckID: deckC, SeasonID: seasonID, RankSum: 0, PlacementCount: 0},
+		// 信頼度0のティアリストにのみ配置
+		{DeckID: deckD, SeasonID: seasonID, RankSum: 21, PlacementCount: 3, WeightedRankSum: 0, WeightSum: 0},
+	}
+
+	// Act
+	got, err := entity.ConsensusFromStatistics(seasonID, 3, statistics, 2, time.Now())
+
+	// Assert
+	require.NoError(t, err, "no error should be returned")
+	assert.Equal(t, entity.ConsensusMethodMean, got.Method(), "method should be mean")
+	require.Len(t, got.Entries(), 1, "decks below the threshold or without trusted placements should be excluded")
+	assert.Equal(t, deckA, got.Entries()[0].DeckID, "deck ID should match")
+	assert.InDelta(t, 14.5/2.5, got.Entries()[0].Score, 1e-9, "score should be the weighted average tier rank")
+	assert.Equal(t, rank.TierS, got.Entries()[0].TierRank, "tier rank should match")
+}
@@ -0,0 +1,288 @@
+package entity
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// FlagReason はティアリストにフラグが付いた理由
+type FlagReason string
+
+const (
+	// FlagReasonOutlier は集計ティアリストから大きく外れている
+	FlagReasonOutlier FlagReason = "outlier"
+	// FlagReasonNearDuplicate は同一投稿者による他のティアリストとほぼ同じ配置である
+	FlagReasonNearDuplicate FlagReason = "near_duplicate"
+)
+
+const (
+	// DefaultOutlierZScore はランク差がこの z スコア以上のティアリストを外れ値とする
+	DefaultOutlierZScore = 2.0
+	// DefaultMinOutlierDistance は外れ値とするために必要な最小のランク差（全体のばらつきが小さい場合の誤検知を防ぐ）
+	DefaultMinOutlierDistance = 1.0
+	// DefaultDuplicateSimilarity は同一投稿者のティアリスト同士をほぼ同じ配置とみなす一致率
+	DefaultDuplicateSimilarity = 0.9
+	// DefaultMinTierListsForZScore は z スコアを算出するために必要な最小のティアリスト数
+	DefaultMinTierListsForZScore = 5
+)
+
+// anonymousAuthorName は作成者名を省略した場合の作成者名（同一投稿者の判定には使用しない）
+const anonymousAuthorName = "匿名ユーザー"
+
+// TierListAuthor は重複投稿の判定に使用するティアリストの投稿者情報
+// AuthorUserID はログイン中に作成された場合の作成者のユーザーIDで、匿名で作成された場合は nil
+type TierListAuthor struct {
+	TierListID   id.TierListID
+	AuthorUserID *id.UserID
+	AuthorName   string
+	AuthorIP     string
+	CreatedAt    time.Time
+}
+
+// TrustScore はティアリストの信頼度の評価結果
+// TrustWeight は集計時の重み（0〜1）で、外れ値は z スコアに応じて下げ、重複投稿は0とする
+type TrustScore struct {
+	TierListID   id.TierListID
+	SeasonID     id.SeasonID
+	TrustWeight  float64
+	RankDistance float64
+	ZScore       float64
+	DuplicateOf  *id.TierListID
+	FlagReasons  []FlagReason
+}
+
+// Flagged はモデレーターの確認が必要かどうかを返す
+func (s TrustScore) Flagged() bool {
+	return len(s.FlagReasons) > 0
+}
+
+// FlaggedTierList はモデレーター向けのフラグ付きティアリスト
+type FlaggedTierList struct {
+	Score       TrustScore
+	Title       string
+	AuthorName  string
+	AuthorIP    string
+	EvaluatedAt time.Time
+}
+
+// TrustEvaluator はシーズン内のティアリストを集計ティアリストと比較して信頼度を評価する
+type TrustEvaluator struct {
+	OutlierZScore         float64
+	MinOutlierDistance    float64
+	DuplicateSimilarity   float64
+	MinTierListsForZScore int
+}
+
+// NewTrustEvaluator は既定の閾値を持つTrustEvaluatorを作成する
+func NewTrustEvaluator() TrustEvaluator {
+	return TrustEvaluator{
+		OutlierZScore:         DefaultOutlierZScore,
+		MinOutlierDistance:    DefaultMinOutlierDistance,
+		DuplicateSimilarity:   DefaultDuplicateSimilarity,
+		MinTierListsForZScore: DefaultMinTierListsForZScore,
+	}
+}
+
+// Evaluate はシーズン内の全ティアリストの信頼度をティアリストID順で返す
+// 配置の重みは使用せず、以下の手順で評価する
+//  1. 作成者のユーザー・作成者名・IPアドレスのいずれかが一致し、配置がほぼ同じティアリストを重複投稿とし、最初の投稿以外を集計から除く
+//  2. 重複投稿を除いた配置の中央値を基準の集計ティアリストとし、各ティアリストとのランク差と z スコアを算出する
+//  3. z スコアが閾値を超えるティアリストは外れ値とし、(閾値 / z スコア)^2 の重みに下げる
+func (e TrustEvaluator) Evaluate(seasonID id.SeasonID, authors []TierListAuthor, placements []Placement) []TrustScore {
+	byTierList := make(map[id.TierListID][]Placement)
+	for _, p := range placements {
+		byTierList[p.TierListID] = append(byTierList[p.TierListID], p)
+	}
+
+	duplicateOf := e.detectDuplicates(authors, byTierList)
+
+	// 多数の重複投稿で基準が歪まないよう、重複投稿を除いて基準の集計ティアリストを算出する
+	reference := make([]Placement, 0, len(placements))
+	for _, p := range placements {
+		if _, ok := duplicateOf[p.TierListID]; ok {
+			continue
+		}
+		p.Weight = DefaultPlacementWeight
+		reference = append(reference, p)
+	}
+	consensus := MedianAlgorithm{}.Score(reference)
+
+	distances := make(map[id.TierListID]float64, len(authors))
+	sample := make([]float64, 0, len(authors))
+	for _, a := range authors {
+		distance, ok := rankDistance(byTierList[a.TierListID], consensus)
+		distances[a.TierListID] = distance
+		if _, duplicated := duplicateOf[a.TierListID]; ok && !duplicated {
+			sample = append(sample, distance)
+		}
+	}
+	mean, stdDev := meanAndStdDev(sample)
+
+	scores := make([]TrustScore, 0, len(authors))
+	for _, a := range authors {
+		score := TrustScore{
+			TierListID:   a.TierListID,
+			SeasonID:     seasonID,
+			TrustWeight:  1,
+			RankDistance: distances[a.TierListID],
+			FlagReasons:  []FlagReason{},
+		}
+		if len(sample) >= e.MinTierListsForZScore && stdDev > 0 {
+			score.ZScore = (score.RankDistance - mean) / stdDev
+		}
+
+		if score.ZScore >= e.OutlierZScore && score.RankDistance >= e.MinOutlierDistance {
+			ratio := e.OutlierZScore / score.ZScore
+			score.TrustWeight = ratio * ratio
+			score.FlagReasons = append(score.FlagReasons, FlagReasonOutlier)
+		}
+		if original, ok := duplicateOf[a.TierListID]; ok {
+			score.DuplicateOf = &original
+			score.TrustWeight = 0
+			score.FlagReasons = append(score.FlagReasons, FlagReasonNearDuplicate)
+		}
+		scores = append(scores, score)
+	}
+
+	slices.SortFunc(scores, func(a, b TrustScore) int {
+		return cmp.Compare(a.TierListID.String(), b.TierListID.String())
+	})
+	return scores
+}
+
+// detectDuplicates は重複投稿のティアリストから元のティアリストへの対応を返す
+// 同一投稿者のティアリストのうち配置がほぼ同じものをまとめ、最も早く作成されたものを元のティアリストとする
+func (e TrustEvaluator) detectDuplicates(authors []TierListAuthor, byTierList map[id.TierListID][]Placement) map[id.TierListID]id.TierListID {
+	buckets := make(map[string][]id.TierListID)
+	for _, a := range authors {
+		// 作成者名やIPアドレスを変えても、同じユーザーのティアリストは同一投稿者とする
+		if a.AuthorUserID != nil {
+			buckets["user:"+a.AuthorUserID.String()] = append(buckets["user:"+a.AuthorUserID.String()], a.TierListID)
+		}
+		if a.AuthorName != "" && a.AuthorName != anonymousAuthorName {
+			buckets["name:"+a.AuthorName] = append(buckets["name:"+a.AuthorName], a.TierListID)
+		}
+		if a.AuthorIP != "" {
+			buckets["ip:"+a.AuthorIP] = append(buckets["ip:"+a.AuthorIP], a.TierListID)
+		}
+	}
+
+	// 推移的につながる重複投稿を1つのグループにまとめる
+	parent := make(map[id.TierListID]id.TierListID)
+	var find func(tierListID id.TierListID) id.TierListID
+	find = func(tierListID id.TierListID) id.TierListID {
+		p, ok := parent[tierListID]
+		if !ok || p == tierListID {
+			return tierListID
+		}
+		root := find(p)
+		parent[tierListID] = root
+		return root
+	}
+	for _, bucket := range buckets {
+		for i := range bucket {
+			for j := i + 1; j < len(bucket); j++ {
+				if placementSimilarity(byTierList[bucket[i]], byTierList[bucket[j]]) < e.DuplicateSimilarity {
+					continue
+				}
+				a, b := find(bucket[i]), find(bucket[j])
+				parent[a] = b
+				parent[b] = b
+			}
+		}
+	}
+
+	groups := make(map[id.TierListID][]TierListAuthor)
+	for _, a := range authors {
+		if _, ok := parent[a.TierListID]; !ok {
+			continue
+		}
+		root := find(a.TierListID)
+		groups[root] = append(groups[root], a)
+	}
+
+	duplicateOf := make(map[id.TierListID]id.TierListID)
+	for _, members := range groups {
+		slices.SortFunc(members, func(a, b TierListAuthor) int {
+			if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.TierListID.String(), b.TierListID.String())
+		})
+		for _, m := range members[1:] {
+			duplicateOf[m.TierListID] = members[0].TierListID
+		}
+	}
+	return duplicateOf
+}
+
+// placementSimilarity は2つのティアリストで同じティアに配置されたデッキの割合を返す
+// 分母はどちらかに配置されたデッキの数で、どちらかの配置が空の場合は0
+func placementSimilarity(a, b []Placement) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	ranks := make(map[id.DeckID]rank.TierRank, len(a))
+	for _, p := range a {
+		ranks[p.DeckID] = p.TierRank
+	}
+
+	union, matched := len(a), 0
+	for _, p := range b {
+		r, ok := ranks[p.DeckID]
+		if !ok {
+			union++
+			continue
+		}
+		if r == p.TierRank {
+			matched++
+		}
+	}
+	return float64(matched) / float64(union)
+}
+
+// rankDistance はティアリストの配置と集計ティアリストのランク差の二乗平均平方根を返す
+// 1デッキだけ極端に外れた配置も検出できるよう、平均ではなく二乗平均を使用する
+// 集計ティアリストに含まれるデッキを配置していない場合は false を返す
+func rankDistance(placements []Placement, consensus map[id.DeckID]float64) (float64, bool) {
+	var sum float64
+	var count int
+	for _, p := range placements {
+		score, ok := consensus[p.DeckID]
+		if !ok {
+			continue
+		}
+		diff := float64(p.TierRank.Int()) - score
+		sum += diff * diff
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return math.Sqrt(sum / float64(count)), true
+}
+
+// meanAndStdDev は平均と母標準偏差を返す
+func meanAndStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
@@ -0,0 +1,118 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustEvaluator_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	seasonID := id.NewSeasonID()
+	decks := []id.DeckID{id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()}
+	baseTime := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	// ranks の順にデッキを配置したティアリストの配置を作成する
+	placementsOf := func(tierListID id.TierListID, ranks ...rank.TierRank) []entity.Placement {
+		placements := make([]entity.Placement, 0, len(ranks))
+		for i, r := range ranks {
+			placements = append(placements, entity.NewPlacement(tierListID, decks[i], r))
+		}
+		return placements
+	}
+	byTierList := func(scores []entity.TrustScore) map[id.TierListID]entity.TrustScore {
+		m := make(map[id.TierListID]entity.TrustScore, len(scores))
+		for _, s := range scores {
+			m[s.TierListID] = s
+		}
+		return m
+	}
+
+	t.Run("正常系: 集計ティアリストから大きく外れたティアリストは外れ値として重みが下がる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		authors := make([]entity.TierListAuthor, 0)
+		placements := make([]entity.Placement, 0)
+		for i := range 9 {
+			tierListID := id.NewTierListID()
+			authors = append(authors, entity.TierListAuthor{TierListID: tierListID, AuthorName: "投稿者" + string(rune('A'+i)), CreatedAt: baseTime})
+			ranks := []rank.TierRank{rank.TierSS, rank.TierS, rank.TierA, rank.TierB, rank.TierC}
+			if i%3 == 0 {
+				ranks[i%5] = ranks[i%5] - 1
+			}
+			placements = append(placements, placementsOf(tierListID, ranks...)...)
+		}
+		troll := id.NewTierListID()
+		authors = append(authors, entity.TierListAuthor{TierListID: troll, AuthorName: "荒らし", CreatedAt: baseTime})
+		placements = append(placements, placementsOf(troll, rank.TierE, rank.TierD, rank.TierC, rank.TierS, rank.TierSS)...)
+
+		// Act
+		got := byTierList(entity.NewTrustEvaluator().Evaluate(seasonID, authors, placements))
+
+		// Assert
+		require.Len(t, got, 10, "all tier lists should be evaluated")
+		trollScore := got[troll]
+		assert.Equal(t, seasonID, trollScore.SeasonID, "season ID should match")
+		assert.Equal(t, []entity.FlagReason{entity.FlagReasonOutlier}, trollScore.FlagReasons, "troll should be flagged as an outlier")
+		assert.GreaterOrEqual(t, trollScore.ZScore, entity.DefaultOutlierZScore, "z-score should exceed the threshold")
+		ratio := entity.DefaultOutlierZScore / trollScore.ZScore
+		assert.InDelta(t, ratio*ratio, trollScore.TrustWeight, 1e-9, "trust weight should decrease with the z-score")
+		assert.Nil(t, trollScore.DuplicateOf, "troll should not be a duplicate")
+		for _, a := range authors[:9] {
+			assert.False(t, got[a.TierListID].Flagged(), "tier lists close to the consensus should not be flagged")
+			assert.InDelta(t, 1.0, got[a.TierListID].TrustWeight, 1e-9, "trust weight should be 1")
+		}
+	})
+
+	t.Run("正常系: 同一投稿者（ユーザー・作成者名・IPアドレス）のほぼ同じティアリストは最初の投稿以外が重複投稿となる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		original, byName, byIP, byUser, different := id.NewTierListID(), id.NewTierListID(), id.NewTierListID(), id.NewTierListID(), id.NewTierListID()
+		anonymous1, anonymous2 := id.NewTierListID(), id.NewTierListID()
+		authorUserID := id.NewUserID()
+		authors := []entity.TierListAuthor{
+			{TierListID: byName, AuthorName: "荒らし", CreatedAt: baseTime.Add(time.Minute)},
+			{TierListID: original, AuthorUserID: &authorUserID, AuthorName: "荒らし", AuthorIP: "198.51.100.7", CreatedAt: baseTime},
+			{TierListID: byUser, AuthorUserID: &authorUserID, AuthorName: "別人を装う名前", AuthorIP: "203.0.113.9", CreatedAt: baseTime.Add(4 * time.Minute)},
+			{TierListID: byIP, AuthorName: "別名", AuthorIP: "198.51.100.7", CreatedAt: baseTime.Add(2 * time.Minute)},
+			{TierListID: different, AuthorName: "荒らし", CreatedAt: baseTime.Add(3 * time.Minute)},
+			{TierListID: anonymous1, AuthorName: "匿名ユーザー", CreatedAt: baseTime},
+			{TierListID: anonymous2, AuthorName: "匿名ユーザー", CreatedAt: baseTime},
+		}
+		placements := make([]entity.Placement, 0)
+		for _, tierListID := range []id.TierListID{original, byName, byIP, byUser} {
+			placements = append(placements, placementsOf(tierListID, rank.TierE, rank.TierS, rank.TierA, rank.TierB, rank.TierSS)...)
+		}
+		placements = append(placements, placementsOf(different, rank.TierSS, rank.TierS, rank.TierA, rank.TierB, rank.TierC)...)
+		placements = append(placements, placementsOf(anonymous1, rank.TierSS, rank.TierS, rank.TierA, rank.TierB, rank.TierC)...)
+		placements = append(placements, placementsOf(anonymous2, rank.TierSS, rank.TierS, rank.TierA, rank.TierB, rank.TierC)...)
+
+		// Act
+		got := byTierList(entity.NewTrustEvaluator().Evaluate(seasonID, authors, placements))
+
+		// Assert
+		require.Len(t, got, 7, "all tier lists should be evaluated")
+		for _, duplicate := range []id.TierListID{byName, byIP, byUser} {
+			require.NotNil(t, got[duplicate].DuplicateOf, "duplicate should reference the original")
+			assert.Equal(t, original, *got[duplicate].DuplicateOf, "the earliest tier list should be the original")
+			assert.Equal(t, []entity.FlagReason{entity.FlagReasonNearDuplicate}, got[duplicate].FlagReasons, "duplicate should be flagged")
+			assert.Zero(t, got[duplicate].TrustWeight, "duplicate should not be counted")
+		}
+		for _, tierListID := range []id.TierListID{original, different, anonymous1, anonymous2} {
+			assert.Nil(t, got[tierListID].DuplicateOf, "tier list should not be a duplicate")
+			assert.False(t, got[tierListID].Flagged(), "tier list should not be flagged")
+			assert.InDelta(t, 1.0, got[tierListID].TrustWeight, 1e-9, "trust weight should be 1")
+			// 重複投稿を除いたティアリストが z スコアの算出に必要な数に満たない
+			assert.Zero(t, got[tierListID].ZScore, "z-score should not be calculated")
+		}
+	})
+}
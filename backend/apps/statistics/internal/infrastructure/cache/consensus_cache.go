@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	pkgcache "poketier/pkg/cache"
+	"poketier/pkg/vo/id"
+)
+
+// consensusKey は集計結果を区別するキー
+// 最小配置数はキーに含めず、全デッキを対象とした集計結果を保持して取得後に絞り込む（任意の最小配置数でキャッシュを迂回させない）
+type consensusKey struct {
+	seasonID id.SeasonID
+	method   entity.ConsensusMethod
+}
+
+// ConsensusCache は集計ティアリストをシーズン・算出方式ごとに保持するプロセス内キャッシュ
+// 集計はシーズン内の配置を全件走査するため、同時アクセス時の再計算をまとめてデータベースへの負荷を抑える
+type ConsensusCache struct {
+	cache *pkgcache.Cache[consensusKey, *entity.ConsensusTierList]
+}
+
+// NewConsensusCache は有効期限と、期限切れ後に古い集計結果を返す期間を指定してConsensusCacheを作成
+func NewConsensusCache(ttl, staleTTL time.Duration) *ConsensusCache {
+	return &ConsensusCache{
+		cache: pkgcache.New[consensusKey, *entity.ConsensusTierList](ttl, staleTTL),
+	}
+}
+
+// Get はシーズン・算出方式に対応する集計結果を返す（保持していない場合は load で算出する）
+func (c *ConsensusCache) Get(ctx context.Context, seasonID id.SeasonID, method entity.ConsensusMethod, load func(ctx context.Context) (*entity.ConsensusTierList, error)) (*entity.ConsensusTierList, error) {
+	return c.cache.Get(ctx, consensusKey{
+		seasonID: seasonID,
+		method:   method,
+	}, load)
+}
+
+// InvalidateSeason は指定したシーズンの集計結果を全ての算出方式について無効化する
+// ティアリストの配置が変更された場合に呼び出す
+func (c *ConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	c.cache.Invalidate(func(key consensusKey) bool {
+		return key.seasonID.Equals(seasonID)
+	})
+}
@@ -0,0 +1,87 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/cache"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusCache(t *testing.T) {
+	t.Parallel()
+
+	seasonA, seasonB := id.NewSeasonID(), id.NewSeasonID()
+	newConsensus := func(seasonID id.SeasonID) *entity.ConsensusTierList {
+		consensus, err := entity.ConsensusFromStatistics(seasonID, 0, nil, 1, time.Now())
+		require.NoError(t, err, "failed to create consensus")
+		return consensus
+	}
+
+	t.Run("正常系: シーズン・算出方式ごとに集計結果が保持される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cache.NewConsensusCache(time.Minute, time.Hour)
+		calls := 0
+		load := func(seasonID id.SeasonID) func(ctx context.Context) (*entity.ConsensusTierList, error) {
+			return func(ctx context.Context) (*entity.ConsensusTierList, error) {
+				calls++
+				return newConsensus(seasonID), nil
+			}
+		}
+
+		// Act
+		first, err := c.Get(context.Background(), seasonA, entity.ConsensusMethodMean, load(seasonA))
+		require.NoError(t, err, "unexpected error occurred")
+		cached, err := c.Get(context.Background(), seasonA, entity.ConsensusMethodMean, load(seasonA))
+		require.NoError(t, err, "unexpected error occurred")
+		_, err = c.Get(context.Background(), seasonA, entity.ConsensusMethodMedian, load(seasonA))
+		require.NoError(t, err, "unexpected error occurred")
+		_, err = c.Get(context.Background(), seasonB, entity.ConsensusMethodMean, load(seasonB))
+		require.NoError(t, err, "unexpected error occurred")
+
+		// Assert
+		assert.Same(t, first, cached, "same conditions should return cached consensus")
+		assert.Equal(t, 3, calls, "different conditions should be calculated separately")
+	})
+
+	t.Run("正常系: シーズンの無効化は他のシーズンの集計結果に影響しない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cache.NewConsensusCache(time.Minute, time.Hour)
+		refreshed := make(chan struct{}, 1)
+		for _, seasonID := range []id.SeasonID{seasonA, seasonB} {
+			_, err := c.Get(context.Background(), seasonID, entity.ConsensusMethodMean, func(ctx context.Context) (*entity.ConsensusTierList, error) {
+				return newConsensus(seasonID), nil
+			})
+			require.NoError(t, err, "unexpected error occurred")
+		}
+
+		// Act
+		c.InvalidateSeason(seasonA)
+		_, err := c.Get(context.Background(), seasonA, entity.ConsensusMethodMean, func(ctx context.Context) (*entity.ConsensusTierList, error) {
+			refreshed <- struct{}{}
+			return newConsensus(seasonA), nil
+		})
+		require.NoError(t, err, "unexpected error occurred")
+		_, err = c.Get(context.Background(), seasonB, entity.ConsensusMethodMean, func(ctx context.Context) (*entity.ConsensusTierList, error) {
+			t.Error("consensus of other season should not be recalculated")
+			return nil, nil
+		})
+		require.NoError(t, err, "unexpected error occurred")
+
+		// Assert
+		select {
+		case <-refreshed:
+		case <-time.After(time.Second):
+			t.Fatal("invalidated season should be recalculated")
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// DeckQuerier はデータベースクエリを定義するインターフェース
+type DeckQuerier interface {
+	GetDeck(ctx context.Context, deckID pgtype.UUID) (db.Deck, error)
+	ListDecksBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.Deck, error)
+}
+
+// DeckRepository は統計から参照するデッキのリポジトリ
+type DeckRepository struct {
+	queries DeckQuerier
+}
+
+// NewDeckRepository は新しいDeckRepositoryを作成
+func NewDeckRepository(queries DeckQuerier) *DeckRepository {
+	return &DeckRepository{
+		queries: queries,
+	}
+}
+
+// FindBySeason は指定したシーズンのデッキを全て取得
+func (r *DeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	rows, err := r.queries.ListDecksBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list decks by season: %w", err)
+	}
+
+	decks := make([]*entity.Deck, 0, len(rows))
+	for _, row := range rows {
+		decks = append(decks, r.toEntity(row))
+	}
+	return decks, nil
+}
+
+// FindByID は指定したIDのデッキを取得（存在しない場合はNotFoundエラー）
+func (r *DeckRepository) FindByID(ctx context.Context, deckID id.DeckID) (*entity.Deck, error) {
+	row, err := r.queries.GetDeck(ctx, pgtype.UUID{Bytes: deckID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("deck not found", err)
+		}
+		return nil, fmt.Errorf("failed to get deck: %w", err)
+	}
+	return r.toEntity(row), nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *DeckRepository) toEntity(row db.Deck) *entity.Deck {
+	return entity.ReconstructDeck(
+		id.DeckIDFromUUID(row.DeckID.Bytes),
+		id.SeasonIDFromUUID(row.SeasonID.Bytes),
+		row.Nickname,
+		row.ImageUrl,
+		row.ArchetypeKey.String,
+	)
+}
@@ -0,0 +1,153 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestDeckRepository_FindBySeason(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckQuerier)
+		want        []*entity.Deck
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズンのデッキが取得できる事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().ListDecksBySeason(gomock.Any(), pgSeasonID).Return([]db.Deck{
+					{
+						DeckID:   pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						SeasonID: pgSeasonID,
+						Nickname: "ピカチュウex",
+						ImageUrl: "https://example.com/decks/pikachu.png",
+						ArchetypeKey: pgtype.Text{
+							String: "2f1c1c9e-4a6b-4f0e-9a7d-1d2c3b4a5e6f,8b7a6c5d-4e3f-4a1b-9c8d-7e6f5a4b3c2d",
+							Valid:  true,
+						},
+					},
+				}, nil)
+			},
+			want: []*entity.Deck{
+				entity.ReconstructDeck(deckID, seasonID, "ピカチュウex", "https://example.com/decks/pikachu.png", "2f1c1c9e-4a6b-4f0e-9a7d-1d2c3b4a5e6f,8b7a6c5d-4e3f-4a1b-9c8d-7e6f5a4b3c2d"),
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().ListDecksBySeason(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "decks do not match")
+		})
+	}
+}
+
+func TestDeckRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgDeckID := pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockDeckQuerier)
+		want         *entity.Deck
+		expectError  bool
+		wantNotFound bool
+	}{
+		{
+			caseName: "正常系: デッキが取得できる事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), pgDeckID).Return(db.Deck{
+					DeckID:   pgDeckID,
+					SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					Nickname: "リザニンフ",
+					ImageUrl: "https://example.com/decks/charizard.png",
+				}, nil)
+			},
+			want: entity.ReconstructDeck(deckID, seasonID, "リザニンフ", "https://example.com/decks/charizard.png", ""),
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), pgDeckID).Return(db.Deck{}, pgx.ErrNoRows)
+			},
+			expectError:  true,
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().GetDeck(gomock.Any(), pgDeckID).Return(db.Deck{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantNotFound, errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound, "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "deck does not match")
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// DeckTrendQuerier はデータベースクエリを定義するインターフェース
+type DeckTrendQuerier interface {
+	SnapshotDeckTrends(ctx context.Context, arg db.SnapshotDeckTrendsParams) (int64, error)
+	ListDeckTrendSnapshotsByDeck(ctx context.Context, arg db.ListDeckTrendSnapshotsByDeckParams) ([]db.DeckTrendSnapshot, error)
+	ListDeckTrendSnapshotDates(ctx context.Context, seasonID pgtype.UUID) ([]pgtype.Date, error)
+	ListDeckTrendSnapshotsByDate(ctx context.Context, arg db.ListDeckTrendSnapshotsByDateParams) ([]db.DeckTrendSnapshot, error)
+}
+
+// DeckTrendRepository はデッキの推移（集計ティアリストの日次スナップショット）のリポジトリ
+type DeckTrendRepository struct {
+	queries DeckTrendQuerier
+}
+
+// NewDeckTrendRepository は新しいDeckTrendRepositoryを作成
+func NewDeckTrendRepository(queries DeckTrendQuerier) *DeckTrendRepository {
+	return &DeckTrendRepository{
+		queries: queries,
+	}
+}
+
+// Snapshot はシーズンのティア統計を指定日のスナップショットとして記録し、記録したデッキ数を返す
+func (r *DeckTrendRepository) Snapshot(ctx context.Context, seasonID id.SeasonID, date time.Time) (int, error) {
+	count, err := r.queries.SnapshotDeckTrends(ctx, db.SnapshotDeckTrendsParams{
+		SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		SnapshotDate: pgtype.Date{Time: date, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to snapshot deck trends: %w", err)
+	}
+	return int(count), nil
+}
+
+// FindByDeck は指定したデッキのスナップショットを記録日の古い順で取得
+func (r *DeckTrendRepository) FindByDeck(ctx context.Context, seasonID id.SeasonID, deckID id.DeckID) ([]entity.DeckTrendPoint, error) {
+	rows, err := r.queries.ListDeckTrendSnapshotsByDeck(ctx, db.ListDeckTrendSnapshotsByDeckParams{
+		SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		DeckID:   pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deck trend snapshots by deck: %w", err)
+	}
+	return r.toEntities(rows), nil
+}
+
+// FindDates はシーズンのスナップショットの記録日を古い順で取得
+func (r *DeckTrendRepository) FindDates(ctx context.Context, seasonID id.SeasonID) ([]time.Time, error) {
+	rows, err := r.queries.ListDeckTrendSnapshotDates(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deck trend snapshot dates: %w", err)
+	}
+
+	dates := make([]time.Time, 0, len(rows))
+	for _, row := range rows {
+		dates = append(dates, row.Time)
+	}
+	return dates, nil
+}
+
+// FindByDate は指定日に記録された全デッキのスナップショットを取得
+func (r *DeckTrendRepository) FindByDate(ctx context.Context, seasonID id.SeasonID, date time.Time) ([]entity.DeckTrendPoint, error) {
+	rows, err := r.queries.ListDeckTrendSnapshotsByDate(ctx, db.ListDeckTrendSnapshotsByDateParams{
+		SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		SnapshotDate: pgtype.Date{Time: date, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deck trend snapshots by date: %w", err)
+	}
+	return r.toEntities(rows), nil
+}
+
+// toEntities はデータベースモデルからエンティティに変換
+func (r *DeckTrendRepository) toEntities(rows []db.DeckTrendSnapshot) []entity.DeckTrendPoint {
+	points := make([]entity.DeckTrendPoint, 0, len(rows))
+	for _, row := range rows {
+		points = append(points, entity.DeckTrendPoint{
+			DeckID:         id.DeckIDFromUUID(row.DeckID.Bytes),
+			SnapshotDate:   row.SnapshotDate.Time,
+			TierRank:       row.TierRank,
+			PlacementCount: int(row.PlacementCount),
+		})
+	}
+	return points
+}
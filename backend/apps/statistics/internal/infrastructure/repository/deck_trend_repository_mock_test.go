@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/infrastructure/repository/deck_trend_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/infrastructure/repository/deck_trend_repository.go -destination=./apps/statistics/internal/infrastructure/repository/deck_trend_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeckTrendQuerier is a mock of DeckTrendQuerier interface.
+type MockDeckTrendQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckTrendQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockDeckTrendQuerierMockRecorder is the mock recorder for MockDeckTrendQuerier.
+type MockDeckTrendQuerierMockRecorder struct {
+	mock *MockDeckTrendQuerier
+}
+
+// NewMockDeckTrendQuerier creates a new mock instance.
+func NewMockDeckTrendQuerier(ctrl *gomock.Controller) *MockDeckTrendQuerier {
+	mock := &MockDeckTrendQuerier{ctrl: ctrl}
+	mock.recorder = &MockDeckTrendQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckTrendQuerier) EXPECT() *MockDeckTrendQuerierMockRecorder {
+	return m.recorder
+}
+
+// ListDeckTrendSnapshotDates mocks base method.
+func (m *MockDeckTrendQuerier) ListDeckTrendSnapshotDates(ctx context.Context, seasonID pgtype.UUID) ([]pgtype.Date, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeckTrendSnapshotDates", ctx, seasonID)
+	ret0, _ := ret[0].([]pgtype.Date)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeckTrendSnapshotDates indicates an expected call of ListDeckTrendSnapshotDates.
+func (mr *MockDeckTrendQuerierMockRecorder) ListDeckTrendSnapshotDates(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeckTrendSnapshotDates", reflect.TypeOf((*MockDeckTrendQuerier)(nil).ListDeckTrendSnapshotDates), ctx, seasonID)
+}
+
+// ListDeckTrendSnapshotsByDate mocks base method.
+func (m *MockDeckTrendQuerier) ListDeckTrendSnapshotsByDate(ctx context.Context, arg db.ListDeckTrendSnapshotsByDateParams) ([]db.DeckTrendSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeckTrendSnapshotsByDate", ctx, arg)
+	ret0, _ := ret[0].([]db.DeckTrendSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeckTrendSnapshotsByDate indicates an expected call of ListDeckTrendSnapshotsByDate.
+func (mr *MockDeckTrendQuerierMockRecorder) ListDeckTrendSnapshotsByDate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeckTrendSnapshotsByDate", reflect.TypeOf((*MockDeckTrendQuerier)(nil).ListDeckTrendSnapshotsByDate), ctx, arg)
+}
+
+// ListDeckTrendSnapshotsByDeck mocks base method.
+func (m *MockDeckTrendQuerier) ListDeckTrendSnapshotsByDeck(ctx context.Context, arg db.ListDeckTrendSnapshotsByDeckParams) ([]db.DeckTrendSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeckTrendSnapshotsByDeck", ctx, arg)
+	ret0, _ := ret[0].([]db.DeckTrendSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeckTrendSnapshotsByDeck indicates an expected call of ListDeckTrendSnapshotsByDeck.
+func (mr *MockDeckTrendQuerierMockRecorder) ListDeckTrendSnapshotsByDeck(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeckTrendSnapshotsByDeck", reflect.TypeOf((*MockDeckTrendQuerier)(nil).ListDeckTrendSnapshotsByDeck), ctx, arg)
+}
+
+// SnapshotDeckTrends mocks base method.
+func (m *MockDeckTrendQuerier) SnapshotDeckTrends(ctx context.Context, arg db.SnapshotDeckTrendsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotDeckTrends", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotDeckTrends indicates an expected call of SnapshotDeckTrends.
+func (mr *MockDeckTrendQuerierMockRecorder) SnapshotDeckTrends(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotDeckTrends", reflect.TypeOf((*MockDeckTrendQuerier)(nil).SnapshotDeckTrends), ctx, arg)
+}
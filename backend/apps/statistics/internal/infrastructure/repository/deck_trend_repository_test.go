@@ -0,0 +1,193 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestDeckTrendRepository_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+	expectedParams := db.SnapshotDeckTrendsParams{
+		SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		SnapshotDate: pgtype.Date{Time: date, Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckTrendQuerier)
+		want        int
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 記録したデッキ数が返される事",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().SnapshotDeckTrends(gomock.Any(), expectedParams).Return(int64(12), nil)
+			},
+			want: 12,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().SnapshotDeckTrends(gomock.Any(), expectedParams).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckTrendQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckTrendRepository(mockQuerier)
+
+			// Act
+			got, err := repo.Snapshot(context.Background(), seasonID, date)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "snapshot count does not match")
+		})
+	}
+}
+
+func TestDeckTrendRepository_FindByDeck(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+	pgDeckID := pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+	day1 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	expectedParams := db.ListDeckTrendSnapshotsByDeckParams{SeasonID: pgSeasonID, DeckID: pgDeckID}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckTrendQuerier)
+		want        []entity.DeckTrendPoint
+		expectError bool
+	}{
+		{
+			caseName: "正常系: デッキのスナップショットが取得できる事",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().ListDeckTrendSnapshotsByDeck(gomock.Any(), expectedParams).Return([]db.DeckTrendSnapshot{
+					{SeasonID: pgSeasonID, DeckID: pgDeckID, SnapshotDate: pgtype.Date{Time: day1, Valid: true}, TierRank: 5.5, PlacementCount: 4},
+					{SeasonID: pgSeasonID, DeckID: pgDeckID, SnapshotDate: pgtype.Date{Time: day2, Valid: true}, TierRank: 6.25, PlacementCount: 8},
+				}, nil)
+			},
+			want: []entity.DeckTrendPoint{
+				{DeckID: deckID, SnapshotDate: day1, TierRank: 5.5, PlacementCount: 4},
+				{DeckID: deckID, SnapshotDate: day2, TierRank: 6.25, PlacementCount: 8},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().ListDeckTrendSnapshotsByDeck(gomock.Any(), expectedParams).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckTrendQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckTrendRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByDeck(context.Background(), seasonID, deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "deck trend points do not match")
+		})
+	}
+}
+
+func TestDeckTrendRepository_FindDates(t *testing.T) {
+	t.Parallel()
+
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+	day1 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckTrendQuerier)
+		want        []time.Time
+		expectError bool
+	}{
+		{
+			caseName: "正常系: スナップショットの記録日が取得できる事",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().ListDeckTrendSnapshotDates(gomock.Any(), pgSeasonID).Return([]pgtype.Date{
+					{Time: day1, Valid: true},
+					{Time: day2, Valid: true},
+				}, nil)
+			},
+			want: []time.Time{day1, day2},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckTrendQuerier) {
+				mockQuerier.EXPECT().ListDeckTrendSnapshotDates(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckTrendQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckTrendRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindDates(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "snapshot dates do not match")
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// FavoriteCountQuerier はデータベースクエリを定義するインターフェース
+type FavoriteCountQuerier interface {
+	ListDeckFavoriteCounts(ctx context.Context, deckIds []pgtype.UUID) ([]db.ListDeckFavoriteCountsRow, error)
+}
+
+// FavoriteCountRepository はデッキのお気に入り数のリポジトリ
+type FavoriteCountRepository struct {
+	queries FavoriteCountQuerier
+}
+
+// NewFavoriteCountRepository は新しいFavoriteCountRepositoryを作成
+func NewFavoriteCountRepository(queries FavoriteCountQuerier) *FavoriteCountRepository {
+	return &FavoriteCountRepository{
+		queries: queries,
+	}
+}
+
+// CountByDeckID は指定したデッキのお気に入り数を取得（お気に入りされたことがない場合は0）
+func (r *FavoriteCountRepository) CountByDeckID(ctx context.Context, deckID id.DeckID) (int, error) {
+	rows, err := r.queries.ListDeckFavoriteCounts(ctx, []pgtype.UUID{{Bytes: deckID.UUID(), Valid: true}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list deck favorite counts: %w", err)
+	}
+
+	count := 0
+	for _, row := range rows {
+		count += int(row.FavoriteCount)
+	}
+	return count, nil
+}
@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestFavoriteCountRepository_CountByDeckID(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgDeckID := pgtype.UUID{Bytes: deckID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockFavoriteCountQuerier)
+		want        int
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シャードを合計したお気に入り数が取得できる事",
+			setupMock: func(mockQuerier *MockFavoriteCountQuerier) {
+				mockQuerier.EXPECT().ListDeckFavoriteCounts(gomock.Any(), []pgtype.UUID{pgDeckID}).Return([]db.ListDeckFavoriteCountsRow{
+					{DeckID: pgDeckID, FavoriteCount: 7},
+				}, nil)
+			},
+			want: 7,
+		},
+		{
+			caseName: "正常系: お気に入りされたことがない場合は0を返す事",
+			setupMock: func(mockQuerier *MockFavoriteCountQuerier) {
+				mockQuerier.EXPECT().ListDeckFavoriteCounts(gomock.Any(), []pgtype.UUID{pgDeckID}).Return([]db.ListDeckFavoriteCountsRow{}, nil)
+			},
+			want: 0,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockFavoriteCountQuerier) {
+				mockQuerier.EXPECT().ListDeckFavoriteCounts(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockFavoriteCountQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewFavoriteCountRepository(mockQuerier)
+
+			// Act
+			got, err := repo.CountByDeckID(context.Background(), deckID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "favorite count does not match")
+		})
+	}
+}
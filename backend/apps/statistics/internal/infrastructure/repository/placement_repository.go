@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+// PlacementQuerier はデータベースクエリを定義するインターフェース
+type PlacementQuerier interface {
+	ListTierPlacementsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.ListTierPlacementsBySeasonRow, error)
+	CountTierListsBySeason(ctx context.Context, seasonID pgtype.UUID) (int64, error)
+}
+
+// PlacementRepository は集計対象となるティア配置のリポジトリ
+type PlacementRepository struct {
+	queries PlacementQuerier
+}
+
+// NewPlacementRepository は新しいPlacementRepositoryを作成
+func NewPlacementRepository(queries PlacementQuerier) *PlacementRepository {
+	return &PlacementRepository{
+		queries: queries,
+	}
+}
+
+// FindBySeason は指定したシーズンの全ティアリストの配置を取得
+// 配置の重みにはティアリストの信頼度を使用する
+func (r *PlacementRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.Placement, error) {
+	rows, err := r.queries.ListTierPlacementsBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier placements by season: %w", err)
+	}
+
+	placements := make([]entity.Placement, 0, len(rows))
+	for _, row := range rows {
+		placement := entity.NewPlacement(
+			id.TierListIDFromUUID(row.TierListID.Bytes),
+			id.DeckIDFromUUID(row.DeckID.Bytes),
+			rank.TierRank(row.TierRank),
+		)
+		placement.Weight = row.TrustWeight
+		placements = append(placements, placement)
+	}
+	return placements, nil
+}
+
+// CountTierListsBySeason は指定したシーズンのティアリスト数を取得
+func (r *PlacementRepository) CountTierListsBySeason(ctx context.Context, seasonID id.SeasonID) (int, error) {
+	count, err := r.queries.CountTierListsBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tier lists by season: %w", err)
+	}
+	return int(count), nil
+}
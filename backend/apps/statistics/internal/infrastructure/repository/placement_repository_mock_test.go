@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/infrastructure/repository/placement_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/infrastructure/repository/placement_repository.go -destination=./apps/statistics/internal/infrastructure/repository/placement_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlacementQuerier is a mock of PlacementQuerier interface.
+type MockPlacementQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlacementQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockPlacementQuerierMockRecorder is the mock recorder for MockPlacementQuerier.
+type MockPlacementQuerierMockRecorder struct {
+	mock *MockPlacementQuerier
+}
+
+// NewMockPlacementQuerier creates a new mock instance.
+func NewMockPlacementQuerier(ctrl *gomock.Controller) *MockPlacementQuerier {
+	mock := &MockPlacementQuerier{ctrl: ctrl}
+	mock.recorder = &MockPlacementQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlacementQuerier) EXPECT() *MockPlacementQuerierMockRecorder {
+	return m.recorder
+}
+
+// CountTierListsBySeason mocks base method.
+func (m *MockPlacementQuerier) CountTierListsBySeason(ctx context.Context, seasonID pgtype.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTierListsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTierListsBySeason indicates an expected call of CountTierListsBySeason.
+func (mr *MockPlacementQuerierMockRecorder) CountTierListsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTierListsBySeason", reflect.TypeOf((*MockPlacementQuerier)(nil).CountTierListsBySeason), ctx, seasonID)
+}
+
+// ListTierPlacementsBySeason mocks base method.
+func (m *MockPlacementQuerier) ListTierPlacementsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.ListTierPlacementsBySeasonRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierPlacementsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]db.ListTierPlacementsBySeasonRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierPlacementsBySeason indicates an expected call of ListTierPlacementsBySeason.
+func (mr *MockPlacementQuerierMockRecorder) ListTierPlacementsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierPlacementsBySeason", reflect.TypeOf((*MockPlacementQuerier)(nil).ListTierPlacementsBySeason), ctx, seasonID)
+}
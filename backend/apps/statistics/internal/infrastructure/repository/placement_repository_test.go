@@ -0,0 +1,140 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+var seasonID = id.NewSeasonID()
+
+func TestPlacementRepository_FindBySeason(t *testing.T) {
+	t.Parallel()
+
+	tierListID, tierListID2, deckID := id.NewTierListID(), id.NewTierListID(), id.NewDeckID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockPlacementQuerier)
+		want        []entity.Placement
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ティアリストの信頼度を重みとして配置が取得できる事",
+			setupMock: func(mockQuerier *MockPlacementQuerier) {
+				mockQuerier.EXPECT().ListTierPlacementsBySeason(gomock.Any(), pgSeasonID).Return([]db.ListTierPlacementsBySeasonRow{
+					{
+						TierListID:  pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+						DeckID:      pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						TierRank:    6,
+						TrustWeight: 1,
+					},
+					{
+						TierListID:  pgtype.UUID{Bytes: tierListID2.UUID(), Valid: true},
+						DeckID:      pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						TierRank:    1,
+						TrustWeight: 0.25,
+					},
+				}, nil)
+			},
+			want: []entity.Placement{
+				{TierListID: tierListID, DeckID: deckID, TierRank: rank.TierS, Weight: entity.DefaultPlacementWeight},
+				{TierListID: tierListID2, DeckID: deckID, TierRank: rank.TierE, Weight: 0.25},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockPlacementQuerier) {
+				mockQuerier.EXPECT().ListTierPlacementsBySeason(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockPlacementQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewPlacementRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "placements do not match")
+		})
+	}
+}
+
+func TestPlacementRepository_CountTierListsBySeason(t *testing.T) {
+	t.Parallel()
+
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockPlacementQuerier)
+		want        int
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ティアリスト数が取得できる事",
+			setupMock: func(mockQuerier *MockPlacementQuerier) {
+				mockQuerier.EXPECT().CountTierListsBySeason(gomock.Any(), pgSeasonID).Return(int64(25), nil)
+			},
+			want: 25,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockPlacementQuerier) {
+				mockQuerier.EXPECT().CountTierListsBySeason(gomock.Any(), pgSeasonID).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockPlacementQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewPlacementRepository(mockQuerier)
+
+			// Act
+			got, err := repo.CountTierListsBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "count does not match")
+		})
+	}
+}
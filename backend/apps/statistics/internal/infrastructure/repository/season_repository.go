@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// SeasonQuerier はデータベースクエリを定義するインターフェース
+type SeasonQuerier interface {
+	GetSeason(ctx context.Context, seasonID pgtype.UUID) (db.Season, error)
+	ListSeasons(ctx context.Context) ([]db.Season, error)
+}
+
+// SeasonRepository は統計から参照するシーズンのリポジトリ
+type SeasonRepository struct {
+	queries SeasonQuerier
+}
+
+// NewSeasonRepository は新しいSeasonRepositoryを作成
+func NewSeasonRepository(queries SeasonQuerier) *SeasonRepository {
+	return &SeasonRepository{
+		queries: queries,
+	}
+}
+
+// Exists は指定したシーズンが存在するかどうかを返す
+func (r *SeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	if _, err := r.queries.GetSeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get season: %w", err)
+	}
+	return true, nil
+}
+
+// FindAllIDs は全シーズンのIDを取得
+func (r *SeasonRepository) FindAllIDs(ctx context.Context) ([]id.SeasonID, error) {
+	rows, err := r.queries.ListSeasons(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+
+	seasonIDs := make([]id.SeasonID, 0, len(rows))
+	for _, row := range rows {
+		seasonIDs = append(seasonIDs, id.SeasonIDFromUUID(row.SeasonID.Bytes))
+	}
+	return seasonIDs, nil
+}
+
+// FindActiveIDs は now の日付が期間内（開始日・終了日を含む）のシーズンのIDを取得
+func (r *SeasonRepository) FindActiveIDs(ctx context.Context, now time.Time) ([]id.SeasonID, error) {
+	rows, err := r.queries.ListSeasons(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	seasonIDs := make([]id.SeasonID, 0, 1)
+	for _, row := range rows {
+		if today.Before(row.StartDate.Time) || today.After(row.EndDate.Time) {
+			continue
+		}
+		seasonIDs = append(seasonIDs, id.SeasonIDFromUUID(row.SeasonID.Bytes))
+	}
+	return seasonIDs, nil
+}
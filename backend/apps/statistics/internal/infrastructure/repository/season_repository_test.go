@@ -0,0 +1,191 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestSeasonRepository_Exists(t *testing.T) {
+	t.Parallel()
+
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSeasonQuerier)
+		want        bool
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズンが存在する場合はtrueを返す事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{SeasonID: pgSeasonID}, nil)
+			},
+			want: true,
+		},
+		{
+			caseName: "正常系: シーズンが存在しない場合はfalseを返す事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{}, pgx.ErrNoRows)
+			},
+			want: false,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSeasonQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSeasonRepository(mockQuerier)
+
+			// Act
+			got, err := repo.Exists(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "exists does not match")
+		})
+	}
+}
+
+func TestSeasonRepository_FindAllIDs(t *testing.T) {
+	t.Parallel()
+
+	otherSeasonID := id.NewSeasonID()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSeasonQuerier)
+		want        []id.SeasonID
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 全シーズンのIDが取得できる事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().ListSeasons(gomock.Any()).Return([]db.Season{
+					{SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}},
+					{SeasonID: pgtype.UUID{Bytes: otherSeasonID.UUID(), Valid: true}},
+				}, nil)
+			},
+			want: []id.SeasonID{seasonID, otherSeasonID},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().ListSeasons(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSeasonQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSeasonRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindAllIDs(context.Background())
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "season IDs do not match")
+		})
+	}
+}
+
+func TestSeasonRepository_FindActiveIDs(t *testing.T) {
+	t.Parallel()
+
+	endedSeasonID, upcomingSeasonID := id.NewSeasonID(), id.NewSeasonID()
+	now := time.Date(2025, 8, 31, 23, 0, 0, 0, time.UTC)
+	date := func(m time.Month, d int) pgtype.Date {
+		return pgtype.Date{Time: time.Date(2025, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSeasonQuerier)
+		want        []id.SeasonID
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 終了日当日を含め、期間内のシーズンのIDのみが取得できる事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().ListSeasons(gomock.Any()).Return([]db.Season{
+					{SeasonID: pgtype.UUID{Bytes: upcomingSeasonID.UUID(), Valid: true}, StartDate: date(9, 1), EndDate: date(9, 30)},
+					{SeasonID: pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}, StartDate: date(8, 1), EndDate: date(8, 31)},
+					{SeasonID: pgtype.UUID{Bytes: endedSeasonID.UUID(), Valid: true}, StartDate: date(7, 1), EndDate: date(7, 31)},
+				}, nil)
+			},
+			want: []id.SeasonID{seasonID},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().ListSeasons(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSeasonQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSeasonRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindActiveIDs(context.Background(), now)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "season IDs do not match")
+		})
+	}
+}
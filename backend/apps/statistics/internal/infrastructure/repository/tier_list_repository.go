@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+// TierListQuerier はデータベースクエリを定義するインターフェース
+type TierListQuerier interface {
+	GetTierList(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error)
+	ListTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) ([]db.TierPlacement, error)
+}
+
+// TierListRepository は集計結果と比較するティアリストのリポジトリ
+type TierListRepository struct {
+	queries TierListQuerier
+}
+
+// NewTierListRepository は新しいTierListRepositoryを作成
+func NewTierListRepository(queries TierListQuerier) *TierListRepository {
+	return &TierListRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのティアリストを配置とともに取得（存在しない場合はNotFoundエラー）
+func (r *TierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+	row, err := r.queries.GetTierList(ctx, pgTierListID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("tier list not found", err)
+		}
+		return nil, fmt.Errorf("failed to get tier list: %w", err)
+	}
+
+	rows, err := r.queries.ListTierPlacementsByTierList(ctx, pgTierListID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier placements by tier list: %w", err)
+	}
+
+	placements := make([]entity.Placement, 0, len(rows))
+	for _, p := range rows {
+		placements = append(placements, entity.NewPlacement(
+			tierListID,
+			id.DeckIDFromUUID(p.DeckID.Bytes),
+			rank.TierRank(p.TierRank),
+		))
+	}
+	return entity.ReconstructTierList(tierListID, id.SeasonIDFromUUID(row.SeasonID.Bytes), placements), nil
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/infrastructure/repository/tier_list_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/infrastructure/repository/tier_list_repository.go -destination=./apps/statistics/internal/infrastructure/repository/tier_list_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListQuerier is a mock of TierListQuerier interface.
+type MockTierListQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListQuerierMockRecorder is the mock recorder for MockTierListQuerier.
+type MockTierListQuerierMockRecorder struct {
+	mock *MockTierListQuerier
+}
+
+// NewMockTierListQuerier creates a new mock instance.
+func NewMockTierListQuerier(ctrl *gomock.Controller) *MockTierListQuerier {
+	mock := &MockTierListQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListQuerier) EXPECT() *MockTierListQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetTierList mocks base method.
+func (m *MockTierListQuerier) GetTierList(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierList", ctx, tierListID)
+	ret0, _ := ret[0].(db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierList indicates an expected call of GetTierList.
+func (mr *MockTierListQuerierMockRecorder) GetTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierList", reflect.TypeOf((*MockTierListQuerier)(nil).GetTierList), ctx, tierListID)
+}
+
+// ListTierPlacementsByTierList mocks base method.
+func (m *MockTierListQuerier) ListTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) ([]db.TierPlacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierPlacementsByTierList", ctx, tierListID)
+	ret0, _ := ret[0].([]db.TierPlacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierPlacementsByTierList indicates an expected call of ListTierPlacementsByTierList.
+func (mr *MockTierListQuerierMockRecorder) ListTierPlacementsByTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierPlacementsByTierList", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierPlacementsByTierList), ctx, tierListID)
+}
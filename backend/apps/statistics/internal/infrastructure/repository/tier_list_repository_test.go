@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+func TestTierListRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	deckID := id.NewDeckID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListQuerier)
+		want         *entity.TierList
+		expectError  bool
+		wantNotFound bool
+	}{
+		{
+			caseName: "正常系: ティアリストが配置とともに取得できる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(db.TierList{
+					TierListID: pgTierListID,
+					SeasonID:   pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+				}, nil)
+				mockQuerier.EXPECT().ListTierPlacementsByTierList(gomock.Any(), pgTierListID).Return([]db.TierPlacement{
+					{
+						TierListID: pgTierListID,
+						DeckID:     pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						TierRank:   int16(rank.TierS),
+					},
+				}, nil)
+			},
+			want: entity.ReconstructTierList(tierListID, seasonID, []entity.Placement{
+				entity.NewPlacement(tierListID, deckID, rank.TierS),
+			}),
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(db.TierList{}, pgx.ErrNoRows)
+			},
+			expectError:  true,
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: 配置の取得でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(db.TierList{
+					TierListID: pgTierListID,
+					SeasonID:   pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+				}, nil)
+				mockQuerier.EXPECT().ListTierPlacementsByTierList(gomock.Any(), pgTierListID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantNotFound, errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound, "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "tier list does not match")
+		})
+	}
+}
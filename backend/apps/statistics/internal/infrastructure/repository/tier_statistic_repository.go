@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// TierStatisticQuerier はデータベースクエリを定義するインターフェース
+type TierStatisticQuerier interface {
+	ListTierStatisticsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.TierStatistic, error)
+	ListTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]db.TierStatistic, error)
+	ListRecomputedTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]db.ListRecomputedTierStatisticsRow, error)
+	LockTierStatistics(ctx context.Context) error
+	DeleteTierStatistics(ctx context.Context, seasonID pgtype.UUID) error
+	RebuildTierStatistics(ctx context.Context, seasonID pgtype.UUID) error
+}
+
+// TierStatisticRepository はティア統計のリポジトリ
+type TierStatisticRepository struct {
+	queries TierStatisticQuerier
+}
+
+// NewTierStatisticRepository は新しいTierStatisticRepositoryを作成
+func NewTierStatisticRepository(queries TierStatisticQuerier) *TierStatisticRepository {
+	return &TierStatisticRepository{
+		queries: queries,
+	}
+}
+
+// FindBySeason は指定したシーズンの配置があるデッキの統計を取得
+func (r *TierStatisticRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierStatistic, error) {
+	rows, err := r.queries.ListTierStatisticsBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier statistics by season: %w", err)
+	}
+	return r.toEntities(rows), nil
+}
+
+// FindAll は差分更新された統計を取得（seasonID がnilの場合は全シーズン）
+func (r *TierStatisticRepository) FindAll(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error) {
+	rows, err := r.queries.ListTierStatistics(ctx, toSeasonUUID(seasonID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier statistics: %w", err)
+	}
+	return r.toEntities(rows), nil
+}
+
+// Recompute は配置から統計を再計算した結果を取得（seasonID がnilの場合は全シーズン）
+func (r *TierStatisticRepository) Recompute(ctx context.Context, seasonID *id.SeasonID) ([]entity.TierStatistic, error) {
+	rows, err := r.queries.ListRecomputedTierStatistics(ctx, toSeasonUUID(seasonID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recomputed tier statistics: %w", err)
+	}
+
+	statistics := make([]entity.TierStatistic, 0, len(rows))
+	for _, row := range rows {
+		statistics = append(statistics, entity.TierStatistic{
+			DeckID:                id.DeckIDFromUUID(row.DeckID.Bytes),
+			SeasonID:              id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			RankSum:               row.RankSum,
+			PlacementCount:        int(row.PlacementCount),
+			WeightedRankSum:       row.WeightedRankSum,
+			WeightSum:             row.WeightSum,
+			WeightedRankSquareSum: row.WeightedRankSquareSum,
+			WeightSquareSum:       row.WeightSquareSum,
+		})
+	}
+	return statistics, nil
+}
+
+// Rebuild は統計を削除し、配置から作り直す（seasonID がnilの場合は全シーズン）
+// 削除と再作成の間に不整合が見えないよう、トランザクション内で呼び出す
+// 再作成の前に統計のテーブルをロックし、配置から読み取った後に差分更新が加算・減算されて二重に反映されることを防ぐ
+func (r *TierStatisticRepository) Rebuild(ctx context.Context, seasonID *id.SeasonID) error {
+	pgSeasonID := toSeasonUUID(seasonID)
+
+	if err := r.queries.LockTierStatistics(ctx); err != nil {
+		return fmt.Errorf("failed to lock tier statistics: %w", err)
+	}
+
+	if err := r.queries.DeleteTierStatistics(ctx, pgSeasonID); err != nil {
+		return fmt.Errorf("failed to delete tier statistics: %w", err)
+	}
+
+	if err := r.queries.RebuildTierStatistics(ctx, pgSeasonID); err != nil {
+		return fmt.Errorf("failed to rebuild tier statistics: %w", err)
+	}
+
+	return nil
+}
+
+// toEntities はデータベースモデルからエンティティに変換
+func (r *TierStatisticRepository) toEntities(rows []db.TierStatistic) []entity.TierStatistic {
+	statistics := make([]entity.TierStatistic, 0, len(rows))
+	for _, row := range rows {
+		statistics = append(statistics, entity.TierStatistic{
+			DeckID:                id.DeckIDFromUUID(row.DeckID.Bytes),
+			SeasonID:              id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			RankSum:               row.RankSum,
+			PlacementCount:        int(row.PlacementCount),
+			WeightedRankSum:       row.WeightedRankSum,
+			WeightSum:             row.WeightSum,
+			WeightedRankSquareSum: row.WeightedRankSquareSum,
+			WeightSquareSum:       row.WeightSquareSum,
+		})
+	}
+	return statistics
+}
+
+// toSeasonUUID は任意指定のシーズンIDをNULL許容のUUIDに変換
+func toSeasonUUID(seasonID *id.SeasonID) pgtype.UUID {
+	if seasonID == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/infrastructure/repository/tier_statistic_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/infrastructure/repository/tier_statistic_repository.go -destination=./apps/statistics/internal/infrastructure/repository/tier_statistic_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierStatisticQuerier is a mock of TierStatisticQuerier interface.
+type MockTierStatisticQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierStatisticQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierStatisticQuerierMockRecorder is the mock recorder for MockTierStatisticQuerier.
+type MockTierStatisticQuerierMockRecorder struct {
+	mock *MockTierStatisticQuerier
+}
+
+// NewMockTierStatisticQuerier creates a new mock instance.
+func NewMockTierStatisticQuerier(ctrl *gomock.Controller) *MockTierStatisticQuerier {
+	mock := &MockTierStatisticQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierStatisticQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierStatisticQuerier) EXPECT() *MockTierStatisticQuerierMockRecorder {
+	return m.recorder
+}
+
+// DeleteTierStatistics mocks base method.
+func (m *MockTierStatisticQuerier) DeleteTierStatistics(ctx context.Context, seasonID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTierStatistics", ctx, seasonID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTierStatistics indicates an expected call of DeleteTierStatistics.
+func (mr *MockTierStatisticQuerierMockRecorder) DeleteTierStatistics(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTierStatistics", reflect.TypeOf((*MockTierStatisticQuerier)(nil).DeleteTierStatistics), ctx, seasonID)
+}
+
+// ListRecomputedTierStatistics mocks base method.
+func (m *MockTierStatisticQuerier) ListRecomputedTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]db.ListRecomputedTierStatisticsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecomputedTierStatistics", ctx, seasonID)
+	ret0, _ := ret[0].([]db.ListRecomputedTierStatisticsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecomputedTierStatistics indicates an expected call of ListRecomputedTierStatistics.
+func (mr *MockTierStatisticQuerierMockRecorder) ListRecomputedTierStatistics(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecomputedTierStatistics", reflect.TypeOf((*MockTierStatisticQuerier)(nil).ListRecomputedTierStatistics), ctx, seasonID)
+}
+
+// ListTierStatistics mocks base method.
+func (m *MockTierStatisticQuerier) ListTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]db.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierStatistics", ctx, seasonID)
+	ret0, _ := ret[0].([]db.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierStatistics indicates an expected call of ListTierStatistics.
+func (mr *MockTierStatisticQuerierMockRecorder) ListTierStatistics(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierStatistics", reflect.TypeOf((*MockTierStatisticQuerier)(nil).ListTierStatistics), ctx, seasonID)
+}
+
+// ListTierStatisticsBySeason mocks base method.
+func (m *MockTierStatisticQuerier) ListTierStatisticsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.TierStatistic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierStatisticsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]db.TierStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierStatisticsBySeason indicates an expected call of ListTierStatisticsBySeason.
+func (mr *MockTierStatisticQuerierMockRecorder) ListTierStatisticsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierStatisticsBySeason", reflect.TypeOf((*MockTierStatisticQuerier)(nil).ListTierStatisticsBySeason), ctx, seasonID)
+}
+
+// LockTierStatistics mocks base method.
+func (m *MockTierStatisticQuerier) LockTierStatistics(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTierStatistics", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTierStatistics indicates an expected call of LockTierStatistics.
+func (mr *MockTierStatisticQuerierMockRecorder) LockTierStatistics(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTierStatistics", reflect.TypeOf((*MockTierStatisticQuerier)(nil).LockTierStatistics), ctx)
+}
+
+// RebuildTierStatistics mocks base method.
+func (m *MockTierStatisticQuerier) RebuildTierStatistics(ctx context.Context, seasonID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildTierStatistics", ctx, seasonID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildTierStatistics indicates an expected call of RebuildTierStatistics.
+func (mr *MockTierStatisticQuerierMockRecorder) RebuildTierStatistics(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildTierStatistics", reflect.TypeOf((*MockTierStatisticQuerier)(nil).RebuildTierStatistics), ctx, seasonID)
+}
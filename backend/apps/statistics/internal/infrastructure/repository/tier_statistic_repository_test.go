@@ -0,0 +1,219 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestTierStatisticRepository_FindBySeason(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierStatisticQuerier)
+		want        []entity.TierStatistic
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズンの統計が取得できる事",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().ListTierStatisticsBySeason(gomock.Any(), pgSeasonID).Return([]db.TierStatistic{
+					{
+						DeckID:                pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						SeasonID:              pgSeasonID,
+						RankSum:               20,
+						PlacementCount:        3,
+						WeightedRankSum:       13.5,
+						WeightSum:             2.25,
+						WeightedRankSquareSum: 81,
+						WeightSquareSum:       1.6875,
+					},
+				}, nil)
+			},
+			want: []entity.TierStatistic{
+				{DeckID: deckID, SeasonID: seasonID, RankSum: 20, PlacementCount: 3, WeightedRankSum: 13.5, WeightSum: 2.25, WeightedRankSquareSum: 81, WeightSquareSum: 1.6875},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().ListTierStatisticsBySeason(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierStatisticQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierStatisticRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "statistics do not match")
+		})
+	}
+}
+
+func TestTierStatisticRepository_Recompute(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+
+	tests := []struct {
+		caseName    string
+		seasonID    *id.SeasonID
+		setupMock   func(mockQuerier *MockTierStatisticQuerier)
+		want        []entity.TierStatistic
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズン未指定の場合はNULLで全シーズンを再計算する事",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().ListRecomputedTierStatistics(gomock.Any(), pgtype.UUID{}).Return([]db.ListRecomputedTierStatisticsRow{
+					{
+						DeckID:                pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						SeasonID:              pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+						RankSum:               7,
+						PlacementCount:        1,
+						WeightedRankSum:       7,
+						WeightSum:             1,
+						WeightedRankSquareSum: 49,
+						WeightSquareSum:       1,
+					},
+				}, nil)
+			},
+			want: []entity.TierStatistic{
+				{DeckID: deckID, SeasonID: seasonID, RankSum: 7, PlacementCount: 1, WeightedRankSum: 7, WeightSum: 1, WeightedRankSquareSum: 49, WeightSquareSum: 1},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			seasonID: &seasonID,
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().ListRecomputedTierStatistics(gomock.Any(), pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierStatisticQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierStatisticRepository(mockQuerier)
+
+			// Act
+			got, err := repo.Recompute(context.Background(), tt.seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "statistics do not match")
+		})
+	}
+}
+
+func TestTierStatisticRepository_Rebuild(t *testing.T) {
+	t.Parallel()
+
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierStatisticQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 統計のテーブルをロックし、統計を削除してから配置から作り直す事",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().LockTierStatistics(gomock.Any()).Return(nil),
+					mockQuerier.EXPECT().DeleteTierStatistics(gomock.Any(), pgSeasonID).Return(nil),
+					mockQuerier.EXPECT().RebuildTierStatistics(gomock.Any(), pgSeasonID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: ロックの取得でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().LockTierStatistics(gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 削除でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().LockTierStatistics(gomock.Any()).Return(nil)
+				mockQuerier.EXPECT().DeleteTierStatistics(gomock.Any(), pgSeasonID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 再作成でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierStatisticQuerier) {
+				mockQuerier.EXPECT().LockTierStatistics(gomock.Any()).Return(nil)
+				mockQuerier.EXPECT().DeleteTierStatistics(gomock.Any(), pgSeasonID).Return(nil)
+				mockQuerier.EXPECT().RebuildTierStatistics(gomock.Any(), pgSeasonID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierStatisticQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierStatisticRepository(mockQuerier)
+
+			// Act
+			err := repo.Rebuild(context.Background(), &seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
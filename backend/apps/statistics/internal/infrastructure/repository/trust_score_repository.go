@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// TrustScoreQuerier はデータベースクエリを定義するインターフェース
+type TrustScoreQuerier interface {
+	ListTierListAuthorsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.ListTierListAuthorsBySeasonRow, error)
+	GetTierListTrustWeightForUpdate(ctx context.Context, tierListID pgtype.UUID) (float64, error)
+	SaveTierListTrustScore(ctx context.Context, arg db.SaveTierListTrustScoreParams) error
+	ListFlaggedTierLists(ctx context.Context, arg db.ListFlaggedTierListsParams) ([]db.ListFlaggedTierListsRow, error)
+	AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error
+	SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error
+}
+
+// TrustScoreRepository はティアリストの信頼度のリポジトリ
+type TrustScoreRepository struct {
+	queries TrustScoreQuerier
+}
+
+// NewTrustScoreRepository は新しいTrustScoreRepositoryを作成
+func NewTrustScoreRepository(queries TrustScoreQuerier) *TrustScoreRepository {
+	return &TrustScoreRepository{
+		queries: queries,
+	}
+}
+
+// FindAuthorsBySeason は指定したシーズンのティアリストの投稿者情報を取得
+func (r *TrustScoreRepository) FindAuthorsBySeason(ctx context.Context, seasonID id.SeasonID) ([]entity.TierListAuthor, error) {
+	rows, err := r.queries.ListTierListAuthorsBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier list authors by season: %w", err)
+	}
+
+	authors := make([]entity.TierListAuthor, 0, len(rows))
+	for _, row := range rows {
+		author := entity.TierListAuthor{
+			TierListID: id.TierListIDFromUUID(row.TierListID.Bytes),
+			AuthorName: row.AuthorName,
+			AuthorIP:   row.AuthorIp,
+			CreatedAt:  row.CreatedAt.Time,
+		}
+		if row.AuthorUserID.Valid {
+			authorUserID := id.UserIDFromUUID(row.AuthorUserID.Bytes)
+			author.AuthorUserID = &authorUserID
+		}
+		authors = append(authors, author)
+	}
+	return authors, nil
+}
+
+// FindWeightForUpdate はティアリストの行をロックし、保存済みの信頼度の重みを取得（評価前のティアリストは重み1）
+// ロックはトランザクションの終了まで保持されるため、トランザクション内で呼び出す
+func (r *TrustScoreRepository) FindWeightForUpdate(ctx context.Context, tierListID id.TierListID) (float64, error) {
+	weight, err := r.queries.GetTierListTrustWeightForUpdate(ctx, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errs.NewNotFoundError("tier list not found", err)
+		}
+		return 0, fmt.Errorf("failed to get tier list trust weight: %w", err)
+	}
+	return weight, nil
+}
+
+// Save は信頼度を保存する（ティア統計には反映しない）
+func (r *TrustScoreRepository) Save(ctx context.Context, score entity.TrustScore) error {
+	if err := r.queries.SaveTierListTrustScore(ctx, r.toSaveParams(score)); err != nil {
+		return fmt.Errorf("failed to save tier list trust score: %w", err)
+	}
+	return nil
+}
+
+// SaveAndReweight は信頼度を保存し、ティア統計の重み付きの累計に反映する
+// 変更前の信頼度で統計から減算し、保存後の信頼度で加算し直すため、トランザクション内で呼び出す
+func (r *TrustScoreRepository) SaveAndReweight(ctx context.Context, score entity.TrustScore) error {
+	pgID := pgtype.UUID{Bytes: score.TierListID.UUID(), Valid: true}
+
+	if err := r.queries.SubtractTierListFromStatistics(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to subtract tier statistics: %w", err)
+	}
+
+	if err := r.Save(ctx, score); err != nil {
+		return err
+	}
+
+	if err := r.queries.AddTierListToStatistics(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to add tier statistics: %w", err)
+	}
+
+	return nil
+}
+
+// FindFlagged はフラグ付きのティアリストを信頼度の低い順で取得（seasonID がnilの場合は全シーズン）
+func (r *TrustScoreRepository) FindFlagged(ctx context.Context, seasonID *id.SeasonID, limit int) ([]entity.FlaggedTierList, error) {
+	rows, err := r.queries.ListFlaggedTierLists(ctx, db.ListFlaggedTierListsParams{
+		SeasonID:  toSeasonUUID(seasonID),
+		PageLimit: int32(limit), // #nosec G115 -- 取得件数はリクエストで上限を検証済み
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flagged tier lists: %w", err)
+	}
+
+	flagged := make([]entity.FlaggedTierList, 0, len(rows))
+	for _, row := range rows {
+		flagged = append(flagged, entity.FlaggedTierList{
+			Score: r.toEntity(db.TierListTrustScore{
+				TierListID:            row.TierListID,
+				SeasonID:              row.SeasonID,
+				TrustWeight:           row.TrustWeight,
+				RankDistance:          row.RankDistance,
+				ZScore:                row.ZScore,
+				DuplicateOfTierListID: row.DuplicateOfTierListID,
+				FlagReasons:           row.FlagReasons,
+			}),
+			Title:       row.Title,
+			AuthorName:  row.AuthorName,
+			AuthorIP:    row.AuthorIp,
+			EvaluatedAt: row.EvaluatedAt.Time,
+		})
+	}
+	return flagged, nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *TrustScoreRepository) toEntity(row db.TierListTrustScore) entity.TrustScore {
+	score := entity.TrustScore{
+		TierListID:   id.TierListIDFromUUID(row.TierListID.Bytes),
+		SeasonID:     id.SeasonIDFromUUID(row.SeasonID.Bytes),
+		TrustWeight:  row.TrustWeight,
+		RankDistance: row.RankDistance,
+		ZScore:       row.ZScore,
+		FlagReasons:  make([]entity.FlagReason, 0, len(row.FlagReasons)),
+	}
+	if row.DuplicateOfTierListID.Valid {
+		duplicateOf := id.TierListIDFromUUID(row.DuplicateOfTierListID.Bytes)
+		score.DuplicateOf = &duplicateOf
+	}
+	for _, reason := range row.FlagReasons {
+		score.FlagReasons = append(score.FlagReasons, entity.FlagReason(reason))
+	}
+	return score
+}
+
+// toSaveParams はエンティティから保存用のパラメータに変換
+func (r *TrustScoreRepository) toSaveParams(score entity.TrustScore) db.SaveTierListTrustScoreParams {
+	params := db.SaveTierListTrustScoreParams{
+		TierListID:   pgtype.UUID{Bytes: score.TierListID.UUID(), Valid: true},
+		SeasonID:     pgtype.UUID{Bytes: score.SeasonID.UUID(), Valid: true},
+		TrustWeight:  score.TrustWeight,
+		RankDistance: score.RankDistance,
+		ZScore:       score.ZScore,
+		FlagReasons:  make([]string, 0, len(score.FlagReasons)),
+	}
+	if score.DuplicateOf != nil {
+		params.DuplicateOfTierListID = pgtype.UUID{Bytes: score.DuplicateOf.UUID(), Valid: true}
+	}
+	for _, reason := range score.FlagReasons {
+		params.FlagReasons = append(params.FlagReasons, string(reason))
+	}
+	return params
+}
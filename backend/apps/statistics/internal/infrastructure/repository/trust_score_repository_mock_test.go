@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/infrastructure/repository/trust_score_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/infrastructure/repository/trust_score_repository.go -destination=./apps/statistics/internal/infrastructure/repository/trust_score_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrustScoreQuerier is a mock of TrustScoreQuerier interface.
+type MockTrustScoreQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrustScoreQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTrustScoreQuerierMockRecorder is the mock recorder for MockTrustScoreQuerier.
+type MockTrustScoreQuerierMockRecorder struct {
+	mock *MockTrustScoreQuerier
+}
+
+// NewMockTrustScoreQuerier creates a new mock instance.
+func NewMockTrustScoreQuerier(ctrl *gomock.Controller) *MockTrustScoreQuerier {
+	mock := &MockTrustScoreQuerier{ctrl: ctrl}
+	mock.recorder = &MockTrustScoreQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrustScoreQuerier) EXPECT() *MockTrustScoreQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddTierListToStatistics mocks base method.
+func (m *MockTrustScoreQuerier) AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListToStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTierListToStatistics indicates an expected call of AddTierListToStatistics.
+func (mr *MockTrustScoreQuerierMockRecorder) AddTierListToStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListToStatistics", reflect.TypeOf((*MockTrustScoreQuerier)(nil).AddTierListToStatistics), ctx, tierListID)
+}
+
+// GetTierListTrustWeightForUpdate mocks base method.
+func (m *MockTrustScoreQuerier) GetTierListTrustWeightForUpdate(ctx context.Context, tierListID pgtype.UUID) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierListTrustWeightForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierListTrustWeightForUpdate indicates an expected call of GetTierListTrustWeightForUpdate.
+func (mr *MockTrustScoreQuerierMockRecorder) GetTierListTrustWeightForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierListTrustWeightForUpdate", reflect.TypeOf((*MockTrustScoreQuerier)(nil).GetTierListTrustWeightForUpdate), ctx, tierListID)
+}
+
+// ListFlaggedTierLists mocks base method.
+func (m *MockTrustScoreQuerier) ListFlaggedTierLists(ctx context.Context, arg db.ListFlaggedTierListsParams) ([]db.ListFlaggedTierListsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlaggedTierLists", ctx, arg)
+	ret0, _ := ret[0].([]db.ListFlaggedTierListsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlaggedTierLists indicates an expected call of ListFlaggedTierLists.
+func (mr *MockTrustScoreQuerierMockRecorder) ListFlaggedTierLists(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlaggedTierLists", reflect.TypeOf((*MockTrustScoreQuerier)(nil).ListFlaggedTierLists), ctx, arg)
+}
+
+// ListTierListAuthorsBySeason mocks base method.
+func (m *MockTrustScoreQuerier) ListTierListAuthorsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.ListTierListAuthorsBySeasonRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListAuthorsBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]db.ListTierListAuthorsBySeasonRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListAuthorsBySeason indicates an expected call of ListTierListAuthorsBySeason.
+func (mr *MockTrustScoreQuerierMockRecorder) ListTierListAuthorsBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListAuthorsBySeason", reflect.TypeOf((*MockTrustScoreQuerier)(nil).ListTierListAuthorsBySeason), ctx, seasonID)
+}
+
+// SaveTierListTrustScore mocks base method.
+func (m *MockTrustScoreQuerier) SaveTierListTrustScore(ctx context.Context, arg db.SaveTierListTrustScoreParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTierListTrustScore", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTierListTrustScore indicates an expected call of SaveTierListTrustScore.
+func (mr *MockTrustScoreQuerierMockRecorder) SaveTierListTrustScore(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTierListTrustScore", reflect.TypeOf((*MockTrustScoreQuerier)(nil).SaveTierListTrustScore), ctx, arg)
+}
+
+// SubtractTierListFromStatistics mocks base method.
+func (m *MockTrustScoreQuerier) SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubtractTierListFromStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubtractTierListFromStatistics indicates an expected call of SubtractTierListFromStatistics.
+func (mr *MockTrustScoreQuerierMockRecorder) SubtractTierListFromStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubtractTierListFromStatistics", reflect.TypeOf((*MockTrustScoreQuerier)(nil).SubtractTierListFromStatistics), ctx, tierListID)
+}
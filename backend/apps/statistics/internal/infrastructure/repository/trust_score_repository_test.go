@@ -0,0 +1,329 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/statistics/internal/domain/entity"
+	"poketier/apps/statistics/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestTrustScoreRepository_FindAuthorsBySeason(t *testing.T) {
+	t.Parallel()
+
+	tierListID, userTierListID := id.NewTierListID(), id.NewTierListID()
+	authorUserID := id.NewUserID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+	createdAt := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTrustScoreQuerier)
+		want        []entity.TierListAuthor
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズンのティアリストの投稿者情報が、ログイン中に作成された場合は作成者のユーザーIDとともに取得できる事",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().ListTierListAuthorsBySeason(gomock.Any(), pgSeasonID).Return([]db.ListTierListAuthorsBySeasonRow{
+					{
+						TierListID: pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+						AuthorName: "配信者A",
+						AuthorIp:   "198.51.100.7",
+						CreatedAt:  pgtype.Timestamptz{Time: createdAt, Valid: true},
+					},
+					{
+						TierListID:   pgtype.UUID{Bytes: userTierListID.UUID(), Valid: true},
+						AuthorUserID: pgtype.UUID{Bytes: authorUserID.UUID(), Valid: true},
+						AuthorName:   "配信者B",
+						AuthorIp:     "203.0.113.9",
+						CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+					},
+				}, nil)
+			},
+			want: []entity.TierListAuthor{
+				{TierListID: tierListID, AuthorName: "配信者A", AuthorIP: "198.51.100.7", CreatedAt: createdAt},
+				{TierListID: userTierListID, AuthorUserID: &authorUserID, AuthorName: "配信者B", AuthorIP: "203.0.113.9", CreatedAt: createdAt},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().ListTierListAuthorsBySeason(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTrustScoreQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTrustScoreRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindAuthorsBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "authors do not match")
+		})
+	}
+}
+
+func TestTrustScoreRepository_FindWeightForUpdate(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTrustScoreQuerier)
+		want         float64
+		expectError  bool
+		wantNotFound bool
+	}{
+		{
+			caseName: "正常系: 保存済みの信頼度の重みを取得できる事",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().GetTierListTrustWeightForUpdate(gomock.Any(), pgTierListID).Return(0.25, nil)
+			},
+			want: 0.25,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す事",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().GetTierListTrustWeightForUpdate(gomock.Any(), pgTierListID).Return(float64(0), pgx.ErrNoRows)
+			},
+			expectError:  true,
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().GetTierListTrustWeightForUpdate(gomock.Any(), pgTierListID).Return(float64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTrustScoreQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTrustScoreRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindWeightForUpdate(context.Background(), tierListID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantNotFound, errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound, "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "trust weight does not match")
+		})
+	}
+}
+
+func TestTrustScoreRepository_SaveAndReweight(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	pgID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+	score := entity.TrustScore{
+		TierListID:   tierListID,
+		SeasonID:     seasonID,
+		TrustWeight:  0.25,
+		RankDistance: 3.9,
+		ZScore:       4,
+		FlagReasons:  []entity.FlagReason{entity.FlagReasonOutlier},
+	}
+	wantParams := db.SaveTierListTrustScoreParams{
+		TierListID:   pgID,
+		SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		TrustWeight:  0.25,
+		RankDistance: 3.9,
+		ZScore:       4,
+		FlagReasons:  []string{"outlier"},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTrustScoreQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 変更前の信頼度で統計から減算し、保存後の信頼度で加算する事",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(nil),
+					mockQuerier.EXPECT().SaveTierListTrustScore(gomock.Any(), wantParams).Return(nil),
+					mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), pgID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: 統計の減算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 信頼度の保存でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(nil)
+				mockQuerier.EXPECT().SaveTierListTrustScore(gomock.Any(), wantParams).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 統計の加算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgID).Return(nil)
+				mockQuerier.EXPECT().SaveTierListTrustScore(gomock.Any(), wantParams).Return(nil)
+				mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), pgID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTrustScoreQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTrustScoreRepository(mockQuerier)
+
+			// Act
+			err := repo.SaveAndReweight(context.Background(), score)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTrustScoreRepository_FindFlagged(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	evaluatedAt := time.Date(2025, 8, 2, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		seasonID    *id.SeasonID
+		setupMock   func(mockQuerier *MockTrustScoreQuerier)
+		want        []entity.FlaggedTierList
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズン未指定の場合はNULLで全シーズンのフラグ付きティアリストを取得する事",
+			seasonID: nil,
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().ListFlaggedTierLists(gomock.Any(), db.ListFlaggedTierListsParams{PageLimit: 50}).Return([]db.ListFlaggedTierListsRow{
+					{
+						TierListID:   pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+						SeasonID:     pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+						TrustWeight:  0.25,
+						RankDistance: 3.9,
+						ZScore:       4,
+						FlagReasons:  []string{"outlier"},
+						EvaluatedAt:  pgtype.Timestamptz{Time: evaluatedAt, Valid: true},
+						Title:        "荒らしのティアリスト",
+						AuthorName:   "荒らし",
+						AuthorIp:     "198.51.100.7",
+					},
+				}, nil)
+			},
+			want: []entity.FlaggedTierList{
+				{
+					Score: entity.TrustScore{
+						TierListID:   tierListID,
+						SeasonID:     seasonID,
+						TrustWeight:  0.25,
+						RankDistance: 3.9,
+						ZScore:       4,
+						FlagReasons:  []entity.FlagReason{entity.FlagReasonOutlier},
+					},
+					Title:       "荒らしのティアリスト",
+					AuthorName:  "荒らし",
+					AuthorIP:    "198.51.100.7",
+					EvaluatedAt: evaluatedAt,
+				},
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			seasonID: &seasonID,
+			setupMock: func(mockQuerier *MockTrustScoreQuerier) {
+				mockQuerier.EXPECT().ListFlaggedTierLists(gomock.Any(), db.ListFlaggedTierListsParams{
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 50,
+				}).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTrustScoreQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTrustScoreRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindFlagged(context.Background(), tt.seasonID, 50)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "flagged tier lists do not match")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+// ErrTierStatisticsMismatch は整合性チェックで不一致が見つかった場合のエラー
+var ErrTierStatisticsMismatch = errors.New("tier statistics mismatch found")
+
+const tierStatisticsUsage = `usage: statistics <command> [-season <season_id>]
+
+commands:
+  rebuild         ティア統計を配置から作り直す
+  check           差分更新されたティア統計を配置からの再計算結果と比較する
+  evaluate-trust  ティアリストの信頼度を評価し、ティア統計の重みに反映する`
+
+type TierStatisticsCommand struct {
+	rebuildUC       RebuildTierStatisticsUseCase
+	checkUC         CheckTierStatisticsUseCase
+	evaluateTrustUC EvaluateTierListTrustUseCase
+}
+
+type RebuildTierStatisticsUseCase interface {
+	Execute(ctx context.Context, params usecase.RebuildTierStatisticsParams) error
+}
+
+type CheckTierStatisticsUseCase interface {
+	Execute(ctx context.Context, params usecase.CheckTierStatisticsParams) (*usecase.CheckTierStatisticsResult, error)
+}
+
+type EvaluateTierListTrustUseCase interface {
+	Execute(ctx context.Context, params usecase.EvaluateTierListTrustParams) (*usecase.EvaluateTierListTrustResult, error)
+}
+
+func NewTierStatisticsCommand(
+	rebuildUC RebuildTierStatisticsUseCase,
+	checkUC CheckTierStatisticsUseCase,
+	evaluateTrustUC EvaluateTierListTrustUseCase,
+) *TierStatisticsCommand {
+	return &TierStatisticsCommand{
+		rebuildUC:       rebuildUC,
+		checkUC:         checkUC,
+		evaluateTrustUC: evaluateTrustUC,
+	}
+}
+
+// Run はサブコマンドを実行し、結果を out に出力する
+func (c *TierStatisticsCommand) Run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(tierStatisticsUsage)
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	flags.SetOutput(out)
+	seasonID := flags.String("season", "", "対象のシーズンID（省略した場合は全シーズン）")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "rebuild":
+		return c.rebuild(ctx, *seasonID, out)
+	case "check":
+		return c.check(ctx, *seasonID, out)
+	case "evaluate-trust":
+		return c.evaluateTrust(ctx, *seasonID, out)
+	}
+	return fmt.Errorf("unknown command: %s\n%s", args[0], tierStatisticsUsage)
+}
+
+// rebuild はティア統計を作り直す
+func (c *TierStatisticsCommand) rebuild(ctx context.Context, seasonID string, out io.Writer) error {
+	if err := c.rebuildUC.Execute(ctx, usecase.RebuildTierStatisticsParams{SeasonID: seasonID}); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(out, "rebuilt tier statistics")
+	return err
+}
+
+// check は整合性チェックを行い、不一致があれば一覧を出力して ErrTierStatisticsMismatch を返す
+func (c *TierStatisticsCommand) check(ctx context.Context, seasonID string, out io.Writer) error {
+	result, err := c.checkUC.Execute(ctx, usecase.CheckTierStatisticsParams{SeasonID: seasonID})
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(out, "checked %d tier statistics, %d mismatches\n", result.CheckedCount, len(result.Mismatches)); err != nil {
+		return err
+	}
+	for _, m := range result.Mismatches {
+		if _, err := fmt.Fprintf(out, "season=%s deck=%s incremental(rank_sum=%d placement_count=%d weighted_rank_sum=%g weight_sum=%g weighted_rank_square_sum=%g weight_square_sum=%g) recomputed(rank_sum=%d placement_count=%d weighted_rank_sum=%g weight_sum=%g weighted_rank_square_sum=%g weight_square_sum=%g)\n",
+			m.SeasonID, m.DeckID,
+			m.IncrementalRankSum, m.IncrementalPlacementCount, m.IncrementalWeightedRankSum, m.IncrementalWeightSum, m.IncrementalWeightedRankSquareSum, m.IncrementalWeightSquareSum,
+			m.RecomputedRankSum, m.RecomputedPlacementCount, m.RecomputedWeightedRankSum, m.RecomputedWeightSum, m.RecomputedWeightedRankSquareSum, m.RecomputedWeightSquareSum,
+		); err != nil {
+			return err
+		}
+	}
+
+	if len(result.Mismatches) > 0 {
+		return ErrTierStatisticsMismatch
+	}
+	return nil
+}
+
+// evaluateTrust はティアリストの信頼度を評価し、評価件数を出力する
+func (c *TierStatisticsCommand) evaluateTrust(ctx context.Context, seasonID string, out io.Writer) error {
+	result, err := c.evaluateTrustUC.Execute(ctx, usecase.EvaluateTierListTrustParams{SeasonID: seasonID})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "evaluated %d tier lists, %d flagged, %d reweighted\n", result.EvaluatedCount, result.FlaggedCount, result.ReweightedCount)
+	return err
+}
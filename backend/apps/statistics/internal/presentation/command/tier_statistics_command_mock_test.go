@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/command/tier_statistics_command.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/command/tier_statistics_command.go -destination=./apps/statistics/internal/presentation/command/tier_statistics_command_mock_test.go -package=command_test
+//
+
+// Package command_test is a generated GoMock package.
+package command_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRebuildTierStatisticsUseCase is a mock of RebuildTierStatisticsUseCase interface.
+type MockRebuildTierStatisticsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRebuildTierStatisticsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRebuildTierStatisticsUseCaseMockRecorder is the mock recorder for MockRebuildTierStatisticsUseCase.
+type MockRebuildTierStatisticsUseCaseMockRecorder struct {
+	mock *MockRebuildTierStatisticsUseCase
+}
+
+// NewMockRebuildTierStatisticsUseCase creates a new mock instance.
+func NewMockRebuildTierStatisticsUseCase(ctrl *gomock.Controller) *MockRebuildTierStatisticsUseCase {
+	mock := &MockRebuildTierStatisticsUseCase{ctrl: ctrl}
+	mock.recorder = &MockRebuildTierStatisticsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRebuildTierStatisticsUseCase) EXPECT() *MockRebuildTierStatisticsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRebuildTierStatisticsUseCase) Execute(ctx context.Context, params usecase.RebuildTierStatisticsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRebuildTierStatisticsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRebuildTierStatisticsUseCase)(nil).Execute), ctx, params)
+}
+
+// MockCheckTierStatisticsUseCase is a mock of CheckTierStatisticsUseCase interface.
+type MockCheckTierStatisticsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckTierStatisticsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckTierStatisticsUseCaseMockRecorder is the mock recorder for MockCheckTierStatisticsUseCase.
+type MockCheckTierStatisticsUseCaseMockRecorder struct {
+	mock *MockCheckTierStatisticsUseCase
+}
+
+// NewMockCheckTierStatisticsUseCase creates a new mock instance.
+func NewMockCheckTierStatisticsUseCase(ctrl *gomock.Controller) *MockCheckTierStatisticsUseCase {
+	mock := &MockCheckTierStatisticsUseCase{ctrl: ctrl}
+	mock.recorder = &MockCheckTierStatisticsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckTierStatisticsUseCase) EXPECT() *MockCheckTierStatisticsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCheckTierStatisticsUseCase) Execute(ctx context.Context, params usecase.CheckTierStatisticsParams) (*usecase.CheckTierStatisticsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.CheckTierStatisticsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCheckTierStatisticsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCheckTierStatisticsUseCase)(nil).Execute), ctx, params)
+}
+
+// MockEvaluateTierListTrustUseCase is a mock of EvaluateTierListTrustUseCase interface.
+type MockEvaluateTierListTrustUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluateTierListTrustUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEvaluateTierListTrustUseCaseMockRecorder is the mock recorder for MockEvaluateTierListTrustUseCase.
+type MockEvaluateTierListTrustUseCaseMockRecorder struct {
+	mock *MockEvaluateTierListTrustUseCase
+}
+
+// NewMockEvaluateTierListTrustUseCase creates a new mock instance.
+func NewMockEvaluateTierListTrustUseCase(ctrl *gomock.Controller) *MockEvaluateTierListTrustUseCase {
+	mock := &MockEvaluateTierListTrustUseCase{ctrl: ctrl}
+	mock.recorder = &MockEvaluateTierListTrustUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluateTierListTrustUseCase) EXPECT() *MockEvaluateTierListTrustUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEvaluateTierListTrustUseCase) Execute(ctx context.Context, params usecase.EvaluateTierListTrustParams) (*usecase.EvaluateTierListTrustResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.EvaluateTierListTrustResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEvaluateTierListTrustUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEvaluateTierListTrustUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,145 @@
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/command"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTierStatisticsCommand_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName       string
+		args           []string
+		mockSetup      func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase)
+		expectedOutput string
+		expectedErr    error
+		wantErr        bool
+	}{
+		{
+			caseName: "正常系: rebuildでシーズンが指定された場合、ユースケースに渡る",
+			args:     []string{"rebuild", "-season", "season-1"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+				rebuildUC.EXPECT().Execute(gomock.Any(), usecase.RebuildTierStatisticsParams{SeasonID: "season-1"}).Return(nil)
+			},
+			expectedOutput: "rebuilt tier statistics\n",
+		},
+		{
+			caseName: "正常系: checkで不一致がない場合、件数のみ出力される",
+			args:     []string{"check"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+				checkUC.EXPECT().Execute(gomock.Any(), usecase.CheckTierStatisticsParams{}).Return(&usecase.CheckTierStatisticsResult{
+					CheckedCount: 3,
+					Mismatches:   []usecase.CTSMismatch{},
+				}, nil)
+			},
+			expectedOutput: "checked 3 tier statistics, 0 mismatches\n",
+		},
+		{
+			caseName: "正常系: evaluate-trustで評価件数が出力される",
+			args:     []string{"evaluate-trust", "-season", "season-1"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+				evaluateTrustUC.EXPECT().Execute(gomock.Any(), usecase.EvaluateTierListTrustParams{SeasonID: "season-1"}).Return(&usecase.EvaluateTierListTrustResult{
+					EvaluatedCount:  10,
+					FlaggedCount:    2,
+					ReweightedCount: 3,
+				}, nil)
+			},
+			expectedOutput: "evaluated 10 tier lists, 2 flagged, 3 reweighted\n",
+		},
+		{
+			caseName: "異常系: checkで不一致がある場合、不一致を出力してエラーを返す",
+			args:     []string{"check"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+				checkUC.EXPECT().Execute(gomock.Any(), usecase.CheckTierStatisticsParams{}).Return(&usecase.CheckTierStatisticsResult{
+					CheckedCount: 3,
+					Mismatches: []usecase.CTSMismatch{
+						{
+							DeckID:                           "deck-1",
+							SeasonID:                         "season-1",
+							IncrementalRankSum:               9,
+							IncrementalPlacementCount:        2,
+							IncrementalWeightedRankSum:       9,
+							IncrementalWeightSum:             2,
+							IncrementalWeightedRankSquareSum: 41,
+							IncrementalWeightSquareSum:       2,
+							RecomputedRankSum:                8,
+							RecomputedPlacementCount:         2,
+							RecomputedWeightedRankSum:        5.5,
+							RecomputedWeightSum:              1.5,
+							RecomputedWeightedRankSquareSum:  23,
+							RecomputedWeightSquareSum:        1.25,
+						},
+					},
+				}, nil)
+			},
+			expectedOutput: "checked 3 tier statistics, 1 mismatches\n" +
+				"season=season-1 deck=deck-1 incremental(rank_sum=9 placement_count=2 weighted_rank_sum=9 weight_sum=2 weighted_rank_square_sum=41 weight_square_sum=2) recomputed(rank_sum=8 placement_count=2 weighted_rank_sum=5.5 weight_sum=1.5 weighted_rank_square_sum=23 weight_square_sum=1.25)\n",
+			expectedErr: command.ErrTierStatisticsMismatch,
+			wantErr:     true,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、エラーを返す",
+			args:     []string{"rebuild"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+				rebuildUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "異常系: 未定義のコマンドの場合、エラーを返す",
+			args:     []string{"repair"},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "異常系: コマンドが指定されない場合、エラーを返す",
+			args:     []string{},
+			mockSetup: func(rebuildUC *MockRebuildTierStatisticsUseCase, checkUC *MockCheckTierStatisticsUseCase, evaluateTrustUC *MockEvaluateTierListTrustUseCase) {
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rebuildUC := NewMockRebuildTierStatisticsUseCase(ctrl)
+			checkUC := NewMockCheckTierStatisticsUseCase(ctrl)
+			evaluateTrustUC := NewMockEvaluateTierListTrustUseCase(ctrl)
+			tt.mockSetup(rebuildUC, checkUC, evaluateTrustUC)
+
+			cmd := command.NewTierStatisticsCommand(rebuildUC, checkUC, evaluateTrustUC)
+			var out bytes.Buffer
+
+			// Act
+			err := cmd.Run(context.Background(), tt.args, &out)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr, "error should match expected")
+				}
+			} else {
+				assert.NoError(t, err, "unexpected error occurred")
+			}
+			if tt.expectedOutput != "" {
+				assert.Equal(t, tt.expectedOutput, out.String(), "output should match expected")
+			}
+		})
+	}
+}
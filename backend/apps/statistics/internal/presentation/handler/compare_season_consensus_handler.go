@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompareSeasonConsensusHandler struct {
+	uc CompareSeasonConsensusUseCase
+}
+
+type CompareSeasonConsensusUseCase interface {
+	Execute(ctx context.Context, params usecase.CompareSeasonConsensusParams) (*usecase.CompareSeasonConsensusResult, error)
+}
+
+func NewCompareSeasonConsensusHandler(uc CompareSeasonConsensusUseCase) *CompareSeasonConsensusHandler {
+	return &CompareSeasonConsensusHandler{
+		uc: uc,
+	}
+}
+
+func (h *CompareSeasonConsensusHandler) Handle(ctx *gin.Context) {
+	var req request.CompareSeasonConsensusRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.CompareSeasonConsensusParams{
+		FromSeasonID:      req.FromSeasonID,
+		ToSeasonID:        req.ToSeasonID,
+		Method:            req.Method,
+		MinPlacementCount: req.MinPlacementCount,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewCompareSeasonConsensusResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/compare_season_consensus_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/compare_season_consensus_handler.go -destination=./apps/statistics/internal/presentation/handler/compare_season_consensus_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompareSeasonConsensusUseCase is a mock of CompareSeasonConsensusUseCase interface.
+type MockCompareSeasonConsensusUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompareSeasonConsensusUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCompareSeasonConsensusUseCaseMockRecorder is the mock recorder for MockCompareSeasonConsensusUseCase.
+type MockCompareSeasonConsensusUseCaseMockRecorder struct {
+	mock *MockCompareSeasonConsensusUseCase
+}
+
+// NewMockCompareSeasonConsensusUseCase creates a new mock instance.
+func NewMockCompareSeasonConsensusUseCase(ctrl *gomock.Controller) *MockCompareSeasonConsensusUseCase {
+	mock := &MockCompareSeasonConsensusUseCase{ctrl: ctrl}
+	mock.recorder = &MockCompareSeasonConsensusUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompareSeasonConsensusUseCase) EXPECT() *MockCompareSeasonConsensusUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCompareSeasonConsensusUseCase) Execute(ctx context.Context, params usecase.CompareSeasonConsensusParams) (*usecase.CompareSeasonConsensusResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.CompareSeasonConsensusResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCompareSeasonConsensusUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCompareSeasonConsensusUseCase)(nil).Execute), ctx, params)
+}
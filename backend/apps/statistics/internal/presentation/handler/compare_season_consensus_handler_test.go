@@ -0,0 +1,164 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompareSeasonConsensusHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockCompareSeasonConsensusUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: クエリパラメータがユースケースに渡り、シーズン間の比較結果が返される",
+			target:   "/statistics/season-comparison?from_season_id=season-1&to_season_id=season-2&method=borda&min_placement_count=5",
+			mockSetup: func(mockUC *MockCompareSeasonConsensusUseCase) {
+				expectedParams := usecase.CompareSeasonConsensusParams{
+					FromSeasonID:      "season-1",
+					ToSeasonID:        "season-2",
+					Method:            "borda",
+					MinPlacementCount: 5,
+				}
+				result := &usecase.CompareSeasonConsensusResult{
+					FromSeasonID: "season-1",
+					ToSeasonID:   "season-2",
+					Method:       "borda",
+					Archetypes: []usecase.CSCArchetype{
+						{
+							ArchetypeKey:          "card-1,card-2",
+							From:                  usecase.CSCDeck{DeckID: "deck-1", Nickname: "リザードンex", Tier: "A", AverageTierRank: 5.123, PlacementCount: 10},
+							To:                    usecase.CSCDeck{DeckID: "deck-2", Nickname: "リザードンex", Tier: "SS", AverageTierRank: 6.789, PlacementCount: 12},
+							AverageTierRankChange: 1.666,
+							TierChange:            2,
+						},
+					},
+					NewEntries: []usecase.CSCDeck{
+						{DeckID: "deck-3", Nickname: "新デッキ", Tier: "S", AverageTierRank: 6, PlacementCount: 5},
+					},
+					Dropped: []usecase.CSCDeck{},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"from_season_id": "season-1",
+				"to_season_id":   "season-2",
+				"method":         "borda",
+				"archetypes": []interface{}{
+					map[string]interface{}{
+						"archetype_key": "card-1,card-2",
+						"from": map[string]interface{}{
+							"deck_id": "deck-1", "nickname": "リザードンex", "image_url": "", "tier": "A", "average_tier_rank": 5.12, "placement_count": 10,
+						},
+						"to": map[string]interface{}{
+							"deck_id": "deck-2", "nickname": "リザードンex", "image_url": "", "tier": "SS", "average_tier_rank": 6.79, "placement_count": 12,
+						},
+						"average_tier_rank_change": 1.67,
+						"tier_change":              2,
+					},
+				},
+				"new_entries": []interface{}{
+					map[string]interface{}{
+						"deck_id": "deck-3", "nickname": "新デッキ", "image_url": "", "tier": "S", "average_tier_rank": 6, "placement_count": 5,
+					},
+				},
+				"dropped": []interface{}{},
+			},
+		},
+		{
+			caseName:       "異常系: to_season_idが指定されていない場合、400が返される",
+			target:         "/statistics/season-comparison?from_season_id=season-1",
+			mockSetup:      func(mockUC *MockCompareSeasonConsensusUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、404が返される",
+			target:   "/statistics/season-comparison?from_season_id=season-1&to_season_id=season-2",
+			mockSetup: func(mockUC *MockCompareSeasonConsensusUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("season not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/statistics/season-comparison?from_season_id=season-1&to_season_id=season-2",
+			mockSetup: func(mockUC *MockCompareSeasonConsensusUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockCompareSeasonConsensusUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewCompareSeasonConsensusHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
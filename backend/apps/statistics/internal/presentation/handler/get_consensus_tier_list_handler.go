@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetConsensusTierListHandler struct {
+	uc GetConsensusTierListUseCase
+}
+
+type GetConsensusTierListUseCase interface {
+	Execute(ctx context.Context, params usecase.GetConsensusTierListParams) (*usecase.GetConsensusTierListResult, error)
+}
+
+func NewGetConsensusTierListHandler(uc GetConsensusTierListUseCase) *GetConsensusTierListHandler {
+	return &GetConsensusTierListHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetConsensusTierListHandler) Handle(ctx *gin.Context) {
+	var req request.GetConsensusTierListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetConsensusTierListParams{
+		SeasonID:          ctx.Param("season_id"),
+		Method:            req.Method,
+		MinPlacementCount: req.MinPlacementCount,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewGetConsensusTierListResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/get_consensus_tier_list_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/get_consensus_tier_list_handler.go -destination=./apps/statistics/internal/presentation/handler/get_consensus_tier_list_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetConsensusTierListUseCase is a mock of GetConsensusTierListUseCase interface.
+type MockGetConsensusTierListUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetConsensusTierListUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetConsensusTierListUseCaseMockRecorder is the mock recorder for MockGetConsensusTierListUseCase.
+type MockGetConsensusTierListUseCaseMockRecorder struct {
+	mock *MockGetConsensusTierListUseCase
+}
+
+// NewMockGetConsensusTierListUseCase creates a new mock instance.
+func NewMockGetConsensusTierListUseCase(ctrl *gomock.Controller) *MockGetConsensusTierListUseCase {
+	mock := &MockGetConsensusTierListUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetConsensusTierListUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetConsensusTierListUseCase) EXPECT() *MockGetConsensusTierListUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetConsensusTierListUseCase) Execute(ctx context.Context, params usecase.GetConsensusTierListParams) (*usecase.GetConsensusTierListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetConsensusTierListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetConsensusTierListUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetConsensusTierListUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetConsensusTierListHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	generatedAt := time.Unix(1691513600, 0)
+	emptyTier := func(label string) usecase.GCTTier {
+		return usecase.GCTTier{Label: label, Decks: []usecase.GCTDeck{}}
+	}
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockGetConsensusTierListUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスとクエリパラメータがユースケースに渡り、全ティアを含む集計結果が返される",
+			target:   "/consensus/season-1?method=borda&min_placement_count=5",
+			mockSetup: func(mockUC *MockGetConsensusTierListUseCase) {
+				expectedParams := usecase.GetConsensusTierListParams{
+					SeasonID:          "season-1",
+					Method:            "borda",
+					MinPlacementCount: 5,
+				}
+				result := &usecase.GetConsensusTierListResult{
+					SeasonID:       "season-1",
+					Method:         "borda",
+					GeneratedAt:    generatedAt,
+					TotalTierLists: 25,
+					Tiers: []usecase.GCTTier{
+						{
+							Label: "SS",
+							Decks: []usecase.GCTDeck{
+								{
+									DeckID:               "deck-1",
+									Nickname:             "リザニンフ",
+									ImageURL:             "https://example.com/decks/deck-1.png",
+									AverageTierRank:      6.8333333,
+									AverageTierRankLower: 6.4167,
+									AverageTierRankUpper: 7,
+									TierProbability:      0.7891,
+									PlacementCount:       20,
+								},
+							},
+						},
+						emptyTier("S"), emptyTier("A"), emptyTier("B"), emptyTier("C"), emptyTier("D"), emptyTier("E"),
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"season_id":        "season-1",
+				"method":           "borda",
+				"generated_at":     1691513600,
+				"total_tier_lists": 25,
+				"tiers": map[string]interface{}{
+					"SS": []interface{}{
+						map[string]interface{}{
+							"deck_id":                 "deck-1",
+							"nickname":                "リザニンフ",
+							"image_url":               "https://example.com/decks/deck-1.png",
+							"average_tier_rank":       6.83,
+							"average_tier_rank_lower": 6.42,
+							"average_tier_rank_upper": 7,
+							"tier_probability":        0.79,
+							"placement_count":         20,
+						},
+					},
+					"S": []interface{}{},
+					"A": []interface{}{},
+					"B": []interface{}{},
+					"C": []interface{}{},
+					"D": []interface{}{},
+					"E": []interface{}{},
+				},
+			},
+		},
+		{
+			caseName:       "異常系: min_placement_countが下限未満の場合、400が返される",
+			target:         "/consensus/season-1?min_placement_count=-1",
+			mockSetup:      func(mockUC *MockGetConsensusTierListUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、404が返される",
+			target:   "/consensus/season-1",
+			mockSetup: func(mockUC *MockGetConsensusTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("season not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/consensus/season-1",
+			mockSetup: func(mockUC *MockGetConsensusTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockGetConsensusTierListUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewGetConsensusTierListHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{{Key: "season_id", Value: "season-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
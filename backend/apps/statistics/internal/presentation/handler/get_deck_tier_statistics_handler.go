@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetDeckTierStatisticsHandler struct {
+	uc GetDeckTierStatisticsUseCase
+}
+
+type GetDeckTierStatisticsUseCase interface {
+	Execute(ctx context.Context, params usecase.GetDeckTierStatisticsParams) (*usecase.GetDeckTierStatisticsResult, error)
+}
+
+func NewGetDeckTierStatisticsHandler(uc GetDeckTierStatisticsUseCase) *GetDeckTierStatisticsHandler {
+	return &GetDeckTierStatisticsHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetDeckTierStatisticsHandler) Handle(ctx *gin.Context) {
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetDeckTierStatisticsParams{
+		DeckID: ctx.Param("deck_id"),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewGetDeckTierStatisticsResponse(result))
+}
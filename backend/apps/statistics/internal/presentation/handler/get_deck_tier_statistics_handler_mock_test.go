@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/get_deck_tier_statistics_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/get_deck_tier_statistics_handler.go -destination=./apps/statistics/internal/presentation/handler/get_deck_tier_statistics_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetDeckTierStatisticsUseCase is a mock of GetDeckTierStatisticsUseCase interface.
+type MockGetDeckTierStatisticsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDeckTierStatisticsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetDeckTierStatisticsUseCaseMockRecorder is the mock recorder for MockGetDeckTierStatisticsUseCase.
+type MockGetDeckTierStatisticsUseCaseMockRecorder struct {
+	mock *MockGetDeckTierStatisticsUseCase
+}
+
+// NewMockGetDeckTierStatisticsUseCase creates a new mock instance.
+func NewMockGetDeckTierStatisticsUseCase(ctrl *gomock.Controller) *MockGetDeckTierStatisticsUseCase {
+	mock := &MockGetDeckTierStatisticsUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetDeckTierStatisticsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDeckTierStatisticsUseCase) EXPECT() *MockGetDeckTierStatisticsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetDeckTierStatisticsUseCase) Execute(ctx context.Context, params usecase.GetDeckTierStatisticsParams) (*usecase.GetDeckTierStatisticsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetDeckTierStatisticsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetDeckTierStatisticsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetDeckTierStatisticsUseCase)(nil).Execute), ctx, params)
+}
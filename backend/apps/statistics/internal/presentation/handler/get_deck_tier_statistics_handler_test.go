@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDeckTierStatisticsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		caseName       string
+		mockSetup      func(*MockGetDeckTierStatisticsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスパラメータがユースケースに渡り、全ティアの分布を含む統計が返される",
+			mockSetup: func(mockUC *MockGetDeckTierStatisticsUseCase) {
+				expectedParams := usecase.GetDeckTierStatisticsParams{DeckID: "deck-1"}
+				result := &usecase.GetDeckTierStatisticsResult{
+					SeasonID: "season-1",
+					DeckID:   "deck-1",
+					Nickname: "リザニンフ",
+					ImageURL: "https://example.com/decks/deck-1.png",
+					Distribution: []usecase.GDSTierCount{
+						{Label: "SS", Count: 1}, {Label: "S", Count: 2}, {Label: "A", Count: 0}, {Label: "B", Count: 0},
+						{Label: "C", Count: 0}, {Label: "D", Count: 0}, {Label: "E", Count: 0},
+					},
+					PlacementCount: 3,
+					TierListCount:  3,
+					TotalTierLists: 7,
+					MeanTierRank:   6.3333333,
+					MedianTierRank: 6,
+					Percentile:     83.3333333,
+					PlacementShare: 0.4285714,
+					FavoriteCount:  8,
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"season_id": "season-1",
+				"deck_id":   "deck-1",
+				"nickname":  "リザニンフ",
+				"image_url": "https://example.com/decks/deck-1.png",
+				"distribution": map[string]interface{}{
+					"SS": 1, "S": 2, "A": 0, "B": 0, "C": 0, "D": 0, "E": 0,
+				},
+				"placement_count":  3,
+				"tier_list_count":  3,
+				"total_tier_lists": 7,
+				"mean_tier_rank":   6.33,
+				"median_tier_rank": 6,
+				"percentile":       83.33,
+				"placement_share":  0.4286,
+				"favorite_count":   8,
+			},
+		},
+		{
+			caseName: "異常系: デッキが存在しない場合、404が返される",
+			mockSetup: func(mockUC *MockGetDeckTierStatisticsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("deck not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: デッキIDが不正な場合、400が返される",
+			mockSetup: func(mockUC *MockGetDeckTierStatisticsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewValidationError("invalid deck_id", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			mockSetup: func(mockUC *MockGetDeckTierStatisticsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockGetDeckTierStatisticsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewGetDeckTierStatisticsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/statistics/tier/deck-1", nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{{Key: "deck_id", Value: "deck-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetDeckTrendHandler struct {
+	uc GetDeckTrendUseCase
+}
+
+type GetDeckTrendUseCase interface {
+	Execute(ctx context.Context, params usecase.GetDeckTrendParams) (*usecase.GetDeckTrendResult, error)
+}
+
+func NewGetDeckTrendHandler(uc GetDeckTrendUseCase) *GetDeckTrendHandler {
+	return &GetDeckTrendHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetDeckTrendHandler) Handle(ctx *gin.Context) {
+	var req request.GetDeckTrendRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetDeckTrendParams{
+		SeasonID: req.SeasonID,
+		DeckID:   req.DeckID,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewGetDeckTrendResponse(result))
+}
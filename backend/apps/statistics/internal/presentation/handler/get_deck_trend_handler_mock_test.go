@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/get_deck_trend_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/get_deck_trend_handler.go -destination=./apps/statistics/internal/presentation/handler/get_deck_trend_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetDeckTrendUseCase is a mock of GetDeckTrendUseCase interface.
+type MockGetDeckTrendUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDeckTrendUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetDeckTrendUseCaseMockRecorder is the mock recorder for MockGetDeckTrendUseCase.
+type MockGetDeckTrendUseCaseMockRecorder struct {
+	mock *MockGetDeckTrendUseCase
+}
+
+// NewMockGetDeckTrendUseCase creates a new mock instance.
+func NewMockGetDeckTrendUseCase(ctrl *gomock.Controller) *MockGetDeckTrendUseCase {
+	mock := &MockGetDeckTrendUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetDeckTrendUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDeckTrendUseCase) EXPECT() *MockGetDeckTrendUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetDeckTrendUseCase) Execute(ctx context.Context, params usecase.GetDeckTrendParams) (*usecase.GetDeckTrendResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetDeckTrendResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetDeckTrendUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetDeckTrendUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,136 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDeckTrendHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	day1 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockGetDeckTrendUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: クエリパラメータがユースケースに渡り、記録日ごとの推移が返される",
+			target:   "/statistics/trends?season_id=season-1&deck_id=deck-1",
+			mockSetup: func(mockUC *MockGetDeckTrendUseCase) {
+				expectedParams := usecase.GetDeckTrendParams{SeasonID: "season-1", DeckID: "deck-1"}
+				result := &usecase.GetDeckTrendResult{
+					SeasonID: "season-1",
+					DeckID:   "deck-1",
+					Points: []usecase.GDTPoint{
+						{SnapshotDate: day1, Tier: "B", AverageTierRank: 4.4444, PlacementCount: 5},
+						{SnapshotDate: day1.AddDate(0, 0, 1), Tier: "S", AverageTierRank: 5.6, PlacementCount: 9},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"season_id": "season-1",
+				"deck_id":   "deck-1",
+				"points": []interface{}{
+					map[string]interface{}{"date": "2025-08-01", "tier": "B", "average_tier_rank": 4.44, "placement_count": 5},
+					map[string]interface{}{"date": "2025-08-02", "tier": "S", "average_tier_rank": 5.6, "placement_count": 9},
+				},
+			},
+		},
+		{
+			caseName:       "異常系: deck_idが指定されていない場合、400が返される",
+			target:         "/statistics/trends?season_id=season-1",
+			mockSetup:      func(mockUC *MockGetDeckTrendUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: シーズンが存在しない場合、404が返される",
+			target:   "/statistics/trends?season_id=season-1&deck_id=deck-1",
+			mockSetup: func(mockUC *MockGetDeckTrendUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("season not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/statistics/trends?season_id=season-1&deck_id=deck-1",
+			mockSetup: func(mockUC *MockGetDeckTrendUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockGetDeckTrendUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewGetDeckTrendHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetTierListAgreementHandler struct {
+	uc GetTierListAgreementUseCase
+}
+
+type GetTierListAgreementUseCase interface {
+	Execute(ctx context.Context, params usecase.GetTierListAgreementParams) (*usecase.GetTierListAgreementResult, error)
+}
+
+func NewGetTierListAgreementHandler(uc GetTierListAgreementUseCase) *GetTierListAgreementHandler {
+	return &GetTierListAgreementHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetTierListAgreementHandler) Handle(ctx *gin.Context) {
+	var req request.GetTierListAgreementRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetTierListAgreementParams{
+		TierListID:        ctx.Param("tier_list_id"),
+		Method:            req.Method,
+		MinPlacementCount: req.MinPlacementCount,
+		Limit:             req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewGetTierListAgreementResponse(result))
+}
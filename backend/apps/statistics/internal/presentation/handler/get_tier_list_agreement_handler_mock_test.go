@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/get_tier_list_agreement_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/get_tier_list_agreement_handler.go -destination=./apps/statistics/internal/presentation/handler/get_tier_list_agreement_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetTierListAgreementUseCase is a mock of GetTierListAgreementUseCase interface.
+type MockGetTierListAgreementUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTierListAgreementUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTierListAgreementUseCaseMockRecorder is the mock recorder for MockGetTierListAgreementUseCase.
+type MockGetTierListAgreementUseCaseMockRecorder struct {
+	mock *MockGetTierListAgreementUseCase
+}
+
+// NewMockGetTierListAgreementUseCase creates a new mock instance.
+func NewMockGetTierListAgreementUseCase(ctrl *gomock.Controller) *MockGetTierListAgreementUseCase {
+	mock := &MockGetTierListAgreementUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetTierListAgreementUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTierListAgreementUseCase) EXPECT() *MockGetTierListAgreementUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTierListAgreementUseCase) Execute(ctx context.Context, params usecase.GetTierListAgreementParams) (*usecase.GetTierListAgreementResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetTierListAgreementResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTierListAgreementUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTierListAgreementUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,192 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTierListAgreementHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tau, rho := 0.6666667, 0.8
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockGetTierListAgreementUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスとクエリパラメータがユースケースに渡り、一致度と食い違いの大きいデッキが返される",
+			target:   "/tier-lists/tier-list-1/agreement?method=median&min_placement_count=2&limit=5",
+			mockSetup: func(mockUC *MockGetTierListAgreementUseCase) {
+				expectedParams := usecase.GetTierListAgreementParams{
+					TierListID:        "tier-list-1",
+					Method:            "median",
+					MinPlacementCount: 2,
+					Limit:             5,
+				}
+				result := &usecase.GetTierListAgreementResult{
+					TierListID:               "tier-list-1",
+					SeasonID:                 "season-1",
+					Method:                   "median",
+					PlacementCount:           5,
+					ComparedDeckCount:        4,
+					KendallTauB:              &tau,
+					Spearman:                 &rho,
+					MeanAbsoluteTierDistance: 0.75,
+					Disagreements: []usecase.GTADisagreement{
+						{
+							DeckID:          "deck-1",
+							Nickname:        "リザニンフ",
+							ImageURL:        "https://example.com/decks/deck-1.png",
+							Tier:            "SS",
+							ConsensusTier:   "A",
+							AverageTierRank: 5.1666667,
+							ScoreDifference: 1.8333333,
+						},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tier_list_id":                "tier-list-1",
+				"season_id":                   "season-1",
+				"method":                      "median",
+				"placement_count":             5,
+				"compared_deck_count":         4,
+				"kendall_tau_b":               0.6667,
+				"spearman":                    0.8,
+				"mean_absolute_tier_distance": 0.75,
+				"disagreements": []interface{}{
+					map[string]interface{}{
+						"deck_id":           "deck-1",
+						"nickname":          "リザニンフ",
+						"image_url":         "https://example.com/decks/deck-1.png",
+						"tier":              "SS",
+						"consensus_tier":    "A",
+						"average_tier_rank": 5.17,
+						"score_difference":  1.83,
+					},
+				},
+			},
+		},
+		{
+			caseName: "正常系: 順位相関を算出できない場合、nullが返される",
+			target:   "/tier-lists/tier-list-1/agreement",
+			mockSetup: func(mockUC *MockGetTierListAgreementUseCase) {
+				result := &usecase.GetTierListAgreementResult{
+					TierListID:    "tier-list-1",
+					SeasonID:      "season-1",
+					Method:        "mean",
+					Disagreements: []usecase.GTADisagreement{},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.GetTierListAgreementParams{TierListID: "tier-list-1"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tier_list_id":                "tier-list-1",
+				"season_id":                   "season-1",
+				"method":                      "mean",
+				"placement_count":             0,
+				"compared_deck_count":         0,
+				"kendall_tau_b":               nil,
+				"spearman":                    nil,
+				"mean_absolute_tier_distance": 0,
+				"disagreements":               []interface{}{},
+			},
+		},
+		{
+			caseName:       "異常系: limitが上限を超える場合、400が返される",
+			target:         "/tier-lists/tier-list-1/agreement?limit=101",
+			mockSetup:      func(mockUC *MockGetTierListAgreementUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、404が返される",
+			target:   "/tier-lists/tier-list-1/agreement",
+			mockSetup: func(mockUC *MockGetTierListAgreementUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/tier-lists/tier-list-1/agreement",
+			mockSetup: func(mockUC *MockGetTierListAgreementUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockGetTierListAgreementUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewGetTierListAgreementHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
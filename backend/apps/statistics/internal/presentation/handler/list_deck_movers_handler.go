@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListDeckMoversHandler struct {
+	uc ListDeckMoversUseCase
+}
+
+type ListDeckMoversUseCase interface {
+	Execute(ctx context.Context, params usecase.ListDeckMoversParams) (*usecase.ListDeckMoversResult, error)
+}
+
+func NewListDeckMoversHandler(uc ListDeckMoversUseCase) *ListDeckMoversHandler {
+	return &ListDeckMoversHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListDeckMoversHandler) Handle(ctx *gin.Context) {
+	var req request.ListDeckMoversRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListDeckMoversParams{
+		SeasonID: req.SeasonID,
+		Days:     req.Days,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListDeckMoversResponse(result))
+}
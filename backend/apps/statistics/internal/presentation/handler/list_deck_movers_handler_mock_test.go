@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/list_deck_movers_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/list_deck_movers_handler.go -destination=./apps/statistics/internal/presentation/handler/list_deck_movers_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListDeckMoversUseCase is a mock of ListDeckMoversUseCase interface.
+type MockListDeckMoversUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDeckMoversUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListDeckMoversUseCaseMockRecorder is the mock recorder for MockListDeckMoversUseCase.
+type MockListDeckMoversUseCaseMockRecorder struct {
+	mock *MockListDeckMoversUseCase
+}
+
+// NewMockListDeckMoversUseCase creates a new mock instance.
+func NewMockListDeckMoversUseCase(ctrl *gomock.Controller) *MockListDeckMoversUseCase {
+	mock := &MockListDeckMoversUseCase{ctrl: ctrl}
+	mock.recorder = &MockListDeckMoversUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDeckMoversUseCase) EXPECT() *MockListDeckMoversUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListDeckMoversUseCase) Execute(ctx context.Context, params usecase.ListDeckMoversParams) (*usecase.ListDeckMoversResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListDeckMoversResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListDeckMoversUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListDeckMoversUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,169 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDeckMoversHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	fromDate := time.Date(2025, 8, 3, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockListDeckMoversUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: クエリパラメータがユースケースに渡り、上昇・下降したデッキが返される",
+			target:   "/statistics/movers?season_id=season-1&days=7&limit=5",
+			mockSetup: func(mockUC *MockListDeckMoversUseCase) {
+				expectedParams := usecase.ListDeckMoversParams{SeasonID: "season-1", Days: 7, Limit: 5}
+				result := &usecase.ListDeckMoversResult{
+					SeasonID: "season-1",
+					Days:     7,
+					FromDate: &fromDate,
+					ToDate:   &toDate,
+					Risers: []usecase.LDMDeck{
+						{
+							DeckID:              "deck-1",
+							Nickname:            "リザニンフ",
+							ImageURL:            "https://example.com/decks/deck-1.png",
+							FromTier:            "B",
+							ToTier:              "SS",
+							FromAverageTierRank: 4.123,
+							ToAverageTierRank:   6.5,
+							Change:              2.377,
+							PlacementCount:      12,
+						},
+					},
+					Fallers: []usecase.LDMDeck{},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"season_id": "season-1",
+				"days":      7,
+				"from_date": "2025-08-03",
+				"to_date":   "2025-08-10",
+				"risers": []interface{}{
+					map[string]interface{}{
+						"deck_id":                "deck-1",
+						"nickname":               "リザニンフ",
+						"image_url":              "https://example.com/decks/deck-1.png",
+						"from_tier":              "B",
+						"to_tier":                "SS",
+						"from_average_tier_rank": 4.12,
+						"to_average_tier_rank":   6.5,
+						"change":                 2.38,
+						"placement_count":        12,
+					},
+				},
+				"fallers": []interface{}{},
+			},
+		},
+		{
+			caseName: "正常系: 比較できるスナップショットがない場合、日付がnullで返される",
+			target:   "/statistics/movers?season_id=season-1",
+			mockSetup: func(mockUC *MockListDeckMoversUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListDeckMoversParams{SeasonID: "season-1"}).Return(&usecase.ListDeckMoversResult{
+					SeasonID: "season-1",
+					Days:     7,
+					Risers:   []usecase.LDMDeck{},
+					Fallers:  []usecase.LDMDeck{},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"season_id": "season-1",
+				"days":      7,
+				"from_date": nil,
+				"to_date":   nil,
+				"risers":    []interface{}{},
+				"fallers":   []interface{}{},
+			},
+		},
+		{
+			caseName:       "異常系: daysが上限を超える場合、400が返される",
+			target:         "/statistics/movers?season_id=season-1&days=91",
+			mockSetup:      func(mockUC *MockListDeckMoversUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/statistics/movers?season_id=season-1",
+			mockSetup: func(mockUC *MockListDeckMoversUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListDeckMoversUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListDeckMoversHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
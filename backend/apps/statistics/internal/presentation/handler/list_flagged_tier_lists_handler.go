@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/request"
+	"poketier/apps/statistics/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListFlaggedTierListsHandler struct {
+	uc ListFlaggedTierListsUseCase
+}
+
+type ListFlaggedTierListsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListFlaggedTierListsParams) (*usecase.ListFlaggedTierListsResult, error)
+}
+
+func NewListFlaggedTierListsHandler(uc ListFlaggedTierListsUseCase) *ListFlaggedTierListsHandler {
+	return &ListFlaggedTierListsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListFlaggedTierListsHandler) Handle(ctx *gin.Context) {
+	var req request.ListFlaggedTierListsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListFlaggedTierListsParams{
+		SeasonID:  req.SeasonID,
+		Limit:     req.Limit,
+		ActorRole: auth.RoleFromContext(ctx.Request.Context()),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListFlaggedTierListsResponse(result))
+}
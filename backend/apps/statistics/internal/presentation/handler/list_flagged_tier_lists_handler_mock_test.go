@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/handler/list_flagged_tier_lists_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/handler/list_flagged_tier_lists_handler.go -destination=./apps/statistics/internal/presentation/handler/list_flagged_tier_lists_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListFlaggedTierListsUseCase is a mock of ListFlaggedTierListsUseCase interface.
+type MockListFlaggedTierListsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListFlaggedTierListsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListFlaggedTierListsUseCaseMockRecorder is the mock recorder for MockListFlaggedTierListsUseCase.
+type MockListFlaggedTierListsUseCaseMockRecorder struct {
+	mock *MockListFlaggedTierListsUseCase
+}
+
+// NewMockListFlaggedTierListsUseCase creates a new mock instance.
+func NewMockListFlaggedTierListsUseCase(ctrl *gomock.Controller) *MockListFlaggedTierListsUseCase {
+	mock := &MockListFlaggedTierListsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListFlaggedTierListsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListFlaggedTierListsUseCase) EXPECT() *MockListFlaggedTierListsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListFlaggedTierListsUseCase) Execute(ctx context.Context, params usecase.ListFlaggedTierListsParams) (*usecase.ListFlaggedTierListsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListFlaggedTierListsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListFlaggedTierListsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListFlaggedTierListsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFlaggedTierListsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	evaluatedAt := time.Unix(1691513600, 0)
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockListFlaggedTierListsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: クエリパラメータがユースケースに渡り、フラグ付きのティアリストが返される",
+			target:   "/admin/flagged-tier-lists?season_id=season-1&limit=10",
+			mockSetup: func(mockUC *MockListFlaggedTierListsUseCase) {
+				expectedParams := usecase.ListFlaggedTierListsParams{
+					SeasonID:  "season-1",
+					Limit:     10,
+					ActorRole: role.Moderator,
+				}
+				result := &usecase.ListFlaggedTierListsResult{
+					TierLists: []usecase.LFTTierList{
+						{
+							TierListID:            "tier-list-2",
+							SeasonID:              "season-1",
+							Title:                 "荒らしのティアリスト",
+							AuthorName:            "荒らし",
+							AuthorIP:              "198.51.100.7",
+							TrustWeight:           0,
+							RankDistance:          2.5,
+							ZScore:                3,
+							DuplicateOfTierListID: "tier-list-1",
+							FlagReasons:           []string{"outlier", "near_duplicate"},
+							EvaluatedAt:           evaluatedAt,
+						},
+						{
+							TierListID:   "tier-list-3",
+							SeasonID:     "season-1",
+							Title:        "外れ値のティアリスト",
+							AuthorName:   "匿名ユーザー",
+							TrustWeight:  0.44,
+							RankDistance: 2.1,
+							ZScore:       3,
+							FlagReasons:  []string{"outlier"},
+							EvaluatedAt:  evaluatedAt,
+						},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"tier_lists": []interface{}{
+					map[string]interface{}{
+						"tier_list_id":              "tier-list-2",
+						"season_id":                 "season-1",
+						"title":                     "荒らしのティアリスト",
+						"author_name":               "荒らし",
+						"author_ip":                 "198.51.100.7",
+						"trust_weight":              0,
+						"rank_distance":             2.5,
+						"z_score":                   3,
+						"duplicate_of_tier_list_id": "tier-list-1",
+						"flag_reasons":              []interface{}{"outlier", "near_duplicate"},
+						"evaluated_at":              1691513600,
+					},
+					map[string]interface{}{
+						"tier_list_id":              "tier-list-3",
+						"season_id":                 "season-1",
+						"title":                     "外れ値のティアリスト",
+						"author_name":               "匿名ユーザー",
+						"author_ip":                 "",
+						"trust_weight":              0.44,
+						"rank_distance":             2.1,
+						"z_score":                   3,
+						"duplicate_of_tier_list_id": nil,
+						"flag_reasons":              []interface{}{"outlier"},
+						"evaluated_at":              1691513600,
+					},
+				},
+			},
+		},
+		{
+			caseName:       "異常系: limitが上限を超える場合、400が返される",
+			target:         "/admin/flagged-tier-lists?limit=101",
+			mockSetup:      func(mockUC *MockListFlaggedTierListsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/admin/flagged-tier-lists",
+			mockSetup: func(mockUC *MockListFlaggedTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListFlaggedTierListsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListFlaggedTierListsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(auth.WithUser(context.Background(), id.NewUserID(), role.Moderator))
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
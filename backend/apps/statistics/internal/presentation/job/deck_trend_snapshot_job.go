@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/pkg/log"
+)
+
+// DeckTrendSnapshotInterval はデッキの推移を記録する間隔
+const DeckTrendSnapshotInterval = 24 * time.Hour
+
+type SnapshotDeckTrendsUseCase interface {
+	Execute(ctx context.Context, params usecase.SnapshotDeckTrendsParams) (*usecase.SnapshotDeckTrendsResult, error)
+}
+
+// DeckTrendSnapshotJob は開催中のシーズンのデッキの推移を定期的に記録するバックグラウンドジョブ
+type DeckTrendSnapshotJob struct {
+	uc       SnapshotDeckTrendsUseCase
+	logger   log.Logger
+	interval time.Duration
+}
+
+func NewDeckTrendSnapshotJob(uc SnapshotDeckTrendsUseCase, logger log.Logger) *DeckTrendSnapshotJob {
+	return &DeckTrendSnapshotJob{
+		uc:       uc,
+		logger:   logger,
+		interval: DeckTrendSnapshotInterval,
+	}
+}
+
+// Run は起動直後に1回記録し、以降は interval ごとに記録する。ctx がキャンセルされるまで戻らない
+// 記録は同じ日であれば置き換えとなるため、再起動や複数インスタンスでの重複実行は問題にならない
+func (j *DeckTrendSnapshotJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce はデッキの推移を1回記録する。失敗しても次回の記録は継続する
+func (j *DeckTrendSnapshotJob) runOnce(ctx context.Context) {
+	result, err := j.uc.Execute(ctx, usecase.SnapshotDeckTrendsParams{Now: time.Now()})
+	if err != nil {
+		j.logger.Error("Failed to snapshot deck trends", "error", err)
+		return
+	}
+	j.logger.Info("Snapshot deck trends",
+		"snapshot_date", result.SnapshotDate.Format(time.DateOnly),
+		"season_count", result.SeasonCount,
+		"deck_count", result.DeckCount,
+	)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/job/deck_trend_snapshot_job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/job/deck_trend_snapshot_job.go -destination=./apps/statistics/internal/presentation/job/deck_trend_snapshot_job_mock_test.go -package=job_test
+//
+
+// Package job_test is a generated GoMock package.
+package job_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSnapshotDeckTrendsUseCase is a mock of SnapshotDeckTrendsUseCase interface.
+type MockSnapshotDeckTrendsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotDeckTrendsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotDeckTrendsUseCaseMockRecorder is the mock recorder for MockSnapshotDeckTrendsUseCase.
+type MockSnapshotDeckTrendsUseCaseMockRecorder struct {
+	mock *MockSnapshotDeckTrendsUseCase
+}
+
+// NewMockSnapshotDeckTrendsUseCase creates a new mock instance.
+func NewMockSnapshotDeckTrendsUseCase(ctrl *gomock.Controller) *MockSnapshotDeckTrendsUseCase {
+	mock := &MockSnapshotDeckTrendsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSnapshotDeckTrendsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotDeckTrendsUseCase) EXPECT() *MockSnapshotDeckTrendsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSnapshotDeckTrendsUseCase) Execute(ctx context.Context, params usecase.SnapshotDeckTrendsParams) (*usecase.SnapshotDeckTrendsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.SnapshotDeckTrendsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSnapshotDeckTrendsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSnapshotDeckTrendsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,70 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/apps/statistics/internal/presentation/job"
+	"poketier/pkg/log"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeckTrendSnapshotJob_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		result   *usecase.SnapshotDeckTrendsResult
+		err      error
+	}{
+		{
+			caseName: "正常系: 起動直後に記録され、キャンセルされると終了する",
+			result:   &usecase.SnapshotDeckTrendsResult{SnapshotDate: time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC), SeasonCount: 1, DeckCount: 12},
+		},
+		{
+			caseName: "異常系: 記録に失敗してもジョブは停止せず、キャンセルされると終了する",
+			err:      errors.New("usecase error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mockUC := NewMockSnapshotDeckTrendsUseCase(ctrl)
+			mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(context.Context, usecase.SnapshotDeckTrendsParams) (*usecase.SnapshotDeckTrendsResult, error) {
+					cancel()
+					return tt.result, tt.err
+				},
+			)
+
+			snapshotJob := job.NewDeckTrendSnapshotJob(mockUC, log.NewStartupLogger("info", true))
+
+			// Act
+			done := make(chan struct{})
+			go func() {
+				snapshotJob.Run(ctx)
+				close(done)
+			}()
+
+			// Assert
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("job should stop after context is cancelled")
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+	"poketier/pkg/log"
+)
+
+// TrustEvaluationInterval はティアリストの信頼度を評価する間隔
+const TrustEvaluationInterval = time.Hour
+
+type EvaluateTierListTrustUseCase interface {
+	Execute(ctx context.Context, params usecase.EvaluateTierListTrustParams) (*usecase.EvaluateTierListTrustResult, error)
+}
+
+// TrustEvaluationJob は全シーズンのティアリストの信頼度を定期的に評価し、ティア統計の重みに反映するバックグラウンドジョブ
+type TrustEvaluationJob struct {
+	uc       EvaluateTierListTrustUseCase
+	logger   log.Logger
+	interval time.Duration
+}
+
+func NewTrustEvaluationJob(uc EvaluateTierListTrustUseCase, logger log.Logger) *TrustEvaluationJob {
+	return &TrustEvaluationJob{
+		uc:       uc,
+		logger:   logger,
+		interval: TrustEvaluationInterval,
+	}
+}
+
+// Run は起動直後に1回評価し、以降は interval ごとに評価する。ctx がキャンセルされるまで戻らない
+// 評価はその時点の配置から信頼度を求め直すため、再起動や複数インスタンスでの重複実行は問題にならない
+func (j *TrustEvaluationJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce は信頼度を1回評価する。失敗しても次回の評価は継続する
+func (j *TrustEvaluationJob) runOnce(ctx context.Context) {
+	result, err := j.uc.Execute(ctx, usecase.EvaluateTierListTrustParams{})
+	if err != nil {
+		j.logger.Error("Failed to evaluate tier list trust", "error", err)
+		return
+	}
+	j.logger.Info("Evaluated tier list trust",
+		"evaluated_count", result.EvaluatedCount,
+		"flagged_count", result.FlaggedCount,
+		"reweighted_count", result.ReweightedCount,
+	)
+}
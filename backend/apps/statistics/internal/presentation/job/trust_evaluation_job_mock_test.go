@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/statistics/internal/presentation/job/trust_evaluation_job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/statistics/internal/presentation/job/trust_evaluation_job.go -destination=./apps/statistics/internal/presentation/job/trust_evaluation_job_mock_test.go -package=job_test
+//
+
+// Package job_test is a generated GoMock package.
+package job_test
+
+import (
+	context "context"
+	usecase "poketier/apps/statistics/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEvaluateTierListTrustUseCase is a mock of EvaluateTierListTrustUseCase interface.
+type MockEvaluateTierListTrustUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluateTierListTrustUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEvaluateTierListTrustUseCaseMockRecorder is the mock recorder for MockEvaluateTierListTrustUseCase.
+type MockEvaluateTierListTrustUseCaseMockRecorder struct {
+	mock *MockEvaluateTierListTrustUseCase
+}
+
+// NewMockEvaluateTierListTrustUseCase creates a new mock instance.
+func NewMockEvaluateTierListTrustUseCase(ctrl *gomock.Controller) *MockEvaluateTierListTrustUseCase {
+	mock := &MockEvaluateTierListTrustUseCase{ctrl: ctrl}
+	mock.recorder = &MockEvaluateTierListTrustUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluateTierListTrustUseCase) EXPECT() *MockEvaluateTierListTrustUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEvaluateTierListTrustUseCase) Execute(ctx context.Context, params usecase.EvaluateTierListTrustParams) (*usecase.EvaluateTierListTrustResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.EvaluateTierListTrustResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEvaluateTierListTrustUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEvaluateTierListTrustUseCase)(nil).Execute), ctx, params)
+}
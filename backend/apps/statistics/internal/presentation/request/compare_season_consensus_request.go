@@ -0,0 +1,9 @@
+package request
+
+// CompareSeasonConsensusRequest はシーズン間の集計ティアリスト比較のクエリパラメータ
+type CompareSeasonConsensusRequest struct {
+	FromSeasonID      string `form:"from_season_id" binding:"required"`
+	ToSeasonID        string `form:"to_season_id" binding:"required"`
+	Method            string `form:"method"`
+	MinPlacementCount int    `form:"min_placement_count" binding:"omitempty,min=1,max=1000"`
+}
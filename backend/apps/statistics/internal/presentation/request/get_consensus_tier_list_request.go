@@ -0,0 +1,7 @@
+package request
+
+// GetConsensusTierListRequest は集計ティアリスト取得のクエリパラメータ
+type GetConsensusTierListRequest struct {
+	Method            string `form:"method"`
+	MinPlacementCount int    `form:"min_placement_count" binding:"omitempty,min=1,max=1000"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// GetDeckTrendRequest はデッキの推移取得のクエリパラメータ
+type GetDeckTrendRequest struct {
+	SeasonID string `form:"season_id" binding:"required"`
+	DeckID   string `form:"deck_id" binding:"required"`
+}
@@ -0,0 +1,8 @@
+package request
+
+// GetTierListAgreementRequest はティアリストと集計結果の一致度取得のクエリパラメータ
+type GetTierListAgreementRequest struct {
+	Method            string `form:"method"`
+	MinPlacementCount int    `form:"min_placement_count" binding:"omitempty,min=1,max=1000"`
+	Limit             int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,8 @@
+package request
+
+// ListDeckMoversRequest は急上昇・急下降デッキ一覧取得のクエリパラメータ
+type ListDeckMoversRequest struct {
+	SeasonID string `form:"season_id" binding:"required"`
+	Days     int    `form:"days" binding:"omitempty,min=1,max=90"`
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// ListFlaggedTierListsRequest はフラグ付きティアリスト一覧取得のクエリパラメータ
+type ListFlaggedTierListsRequest struct {
+	SeasonID string `form:"season_id"`
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,75 @@
+package response
+
+import (
+	"math"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type CompareSeasonConsensusResponse struct {
+	FromSeasonID string         `json:"from_season_id"`
+	ToSeasonID   string         `json:"to_season_id"`
+	Method       string         `json:"method"`
+	Archetypes   []CSCArchetype `json:"archetypes"`
+	NewEntries   []CSCDeck      `json:"new_entries"`
+	Dropped      []CSCDeck      `json:"dropped"`
+}
+
+type CSCArchetype struct {
+	ArchetypeKey          string  `json:"archetype_key"`
+	From                  CSCDeck `json:"from"`
+	To                    CSCDeck `json:"to"`
+	AverageTierRankChange float64 `json:"average_tier_rank_change"`
+	TierChange            int     `json:"tier_change"`
+}
+
+type CSCDeck struct {
+	DeckID          string  `json:"deck_id"`
+	Nickname        string  `json:"nickname"`
+	ImageURL        string  `json:"image_url"`
+	Tier            string  `json:"tier"`
+	AverageTierRank float64 `json:"average_tier_rank"`
+	PlacementCount  int     `json:"placement_count"`
+}
+
+// NewCompareSeasonConsensusResponse はシーズン間の比較結果をレスポンスに変換する
+// 平均ランクとその変化は小数第2位に丸める
+func NewCompareSeasonConsensusResponse(result *usecase.CompareSeasonConsensusResult) CompareSeasonConsensusResponse {
+	archetypes := make([]CSCArchetype, 0, len(result.Archetypes))
+	for _, a := range result.Archetypes {
+		archetypes = append(archetypes, CSCArchetype{
+			ArchetypeKey:          a.ArchetypeKey,
+			From:                  toCSCDeck(a.From),
+			To:                    toCSCDeck(a.To),
+			AverageTierRankChange: math.Round(a.AverageTierRankChange*100) / 100,
+			TierChange:            a.TierChange,
+		})
+	}
+	return CompareSeasonConsensusResponse{
+		FromSeasonID: result.FromSeasonID,
+		ToSeasonID:   result.ToSeasonID,
+		Method:       result.Method,
+		Archetypes:   archetypes,
+		NewEntries:   toCSCDecks(result.NewEntries),
+		Dropped:      toCSCDecks(result.Dropped),
+	}
+}
+
+func toCSCDecks(decks []usecase.CSCDeck) []CSCDeck {
+	res := make([]CSCDeck, 0, len(decks))
+	for _, d := range decks {
+		res = append(res, toCSCDeck(d))
+	}
+	return res
+}
+
+func toCSCDeck(d usecase.CSCDeck) CSCDeck {
+	return CSCDeck{
+		DeckID:          d.DeckID,
+		Nickname:        d.Nickname,
+		ImageURL:        d.ImageURL,
+		Tier:            d.Tier,
+		AverageTierRank: math.Round(d.AverageTierRank*100) / 100,
+		PlacementCount:  d.PlacementCount,
+	}
+}
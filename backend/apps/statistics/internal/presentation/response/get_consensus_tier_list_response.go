@@ -0,0 +1,55 @@
+package response
+
+import (
+	"math"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type GetConsensusTierListResponse struct {
+	SeasonID       string               `json:"season_id"`
+	Method         string               `json:"method"`
+	GeneratedAt    int64                `json:"generated_at"`
+	TotalTierLists int                  `json:"total_tier_lists"`
+	Tiers          map[string][]GCTDeck `json:"tiers"`
+}
+
+type GCTDeck struct {
+	DeckID               string  `json:"deck_id"`
+	Nickname             string  `json:"nickname"`
+	ImageURL             string  `json:"image_url"`
+	AverageTierRank      float64 `json:"average_tier_rank"`
+	AverageTierRankLower float64 `json:"average_tier_rank_lower"`
+	AverageTierRankUpper float64 `json:"average_tier_rank_upper"`
+	TierProbability      float64 `json:"tier_probability"`
+	PlacementCount       int     `json:"placement_count"`
+}
+
+// NewGetConsensusTierListResponse は集計結果をレスポンスに変換する
+// 平均ランクとその信頼区間、ティアに属する確率は小数第2位に丸め、デッキのないティアも空配列として返す
+func NewGetConsensusTierListResponse(result *usecase.GetConsensusTierListResult) GetConsensusTierListResponse {
+	tiers := make(map[string][]GCTDeck, len(result.Tiers))
+	for _, tier := range result.Tiers {
+		decks := make([]GCTDeck, 0, len(tier.Decks))
+		for _, d := range tier.Decks {
+			decks = append(decks, GCTDeck{
+				DeckID:               d.DeckID,
+				Nickname:             d.Nickname,
+				ImageURL:             d.ImageURL,
+				AverageTierRank:      math.Round(d.AverageTierRank*100) / 100,
+				AverageTierRankLower: math.Round(d.AverageTierRankLower*100) / 100,
+				AverageTierRankUpper: math.Round(d.AverageTierRankUpper*100) / 100,
+				TierProbability:      math.Round(d.TierProbability*100) / 100,
+				PlacementCount:       d.PlacementCount,
+			})
+		}
+		tiers[tier.Label] = decks
+	}
+	return GetConsensusTierListResponse{
+		SeasonID:       result.SeasonID,
+		Method:         result.Method,
+		GeneratedAt:    result.GeneratedAt.Unix(),
+		TotalTierLists: result.TotalTierLists,
+		Tiers:          tiers,
+	}
+}
@@ -0,0 +1,47 @@
+package response
+
+import (
+	"math"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type GetDeckTierStatisticsResponse struct {
+	SeasonID       string         `json:"season_id"`
+	DeckID         string         `json:"deck_id"`
+	Nickname       string         `json:"nickname"`
+	ImageURL       string         `json:"image_url"`
+	Distribution   map[string]int `json:"distribution"`
+	PlacementCount int            `json:"placement_count"`
+	TierListCount  int            `json:"tier_list_count"`
+	TotalTierLists int            `json:"total_tier_lists"`
+	MeanTierRank   float64        `json:"mean_tier_rank"`
+	MedianTierRank float64        `json:"median_tier_rank"`
+	Percentile     float64        `json:"percentile"`
+	PlacementShare float64        `json:"placement_share"`
+	FavoriteCount  int            `json:"favorite_count"`
+}
+
+// NewGetDeckTierStatisticsResponse はデッキのティア統計をレスポンスに変換する
+// 平均・中央値ランクとパーセンタイルは小数第2位、配置率は小数第4位に丸める
+func NewGetDeckTierStatisticsResponse(result *usecase.GetDeckTierStatisticsResult) GetDeckTierStatisticsResponse {
+	distribution := make(map[string]int, len(result.Distribution))
+	for _, d := range result.Distribution {
+		distribution[d.Label] = d.Count
+	}
+	return GetDeckTierStatisticsResponse{
+		SeasonID:       result.SeasonID,
+		DeckID:         result.DeckID,
+		Nickname:       result.Nickname,
+		ImageURL:       result.ImageURL,
+		Distribution:   distribution,
+		PlacementCount: result.PlacementCount,
+		TierListCount:  result.TierListCount,
+		TotalTierLists: result.TotalTierLists,
+		MeanTierRank:   math.Round(result.MeanTierRank*100) / 100,
+		MedianTierRank: math.Round(result.MedianTierRank*100) / 100,
+		Percentile:     math.Round(result.Percentile*100) / 100,
+		PlacementShare: math.Round(result.PlacementShare*10000) / 10000,
+		FavoriteCount:  result.FavoriteCount,
+	}
+}
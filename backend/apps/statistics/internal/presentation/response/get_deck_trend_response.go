@@ -0,0 +1,40 @@
+package response
+
+import (
+	"math"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type GetDeckTrendResponse struct {
+	SeasonID string     `json:"season_id"`
+	DeckID   string     `json:"deck_id"`
+	Points   []GDTPoint `json:"points"`
+}
+
+type GDTPoint struct {
+	Date            string  `json:"date"`
+	Tier            string  `json:"tier"`
+	AverageTierRank float64 `json:"average_tier_rank"`
+	PlacementCount  int     `json:"placement_count"`
+}
+
+// NewGetDeckTrendResponse はデッキの推移をレスポンスに変換する
+// 平均ランクは小数第2位に丸める
+func NewGetDeckTrendResponse(result *usecase.GetDeckTrendResult) GetDeckTrendResponse {
+	points := make([]GDTPoint, 0, len(result.Points))
+	for _, p := range result.Points {
+		points = append(points, GDTPoint{
+			Date:            p.SnapshotDate.Format(time.DateOnly),
+			Tier:            p.Tier,
+			AverageTierRank: math.Round(p.AverageTierRank*100) / 100,
+			PlacementCount:  p.PlacementCount,
+		})
+	}
+	return GetDeckTrendResponse{
+		SeasonID: result.SeasonID,
+		DeckID:   result.DeckID,
+		Points:   points,
+	}
+}
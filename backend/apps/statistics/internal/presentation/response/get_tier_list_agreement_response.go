@@ -0,0 +1,63 @@
+package response
+
+import (
+	"math"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type GetTierListAgreementResponse struct {
+	TierListID               string            `json:"tier_list_id"`
+	SeasonID                 string            `json:"season_id"`
+	Method                   string            `json:"method"`
+	PlacementCount           int               `json:"placement_count"`
+	ComparedDeckCount        int               `json:"compared_deck_count"`
+	KendallTauB              *float64          `json:"kendall_tau_b"`
+	Spearman                 *float64          `json:"spearman"`
+	MeanAbsoluteTierDistance float64           `json:"mean_absolute_tier_distance"`
+	Disagreements            []GTADisagreement `json:"disagreements"`
+}
+
+type GTADisagreement struct {
+	DeckID          string  `json:"deck_id"`
+	Nickname        string  `json:"nickname"`
+	ImageURL        string  `json:"image_url"`
+	Tier            string  `json:"tier"`
+	ConsensusTier   string  `json:"consensus_tier"`
+	AverageTierRank float64 `json:"average_tier_rank"`
+	ScoreDifference float64 `json:"score_difference"`
+}
+
+// NewGetTierListAgreementResponse は一致度をレスポンスに変換する
+// 順位相関係数は小数第4位、ティアの差と平均ランクは小数第2位に丸める（順位相関を算出できない場合は null）
+func NewGetTierListAgreementResponse(result *usecase.GetTierListAgreementResult) GetTierListAgreementResponse {
+	res := GetTierListAgreementResponse{
+		TierListID:               result.TierListID,
+		SeasonID:                 result.SeasonID,
+		Method:                   result.Method,
+		PlacementCount:           result.PlacementCount,
+		ComparedDeckCount:        result.ComparedDeckCount,
+		MeanAbsoluteTierDistance: math.Round(result.MeanAbsoluteTierDistance*100) / 100,
+		Disagreements:            make([]GTADisagreement, 0, len(result.Disagreements)),
+	}
+	if result.KendallTauB != nil {
+		tau := math.Round(*result.KendallTauB*10000) / 10000
+		res.KendallTauB = &tau
+	}
+	if result.Spearman != nil {
+		rho := math.Round(*result.Spearman*10000) / 10000
+		res.Spearman = &rho
+	}
+	for _, d := range result.Disagreements {
+		res.Disagreements = append(res.Disagreements, GTADisagreement{
+			DeckID:          d.DeckID,
+			Nickname:        d.Nickname,
+			ImageURL:        d.ImageURL,
+			Tier:            d.Tier,
+			ConsensusTier:   d.ConsensusTier,
+			AverageTierRank: math.Round(d.AverageTierRank*100) / 100,
+			ScoreDifference: math.Round(d.ScoreDifference*100) / 100,
+		})
+	}
+	return res
+}
@@ -0,0 +1,64 @@
+package response
+
+import (
+	"math"
+	"time"
+
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type ListDeckMoversResponse struct {
+	SeasonID string    `json:"season_id"`
+	Days     int       `json:"days"`
+	FromDate *string   `json:"from_date"`
+	ToDate   *string   `json:"to_date"`
+	Risers   []LDMDeck `json:"risers"`
+	Fallers  []LDMDeck `json:"fallers"`
+}
+
+type LDMDeck struct {
+	DeckID              string  `json:"deck_id"`
+	Nickname            string  `json:"nickname"`
+	ImageURL            string  `json:"image_url"`
+	FromTier            string  `json:"from_tier"`
+	ToTier              string  `json:"to_tier"`
+	FromAverageTierRank float64 `json:"from_average_tier_rank"`
+	ToAverageTierRank   float64 `json:"to_average_tier_rank"`
+	Change              float64 `json:"change"`
+	PlacementCount      int     `json:"placement_count"`
+}
+
+// NewListDeckMoversResponse は急上昇・急下降デッキ一覧をレスポンスに変換する
+// 平均ランクと変化量は小数第2位に丸め、比較できない場合は日付を null で返す
+func NewListDeckMoversResponse(result *usecase.ListDeckMoversResult) ListDeckMoversResponse {
+	res := ListDeckMoversResponse{
+		SeasonID: result.SeasonID,
+		Days:     result.Days,
+		Risers:   toLDMDecks(result.Risers),
+		Fallers:  toLDMDecks(result.Fallers),
+	}
+	if result.FromDate != nil && result.ToDate != nil {
+		fromDate := result.FromDate.Format(time.DateOnly)
+		toDate := result.ToDate.Format(time.DateOnly)
+		res.FromDate, res.ToDate = &fromDate, &toDate
+	}
+	return res
+}
+
+func toLDMDecks(decks []usecase.LDMDeck) []LDMDeck {
+	res := make([]LDMDeck, 0, len(decks))
+	for _, d := range decks {
+		res = append(res, LDMDeck{
+			DeckID:              d.DeckID,
+			Nickname:            d.Nickname,
+			ImageURL:            d.ImageURL,
+			FromTier:            d.FromTier,
+			ToTier:              d.ToTier,
+			FromAverageTierRank: math.Round(d.FromAverageTierRank*100) / 100,
+			ToAverageTierRank:   math.Round(d.ToAverageTierRank*100) / 100,
+			Change:              math.Round(d.Change*100) / 100,
+			PlacementCount:      d.PlacementCount,
+		})
+	}
+	return res
+}
@@ -0,0 +1,51 @@
+package response
+
+import (
+	"poketier/apps/statistics/internal/application/usecase"
+)
+
+type ListFlaggedTierListsResponse struct {
+	TierLists []LFTTierList `json:"tier_lists"`
+}
+
+type LFTTierList struct {
+	TierListID            string   `json:"tier_list_id"`
+	SeasonID              string   `json:"season_id"`
+	Title                 string   `json:"title"`
+	AuthorName            string   `json:"author_name"`
+	AuthorIP              string   `json:"author_ip"`
+	TrustWeight           float64  `json:"trust_weight"`
+	RankDistance          float64  `json:"rank_distance"`
+	ZScore                float64  `json:"z_score"`
+	DuplicateOfTierListID *string  `json:"duplicate_of_tier_list_id"`
+	FlagReasons           []string `json:"flag_reasons"`
+	EvaluatedAt           int64    `json:"evaluated_at"`
+}
+
+// NewListFlaggedTierListsResponse はフラグ付きティアリスト一覧をレスポンスに変換する
+// 重複元がない場合は duplicate_of_tier_list_id を null で返す
+func NewListFlaggedTierListsResponse(result *usecase.ListFlaggedTierListsResult) ListFlaggedTierListsResponse {
+	tierLists := make([]LFTTierList, 0, len(result.TierLists))
+	for _, tl := range result.TierLists {
+		var duplicateOf *string
+		if tl.DuplicateOfTierListID != "" {
+			duplicateOf = &tl.DuplicateOfTierListID
+		}
+		tierLists = append(tierLists, LFTTierList{
+			TierListID:            tl.TierListID,
+			SeasonID:              tl.SeasonID,
+			Title:                 tl.Title,
+			AuthorName:            tl.AuthorName,
+			AuthorIP:              tl.AuthorIP,
+			TrustWeight:           tl.TrustWeight,
+			RankDistance:          tl.RankDistance,
+			ZScore:                tl.ZScore,
+			DuplicateOfTierListID: duplicateOf,
+			FlagReasons:           tl.FlagReasons,
+			EvaluatedAt:           tl.EvaluatedAt.Unix(),
+		})
+	}
+	return ListFlaggedTierListsResponse{
+		TierLists: tierLists,
+	}
+}
@@ -0,0 +1,252 @@
+//go:build wireinject
+// +build wireinject
+
+package tierlist
+
+import (
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/infrastructure/renderer"
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/job"
+	"poketier/pkg/blob"
+	"poketier/pkg/log"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeListTierListsHandler はListTierListsHandlerとその依存関係を初期化します
+func InitializeListTierListsHandler(queries db.Querier) *handler.ListTierListsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.FavoriteCountQuerier), new(db.Querier)),
+		wire.Bind(new(repository.LikeCountQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewFavoriteCountRepository,
+		repository.NewLikeCountRepository,
+		wire.Bind(new(usecase.LTLTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.LTLFavoriteCountRepository), new(*repository.FavoriteCountRepository)),
+		wire.Bind(new(usecase.LTLLikeCountRepository), new(*repository.LikeCountRepository)),
+
+		// Usecase provider
+		usecase.NewListTierListsUsecase,
+		wire.Bind(new(handler.ListTierListsUseCase), new(*usecase.ListTierListsUsecase)),
+
+		// Handler provider
+		handler.NewListTierListsHandler,
+	)
+	return &handler.ListTierListsHandler{}
+}
+
+// InitializeForkTierListHandler はForkTierListHandlerとその依存関係を初期化します
+func InitializeForkTierListHandler(queries db.Querier, txManager *sqlc.TxManager, consensusCache usecase.FTLConsensusCache) *handler.ForkTierListHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SeasonQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewDeckRepository,
+		repository.NewSeasonRepository,
+		repository.NewTierListRevisionRepository,
+		wire.Bind(new(usecase.FTLTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.FTLRevisionRepository), new(*repository.TierListRevisionRepository)),
+		wire.Bind(new(usecase.FTLDeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.FTLSeasonRepository), new(*repository.SeasonRepository)),
+		wire.Bind(new(usecase.FTLTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewForkTierListUsecase,
+		wire.Bind(new(handler.ForkTierListUseCase), new(*usecase.ForkTierListUsecase)),
+
+		// Handler provider
+		handler.NewForkTierListHandler,
+	)
+	return &handler.ForkTierListHandler{}
+}
+
+// InitializeListTierListForksHandler はListTierListForksHandlerとその依存関係を初期化します
+func InitializeListTierListForksHandler(queries db.Querier) *handler.ListTierListForksHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.FavoriteCountQuerier), new(db.Querier)),
+		wire.Bind(new(repository.LikeCountQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewFavoriteCountRepository,
+		repository.NewLikeCountRepository,
+		wire.Bind(new(usecase.LTFTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.LTFFavoriteCountRepository), new(*repository.FavoriteCountRepository)),
+		wire.Bind(new(usecase.LTFLikeCountRepository), new(*repository.LikeCountRepository)),
+
+		// Usecase provider
+		usecase.NewListTierListForksUsecase,
+		wire.Bind(new(handler.ListTierListForksUseCase), new(*usecase.ListTierListForksUsecase)),
+
+		// Handler provider
+		handler.NewListTierListForksHandler,
+	)
+	return &handler.ListTierListForksHandler{}
+}
+
+// InitializeSaveTierListPlacementsHandler はSaveTierListPlacementsHandlerとその依存関係を初期化します
+func InitializeSaveTierListPlacementsHandler(queries db.Querier, txManager *sqlc.TxManager, consensusCache usecase.STPConsensusCache) *handler.SaveTierListPlacementsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewTierListRevisionRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.STPTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.STPRevisionRepository), new(*repository.TierListRevisionRepository)),
+		wire.Bind(new(usecase.STPDeckRepository), new(*repository.DeckRepository)),
+		wire.Bind(new(usecase.STPTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewSaveTierListPlacementsUsecase,
+		wire.Bind(new(handler.SaveTierListPlacementsUseCase), new(*usecase.SaveTierListPlacementsUsecase)),
+
+		// Handler provider
+		handler.NewSaveTierListPlacementsHandler,
+	)
+	return &handler.SaveTierListPlacementsHandler{}
+}
+
+// InitializeListTierListRevisionsHandler はListTierListRevisionsHandlerとその依存関係を初期化します
+func InitializeListTierListRevisionsHandler(queries db.Querier) *handler.ListTierListRevisionsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewTierListRevisionRepository,
+		wire.Bind(new(usecase.LTRTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.LTRRevisionRepository), new(*repository.TierListRevisionRepository)),
+
+		// Usecase provider
+		usecase.NewListTierListRevisionsUsecase,
+		wire.Bind(new(handler.ListTierListRevisionsUseCase), new(*usecase.ListTierListRevisionsUsecase)),
+
+		// Handler provider
+		handler.NewListTierListRevisionsHandler,
+	)
+	return &handler.ListTierListRevisionsHandler{}
+}
+
+// InitializeDiffTierListRevisionsHandler はDiffTierListRevisionsHandlerとその依存関係を初期化します
+func InitializeDiffTierListRevisionsHandler(queries db.Querier) *handler.DiffTierListRevisionsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewTierListRevisionRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.DTRRevisionRepository), new(*repository.TierListRevisionRepository)),
+		wire.Bind(new(usecase.DTRDeckRepository), new(*repository.DeckRepository)),
+
+		// Usecase provider
+		usecase.NewDiffTierListRevisionsUsecase,
+		wire.Bind(new(handler.DiffTierListRevisionsUseCase), new(*usecase.DiffTierListRevisionsUsecase)),
+
+		// Handler provider
+		handler.NewDiffTierListRevisionsHandler,
+	)
+	return &handler.DiffTierListRevisionsHandler{}
+}
+
+// InitializeRestoreTierListRevisionHandler はRestoreTierListRevisionHandlerとその依存関係を初期化します
+func InitializeRestoreTierListRevisionHandler(queries db.Querier, txManager *sqlc.TxManager, consensusCache usecase.RTRConsensusCache) *handler.RestoreTierListRevisionHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewTierListRevisionRepository,
+		wire.Bind(new(usecase.RTRTierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.RTRRevisionRepository), new(*repository.TierListRevisionRepository)),
+		wire.Bind(new(usecase.RTRTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewRestoreTierListRevisionUsecase,
+		wire.Bind(new(handler.RestoreTierListRevisionUseCase), new(*usecase.RestoreTierListRevisionUsecase)),
+
+		// Handler provider
+		handler.NewRestoreTierListRevisionHandler,
+	)
+	return &handler.RestoreTierListRevisionHandler{}
+}
+
+// InitializeGetTierListImageHandler はGetTierListImageHandlerとその依存関係を初期化します
+func InitializeGetTierListImageHandler(queries db.Querier, txManager *sqlc.TxManager, blobStore *blob.LocalStore, logger log.Logger) *handler.GetTierListImageHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListQuerier), new(db.Querier)),
+		wire.Bind(new(repository.TierListRevisionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.DeckQuerier), new(db.Querier)),
+		repository.NewTierListRepository,
+		repository.NewTierListRevisionRepository,
+		repository.NewDeckRepository,
+		wire.Bind(new(usecase.GTITierListRepository), new(*repository.TierListRepository)),
+		wire.Bind(new(usecase.GTIRevisionRepository), new(*repository.TierListRevisionRepository)),
+		wire.Bind(new(usecase.GTIDeckRepository), new(*repository.DeckRepository)),
+
+		// Renderer provider
+		renderer.NewHTTPThumbnailLoader,
+		wire.Bind(new(renderer.ThumbnailLoader), new(*renderer.HTTPThumbnailLoader)),
+		renderer.NewTierListImageRenderer,
+		wire.Bind(new(usecase.GTIImageRenderer), new(*renderer.TierListImageRenderer)),
+		wire.Bind(new(usecase.GTIBlobStore), new(*blob.LocalStore)),
+		wire.Bind(new(usecase.GTITxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewGetTierListImageUsecase,
+		wire.Bind(new(handler.GetTierListImageUseCase), new(*usecase.GetTierListImageUsecase)),
+
+		// Handler provider
+		handler.NewGetTierListImageHandler,
+	)
+	return &handler.GetTierListImageHandler{}
+}
+
+// InitializeRecordTierListViewHandler はRecordTierListViewHandlerとその依存関係を初期化します
+func InitializeRecordTierListViewHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.RecordTierListViewHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListViewQuerier), new(db.Querier)),
+		repository.NewTierListViewRepository,
+		wire.Bind(new(usecase.RTVViewRepository), new(*repository.TierListViewRepository)),
+		wire.Bind(new(usecase.RTVTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewRecordTierListViewUsecase,
+		wire.Bind(new(handler.RecordTierListViewUseCase), new(*usecase.RecordTierListViewUsecase)),
+
+		// Handler provider
+		handler.NewRecordTierListViewHandler,
+	)
+	return &handler.RecordTierListViewHandler{}
+}
+
+// InitializeTrendingScoreRefreshJob はTrendingScoreRefreshJobとその依存関係を初期化します
+func InitializeTrendingScoreRefreshJob(queries db.Querier, logger log.Logger) *job.TrendingScoreRefreshJob {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.TierListViewQuerier), new(db.Querier)),
+		repository.NewTierListViewRepository,
+		wire.Bind(new(usecase.RTSViewRepository), new(*repository.TierListViewRepository)),
+
+		// Usecase provider
+		usecase.NewRefreshTrendingScoresUsecase,
+		wire.Bind(new(job.RefreshTrendingScoresUseCase), new(*usecase.RefreshTrendingScoresUsecase)),
+
+		// Job provider
+		job.NewTrendingScoreRefreshJob,
+	)
+	return &job.TrendingScoreRefreshJob{}
+}
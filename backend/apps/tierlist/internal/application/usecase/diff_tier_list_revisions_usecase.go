@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// DiffTierListRevisionsParams はリビジョン間の差分取得の入力
+type DiffTierListRevisionsParams struct {
+	TierListID   string
+	FromRevision string
+	ToRevision   string
+}
+
+// DiffTierListRevisionsResult はリビジョン間の差分取得結果
+type DiffTierListRevisionsResult struct {
+	FromRevision int
+	ToRevision   int
+	Changes      []DTRChange
+}
+
+// DTRChange はデッキ1件分の変更
+// 追加の場合は From 側、削除の場合は To 側が空となる
+type DTRChange struct {
+	DeckID       string
+	Nickname     string
+	Type         string
+	FromTierRank string
+	ToTierRank   string
+	FromPosition *int
+	ToPosition   *int
+	Summary      string
+}
+
+type DTRRevisionRepository interface {
+	FindByNumber(ctx context.Context, tierListID id.TierListID, number int) (*entity.TierListRevision, error)
+}
+
+type DTRDeckRepository interface {
+	FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error)
+}
+
+type DiffTierListRevisionsUsecase struct {
+	revisionRepo DTRRevisionRepository
+	deckRepo     DTRDeckRepository
+}
+
+func NewDiffTierListRevisionsUsecase(revisionRepo DTRRevisionRepository, deckRepo DTRDeckRepository) *DiffTierListRevisionsUsecase {
+	return &DiffTierListRevisionsUsecase{
+		revisionRepo: revisionRepo,
+		deckRepo:     deckRepo,
+	}
+}
+
+// Execute はリビジョン間の差分取得を実行
+func (u *DiffTierListRevisionsUsecase) Execute(ctx context.Context, params DiffTierListRevisionsParams) (*DiffTierListRevisionsResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+	fromNumber, err := parseRevisionNumber(params.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+	toNumber, err := parseRevisionNumber(params.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := u.revisionRepo.FindByNumber(ctx, tierListID, fromNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find from revision: %w", err)
+	}
+	to, err := u.revisionRepo.FindByNumber(ctx, tierListID, toNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find to revision: %w", err)
+	}
+
+	changes := entity.DiffPlacements(from.Placements(), to.Placements())
+
+	nicknames, err := u.findNicknames(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DiffTierListRevisionsResult{
+		FromRevision: fromNumber,
+		ToRevision:   toNumber,
+		Changes:      make([]DTRChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		result.Changes = append(result.Changes, toDTRChange(change, nicknames[change.DeckID]))
+	}
+
+	return result, nil
+}
+
+// findNicknames は変更のあったデッキのニックネームを取得
+func (u *DiffTierListRevisionsUsecase) findNicknames(ctx context.Context, changes []entity.PlacementChange) (map[id.DeckID]string, error) {
+	nicknames := make(map[id.DeckID]string, len(changes))
+	if len(changes) == 0 {
+		return nicknames, nil
+	}
+
+	deckIDs := make([]id.DeckID, 0, len(changes))
+	for _, change := range changes {
+		deckIDs = append(deckIDs, change.DeckID)
+	}
+	decks, err := u.deckRepo.FindByIDs(ctx, deckIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decks: %w", err)
+	}
+	for _, deck := range decks {
+		nicknames[deck.ID()] = deck.Nickname()
+	}
+
+	return nicknames, nil
+}
+
+// toDTRChange は変更を出力用に変換し、「リザニンフ: A → S」形式の要約を付与する
+// 同じティア内での並び替えは「リザニンフ: A#3 → A#1」（1始まりの順位）とする
+func toDTRChange(change entity.PlacementChange, nickname string) DTRChange {
+	if nickname == "" {
+		nickname = change.DeckID.String()
+	}
+
+	c := DTRChange{
+		DeckID:   change.DeckID.String(),
+		Nickname: nickname,
+		Type:     string(change.Type),
+	}
+	from, to := "-", "-"
+	if change.From != nil {
+		position := change.From.Position
+		c.FromTierRank = change.From.TierRank.String()
+		c.FromPosition = &position
+		from = c.FromTierRank
+	}
+	if change.To != nil {
+		position := change.To.Position
+		c.ToTierRank = change.To.TierRank.String()
+		c.ToPosition = &position
+		to = c.ToTierRank
+	}
+	if change.Type == entity.PlacementChangeReordered {
+		from = fmt.Sprintf("%s#%d", c.FromTierRank, *c.FromPosition+1)
+		to = fmt.Sprintf("%s#%d", c.ToTierRank, *c.ToPosition+1)
+	}
+	c.Summary = fmt.Sprintf("%s: %s → %s", nickname, from, to)
+
+	return c
+}
+
+// parseRevisionNumber はリビジョン番号の文字列を検証して数値に変換
+func parseRevisionNumber(s string) (int, error) {
+	number, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errs.NewValidationError("invalid revision_number", err)
+	}
+	if number < 1 {
+		return 0, errs.NewValidationError("invalid revision_number", fmt.Errorf("revision number must be 1 or greater: %d", number))
+	}
+	return number, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/diff_tier_list_revisions_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/diff_tier_list_revisions_usecase.go -destination=./apps/tierlist/internal/application/usecase/diff_tier_list_revisions_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDTRRevisionRepository is a mock of DTRRevisionRepository interface.
+type MockDTRRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDTRRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDTRRevisionRepositoryMockRecorder is the mock recorder for MockDTRRevisionRepository.
+type MockDTRRevisionRepositoryMockRecorder struct {
+	mock *MockDTRRevisionRepository
+}
+
+// NewMockDTRRevisionRepository creates a new mock instance.
+func NewMockDTRRevisionRepository(ctrl *gomock.Controller) *MockDTRRevisionRepository {
+	mock := &MockDTRRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockDTRRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDTRRevisionRepository) EXPECT() *MockDTRRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByNumber mocks base method.
+func (m *MockDTRRevisionRepository) FindByNumber(ctx context.Context, tierListID id.TierListID, number int) (*entity.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNumber", ctx, tierListID, number)
+	ret0, _ := ret[0].(*entity.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNumber indicates an expected call of FindByNumber.
+func (mr *MockDTRRevisionRepositoryMockRecorder) FindByNumber(ctx, tierListID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNumber", reflect.TypeOf((*MockDTRRevisionRepository)(nil).FindByNumber), ctx, tierListID, number)
+}
+
+// MockDTRDeckRepository is a mock of DTRDeckRepository interface.
+type MockDTRDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDTRDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDTRDeckRepositoryMockRecorder is the mock recorder for MockDTRDeckRepository.
+type MockDTRDeckRepositoryMockRecorder struct {
+	mock *MockDTRDeckRepository
+}
+
+// NewMockDTRDeckRepository creates a new mock instance.
+func NewMockDTRDeckRepository(ctrl *gomock.Controller) *MockDTRDeckRepository {
+	mock := &MockDTRDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockDTRDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDTRDeckRepository) EXPECT() *MockDTRDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDs mocks base method.
+func (m *MockDTRDeckRepository) FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, deckIDs)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockDTRDeckRepositoryMockRecorder) FindByIDs(ctx, deckIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockDTRDeckRepository)(nil).FindByIDs), ctx, deckIDs)
+}
@@ -0,0 +1,135 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffTierListRevisionsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	moved, reordered, added, removed, stayed := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	card := id.NewCardID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	from, err := entity.ReconstructTierListRevision(tierListID, 1, []entity.PlacementSnapshot{
+		{DeckID: moved, TierRank: rank.TierA, Position: 0},
+		{DeckID: stayed, TierRank: rank.TierB, Position: 0},
+		{DeckID: reordered, TierRank: rank.TierB, Position: 1},
+		{DeckID: removed, TierRank: rank.TierC, Position: 0},
+	}, []entity.PlacementChange{}, nil, createdAt)
+	assert.NoError(t, err, "failed to create revision")
+	to, err := entity.ReconstructTierListRevision(tierListID, 2, []entity.PlacementSnapshot{
+		{DeckID: moved, TierRank: rank.TierS, Position: 0},
+		{DeckID: reordered, TierRank: rank.TierB, Position: 0},
+		{DeckID: stayed, TierRank: rank.TierB, Position: 1},
+		{DeckID: added, TierRank: rank.TierC, Position: 0},
+	}, []entity.PlacementChange{}, nil, createdAt.Add(time.Hour))
+	assert.NoError(t, err, "failed to create revision")
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		caseName    string
+		params      usecase.DiffTierListRevisionsParams
+		setupMock   func(revisionRepo *MockDTRRevisionRepository, deckRepo *MockDTRDeckRepository)
+		want        *usecase.DiffTierListRevisionsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: リビジョン間の変更がデッキ名付きの要約とともに返される",
+			params:   usecase.DiffTierListRevisionsParams{TierListID: testTierListID, FromRevision: "1", ToRevision: "2"},
+			setupMock: func(revisionRepo *MockDTRRevisionRepository, deckRepo *MockDTRDeckRepository) {
+				revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 1).Return(from, nil)
+				revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 2).Return(to, nil)
+				deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return([]*entity.Deck{
+					entity.ReconstructDeck(moved, seasonID, []id.CardID{card}, "リザニンフ", ""),
+					entity.ReconstructDeck(reordered, seasonID, []id.CardID{card}, "ピカチュウex", ""),
+					entity.ReconstructDeck(stayed, seasonID, []id.CardID{card}, "ミュウツーex", ""),
+					entity.ReconstructDeck(added, seasonID, []id.CardID{card}, "セレビィex", ""),
+				}, nil)
+			},
+			want: &usecase.DiffTierListRevisionsResult{
+				FromRevision: 1,
+				ToRevision:   2,
+				Changes: []usecase.DTRChange{
+					{DeckID: moved.String(), Nickname: "リザニンフ", Type: "moved", FromTierRank: "A", ToTierRank: "S", FromPosition: intPtr(0), ToPosition: intPtr(0), Summary: "リザニンフ: A → S"},
+					{DeckID: reordered.String(), Nickname: "ピカチュウex", Type: "reordered", FromTierRank: "B", ToTierRank: "B", FromPosition: intPtr(1), ToPosition: intPtr(0), Summary: "ピカチュウex: B#2 → B#1"},
+					{DeckID: stayed.String(), Nickname: "ミュウツーex", Type: "reordered", FromTierRank: "B", ToTierRank: "B", FromPosition: intPtr(0), ToPosition: intPtr(1), Summary: "ミュウツーex: B#1 → B#2"},
+					{DeckID: added.String(), Nickname: "セレビィex", Type: "added", ToTierRank: "C", ToPosition: intPtr(0), Summary: "セレビィex: - → C"},
+					// 削除済みでデッキ名が取得できない場合はデッキIDで表示する
+					{DeckID: removed.String(), Nickname: removed.String(), Type: "removed", FromTierRank: "C", FromPosition: intPtr(0), Summary: removed.String() + ": C → -"},
+				},
+			},
+		},
+		{
+			caseName: "正常系: 同じリビジョン同士の場合、空の変更が返される",
+			params:   usecase.DiffTierListRevisionsParams{TierListID: testTierListID, FromRevision: "2", ToRevision: "2"},
+			setupMock: func(revisionRepo *MockDTRRevisionRepository, deckRepo *MockDTRDeckRepository) {
+				revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 2).Return(to, nil).Times(2)
+			},
+			want: &usecase.DiffTierListRevisionsResult{FromRevision: 2, ToRevision: 2, Changes: []usecase.DTRChange{}},
+		},
+		{
+			caseName:    "異常系: リビジョン番号が数値でない場合、バリデーションエラーを返す",
+			params:      usecase.DiffTierListRevisionsParams{TierListID: testTierListID, FromRevision: "1", ToRevision: "latest"},
+			setupMock:   func(revisionRepo *MockDTRRevisionRepository, deckRepo *MockDTRDeckRepository) {},
+			wantErr:     true,
+			errContains: "invalid revision_number",
+		},
+		{
+			caseName: "異常系: リビジョンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.DiffTierListRevisionsParams{TierListID: testTierListID, FromRevision: "1", ToRevision: "9"},
+			setupMock: func(revisionRepo *MockDTRRevisionRepository, deckRepo *MockDTRDeckRepository) {
+				revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 1).Return(from, nil)
+				revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 9).Return(nil, errs.NewNotFoundError("revision not found", nil))
+			},
+			wantErr:     true,
+			errContains: "revision not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revisionRepo := NewMockDTRRevisionRepository(ctrl)
+			deckRepo := NewMockDTRDeckRepository(ctrl)
+			tt.setupMock(revisionRepo, deckRepo)
+
+			usecase := usecase.NewDiffTierListRevisionsUsecase(revisionRepo, deckRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// ForkTierListParams はティアリストのフォークの入力
+// SeasonID が空の場合はフォーク元と同じシーズン、Title が空の場合はフォーク元のタイトルを使用する
+// AuthorIP は投稿元のIPアドレスで、荒らし検知のためにフォーク先へ記録する
+// AuthorUserID はログイン中のユーザーで、未ログインの場合は nil
+type ForkTierListParams struct {
+	TierListID   string
+	SeasonID     string
+	Title        string
+	AuthorName   string
+	AuthorIP     string
+	AuthorUserID *id.UserID
+}
+
+// ForkTierListResult はティアリストのフォーク結果
+// DroppedDecks はフォーク先のシーズンに存在しないため除外されたデッキ
+type ForkTierListResult struct {
+	TierList     FTLTierList
+	DroppedDecks []FTLDroppedDeck
+}
+
+type FTLTierList struct {
+	TierListID           string
+	SeasonID             string
+	ForkedFromTierListID string
+	Title                string
+	Description          string
+	AuthorName           string
+	Placements           []FTLPlacement
+	CreatedAt            time.Time
+}
+
+type FTLPlacement struct {
+	DeckID   string
+	TierRank string
+	Position int
+}
+
+type FTLDroppedDeck struct {
+	DeckID   string
+	Nickname string
+}
+
+type FTLTierListRepository interface {
+	FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+	Create(ctx context.Context, tierList *entity.TierList) error
+	IncrementForkCount(ctx context.Context, tierListID id.TierListID) error
+}
+
+type FTLRevisionRepository interface {
+	Create(ctx context.Context, revision *entity.TierListRevision) error
+}
+
+type FTLDeckRepository interface {
+	FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error)
+	FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error)
+}
+
+type FTLSeasonRepository interface {
+	Exists(ctx context.Context, seasonID id.SeasonID) (bool, error)
+}
+
+type FTLTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type FTLConsensusCache interface {
+	InvalidateSeason(seasonID id.SeasonID)
+}
+
+type ForkTierListUsecase struct {
+	tierListRepo FTLTierListRepository
+	revisionRepo FTLRevisionRepository
+	deckRepo     FTLDeckRepository
+	seasonRepo   FTLSeasonRepository
+	txManager    FTLTxManager
+	cache        FTLConsensusCache
+}
+
+func NewForkTierListUsecase(
+	tierListRepo FTLTierListRepository,
+	revisionRepo FTLRevisionRepository,
+	deckRepo FTLDeckRepository,
+	seasonRepo FTLSeasonRepository,
+	txManager FTLTxManager,
+	cache FTLConsensusCache,
+) *ForkTierListUsecase {
+	return &ForkTierListUsecase{
+		tierListRepo: tierListRepo,
+		revisionRepo: revisionRepo,
+		deckRepo:     deckRepo,
+		seasonRepo:   seasonRepo,
+		txManager:    txManager,
+		cache:        cache,
+	}
+}
+
+// Execute はティアリストのフォークを実行
+func (u *ForkTierListUsecase) Execute(ctx context.Context, params ForkTierListParams) (*ForkTierListResult, error) {
+	sourceID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	source, err := u.tierListRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tier list: %w", err)
+	}
+
+	seasonID, err := u.resolveSeasonID(ctx, source, params.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	deckMapping, sourceDecks, err := u.mapDecks(ctx, source, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	forked, droppedIDs, err := source.Fork(id.NewTierListID(), seasonID, params.Title, params.AuthorName, deckMapping)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid fork parameters", err)
+	}
+	forked.RecordAuthorIP(params.AuthorIP)
+	if params.AuthorUserID != nil {
+		forked.AttributeToUser(*params.AuthorUserID)
+	}
+
+	// フォーク時の配置を最初のリビジョンとして記録する
+	revision, err := entity.NewTierListRevision(forked.ID(), 1, forked.Snapshot(), entity.DiffPlacements(nil, forked.Snapshot()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create revision: %w", err)
+	}
+
+	// フォークの保存とフォーク元のフォーク数の更新は同一トランザクションで行う
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.tierListRepo.Create(ctx, forked); err != nil {
+			return fmt.Errorf("failed to create forked tier list: %w", err)
+		}
+		if err := u.revisionRepo.Create(ctx, revision); err != nil {
+			return fmt.Errorf("failed to save revision: %w", err)
+		}
+		if err := u.tierListRepo.IncrementForkCount(ctx, source.ID()); err != nil {
+			return fmt.Errorf("failed to increment fork count: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// フォークの配置が加わったシーズンの集計結果は再計算させる
+	u.cache.InvalidateSeason(seasonID)
+
+	return u.toResult(forked, droppedIDs, sourceDecks), nil
+}
+
+// resolveSeasonID はフォーク先のシーズンIDを決定し、存在を確認する
+func (u *ForkTierListUsecase) resolveSeasonID(ctx context.Context, source *entity.TierList, seasonIDStr string) (id.SeasonID, error) {
+	if seasonIDStr == "" {
+		return source.SeasonID(), nil
+	}
+
+	seasonID, err := id.SeasonIDFromString(seasonIDStr)
+	if err != nil {
+		return id.SeasonID{}, errs.NewValidationError("invalid season_id", err)
+	}
+	if seasonID.Equals(source.SeasonID()) {
+		return seasonID, nil
+	}
+
+	exists, err := u.seasonRepo.Exists(ctx, seasonID)
+	if err != nil {
+		return id.SeasonID{}, fmt.Errorf("failed to check season existence: %w", err)
+	}
+	if !exists {
+		return id.SeasonID{}, errs.NewNotFoundError("season not found", nil)
+	}
+
+	return seasonID, nil
+}
+
+// mapDecks はフォーク元のデッキからフォーク先シーズンのデッキへの対応を作成する
+// 同じシーズンへのフォークではデッキをそのまま引き継ぎ、別シーズンへのフォークではカード構成が同じデッキに対応付ける
+func (u *ForkTierListUsecase) mapDecks(
+	ctx context.Context, source *entity.TierList, seasonID id.SeasonID,
+) (map[id.DeckID]id.DeckID, []*entity.Deck, error) {
+	deckIDs := make([]id.DeckID, 0, len(source.Placements()))
+	for _, placement := range source.Placements() {
+		deckIDs = append(deckIDs, placement.DeckID())
+	}
+
+	if seasonID.Equals(source.SeasonID()) {
+		mapping := make(map[id.DeckID]id.DeckID, len(deckIDs))
+		for _, deckID := range deckIDs {
+			mapping[deckID] = deckID
+		}
+		return mapping, nil, nil
+	}
+
+	sourceDecks, err := u.deckRepo.FindByIDs(ctx, deckIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find source decks: %w", err)
+	}
+	targetDecks, err := u.deckRepo.FindBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find target season decks: %w", err)
+	}
+
+	return entity.MapDecksToSeason(sourceDecks, targetDecks), sourceDecks, nil
+}
+
+func (u *ForkTierListUsecase) toResult(forked *entity.TierList, droppedIDs []id.DeckID, sourceDecks []*entity.Deck) *ForkTierListResult {
+	placements := make([]FTLPlacement, 0, len(forked.Placements()))
+	for _, placement := range forked.Placements() {
+		placements = append(placements, FTLPlacement{
+			DeckID:   placement.DeckID().String(),
+			TierRank: placement.TierRank().String(),
+			Position: placement.Position(),
+		})
+	}
+
+	nicknames := make(map[id.DeckID]string, len(sourceDecks))
+	for _, deck := range sourceDecks {
+		nicknames[deck.ID()] = deck.Nickname()
+	}
+	droppedDecks := make([]FTLDroppedDeck, 0, len(droppedIDs))
+	for _, deckID := range droppedIDs {
+		droppedDecks = append(droppedDecks, FTLDroppedDeck{
+			DeckID:   deckID.String(),
+			Nickname: nicknames[deckID],
+		})
+	}
+
+	return &ForkTierListResult{
+		TierList: FTLTierList{
+			TierListID:           forked.ID().String(),
+			SeasonID:             forked.SeasonID().String(),
+			ForkedFromTierListID: forked.ForkedFrom().String(),
+			Title:                forked.Title(),
+			Description:          forked.Description(),
+			AuthorName:           forked.AuthorName(),
+			Placements:           placements,
+			CreatedAt:            forked.CreatedAt(),
+		},
+		DroppedDecks: droppedDecks,
+	}
+}
@@ -0,0 +1,291 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/fork_tier_list_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/fork_tier_list_usecase.go -destination=./apps/tierlist/internal/application/usecase/fork_tier_list_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFTLTierListRepository is a mock of FTLTierListRepository interface.
+type MockFTLTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLTierListRepositoryMockRecorder is the mock recorder for MockFTLTierListRepository.
+type MockFTLTierListRepositoryMockRecorder struct {
+	mock *MockFTLTierListRepository
+}
+
+// NewMockFTLTierListRepository creates a new mock instance.
+func NewMockFTLTierListRepository(ctrl *gomock.Controller) *MockFTLTierListRepository {
+	mock := &MockFTLTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockFTLTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLTierListRepository) EXPECT() *MockFTLTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFTLTierListRepository) Create(ctx context.Context, tierList *entity.TierList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tierList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFTLTierListRepositoryMockRecorder) Create(ctx, tierList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFTLTierListRepository)(nil).Create), ctx, tierList)
+}
+
+// FindByID mocks base method.
+func (m *MockFTLTierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockFTLTierListRepositoryMockRecorder) FindByID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockFTLTierListRepository)(nil).FindByID), ctx, tierListID)
+}
+
+// IncrementForkCount mocks base method.
+func (m *MockFTLTierListRepository) IncrementForkCount(ctx context.Context, tierListID id.TierListID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementForkCount", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementForkCount indicates an expected call of IncrementForkCount.
+func (mr *MockFTLTierListRepositoryMockRecorder) IncrementForkCount(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementForkCount", reflect.TypeOf((*MockFTLTierListRepository)(nil).IncrementForkCount), ctx, tierListID)
+}
+
+// MockFTLRevisionRepository is a mock of FTLRevisionRepository interface.
+type MockFTLRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLRevisionRepositoryMockRecorder is the mock recorder for MockFTLRevisionRepository.
+type MockFTLRevisionRepositoryMockRecorder struct {
+	mock *MockFTLRevisionRepository
+}
+
+// NewMockFTLRevisionRepository creates a new mock instance.
+func NewMockFTLRevisionRepository(ctrl *gomock.Controller) *MockFTLRevisionRepository {
+	mock := &MockFTLRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockFTLRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLRevisionRepository) EXPECT() *MockFTLRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFTLRevisionRepository) Create(ctx context.Context, revision *entity.TierListRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFTLRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFTLRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// MockFTLDeckRepository is a mock of FTLDeckRepository interface.
+type MockFTLDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLDeckRepositoryMockRecorder is the mock recorder for MockFTLDeckRepository.
+type MockFTLDeckRepositoryMockRecorder struct {
+	mock *MockFTLDeckRepository
+}
+
+// NewMockFTLDeckRepository creates a new mock instance.
+func NewMockFTLDeckRepository(ctrl *gomock.Controller) *MockFTLDeckRepository {
+	mock := &MockFTLDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockFTLDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLDeckRepository) EXPECT() *MockFTLDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDs mocks base method.
+func (m *MockFTLDeckRepository) FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, deckIDs)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockFTLDeckRepositoryMockRecorder) FindByIDs(ctx, deckIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockFTLDeckRepository)(nil).FindByIDs), ctx, deckIDs)
+}
+
+// FindBySeason mocks base method.
+func (m *MockFTLDeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeason", ctx, seasonID)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeason indicates an expected call of FindBySeason.
+func (mr *MockFTLDeckRepositoryMockRecorder) FindBySeason(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeason", reflect.TypeOf((*MockFTLDeckRepository)(nil).FindBySeason), ctx, seasonID)
+}
+
+// MockFTLSeasonRepository is a mock of FTLSeasonRepository interface.
+type MockFTLSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLSeasonRepositoryMockRecorder is the mock recorder for MockFTLSeasonRepository.
+type MockFTLSeasonRepositoryMockRecorder struct {
+	mock *MockFTLSeasonRepository
+}
+
+// NewMockFTLSeasonRepository creates a new mock instance.
+func NewMockFTLSeasonRepository(ctrl *gomock.Controller) *MockFTLSeasonRepository {
+	mock := &MockFTLSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockFTLSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLSeasonRepository) EXPECT() *MockFTLSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockFTLSeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockFTLSeasonRepositoryMockRecorder) Exists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockFTLSeasonRepository)(nil).Exists), ctx, seasonID)
+}
+
+// MockFTLTxManager is a mock of FTLTxManager interface.
+type MockFTLTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLTxManagerMockRecorder is the mock recorder for MockFTLTxManager.
+type MockFTLTxManagerMockRecorder struct {
+	mock *MockFTLTxManager
+}
+
+// NewMockFTLTxManager creates a new mock instance.
+func NewMockFTLTxManager(ctrl *gomock.Controller) *MockFTLTxManager {
+	mock := &MockFTLTxManager{ctrl: ctrl}
+	mock.recorder = &MockFTLTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLTxManager) EXPECT() *MockFTLTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockFTLTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockFTLTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockFTLTxManager)(nil).RunInTx), ctx, fn)
+}
+
+// MockFTLConsensusCache is a mock of FTLConsensusCache interface.
+type MockFTLConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockFTLConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockFTLConsensusCacheMockRecorder is the mock recorder for MockFTLConsensusCache.
+type MockFTLConsensusCacheMockRecorder struct {
+	mock *MockFTLConsensusCache
+}
+
+// NewMockFTLConsensusCache creates a new mock instance.
+func NewMockFTLConsensusCache(ctrl *gomock.Controller) *MockFTLConsensusCache {
+	mock := &MockFTLConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockFTLConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFTLConsensusCache) EXPECT() *MockFTLConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateSeason mocks base method.
+func (m *MockFTLConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateSeason", seasonID)
+}
+
+// InvalidateSeason indicates an expected call of InvalidateSeason.
+func (mr *MockFTLConsensusCacheMockRecorder) InvalidateSeason(seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSeason", reflect.TypeOf((*MockFTLConsensusCache)(nil).InvalidateSeason), seasonID)
+}
@@ -0,0 +1,229 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const testTargetSeasonID = "0198934f-7780-781a-bb9b-d8957ea79100"
+
+func TestForkTierListUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	targetSeasonID, _ := id.SeasonIDFromString(testTargetSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	deckS, deckA := id.NewDeckID(), id.NewDeckID()
+	targetDeckS := id.NewDeckID()
+	cardS, cardA := id.NewCardID(), id.NewCardID()
+	userID := id.NewUserID()
+
+	type mocks struct {
+		tierListRepo *MockFTLTierListRepository
+		revisionRepo *MockFTLRevisionRepository
+		deckRepo     *MockFTLDeckRepository
+		seasonRepo   *MockFTLSeasonRepository
+		txManager    *MockFTLTxManager
+		cache        *MockFTLConsensusCache
+	}
+
+	// runInTx はトランザクション内の処理をそのまま実行させる
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	tests := []struct {
+		caseName         string
+		params           usecase.ForkTierListParams
+		setupMock        func(m mocks, source *entity.TierList)
+		wantSeasonID     string
+		wantTitle        string
+		wantPlacements   []usecase.FTLPlacement
+		wantDroppedDecks []usecase.FTLDroppedDeck
+		wantErr          bool
+		errContains      string
+	}{
+		{
+			caseName: "正常系: 同じシーズンにフォークした場合、全ての配置が複製されフォーク数が更新される",
+			params: usecase.ForkTierListParams{
+				TierListID:   testTierListID,
+				AuthorName:   "視聴者B",
+				AuthorIP:     "192.0.2.1",
+				AuthorUserID: &userID,
+			},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+				runInTx(m)
+				// 投稿元のIPアドレスとログイン中のユーザーがフォーク先に記録される
+				m.tierListRepo.EXPECT().Create(gomock.Any(), gomock.Cond(func(forked *entity.TierList) bool {
+					return forked.AuthorIP() == "192.0.2.1" && forked.AuthorUserID() != nil && forked.AuthorUserID().Equals(userID)
+				})).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.tierListRepo.EXPECT().IncrementForkCount(gomock.Any(), tierListID).Return(nil)
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+			wantSeasonID: testSeasonID,
+			wantTitle:    "A4環境ティアリスト",
+			wantPlacements: []usecase.FTLPlacement{
+				{DeckID: deckS.String(), TierRank: "S", Position: 0},
+				{DeckID: deckA.String(), TierRank: "A", Position: 0},
+			},
+			wantDroppedDecks: []usecase.FTLDroppedDeck{},
+		},
+		{
+			caseName: "正常系: 別シーズンにフォークした場合、存在しないデッキは除外されて報告される",
+			params: usecase.ForkTierListParams{
+				TierListID: testTierListID,
+				SeasonID:   testTargetSeasonID,
+				Title:      "B1環境ティアリスト",
+			},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), targetSeasonID).Return(true, nil)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), []id.DeckID{deckS, deckA}).Return([]*entity.Deck{
+					entity.ReconstructDeck(deckS, seasonID, []id.CardID{cardS}, "Sデッキ", ""),
+					entity.ReconstructDeck(deckA, seasonID, []id.CardID{cardA}, "Aデッキ", ""),
+				}, nil)
+				m.deckRepo.EXPECT().FindBySeason(gomock.Any(), targetSeasonID).Return([]*entity.Deck{
+					entity.ReconstructDeck(targetDeckS, targetSeasonID, []id.CardID{cardS}, "Sデッキ", ""),
+				}, nil)
+				runInTx(m)
+				// 未ログインの場合は作成者のユーザーを記録しない
+				m.tierListRepo.EXPECT().Create(gomock.Any(), gomock.Cond(func(forked *entity.TierList) bool {
+					return forked.AuthorUserID() == nil
+				})).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.tierListRepo.EXPECT().IncrementForkCount(gomock.Any(), tierListID).Return(nil)
+				// フォーク先のシーズンの集計結果が無効化される
+				m.cache.EXPECT().InvalidateSeason(targetSeasonID)
+			},
+			wantSeasonID: testTargetSeasonID,
+			wantTitle:    "B1環境ティアリスト",
+			wantPlacements: []usecase.FTLPlacement{
+				{DeckID: targetDeckS.String(), TierRank: "S", Position: 0},
+			},
+			wantDroppedDecks: []usecase.FTLDroppedDeck{
+				{DeckID: deckA.String(), Nickname: "Aデッキ"},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ForkTierListParams{TierListID: "invalid"},
+			setupMock:   func(m mocks, source *entity.TierList) {},
+			wantErr:     true,
+			errContains: "invalid tier_list_id",
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ForkTierListParams{TierListID: testTierListID, SeasonID: "invalid"},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: フォーク先のシーズンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ForkTierListParams{TierListID: testTierListID, SeasonID: testTargetSeasonID},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+				m.seasonRepo.EXPECT().Exists(gomock.Any(), targetSeasonID).Return(false, nil)
+			},
+			wantErr:     true,
+			errContains: "season not found",
+		},
+		{
+			caseName: "異常系: フォーク元の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ForkTierListParams{TierListID: testTierListID},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "repository error",
+		},
+		{
+			caseName: "異常系: リビジョンの保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.ForkTierListParams{TierListID: testTierListID},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+				runInTx(m)
+				m.tierListRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to save revision",
+		},
+		{
+			caseName: "異常系: フォーク数の更新でエラーが発生した場合、エラーを返す",
+			params:   usecase.ForkTierListParams{TierListID: testTierListID},
+			setupMock: func(m mocks, source *entity.TierList) {
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(source, nil)
+				runInTx(m)
+				m.tierListRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.tierListRepo.EXPECT().IncrementForkCount(gomock.Any(), tierListID).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to increment fork count",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				tierListRepo: NewMockFTLTierListRepository(ctrl),
+				revisionRepo: NewMockFTLRevisionRepository(ctrl),
+				deckRepo:     NewMockFTLDeckRepository(ctrl),
+				seasonRepo:   NewMockFTLSeasonRepository(ctrl),
+				txManager:    NewMockFTLTxManager(ctrl),
+				cache:        NewMockFTLConsensusCache(ctrl),
+			}
+			source := createTestTierList(t, tierListID, seasonID, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+			assert.NoError(t, source.PlaceDeck(id.NewTierPlacementID(), deckS, rank.TierS, 0), "failed to place deck")
+			assert.NoError(t, source.PlaceDeck(id.NewTierPlacementID(), deckA, rank.TierA, 0), "failed to place deck")
+			tt.setupMock(m, source)
+
+			usecase := usecase.NewForkTierListUsecase(m.tierListRepo, m.revisionRepo, m.deckRepo, m.seasonRepo, m.txManager, m.cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.NotEqual(t, testTierListID, got.TierList.TierListID, "forked tier list should have a new ID")
+			assert.Equal(t, testTierListID, got.TierList.ForkedFromTierListID, "forked from does not match")
+			assert.Equal(t, tt.wantSeasonID, got.TierList.SeasonID, "season ID does not match")
+			assert.Equal(t, tt.wantTitle, got.TierList.Title, "title does not match")
+			assert.Equal(t, tt.wantPlacements, got.TierList.Placements, "placements do not match")
+			assert.Equal(t, tt.wantDroppedDecks, got.DroppedDecks, "dropped decks do not match")
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/blob"
+	"poketier/pkg/errs"
+	"poketier/pkg/log"
+	"poketier/pkg/vo/id"
+)
+
+// GetTierListImageParams はティアリスト画像取得の入力
+type GetTierListImageParams struct {
+	TierListID string
+}
+
+// GetTierListImageResult はティアリスト画像取得結果
+// Version は画像の元になったリビジョン番号で、キャッシュの検証に使用できる
+// Complete はサムネイルの取得に失敗して代替のタイルで描画した場合に false となる
+type GetTierListImageResult struct {
+	PNG      []byte
+	Version  int
+	Complete bool
+}
+
+type GTITierListRepository interface {
+	FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+}
+
+type GTIRevisionRepository interface {
+	LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error)
+}
+
+type GTIDeckRepository interface {
+	FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error)
+}
+
+type GTIImageRenderer interface {
+	Render(ctx context.Context, tierList *entity.TierList, decks []*entity.Deck) (rendered []byte, complete bool, err error)
+}
+
+type GTIBlobStore interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+type GTITxManager interface {
+	RunInReadOnlySnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type GetTierListImageUsecase struct {
+	tierListRepo GTITierListRepository
+	revisionRepo GTIRevisionRepository
+	deckRepo     GTIDeckRepository
+	renderer     GTIImageRenderer
+	blobStore    GTIBlobStore
+	txManager    GTITxManager
+	logger       log.Logger
+}
+
+func NewGetTierListImageUsecase(
+	tierListRepo GTITierListRepository,
+	revisionRepo GTIRevisionRepository,
+	deckRepo GTIDeckRepository,
+	renderer GTIImageRenderer,
+	blobStore GTIBlobStore,
+	txManager GTITxManager,
+	logger log.Logger,
+) *GetTierListImageUsecase {
+	return &GetTierListImageUsecase{
+		tierListRepo: tierListRepo,
+		revisionRepo: revisionRepo,
+		deckRepo:     deckRepo,
+		renderer:     renderer,
+		blobStore:    blobStore,
+		txManager:    txManager,
+		logger:       logger,
+	}
+}
+
+// Execute はティアリスト画像取得を実行
+// 画像はリビジョンごとにBlobストアへキャッシュし、配置が変わるまでは再描画しない
+// 配置とリビジョン番号は同じスナップショットから読み取り、別のリビジョンの配置をキャッシュしないようにする
+func (u *GetTierListImageUsecase) Execute(ctx context.Context, params GetTierListImageParams) (*GetTierListImageResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	var (
+		tierList *entity.TierList
+		version  int
+	)
+	err = u.txManager.RunInReadOnlySnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		tierList, err = u.tierListRepo.FindByID(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to find tier list: %w", err)
+		}
+
+		version, err = u.revisionRepo.LatestNumber(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest revision number: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := tierListImageKey(tierListID, version)
+	cached, err := u.blobStore.Get(ctx, key)
+	if err == nil {
+		return &GetTierListImageResult{PNG: cached, Version: version, Complete: true}, nil
+	}
+	if !errors.Is(err, blob.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get cached image: %w", err)
+	}
+
+	decks, err := u.findDecks(ctx, tierList)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, complete, err := u.renderer.Render(ctx, tierList, decks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render image: %w", err)
+	}
+
+	// 代替のタイルを含む画像はキャッシュせず、次回のリクエストでサムネイルの取得をやり直す
+	if complete {
+		// キャッシュの保存に失敗しても次回のリクエストで再描画されるだけのため、画像は返す
+		if err := u.blobStore.Put(ctx, key, rendered); err != nil {
+			u.logger.Warn("Failed to cache tier list image", "tier_list_id", tierListID.String(), "version", version, "error", err)
+		}
+	}
+
+	return &GetTierListImageResult{PNG: rendered, Version: version, Complete: complete}, nil
+}
+
+// findDecks は配置されたデッキを取得
+func (u *GetTierListImageUsecase) findDecks(ctx context.Context, tierList *entity.TierList) ([]*entity.Deck, error) {
+	if len(tierList.Placements()) == 0 {
+		return []*entity.Deck{}, nil
+	}
+
+	deckIDs := make([]id.DeckID, 0, len(tierList.Placements()))
+	for _, p := range tierList.Placements() {
+		deckIDs = append(deckIDs, p.DeckID())
+	}
+	decks, err := u.deckRepo.FindByIDs(ctx, deckIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decks: %w", err)
+	}
+	return decks, nil
+}
+
+// tierListImageKey はティアリスト画像のBlobストア上のキーを返す
+func tierListImageKey(tierListID id.TierListID, version int) string {
+	return fmt.Sprintf("tier-lists/%s/image-r%d.png", tierListID, version)
+}
@@ -0,0 +1,267 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/get_tier_list_image_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/get_tier_list_image_usecase.go -destination=./apps/tierlist/internal/application/usecase/get_tier_list_image_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGTITierListRepository is a mock of GTITierListRepository interface.
+type MockGTITierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTITierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTITierListRepositoryMockRecorder is the mock recorder for MockGTITierListRepository.
+type MockGTITierListRepositoryMockRecorder struct {
+	mock *MockGTITierListRepository
+}
+
+// NewMockGTITierListRepository creates a new mock instance.
+func NewMockGTITierListRepository(ctrl *gomock.Controller) *MockGTITierListRepository {
+	mock := &MockGTITierListRepository{ctrl: ctrl}
+	mock.recorder = &MockGTITierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTITierListRepository) EXPECT() *MockGTITierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGTITierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGTITierListRepositoryMockRecorder) FindByID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGTITierListRepository)(nil).FindByID), ctx, tierListID)
+}
+
+// MockGTIRevisionRepository is a mock of GTIRevisionRepository interface.
+type MockGTIRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTIRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTIRevisionRepositoryMockRecorder is the mock recorder for MockGTIRevisionRepository.
+type MockGTIRevisionRepositoryMockRecorder struct {
+	mock *MockGTIRevisionRepository
+}
+
+// NewMockGTIRevisionRepository creates a new mock instance.
+func NewMockGTIRevisionRepository(ctrl *gomock.Controller) *MockGTIRevisionRepository {
+	mock := &MockGTIRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockGTIRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTIRevisionRepository) EXPECT() *MockGTIRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// LatestNumber mocks base method.
+func (m *MockGTIRevisionRepository) LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestNumber", ctx, tierListID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestNumber indicates an expected call of LatestNumber.
+func (mr *MockGTIRevisionRepositoryMockRecorder) LatestNumber(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestNumber", reflect.TypeOf((*MockGTIRevisionRepository)(nil).LatestNumber), ctx, tierListID)
+}
+
+// MockGTIDeckRepository is a mock of GTIDeckRepository interface.
+type MockGTIDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTIDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGTIDeckRepositoryMockRecorder is the mock recorder for MockGTIDeckRepository.
+type MockGTIDeckRepositoryMockRecorder struct {
+	mock *MockGTIDeckRepository
+}
+
+// NewMockGTIDeckRepository creates a new mock instance.
+func NewMockGTIDeckRepository(ctrl *gomock.Controller) *MockGTIDeckRepository {
+	mock := &MockGTIDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockGTIDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTIDeckRepository) EXPECT() *MockGTIDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDs mocks base method.
+func (m *MockGTIDeckRepository) FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, deckIDs)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockGTIDeckRepositoryMockRecorder) FindByIDs(ctx, deckIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockGTIDeckRepository)(nil).FindByIDs), ctx, deckIDs)
+}
+
+// MockGTIImageRenderer is a mock of GTIImageRenderer interface.
+type MockGTIImageRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTIImageRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockGTIImageRendererMockRecorder is the mock recorder for MockGTIImageRenderer.
+type MockGTIImageRendererMockRecorder struct {
+	mock *MockGTIImageRenderer
+}
+
+// NewMockGTIImageRenderer creates a new mock instance.
+func NewMockGTIImageRenderer(ctrl *gomock.Controller) *MockGTIImageRenderer {
+	mock := &MockGTIImageRenderer{ctrl: ctrl}
+	mock.recorder = &MockGTIImageRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTIImageRenderer) EXPECT() *MockGTIImageRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockGTIImageRenderer) Render(ctx context.Context, tierList *entity.TierList, decks []*entity.Deck) ([]byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, tierList, decks)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockGTIImageRendererMockRecorder) Render(ctx, tierList, decks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockGTIImageRenderer)(nil).Render), ctx, tierList, decks)
+}
+
+// MockGTIBlobStore is a mock of GTIBlobStore interface.
+type MockGTIBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTIBlobStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockGTIBlobStoreMockRecorder is the mock recorder for MockGTIBlobStore.
+type MockGTIBlobStoreMockRecorder struct {
+	mock *MockGTIBlobStore
+}
+
+// NewMockGTIBlobStore creates a new mock instance.
+func NewMockGTIBlobStore(ctrl *gomock.Controller) *MockGTIBlobStore {
+	mock := &MockGTIBlobStore{ctrl: ctrl}
+	mock.recorder = &MockGTIBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTIBlobStore) EXPECT() *MockGTIBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGTIBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGTIBlobStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGTIBlobStore)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockGTIBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockGTIBlobStoreMockRecorder) Put(ctx, key, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockGTIBlobStore)(nil).Put), ctx, key, data)
+}
+
+// MockGTITxManager is a mock of GTITxManager interface.
+type MockGTITxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockGTITxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockGTITxManagerMockRecorder is the mock recorder for MockGTITxManager.
+type MockGTITxManagerMockRecorder struct {
+	mock *MockGTITxManager
+}
+
+// NewMockGTITxManager creates a new mock instance.
+func NewMockGTITxManager(ctrl *gomock.Controller) *MockGTITxManager {
+	mock := &MockGTITxManager{ctrl: ctrl}
+	mock.recorder = &MockGTITxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGTITxManager) EXPECT() *MockGTITxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInReadOnlySnapshot mocks base method.
+func (m *MockGTITxManager) RunInReadOnlySnapshot(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInReadOnlySnapshot", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInReadOnlySnapshot indicates an expected call of RunInReadOnlySnapshot.
+func (mr *MockGTITxManagerMockRecorder) RunInReadOnlySnapshot(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInReadOnlySnapshot", reflect.TypeOf((*MockGTITxManager)(nil).RunInReadOnlySnapshot), ctx, fn)
+}
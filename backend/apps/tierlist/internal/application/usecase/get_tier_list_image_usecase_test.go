@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/blob"
+	"poketier/pkg/errs"
+	"poketier/pkg/log"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTierListImageUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	deckID := id.NewDeckID()
+	decks := []*entity.Deck{
+		entity.ReconstructDeck(deckID, seasonID, []id.CardID{id.NewCardID()}, "リザニンフ", "https://example.com/decks/1.png"),
+	}
+	cacheKey := "tier-lists/" + testTierListID + "/image-r3.png"
+
+	type mocks struct {
+		tierListRepo *MockGTITierListRepository
+		revisionRepo *MockGTIRevisionRepository
+		deckRepo     *MockGTIDeckRepository
+		renderer     *MockGTIImageRenderer
+		blobStore    *MockGTIBlobStore
+		txManager    *MockGTITxManager
+	}
+
+	// 配置とリビジョン番号は同じスナップショットから読み取る
+	expectSnapshot := func(m mocks) {
+		m.txManager.EXPECT().RunInReadOnlySnapshot(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.GetTierListImageParams
+		setupMock   func(m mocks, tierList *entity.TierList)
+		want        *usecase.GetTierListImageResult
+		wantWarns   []string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 同じリビジョンの画像がキャッシュされている場合、描画せずにキャッシュを返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return([]byte("cached"), nil)
+			},
+			want: &usecase.GetTierListImageResult{PNG: []byte("cached"), Version: 3, Complete: true},
+		},
+		{
+			caseName: "正常系: キャッシュがない場合、描画した画像をキャッシュに保存して返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return(nil, blob.ErrNotFound)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), []id.DeckID{deckID}).Return(decks, nil)
+				m.renderer.EXPECT().Render(gomock.Any(), tierList, decks).Return([]byte("rendered"), true, nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), cacheKey, []byte("rendered")).Return(nil)
+			},
+			want: &usecase.GetTierListImageResult{PNG: []byte("rendered"), Version: 3, Complete: true},
+		},
+		{
+			caseName: "正常系: 代替のタイルで描画した場合、キャッシュに保存せずに画像を返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return(nil, blob.ErrNotFound)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(decks, nil)
+				m.renderer.EXPECT().Render(gomock.Any(), tierList, decks).Return([]byte("degraded"), false, nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: &usecase.GetTierListImageResult{PNG: []byte("degraded"), Version: 3, Complete: false},
+		},
+		{
+			caseName: "正常系: キャッシュの保存に失敗した場合でも、描画した画像を返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return(nil, blob.ErrNotFound)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(decks, nil)
+				m.renderer.EXPECT().Render(gomock.Any(), tierList, decks).Return([]byte("rendered"), true, nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), cacheKey, gomock.Any()).Return(errors.New("disk full"))
+			},
+			want:      &usecase.GetTierListImageResult{PNG: []byte("rendered"), Version: 3, Complete: true},
+			wantWarns: []string{"Failed to cache tier list image"},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.GetTierListImageParams{TierListID: "invalid"},
+			setupMock:   func(m mocks, tierList *entity.TierList) {},
+			wantErr:     true,
+			errContains: "invalid tier_list_id",
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			errContains: "tier list not found",
+		},
+		{
+			caseName: "異常系: キャッシュの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return(nil, errors.New("permission denied"))
+			},
+			wantErr:     true,
+			errContains: "failed to get cached image",
+		},
+		{
+			caseName: "異常系: 描画でエラーが発生した場合、エラーを返す",
+			params:   usecase.GetTierListImageParams{TierListID: testTierListID},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				expectSnapshot(m)
+				m.tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(3, nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), cacheKey).Return(nil, blob.ErrNotFound)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(decks, nil)
+				m.renderer.EXPECT().Render(gomock.Any(), tierList, decks).Return(nil, false, errors.New("render error"))
+			},
+			wantErr:     true,
+			errContains: "failed to render image",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				tierListRepo: NewMockGTITierListRepository(ctrl),
+				revisionRepo: NewMockGTIRevisionRepository(ctrl),
+				deckRepo:     NewMockGTIDeckRepository(ctrl),
+				renderer:     NewMockGTIImageRenderer(ctrl),
+				blobStore:    NewMockGTIBlobStore(ctrl),
+				txManager:    NewMockGTITxManager(ctrl),
+			}
+			logger := &recordingLogger{Logger: log.NewStartupLogger("info", true)}
+			tierList := createTestTierList(t, tierListID, seasonID, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckID, rank.TierS, 0), "failed to place deck")
+			tt.setupMock(m, tierList)
+
+			usecase := usecase.NewGetTierListImageUsecase(m.tierListRepo, m.revisionRepo, m.deckRepo, m.renderer, m.blobStore, m.txManager, logger)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+			assert.Equal(t, tt.wantWarns, logger.warns, "warning logs do not match")
+		})
+	}
+}
+
+// recordingLogger は出力された警告ログを記録するテスト用のロガー
+type recordingLogger struct {
+	log.Logger
+	warns []string
+}
+
+func (l *recordingLogger) Warn(msg string, args ...any) {
+	l.warns = append(l.warns, msg)
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListTierListForksParams はフォーク一覧取得の入力
+type ListTierListForksParams struct {
+	TierListID string
+	Cursor     string
+	Limit      int
+}
+
+// ListTierListForksResult はフォーク一覧取得結果（新着順）
+// ForkCount はフォーク元のフォーク数、NextCursor は次ページが存在しない場合は空文字列
+type ListTierListForksResult struct {
+	ForkCount  int
+	TierLists  []LTFTierList
+	NextCursor string
+}
+
+type LTFTierList struct {
+	TierListID    string
+	SeasonID      string
+	Title         string
+	Description   string
+	AuthorName    string
+	ViewCount     int
+	ForkCount     int
+	FavoriteCount int
+	LikeCount     int
+	CreatedAt     time.Time
+}
+
+type LTFTierListRepository interface {
+	FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+	FindPage(ctx context.Context, query entity.TierListQuery) (*entity.TierListPage, error)
+}
+
+type LTFFavoriteCountRepository interface {
+	CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error)
+}
+
+type LTFLikeCountRepository interface {
+	CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error)
+}
+
+type ListTierListForksUsecase struct {
+	tierListRepo      LTFTierListRepository
+	favoriteCountRepo LTFFavoriteCountRepository
+	likeCountRepo     LTFLikeCountRepository
+}
+
+func NewListTierListForksUsecase(tierListRepo LTFTierListRepository, favoriteCountRepo LTFFavoriteCountRepository, likeCountRepo LTFLikeCountRepository) *ListTierListForksUsecase {
+	return &ListTierListForksUsecase{
+		tierListRepo:      tierListRepo,
+		favoriteCountRepo: favoriteCountRepo,
+		likeCountRepo:     likeCountRepo,
+	}
+}
+
+// Execute はフォーク一覧取得を実行
+func (u *ListTierListForksUsecase) Execute(ctx context.Context, params ListTierListForksParams) (*ListTierListForksResult, error) {
+	sourceID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	after, err := decodeTierListCursor(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := u.tierListRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tier list: %w", err)
+	}
+
+	page, err := u.tierListRepo.FindPage(ctx, entity.TierListQuery{
+		ForkedFrom: &sourceID,
+		Sort:       entity.TierListSortNewest,
+		After:      after,
+		Limit:      pagination.NormalizeLimit(params.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fork page: %w", err)
+	}
+
+	favoriteCounts, err := u.favoriteCountRepo.CountByTierListIDs(ctx, tierListIDs(page.TierLists))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count favorites: %w", err)
+	}
+
+	likeCounts, err := u.likeCountRepo.CountByTierListIDs(ctx, tierListIDs(page.TierLists))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	tierLists := make([]LTFTierList, 0, len(page.TierLists))
+	for _, tierList := range page.TierLists {
+		tierLists = append(tierLists, LTFTierList{
+			TierListID:    tierList.ID().String(),
+			SeasonID:      tierList.SeasonID().String(),
+			Title:         tierList.Title(),
+			Description:   tierList.Description(),
+			AuthorName:    tierList.AuthorName(),
+			ViewCount:     tierList.ViewCount(),
+			ForkCount:     tierList.ForkCount(),
+			FavoriteCount: favoriteCounts[tierList.ID()],
+			LikeCount:     likeCounts[tierList.ID()],
+			CreatedAt:     tierList.CreatedAt(),
+		})
+	}
+
+	return &ListTierListForksResult{
+		ForkCount:  source.ForkCount(),
+		TierLists:  tierLists,
+		NextCursor: encodeTierListCursor(page.Next),
+	}, nil
+}
@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/list_tier_list_forks_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/list_tier_list_forks_usecase.go -destination=./apps/tierlist/internal/application/usecase/list_tier_list_forks_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLTFTierListRepository is a mock of LTFTierListRepository interface.
+type MockLTFTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTFTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTFTierListRepositoryMockRecorder is the mock recorder for MockLTFTierListRepository.
+type MockLTFTierListRepositoryMockRecorder struct {
+	mock *MockLTFTierListRepository
+}
+
+// NewMockLTFTierListRepository creates a new mock instance.
+func NewMockLTFTierListRepository(ctrl *gomock.Controller) *MockLTFTierListRepository {
+	mock := &MockLTFTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockLTFTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTFTierListRepository) EXPECT() *MockLTFTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockLTFTierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLTFTierListRepositoryMockRecorder) FindByID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLTFTierListRepository)(nil).FindByID), ctx, tierListID)
+}
+
+// FindPage mocks base method.
+func (m *MockLTFTierListRepository) FindPage(ctx context.Context, query entity.TierListQuery) (*entity.TierListPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.TierListPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLTFTierListRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLTFTierListRepository)(nil).FindPage), ctx, query)
+}
+
+// MockLTFFavoriteCountRepository is a mock of LTFFavoriteCountRepository interface.
+type MockLTFFavoriteCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTFFavoriteCountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTFFavoriteCountRepositoryMockRecorder is the mock recorder for MockLTFFavoriteCountRepository.
+type MockLTFFavoriteCountRepositoryMockRecorder struct {
+	mock *MockLTFFavoriteCountRepository
+}
+
+// NewMockLTFFavoriteCountRepository creates a new mock instance.
+func NewMockLTFFavoriteCountRepository(ctrl *gomock.Controller) *MockLTFFavoriteCountRepository {
+	mock := &MockLTFFavoriteCountRepository{ctrl: ctrl}
+	mock.recorder = &MockLTFFavoriteCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTFFavoriteCountRepository) EXPECT() *MockLTFFavoriteCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByTierListIDs mocks base method.
+func (m *MockLTFFavoriteCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTierListIDs", ctx, tierListIDs)
+	ret0, _ := ret[0].(map[id.TierListID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTierListIDs indicates an expected call of CountByTierListIDs.
+func (mr *MockLTFFavoriteCountRepositoryMockRecorder) CountByTierListIDs(ctx, tierListIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTierListIDs", reflect.TypeOf((*MockLTFFavoriteCountRepository)(nil).CountByTierListIDs), ctx, tierListIDs)
+}
+
+// MockLTFLikeCountRepository is a mock of LTFLikeCountRepository interface.
+type MockLTFLikeCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTFLikeCountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTFLikeCountRepositoryMockRecorder is the mock recorder for MockLTFLikeCountRepository.
+type MockLTFLikeCountRepositoryMockRecorder struct {
+	mock *MockLTFLikeCountRepository
+}
+
+// NewMockLTFLikeCountRepository creates a new mock instance.
+func NewMockLTFLikeCountRepository(ctrl *gomock.Controller) *MockLTFLikeCountRepository {
+	mock := &MockLTFLikeCountRepository{ctrl: ctrl}
+	mock.recorder = &MockLTFLikeCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTFLikeCountRepository) EXPECT() *MockLTFLikeCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByTierListIDs mocks base method.
+func (m *MockLTFLikeCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTierListIDs", ctx, tierListIDs)
+	ret0, _ := ret[0].(map[id.TierListID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTierListIDs indicates an expected call of CountByTierListIDs.
+func (mr *MockLTFLikeCountRepositoryMockRecorder) CountByTierListIDs(ctx, tierListIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTierListIDs", reflect.TypeOf((*MockLTFLikeCountRepository)(nil).CountByTierListIDs), ctx, tierListIDs)
+}
@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTierListForksUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	sourceID, _ := id.TierListIDFromString(testTierListID)
+	forkID := id.NewTierListID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	validCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: createdAt.UnixMicro(), ID: forkID.String()})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListTierListForksParams
+		setupMock   func(*MockLTFTierListRepository, *MockLTFFavoriteCountRepository, *MockLTFLikeCountRepository)
+		wantResult  *usecase.ListTierListForksResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: フォーク元のフォーク数とフォーク一覧を新着順で返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID, Limit: 1},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+				source, _ := entity.ReconstructTierList(
+					sourceID, seasonID, "A4環境ティアリスト", "", "配信者A", nil, 100, 3, nil, createdAt, createdAt,
+				)
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(source, nil)
+				expectedQuery := entity.TierListQuery{
+					ForkedFrom: &sourceID,
+					Sort:       entity.TierListSortNewest,
+					Limit:      1,
+				}
+				mockRepo.EXPECT().FindPage(gomock.Any(), expectedQuery).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, forkID, seasonID, createdAt)},
+					Next:      &entity.TierListCursor{SortKey: createdAt.UnixMicro(), TierListID: forkID},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{forkID}).Return(map[id.TierListID]int{forkID: 5}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{forkID}).Return(map[id.TierListID]int{forkID: 2}, nil)
+			},
+			wantResult: &usecase.ListTierListForksResult{
+				ForkCount: 3,
+				TierLists: []usecase.LTFTierList{
+					{
+						TierListID:    forkID.String(),
+						SeasonID:      testSeasonID,
+						Title:         "A4環境ティアリスト",
+						AuthorName:    "配信者A",
+						ViewCount:     100,
+						FavoriteCount: 5,
+						LikeCount:     2,
+						CreatedAt:     createdAt,
+					},
+				},
+				NextCursor: validCursor,
+			},
+		},
+		{
+			caseName: "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: "invalid"},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid tier_list_id",
+		},
+		{
+			caseName: "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID, Cursor: "!!!"},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid cursor",
+		},
+		{
+			caseName: "異常系: フォーク元が存在しない場合、エラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			errContains: "tier list not found",
+		},
+		{
+			caseName: "異常系: 一覧の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(createTestTierList(t, sourceID, seasonID, createdAt), nil)
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "repository error",
+		},
+		{
+			caseName: "異常系: お気に入り数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(createTestTierList(t, sourceID, seasonID, createdAt), nil)
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, forkID, seasonID, createdAt)},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("favorite count error"))
+			},
+			wantErr:     true,
+			errContains: "favorite count error",
+		},
+		{
+			caseName: "異常系: いいね数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListForksParams{TierListID: testTierListID},
+			setupMock: func(mockRepo *MockLTFTierListRepository, mockFavoriteRepo *MockLTFFavoriteCountRepository, mockLikeRepo *MockLTFLikeCountRepository) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(createTestTierList(t, sourceID, seasonID, createdAt), nil)
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, forkID, seasonID, createdAt)},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(map[id.TierListID]int{}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("like count error"))
+			},
+			wantErr:     true,
+			errContains: "like count error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLTFTierListRepository(ctrl)
+			mockFavoriteRepo := NewMockLTFFavoriteCountRepository(ctrl)
+			mockLikeRepo := NewMockLTFLikeCountRepository(ctrl)
+			tt.setupMock(mockRepo, mockFavoriteRepo, mockLikeRepo)
+
+			usecase := usecase.NewListTierListForksUsecase(mockRepo, mockFavoriteRepo, mockLikeRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// ListTierListRevisionsParams はリビジョン一覧取得の入力
+type ListTierListRevisionsParams struct {
+	TierListID string
+}
+
+// ListTierListRevisionsResult はリビジョン一覧取得結果（新しい順）
+type ListTierListRevisionsResult struct {
+	Revisions []LTRRevision
+}
+
+// LTRRevision はリビジョンの概要
+// RestoredFromRevision は復元によって作成されたリビジョンのみ設定される
+type LTRRevision struct {
+	RevisionNumber       int
+	ChangeCount          int
+	PlacementCount       int
+	RestoredFromRevision *int
+	CreatedAt            time.Time
+}
+
+type LTRTierListRepository interface {
+	FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+}
+
+type LTRRevisionRepository interface {
+	FindByTierList(ctx context.Context, tierListID id.TierListID) ([]*entity.TierListRevision, error)
+}
+
+type ListTierListRevisionsUsecase struct {
+	tierListRepo LTRTierListRepository
+	revisionRepo LTRRevisionRepository
+}
+
+func NewListTierListRevisionsUsecase(tierListRepo LTRTierListRepository, revisionRepo LTRRevisionRepository) *ListTierListRevisionsUsecase {
+	return &ListTierListRevisionsUsecase{
+		tierListRepo: tierListRepo,
+		revisionRepo: revisionRepo,
+	}
+}
+
+// Execute はリビジョン一覧取得を実行
+func (u *ListTierListRevisionsUsecase) Execute(ctx context.Context, params ListTierListRevisionsParams) (*ListTierListRevisionsResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	// ティアリストが存在しない場合は404とするため、先に存在を確認する
+	if _, err := u.tierListRepo.FindByID(ctx, tierListID); err != nil {
+		return nil, fmt.Errorf("failed to find tier list: %w", err)
+	}
+
+	revisions, err := u.revisionRepo.FindByTierList(ctx, tierListID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revisions: %w", err)
+	}
+
+	result := &ListTierListRevisionsResult{
+		Revisions: make([]LTRRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		result.Revisions = append(result.Revisions, LTRRevision{
+			RevisionNumber:       revision.Number(),
+			ChangeCount:          len(revision.Changes()),
+			PlacementCount:       len(revision.Placements()),
+			RestoredFromRevision: revision.RestoredFrom(),
+			CreatedAt:            revision.CreatedAt(),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/list_tier_list_revisions_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/list_tier_list_revisions_usecase.go -destination=./apps/tierlist/internal/application/usecase/list_tier_list_revisions_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLTRTierListRepository is a mock of LTRTierListRepository interface.
+type MockLTRTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTRTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTRTierListRepositoryMockRecorder is the mock recorder for MockLTRTierListRepository.
+type MockLTRTierListRepositoryMockRecorder struct {
+	mock *MockLTRTierListRepository
+}
+
+// NewMockLTRTierListRepository creates a new mock instance.
+func NewMockLTRTierListRepository(ctrl *gomock.Controller) *MockLTRTierListRepository {
+	mock := &MockLTRTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockLTRTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTRTierListRepository) EXPECT() *MockLTRTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockLTRTierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLTRTierListRepositoryMockRecorder) FindByID(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLTRTierListRepository)(nil).FindByID), ctx, tierListID)
+}
+
+// MockLTRRevisionRepository is a mock of LTRRevisionRepository interface.
+type MockLTRRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTRRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTRRevisionRepositoryMockRecorder is the mock recorder for MockLTRRevisionRepository.
+type MockLTRRevisionRepositoryMockRecorder struct {
+	mock *MockLTRRevisionRepository
+}
+
+// NewMockLTRRevisionRepository creates a new mock instance.
+func NewMockLTRRevisionRepository(ctrl *gomock.Controller) *MockLTRRevisionRepository {
+	mock := &MockLTRRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockLTRRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTRRevisionRepository) EXPECT() *MockLTRRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByTierList mocks base method.
+func (m *MockLTRRevisionRepository) FindByTierList(ctx context.Context, tierListID id.TierListID) ([]*entity.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTierList", ctx, tierListID)
+	ret0, _ := ret[0].([]*entity.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTierList indicates an expected call of FindByTierList.
+func (mr *MockLTRRevisionRepositoryMockRecorder) FindByTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTierList", reflect.TypeOf((*MockLTRRevisionRepository)(nil).FindByTierList), ctx, tierListID)
+}
@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTierListRevisionsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	deckID := id.NewDeckID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	restoredFrom := 1
+
+	placed := []entity.PlacementSnapshot{{DeckID: deckID, TierRank: rank.TierS, Position: 0}}
+	revision1, err := entity.ReconstructTierListRevision(tierListID, 1, placed, entity.DiffPlacements(nil, placed), nil, createdAt)
+	assert.NoError(t, err, "failed to create revision")
+	revision2, err := entity.ReconstructTierListRevision(tierListID, 2, nil, entity.DiffPlacements(placed, nil), nil, createdAt.Add(time.Hour))
+	assert.NoError(t, err, "failed to create revision")
+	revision3, err := entity.ReconstructTierListRevision(tierListID, 3, placed, entity.DiffPlacements(nil, placed), &restoredFrom, createdAt.Add(2*time.Hour))
+	assert.NoError(t, err, "failed to create revision")
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListTierListRevisionsParams
+		setupMock   func(tierListRepo *MockLTRTierListRepository, revisionRepo *MockLTRRevisionRepository)
+		want        *usecase.ListTierListRevisionsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: リビジョンの概要が新しい順に返される",
+			params:   usecase.ListTierListRevisionsParams{TierListID: testTierListID},
+			setupMock: func(tierListRepo *MockLTRTierListRepository, revisionRepo *MockLTRRevisionRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(createTestTierList(t, tierListID, seasonID, createdAt), nil)
+				revisionRepo.EXPECT().FindByTierList(gomock.Any(), tierListID).Return([]*entity.TierListRevision{revision3, revision2, revision1}, nil)
+			},
+			want: &usecase.ListTierListRevisionsResult{
+				Revisions: []usecase.LTRRevision{
+					{RevisionNumber: 3, ChangeCount: 1, PlacementCount: 1, RestoredFromRevision: &restoredFrom, CreatedAt: createdAt.Add(2 * time.Hour)},
+					{RevisionNumber: 2, ChangeCount: 1, PlacementCount: 0, CreatedAt: createdAt.Add(time.Hour)},
+					{RevisionNumber: 1, ChangeCount: 1, PlacementCount: 1, CreatedAt: createdAt},
+				},
+			},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.ListTierListRevisionsParams{TierListID: "invalid"},
+			setupMock:   func(tierListRepo *MockLTRTierListRepository, revisionRepo *MockLTRRevisionRepository) {},
+			wantErr:     true,
+			errContains: "invalid tier_list_id",
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ListTierListRevisionsParams{TierListID: testTierListID},
+			setupMock: func(tierListRepo *MockLTRTierListRepository, revisionRepo *MockLTRRevisionRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			errContains: "tier list not found",
+		},
+		{
+			caseName: "異常系: リビジョンの取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListRevisionsParams{TierListID: testTierListID},
+			setupMock: func(tierListRepo *MockLTRTierListRepository, revisionRepo *MockLTRRevisionRepository) {
+				tierListRepo.EXPECT().FindByID(gomock.Any(), tierListID).Return(createTestTierList(t, tierListID, seasonID, createdAt), nil)
+				revisionRepo.EXPECT().FindByTierList(gomock.Any(), tierListID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find revisions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tierListRepo := NewMockLTRTierListRepository(ctrl)
+			revisionRepo := NewMockLTRRevisionRepository(ctrl)
+			tt.setupMock(tierListRepo, revisionRepo)
+
+			usecase := usecase.NewListTierListRevisionsUsecase(tierListRepo, revisionRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
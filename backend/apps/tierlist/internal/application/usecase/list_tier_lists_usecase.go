@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+)
+
+// ListTierListsParams はティアリスト一覧取得の入力
+type ListTierListsParams struct {
+	SeasonID string
+	Author   string
+	Sort     string
+	Cursor   string
+	Limit    int
+}
+
+// ListTierListsResult はティアリスト一覧取得結果
+// NextCursor は次ページが存在しない場合は空文字列
+type ListTierListsResult struct {
+	TierLists  []LTLTierList
+	NextCursor string
+}
+
+type LTLTierList struct {
+	TierListID    string
+	SeasonID      string
+	Title         string
+	Description   string
+	AuthorName    string
+	ViewCount     int
+	ForkCount     int
+	FavoriteCount int
+	LikeCount     int
+	CreatedAt     time.Time
+}
+
+type LTLTierListRepository interface {
+	FindPage(ctx context.Context, query entity.TierListQuery) (*entity.TierListPage, error)
+}
+
+type LTLFavoriteCountRepository interface {
+	CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error)
+}
+
+type LTLLikeCountRepository interface {
+	CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error)
+}
+
+type ListTierListsUsecase struct {
+	tierListRepo      LTLTierListRepository
+	favoriteCountRepo LTLFavoriteCountRepository
+	likeCountRepo     LTLLikeCountRepository
+}
+
+func NewListTierListsUsecase(tierListRepo LTLTierListRepository, favoriteCountRepo LTLFavoriteCountRepository, likeCountRepo LTLLikeCountRepository) *ListTierListsUsecase {
+	return &ListTierListsUsecase{
+		tierListRepo:      tierListRepo,
+		favoriteCountRepo: favoriteCountRepo,
+		likeCountRepo:     likeCountRepo,
+	}
+}
+
+// Execute はティアリスト一覧取得を実行
+func (u *ListTierListsUsecase) Execute(ctx context.Context, params ListTierListsParams) (*ListTierListsResult, error) {
+	query, err := u.toQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.tierListRepo.FindPage(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tier list page: %w", err)
+	}
+
+	favoriteCounts, err := u.favoriteCountRepo.CountByTierListIDs(ctx, tierListIDs(page.TierLists))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count favorites: %w", err)
+	}
+
+	likeCounts, err := u.likeCountRepo.CountByTierListIDs(ctx, tierListIDs(page.TierLists))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	return u.toResult(page, favoriteCounts, likeCounts), nil
+}
+
+// toQuery は入力値を検証し、ドメインの検索条件に変換
+func (u *ListTierListsUsecase) toQuery(params ListTierListsParams) (entity.TierListQuery, error) {
+	sort, err := entity.ParseTierListSort(params.Sort)
+	if err != nil {
+		return entity.TierListQuery{}, errs.NewValidationError("invalid sort", err)
+	}
+
+	query := entity.TierListQuery{
+		AuthorName: params.Author,
+		Sort:       sort,
+		Limit:      pagination.NormalizeLimit(params.Limit),
+	}
+
+	if params.SeasonID != "" {
+		seasonID, err := id.SeasonIDFromString(params.SeasonID)
+		if err != nil {
+			return entity.TierListQuery{}, errs.NewValidationError("invalid season_id", err)
+		}
+		query.SeasonID = &seasonID
+	}
+
+	after, err := decodeTierListCursor(params.Cursor)
+	if err != nil {
+		return entity.TierListQuery{}, err
+	}
+	if after != nil {
+		if err := after.Validate(sort); err != nil {
+			return entity.TierListQuery{}, errs.NewValidationError("invalid cursor", err)
+		}
+	}
+	query.After = after
+
+	return query, nil
+}
+
+func (u *ListTierListsUsecase) toResult(page *entity.TierListPage, favoriteCounts, likeCounts map[id.TierListID]int) *ListTierListsResult {
+	tierLists := make([]LTLTierList, 0, len(page.TierLists))
+	for _, tierList := range page.TierLists {
+		tierLists = append(tierLists, LTLTierList{
+			TierListID:    tierList.ID().String(),
+			SeasonID:      tierList.SeasonID().String(),
+			Title:         tierList.Title(),
+			Description:   tierList.Description(),
+			AuthorName:    tierList.AuthorName(),
+			ViewCount:     tierList.ViewCount(),
+			ForkCount:     tierList.ForkCount(),
+			FavoriteCount: favoriteCounts[tierList.ID()],
+			LikeCount:     likeCounts[tierList.ID()],
+			CreatedAt:     tierList.CreatedAt(),
+		})
+	}
+
+	return &ListTierListsResult{
+		TierLists:  tierLists,
+		NextCursor: encodeTierListCursor(page.Next),
+	}
+}
+
+// tierListIDs はティアリストのIDを一覧の順に返す
+func tierListIDs(tierLists []*entity.TierList) []id.TierListID {
+	ids := make([]id.TierListID, 0, len(tierLists))
+	for _, tierList := range tierLists {
+		ids = append(ids, tierList.ID())
+	}
+	return ids
+}
+
+// decodeTierListCursor はカーソル文字列をドメインのカーソルに変換。空文字列の場合は nil を返す
+func decodeTierListCursor(s string) (*entity.TierListCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	tierListID, err := id.TierListIDFromString(cursor.ID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid cursor", err)
+	}
+
+	return &entity.TierListCursor{
+		SortKey:    cursor.SortKey,
+		TierListID: tierListID,
+	}, nil
+}
+
+// encodeTierListCursor はドメインのカーソルをカーソル文字列に変換。nil の場合は空文字列を返す
+func encodeTierListCursor(next *entity.TierListCursor) string {
+	if next == nil {
+		return ""
+	}
+
+	return pagination.EncodeCursor(pagination.Cursor{
+		SortKey: next.SortKey,
+		ID:      next.TierListID.String(),
+	})
+}
@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/list_tier_lists_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/list_tier_lists_usecase.go -destination=./apps/tierlist/internal/application/usecase/list_tier_lists_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLTLTierListRepository is a mock of LTLTierListRepository interface.
+type MockLTLTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTLTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTLTierListRepositoryMockRecorder is the mock recorder for MockLTLTierListRepository.
+type MockLTLTierListRepositoryMockRecorder struct {
+	mock *MockLTLTierListRepository
+}
+
+// NewMockLTLTierListRepository creates a new mock instance.
+func NewMockLTLTierListRepository(ctrl *gomock.Controller) *MockLTLTierListRepository {
+	mock := &MockLTLTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockLTLTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTLTierListRepository) EXPECT() *MockLTLTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPage mocks base method.
+func (m *MockLTLTierListRepository) FindPage(ctx context.Context, query entity.TierListQuery) (*entity.TierListPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPage", ctx, query)
+	ret0, _ := ret[0].(*entity.TierListPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPage indicates an expected call of FindPage.
+func (mr *MockLTLTierListRepositoryMockRecorder) FindPage(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPage", reflect.TypeOf((*MockLTLTierListRepository)(nil).FindPage), ctx, query)
+}
+
+// MockLTLFavoriteCountRepository is a mock of LTLFavoriteCountRepository interface.
+type MockLTLFavoriteCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTLFavoriteCountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTLFavoriteCountRepositoryMockRecorder is the mock recorder for MockLTLFavoriteCountRepository.
+type MockLTLFavoriteCountRepositoryMockRecorder struct {
+	mock *MockLTLFavoriteCountRepository
+}
+
+// NewMockLTLFavoriteCountRepository creates a new mock instance.
+func NewMockLTLFavoriteCountRepository(ctrl *gomock.Controller) *MockLTLFavoriteCountRepository {
+	mock := &MockLTLFavoriteCountRepository{ctrl: ctrl}
+	mock.recorder = &MockLTLFavoriteCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTLFavoriteCountRepository) EXPECT() *MockLTLFavoriteCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByTierListIDs mocks base method.
+func (m *MockLTLFavoriteCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTierListIDs", ctx, tierListIDs)
+	ret0, _ := ret[0].(map[id.TierListID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTierListIDs indicates an expected call of CountByTierListIDs.
+func (mr *MockLTLFavoriteCountRepositoryMockRecorder) CountByTierListIDs(ctx, tierListIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTierListIDs", reflect.TypeOf((*MockLTLFavoriteCountRepository)(nil).CountByTierListIDs), ctx, tierListIDs)
+}
+
+// MockLTLLikeCountRepository is a mock of LTLLikeCountRepository interface.
+type MockLTLLikeCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLTLLikeCountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLTLLikeCountRepositoryMockRecorder is the mock recorder for MockLTLLikeCountRepository.
+type MockLTLLikeCountRepositoryMockRecorder struct {
+	mock *MockLTLLikeCountRepository
+}
+
+// NewMockLTLLikeCountRepository creates a new mock instance.
+func NewMockLTLLikeCountRepository(ctrl *gomock.Controller) *MockLTLLikeCountRepository {
+	mock := &MockLTLLikeCountRepository{ctrl: ctrl}
+	mock.recorder = &MockLTLLikeCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLTLLikeCountRepository) EXPECT() *MockLTLLikeCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByTierListIDs mocks base method.
+func (m *MockLTLLikeCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTierListIDs", ctx, tierListIDs)
+	ret0, _ := ret[0].(map[id.TierListID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTierListIDs indicates an expected call of CountByTierListIDs.
+func (mr *MockLTLLikeCountRepositoryMockRecorder) CountByTierListIDs(ctx, tierListIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTierListIDs", reflect.TypeOf((*MockLTLLikeCountRepository)(nil).CountByTierListIDs), ctx, tierListIDs)
+}
@@ -0,0 +1,262 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/pagination"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testSeasonID   = "0198934f-7780-781a-bb9b-d8957ea790ff"
+	testTierListID = "01989a00-0000-7000-8000-000000000001"
+)
+
+func TestListTierListsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	validCursor := pagination.EncodeCursor(pagination.Cursor{SortKey: 100, ID: testTierListID})
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ListTierListsParams
+		setupMock   func(*MockLTLTierListRepository, *MockLTLFavoriteCountRepository, *MockLTLLikeCountRepository)
+		wantResult  *usecase.ListTierListsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 次ページがある場合、ティアリスト一覧と次ページのカーソルを返す",
+			params: usecase.ListTierListsParams{
+				SeasonID: testSeasonID,
+				Sort:     "popular",
+				Limit:    1,
+			},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				expectedQuery := entity.TierListQuery{
+					SeasonID: &seasonID,
+					Sort:     entity.TierListSortPopular,
+					Limit:    1,
+				}
+				mockRepo.EXPECT().FindPage(gomock.Any(), expectedQuery).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, tierListID, seasonID, createdAt)},
+					Next:      &entity.TierListCursor{SortKey: 100, TierListID: tierListID},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{tierListID}).Return(map[id.TierListID]int{tierListID: 12}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{tierListID}).Return(map[id.TierListID]int{tierListID: 3}, nil)
+			},
+			wantResult: &usecase.ListTierListsResult{
+				TierLists: []usecase.LTLTierList{
+					{
+						TierListID:    testTierListID,
+						SeasonID:      testSeasonID,
+						Title:         "A4環境ティアリスト",
+						AuthorName:    "配信者A",
+						ViewCount:     100,
+						FavoriteCount: 12,
+						LikeCount:     3,
+						CreatedAt:     createdAt,
+					},
+				},
+				NextCursor: validCursor,
+			},
+		},
+		{
+			caseName: "正常系: 条件未指定の場合、人気順・デフォルト件数で検索し、最終ページはカーソルが空になる",
+			params:   usecase.ListTierListsParams{},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				expectedQuery := entity.TierListQuery{
+					Sort:  entity.TierListSortPopular,
+					Limit: pagination.DefaultLimit,
+				}
+				mockRepo.EXPECT().FindPage(gomock.Any(), expectedQuery).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+			},
+			wantResult: &usecase.ListTierListsResult{
+				TierLists: []usecase.LTLTierList{},
+			},
+		},
+		{
+			caseName: "正常系: カーソルと作成者名が指定された場合、検索条件に変換される",
+			params: usecase.ListTierListsParams{
+				Author: "配信者A",
+				Sort:   "newest",
+				Cursor: validCursor,
+				Limit:  500,
+			},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				expectedQuery := entity.TierListQuery{
+					AuthorName: "配信者A",
+					Sort:       entity.TierListSortNewest,
+					After:      &entity.TierListCursor{SortKey: 100, TierListID: tierListID},
+					Limit:      pagination.MaxLimit,
+				}
+				mockRepo.EXPECT().FindPage(gomock.Any(), expectedQuery).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+			},
+			wantResult: &usecase.ListTierListsResult{
+				TierLists: []usecase.LTLTierList{},
+			},
+		},
+		{
+			caseName: "正常系: ホット順が指定された場合、検索条件に変換される",
+			params:   usecase.ListTierListsParams{Sort: "hot"},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				expectedQuery := entity.TierListQuery{
+					Sort:  entity.TierListSortHot,
+					Limit: pagination.DefaultLimit,
+				}
+				mockRepo.EXPECT().FindPage(gomock.Any(), expectedQuery).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), []id.TierListID{}).Return(map[id.TierListID]int{}, nil)
+			},
+			wantResult: &usecase.ListTierListsResult{
+				TierLists: []usecase.LTLTierList{},
+			},
+		},
+		{
+			caseName: "異常系: 未定義の並び順が指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListTierListsParams{Sort: "oldest"},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid sort",
+		},
+		{
+			caseName: "異常系: 不正なシーズンIDが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListTierListsParams{SeasonID: "invalid"},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid season_id",
+		},
+		{
+			caseName: "異常系: 不正なカーソルが指定された場合、バリデーションエラーを返す",
+			params:   usecase.ListTierListsParams{Cursor: "!!!"},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid cursor",
+		},
+		{
+			caseName: "異常系: 人気順のカーソルのキー値が閲覧数の範囲外の場合、バリデーションエラーを返す",
+			params: usecase.ListTierListsParams{
+				Sort:   "popular",
+				Cursor: pagination.EncodeCursor(pagination.Cursor{SortKey: math.MaxInt32 + 1, ID: testTierListID}),
+			},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid cursor",
+		},
+		{
+			caseName: "異常系: トレンド順のカーソルのキー値が負の場合、バリデーションエラーを返す",
+			params: usecase.ListTierListsParams{
+				Sort:   "trending",
+				Cursor: pagination.EncodeCursor(pagination.Cursor{SortKey: -1, ID: testTierListID}),
+			},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+			},
+			wantErr:     true,
+			errContains: "invalid cursor",
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListsParams{},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "repository error",
+		},
+		{
+			caseName: "異常系: お気に入り数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListsParams{},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, tierListID, seasonID, createdAt)},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("favorite count error"))
+			},
+			wantErr:     true,
+			errContains: "favorite count error",
+		},
+		{
+			caseName: "異常系: いいね数の取得でエラーが発生した場合、エラーを返す",
+			params:   usecase.ListTierListsParams{},
+			setupMock: func(mockRepo *MockLTLTierListRepository, mockFavoriteRepo *MockLTLFavoriteCountRepository, mockLikeRepo *MockLTLLikeCountRepository) {
+				mockRepo.EXPECT().FindPage(gomock.Any(), gomock.Any()).Return(&entity.TierListPage{
+					TierLists: []*entity.TierList{createTestTierList(t, tierListID, seasonID, createdAt)},
+				}, nil)
+				mockFavoriteRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(map[id.TierListID]int{}, nil)
+				mockLikeRepo.EXPECT().CountByTierListIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("like count error"))
+			},
+			wantErr:     true,
+			errContains: "like count error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockLTLTierListRepository(ctrl)
+			mockFavoriteRepo := NewMockLTLFavoriteCountRepository(ctrl)
+			mockLikeRepo := NewMockLTLLikeCountRepository(ctrl)
+			tt.setupMock(mockRepo, mockFavoriteRepo, mockLikeRepo)
+
+			usecase := usecase.NewListTierListsUsecase(mockRepo, mockFavoriteRepo, mockLikeRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantResult, got, "result does not match expected value")
+		})
+	}
+}
+
+// createTestTierList はテスト用のTierListエンティティを作成するヘルパー関数
+func createTestTierList(t *testing.T, tierListID id.TierListID, seasonID id.SeasonID, createdAt time.Time) *entity.TierList {
+	t.Helper()
+
+	tierList, err := entity.ReconstructTierList(
+		tierListID, seasonID, "A4環境ティアリスト", "", "配信者A", nil, 100, 0, nil, createdAt, createdAt,
+	)
+	assert.NoError(t, err, "failed to create tier list entity")
+
+	return tierList
+}
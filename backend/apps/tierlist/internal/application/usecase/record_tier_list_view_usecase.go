@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// RecordTierListViewParams はティアリストの閲覧の記録の入力
+// ViewerIP は閲覧元のIPアドレスで、未ログインの閲覧者を識別するために使う
+// ViewerUserID はログイン中のユーザーで、未ログインの場合は nil
+type RecordTierListViewParams struct {
+	TierListID   string
+	ViewerIP     string
+	ViewerUserID *id.UserID
+}
+
+type RTVViewRepository interface {
+	Record(ctx context.Context, tierListID id.TierListID, viewerKey string) error
+}
+
+type RTVTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RecordTierListViewUsecase struct {
+	viewRepo  RTVViewRepository
+	txManager RTVTxManager
+}
+
+func NewRecordTierListViewUsecase(viewRepo RTVViewRepository, txManager RTVTxManager) *RecordTierListViewUsecase {
+	return &RecordTierListViewUsecase{
+		viewRepo:  viewRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute はティアリストの閲覧を記録する
+// 同じ閲覧者の同じ日の閲覧は1回として数え、再読み込みなどで閲覧数とトレンドスコアが水増しされないようにする
+// 閲覧者はログイン中であればユーザー、未ログインであればIPアドレスで識別する
+func (u *RecordTierListViewUsecase) Execute(ctx context.Context, params RecordTierListViewParams) error {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	viewerKey := "ip:" + params.ViewerIP
+	if params.ViewerUserID != nil {
+		viewerKey = "user:" + params.ViewerUserID.String()
+	}
+
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return u.viewRepo.Record(ctx, tierListID, viewerKey)
+	})
+}
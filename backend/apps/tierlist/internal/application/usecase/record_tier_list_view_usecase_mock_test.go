@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/record_tier_list_view_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/record_tier_list_view_usecase.go -destination=./apps/tierlist/internal/application/usecase/record_tier_list_view_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRTVViewRepository is a mock of RTVViewRepository interface.
+type MockRTVViewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTVViewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTVViewRepositoryMockRecorder is the mock recorder for MockRTVViewRepository.
+type MockRTVViewRepositoryMockRecorder struct {
+	mock *MockRTVViewRepository
+}
+
+// NewMockRTVViewRepository creates a new mock instance.
+func NewMockRTVViewRepository(ctrl *gomock.Controller) *MockRTVViewRepository {
+	mock := &MockRTVViewRepository{ctrl: ctrl}
+	mock.recorder = &MockRTVViewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTVViewRepository) EXPECT() *MockRTVViewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockRTVViewRepository) Record(ctx context.Context, tierListID id.TierListID, viewerKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, tierListID, viewerKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRTVViewRepositoryMockRecorder) Record(ctx, tierListID, viewerKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRTVViewRepository)(nil).Record), ctx, tierListID, viewerKey)
+}
+
+// MockRTVTxManager is a mock of RTVTxManager interface.
+type MockRTVTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTVTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRTVTxManagerMockRecorder is the mock recorder for MockRTVTxManager.
+type MockRTVTxManagerMockRecorder struct {
+	mock *MockRTVTxManager
+}
+
+// NewMockRTVTxManager creates a new mock instance.
+func NewMockRTVTxManager(ctrl *gomock.Controller) *MockRTVTxManager {
+	mock := &MockRTVTxManager{ctrl: ctrl}
+	mock.recorder = &MockRTVTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTVTxManager) EXPECT() *MockRTVTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRTVTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRTVTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRTVTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordTierListViewUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	tierListID := id.NewTierListID()
+
+	runInTx := func(mockTx *MockRTVTxManager) {
+		mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.RecordTierListViewParams
+		setupMock   func(*MockRTVViewRepository, *MockRTVTxManager)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 未ログインの場合、IPアドレスを閲覧者として記録される",
+			params:   usecase.RecordTierListViewParams{TierListID: tierListID.String(), ViewerIP: "192.0.2.1"},
+			setupMock: func(mockRepo *MockRTVViewRepository, mockTx *MockRTVTxManager) {
+				runInTx(mockTx)
+				mockRepo.EXPECT().Record(gomock.Any(), tierListID, "ip:192.0.2.1").Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: ログイン中の場合、IPアドレスではなくユーザーを閲覧者として記録される",
+			params:   usecase.RecordTierListViewParams{TierListID: tierListID.String(), ViewerIP: "192.0.2.1", ViewerUserID: &userID},
+			setupMock: func(mockRepo *MockRTVViewRepository, mockTx *MockRTVTxManager) {
+				runInTx(mockTx)
+				mockRepo.EXPECT().Record(gomock.Any(), tierListID, "user:"+userID.String()).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 不正なティアリストIDが指定された場合、バリデーションエラーを返す",
+			params:      usecase.RecordTierListViewParams{TierListID: "invalid", ViewerIP: "192.0.2.1"},
+			setupMock:   func(mockRepo *MockRTVViewRepository, mockTx *MockRTVTxManager) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.RecordTierListViewParams{TierListID: tierListID.String(), ViewerIP: "192.0.2.1"},
+			setupMock: func(mockRepo *MockRTVViewRepository, mockTx *MockRTVTxManager) {
+				runInTx(mockTx)
+				mockRepo.EXPECT().Record(gomock.Any(), tierListID, "ip:192.0.2.1").Return(errs.NewNotFoundError("tier list not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+		},
+		{
+			caseName: "異常系: リポジトリでエラーが発生した場合、エラーを返す",
+			params:   usecase.RecordTierListViewParams{TierListID: tierListID.String(), ViewerIP: "192.0.2.1"},
+			setupMock: func(mockRepo *MockRTVViewRepository, mockTx *MockRTVTxManager) {
+				runInTx(mockTx)
+				mockRepo.EXPECT().Record(gomock.Any(), tierListID, "ip:192.0.2.1").Return(errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockRTVViewRepository(ctrl)
+			mockTx := NewMockRTVTxManager(ctrl)
+			tt.setupMock(mockRepo, mockTx)
+
+			uc := usecase.NewRecordTierListViewUsecase(mockRepo, mockTx)
+
+			// Act
+			err := uc.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
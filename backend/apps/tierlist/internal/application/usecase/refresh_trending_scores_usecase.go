@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+// RefreshTrendingScoresResult はトレンドスコアの再計算結果
+// UpdatedCount はスコアが変わったティアリストの件数、DeletedViewerCount は削除した閲覧者の記録の件数
+type RefreshTrendingScoresResult struct {
+	UpdatedCount       int64
+	DeletedViewerCount int64
+}
+
+type RTSViewRepository interface {
+	RefreshTrendingScores(ctx context.Context) (int64, error)
+	DeleteExpiredViewers(ctx context.Context) (int64, error)
+}
+
+type RefreshTrendingScoresUsecase struct {
+	viewRepo RTSViewRepository
+}
+
+func NewRefreshTrendingScoresUsecase(viewRepo RTSViewRepository) *RefreshTrendingScoresUsecase {
+	return &RefreshTrendingScoresUsecase{
+		viewRepo: viewRepo,
+	}
+}
+
+// Execute は閲覧の記録時に加算したトレンドスコアを直近7日間の日別閲覧数で再計算し、集計期間から外れた閲覧を除く
+// あわせて重複の判定に使わなくなった前日以前の閲覧者の記録を削除する
+// 再計算は冪等のため、複数インスタンスでの重複実行は問題にならない
+func (u *RefreshTrendingScoresUsecase) Execute(ctx context.Context) (*RefreshTrendingScoresResult, error) {
+	updated, err := u.viewRepo.RefreshTrendingScores(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh trending scores: %w", err)
+	}
+
+	deleted, err := u.viewRepo.DeleteExpiredViewers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired viewers: %w", err)
+	}
+
+	return &RefreshTrendingScoresResult{
+		UpdatedCount:       updated,
+		DeletedViewerCount: deleted,
+	}, nil
+}
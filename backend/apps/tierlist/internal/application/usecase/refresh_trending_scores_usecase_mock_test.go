@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/refresh_trending_scores_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/refresh_trending_scores_usecase.go -destination=./apps/tierlist/internal/application/usecase/refresh_trending_scores_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRTSViewRepository is a mock of RTSViewRepository interface.
+type MockRTSViewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTSViewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTSViewRepositoryMockRecorder is the mock recorder for MockRTSViewRepository.
+type MockRTSViewRepositoryMockRecorder struct {
+	mock *MockRTSViewRepository
+}
+
+// NewMockRTSViewRepository creates a new mock instance.
+func NewMockRTSViewRepository(ctrl *gomock.Controller) *MockRTSViewRepository {
+	mock := &MockRTSViewRepository{ctrl: ctrl}
+	mock.recorder = &MockRTSViewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTSViewRepository) EXPECT() *MockRTSViewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredViewers mocks base method.
+func (m *MockRTSViewRepository) DeleteExpiredViewers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredViewers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredViewers indicates an expected call of DeleteExpiredViewers.
+func (mr *MockRTSViewRepositoryMockRecorder) DeleteExpiredViewers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredViewers", reflect.TypeOf((*MockRTSViewRepository)(nil).DeleteExpiredViewers), ctx)
+}
+
+// RefreshTrendingScores mocks base method.
+func (m *MockRTSViewRepository) RefreshTrendingScores(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTrendingScores", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTrendingScores indicates an expected call of RefreshTrendingScores.
+func (mr *MockRTSViewRepositoryMockRecorder) RefreshTrendingScores(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTrendingScores", reflect.TypeOf((*MockRTSViewRepository)(nil).RefreshTrendingScores), ctx)
+}
@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshTrendingScoresUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName  string
+		setupMock func(*MockRTSViewRepository)
+		want      *usecase.RefreshTrendingScoresResult
+		wantErr   bool
+	}{
+		{
+			caseName: "正常系: トレンドスコアが再計算され、期限切れの閲覧者の記録が削除される",
+			setupMock: func(mockRepo *MockRTSViewRepository) {
+				gomock.InOrder(
+					mockRepo.EXPECT().RefreshTrendingScores(gomock.Any()).Return(int64(12), nil),
+					mockRepo.EXPECT().DeleteExpiredViewers(gomock.Any()).Return(int64(340), nil),
+				)
+			},
+			want: &usecase.RefreshTrendingScoresResult{UpdatedCount: 12, DeletedViewerCount: 340},
+		},
+		{
+			caseName: "異常系: 再計算に失敗した場合、閲覧者の記録を削除せずにエラーを返す",
+			setupMock: func(mockRepo *MockRTSViewRepository) {
+				mockRepo.EXPECT().RefreshTrendingScores(gomock.Any()).Return(int64(0), errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "異常系: 閲覧者の記録の削除に失敗した場合、エラーを返す",
+			setupMock: func(mockRepo *MockRTSViewRepository) {
+				mockRepo.EXPECT().RefreshTrendingScores(gomock.Any()).Return(int64(12), nil)
+				mockRepo.EXPECT().DeleteExpiredViewers(gomock.Any()).Return(int64(0), errors.New("repository error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := NewMockRTSViewRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := usecase.NewRefreshTrendingScoresUsecase(mockRepo)
+
+			// Act
+			got, err := uc.Execute(context.Background())
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// RestoreTierListRevisionParams はリビジョン復元の入力
+type RestoreTierListRevisionParams struct {
+	UserID         id.UserID
+	TierListID     string
+	RevisionNumber string
+}
+
+// RestoreTierListRevisionResult はリビジョン復元結果
+// 復元は履歴を巻き戻さず、復元元と同じ配置の新しいリビジョンとして記録される
+// 現在の配置が復元元と同じ場合はリビジョンを作成せず、RevisionNumber は最新のリビジョン番号のまま
+type RestoreTierListRevisionResult struct {
+	RevisionNumber       int
+	RestoredFromRevision int
+	ChangeCount          int
+}
+
+type RTRTierListRepository interface {
+	FindByIDForUpdate(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+	UpdatePlacements(ctx context.Context, tierList *entity.TierList) error
+}
+
+type RTRRevisionRepository interface {
+	FindByNumber(ctx context.Context, tierListID id.TierListID, number int) (*entity.TierListRevision, error)
+	LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error)
+	Create(ctx context.Context, revision *entity.TierListRevision) error
+}
+
+type RTRTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RTRConsensusCache interface {
+	InvalidateSeason(seasonID id.SeasonID)
+}
+
+type RestoreTierListRevisionUsecase struct {
+	tierListRepo RTRTierListRepository
+	revisionRepo RTRRevisionRepository
+	txManager    RTRTxManager
+	cache        RTRConsensusCache
+}
+
+func NewRestoreTierListRevisionUsecase(
+	tierListRepo RTRTierListRepository,
+	revisionRepo RTRRevisionRepository,
+	txManager RTRTxManager,
+	cache RTRConsensusCache,
+) *RestoreTierListRevisionUsecase {
+	return &RestoreTierListRevisionUsecase{
+		tierListRepo: tierListRepo,
+		revisionRepo: revisionRepo,
+		txManager:    txManager,
+		cache:        cache,
+	}
+}
+
+// Execute はリビジョンの復元を実行
+// 復元できるのはティアリストの作成者のみ
+func (u *RestoreTierListRevisionUsecase) Execute(ctx context.Context, params RestoreTierListRevisionParams) (*RestoreTierListRevisionResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+	number, err := parseRevisionNumber(params.RevisionNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じティアリストへの同時更新で差分とリビジョン番号が食い違わないよう、行ロックを取得してから差分を計算する
+	var (
+		seasonID id.SeasonID
+		result   *RestoreTierListRevisionResult
+	)
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tierList, err := u.tierListRepo.FindByIDForUpdate(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to find tier list: %w", err)
+		}
+		if !tierList.IsEditableBy(params.UserID) {
+			return errs.NewForbiddenError("only the author can edit the tier list", nil)
+		}
+		seasonID = tierList.SeasonID()
+
+		target, err := u.revisionRepo.FindByNumber(ctx, tierListID, number)
+		if err != nil {
+			return fmt.Errorf("failed to find revision: %w", err)
+		}
+
+		changes, err := tierList.ReplacePlacements(target.Placements())
+		if err != nil {
+			return fmt.Errorf("failed to replace placements: %w", err)
+		}
+
+		latest, err := u.revisionRepo.LatestNumber(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest revision number: %w", err)
+		}
+		restoredFrom := target.Number()
+		// 現在の配置と同じ場合は、空のリビジョンで履歴を埋めないよう何も記録しない
+		if len(changes) == 0 {
+			result = &RestoreTierListRevisionResult{
+				RevisionNumber:       latest,
+				RestoredFromRevision: restoredFrom,
+			}
+			return nil
+		}
+
+		if err := u.tierListRepo.UpdatePlacements(ctx, tierList); err != nil {
+			return fmt.Errorf("failed to update placements: %w", err)
+		}
+
+		revision, err := entity.NewTierListRevision(tierListID, latest+1, tierList.Snapshot(), changes, &restoredFrom)
+		if err != nil {
+			return fmt.Errorf("failed to create revision: %w", err)
+		}
+		if err := u.revisionRepo.Create(ctx, revision); err != nil {
+			return fmt.Errorf("failed to save revision: %w", err)
+		}
+
+		result = &RestoreTierListRevisionResult{
+			RevisionNumber:       revision.Number(),
+			RestoredFromRevision: restoredFrom,
+			ChangeCount:          len(changes),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 配置が変わったシーズンの集計結果は再計算させる
+	if result.ChangeCount > 0 {
+		u.cache.InvalidateSeason(seasonID)
+	}
+
+	return result, nil
+}
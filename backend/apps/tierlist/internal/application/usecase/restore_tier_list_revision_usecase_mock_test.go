@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/restore_tier_list_revision_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/restore_tier_list_revision_usecase.go -destination=./apps/tierlist/internal/application/usecase/restore_tier_list_revision_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRTRTierListRepository is a mock of RTRTierListRepository interface.
+type MockRTRTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTRTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTRTierListRepositoryMockRecorder is the mock recorder for MockRTRTierListRepository.
+type MockRTRTierListRepositoryMockRecorder struct {
+	mock *MockRTRTierListRepository
+}
+
+// NewMockRTRTierListRepository creates a new mock instance.
+func NewMockRTRTierListRepository(ctrl *gomock.Controller) *MockRTRTierListRepository {
+	mock := &MockRTRTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockRTRTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTRTierListRepository) EXPECT() *MockRTRTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockRTRTierListRepository) FindByIDForUpdate(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockRTRTierListRepositoryMockRecorder) FindByIDForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockRTRTierListRepository)(nil).FindByIDForUpdate), ctx, tierListID)
+}
+
+// UpdatePlacements mocks base method.
+func (m *MockRTRTierListRepository) UpdatePlacements(ctx context.Context, tierList *entity.TierList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlacements", ctx, tierList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlacements indicates an expected call of UpdatePlacements.
+func (mr *MockRTRTierListRepositoryMockRecorder) UpdatePlacements(ctx, tierList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlacements", reflect.TypeOf((*MockRTRTierListRepository)(nil).UpdatePlacements), ctx, tierList)
+}
+
+// MockRTRRevisionRepository is a mock of RTRRevisionRepository interface.
+type MockRTRRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTRRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRTRRevisionRepositoryMockRecorder is the mock recorder for MockRTRRevisionRepository.
+type MockRTRRevisionRepositoryMockRecorder struct {
+	mock *MockRTRRevisionRepository
+}
+
+// NewMockRTRRevisionRepository creates a new mock instance.
+func NewMockRTRRevisionRepository(ctrl *gomock.Controller) *MockRTRRevisionRepository {
+	mock := &MockRTRRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockRTRRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTRRevisionRepository) EXPECT() *MockRTRRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRTRRevisionRepository) Create(ctx context.Context, revision *entity.TierListRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRTRRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRTRRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// FindByNumber mocks base method.
+func (m *MockRTRRevisionRepository) FindByNumber(ctx context.Context, tierListID id.TierListID, number int) (*entity.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNumber", ctx, tierListID, number)
+	ret0, _ := ret[0].(*entity.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNumber indicates an expected call of FindByNumber.
+func (mr *MockRTRRevisionRepositoryMockRecorder) FindByNumber(ctx, tierListID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNumber", reflect.TypeOf((*MockRTRRevisionRepository)(nil).FindByNumber), ctx, tierListID, number)
+}
+
+// LatestNumber mocks base method.
+func (m *MockRTRRevisionRepository) LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestNumber", ctx, tierListID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestNumber indicates an expected call of LatestNumber.
+func (mr *MockRTRRevisionRepositoryMockRecorder) LatestNumber(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestNumber", reflect.TypeOf((*MockRTRRevisionRepository)(nil).LatestNumber), ctx, tierListID)
+}
+
+// MockRTRTxManager is a mock of RTRTxManager interface.
+type MockRTRTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTRTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRTRTxManagerMockRecorder is the mock recorder for MockRTRTxManager.
+type MockRTRTxManagerMockRecorder struct {
+	mock *MockRTRTxManager
+}
+
+// NewMockRTRTxManager creates a new mock instance.
+func NewMockRTRTxManager(ctrl *gomock.Controller) *MockRTRTxManager {
+	mock := &MockRTRTxManager{ctrl: ctrl}
+	mock.recorder = &MockRTRTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTRTxManager) EXPECT() *MockRTRTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRTRTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRTRTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRTRTxManager)(nil).RunInTx), ctx, fn)
+}
+
+// MockRTRConsensusCache is a mock of RTRConsensusCache interface.
+type MockRTRConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockRTRConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockRTRConsensusCacheMockRecorder is the mock recorder for MockRTRConsensusCache.
+type MockRTRConsensusCacheMockRecorder struct {
+	mock *MockRTRConsensusCache
+}
+
+// NewMockRTRConsensusCache creates a new mock instance.
+func NewMockRTRConsensusCache(ctrl *gomock.Controller) *MockRTRConsensusCache {
+	mock := &MockRTRConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockRTRConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRTRConsensusCache) EXPECT() *MockRTRConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateSeason mocks base method.
+func (m *MockRTRConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateSeason", seasonID)
+}
+
+// InvalidateSeason indicates an expected call of InvalidateSeason.
+func (mr *MockRTRConsensusCacheMockRecorder) InvalidateSeason(seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSeason", reflect.TypeOf((*MockRTRConsensusCache)(nil).InvalidateSeason), seasonID)
+}
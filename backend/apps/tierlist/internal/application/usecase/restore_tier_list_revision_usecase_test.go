@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestoreTierListRevisionUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	authorID := id.NewUserID()
+	deckS, deckA := id.NewDeckID(), id.NewDeckID()
+
+	// リビジョン1では deckS がA、deckA がSに配置されていた
+	revision1, err := entity.ReconstructTierListRevision(tierListID, 1, []entity.PlacementSnapshot{
+		{DeckID: deckA, TierRank: rank.TierS, Position: 0},
+		{DeckID: deckS, TierRank: rank.TierA, Position: 0},
+	}, []entity.PlacementChange{}, nil, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err, "failed to create revision")
+
+	// リビジョン3は現在の配置と同じ
+	revision3, err := entity.ReconstructTierListRevision(tierListID, 3, []entity.PlacementSnapshot{
+		{DeckID: deckS, TierRank: rank.TierS, Position: 0},
+		{DeckID: deckA, TierRank: rank.TierA, Position: 0},
+	}, []entity.PlacementChange{}, nil, time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err, "failed to create revision")
+
+	type mocks struct {
+		tierListRepo *MockRTRTierListRepository
+		revisionRepo *MockRTRRevisionRepository
+		txManager    *MockRTRTxManager
+		cache        *MockRTRConsensusCache
+	}
+
+	// runInTx はトランザクション内の処理をそのまま実行させる
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.RestoreTierListRevisionParams
+		setupMock   func(m mocks, tierList *entity.TierList)
+		want        *usecase.RestoreTierListRevisionResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 復元元の配置で新しいリビジョンが記録される",
+			params:   usecase.RestoreTierListRevisionParams{UserID: authorID, TierListID: testTierListID, RevisionNumber: "1"},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 1).Return(revision1, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(4, nil)
+				m.tierListRepo.EXPECT().UpdatePlacements(gomock.Any(), tierList).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, revision *entity.TierListRevision) error {
+						assert.Equal(t, 5, revision.Number(), "revision number does not match")
+						assert.Equal(t, revision1.Placements(), revision.Placements(), "placements should match restored revision")
+						return nil
+					},
+				)
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+			want: &usecase.RestoreTierListRevisionResult{RevisionNumber: 5, RestoredFromRevision: 1, ChangeCount: 2},
+		},
+		{
+			caseName: "正常系: 現在の配置と同じリビジョンを復元した場合、リビジョンを作成せず最新のリビジョン番号を返す",
+			params:   usecase.RestoreTierListRevisionParams{UserID: authorID, TierListID: testTierListID, RevisionNumber: "3"},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 3).Return(revision3, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(4, nil)
+			},
+			want: &usecase.RestoreTierListRevisionResult{RevisionNumber: 4, RestoredFromRevision: 3, ChangeCount: 0},
+		},
+		{
+			caseName:    "異常系: 不正なリビジョン番号が指定された場合、バリデーションエラーを返す",
+			params:      usecase.RestoreTierListRevisionParams{UserID: authorID, TierListID: testTierListID, RevisionNumber: "0"},
+			setupMock:   func(m mocks, tierList *entity.TierList) {},
+			wantErr:     true,
+			errContains: "invalid revision_number",
+		},
+		{
+			caseName: "異常系: 作成者以外が復元した場合、Forbiddenエラーを返す",
+			params:   usecase.RestoreTierListRevisionParams{UserID: id.NewUserID(), TierListID: testTierListID, RevisionNumber: "1"},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+			},
+			wantErr:     true,
+			errContains: "only the author can edit the tier list",
+		},
+		{
+			caseName: "異常系: リビジョンが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.RestoreTierListRevisionParams{UserID: authorID, TierListID: testTierListID, RevisionNumber: "9"},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 9).Return(nil, errs.NewNotFoundError("revision not found", nil))
+			},
+			wantErr:     true,
+			errContains: "revision not found",
+		},
+		{
+			caseName: "異常系: 配置の更新でエラーが発生した場合、エラーを返す",
+			params:   usecase.RestoreTierListRevisionParams{UserID: authorID, TierListID: testTierListID, RevisionNumber: "1"},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().FindByNumber(gomock.Any(), tierListID, 1).Return(revision1, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(4, nil)
+				m.tierListRepo.EXPECT().UpdatePlacements(gomock.Any(), tierList).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to update placements",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				tierListRepo: NewMockRTRTierListRepository(ctrl),
+				revisionRepo: NewMockRTRRevisionRepository(ctrl),
+				txManager:    NewMockRTRTxManager(ctrl),
+				cache:        NewMockRTRConsensusCache(ctrl),
+			}
+			tierList := createTestTierList(t, tierListID, seasonID, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckS, rank.TierS, 0), "failed to place deck")
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckA, rank.TierA, 0), "failed to place deck")
+			tierList.AttributeToUser(authorID)
+			tt.setupMock(m, tierList)
+
+			usecase := usecase.NewRestoreTierListRevisionUsecase(m.tierListRepo, m.revisionRepo, m.txManager, m.cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
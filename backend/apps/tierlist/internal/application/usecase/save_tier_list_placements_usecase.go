@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// SaveTierListPlacementsParams はティアリストの配置保存の入力
+// Placements は保存後の全ての配置で、現在の配置と丸ごと置き換える
+type SaveTierListPlacementsParams struct {
+	UserID     id.UserID
+	TierListID string
+	Placements []STPPlacement
+}
+
+type STPPlacement struct {
+	DeckID   string
+	TierRank string
+	Position int
+}
+
+// SaveTierListPlacementsResult はティアリストの配置保存結果
+// 変更がない場合はリビジョンを作成せず、RevisionNumber は最新のリビジョン番号となる
+type SaveTierListPlacementsResult struct {
+	RevisionNumber int
+	ChangeCount    int
+}
+
+type STPTierListRepository interface {
+	FindByIDForUpdate(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error)
+	UpdatePlacements(ctx context.Context, tierList *entity.TierList) error
+}
+
+type STPRevisionRepository interface {
+	LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error)
+	Create(ctx context.Context, revision *entity.TierListRevision) error
+}
+
+type STPDeckRepository interface {
+	FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error)
+}
+
+type STPTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type STPConsensusCache interface {
+	InvalidateSeason(seasonID id.SeasonID)
+}
+
+type SaveTierListPlacementsUsecase struct {
+	tierListRepo STPTierListRepository
+	revisionRepo STPRevisionRepository
+	deckRepo     STPDeckRepository
+	txManager    STPTxManager
+	cache        STPConsensusCache
+}
+
+func NewSaveTierListPlacementsUsecase(
+	tierListRepo STPTierListRepository,
+	revisionRepo STPRevisionRepository,
+	deckRepo STPDeckRepository,
+	txManager STPTxManager,
+	cache STPConsensusCache,
+) *SaveTierListPlacementsUsecase {
+	return &SaveTierListPlacementsUsecase{
+		tierListRepo: tierListRepo,
+		revisionRepo: revisionRepo,
+		deckRepo:     deckRepo,
+		txManager:    txManager,
+		cache:        cache,
+	}
+}
+
+// Execute はティアリストの配置保存を実行し、変更をリビジョンとして記録する
+// 保存できるのはティアリストの作成者のみ
+func (u *SaveTierListPlacementsUsecase) Execute(ctx context.Context, params SaveTierListPlacementsParams) (*SaveTierListPlacementsResult, error) {
+	tierListID, err := id.TierListIDFromString(params.TierListID)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid tier_list_id", err)
+	}
+
+	snapshot, err := u.toSnapshot(params.Placements)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じティアリストへの同時保存で差分とリビジョン番号が食い違わないよう、行ロックを取得してから差分を計算する
+	var (
+		seasonID       id.SeasonID
+		changes        []entity.PlacementChange
+		revisionNumber int
+	)
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tierList, err := u.tierListRepo.FindByIDForUpdate(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to find tier list: %w", err)
+		}
+		if !tierList.IsEditableBy(params.UserID) {
+			return errs.NewForbiddenError("only the author can edit the tier list", nil)
+		}
+		seasonID = tierList.SeasonID()
+
+		if err := u.validateDecks(ctx, seasonID, snapshot); err != nil {
+			return err
+		}
+
+		changes, err = tierList.ReplacePlacements(snapshot)
+		if err != nil {
+			return errs.NewValidationError("invalid placements", err)
+		}
+
+		latest, err := u.revisionRepo.LatestNumber(ctx, tierListID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest revision number: %w", err)
+		}
+		revisionNumber = latest
+		if len(changes) == 0 {
+			return nil
+		}
+
+		if err := u.tierListRepo.UpdatePlacements(ctx, tierList); err != nil {
+			return fmt.Errorf("failed to update placements: %w", err)
+		}
+
+		revision, err := entity.NewTierListRevision(tierListID, latest+1, tierList.Snapshot(), changes, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create revision: %w", err)
+		}
+		if err := u.revisionRepo.Create(ctx, revision); err != nil {
+			return fmt.Errorf("failed to save revision: %w", err)
+		}
+		revisionNumber = revision.Number()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 配置が変わったシーズンの集計結果は再計算させる
+	if len(changes) > 0 {
+		u.cache.InvalidateSeason(seasonID)
+	}
+
+	return &SaveTierListPlacementsResult{
+		RevisionNumber: revisionNumber,
+		ChangeCount:    len(changes),
+	}, nil
+}
+
+// toSnapshot は入力値を検証し、配置のスナップショットに変換
+func (u *SaveTierListPlacementsUsecase) toSnapshot(placements []STPPlacement) ([]entity.PlacementSnapshot, error) {
+	snapshot := make([]entity.PlacementSnapshot, 0, len(placements))
+	for _, p := range placements {
+		deckID, err := id.DeckIDFromString(p.DeckID)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid deck_id", err)
+		}
+		tierRank, err := rank.ParseTierRank(p.TierRank)
+		if err != nil {
+			return nil, errs.NewValidationError("invalid tier_rank", err)
+		}
+		snapshot = append(snapshot, entity.PlacementSnapshot{
+			DeckID:   deckID,
+			TierRank: tierRank,
+			Position: p.Position,
+		})
+	}
+	return snapshot, nil
+}
+
+// validateDecks は配置するデッキがティアリストのシーズンに存在することを確認する
+func (u *SaveTierListPlacementsUsecase) validateDecks(ctx context.Context, seasonID id.SeasonID, snapshot []entity.PlacementSnapshot) error {
+	if len(snapshot) == 0 {
+		return nil
+	}
+
+	deckIDs := make([]id.DeckID, 0, len(snapshot))
+	for _, s := range snapshot {
+		deckIDs = append(deckIDs, s.DeckID)
+	}
+
+	decks, err := u.deckRepo.FindByIDs(ctx, deckIDs)
+	if err != nil {
+		return fmt.Errorf("failed to find decks: %w", err)
+	}
+
+	found := make(map[id.DeckID]struct{}, len(decks))
+	for _, deck := range decks {
+		if deck.SeasonID().Equals(seasonID) {
+			found[deck.ID()] = struct{}{}
+		}
+	}
+	for _, deckID := range deckIDs {
+		if _, ok := found[deckID]; !ok {
+			return errs.NewValidationError(fmt.Sprintf("deck not found in season: %s", deckID), nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/application/usecase/save_tier_list_placements_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/application/usecase/save_tier_list_placements_usecase.go -destination=./apps/tierlist/internal/application/usecase/save_tier_list_placements_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/tierlist/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSTPTierListRepository is a mock of STPTierListRepository interface.
+type MockSTPTierListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTPTierListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSTPTierListRepositoryMockRecorder is the mock recorder for MockSTPTierListRepository.
+type MockSTPTierListRepositoryMockRecorder struct {
+	mock *MockSTPTierListRepository
+}
+
+// NewMockSTPTierListRepository creates a new mock instance.
+func NewMockSTPTierListRepository(ctrl *gomock.Controller) *MockSTPTierListRepository {
+	mock := &MockSTPTierListRepository{ctrl: ctrl}
+	mock.recorder = &MockSTPTierListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTPTierListRepository) EXPECT() *MockSTPTierListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockSTPTierListRepository) FindByIDForUpdate(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(*entity.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockSTPTierListRepositoryMockRecorder) FindByIDForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockSTPTierListRepository)(nil).FindByIDForUpdate), ctx, tierListID)
+}
+
+// UpdatePlacements mocks base method.
+func (m *MockSTPTierListRepository) UpdatePlacements(ctx context.Context, tierList *entity.TierList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlacements", ctx, tierList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlacements indicates an expected call of UpdatePlacements.
+func (mr *MockSTPTierListRepositoryMockRecorder) UpdatePlacements(ctx, tierList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlacements", reflect.TypeOf((*MockSTPTierListRepository)(nil).UpdatePlacements), ctx, tierList)
+}
+
+// MockSTPRevisionRepository is a mock of STPRevisionRepository interface.
+type MockSTPRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTPRevisionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSTPRevisionRepositoryMockRecorder is the mock recorder for MockSTPRevisionRepository.
+type MockSTPRevisionRepositoryMockRecorder struct {
+	mock *MockSTPRevisionRepository
+}
+
+// NewMockSTPRevisionRepository creates a new mock instance.
+func NewMockSTPRevisionRepository(ctrl *gomock.Controller) *MockSTPRevisionRepository {
+	mock := &MockSTPRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockSTPRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTPRevisionRepository) EXPECT() *MockSTPRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSTPRevisionRepository) Create(ctx context.Context, revision *entity.TierListRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSTPRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSTPRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// LatestNumber mocks base method.
+func (m *MockSTPRevisionRepository) LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestNumber", ctx, tierListID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestNumber indicates an expected call of LatestNumber.
+func (mr *MockSTPRevisionRepositoryMockRecorder) LatestNumber(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestNumber", reflect.TypeOf((*MockSTPRevisionRepository)(nil).LatestNumber), ctx, tierListID)
+}
+
+// MockSTPDeckRepository is a mock of STPDeckRepository interface.
+type MockSTPDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTPDeckRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSTPDeckRepositoryMockRecorder is the mock recorder for MockSTPDeckRepository.
+type MockSTPDeckRepositoryMockRecorder struct {
+	mock *MockSTPDeckRepository
+}
+
+// NewMockSTPDeckRepository creates a new mock instance.
+func NewMockSTPDeckRepository(ctrl *gomock.Controller) *MockSTPDeckRepository {
+	mock := &MockSTPDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockSTPDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTPDeckRepository) EXPECT() *MockSTPDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByIDs mocks base method.
+func (m *MockSTPDeckRepository) FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, deckIDs)
+	ret0, _ := ret[0].([]*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockSTPDeckRepositoryMockRecorder) FindByIDs(ctx, deckIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockSTPDeckRepository)(nil).FindByIDs), ctx, deckIDs)
+}
+
+// MockSTPTxManager is a mock of STPTxManager interface.
+type MockSTPTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTPTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockSTPTxManagerMockRecorder is the mock recorder for MockSTPTxManager.
+type MockSTPTxManagerMockRecorder struct {
+	mock *MockSTPTxManager
+}
+
+// NewMockSTPTxManager creates a new mock instance.
+func NewMockSTPTxManager(ctrl *gomock.Controller) *MockSTPTxManager {
+	mock := &MockSTPTxManager{ctrl: ctrl}
+	mock.recorder = &MockSTPTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTPTxManager) EXPECT() *MockSTPTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockSTPTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockSTPTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockSTPTxManager)(nil).RunInTx), ctx, fn)
+}
+
+// MockSTPConsensusCache is a mock of STPConsensusCache interface.
+type MockSTPConsensusCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTPConsensusCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockSTPConsensusCacheMockRecorder is the mock recorder for MockSTPConsensusCache.
+type MockSTPConsensusCacheMockRecorder struct {
+	mock *MockSTPConsensusCache
+}
+
+// NewMockSTPConsensusCache creates a new mock instance.
+func NewMockSTPConsensusCache(ctrl *gomock.Controller) *MockSTPConsensusCache {
+	mock := &MockSTPConsensusCache{ctrl: ctrl}
+	mock.recorder = &MockSTPConsensusCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTPConsensusCache) EXPECT() *MockSTPConsensusCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateSeason mocks base method.
+func (m *MockSTPConsensusCache) InvalidateSeason(seasonID id.SeasonID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateSeason", seasonID)
+}
+
+// InvalidateSeason indicates an expected call of InvalidateSeason.
+func (mr *MockSTPConsensusCacheMockRecorder) InvalidateSeason(seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSeason", reflect.TypeOf((*MockSTPConsensusCache)(nil).InvalidateSeason), seasonID)
+}
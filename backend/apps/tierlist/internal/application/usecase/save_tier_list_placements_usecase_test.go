@@ -0,0 +1,241 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSaveTierListPlacementsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	seasonID, _ := id.SeasonIDFromString(testSeasonID)
+	otherSeasonID, _ := id.SeasonIDFromString(testTargetSeasonID)
+	tierListID, _ := id.TierListIDFromString(testTierListID)
+	authorID := id.NewUserID()
+	deckS, deckA := id.NewDeckID(), id.NewDeckID()
+	card := id.NewCardID()
+
+	type mocks struct {
+		tierListRepo *MockSTPTierListRepository
+		revisionRepo *MockSTPRevisionRepository
+		deckRepo     *MockSTPDeckRepository
+		txManager    *MockSTPTxManager
+		cache        *MockSTPConsensusCache
+	}
+
+	// runInTx はトランザクション内の処理をそのまま実行させる
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+	seasonDecks := []*entity.Deck{
+		entity.ReconstructDeck(deckS, seasonID, []id.CardID{card}, "Sデッキ", ""),
+		entity.ReconstructDeck(deckA, seasonID, []id.CardID{card}, "Aデッキ", ""),
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.SaveTierListPlacementsParams
+		setupMock   func(m mocks, tierList *entity.TierList)
+		want        *usecase.SaveTierListPlacementsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 配置が変更された場合、配置が更新され次のリビジョンが記録される",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{
+					{DeckID: deckA.String(), TierRank: "S", Position: 0},
+					{DeckID: deckS.String(), TierRank: "S", Position: 1},
+				},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), []id.DeckID{deckA, deckS}).Return(seasonDecks, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(2, nil)
+				m.tierListRepo.EXPECT().UpdatePlacements(gomock.Any(), tierList).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, revision *entity.TierListRevision) error {
+						assert.Equal(t, 3, revision.Number(), "revision number does not match")
+						assert.Nil(t, revision.RestoredFrom(), "restored from should be nil")
+						assert.Len(t, revision.Placements(), 2, "placement count does not match")
+						return nil
+					},
+				)
+				// 配置が変わったシーズンの集計結果が無効化される
+				m.cache.EXPECT().InvalidateSeason(seasonID)
+			},
+			// deckAはAからSへ移動、deckSはS内で0番目から1番目へ並び替え
+			want: &usecase.SaveTierListPlacementsResult{RevisionNumber: 3, ChangeCount: 2},
+		},
+		{
+			caseName: "正常系: 配置に変更がない場合、リビジョンを作成せず最新のリビジョン番号を返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{
+					{DeckID: deckS.String(), TierRank: "S", Position: 0},
+					{DeckID: deckA.String(), TierRank: "A", Position: 0},
+				},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(seasonDecks, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(2, nil)
+			},
+			want: &usecase.SaveTierListPlacementsResult{RevisionNumber: 2, ChangeCount: 0},
+		},
+		{
+			caseName: "異常系: 不正なティアランクが指定された場合、バリデーションエラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{{DeckID: deckS.String(), TierRank: "Z"}},
+			},
+			setupMock:   func(m mocks, tierList *entity.TierList) {},
+			wantErr:     true,
+			errContains: "invalid tier_rank",
+		},
+		{
+			caseName: "異常系: 同じデッキが複数回指定された場合、バリデーションエラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{
+					{DeckID: deckS.String(), TierRank: "S", Position: 0},
+					{DeckID: deckS.String(), TierRank: "A", Position: 0},
+				},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(seasonDecks, nil)
+			},
+			wantErr:     true,
+			errContains: "invalid placements",
+		},
+		{
+			caseName: "異常系: ティアリストのシーズンにないデッキが指定された場合、バリデーションエラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{{DeckID: deckS.String(), TierRank: "S", Position: 0}},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.deckRepo.EXPECT().FindByIDs(gomock.Any(), []id.DeckID{deckS}).Return([]*entity.Deck{
+					entity.ReconstructDeck(deckS, otherSeasonID, []id.CardID{card}, "Sデッキ", ""),
+				}, nil)
+			},
+			wantErr:     true,
+			errContains: "deck not found in season",
+		},
+		{
+			caseName: "異常系: 作成者以外が保存した場合、Forbiddenエラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     id.NewUserID(),
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+			},
+			wantErr:     true,
+			errContains: "only the author can edit the tier list",
+		},
+		{
+			caseName: "異常系: リビジョンの保存でエラーが発生した場合、エラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(1, nil)
+				m.tierListRepo.EXPECT().UpdatePlacements(gomock.Any(), tierList).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to save revision",
+		},
+		{
+			caseName: "異常系: 同時の保存でリビジョン番号が重複した場合、Conflictエラーを返す",
+			params: usecase.SaveTierListPlacementsParams{
+				UserID:     authorID,
+				TierListID: testTierListID,
+				Placements: []usecase.STPPlacement{},
+			},
+			setupMock: func(m mocks, tierList *entity.TierList) {
+				runInTx(m)
+				m.tierListRepo.EXPECT().FindByIDForUpdate(gomock.Any(), tierListID).Return(tierList, nil)
+				m.revisionRepo.EXPECT().LatestNumber(gomock.Any(), tierListID).Return(1, nil)
+				m.tierListRepo.EXPECT().UpdatePlacements(gomock.Any(), tierList).Return(nil)
+				m.revisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errs.NewConflictError("revision already exists", nil))
+			},
+			wantErr:     true,
+			errContains: "revision already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				tierListRepo: NewMockSTPTierListRepository(ctrl),
+				revisionRepo: NewMockSTPRevisionRepository(ctrl),
+				deckRepo:     NewMockSTPDeckRepository(ctrl),
+				txManager:    NewMockSTPTxManager(ctrl),
+				cache:        NewMockSTPConsensusCache(ctrl),
+			}
+			tierList := createTestTierList(t, tierListID, seasonID, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckS, rank.TierS, 0), "failed to place deck")
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckA, rank.TierA, 0), "failed to place deck")
+			tierList.AttributeToUser(authorID)
+			tt.setupMock(m, tierList)
+
+			usecase := usecase.NewSaveTierListPlacementsUsecase(m.tierListRepo, m.revisionRepo, m.deckRepo, m.txManager, m.cache)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+
+	"poketier/pkg/vo/id"
+)
+
+// Deck はティアリストに配置されるデッキの参照情報
+// デッキ自体はDeck集約が管理するため、ティアリストではフォーク時の対応付けに必要な情報のみを扱う
+type Deck struct {
+	id       id.DeckID
+	seasonID id.SeasonID
+	cardIDs  []id.CardID
+	nickname string
+	imageURL string
+}
+
+// ReconstructDeck は永続化されたデータからDeckを復元する
+func ReconstructDeck(id id.DeckID, seasonID id.SeasonID, cardIDs []id.CardID, nickname, imageURL string) *Deck {
+	return &Deck{
+		id:       id,
+		seasonID: seasonID,
+		cardIDs:  cardIDs,
+		nickname: nickname,
+		imageURL: imageURL,
+	}
+}
+
+// ID はDeckのIDを返す
+func (d *Deck) ID() id.DeckID {
+	return d.id
+}
+
+// SeasonID は所属シーズンのIDを返す
+func (d *Deck) SeasonID() id.SeasonID {
+	return d.seasonID
+}
+
+// Nickname はデッキのニックネームを返す
+func (d *Deck) Nickname() string {
+	return d.nickname
+}
+
+// ImageURL はデッキのサムネイル画像のURLを返す（未設定の場合は空文字）
+func (d *Deck) ImageURL() string {
+	return d.imageURL
+}
+
+// CardSetKey はカード構成を表すキーを返す
+// カードの順序に依存しないため、シーズンをまたいだ同一デッキの判定に使用する
+func (d *Deck) CardSetKey() string {
+	keys := make([]string, 0, len(d.cardIDs))
+	for _, cardID := range d.cardIDs {
+		keys = append(keys, cardID.String())
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+// MapDecksToSeason はフォーク元のデッキを対象シーズンの同一カード構成のデッキに対応付ける
+// 対象シーズンに存在しないデッキは結果に含まれない
+func MapDecksToSeason(sourceDecks, targetDecks []*Deck) map[id.DeckID]id.DeckID {
+	targetsByKey := make(map[string]id.DeckID, len(targetDecks))
+	for _, deck := range targetDecks {
+		if _, ok := targetsByKey[deck.CardSetKey()]; !ok {
+			targetsByKey[deck.CardSetKey()] = deck.ID()
+		}
+	}
+
+	mapping := make(map[id.DeckID]id.DeckID, len(sourceDecks))
+	for _, deck := range sourceDecks {
+		if targetID, ok := targetsByKey[deck.CardSetKey()]; ok {
+			mapping[deck.ID()] = targetID
+		}
+	}
+	return mapping
+}
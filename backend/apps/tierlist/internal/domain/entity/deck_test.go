@@ -0,0 +1,71 @@
+package entity_test
+
+import (
+	"testing"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeck_CardSetKey(t *testing.T) {
+	t.Parallel()
+
+	cardA, cardB := id.NewCardID(), id.NewCardID()
+
+	tests := []struct {
+		caseName  string
+		cardIDs   []id.CardID
+		otherIDs  []id.CardID
+		wantEqual bool
+	}{
+		{
+			caseName:  "正常系: カードの順序が異なっても同じキーになる",
+			cardIDs:   []id.CardID{cardA, cardB},
+			otherIDs:  []id.CardID{cardB, cardA},
+			wantEqual: true,
+		},
+		{
+			caseName:  "正常系: カード構成が異なる場合は別のキーになる",
+			cardIDs:   []id.CardID{cardA, cardB},
+			otherIDs:  []id.CardID{cardA},
+			wantEqual: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deck := entity.ReconstructDeck(id.NewDeckID(), id.NewSeasonID(), tt.cardIDs, "デッキA", "")
+			other := entity.ReconstructDeck(id.NewDeckID(), id.NewSeasonID(), tt.otherIDs, "デッキB", "")
+
+			// Act
+			got := deck.CardSetKey() == other.CardSetKey()
+
+			// Assert
+			assert.Equal(t, tt.wantEqual, got, "card set key comparison does not match")
+		})
+	}
+}
+
+func TestMapDecksToSeason(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	sourceSeasonID, targetSeasonID := id.NewSeasonID(), id.NewSeasonID()
+	cardA, cardB, cardC := id.NewCardID(), id.NewCardID(), id.NewCardID()
+
+	sourceAB := entity.ReconstructDeck(id.NewDeckID(), sourceSeasonID, []id.CardID{cardA, cardB}, "ABデッキ", "")
+	sourceC := entity.ReconstructDeck(id.NewDeckID(), sourceSeasonID, []id.CardID{cardC}, "Cデッキ", "")
+	targetBA := entity.ReconstructDeck(id.NewDeckID(), targetSeasonID, []id.CardID{cardB, cardA}, "ABデッキ", "")
+	targetA := entity.ReconstructDeck(id.NewDeckID(), targetSeasonID, []id.CardID{cardA}, "Aデッキ", "")
+
+	// Act
+	got := entity.MapDecksToSeason([]*entity.Deck{sourceAB, sourceC}, []*entity.Deck{targetBA, targetA})
+
+	// Assert
+	assert.Equal(t, map[id.DeckID]id.DeckID{sourceAB.ID(): targetBA.ID()}, got, "deck mapping does not match")
+}
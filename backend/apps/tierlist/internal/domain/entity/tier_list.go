@@ -0,0 +1,362 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+const (
+	// DefaultAuthorName は作成者名が未入力の場合に使用する名前
+	DefaultAuthorName = "匿名ユーザー"
+
+	maxTitleLength      = 100
+	maxAuthorNameLength = 30
+)
+
+// TierList はユーザーが作成するデッキ強度ランキングを表す集約ルート
+type TierList struct {
+	id          id.TierListID
+	seasonID    id.SeasonID
+	title       string
+	description string
+	authorName  string
+	authorIP    string
+	authorUser  *id.UserID
+	forkedFrom  *id.TierListID
+	viewCount   int
+	forkCount   int
+	placements  []*TierPlacement
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewTierList は新しいTierListインスタンスを作成する
+func NewTierList(id id.TierListID, seasonID id.SeasonID, title, description, authorName string) (*TierList, error) {
+	if authorName == "" {
+		authorName = DefaultAuthorName
+	}
+
+	now := time.Now()
+	tierList := &TierList{
+		id:          id,
+		seasonID:    seasonID,
+		title:       title,
+		description: description,
+		authorName:  authorName,
+		viewCount:   0,
+		forkCount:   0,
+		placements:  []*TierPlacement{},
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	if err := tierList.validate(); err != nil {
+		return nil, err
+	}
+
+	return tierList, nil
+}
+
+// ReconstructTierList は永続化されたデータからTierListを復元する
+// forkedFrom はフォークしていない場合 nil、placements は配置を読み込まない場合 nil を渡す
+func ReconstructTierList(
+	id id.TierListID,
+	seasonID id.SeasonID,
+	title, description, authorName string,
+	forkedFrom *id.TierListID,
+	viewCount, forkCount int,
+	placements []*TierPlacement,
+	createdAt, updatedAt time.Time,
+) (*TierList, error) {
+	tierList := &TierList{
+		id:          id,
+		seasonID:    seasonID,
+		title:       title,
+		description: description,
+		authorName:  authorName,
+		forkedFrom:  forkedFrom,
+		viewCount:   viewCount,
+		forkCount:   forkCount,
+		placements:  placements,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+	tierList.sortPlacements()
+
+	if err := tierList.validate(); err != nil {
+		return nil, err
+	}
+
+	return tierList, nil
+}
+
+// ID はTierListのIDを返す
+func (t *TierList) ID() id.TierListID {
+	return t.id
+}
+
+// SeasonID は対象シーズンのIDを返す
+func (t *TierList) SeasonID() id.SeasonID {
+	return t.seasonID
+}
+
+// Title はタイトルを返す
+func (t *TierList) Title() string {
+	return t.title
+}
+
+// Description は説明を返す
+func (t *TierList) Description() string {
+	return t.description
+}
+
+// AuthorName は作成者名を返す
+func (t *TierList) AuthorName() string {
+	return t.authorName
+}
+
+// AuthorIP は投稿元のIPアドレスを返す。記録していない場合は空文字
+func (t *TierList) AuthorIP() string {
+	return t.authorIP
+}
+
+// RecordAuthorIP は投稿元のIPアドレスを記録する（荒らし検知で同一投稿者の判定に使用）
+func (t *TierList) RecordAuthorIP(ip string) {
+	t.authorIP = ip
+}
+
+// AuthorUserID は作成者のユーザーIDを返す。匿名で作成された場合は nil
+func (t *TierList) AuthorUserID() *id.UserID {
+	return t.authorUser
+}
+
+// AttributeToUser は作成者のユーザーを記録する（ログイン中に作成された場合のみ）
+func (t *TierList) AttributeToUser(userID id.UserID) {
+	t.authorUser = &userID
+}
+
+// IsEditableBy は userID のユーザーが配置の保存・リビジョンの復元を行えるかどうかを返す
+// 編集できるのはログイン中に作成した作成者のみで、匿名で作成されたティアリストは誰も編集できない
+func (t *TierList) IsEditableBy(userID id.UserID) bool {
+	return t.authorUser != nil && t.authorUser.Equals(userID)
+}
+
+// ForkedFrom はフォーク元のティアリストIDを返す。フォークでない場合は nil
+func (t *TierList) ForkedFrom() *id.TierListID {
+	return t.forkedFrom
+}
+
+// ViewCount は閲覧数を返す
+func (t *TierList) ViewCount() int {
+	return t.viewCount
+}
+
+// ForkCount はフォークされた回数を返す
+func (t *TierList) ForkCount() int {
+	return t.forkCount
+}
+
+// Placements はティアの強い順、ティア内の並び順で配置を返す
+func (t *TierList) Placements() []*TierPlacement {
+	return t.placements
+}
+
+// CreatedAt は作成日時を返す
+func (t *TierList) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (t *TierList) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// PlaceDeck はデッキをティアに配置する。同じデッキを複数回配置することはできない
+func (t *TierList) PlaceDeck(placementID id.TierPlacementID, deckID id.DeckID, tierRank rank.TierRank, position int) error {
+	if t.hasDeck(deckID) {
+		return errors.New("deck is already placed in the tier list")
+	}
+
+	placement, err := NewTierPlacement(placementID, deckID, tierRank, position)
+	if err != nil {
+		return err
+	}
+
+	t.placements = append(t.placements, placement)
+	t.sortPlacements()
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// Snapshot は現在の配置のスナップショットを返す
+func (t *TierList) Snapshot() []PlacementSnapshot {
+	snapshot := make([]PlacementSnapshot, 0, len(t.placements))
+	for _, p := range t.placements {
+		snapshot = append(snapshot, PlacementSnapshot{
+			DeckID:   p.DeckID(),
+			TierRank: p.TierRank(),
+			Position: p.Position(),
+		})
+	}
+	return snapshot
+}
+
+// ReplacePlacements は配置を丸ごと置き換え、置き換え前からの変更を返す
+// 並び順はティアごとに0から振り直し、引き続き配置されるデッキは配置IDを引き継ぐ
+func (t *TierList) ReplacePlacements(snapshot []PlacementSnapshot) ([]PlacementChange, error) {
+	before := t.Snapshot()
+
+	currentIDs := make(map[id.DeckID]id.TierPlacementID, len(t.placements))
+	for _, p := range t.placements {
+		currentIDs[p.DeckID()] = p.ID()
+	}
+
+	placements := make([]*TierPlacement, 0, len(snapshot))
+	for _, s := range snapshot {
+		placementID, ok := currentIDs[s.DeckID]
+		if !ok {
+			placementID = id.NewTierPlacementID()
+		}
+		placement, err := NewTierPlacement(placementID, s.DeckID, s.TierRank, s.Position)
+		if err != nil {
+			return nil, err
+		}
+		placements = append(placements, placement)
+	}
+
+	replaced := &TierList{placements: placements}
+	if err := replaced.validPlacements(); err != nil {
+		return nil, err
+	}
+	replaced.sortPlacements()
+
+	positions := make(map[rank.TierRank]int)
+	for _, p := range replaced.placements {
+		p.position = positions[p.TierRank()]
+		positions[p.TierRank()]++
+	}
+
+	t.placements = replaced.placements
+	t.updatedAt = time.Now()
+
+	return DiffPlacements(before, t.Snapshot()), nil
+}
+
+// Fork はこのティアリストの配置を複製した新しいTierListを作成する
+// title が空の場合はフォーク元のタイトルを引き継ぐ
+// deckMapping はフォーク元のデッキIDからフォーク先のデッキIDへの対応で、
+// 対応が存在しないデッキの配置は除外し、除外したデッキIDを配置順に返す
+func (t *TierList) Fork(
+	newID id.TierListID,
+	seasonID id.SeasonID,
+	title, authorName string,
+	deckMapping map[id.DeckID]id.DeckID,
+) (*TierList, []id.DeckID, error) {
+	if title == "" {
+		title = t.title
+	}
+
+	forked, err := NewTierList(newID, seasonID, title, t.description, authorName)
+	if err != nil {
+		return nil, nil, err
+	}
+	forkedFrom := t.id
+	forked.forkedFrom = &forkedFrom
+
+	// 除外によって欠番が出ないよう、ティアごとに並び順を振り直す
+	dropped := []id.DeckID{}
+	positions := make(map[rank.TierRank]int)
+	for _, placement := range t.placements {
+		deckID, ok := deckMapping[placement.DeckID()]
+		if !ok || forked.hasDeck(deckID) {
+			dropped = append(dropped, placement.DeckID())
+			continue
+		}
+
+		if err := forked.PlaceDeck(id.NewTierPlacementID(), deckID, placement.TierRank(), positions[placement.TierRank()]); err != nil {
+			return nil, nil, err
+		}
+		positions[placement.TierRank()]++
+	}
+
+	return forked, dropped, nil
+}
+
+// hasDeck は指定したデッキが配置済みかどうかを返す
+func (t *TierList) hasDeck(deckID id.DeckID) bool {
+	return slices.ContainsFunc(t.placements, func(p *TierPlacement) bool {
+		return p.DeckID().Equals(deckID)
+	})
+}
+
+// sortPlacements は配置をティアの強い順、ティア内の並び順に並べ替える
+func (t *TierList) sortPlacements() {
+	slices.SortStableFunc(t.placements, func(a, b *TierPlacement) int {
+		if c := cmp.Compare(b.TierRank(), a.TierRank()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Position(), b.Position())
+	})
+}
+
+// validate は全体のバリデーションを実行する
+func (t *TierList) validate() error {
+	if err := t.validTitle(); err != nil {
+		return err
+	}
+
+	if err := t.validAuthorName(); err != nil {
+		return err
+	}
+
+	if t.viewCount < 0 {
+		return errors.New("view count cannot be negative")
+	}
+
+	if t.forkCount < 0 {
+		return errors.New("fork count cannot be negative")
+	}
+
+	if err := t.validPlacements(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validTitle はタイトルのバリデーションを行う
+func (t *TierList) validTitle() error {
+	if t.title == "" {
+		return errors.New("title cannot be empty")
+	}
+	if utf8.RuneCountInString(t.title) > maxTitleLength {
+		return errors.New("title must be 100 characters or less")
+	}
+	return nil
+}
+
+// validAuthorName は作成者名のバリデーションを行う
+func (t *TierList) validAuthorName() error {
+	if utf8.RuneCountInString(t.authorName) > maxAuthorNameLength {
+		return errors.New("author name must be 30 characters or less")
+	}
+	return nil
+}
+
+// validPlacements は配置のバリデーションを行う
+func (t *TierList) validPlacements() error {
+	deckIDs := make(map[id.DeckID]struct{}, len(t.placements))
+	for _, placement := range t.placements {
+		if _, ok := deckIDs[placement.DeckID()]; ok {
+			return errors.New("deck cannot be placed more than once")
+		}
+		deckIDs[placement.DeckID()] = struct{}{}
+	}
+	return nil
+}
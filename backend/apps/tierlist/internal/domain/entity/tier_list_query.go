@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+
+	"poketier/pkg/vo/id"
+)
+
+// TierListSort はティアリスト一覧の並び順
+type TierListSort string
+
+const (
+	// TierListSortPopular は累計閲覧数の多い順
+	TierListSortPopular TierListSort = "popular"
+	// TierListSortNewest は作成日時の新しい順
+	TierListSortNewest TierListSort = "newest"
+	// TierListSortTrending は直近7日間の閲覧数（トレンドスコア）の多い順
+	TierListSortTrending TierListSort = "trending"
+	// TierListSortHot はいいね数・閲覧数に作成日時の新しさを加味したホットスコアの高い順
+	// スコアの算出式はDBの tier_list_hot_score() に定義し、いいね数・閲覧数の更新時に再計算して保存している
+	TierListSortHot TierListSort = "hot"
+)
+
+// ParseTierListSort は文字列から並び順を解析する。空文字列の場合は人気順とする
+func ParseTierListSort(s string) (TierListSort, error) {
+	switch TierListSort(s) {
+	case "":
+		return TierListSortPopular, nil
+	case TierListSortPopular, TierListSortNewest, TierListSortTrending, TierListSortHot:
+		return TierListSort(s), nil
+	default:
+		return "", fmt.Errorf("unknown tier list sort: %s", s)
+	}
+}
+
+// TierListCursor はキーセットページネーションの位置
+// SortKey は並び順ごとのキー値（人気順: 閲覧数、新着順: 作成日時のUNIXマイクロ秒、トレンド順: トレンドスコア、ホット順: ホットスコア）
+type TierListCursor struct {
+	SortKey    int64
+	TierListID id.TierListID
+}
+
+// Validate はキー値が並び順のキーとして取りうる範囲内かを検証する
+// カーソルはクライアントから渡されるため、クエリのパラメータの型に変換する前に範囲外の値を拒否する
+func (c TierListCursor) Validate(sort TierListSort) error {
+	switch sort {
+	case TierListSortPopular:
+		// 閲覧数は int4 のカラムに保存している
+		if c.SortKey < 0 || c.SortKey > math.MaxInt32 {
+			return fmt.Errorf("view count out of range: %d", c.SortKey)
+		}
+	case TierListSortTrending:
+		// トレンドスコアは int4 のカラムに保存している
+		if c.SortKey < 0 || c.SortKey > math.MaxInt32 {
+			return fmt.Errorf("trending score out of range: %d", c.SortKey)
+		}
+	}
+	return nil
+}
+
+// TierListQuery はティアリスト一覧の検索条件
+type TierListQuery struct {
+	SeasonID   *id.SeasonID
+	AuthorName string
+	ForkedFrom *id.TierListID
+	Sort       TierListSort
+	After      *TierListCursor
+	Limit      int
+}
+
+// TierListPage はティアリスト一覧の1ページ分の結果
+// Next は次ページが存在しない場合 nil となる
+type TierListPage struct {
+	TierLists []*TierList
+	Next      *TierListCursor
+}
@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// PlacementSnapshot はリビジョンに記録する配置のスナップショット
+type PlacementSnapshot struct {
+	DeckID   id.DeckID
+	TierRank rank.TierRank
+	Position int
+}
+
+// PlacementChangeType は配置の変更の種類
+type PlacementChangeType string
+
+const (
+	// PlacementChangeAdded はデッキが新たに配置された
+	PlacementChangeAdded PlacementChangeType = "added"
+	// PlacementChangeRemoved はデッキの配置が外された
+	PlacementChangeRemoved PlacementChangeType = "removed"
+	// PlacementChangeMoved はデッキが別のティアに移動した
+	PlacementChangeMoved PlacementChangeType = "moved"
+	// PlacementChangeReordered はデッキが同じティア内で並び替えられた
+	PlacementChangeReordered PlacementChangeType = "reordered"
+)
+
+// PlacementChange は1デッキ分の配置の変更
+// From は追加の場合、To は削除の場合に nil となる
+type PlacementChange struct {
+	Type   PlacementChangeType
+	DeckID id.DeckID
+	From   *PlacementSnapshot
+	To     *PlacementSnapshot
+}
+
+// DiffPlacements は2つの配置の差分をデッキ単位で返す
+// 変更後の配置順に追加・移動・並び替えを並べ、最後に削除を変更前の配置順で並べる
+func DiffPlacements(before, after []PlacementSnapshot) []PlacementChange {
+	beforeByDeck := make(map[id.DeckID]PlacementSnapshot, len(before))
+	for _, p := range before {
+		beforeByDeck[p.DeckID] = p
+	}
+	afterByDeck := make(map[id.DeckID]struct{}, len(after))
+
+	changes := []PlacementChange{}
+	for _, p := range after {
+		to := p
+		afterByDeck[p.DeckID] = struct{}{}
+
+		from, ok := beforeByDeck[p.DeckID]
+		switch {
+		case !ok:
+			changes = append(changes, PlacementChange{Type: PlacementChangeAdded, DeckID: p.DeckID, To: &to})
+		case from.TierRank != p.TierRank:
+			changes = append(changes, PlacementChange{Type: PlacementChangeMoved, DeckID: p.DeckID, From: &from, To: &to})
+		case from.Position != p.Position:
+			changes = append(changes, PlacementChange{Type: PlacementChangeReordered, DeckID: p.DeckID, From: &from, To: &to})
+		}
+	}
+
+	for _, p := range before {
+		if _, ok := afterByDeck[p.DeckID]; ok {
+			continue
+		}
+		from := p
+		changes = append(changes, PlacementChange{Type: PlacementChangeRemoved, DeckID: p.DeckID, From: &from})
+	}
+
+	return changes
+}
+
+// TierListRevision はティアリストの保存履歴の1リビジョン
+// 保存時点の配置のスナップショットと、直前のリビジョンからの変更を保持する
+type TierListRevision struct {
+	tierListID   id.TierListID
+	number       int
+	placements   []PlacementSnapshot
+	changes      []PlacementChange
+	restoredFrom *int
+	createdAt    time.Time
+}
+
+// NewTierListRevision は新しいTierListRevisionインスタンスを作成する
+// restoredFrom は復元によって作成する場合のみ復元元のリビジョン番号を渡す
+func NewTierListRevision(
+	tierListID id.TierListID,
+	number int,
+	placements []PlacementSnapshot,
+	changes []PlacementChange,
+	restoredFrom *int,
+) (*TierListRevision, error) {
+	return ReconstructTierListRevision(tierListID, number, placements, changes, restoredFrom, time.Now())
+}
+
+// ReconstructTierListRevision は永続化されたデータからTierListRevisionを復元する
+func ReconstructTierListRevision(
+	tierListID id.TierListID,
+	number int,
+	placements []PlacementSnapshot,
+	changes []PlacementChange,
+	restoredFrom *int,
+	createdAt time.Time,
+) (*TierListRevision, error) {
+	revision := &TierListRevision{
+		tierListID:   tierListID,
+		number:       number,
+		placements:   placements,
+		changes:      changes,
+		restoredFrom: restoredFrom,
+		createdAt:    createdAt,
+	}
+
+	if err := revision.validate(); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// TierListID は対象ティアリストのIDを返す
+func (r *TierListRevision) TierListID() id.TierListID {
+	return r.tierListID
+}
+
+// Number はリビジョン番号（1始まり）を返す
+func (r *TierListRevision) Number() int {
+	return r.number
+}
+
+// Placements は保存時点の配置のスナップショットを返す
+func (r *TierListRevision) Placements() []PlacementSnapshot {
+	return r.placements
+}
+
+// Changes は直前のリビジョンからの変更を返す
+func (r *TierListRevision) Changes() []PlacementChange {
+	return r.changes
+}
+
+// RestoredFrom は復元元のリビジョン番号を返す。復元でない場合は nil
+func (r *TierListRevision) RestoredFrom() *int {
+	return r.restoredFrom
+}
+
+// CreatedAt は作成日時を返す
+func (r *TierListRevision) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// validate は全体のバリデーションを実行する
+func (r *TierListRevision) validate() error {
+	if r.number < 1 {
+		return errors.New("revision number must be 1 or greater")
+	}
+
+	if r.restoredFrom != nil && (*r.restoredFrom < 1 || *r.restoredFrom >= r.number) {
+		return errors.New("restored revision must be an earlier revision")
+	}
+
+	return nil
+}
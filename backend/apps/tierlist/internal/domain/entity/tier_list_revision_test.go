@@ -0,0 +1,137 @@
+package entity_test
+
+import (
+	"testing"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPlacements(t *testing.T) {
+	t.Parallel()
+
+	deckA, deckB, deckC, deckD := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	before := []entity.PlacementSnapshot{
+		{DeckID: deckA, TierRank: rank.TierA, Position: 0},
+		{DeckID: deckB, TierRank: rank.TierA, Position: 1},
+		{DeckID: deckC, TierRank: rank.TierB, Position: 0},
+	}
+
+	tests := []struct {
+		caseName string
+		after    []entity.PlacementSnapshot
+		want     []entity.PlacementChange
+	}{
+		{
+			caseName: "正常系: 変更がない場合は空の差分を返す",
+			after:    before,
+			want:     []entity.PlacementChange{},
+		},
+		{
+			caseName: "正常系: 移動・並び替え・追加・削除がデッキ単位で返される",
+			after: []entity.PlacementSnapshot{
+				{DeckID: deckB, TierRank: rank.TierS, Position: 0},
+				{DeckID: deckD, TierRank: rank.TierA, Position: 0},
+				{DeckID: deckC, TierRank: rank.TierB, Position: 1},
+			},
+			want: []entity.PlacementChange{
+				{
+					Type:   entity.PlacementChangeMoved,
+					DeckID: deckB,
+					From:   &entity.PlacementSnapshot{DeckID: deckB, TierRank: rank.TierA, Position: 1},
+					To:     &entity.PlacementSnapshot{DeckID: deckB, TierRank: rank.TierS, Position: 0},
+				},
+				{
+					Type:   entity.PlacementChangeAdded,
+					DeckID: deckD,
+					To:     &entity.PlacementSnapshot{DeckID: deckD, TierRank: rank.TierA, Position: 0},
+				},
+				{
+					Type:   entity.PlacementChangeReordered,
+					DeckID: deckC,
+					From:   &entity.PlacementSnapshot{DeckID: deckC, TierRank: rank.TierB, Position: 0},
+					To:     &entity.PlacementSnapshot{DeckID: deckC, TierRank: rank.TierB, Position: 1},
+				},
+				{
+					Type:   entity.PlacementChangeRemoved,
+					DeckID: deckA,
+					From:   &entity.PlacementSnapshot{DeckID: deckA, TierRank: rank.TierA, Position: 0},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.DiffPlacements(before, tt.after)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "placement changes do not match")
+		})
+	}
+}
+
+func TestNewTierListRevision(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		caseName     string
+		number       int
+		restoredFrom *int
+		wantErr      bool
+	}{
+		{
+			caseName: "正常系: 有効なリビジョン番号でリビジョンが作成される",
+			number:   1,
+		},
+		{
+			caseName:     "正常系: 過去のリビジョンからの復元として作成される",
+			number:       5,
+			restoredFrom: intPtr(2),
+		},
+		{
+			caseName: "異常系: リビジョン番号が0の場合",
+			number:   0,
+			wantErr:  true,
+		},
+		{
+			caseName:     "異常系: 復元元が自身以降のリビジョンの場合",
+			number:       3,
+			restoredFrom: intPtr(3),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tierListID := id.NewTierListID()
+
+			// Act
+			got, err := entity.NewTierListRevision(tierListID, tt.number, nil, nil, tt.restoredFrom)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Nil(t, got, "revision should be nil on error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID, got.TierListID(), "tier list ID does not match")
+			assert.Equal(t, tt.number, got.Number(), "revision number does not match")
+			assert.Equal(t, tt.restoredFrom, got.RestoredFrom(), "restored from does not match")
+			assert.False(t, got.CreatedAt().IsZero(), "created at should be set")
+		})
+	}
+}
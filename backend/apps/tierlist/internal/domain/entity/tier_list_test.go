@@ -0,0 +1,551 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTierList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName       string
+		title          string
+		description    string
+		authorName     string
+		wantAuthorName string
+		wantErr        bool
+	}{
+		{
+			caseName:       "正常系: 有効なパラメータでTierListが作成される",
+			title:          "8月環境ティアリスト",
+			description:    "新弾環境での評価",
+			authorName:     "配信者A",
+			wantAuthorName: "配信者A",
+			wantErr:        false,
+		},
+		{
+			caseName:       "正常系: 作成者名が空の場合は匿名ユーザーになる",
+			title:          "8月環境ティアリスト",
+			authorName:     "",
+			wantAuthorName: entity.DefaultAuthorName,
+			wantErr:        false,
+		},
+		{
+			caseName:       "正常系: 100文字のタイトルが渡された場合",
+			title:          strings.Repeat("あ", 100),
+			wantAuthorName: entity.DefaultAuthorName,
+			wantErr:        false,
+		},
+		{
+			caseName: "異常系: 空のタイトルが渡された場合",
+			title:    "",
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 100文字を超えるタイトルが渡された場合",
+			title:    strings.Repeat("あ", 101),
+			wantErr:  true,
+		},
+		{
+			caseName:   "異常系: 30文字を超える作成者名が渡された場合",
+			title:      "8月環境ティアリスト",
+			authorName: strings.Repeat("あ", 31),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tierListID := id.NewTierListID()
+			seasonID := id.NewSeasonID()
+
+			// Act
+			got, err := entity.NewTierList(tierListID, seasonID, tt.title, tt.description, tt.authorName)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Nil(t, got, "tier list should be nil on error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID, got.ID(), "tier list ID does not match")
+			assert.Equal(t, seasonID, got.SeasonID(), "season ID does not match")
+			assert.Equal(t, tt.title, got.Title(), "title does not match")
+			assert.Equal(t, tt.description, got.Description(), "description does not match")
+			assert.Equal(t, tt.wantAuthorName, got.AuthorName(), "author name does not match")
+			assert.Equal(t, 0, got.ViewCount(), "view count should be zero")
+			assert.False(t, got.CreatedAt().IsZero(), "created at should be set")
+		})
+	}
+}
+
+func TestReconstructTierList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName      string
+		viewCount     int
+		forkCount     int
+		duplicateDeck bool
+		wantErr       bool
+	}{
+		{
+			caseName:  "正常系: 永続化データからTierListが復元される",
+			viewCount: 120,
+			forkCount: 3,
+			wantErr:   false,
+		},
+		{
+			caseName:  "異常系: 閲覧数が負数の場合",
+			viewCount: -1,
+			wantErr:   true,
+		},
+		{
+			caseName:  "異常系: フォーク数が負数の場合",
+			forkCount: -1,
+			wantErr:   true,
+		},
+		{
+			caseName:      "異常系: 同じデッキが複数配置されている場合",
+			duplicateDeck: true,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+			updatedAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+			forkedFrom := id.NewTierListID()
+			deckA, deckB := id.NewDeckID(), id.NewDeckID()
+			if tt.duplicateDeck {
+				deckB = deckA
+			}
+			// 並び順がばらばらの配置を渡す
+			placements := []*entity.TierPlacement{
+				createTestTierPlacement(t, deckA, rank.TierA, 0),
+				createTestTierPlacement(t, deckB, rank.TierSS, 0),
+			}
+
+			// Act
+			got, err := entity.ReconstructTierList(
+				id.NewTierListID(), id.NewSeasonID(), "A4環境", "", "配信者A",
+				&forkedFrom, tt.viewCount, tt.forkCount, placements, createdAt, updatedAt,
+			)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.viewCount, got.ViewCount(), "view count does not match")
+			assert.Equal(t, tt.forkCount, got.ForkCount(), "fork count does not match")
+			assert.Equal(t, &forkedFrom, got.ForkedFrom(), "forked from does not match")
+			assert.Equal(t, deckB, got.Placements()[0].DeckID(), "placements should be sorted by tier rank")
+			assert.Equal(t, createdAt, got.CreatedAt(), "created at does not match")
+			assert.Equal(t, updatedAt, got.UpdatedAt(), "updated at does not match")
+		})
+	}
+}
+
+func TestTierList_PlaceDeck(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+
+	tests := []struct {
+		caseName string
+		deckID   id.DeckID
+		tierRank rank.TierRank
+		position int
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 未配置のデッキを配置できる",
+			deckID:   id.NewDeckID(),
+			tierRank: rank.TierS,
+			position: 0,
+			wantErr:  false,
+		},
+		{
+			caseName: "異常系: 配置済みのデッキを配置した場合",
+			deckID:   deckID,
+			tierRank: rank.TierS,
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 範囲外のティアランクの場合",
+			deckID:   id.NewDeckID(),
+			tierRank: rank.TierRank(8),
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 並び順が負数の場合",
+			deckID:   id.NewDeckID(),
+			tierRank: rank.TierS,
+			position: -1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tierList, err := entity.NewTierList(id.NewTierListID(), id.NewSeasonID(), "A4環境", "", "")
+			assert.NoError(t, err, "failed to create tier list")
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckID, rank.TierA, 0), "failed to place deck")
+
+			// Act
+			err = tierList.PlaceDeck(id.NewTierPlacementID(), tt.deckID, tt.tierRank, tt.position)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Len(t, tierList.Placements(), 1, "placements should not change on error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Len(t, tierList.Placements(), 2, "placement count does not match")
+			assert.Equal(t, tt.deckID, tierList.Placements()[0].DeckID(), "stronger tier should come first")
+		})
+	}
+}
+
+func TestTierList_IsEditableBy(t *testing.T) {
+	t.Parallel()
+
+	authorID := id.NewUserID()
+
+	tests := []struct {
+		caseName string
+		author   *id.UserID
+		userID   id.UserID
+		want     bool
+	}{
+		{
+			caseName: "正常系: 作成者の場合は編集できる",
+			author:   &authorID,
+			userID:   authorID,
+			want:     true,
+		},
+		{
+			caseName: "正常系: 作成者以外の場合は編集できない",
+			author:   &authorID,
+			userID:   id.NewUserID(),
+			want:     false,
+		},
+		{
+			caseName: "正常系: 匿名で作成された場合は誰も編集できない",
+			author:   nil,
+			userID:   authorID,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tierList, err := entity.NewTierList(id.NewTierListID(), id.NewSeasonID(), "A4環境", "", "")
+			assert.NoError(t, err, "failed to create tier list")
+			if tt.author != nil {
+				tierList.AttributeToUser(*tt.author)
+			}
+
+			// Act
+			got := tierList.IsEditableBy(tt.userID)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "editable does not match")
+		})
+	}
+}
+
+func TestTierList_ReplacePlacements(t *testing.T) {
+	t.Parallel()
+
+	deckA, deckB, deckC := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	tests := []struct {
+		caseName     string
+		snapshot     []entity.PlacementSnapshot
+		wantSnapshot []entity.PlacementSnapshot
+		wantChanges  []entity.PlacementChangeType
+		wantErr      bool
+	}{
+		{
+			caseName: "正常系: 配置が置き換えられ、ティアごとに並び順が振り直される",
+			snapshot: []entity.PlacementSnapshot{
+				{DeckID: deckC, TierRank: rank.TierA, Position: 10},
+				{DeckID: deckA, TierRank: rank.TierS, Position: 3},
+				{DeckID: deckB, TierRank: rank.TierA, Position: 5},
+			},
+			wantSnapshot: []entity.PlacementSnapshot{
+				{DeckID: deckA, TierRank: rank.TierS, Position: 0},
+				{DeckID: deckB, TierRank: rank.TierA, Position: 0},
+				{DeckID: deckC, TierRank: rank.TierA, Position: 1},
+			},
+			wantChanges: []entity.PlacementChangeType{
+				entity.PlacementChangeMoved,
+				entity.PlacementChangeReordered,
+				entity.PlacementChangeAdded,
+			},
+		},
+		{
+			caseName:     "正常系: 空の配置に置き換えると全て削除される",
+			snapshot:     []entity.PlacementSnapshot{},
+			wantSnapshot: []entity.PlacementSnapshot{},
+			wantChanges: []entity.PlacementChangeType{
+				entity.PlacementChangeRemoved,
+				entity.PlacementChangeRemoved,
+			},
+		},
+		{
+			caseName: "異常系: 同じデッキが複数含まれる場合",
+			snapshot: []entity.PlacementSnapshot{
+				{DeckID: deckA, TierRank: rank.TierS, Position: 0},
+				{DeckID: deckA, TierRank: rank.TierA, Position: 0},
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "異常系: 範囲外のティアランクが含まれる場合",
+			snapshot: []entity.PlacementSnapshot{
+				{DeckID: deckA, TierRank: rank.TierRank(0), Position: 0},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			placementA := createTestTierPlacement(t, deckA, rank.TierA, 0)
+			tierList, err := entity.ReconstructTierList(
+				id.NewTierListID(), id.NewSeasonID(), "A4環境", "", "配信者A", nil, 0, 0,
+				[]*entity.TierPlacement{placementA, createTestTierPlacement(t, deckB, rank.TierA, 1)},
+				time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+			)
+			assert.NoError(t, err, "failed to reconstruct tier list")
+			before := tierList.Snapshot()
+
+			// Act
+			got, err := tierList.ReplacePlacements(tt.snapshot)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, before, tierList.Snapshot(), "placements should not change on error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantSnapshot, tierList.Snapshot(), "snapshot does not match")
+			gotTypes := make([]entity.PlacementChangeType, 0, len(got))
+			for _, change := range got {
+				gotTypes = append(gotTypes, change.Type)
+			}
+			assert.Equal(t, tt.wantChanges, gotTypes, "change types do not match")
+			for _, p := range tierList.Placements() {
+				if p.DeckID() == deckA {
+					assert.Equal(t, placementA.ID(), p.ID(), "placement ID should be kept for remaining deck")
+				}
+			}
+		})
+	}
+}
+
+func TestTierList_Fork(t *testing.T) {
+	t.Parallel()
+
+	sourceSeasonID := id.NewSeasonID()
+	targetSeasonID := id.NewSeasonID()
+	deckSS, deckA1, deckA2, deckA3 := id.NewDeckID(), id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+	mappedSS, mappedA1, mappedA3 := id.NewDeckID(), id.NewDeckID(), id.NewDeckID()
+
+	type wantPlacement struct {
+		deckID   id.DeckID
+		tierRank rank.TierRank
+		position int
+	}
+
+	tests := []struct {
+		caseName       string
+		seasonID       id.SeasonID
+		title          string
+		deckMapping    map[id.DeckID]id.DeckID
+		wantTitle      string
+		wantPlacements []wantPlacement
+		wantDropped    []id.DeckID
+		wantErr        bool
+	}{
+		{
+			caseName: "正常系: 同じシーズンにフォークした場合は全ての配置が複製される",
+			seasonID: sourceSeasonID,
+			title:    "",
+			deckMapping: map[id.DeckID]id.DeckID{
+				deckSS: deckSS, deckA1: deckA1, deckA2: deckA2, deckA3: deckA3,
+			},
+			wantTitle: "A4環境",
+			wantPlacements: []wantPlacement{
+				{deckID: deckSS, tierRank: rank.TierSS, position: 0},
+				{deckID: deckA1, tierRank: rank.TierA, position: 0},
+				{deckID: deckA2, tierRank: rank.TierA, position: 1},
+				{deckID: deckA3, tierRank: rank.TierA, position: 2},
+			},
+			wantDropped: []id.DeckID{},
+		},
+		{
+			caseName: "正常系: 別シーズンにフォークした場合は存在しないデッキが除外され並び順が詰められる",
+			seasonID: targetSeasonID,
+			title:    "A4環境（自分用）",
+			deckMapping: map[id.DeckID]id.DeckID{
+				deckSS: mappedSS, deckA1: mappedA1, deckA3: mappedA3,
+			},
+			wantTitle: "A4環境（自分用）",
+			wantPlacements: []wantPlacement{
+				{deckID: mappedSS, tierRank: rank.TierSS, position: 0},
+				{deckID: mappedA1, tierRank: rank.TierA, position: 0},
+				{deckID: mappedA3, tierRank: rank.TierA, position: 1},
+			},
+			wantDropped: []id.DeckID{deckA2},
+		},
+		{
+			caseName: "正常系: フォーク先で同じデッキに対応付けられた配置は除外される",
+			seasonID: targetSeasonID,
+			deckMapping: map[id.DeckID]id.DeckID{
+				deckSS: mappedSS, deckA1: mappedA1, deckA2: mappedA1, deckA3: mappedA3,
+			},
+			wantTitle: "A4環境",
+			wantPlacements: []wantPlacement{
+				{deckID: mappedSS, tierRank: rank.TierSS, position: 0},
+				{deckID: mappedA1, tierRank: rank.TierA, position: 0},
+				{deckID: mappedA3, tierRank: rank.TierA, position: 1},
+			},
+			wantDropped: []id.DeckID{deckA2},
+		},
+		{
+			caseName:    "異常系: 100文字を超えるタイトルが渡された場合",
+			seasonID:    sourceSeasonID,
+			title:       strings.Repeat("あ", 101),
+			deckMapping: map[id.DeckID]id.DeckID{},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			sourceID := id.NewTierListID()
+			source, err := entity.ReconstructTierList(
+				sourceID, sourceSeasonID, "A4環境", "新弾環境での評価", "配信者A",
+				nil, 500, 2,
+				[]*entity.TierPlacement{
+					createTestTierPlacement(t, deckSS, rank.TierSS, 0),
+					createTestTierPlacement(t, deckA1, rank.TierA, 0),
+					createTestTierPlacement(t, deckA2, rank.TierA, 1),
+					createTestTierPlacement(t, deckA3, rank.TierA, 2),
+				},
+				time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+			)
+			assert.NoError(t, err, "failed to reconstruct source tier list")
+			newID := id.NewTierListID()
+
+			// Act
+			got, dropped, err := source.Fork(newID, tt.seasonID, tt.title, "視聴者B", tt.deckMapping)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				assert.Nil(t, got, "forked tier list should be nil on error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, newID, got.ID(), "tier list ID does not match")
+			assert.Equal(t, tt.seasonID, got.SeasonID(), "season ID does not match")
+			assert.Equal(t, tt.wantTitle, got.Title(), "title does not match")
+			assert.Equal(t, "新弾環境での評価", got.Description(), "description should be copied")
+			assert.Equal(t, "視聴者B", got.AuthorName(), "author name does not match")
+			assert.Equal(t, &sourceID, got.ForkedFrom(), "forked from does not match")
+			assert.Equal(t, 0, got.ViewCount(), "view count should be reset")
+			assert.Equal(t, 0, got.ForkCount(), "fork count should be reset")
+			assert.Equal(t, tt.wantDropped, dropped, "dropped decks do not match")
+
+			gotPlacements := make([]wantPlacement, 0, len(got.Placements()))
+			for _, p := range got.Placements() {
+				gotPlacements = append(gotPlacements, wantPlacement{deckID: p.DeckID(), tierRank: p.TierRank(), position: p.Position()})
+			}
+			assert.Equal(t, tt.wantPlacements, gotPlacements, "placements do not match")
+			assert.Len(t, source.Placements(), 4, "source placements should not change")
+		})
+	}
+}
+
+func TestParseTierListSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		input    string
+		want     entity.TierListSort
+		wantErr  bool
+	}{
+		{caseName: "正常系: 空文字列は人気順", input: "", want: entity.TierListSortPopular},
+		{caseName: "正常系: popular", input: "popular", want: entity.TierListSortPopular},
+		{caseName: "正常系: newest", input: "newest", want: entity.TierListSortNewest},
+		{caseName: "正常系: trending", input: "trending", want: entity.TierListSortTrending},
+		{caseName: "正常系: hot", input: "hot", want: entity.TierListSortHot},
+		{caseName: "異常系: 未定義の並び順", input: "oldest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.ParseTierListSort(tt.input)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "sort does not match")
+		})
+	}
+}
+
+// createTestTierPlacement はテスト用のTierPlacementエンティティを作成するヘルパー関数
+func createTestTierPlacement(t *testing.T, deckID id.DeckID, tierRank rank.TierRank, position int) *entity.TierPlacement {
+	t.Helper()
+
+	placement, err := entity.NewTierPlacement(id.NewTierPlacementID(), deckID, tierRank, position)
+	assert.NoError(t, err, "failed to create tier placement entity")
+
+	return placement
+}
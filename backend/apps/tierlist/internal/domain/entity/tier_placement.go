@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"errors"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+)
+
+// TierPlacement はティアリスト内でのデッキの配置を表す
+type TierPlacement struct {
+	id       id.TierPlacementID
+	deckID   id.DeckID
+	tierRank rank.TierRank
+	position int
+}
+
+// NewTierPlacement は新しいTierPlacementインスタンスを作成する
+func NewTierPlacement(id id.TierPlacementID, deckID id.DeckID, tierRank rank.TierRank, position int) (*TierPlacement, error) {
+	placement := &TierPlacement{
+		id:       id,
+		deckID:   deckID,
+		tierRank: tierRank,
+		position: position,
+	}
+
+	if err := placement.validate(); err != nil {
+		return nil, err
+	}
+
+	return placement, nil
+}
+
+// ID はTierPlacementのIDを返す
+func (p *TierPlacement) ID() id.TierPlacementID {
+	return p.id
+}
+
+// DeckID は配置されたデッキのIDを返す
+func (p *TierPlacement) DeckID() id.DeckID {
+	return p.deckID
+}
+
+// TierRank は配置されたティアランクを返す
+func (p *TierPlacement) TierRank() rank.TierRank {
+	return p.tierRank
+}
+
+// Position はティア内での並び順を返す
+func (p *TierPlacement) Position() int {
+	return p.position
+}
+
+// validate は全体のバリデーションを実行する
+func (p *TierPlacement) validate() error {
+	if !p.tierRank.IsValid() {
+		return errors.New("tier rank must be between 1 and 7")
+	}
+
+	if p.position < 0 {
+		return errors.New("position cannot be negative")
+	}
+
+	return nil
+}
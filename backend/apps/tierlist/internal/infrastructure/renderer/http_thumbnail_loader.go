@@ -0,0 +1,55 @@
+package renderer
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	thumbnailTimeout = 3 * time.Second
+	// 想定外に大きな画像でメモリを圧迫しないよう、読み込むサイズに上限を設ける
+	maxThumbnailBytes = 2 << 20
+)
+
+// HTTPThumbnailLoader はデッキの画像URLからサムネイル画像を取得する
+type HTTPThumbnailLoader struct {
+	client *http.Client
+}
+
+func NewHTTPThumbnailLoader() *HTTPThumbnailLoader {
+	return &HTTPThumbnailLoader{
+		client: &http.Client{Timeout: thumbnailTimeout},
+	}
+}
+
+// Load は画像を取得してデコードする（PNG, JPEG, WebPに対応）
+func (l *HTTPThumbnailLoader) Load(ctx context.Context, url string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch thumbnail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch thumbnail: unexpected status %d", resp.StatusCode)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxThumbnailBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode thumbnail: %w", err)
+	}
+	return img, nil
+}
@@ -0,0 +1,79 @@
+package renderer_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"poketier/apps/tierlist/internal/infrastructure/renderer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPThumbnailLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	var pngData bytes.Buffer
+	src := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	src.Set(0, 0, color.RGBA{0xff, 0, 0, 0xff})
+	assert.NoError(t, png.Encode(&pngData, src), "failed to encode png")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deck.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(pngData.Bytes())
+		case "/broken.png":
+			_, _ = w.Write([]byte("not an image"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		caseName string
+		path     string
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: PNG画像が取得できる事",
+			path:     "/deck.png",
+		},
+		{
+			caseName: "異常系: 画像が存在しない場合、エラーを返す事",
+			path:     "/missing.png",
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 画像としてデコードできない場合、エラーを返す事",
+			path:     "/broken.png",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			loader := renderer.NewHTTPThumbnailLoader()
+
+			// Act
+			got, err := loader.Load(context.Background(), server.URL+tt.path)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, image.Rect(0, 0, 4, 3), got.Bounds(), "image bounds do not match")
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package renderer
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"sync"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// 画像サイズはX（旧Twitter）などのOGP画像（summary_large_image）に合わせる
+const (
+	ImageWidth  = 1200
+	ImageHeight = 630
+)
+
+const (
+	padding      = 16
+	headerHeight = 96
+	rowGap       = 4
+	labelWidth   = 96
+	tilePadding  = 6
+	tileGap      = 6
+
+	// 同時に取得するサムネイルの上限
+	maxConcurrentThumbnails = 8
+)
+
+var (
+	backgroundColor  = color.RGBA{0x1e, 0x1f, 0x26, 0xff}
+	rowColor         = color.RGBA{0x2a, 0x2b, 0x35, 0xff}
+	placeholderColor = color.RGBA{0x44, 0x46, 0x55, 0xff}
+	titleColor       = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	subTextColor     = color.RGBA{0xb4, 0xb6, 0xc2, 0xff}
+	labelTextColor   = color.RGBA{0x1e, 0x1f, 0x26, 0xff}
+
+	tierColors = map[rank.TierRank]color.RGBA{
+		rank.TierSS: {0xff, 0x4d, 0x6d, 0xff},
+		rank.TierS:  {0xff, 0x7f, 0x50, 0xff},
+		rank.TierA:  {0xff, 0xbf, 0x40, 0xff},
+		rank.TierB:  {0xff, 0xe0, 0x66, 0xff},
+		rank.TierC:  {0x9b, 0xe5, 0x64, 0xff},
+		rank.TierD:  {0x5c, 0xc8, 0xe6, 0xff},
+		rank.TierE:  {0xa0, 0x8c, 0xe6, 0xff},
+	}
+)
+
+// タイトルや作成者名の日本語を描画するため、M+ FONTS を埋め込む
+//
+//go:embed fonts/mplus-1p-regular.ttf
+var fontData []byte
+
+var parseFont = sync.OnceValues(func() (*opentype.Font, error) {
+	return opentype.Parse(fontData)
+})
+
+// ThumbnailLoader はデッキのサムネイル画像を取得する
+type ThumbnailLoader interface {
+	Load(ctx context.Context, url string) (image.Image, error)
+}
+
+// TierListImageRenderer はティアリストを共有用のPNG画像に描画する
+type TierListImageRenderer struct {
+	thumbnails ThumbnailLoader
+}
+
+func NewTierListImageRenderer(thumbnails ThumbnailLoader) *TierListImageRenderer {
+	return &TierListImageRenderer{
+		thumbnails: thumbnails,
+	}
+}
+
+// faces は描画に使用するフォントサイズごとのフェイス
+type faces struct {
+	title  font.Face
+	author font.Face
+	label  font.Face
+	tile   font.Face
+}
+
+func (f *faces) Close() {
+	for _, face := range []font.Face{f.title, f.author, f.label, f.tile} {
+		if face != nil {
+			face.Close()
+		}
+	}
+}
+
+// Render はティアリストをSS〜Eの行ごとに描画し、PNGとして返す
+// サムネイルが取得できないデッキはニックネームを表示したタイルで代替する
+// complete は画像URLが設定されたデッキのサムネイルをすべて取得できた場合に true となる
+func (r *TierListImageRenderer) Render(ctx context.Context, tierList *entity.TierList, decks []*entity.Deck) (rendered []byte, complete bool, err error) {
+	fs, err := newFaces()
+	if err != nil {
+		return nil, false, err
+	}
+	defer fs.Close()
+
+	decksByID := make(map[id.DeckID]*entity.Deck, len(decks))
+	for _, deck := range decks {
+		decksByID[deck.ID()] = deck
+	}
+	thumbnails, complete := r.loadThumbnails(ctx, decks)
+
+	canvas := image.NewRGBA(image.Rect(0, 0, ImageWidth, ImageHeight))
+	fillRect(canvas, canvas.Bounds(), backgroundColor)
+
+	drawHeader(canvas, fs, tierList)
+
+	rows := make(map[rank.TierRank][]*entity.TierPlacement)
+	for _, p := range tierList.Placements() {
+		rows[p.TierRank()] = append(rows[p.TierRank()], p)
+	}
+
+	rowHeight := (ImageHeight - headerHeight - padding) / len(rank.AllTierRanks())
+	for i, tierRank := range rank.AllTierRanks() {
+		bounds := image.Rect(padding, headerHeight+i*rowHeight, ImageWidth-padding, headerHeight+(i+1)*rowHeight-rowGap)
+		drawRow(canvas, fs, bounds, tierRank, rows[tierRank], decksByID, thumbnails)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, false, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return buf.Bytes(), complete, nil
+}
+
+// loadThumbnails はデッキのサムネイル画像を並行して取得する
+// 取得に失敗したデッキは結果に含めず、complete を false にする
+func (r *TierListImageRenderer) loadThumbnails(ctx context.Context, decks []*entity.Deck) (thumbnails map[id.DeckID]image.Image, complete bool) {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, maxConcurrentThumbnails)
+	)
+	thumbnails = make(map[id.DeckID]image.Image, len(decks))
+	complete = true
+	for _, deck := range decks {
+		if deck.ImageURL() == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(deck *entity.Deck) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			img, err := r.thumbnails.Load(ctx, deck.ImageURL())
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				complete = false
+				return
+			}
+			thumbnails[deck.ID()] = img
+		}(deck)
+	}
+	wg.Wait()
+
+	return thumbnails, complete
+}
+
+func newFaces() (*faces, error) {
+	f, err := parseFont()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse font: %w", err)
+	}
+
+	fs := &faces{}
+	for _, target := range []struct {
+		face *font.Face
+		size float64
+	}{
+		{&fs.title, 40},
+		{&fs.author, 22},
+		{&fs.label, 34},
+		{&fs.tile, 13},
+	} {
+		face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: target.size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			fs.Close()
+			return nil, fmt.Errorf("failed to create font face: %w", err)
+		}
+		*target.face = face
+	}
+	return fs, nil
+}
+
+// drawHeader はタイトルと作成者名を描画する
+func drawHeader(canvas *image.RGBA, fs *faces, tierList *entity.TierList) {
+	maxWidth := ImageWidth - padding*2
+	drawText(canvas, fs.title, titleColor, truncate(fs.title, tierList.Title(), maxWidth), padding, 50)
+	drawText(canvas, fs.author, subTextColor, truncate(fs.author, "作成者: "+tierList.AuthorName(), maxWidth), padding, 82)
+}
+
+// drawRow はティア1行分（ラベルと配置されたデッキ）を描画する
+// 行に収まらない場合は末尾のタイルに残りの件数を表示する
+func drawRow(
+	canvas *image.RGBA,
+	fs *faces,
+	bounds image.Rectangle,
+	tierRank rank.TierRank,
+	placements []*entity.TierPlacement,
+	decks map[id.DeckID]*entity.Deck,
+	thumbnails map[id.DeckID]image.Image,
+) {
+	labelBounds := image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Min.X+labelWidth, bounds.Max.Y)
+	fillRect(canvas, labelBounds, tierColors[tierRank])
+	drawCenteredText(canvas, fs.label, labelTextColor, tierRank.String(), labelBounds)
+
+	fillRect(canvas, image.Rect(labelBounds.Max.X, bounds.Min.Y, bounds.Max.X, bounds.Max.Y), rowColor)
+
+	tileSize := bounds.Dy() - tilePadding*2
+	left := labelBounds.Max.X + tilePadding
+	capacity := (bounds.Max.X - left) / (tileSize + tileGap)
+
+	visible := placements
+	if len(placements) > capacity {
+		visible = placements[:capacity-1]
+	}
+	for i, p := range visible {
+		x := left + i*(tileSize+tileGap)
+		tile := image.Rect(x, bounds.Min.Y+tilePadding, x+tileSize, bounds.Min.Y+tilePadding+tileSize)
+		if thumbnail, ok := thumbnails[p.DeckID()]; ok && !thumbnail.Bounds().Empty() {
+			drawThumbnail(canvas, tile, thumbnail)
+			continue
+		}
+		nickname := ""
+		if deck, ok := decks[p.DeckID()]; ok {
+			nickname = deck.Nickname()
+		}
+		fillRect(canvas, tile, placeholderColor)
+		drawCenteredLines(canvas, fs.tile, titleColor, wrap(fs.tile, nickname, tileSize-4, 2), tile)
+	}
+
+	if rest := len(placements) - len(visible); rest > 0 {
+		x := left + len(visible)*(tileSize+tileGap)
+		tile := image.Rect(x, bounds.Min.Y+tilePadding, x+tileSize, bounds.Min.Y+tilePadding+tileSize)
+		fillRect(canvas, tile, placeholderColor)
+		drawCenteredText(canvas, fs.author, titleColor, fmt.Sprintf("+%d", rest), tile)
+	}
+}
+
+// drawThumbnail はサムネイルを中央で正方形に切り抜いてタイルに収める
+func drawThumbnail(canvas *image.RGBA, tile image.Rectangle, thumbnail image.Image) {
+	src := thumbnail.Bounds()
+	side := min(src.Dx(), src.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(src.Min.X+(src.Dx()-side)/2, src.Min.Y+(src.Dy()-side)/2))
+	xdraw.CatmullRom.Scale(canvas, tile, thumbnail, crop, xdraw.Over, nil)
+}
+
+func fillRect(canvas *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(canvas, rect, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+func drawText(canvas *image.RGBA, face font.Face, c color.Color, s string, x, y int) {
+	d := &font.Drawer{
+		Dst:  canvas,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(s)
+}
+
+// drawCenteredText は文字列を矩形の中央に描画する
+func drawCenteredText(canvas *image.RGBA, face font.Face, c color.Color, s string, rect image.Rectangle) {
+	metrics := face.Metrics()
+	width := font.MeasureString(face, s).Round()
+	height := (metrics.Ascent + metrics.Descent).Round()
+	x := rect.Min.X + (rect.Dx()-width)/2
+	y := rect.Min.Y + (rect.Dy()-height)/2 + metrics.Ascent.Round()
+	drawText(canvas, face, c, s, x, y)
+}
+
+// drawCenteredLines は複数行の文字列を矩形の中央に描画する
+func drawCenteredLines(canvas *image.RGBA, face font.Face, c color.Color, lines []string, rect image.Rectangle) {
+	lineHeight := face.Metrics().Height.Round()
+	top := rect.Min.Y + (rect.Dy()-lineHeight*len(lines))/2
+	for i, line := range lines {
+		drawCenteredText(canvas, face, c, line, image.Rect(rect.Min.X, top+i*lineHeight, rect.Max.X, top+(i+1)*lineHeight))
+	}
+}
+
+// wrap は文字列を最大幅で折り返し、最大行数を超える部分は最終行の末尾を省略記号に置き換える
+func wrap(face font.Face, s string, maxWidth, maxLines int) []string {
+	var lines []string
+	runes := []rune(s)
+	for len(runes) > 0 && len(lines) < maxLines {
+		n := 1
+		for n < len(runes) && font.MeasureString(face, string(runes[:n+1])).Round() <= maxWidth {
+			n++
+		}
+		if len(lines) == maxLines-1 && n < len(runes) {
+			lines = append(lines, truncate(face, string(runes), maxWidth))
+			break
+		}
+		lines = append(lines, string(runes[:n]))
+		runes = runes[n:]
+	}
+	return lines
+}
+
+// truncate は文字列が最大幅に収まらない場合、末尾を省略記号に置き換える
+func truncate(face font.Face, s string, maxWidth int) string {
+	if font.MeasureString(face, s).Round() <= maxWidth {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + "…"
+		if font.MeasureString(face, candidate).Round() <= maxWidth {
+			return candidate
+		}
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/renderer/tier_list_image_renderer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/renderer/tier_list_image_renderer.go -destination=./apps/tierlist/internal/infrastructure/renderer/tier_list_image_renderer_mock_test.go -package=renderer_test
+//
+
+// Package renderer_test is a generated GoMock package.
+package renderer_test
+
+import (
+	context "context"
+	image "image"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockThumbnailLoader is a mock of ThumbnailLoader interface.
+type MockThumbnailLoader struct {
+	ctrl     *gomock.Controller
+	recorder *MockThumbnailLoaderMockRecorder
+	isgomock struct{}
+}
+
+// MockThumbnailLoaderMockRecorder is the mock recorder for MockThumbnailLoader.
+type MockThumbnailLoaderMockRecorder struct {
+	mock *MockThumbnailLoader
+}
+
+// NewMockThumbnailLoader creates a new mock instance.
+func NewMockThumbnailLoader(ctrl *gomock.Controller) *MockThumbnailLoader {
+	mock := &MockThumbnailLoader{ctrl: ctrl}
+	mock.recorder = &MockThumbnailLoaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThumbnailLoader) EXPECT() *MockThumbnailLoaderMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockThumbnailLoader) Load(ctx context.Context, url string) (image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx, url)
+	ret0, _ := ret[0].(image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockThumbnailLoaderMockRecorder) Load(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockThumbnailLoader)(nil).Load), ctx, url)
+}
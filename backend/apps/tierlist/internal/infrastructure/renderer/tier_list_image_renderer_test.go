@@ -0,0 +1,160 @@
+package renderer_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/apps/tierlist/internal/infrastructure/renderer"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 描画位置の検証に使用するレイアウト上の座標
+// 行の高さは (630 - ヘッダー96 - 余白16) / 7 = 74、タイルは58px四方で64px間隔に並ぶ
+const (
+	rowHeight  = 74
+	firstRowY  = 96
+	firstTileX = 118
+	firstTileY = 102
+	tileStride = 64
+)
+
+var (
+	thumbnailColor   = color.RGBA{0xe0, 0x10, 0x10, 0xff}
+	placeholderColor = color.RGBA{0x44, 0x46, 0x55, 0xff}
+)
+
+func TestTierListImageRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	seasonID := id.NewSeasonID()
+	thumbnail := boundedImage(image.NewUniform(thumbnailColor), 80, 80)
+
+	tests := []struct {
+		caseName     string
+		deckCount    int
+		imageURL     string
+		setupMock    func(mockLoader *MockThumbnailLoader)
+		wantComplete bool
+		assertTile   func(t *testing.T, img image.Image)
+	}{
+		{
+			caseName:  "正常系: サムネイルが取得できた場合、タイルにサムネイルが描画される",
+			deckCount: 1,
+			imageURL:  "https://example.com/decks/1.png",
+			setupMock: func(mockLoader *MockThumbnailLoader) {
+				mockLoader.EXPECT().Load(gomock.Any(), "https://example.com/decks/1.png").Return(boundedImage(image.NewUniform(thumbnailColor), 100, 50), nil)
+			},
+			wantComplete: true,
+			assertTile: func(t *testing.T, img image.Image) {
+				assert.Equal(t, thumbnailColor, rgbaAt(img, firstTileX+29, firstTileY+29), "thumbnail should be drawn in the tile")
+			},
+		},
+		{
+			caseName:  "正常系: サムネイルの取得に失敗した場合、代替のタイルが描画される",
+			deckCount: 1,
+			imageURL:  "https://example.com/decks/1.png",
+			setupMock: func(mockLoader *MockThumbnailLoader) {
+				mockLoader.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+			},
+			wantComplete: false,
+			assertTile: func(t *testing.T, img image.Image) {
+				assert.Equal(t, placeholderColor, rgbaAt(img, firstTileX+1, firstTileY+1), "placeholder should be drawn in the tile")
+			},
+		},
+		{
+			caseName:     "正常系: 画像URLが未設定の場合、サムネイルを取得せず代替のタイルが描画される",
+			deckCount:    1,
+			imageURL:     "",
+			setupMock:    func(mockLoader *MockThumbnailLoader) {},
+			wantComplete: true,
+			assertTile: func(t *testing.T, img image.Image) {
+				assert.Equal(t, placeholderColor, rgbaAt(img, firstTileX+1, firstTileY+1), "placeholder should be drawn in the tile")
+			},
+		},
+		{
+			caseName:  "正常系: 行に収まらない場合、末尾のタイルが残りの件数の表示に置き換わる",
+			deckCount: 20,
+			imageURL:  "https://example.com/decks/1.png",
+			setupMock: func(mockLoader *MockThumbnailLoader) {
+				mockLoader.EXPECT().Load(gomock.Any(), gomock.Any()).Return(thumbnail, nil).Times(20)
+			},
+			wantComplete: true,
+			assertTile: func(t *testing.T, img image.Image) {
+				// 1行には16タイルまで並ぶため、15件のデッキと「+5」のタイルが描画される
+				assert.Equal(t, thumbnailColor, rgbaAt(img, firstTileX+14*tileStride+1, firstTileY+1), "15th tile should be a thumbnail")
+				assert.Equal(t, placeholderColor, rgbaAt(img, firstTileX+15*tileStride+1, firstTileY+1), "last tile should show the rest count")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLoader := NewMockThumbnailLoader(ctrl)
+			tt.setupMock(mockLoader)
+
+			createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+			tierList, err := entity.ReconstructTierList(
+				id.NewTierListID(), seasonID, "8月環境ティアリスト", "", "配信者A", nil, 0, 0, nil, createdAt, createdAt,
+			)
+			assert.NoError(t, err, "failed to create tier list")
+
+			decks := make([]*entity.Deck, 0, tt.deckCount)
+			for i := 0; i < tt.deckCount; i++ {
+				deck := entity.ReconstructDeck(id.NewDeckID(), seasonID, []id.CardID{id.NewCardID()}, "リザニンフ", tt.imageURL)
+				assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deck.ID(), rank.TierSS, i), "failed to place deck")
+				decks = append(decks, deck)
+			}
+
+			r := renderer.NewTierListImageRenderer(mockLoader)
+
+			// Act
+			got, complete, err := r.Render(context.Background(), tierList, decks)
+
+			// Assert
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantComplete, complete, "complete flag does not match")
+
+			img, err := png.Decode(bytes.NewReader(got))
+			assert.NoError(t, err, "rendered image should be a valid PNG")
+			assert.Equal(t, image.Rect(0, 0, renderer.ImageWidth, renderer.ImageHeight), img.Bounds(), "image size should match social card size")
+
+			// 各行の左端にティアの色のラベルが描画されている
+			assert.Equal(t, color.RGBA{0xff, 0x4d, 0x6d, 0xff}, rgbaAt(img, 18, firstRowY+2), "SS label color does not match")
+			assert.Equal(t, color.RGBA{0xa0, 0x8c, 0xe6, 0xff}, rgbaAt(img, 18, firstRowY+6*rowHeight+2), "E label color does not match")
+
+			tt.assertTile(t, img)
+		})
+	}
+}
+
+// boundedImage は単色の画像を指定したサイズで切り出す
+func boundedImage(src image.Image, width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, src.At(x, y))
+		}
+	}
+	return img
+}
+
+func rgbaAt(img image.Image, x, y int) color.RGBA {
+	return color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// DeckQuerier はデータベースクエリを定義するインターフェース
+type DeckQuerier interface {
+	ListDecksByIDs(ctx context.Context, deckIds []pgtype.UUID) ([]db.Deck, error)
+	ListDecksBySeason(ctx context.Context, seasonID pgtype.UUID) ([]db.Deck, error)
+}
+
+// DeckRepository はティアリストから参照するデッキのリポジトリ
+type DeckRepository struct {
+	queries DeckQuerier
+}
+
+// NewDeckRepository は新しいDeckRepositoryを作成
+func NewDeckRepository(queries DeckQuerier) *DeckRepository {
+	return &DeckRepository{
+		queries: queries,
+	}
+}
+
+// FindByIDs は指定したIDのデッキを取得（存在しないIDは結果に含まれない）
+func (r *DeckRepository) FindByIDs(ctx context.Context, deckIDs []id.DeckID) ([]*entity.Deck, error) {
+	pgIDs := make([]pgtype.UUID, 0, len(deckIDs))
+	for _, deckID := range deckIDs {
+		pgIDs = append(pgIDs, pgtype.UUID{Bytes: deckID.UUID(), Valid: true})
+	}
+
+	rows, err := r.queries.ListDecksByIDs(ctx, pgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list decks by IDs: %w", err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+// FindBySeason は指定したシーズンのデッキを全て取得
+func (r *DeckRepository) FindBySeason(ctx context.Context, seasonID id.SeasonID) ([]*entity.Deck, error) {
+	rows, err := r.queries.ListDecksBySeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list decks by season: %w", err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+// toEntities はデータベースモデルからエンティティに変換
+func (r *DeckRepository) toEntities(rows []db.Deck) []*entity.Deck {
+	decks := make([]*entity.Deck, 0, len(rows))
+	for _, row := range rows {
+		cardIDs := make([]id.CardID, 0, 3)
+		for _, cardID := range []pgtype.UUID{row.PrimaryCardID, row.SecondaryCardID, row.TertiaryCardID} {
+			if cardID.Valid {
+				cardIDs = append(cardIDs, id.CardIDFromUUID(cardID.Bytes))
+			}
+		}
+
+		decks = append(decks, entity.ReconstructDeck(
+			id.DeckIDFromUUID(row.DeckID.Bytes),
+			id.SeasonIDFromUUID(row.SeasonID.Bytes),
+			cardIDs,
+			row.Nickname,
+			row.ImageUrl,
+		))
+	}
+	return decks
+}
@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestDeckRepository_FindBySeason(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	cardA, cardB := id.NewCardID(), id.NewCardID()
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockDeckQuerier)
+		want        []*entity.Deck
+		expectError bool
+	}{
+		{
+			caseName: "正常系: NULLのカードを除いたカード構成でデッキが取得できる事",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().ListDecksBySeason(gomock.Any(), pgSeasonID).Return([]db.Deck{
+					{
+						DeckID:          pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						SeasonID:        pgSeasonID,
+						PrimaryCardID:   pgtype.UUID{Bytes: cardA.UUID(), Valid: true},
+						SecondaryCardID: pgtype.UUID{Bytes: cardB.UUID(), Valid: true},
+						Nickname:        "ピカチュウex",
+						ImageUrl:        "https://example.com/decks/pikachu.png",
+					},
+				}, nil)
+			},
+			want: []*entity.Deck{
+				entity.ReconstructDeck(deckID, seasonID, []id.CardID{cardA, cardB}, "ピカチュウex", "https://example.com/decks/pikachu.png"),
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockDeckQuerier) {
+				mockQuerier.EXPECT().ListDecksBySeason(gomock.Any(), pgSeasonID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockDeckQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewDeckRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindBySeason(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "decks do not match")
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// FavoriteCountQuerier はデータベースクエリを定義するインターフェース
+type FavoriteCountQuerier interface {
+	ListTierListFavoriteCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListFavoriteCountsRow, error)
+}
+
+// FavoriteCountRepository はティアリストのお気に入り数のリポジトリ
+type FavoriteCountRepository struct {
+	queries FavoriteCountQuerier
+}
+
+// NewFavoriteCountRepository は新しいFavoriteCountRepositoryを作成
+func NewFavoriteCountRepository(queries FavoriteCountQuerier) *FavoriteCountRepository {
+	return &FavoriteCountRepository{
+		queries: queries,
+	}
+}
+
+// CountByTierListIDs は指定したティアリストのお気に入り数を取得
+// お気に入りされたことがないティアリストは結果に含まない（0件として扱う）
+func (r *FavoriteCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	if len(tierListIDs) == 0 {
+		return map[id.TierListID]int{}, nil
+	}
+
+	pgIDs := make([]pgtype.UUID, 0, len(tierListIDs))
+	for _, tierListID := range tierListIDs {
+		pgIDs = append(pgIDs, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	}
+
+	rows, err := r.queries.ListTierListFavoriteCounts(ctx, pgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier list favorite counts: %w", err)
+	}
+
+	counts := make(map[id.TierListID]int, len(rows))
+	for _, row := range rows {
+		counts[id.TierListIDFromUUID(row.TierListID.Bytes)] = int(row.FavoriteCount)
+	}
+	return counts, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/repository/favorite_count_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/repository/favorite_count_repository.go -destination=./apps/tierlist/internal/infrastructure/repository/favorite_count_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFavoriteCountQuerier is a mock of FavoriteCountQuerier interface.
+type MockFavoriteCountQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockFavoriteCountQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockFavoriteCountQuerierMockRecorder is the mock recorder for MockFavoriteCountQuerier.
+type MockFavoriteCountQuerierMockRecorder struct {
+	mock *MockFavoriteCountQuerier
+}
+
+// NewMockFavoriteCountQuerier creates a new mock instance.
+func NewMockFavoriteCountQuerier(ctrl *gomock.Controller) *MockFavoriteCountQuerier {
+	mock := &MockFavoriteCountQuerier{ctrl: ctrl}
+	mock.recorder = &MockFavoriteCountQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFavoriteCountQuerier) EXPECT() *MockFavoriteCountQuerierMockRecorder {
+	return m.recorder
+}
+
+// ListTierListFavoriteCounts mocks base method.
+func (m *MockFavoriteCountQuerier) ListTierListFavoriteCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListFavoriteCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListFavoriteCounts", ctx, tierListIds)
+	ret0, _ := ret[0].([]db.ListTierListFavoriteCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListFavoriteCounts indicates an expected call of ListTierListFavoriteCounts.
+func (mr *MockFavoriteCountQuerierMockRecorder) ListTierListFavoriteCounts(ctx, tierListIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListFavoriteCounts", reflect.TypeOf((*MockFavoriteCountQuerier)(nil).ListTierListFavoriteCounts), ctx, tierListIds)
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestFavoriteCountRepository_CountByTierListIDs(t *testing.T) {
+	t.Parallel()
+
+	favoritedID := id.NewTierListID()
+	notFavoritedID := id.NewTierListID()
+
+	tests := []struct {
+		caseName    string
+		tierListIDs []id.TierListID
+		setupMock   func(mockQuerier *MockFavoriteCountQuerier)
+		want        map[id.TierListID]int
+		expectError bool
+	}{
+		{
+			caseName:    "正常系: ティアリストごとのお気に入り数が取得できる事",
+			tierListIDs: []id.TierListID{favoritedID, notFavoritedID},
+			setupMock: func(mockQuerier *MockFavoriteCountQuerier) {
+				mockQuerier.EXPECT().ListTierListFavoriteCounts(gomock.Any(), []pgtype.UUID{
+					{Bytes: favoritedID.UUID(), Valid: true},
+					{Bytes: notFavoritedID.UUID(), Valid: true},
+				}).Return([]db.ListTierListFavoriteCountsRow{
+					{TierListID: pgtype.UUID{Bytes: favoritedID.UUID(), Valid: true}, FavoriteCount: 42},
+				}, nil)
+			},
+			want: map[id.TierListID]int{favoritedID: 42},
+		},
+		{
+			caseName:    "正常系: ティアリストが指定されない場合、クエリを実行せずに空の結果を返す事",
+			tierListIDs: []id.TierListID{},
+			setupMock:   func(mockQuerier *MockFavoriteCountQuerier) {},
+			want:        map[id.TierListID]int{},
+		},
+		{
+			caseName:    "異常系: DBエラーが発生した場合",
+			tierListIDs: []id.TierListID{favoritedID},
+			setupMock: func(mockQuerier *MockFavoriteCountQuerier) {
+				mockQuerier.EXPECT().ListTierListFavoriteCounts(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockFavoriteCountQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewFavoriteCountRepository(mockQuerier)
+
+			// Act
+			got, err := repo.CountByTierListIDs(context.Background(), tt.tierListIDs)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "favorite counts do not match")
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// LikeCountQuerier はデータベースクエリを定義するインターフェース
+type LikeCountQuerier interface {
+	ListTierListLikeCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListLikeCountsRow, error)
+}
+
+// LikeCountRepository はティアリストのいいね数のリポジトリ
+type LikeCountRepository struct {
+	queries LikeCountQuerier
+}
+
+// NewLikeCountRepository は新しいLikeCountRepositoryを作成
+func NewLikeCountRepository(queries LikeCountQuerier) *LikeCountRepository {
+	return &LikeCountRepository{
+		queries: queries,
+	}
+}
+
+// CountByTierListIDs は指定したティアリストのいいね数を取得
+// いいねされたことがないティアリストは結果に含まない（0件として扱う）
+func (r *LikeCountRepository) CountByTierListIDs(ctx context.Context, tierListIDs []id.TierListID) (map[id.TierListID]int, error) {
+	if len(tierListIDs) == 0 {
+		return map[id.TierListID]int{}, nil
+	}
+
+	pgIDs := make([]pgtype.UUID, 0, len(tierListIDs))
+	for _, tierListID := range tierListIDs {
+		pgIDs = append(pgIDs, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	}
+
+	rows, err := r.queries.ListTierListLikeCounts(ctx, pgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier list like counts: %w", err)
+	}
+
+	counts := make(map[id.TierListID]int, len(rows))
+	for _, row := range rows {
+		counts[id.TierListIDFromUUID(row.TierListID.Bytes)] = int(row.LikeCount)
+	}
+	return counts, nil
+}
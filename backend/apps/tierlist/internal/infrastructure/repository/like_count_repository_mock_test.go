@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/repository/like_count_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/repository/like_count_repository.go -destination=./apps/tierlist/internal/infrastructure/repository/like_count_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLikeCountQuerier is a mock of LikeCountQuerier interface.
+type MockLikeCountQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockLikeCountQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockLikeCountQuerierMockRecorder is the mock recorder for MockLikeCountQuerier.
+type MockLikeCountQuerierMockRecorder struct {
+	mock *MockLikeCountQuerier
+}
+
+// NewMockLikeCountQuerier creates a new mock instance.
+func NewMockLikeCountQuerier(ctrl *gomock.Controller) *MockLikeCountQuerier {
+	mock := &MockLikeCountQuerier{ctrl: ctrl}
+	mock.recorder = &MockLikeCountQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLikeCountQuerier) EXPECT() *MockLikeCountQuerierMockRecorder {
+	return m.recorder
+}
+
+// ListTierListLikeCounts mocks base method.
+func (m *MockLikeCountQuerier) ListTierListLikeCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]db.ListTierListLikeCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListLikeCounts", ctx, tierListIds)
+	ret0, _ := ret[0].([]db.ListTierListLikeCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListLikeCounts indicates an expected call of ListTierListLikeCounts.
+func (mr *MockLikeCountQuerierMockRecorder) ListTierListLikeCounts(ctx, tierListIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListLikeCounts", reflect.TypeOf((*MockLikeCountQuerier)(nil).ListTierListLikeCounts), ctx, tierListIds)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// SeasonQuerier はデータベースクエリを定義するインターフェース
+type SeasonQuerier interface {
+	GetSeason(ctx context.Context, seasonID pgtype.UUID) (db.Season, error)
+}
+
+// SeasonRepository はティアリストから参照するシーズンのリポジトリ
+type SeasonRepository struct {
+	queries SeasonQuerier
+}
+
+// NewSeasonRepository は新しいSeasonRepositoryを作成
+func NewSeasonRepository(queries SeasonQuerier) *SeasonRepository {
+	return &SeasonRepository{
+		queries: queries,
+	}
+}
+
+// Exists は指定したシーズンが存在するかどうかを返す
+func (r *SeasonRepository) Exists(ctx context.Context, seasonID id.SeasonID) (bool, error) {
+	if _, err := r.queries.GetSeason(ctx, pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get season: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/sqlc/db"
+)
+
+func TestSeasonRepository_Exists(t *testing.T) {
+	t.Parallel()
+
+	pgSeasonID := pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSeasonQuerier)
+		want        bool
+		expectError bool
+	}{
+		{
+			caseName: "正常系: シーズンが存在する場合はtrueを返す事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{SeasonID: pgSeasonID}, nil)
+			},
+			want: true,
+		},
+		{
+			caseName: "正常系: シーズンが存在しない場合はfalseを返す事",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{}, pgx.ErrNoRows)
+			},
+			want: false,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockSeasonQuerier) {
+				mockQuerier.EXPECT().GetSeason(gomock.Any(), pgSeasonID).Return(db.Season{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSeasonQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSeasonRepository(mockQuerier)
+
+			// Act
+			got, err := repo.Exists(context.Background(), seasonID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "exists does not match")
+		})
+	}
+}
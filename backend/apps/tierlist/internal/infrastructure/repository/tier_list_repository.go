@@ -0,0 +1,435 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+// TierListQuerier はデータベースクエリを定義するインターフェース
+type TierListQuerier interface {
+	GetTierList(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error)
+	GetTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error)
+	CreateTierList(ctx context.Context, arg db.CreateTierListParams) (db.TierList, error)
+	IncrementTierListForkCount(ctx context.Context, tierListID pgtype.UUID) error
+	TouchTierList(ctx context.Context, tierListID pgtype.UUID) error
+	ListTierListsByPopular(ctx context.Context, arg db.ListTierListsByPopularParams) ([]db.TierList, error)
+	ListTierListsByNewest(ctx context.Context, arg db.ListTierListsByNewestParams) ([]db.TierList, error)
+	ListTierListsByTrending(ctx context.Context, arg db.ListTierListsByTrendingParams) ([]db.TierList, error)
+	ListTierListsByHot(ctx context.Context, arg db.ListTierListsByHotParams) ([]db.TierList, error)
+	ListSeasonTierListsByPopular(ctx context.Context, arg db.ListSeasonTierListsByPopularParams) ([]db.TierList, error)
+	ListSeasonTierListsByNewest(ctx context.Context, arg db.ListSeasonTierListsByNewestParams) ([]db.TierList, error)
+	ListSeasonTierListsByTrending(ctx context.Context, arg db.ListSeasonTierListsByTrendingParams) ([]db.TierList, error)
+	ListSeasonTierListsByHot(ctx context.Context, arg db.ListSeasonTierListsByHotParams) ([]db.TierList, error)
+	ListTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) ([]db.TierPlacement, error)
+	BulkCreateTierPlacements(ctx context.Context, arg []db.BulkCreateTierPlacementsParams) (int64, error)
+	DeleteTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) error
+	AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error
+	SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error
+}
+
+// TierListRepository はTierListRepositoryの実装
+type TierListRepository struct {
+	queries TierListQuerier
+}
+
+// NewTierListRepository は新しいTierListRepositoryを作成
+func NewTierListRepository(queries TierListQuerier) *TierListRepository {
+	return &TierListRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのティアリストを配置を含めて取得
+func (r *TierListRepository) FindByID(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	pgID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	row, err := r.queries.GetTierList(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("tier list not found", err)
+		}
+		return nil, fmt.Errorf("failed to get tier list: %w", err)
+	}
+
+	return r.withPlacements(ctx, row)
+}
+
+// FindByIDForUpdate は指定したIDのティアリストを行ロックを取得して配置を含めて取得
+// トランザクション内で呼び出し、同じティアリストへの同時更新を直列化する
+func (r *TierListRepository) FindByIDForUpdate(ctx context.Context, tierListID id.TierListID) (*entity.TierList, error) {
+	pgID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	row, err := r.queries.GetTierListForUpdate(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("tier list not found", err)
+		}
+		return nil, fmt.Errorf("failed to get tier list for update: %w", err)
+	}
+
+	return r.withPlacements(ctx, row)
+}
+
+// withPlacements はティアリストの配置を取得してエンティティに変換
+func (r *TierListRepository) withPlacements(ctx context.Context, row db.TierList) (*entity.TierList, error) {
+	placementRows, err := r.queries.ListTierPlacementsByTierList(ctx, row.TierListID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier placements: %w", err)
+	}
+
+	placements := make([]*entity.TierPlacement, 0, len(placementRows))
+	for _, placementRow := range placementRows {
+		placement, err := entity.NewTierPlacement(
+			id.TierPlacementIDFromUUID(placementRow.TierPlacementID.Bytes),
+			id.DeckIDFromUUID(placementRow.DeckID.Bytes),
+			rank.TierRank(placementRow.TierRank),
+			int(placementRow.Position),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tier placement entity: %w", err)
+		}
+		placements = append(placements, placement)
+	}
+
+	return r.toEntity(row, placements)
+}
+
+// Create はティアリストと配置を保存
+func (r *TierListRepository) Create(ctx context.Context, tierList *entity.TierList) error {
+	pgID := pgtype.UUID{Bytes: tierList.ID().UUID(), Valid: true}
+
+	params := db.CreateTierListParams{
+		TierListID:  pgID,
+		SeasonID:    pgtype.UUID{Bytes: tierList.SeasonID().UUID(), Valid: true},
+		Title:       tierList.Title(),
+		Description: tierList.Description(),
+		AuthorName:  tierList.AuthorName(),
+		AuthorIp:    tierList.AuthorIP(),
+	}
+	if forkedFrom := tierList.ForkedFrom(); forkedFrom != nil {
+		params.ForkedFromTierListID = pgtype.UUID{Bytes: forkedFrom.UUID(), Valid: true}
+	}
+	if authorUserID := tierList.AuthorUserID(); authorUserID != nil {
+		params.AuthorUserID = pgtype.UUID{Bytes: authorUserID.UUID(), Valid: true}
+	}
+
+	if _, err := r.queries.CreateTierList(ctx, params); err != nil {
+		return fmt.Errorf("failed to create tier list: %w", err)
+	}
+
+	return r.createPlacements(ctx, tierList)
+}
+
+// UpdatePlacements はティアリストの配置を保存済みの配置と丸ごと置き換える
+// ティア統計は削除前の配置を減算し、保存後の配置を加算して差分を反映する
+func (r *TierListRepository) UpdatePlacements(ctx context.Context, tierList *entity.TierList) error {
+	pgID := pgtype.UUID{Bytes: tierList.ID().UUID(), Valid: true}
+
+	if err := r.queries.SubtractTierListFromStatistics(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to subtract tier statistics: %w", err)
+	}
+
+	if err := r.queries.DeleteTierPlacementsByTierList(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to delete tier placements: %w", err)
+	}
+
+	if err := r.createPlacements(ctx, tierList); err != nil {
+		return err
+	}
+
+	if err := r.queries.TouchTierList(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to touch tier list: %w", err)
+	}
+
+	return nil
+}
+
+// createPlacements はティアリストの配置を一括で保存し、ティア統計に加算
+func (r *TierListRepository) createPlacements(ctx context.Context, tierList *entity.TierList) error {
+	if len(tierList.Placements()) == 0 {
+		return nil
+	}
+
+	pgID := pgtype.UUID{Bytes: tierList.ID().UUID(), Valid: true}
+	placementParams := make([]db.BulkCreateTierPlacementsParams, 0, len(tierList.Placements()))
+	for _, placement := range tierList.Placements() {
+		placementParams = append(placementParams, db.BulkCreateTierPlacementsParams{
+			TierPlacementID: pgtype.UUID{Bytes: placement.ID().UUID(), Valid: true},
+			TierListID:      pgID,
+			DeckID:          pgtype.UUID{Bytes: placement.DeckID().UUID(), Valid: true},
+			TierRank:        int16(placement.TierRank().Int()), // #nosec G115 -- ティアランクは1〜7
+			Position:        int32(placement.Position()),       // #nosec G115 -- 並び順はティア内のデッキ数以下
+		})
+	}
+
+	if _, err := r.queries.BulkCreateTierPlacements(ctx, placementParams); err != nil {
+		return fmt.Errorf("failed to create tier placements: %w", err)
+	}
+
+	if err := r.queries.AddTierListToStatistics(ctx, pgID); err != nil {
+		return fmt.Errorf("failed to add tier statistics: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementForkCount はティアリストのフォーク数を1増やす
+func (r *TierListRepository) IncrementForkCount(ctx context.Context, tierListID id.TierListID) error {
+	if err := r.queries.IncrementTierListForkCount(ctx, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}); err != nil {
+		return fmt.Errorf("failed to increment fork count: %w", err)
+	}
+	return nil
+}
+
+// FindPage は検索条件に一致するティアリストを1ページ分取得（配置は含まない）
+func (r *TierListRepository) FindPage(ctx context.Context, query entity.TierListQuery) (*entity.TierListPage, error) {
+	// 次ページの有無を判定するため1件多く取得する
+	fetchLimit := int32(query.Limit + 1) // #nosec G115 -- Limitはusecaseで上限を丸めている
+
+	var (
+		rows     []db.TierList
+		sortKeys []int64
+		err      error
+	)
+	switch query.Sort {
+	case entity.TierListSortPopular:
+		rows, err = r.listPopular(ctx, query, fetchLimit)
+		sortKeys = make([]int64, len(rows))
+		for i, row := range rows {
+			sortKeys[i] = int64(row.ViewCount)
+		}
+	case entity.TierListSortNewest:
+		rows, err = r.listNewest(ctx, query, fetchLimit)
+		sortKeys = make([]int64, len(rows))
+		for i, row := range rows {
+			sortKeys[i] = row.CreatedAt.Time.UnixMicro()
+		}
+	case entity.TierListSortTrending:
+		rows, err = r.listTrending(ctx, query, fetchLimit)
+		sortKeys = make([]int64, len(rows))
+		for i, row := range rows {
+			sortKeys[i] = int64(row.TrendingScore)
+		}
+	case entity.TierListSortHot:
+		rows, err = r.listHot(ctx, query, fetchLimit)
+		sortKeys = make([]int64, len(rows))
+		for i, row := range rows {
+			sortKeys[i] = row.HotScore
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tier list sort: %s", query.Sort)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier lists: %w", err)
+	}
+
+	return r.toPage(rows, sortKeys, query.Limit)
+}
+
+// toPage は取得結果をページに変換し、次ページのカーソルを算出
+func (r *TierListRepository) toPage(rows []db.TierList, sortKeys []int64, limit int) (*entity.TierListPage, error) {
+	page := &entity.TierListPage{}
+
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		page.Next = &entity.TierListCursor{
+			SortKey:    sortKeys[limit-1],
+			TierListID: id.TierListIDFromUUID(last.TierListID.Bytes),
+		}
+	}
+
+	page.TierLists = make([]*entity.TierList, 0, len(rows))
+	for _, row := range rows {
+		tierList, err := r.toEntity(row, nil)
+		if err != nil {
+			return nil, err
+		}
+		page.TierLists = append(page.TierLists, tierList)
+	}
+
+	return page, nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *TierListRepository) toEntity(row db.TierList, placements []*entity.TierPlacement) (*entity.TierList, error) {
+	var forkedFrom *id.TierListID
+	if row.ForkedFromTierListID.Valid {
+		forkedFromID := id.TierListIDFromUUID(row.ForkedFromTierListID.Bytes)
+		forkedFrom = &forkedFromID
+	}
+
+	tierList, err := entity.ReconstructTierList(
+		id.TierListIDFromUUID(row.TierListID.Bytes),
+		id.SeasonIDFromUUID(row.SeasonID.Bytes),
+		row.Title,
+		row.Description,
+		row.AuthorName,
+		forkedFrom,
+		int(row.ViewCount),
+		int(row.ForkCount),
+		placements,
+		row.CreatedAt.Time,
+		row.UpdatedAt.Time,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tier list entity: %w", err)
+	}
+	if row.AuthorUserID.Valid {
+		tierList.AttributeToUser(id.UserIDFromUUID(row.AuthorUserID.Bytes))
+	}
+
+	return tierList, nil
+}
+
+// listPopular は人気順で取得する。シーズンが指定された場合はシーズン指定版のクエリを使う
+func (r *TierListRepository) listPopular(ctx context.Context, query entity.TierListQuery, limit int32) ([]db.TierList, error) {
+	params := r.toPopularParams(query, limit)
+	if query.SeasonID == nil {
+		return r.queries.ListTierListsByPopular(ctx, params)
+	}
+	return r.queries.ListSeasonTierListsByPopular(ctx, db.ListSeasonTierListsByPopularParams{
+		SeasonID:             toSeasonUUID(*query.SeasonID),
+		AuthorName:           params.AuthorName,
+		ForkedFromTierListID: params.ForkedFromTierListID,
+		CursorViewCount:      params.CursorViewCount,
+		CursorTierListID:     params.CursorTierListID,
+		PageLimit:            params.PageLimit,
+	})
+}
+
+// listNewest は新着順で取得する。シーズンが指定された場合はシーズン指定版のクエリを使う
+func (r *TierListRepository) listNewest(ctx context.Context, query entity.TierListQuery, limit int32) ([]db.TierList, error) {
+	params := r.toNewestParams(query, limit)
+	if query.SeasonID == nil {
+		return r.queries.ListTierListsByNewest(ctx, params)
+	}
+	return r.queries.ListSeasonTierListsByNewest(ctx, db.ListSeasonTierListsByNewestParams{
+		SeasonID:             toSeasonUUID(*query.SeasonID),
+		AuthorName:           params.AuthorName,
+		ForkedFromTierListID: params.ForkedFromTierListID,
+		CursorCreatedAt:      params.CursorCreatedAt,
+		CursorTierListID:     params.CursorTierListID,
+		PageLimit:            params.PageLimit,
+	})
+}
+
+// listTrending はトレンド順で取得する。シーズンが指定された場合はシーズン指定版のクエリを使う
+func (r *TierListRepository) listTrending(ctx context.Context, query entity.TierListQuery, limit int32) ([]db.TierList, error) {
+	params := r.toTrendingParams(query, limit)
+	if query.SeasonID == nil {
+		return r.queries.ListTierListsByTrending(ctx, params)
+	}
+	return r.queries.ListSeasonTierListsByTrending(ctx, db.ListSeasonTierListsByTrendingParams{
+		SeasonID:             toSeasonUUID(*query.SeasonID),
+		AuthorName:           params.AuthorName,
+		ForkedFromTierListID: params.ForkedFromTierListID,
+		CursorTrendingScore:  params.CursorTrendingScore,
+		CursorTierListID:     params.CursorTierListID,
+		PageLimit:            params.PageLimit,
+	})
+}
+
+// listHot はホット順で取得する。シーズンが指定された場合はシーズン指定版のクエリを使う
+func (r *TierListRepository) listHot(ctx context.Context, query entity.TierListQuery, limit int32) ([]db.TierList, error) {
+	params := r.toHotParams(query, limit)
+	if query.SeasonID == nil {
+		return r.queries.ListTierListsByHot(ctx, params)
+	}
+	return r.queries.ListSeasonTierListsByHot(ctx, db.ListSeasonTierListsByHotParams{
+		SeasonID:             toSeasonUUID(*query.SeasonID),
+		AuthorName:           params.AuthorName,
+		ForkedFromTierListID: params.ForkedFromTierListID,
+		CursorHotScore:       params.CursorHotScore,
+		CursorTierListID:     params.CursorTierListID,
+		PageLimit:            params.PageLimit,
+	})
+}
+
+// toPopularParams は検索条件から人気順クエリのパラメータに変換
+func (r *TierListRepository) toPopularParams(query entity.TierListQuery, limit int32) db.ListTierListsByPopularParams {
+	params := db.ListTierListsByPopularParams{
+		AuthorName:           toAuthorText(query.AuthorName),
+		ForkedFromTierListID: toTierListUUID(query.ForkedFrom),
+		PageLimit:            limit,
+	}
+	if query.After != nil {
+		params.CursorViewCount = pgtype.Int4{Int32: int32(query.After.SortKey), Valid: true} // #nosec G115 -- カーソルのキー値はusecaseで閲覧数（int4）の範囲内か検証している
+		params.CursorTierListID = pgtype.UUID{Bytes: query.After.TierListID.UUID(), Valid: true}
+	}
+	return params
+}
+
+// toNewestParams は検索条件から新着順クエリのパラメータに変換
+func (r *TierListRepository) toNewestParams(query entity.TierListQuery, limit int32) db.ListTierListsByNewestParams {
+	params := db.ListTierListsByNewestParams{
+		AuthorName:           toAuthorText(query.AuthorName),
+		ForkedFromTierListID: toTierListUUID(query.ForkedFrom),
+		PageLimit:            limit,
+	}
+	if query.After != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: time.UnixMicro(query.After.SortKey), Valid: true}
+		params.CursorTierListID = pgtype.UUID{Bytes: query.After.TierListID.UUID(), Valid: true}
+	}
+	return params
+}
+
+// toTrendingParams は検索条件からトレンド順クエリのパラメータに変換
+func (r *TierListRepository) toTrendingParams(query entity.TierListQuery, limit int32) db.ListTierListsByTrendingParams {
+	params := db.ListTierListsByTrendingParams{
+		AuthorName:           toAuthorText(query.AuthorName),
+		ForkedFromTierListID: toTierListUUID(query.ForkedFrom),
+		PageLimit:            limit,
+	}
+	if query.After != nil {
+		params.CursorTrendingScore = pgtype.Int4{Int32: int32(query.After.SortKey), Valid: true} // #nosec G115 -- カーソルのキー値はusecaseでトレンドスコア（int4）の範囲内か検証している
+		params.CursorTierListID = pgtype.UUID{Bytes: query.After.TierListID.UUID(), Valid: true}
+	}
+	return params
+}
+
+// toHotParams は検索条件からホット順クエリのパラメータに変換
+func (r *TierListRepository) toHotParams(query entity.TierListQuery, limit int32) db.ListTierListsByHotParams {
+	params := db.ListTierListsByHotParams{
+		AuthorName:           toAuthorText(query.AuthorName),
+		ForkedFromTierListID: toTierListUUID(query.ForkedFrom),
+		PageLimit:            limit,
+	}
+	if query.After != nil {
+		params.CursorHotScore = pgtype.Int8{Int64: query.After.SortKey, Valid: true}
+		params.CursorTierListID = pgtype.UUID{Bytes: query.After.TierListID.UUID(), Valid: true}
+	}
+	return params
+}
+
+// toSeasonUUID はシーズンIDをUUIDに変換
+func toSeasonUUID(seasonID id.SeasonID) pgtype.UUID {
+	return pgtype.UUID{Bytes: seasonID.UUID(), Valid: true}
+}
+
+// toTierListUUID は任意指定のティアリストIDをNULL許容のUUIDに変換
+func toTierListUUID(tierListID *id.TierListID) pgtype.UUID {
+	if tierListID == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+}
+
+// toAuthorText は任意指定の作成者名をNULL許容のテキストに変換
+func toAuthorText(authorName string) pgtype.Text {
+	if authorName == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: authorName, Valid: true}
+}
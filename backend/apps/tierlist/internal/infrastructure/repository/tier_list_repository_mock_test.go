@@ -0,0 +1,308 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/repository/tier_list_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/repository/tier_list_repository.go -destination=./apps/tierlist/internal/infrastructure/repository/tier_list_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListQuerier is a mock of TierListQuerier interface.
+type MockTierListQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListQuerierMockRecorder is the mock recorder for MockTierListQuerier.
+type MockTierListQuerierMockRecorder struct {
+	mock *MockTierListQuerier
+}
+
+// NewMockTierListQuerier creates a new mock instance.
+func NewMockTierListQuerier(ctrl *gomock.Controller) *MockTierListQuerier {
+	mock := &MockTierListQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListQuerier) EXPECT() *MockTierListQuerierMockRecorder {
+	return m.recorder
+}
+
+// AddTierListToStatistics mocks base method.
+func (m *MockTierListQuerier) AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTierListToStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTierListToStatistics indicates an expected call of AddTierListToStatistics.
+func (mr *MockTierListQuerierMockRecorder) AddTierListToStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTierListToStatistics", reflect.TypeOf((*MockTierListQuerier)(nil).AddTierListToStatistics), ctx, tierListID)
+}
+
+// BulkCreateTierPlacements mocks base method.
+func (m *MockTierListQuerier) BulkCreateTierPlacements(ctx context.Context, arg []db.BulkCreateTierPlacementsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateTierPlacements", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreateTierPlacements indicates an expected call of BulkCreateTierPlacements.
+func (mr *MockTierListQuerierMockRecorder) BulkCreateTierPlacements(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateTierPlacements", reflect.TypeOf((*MockTierListQuerier)(nil).BulkCreateTierPlacements), ctx, arg)
+}
+
+// CreateTierList mocks base method.
+func (m *MockTierListQuerier) CreateTierList(ctx context.Context, arg db.CreateTierListParams) (db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTierList", ctx, arg)
+	ret0, _ := ret[0].(db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTierList indicates an expected call of CreateTierList.
+func (mr *MockTierListQuerierMockRecorder) CreateTierList(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTierList", reflect.TypeOf((*MockTierListQuerier)(nil).CreateTierList), ctx, arg)
+}
+
+// DeleteTierPlacementsByTierList mocks base method.
+func (m *MockTierListQuerier) DeleteTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTierPlacementsByTierList", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTierPlacementsByTierList indicates an expected call of DeleteTierPlacementsByTierList.
+func (mr *MockTierListQuerierMockRecorder) DeleteTierPlacementsByTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTierPlacementsByTierList", reflect.TypeOf((*MockTierListQuerier)(nil).DeleteTierPlacementsByTierList), ctx, tierListID)
+}
+
+// GetTierList mocks base method.
+func (m *MockTierListQuerier) GetTierList(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierList", ctx, tierListID)
+	ret0, _ := ret[0].(db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierList indicates an expected call of GetTierList.
+func (mr *MockTierListQuerierMockRecorder) GetTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierList", reflect.TypeOf((*MockTierListQuerier)(nil).GetTierList), ctx, tierListID)
+}
+
+// GetTierListForUpdate mocks base method.
+func (m *MockTierListQuerier) GetTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierListForUpdate", ctx, tierListID)
+	ret0, _ := ret[0].(db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierListForUpdate indicates an expected call of GetTierListForUpdate.
+func (mr *MockTierListQuerierMockRecorder) GetTierListForUpdate(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierListForUpdate", reflect.TypeOf((*MockTierListQuerier)(nil).GetTierListForUpdate), ctx, tierListID)
+}
+
+// IncrementTierListForkCount mocks base method.
+func (m *MockTierListQuerier) IncrementTierListForkCount(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTierListForkCount", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementTierListForkCount indicates an expected call of IncrementTierListForkCount.
+func (mr *MockTierListQuerierMockRecorder) IncrementTierListForkCount(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTierListForkCount", reflect.TypeOf((*MockTierListQuerier)(nil).IncrementTierListForkCount), ctx, tierListID)
+}
+
+// ListSeasonTierListsByHot mocks base method.
+func (m *MockTierListQuerier) ListSeasonTierListsByHot(ctx context.Context, arg db.ListSeasonTierListsByHotParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasonTierListsByHot", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasonTierListsByHot indicates an expected call of ListSeasonTierListsByHot.
+func (mr *MockTierListQuerierMockRecorder) ListSeasonTierListsByHot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasonTierListsByHot", reflect.TypeOf((*MockTierListQuerier)(nil).ListSeasonTierListsByHot), ctx, arg)
+}
+
+// ListSeasonTierListsByNewest mocks base method.
+func (m *MockTierListQuerier) ListSeasonTierListsByNewest(ctx context.Context, arg db.ListSeasonTierListsByNewestParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasonTierListsByNewest", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasonTierListsByNewest indicates an expected call of ListSeasonTierListsByNewest.
+func (mr *MockTierListQuerierMockRecorder) ListSeasonTierListsByNewest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasonTierListsByNewest", reflect.TypeOf((*MockTierListQuerier)(nil).ListSeasonTierListsByNewest), ctx, arg)
+}
+
+// ListSeasonTierListsByPopular mocks base method.
+func (m *MockTierListQuerier) ListSeasonTierListsByPopular(ctx context.Context, arg db.ListSeasonTierListsByPopularParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasonTierListsByPopular", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasonTierListsByPopular indicates an expected call of ListSeasonTierListsByPopular.
+func (mr *MockTierListQuerierMockRecorder) ListSeasonTierListsByPopular(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasonTierListsByPopular", reflect.TypeOf((*MockTierListQuerier)(nil).ListSeasonTierListsByPopular), ctx, arg)
+}
+
+// ListSeasonTierListsByTrending mocks base method.
+func (m *MockTierListQuerier) ListSeasonTierListsByTrending(ctx context.Context, arg db.ListSeasonTierListsByTrendingParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasonTierListsByTrending", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeasonTierListsByTrending indicates an expected call of ListSeasonTierListsByTrending.
+func (mr *MockTierListQuerierMockRecorder) ListSeasonTierListsByTrending(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasonTierListsByTrending", reflect.TypeOf((*MockTierListQuerier)(nil).ListSeasonTierListsByTrending), ctx, arg)
+}
+
+// ListTierListsByHot mocks base method.
+func (m *MockTierListQuerier) ListTierListsByHot(ctx context.Context, arg db.ListTierListsByHotParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListsByHot", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListsByHot indicates an expected call of ListTierListsByHot.
+func (mr *MockTierListQuerierMockRecorder) ListTierListsByHot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListsByHot", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierListsByHot), ctx, arg)
+}
+
+// ListTierListsByNewest mocks base method.
+func (m *MockTierListQuerier) ListTierListsByNewest(ctx context.Context, arg db.ListTierListsByNewestParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListsByNewest", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListsByNewest indicates an expected call of ListTierListsByNewest.
+func (mr *MockTierListQuerierMockRecorder) ListTierListsByNewest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListsByNewest", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierListsByNewest), ctx, arg)
+}
+
+// ListTierListsByPopular mocks base method.
+func (m *MockTierListQuerier) ListTierListsByPopular(ctx context.Context, arg db.ListTierListsByPopularParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListsByPopular", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListsByPopular indicates an expected call of ListTierListsByPopular.
+func (mr *MockTierListQuerierMockRecorder) ListTierListsByPopular(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListsByPopular", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierListsByPopular), ctx, arg)
+}
+
+// ListTierListsByTrending mocks base method.
+func (m *MockTierListQuerier) ListTierListsByTrending(ctx context.Context, arg db.ListTierListsByTrendingParams) ([]db.TierList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListsByTrending", ctx, arg)
+	ret0, _ := ret[0].([]db.TierList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListsByTrending indicates an expected call of ListTierListsByTrending.
+func (mr *MockTierListQuerierMockRecorder) ListTierListsByTrending(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListsByTrending", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierListsByTrending), ctx, arg)
+}
+
+// ListTierPlacementsByTierList mocks base method.
+func (m *MockTierListQuerier) ListTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) ([]db.TierPlacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierPlacementsByTierList", ctx, tierListID)
+	ret0, _ := ret[0].([]db.TierPlacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierPlacementsByTierList indicates an expected call of ListTierPlacementsByTierList.
+func (mr *MockTierListQuerierMockRecorder) ListTierPlacementsByTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierPlacementsByTierList", reflect.TypeOf((*MockTierListQuerier)(nil).ListTierPlacementsByTierList), ctx, tierListID)
+}
+
+// SubtractTierListFromStatistics mocks base method.
+func (m *MockTierListQuerier) SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubtractTierListFromStatistics", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubtractTierListFromStatistics indicates an expected call of SubtractTierListFromStatistics.
+func (mr *MockTierListQuerierMockRecorder) SubtractTierListFromStatistics(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubtractTierListFromStatistics", reflect.TypeOf((*MockTierListQuerier)(nil).SubtractTierListFromStatistics), ctx, tierListID)
+}
+
+// TouchTierList mocks base method.
+func (m *MockTierListQuerier) TouchTierList(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchTierList", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchTierList indicates an expected call of TouchTierList.
+func (mr *MockTierListQuerierMockRecorder) TouchTierList(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchTierList", reflect.TypeOf((*MockTierListQuerier)(nil).TouchTierList), ctx, tierListID)
+}
@@ -0,0 +1,616 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+var (
+	seasonID    = id.NewSeasonID()
+	tierListID1 = id.NewTierListID()
+	tierListID2 = id.NewTierListID()
+	tierListID3 = id.NewTierListID()
+	createdAt1  = time.Date(2025, 8, 3, 12, 0, 0, 0, time.UTC)
+	createdAt2  = time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	createdAt3  = time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	userID      = id.NewUserID()
+)
+
+func newDBTierList(tierListID id.TierListID, viewCount int32, createdAt time.Time) db.TierList {
+	return db.TierList{
+		TierListID:  pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+		SeasonID:    pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+		Title:       "A4環境ティアリスト",
+		Description: "",
+		AuthorName:  "配信者A",
+		ViewCount:   viewCount,
+		CreatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+		UpdatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+}
+
+func TestTierListRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	deckID1, deckID2 := id.NewDeckID(), id.NewDeckID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName       string
+		setupMock      func(mockQuerier *MockTierListQuerier)
+		wantDeckIDs    []id.DeckID
+		wantForkedFrom *id.TierListID
+		wantAuthorUser *id.UserID
+		wantNotFound   bool
+		expectError    bool
+	}{
+		{
+			caseName: "正常系: ティアリストが配置を含めて取得できる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				row := newDBTierList(tierListID1, 100, createdAt1)
+				row.ForkedFromTierListID = pgtype.UUID{Bytes: tierListID2.UUID(), Valid: true}
+				row.ForkCount = 2
+				row.AuthorUserID = pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(row, nil)
+				mockQuerier.EXPECT().ListTierPlacementsByTierList(gomock.Any(), pgTierListID).Return([]db.TierPlacement{
+					{
+						TierPlacementID: pgtype.UUID{Bytes: id.NewTierPlacementID().UUID(), Valid: true},
+						TierListID:      pgTierListID,
+						DeckID:          pgtype.UUID{Bytes: deckID1.UUID(), Valid: true},
+						TierRank:        7,
+						Position:        0,
+					},
+					{
+						TierPlacementID: pgtype.UUID{Bytes: id.NewTierPlacementID().UUID(), Valid: true},
+						TierListID:      pgTierListID,
+						DeckID:          pgtype.UUID{Bytes: deckID2.UUID(), Valid: true},
+						TierRank:        5,
+						Position:        0,
+					},
+				}, nil)
+			},
+			wantDeckIDs:    []id.DeckID{deckID1, deckID2},
+			wantForkedFrom: &tierListID2,
+			wantAuthorUser: &userID,
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(db.TierList{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: 配置の取得でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierList(gomock.Any(), pgTierListID).Return(newDBTierList(tierListID1, 100, createdAt1), nil)
+				mockQuerier.EXPECT().ListTierPlacementsByTierList(gomock.Any(), pgTierListID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), tierListID1)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID1, got.ID(), "tier list ID does not match")
+			assert.Equal(t, tt.wantForkedFrom, got.ForkedFrom(), "forked from does not match")
+			assert.Equal(t, tt.wantAuthorUser, got.AuthorUserID(), "author user ID does not match")
+			assert.Equal(t, 2, got.ForkCount(), "fork count does not match")
+			gotDeckIDs := make([]id.DeckID, 0, len(got.Placements()))
+			for _, placement := range got.Placements() {
+				gotDeckIDs = append(gotDeckIDs, placement.DeckID())
+			}
+			assert.Equal(t, tt.wantDeckIDs, gotDeckIDs, "placed deck IDs do not match")
+		})
+	}
+}
+
+func TestTierListRepository_FindByIDForUpdate(t *testing.T) {
+	t.Parallel()
+
+	pgTierListID := pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 行ロックを取得してティアリストが取得できる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierListForUpdate(gomock.Any(), pgTierListID).Return(newDBTierList(tierListID1, 100, createdAt1), nil)
+				mockQuerier.EXPECT().ListTierPlacementsByTierList(gomock.Any(), pgTierListID).Return([]db.TierPlacement{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().GetTierListForUpdate(gomock.Any(), pgTierListID).Return(db.TierList{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByIDForUpdate(context.Background(), tierListID1)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tierListID1, got.ID(), "tier list ID does not match")
+		})
+	}
+}
+
+func TestTierListRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+
+	tests := []struct {
+		caseName    string
+		withDeck    bool
+		setupMock   func(mockQuerier *MockTierListQuerier, tierList *entity.TierList)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ティアリストと配置が保存され、ティア統計に加算される事",
+			withDeck: true,
+			setupMock: func(mockQuerier *MockTierListQuerier, tierList *entity.TierList) {
+				pgID := pgtype.UUID{Bytes: tierList.ID().UUID(), Valid: true}
+				mockQuerier.EXPECT().CreateTierList(gomock.Any(), db.CreateTierListParams{
+					TierListID:           pgID,
+					SeasonID:             pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					Title:                "A4環境ティアリスト",
+					Description:          "",
+					AuthorName:           entity.DefaultAuthorName,
+					ForkedFromTierListID: pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true},
+					AuthorIp:             "192.0.2.1",
+					AuthorUserID:         pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+				}).Return(db.TierList{}, nil)
+				mockQuerier.EXPECT().BulkCreateTierPlacements(gomock.Any(), []db.BulkCreateTierPlacementsParams{
+					{
+						TierPlacementID: pgtype.UUID{Bytes: tierList.Placements()[0].ID().UUID(), Valid: true},
+						TierListID:      pgID,
+						DeckID:          pgtype.UUID{Bytes: deckID.UUID(), Valid: true},
+						TierRank:        6,
+						Position:        0,
+					},
+				}).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), pgID).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 配置がない場合は配置の保存を行わない事",
+			withDeck: false,
+			setupMock: func(mockQuerier *MockTierListQuerier, tierList *entity.TierList) {
+				mockQuerier.EXPECT().CreateTierList(gomock.Any(), gomock.Any()).Return(db.TierList{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 配置の保存でDBエラーが発生した場合",
+			withDeck: true,
+			setupMock: func(mockQuerier *MockTierListQuerier, tierList *entity.TierList) {
+				mockQuerier.EXPECT().CreateTierList(gomock.Any(), gomock.Any()).Return(db.TierList{}, nil)
+				mockQuerier.EXPECT().BulkCreateTierPlacements(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: ティア統計の加算でDBエラーが発生した場合",
+			withDeck: true,
+			setupMock: func(mockQuerier *MockTierListQuerier, tierList *entity.TierList) {
+				mockQuerier.EXPECT().CreateTierList(gomock.Any(), gomock.Any()).Return(db.TierList{}, nil)
+				mockQuerier.EXPECT().BulkCreateTierPlacements(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			source, err := entity.NewTierList(tierListID1, seasonID, "A4環境ティアリスト", "", "")
+			assert.NoError(t, err, "failed to create source tier list")
+			if tt.withDeck {
+				assert.NoError(t, source.PlaceDeck(id.NewTierPlacementID(), deckID, rank.TierS, 0), "failed to place deck")
+			}
+			tierList, _, err := source.Fork(id.NewTierListID(), seasonID, "", "", map[id.DeckID]id.DeckID{deckID: deckID})
+			assert.NoError(t, err, "failed to fork tier list")
+			tierList.RecordAuthorIP("192.0.2.1")
+			tierList.AttributeToUser(userID)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier, tierList)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			err = repo.Create(context.Background(), tierList)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListRepository_UpdatePlacements(t *testing.T) {
+	t.Parallel()
+
+	deckID := id.NewDeckID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 既存の配置を統計から減算して削除し、保存し直した配置を統計に加算して更新日時を進める事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().DeleteTierPlacementsByTierList(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().BulkCreateTierPlacements(gomock.Any(), gomock.Len(1)).Return(int64(1), nil),
+					mockQuerier.EXPECT().AddTierListToStatistics(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().TouchTierList(gomock.Any(), pgTierListID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: ティア統計の減算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgTierListID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 配置の削除でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().SubtractTierListFromStatistics(gomock.Any(), pgTierListID).Return(nil)
+				mockQuerier.EXPECT().DeleteTierPlacementsByTierList(gomock.Any(), pgTierListID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tierList, err := entity.NewTierList(tierListID1, seasonID, "A4環境ティアリスト", "", "")
+			assert.NoError(t, err, "failed to create tier list")
+			assert.NoError(t, tierList.PlaceDeck(id.NewTierPlacementID(), deckID, rank.TierS, 0), "failed to place deck")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			err = repo.UpdatePlacements(context.Background(), tierList)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListRepository_FindPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListQuerier)
+		query       entity.TierListQuery
+		wantIDs     []id.TierListID
+		wantNext    *entity.TierListCursor
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 人気順で次ページがある場合、末尾の閲覧数とIDがカーソルになる事（シーズン指定版のクエリを使う）",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListSeasonTierListsByPopularParams{
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 3,
+				}
+				mockQuerier.EXPECT().ListSeasonTierListsByPopular(gomock.Any(), expectedParams).Return([]db.TierList{
+					newDBTierList(tierListID1, 300, createdAt1),
+					newDBTierList(tierListID2, 200, createdAt2),
+					newDBTierList(tierListID3, 100, createdAt3),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				SeasonID: &seasonID,
+				Sort:     entity.TierListSortPopular,
+				Limit:    2,
+			},
+			wantIDs:  []id.TierListID{tierListID1, tierListID2},
+			wantNext: &entity.TierListCursor{SortKey: 200, TierListID: tierListID2},
+		},
+		{
+			caseName: "正常系: 人気順でカーソルと作成者名が指定された場合、条件がパラメータに渡る事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByPopularParams{
+					AuthorName:       pgtype.Text{String: "配信者A", Valid: true},
+					CursorViewCount:  pgtype.Int4{Int32: 200, Valid: true},
+					CursorTierListID: pgtype.UUID{Bytes: tierListID2.UUID(), Valid: true},
+					PageLimit:        3,
+				}
+				mockQuerier.EXPECT().ListTierListsByPopular(gomock.Any(), expectedParams).Return([]db.TierList{
+					newDBTierList(tierListID3, 100, createdAt3),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				AuthorName: "配信者A",
+				Sort:       entity.TierListSortPopular,
+				After:      &entity.TierListCursor{SortKey: 200, TierListID: tierListID2},
+				Limit:      2,
+			},
+			wantIDs:  []id.TierListID{tierListID3},
+			wantNext: nil,
+		},
+		{
+			caseName: "正常系: フォーク元が指定された場合、条件がパラメータに渡る事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByNewestParams{
+					ForkedFromTierListID: pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true},
+					PageLimit:            21,
+				}
+				mockQuerier.EXPECT().ListTierListsByNewest(gomock.Any(), expectedParams).Return([]db.TierList{
+					newDBTierList(tierListID2, 0, createdAt2),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				ForkedFrom: &tierListID1,
+				Sort:       entity.TierListSortNewest,
+				Limit:      20,
+			},
+			wantIDs:  []id.TierListID{tierListID2},
+			wantNext: nil,
+		},
+		{
+			caseName: "正常系: 新着順の場合、作成日時がカーソルのキーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByNewestParams{
+					PageLimit: 2,
+				}
+				mockQuerier.EXPECT().ListTierListsByNewest(gomock.Any(), expectedParams).Return([]db.TierList{
+					newDBTierList(tierListID1, 0, createdAt1),
+					newDBTierList(tierListID2, 0, createdAt2),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSortNewest,
+				Limit: 1,
+			},
+			wantIDs:  []id.TierListID{tierListID1},
+			wantNext: &entity.TierListCursor{SortKey: createdAt1.UnixMicro(), TierListID: tierListID1},
+		},
+		{
+			caseName: "正常系: 新着順でカーソルが指定された場合、作成日時に復元されて渡る事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByNewestParams{
+					CursorCreatedAt:  pgtype.Timestamptz{Time: time.UnixMicro(createdAt1.UnixMicro()), Valid: true},
+					CursorTierListID: pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true},
+					PageLimit:        2,
+				}
+				mockQuerier.EXPECT().ListTierListsByNewest(gomock.Any(), expectedParams).Return([]db.TierList{}, nil)
+			},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSortNewest,
+				After: &entity.TierListCursor{SortKey: createdAt1.UnixMicro(), TierListID: tierListID1},
+				Limit: 1,
+			},
+			wantIDs:  []id.TierListID{},
+			wantNext: nil,
+		},
+		{
+			caseName: "正常系: トレンド順の場合、トレンドスコアがカーソルのキーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByTrendingParams{
+					PageLimit: 2,
+				}
+				mockQuerier.EXPECT().ListTierListsByTrending(gomock.Any(), expectedParams).Return([]db.TierList{
+					withTrendingScore(newDBTierList(tierListID1, 10, createdAt1), 40),
+					withTrendingScore(newDBTierList(tierListID2, 500, createdAt2), 5),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSortTrending,
+				Limit: 1,
+			},
+			wantIDs:  []id.TierListID{tierListID1},
+			wantNext: &entity.TierListCursor{SortKey: 40, TierListID: tierListID1},
+		},
+		{
+			caseName: "正常系: シーズンが指定されたトレンド順の場合、シーズン指定版のクエリで取得する事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListSeasonTierListsByTrendingParams{
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 2,
+				}
+				mockQuerier.EXPECT().ListSeasonTierListsByTrending(gomock.Any(), expectedParams).Return([]db.TierList{
+					withTrendingScore(newDBTierList(tierListID1, 10, createdAt1), 40),
+					withTrendingScore(newDBTierList(tierListID2, 500, createdAt2), 5),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				SeasonID: &seasonID,
+				Sort:     entity.TierListSortTrending,
+				Limit:    1,
+			},
+			wantIDs:  []id.TierListID{tierListID1},
+			wantNext: &entity.TierListCursor{SortKey: 40, TierListID: tierListID1},
+		},
+		{
+			caseName: "正常系: シーズンが指定されたホット順の場合、シーズン指定版のクエリで取得する事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListSeasonTierListsByHotParams{
+					SeasonID:  pgtype.UUID{Bytes: seasonID.UUID(), Valid: true},
+					PageLimit: 21,
+				}
+				mockQuerier.EXPECT().ListSeasonTierListsByHot(gomock.Any(), expectedParams).Return([]db.TierList{
+					withHotScore(newDBTierList(tierListID1, 10, createdAt1), 800000000),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				SeasonID: &seasonID,
+				Sort:     entity.TierListSortHot,
+				Limit:    20,
+			},
+			wantIDs:  []id.TierListID{tierListID1},
+			wantNext: nil,
+		},
+		{
+			caseName: "正常系: ホット順の場合、ホットスコアがカーソルのキーになる事",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				expectedParams := db.ListTierListsByHotParams{
+					CursorHotScore:   pgtype.Int8{Int64: 900000000, Valid: true},
+					CursorTierListID: pgtype.UUID{Bytes: tierListID3.UUID(), Valid: true},
+					PageLimit:        2,
+				}
+				mockQuerier.EXPECT().ListTierListsByHot(gomock.Any(), expectedParams).Return([]db.TierList{
+					withHotScore(newDBTierList(tierListID1, 10, createdAt1), 800000000),
+					withHotScore(newDBTierList(tierListID2, 500, createdAt2), 700000000),
+				}, nil)
+			},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSortHot,
+				After: &entity.TierListCursor{SortKey: 900000000, TierListID: tierListID3},
+				Limit: 1,
+			},
+			wantIDs:  []id.TierListID{tierListID1},
+			wantNext: &entity.TierListCursor{SortKey: 800000000, TierListID: tierListID1},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {
+				mockQuerier.EXPECT().ListTierListsByPopular(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSortPopular,
+				Limit: 20,
+			},
+			expectError: true,
+		},
+		{
+			caseName:  "異常系: 未定義の並び順が指定された場合",
+			setupMock: func(mockQuerier *MockTierListQuerier) {},
+			query: entity.TierListQuery{
+				Sort:  entity.TierListSort("oldest"),
+				Limit: 20,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindPage(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			gotIDs := make([]id.TierListID, 0, len(got.TierLists))
+			for _, tierList := range got.TierLists {
+				gotIDs = append(gotIDs, tierList.ID())
+			}
+			assert.Equal(t, tt.wantIDs, gotIDs, "tier list IDs do not match")
+			assert.Equal(t, tt.wantNext, got.Next, "next cursor does not match")
+		})
+	}
+}
+
+func withTrendingScore(row db.TierList, trendingScore int32) db.TierList {
+	row.TrendingScore = trendingScore
+	return row
+}
+
+func withHotScore(row db.TierList, hotScore int64) db.TierList {
+	row.HotScore = hotScore
+	return row
+}
+
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+// uniqueViolation は一意制約違反のエラーコード（同じリビジョン番号が同時に作成された場合）
+const uniqueViolation = "23505"
+
+// TierListRevisionQuerier はデータベースクエリを定義するインターフェース
+type TierListRevisionQuerier interface {
+	CreateTierListRevision(ctx context.Context, arg db.CreateTierListRevisionParams) (db.TierListRevision, error)
+	GetTierListRevision(ctx context.Context, arg db.GetTierListRevisionParams) (db.TierListRevision, error)
+	ListTierListRevisions(ctx context.Context, tierListID pgtype.UUID) ([]db.TierListRevision, error)
+	GetLatestTierListRevisionNumber(ctx context.Context, tierListID pgtype.UUID) (int32, error)
+}
+
+// placementJSON は配置のスナップショットのJSON表現
+type placementJSON struct {
+	DeckID   string `json:"deck_id"`
+	TierRank int    `json:"tier_rank"`
+	Position int    `json:"position"`
+}
+
+// changeJSON は配置の変更のJSON表現
+type changeJSON struct {
+	Type   string         `json:"type"`
+	DeckID string         `json:"deck_id"`
+	From   *placementJSON `json:"from,omitempty"`
+	To     *placementJSON `json:"to,omitempty"`
+}
+
+// TierListRevisionRepository はTierListRevisionRepositoryの実装
+type TierListRevisionRepository struct {
+	queries TierListRevisionQuerier
+}
+
+// NewTierListRevisionRepository は新しいTierListRevisionRepositoryを作成
+func NewTierListRevisionRepository(queries TierListRevisionQuerier) *TierListRevisionRepository {
+	return &TierListRevisionRepository{
+		queries: queries,
+	}
+}
+
+// LatestNumber は最新のリビジョン番号を返す。リビジョンが存在しない場合は0
+func (r *TierListRevisionRepository) LatestNumber(ctx context.Context, tierListID id.TierListID) (int, error) {
+	number, err := r.queries.GetLatestTierListRevisionNumber(ctx, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest revision number: %w", err)
+	}
+	return int(number), nil
+}
+
+// Create はリビジョンを保存
+func (r *TierListRevisionRepository) Create(ctx context.Context, revision *entity.TierListRevision) error {
+	placements, err := json.Marshal(toPlacementJSONs(revision.Placements()))
+	if err != nil {
+		return fmt.Errorf("failed to marshal placements: %w", err)
+	}
+
+	changes := make([]changeJSON, 0, len(revision.Changes()))
+	for _, change := range revision.Changes() {
+		changes = append(changes, changeJSON{
+			Type:   string(change.Type),
+			DeckID: change.DeckID.String(),
+			From:   toPlacementJSONPtr(change.From),
+			To:     toPlacementJSONPtr(change.To),
+		})
+	}
+	operations, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal operations: %w", err)
+	}
+
+	params := db.CreateTierListRevisionParams{
+		TierListID:     pgtype.UUID{Bytes: revision.TierListID().UUID(), Valid: true},
+		RevisionNumber: int32(revision.Number()), // #nosec G115 -- リビジョン番号はint4の範囲内
+		Placements:     placements,
+		Operations:     operations,
+	}
+	if restoredFrom := revision.RestoredFrom(); restoredFrom != nil {
+		params.RestoredFromRevision = pgtype.Int4{Int32: int32(*restoredFrom), Valid: true} // #nosec G115 -- リビジョン番号はint4の範囲内
+	}
+
+	if _, err := r.queries.CreateTierListRevision(ctx, params); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return errs.NewConflictError("revision already exists", err)
+		}
+		return fmt.Errorf("failed to create tier list revision: %w", err)
+	}
+
+	return nil
+}
+
+// FindByNumber は指定した番号のリビジョンを取得
+func (r *TierListRevisionRepository) FindByNumber(ctx context.Context, tierListID id.TierListID, number int) (*entity.TierListRevision, error) {
+	row, err := r.queries.GetTierListRevision(ctx, db.GetTierListRevisionParams{
+		TierListID:     pgtype.UUID{Bytes: tierListID.UUID(), Valid: true},
+		RevisionNumber: int32(number), // #nosec G115 -- リビジョン番号はint4の範囲内
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("tier list revision not found", err)
+		}
+		return nil, fmt.Errorf("failed to get tier list revision: %w", err)
+	}
+
+	return r.toEntity(row)
+}
+
+// FindByTierList はティアリストの全リビジョンを新しい順に取得
+func (r *TierListRevisionRepository) FindByTierList(ctx context.Context, tierListID id.TierListID) ([]*entity.TierListRevision, error) {
+	rows, err := r.queries.ListTierListRevisions(ctx, pgtype.UUID{Bytes: tierListID.UUID(), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier list revisions: %w", err)
+	}
+
+	revisions := make([]*entity.TierListRevision, 0, len(rows))
+	for _, row := range rows {
+		revision, err := r.toEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *TierListRevisionRepository) toEntity(row db.TierListRevision) (*entity.TierListRevision, error) {
+	var placementJSONs []placementJSON
+	if err := json.Unmarshal(row.Placements, &placementJSONs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal placements: %w", err)
+	}
+	placements := make([]entity.PlacementSnapshot, 0, len(placementJSONs))
+	for _, p := range placementJSONs {
+		snapshot, err := toPlacementSnapshot(p)
+		if err != nil {
+			return nil, err
+		}
+		placements = append(placements, snapshot)
+	}
+
+	var changeJSONs []changeJSON
+	if err := json.Unmarshal(row.Operations, &changeJSONs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal operations: %w", err)
+	}
+	changes := make([]entity.PlacementChange, 0, len(changeJSONs))
+	for _, c := range changeJSONs {
+		deckID, err := id.DeckIDFromString(c.DeckID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse deck ID: %w", err)
+		}
+		change := entity.PlacementChange{
+			Type:   entity.PlacementChangeType(c.Type),
+			DeckID: deckID,
+		}
+		if change.From, err = toPlacementSnapshotPtr(c.From); err != nil {
+			return nil, err
+		}
+		if change.To, err = toPlacementSnapshotPtr(c.To); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	var restoredFrom *int
+	if row.RestoredFromRevision.Valid {
+		number := int(row.RestoredFromRevision.Int32)
+		restoredFrom = &number
+	}
+
+	revision, err := entity.ReconstructTierListRevision(
+		id.TierListIDFromUUID(row.TierListID.Bytes),
+		int(row.RevisionNumber),
+		placements,
+		changes,
+		restoredFrom,
+		row.CreatedAt.Time,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tier list revision entity: %w", err)
+	}
+
+	return revision, nil
+}
+
+// toPlacementJSONs はスナップショットをJSON表現に変換
+func toPlacementJSONs(snapshots []entity.PlacementSnapshot) []placementJSON {
+	placements := make([]placementJSON, 0, len(snapshots))
+	for _, s := range snapshots {
+		placements = append(placements, *toPlacementJSONPtr(&s))
+	}
+	return placements
+}
+
+// toPlacementJSONPtr は任意のスナップショットをJSON表現に変換
+func toPlacementJSONPtr(snapshot *entity.PlacementSnapshot) *placementJSON {
+	if snapshot == nil {
+		return nil
+	}
+	return &placementJSON{
+		DeckID:   snapshot.DeckID.String(),
+		TierRank: snapshot.TierRank.Int(),
+		Position: snapshot.Position,
+	}
+}
+
+// toPlacementSnapshot はJSON表現からスナップショットに変換
+func toPlacementSnapshot(p placementJSON) (entity.PlacementSnapshot, error) {
+	deckID, err := id.DeckIDFromString(p.DeckID)
+	if err != nil {
+		return entity.PlacementSnapshot{}, fmt.Errorf("failed to parse deck ID: %w", err)
+	}
+	tierRank, err := rank.NewTierRank(p.TierRank)
+	if err != nil {
+		return entity.PlacementSnapshot{}, fmt.Errorf("failed to parse tier rank: %w", err)
+	}
+	return entity.PlacementSnapshot{DeckID: deckID, TierRank: tierRank, Position: p.Position}, nil
+}
+
+// toPlacementSnapshotPtr は任意のJSON表現からスナップショットに変換
+func toPlacementSnapshotPtr(p *placementJSON) (*entity.PlacementSnapshot, error) {
+	if p == nil {
+		return nil, nil
+	}
+	snapshot, err := toPlacementSnapshot(*p)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/repository/tier_list_revision_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/repository/tier_list_revision_repository.go -destination=./apps/tierlist/internal/infrastructure/repository/tier_list_revision_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListRevisionQuerier is a mock of TierListRevisionQuerier interface.
+type MockTierListRevisionQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListRevisionQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListRevisionQuerierMockRecorder is the mock recorder for MockTierListRevisionQuerier.
+type MockTierListRevisionQuerierMockRecorder struct {
+	mock *MockTierListRevisionQuerier
+}
+
+// NewMockTierListRevisionQuerier creates a new mock instance.
+func NewMockTierListRevisionQuerier(ctrl *gomock.Controller) *MockTierListRevisionQuerier {
+	mock := &MockTierListRevisionQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListRevisionQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListRevisionQuerier) EXPECT() *MockTierListRevisionQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateTierListRevision mocks base method.
+func (m *MockTierListRevisionQuerier) CreateTierListRevision(ctx context.Context, arg db.CreateTierListRevisionParams) (db.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTierListRevision", ctx, arg)
+	ret0, _ := ret[0].(db.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTierListRevision indicates an expected call of CreateTierListRevision.
+func (mr *MockTierListRevisionQuerierMockRecorder) CreateTierListRevision(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTierListRevision", reflect.TypeOf((*MockTierListRevisionQuerier)(nil).CreateTierListRevision), ctx, arg)
+}
+
+// GetLatestTierListRevisionNumber mocks base method.
+func (m *MockTierListRevisionQuerier) GetLatestTierListRevisionNumber(ctx context.Context, tierListID pgtype.UUID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestTierListRevisionNumber", ctx, tierListID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestTierListRevisionNumber indicates an expected call of GetLatestTierListRevisionNumber.
+func (mr *MockTierListRevisionQuerierMockRecorder) GetLatestTierListRevisionNumber(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTierListRevisionNumber", reflect.TypeOf((*MockTierListRevisionQuerier)(nil).GetLatestTierListRevisionNumber), ctx, tierListID)
+}
+
+// GetTierListRevision mocks base method.
+func (m *MockTierListRevisionQuerier) GetTierListRevision(ctx context.Context, arg db.GetTierListRevisionParams) (db.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierListRevision", ctx, arg)
+	ret0, _ := ret[0].(db.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierListRevision indicates an expected call of GetTierListRevision.
+func (mr *MockTierListRevisionQuerierMockRecorder) GetTierListRevision(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierListRevision", reflect.TypeOf((*MockTierListRevisionQuerier)(nil).GetTierListRevision), ctx, arg)
+}
+
+// ListTierListRevisions mocks base method.
+func (m *MockTierListRevisionQuerier) ListTierListRevisions(ctx context.Context, tierListID pgtype.UUID) ([]db.TierListRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTierListRevisions", ctx, tierListID)
+	ret0, _ := ret[0].([]db.TierListRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTierListRevisions indicates an expected call of ListTierListRevisions.
+func (mr *MockTierListRevisionQuerierMockRecorder) ListTierListRevisions(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTierListRevisions", reflect.TypeOf((*MockTierListRevisionQuerier)(nil).ListTierListRevisions), ctx, tierListID)
+}
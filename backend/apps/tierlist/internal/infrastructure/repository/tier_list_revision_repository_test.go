@@ -0,0 +1,185 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/domain/entity"
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/rank"
+	"poketier/sqlc/db"
+)
+
+func TestTierListRevisionRepository_CreateAndFindByNumber(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deckA, deckB := id.NewDeckID(), id.NewDeckID()
+	restoredFrom := 1
+	revision, err := entity.NewTierListRevision(
+		tierListID1,
+		2,
+		[]entity.PlacementSnapshot{{DeckID: deckA, TierRank: rank.TierS, Position: 0}},
+		[]entity.PlacementChange{
+			{
+				Type:   entity.PlacementChangeMoved,
+				DeckID: deckA,
+				From:   &entity.PlacementSnapshot{DeckID: deckA, TierRank: rank.TierA, Position: 0},
+				To:     &entity.PlacementSnapshot{DeckID: deckA, TierRank: rank.TierS, Position: 0},
+			},
+			{
+				Type:   entity.PlacementChangeRemoved,
+				DeckID: deckB,
+				From:   &entity.PlacementSnapshot{DeckID: deckB, TierRank: rank.TierB, Position: 0},
+			},
+		},
+		&restoredFrom,
+	)
+	assert.NoError(t, err, "failed to create revision")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockQuerier := NewMockTierListRevisionQuerier(ctrl)
+
+	// 保存されたJSONをそのまま読み出しに使う
+	var saved db.CreateTierListRevisionParams
+	mockQuerier.EXPECT().CreateTierListRevision(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg db.CreateTierListRevisionParams) (db.TierListRevision, error) {
+			saved = arg
+			return db.TierListRevision{}, nil
+		},
+	)
+	mockQuerier.EXPECT().GetTierListRevision(gomock.Any(), db.GetTierListRevisionParams{
+		TierListID:     pgtype.UUID{Bytes: tierListID1.UUID(), Valid: true},
+		RevisionNumber: 2,
+	}).DoAndReturn(func(_ context.Context, _ db.GetTierListRevisionParams) (db.TierListRevision, error) {
+		return db.TierListRevision{
+			TierListID:           saved.TierListID,
+			RevisionNumber:       saved.RevisionNumber,
+			Placements:           saved.Placements,
+			Operations:           saved.Operations,
+			RestoredFromRevision: saved.RestoredFromRevision,
+			CreatedAt:            pgtype.Timestamptz{Time: createdAt1, Valid: true},
+		}, nil
+	})
+	repo := repository.NewTierListRevisionRepository(mockQuerier)
+
+	// Act
+	err = repo.Create(context.Background(), revision)
+	assert.NoError(t, err, "unexpected error occurred on create")
+	got, err := repo.FindByNumber(context.Background(), tierListID1, 2)
+
+	// Assert
+	assert.NoError(t, err, "unexpected error occurred on find")
+	assert.Equal(t, pgtype.Int4{Int32: 1, Valid: true}, saved.RestoredFromRevision, "restored from should be saved")
+	assert.Equal(t, revision.Placements(), got.Placements(), "placements should round trip")
+	assert.Equal(t, revision.Changes(), got.Changes(), "changes should round trip")
+	assert.Equal(t, &restoredFrom, got.RestoredFrom(), "restored from should round trip")
+	assert.Equal(t, createdAt1, got.CreatedAt(), "created at does not match")
+}
+
+func TestTierListRevisionRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	revision, err := entity.NewTierListRevision(
+		tierListID1,
+		2,
+		[]entity.PlacementSnapshot{{DeckID: id.NewDeckID(), TierRank: rank.TierS, Position: 0}},
+		[]entity.PlacementChange{},
+		nil,
+	)
+	assert.NoError(t, err, "failed to create revision")
+
+	tests := []struct {
+		caseName     string
+		createErr    error
+		wantConflict bool
+	}{
+		{
+			caseName:     "異常系: 同じリビジョン番号が既に作成されている場合、Conflictエラーを返す",
+			createErr:    &pgconn.PgError{Code: "23505"},
+			wantConflict: true,
+		},
+		{
+			caseName:     "異常系: DBエラーが発生した場合",
+			createErr:    errors.New("db error"),
+			wantConflict: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListRevisionQuerier(ctrl)
+			mockQuerier.EXPECT().CreateTierListRevision(gomock.Any(), gomock.Any()).Return(db.TierListRevision{}, tt.createErr)
+			repo := repository.NewTierListRevisionRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), revision)
+
+			// Assert
+			assert.Error(t, err, "expected error but got none")
+			var domainErr *errs.DomainError
+			assert.Equal(t, tt.wantConflict, errors.As(err, &domainErr) && domainErr.Type == errs.ErrConflict, "conflict error does not match")
+		})
+	}
+}
+
+func TestTierListRevisionRepository_FindByNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListRevisionQuerier)
+		wantNotFound bool
+	}{
+		{
+			caseName: "異常系: リビジョンが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockTierListRevisionQuerier) {
+				mockQuerier.EXPECT().GetTierListRevision(gomock.Any(), gomock.Any()).Return(db.TierListRevision{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListRevisionQuerier) {
+				mockQuerier.EXPECT().GetTierListRevision(gomock.Any(), gomock.Any()).Return(db.TierListRevision{}, errors.New("db error"))
+			},
+			wantNotFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListRevisionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListRevisionRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByNumber(context.Background(), tierListID1, 3)
+
+			// Assert
+			assert.Error(t, err, "expected error but got none")
+			assert.Nil(t, got, "revision should be nil on error")
+			assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// foreignKeyViolation は外部キー制約違反のエラーコード（閲覧の対象が存在しない場合）
+const foreignKeyViolation = "23503"
+
+// TierListViewQuerier はデータベースクエリを定義するインターフェース
+type TierListViewQuerier interface {
+	CreateTierListViewer(ctx context.Context, arg db.CreateTierListViewerParams) (int64, error)
+	IncrementTierListViewCount(ctx context.Context, tierListID pgtype.UUID) (int64, error)
+	IncrementTierListDailyViewCount(ctx context.Context, tierListID pgtype.UUID) error
+	RefreshTierListHotScore(ctx context.Context, tierListID pgtype.UUID) error
+	RefreshTierListTrendingScores(ctx context.Context) (int64, error)
+	DeleteExpiredTierListViewers(ctx context.Context) (int64, error)
+}
+
+// TierListViewRepository はティアリストの閲覧数とトレンドスコアの永続化を行う
+type TierListViewRepository struct {
+	queries TierListViewQuerier
+}
+
+// NewTierListViewRepository は新しいTierListViewRepositoryを作成
+func NewTierListViewRepository(queries TierListViewQuerier) *TierListViewRepository {
+	return &TierListViewRepository{
+		queries: queries,
+	}
+}
+
+// Record は閲覧者のティアリストの閲覧を記録し、その日に初めて閲覧した場合のみ閲覧数とトレンドスコアを1増やしてホットスコアを再計算する
+// 閲覧者・日・閲覧数を一貫させるためトランザクション内で呼び出す
+// ティアリストが存在しないか非表示の場合はNotFoundエラーを返す
+func (r *TierListViewRepository) Record(ctx context.Context, tierListID id.TierListID, viewerKey string) error {
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+
+	rows, err := r.queries.CreateTierListViewer(ctx, db.CreateTierListViewerParams{
+		TierListID: pgTierListID,
+		ViewerKey:  viewerKey,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.NewNotFoundError("tier list not found", err)
+		}
+		return fmt.Errorf("failed to create tier list viewer: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	updated, err := r.queries.IncrementTierListViewCount(ctx, pgTierListID)
+	if err != nil {
+		return fmt.Errorf("failed to increment tier list view count: %w", err)
+	}
+	if updated == 0 {
+		return errs.NewNotFoundError("tier list not found", nil)
+	}
+
+	if err := r.queries.IncrementTierListDailyViewCount(ctx, pgTierListID); err != nil {
+		return fmt.Errorf("failed to increment tier list daily view count: %w", err)
+	}
+
+	// 閲覧数の加算で行ロックを取得済みのため、別の文で再計算すれば同時に追加されたいいねも反映される
+	if err := r.queries.RefreshTierListHotScore(ctx, pgTierListID); err != nil {
+		return fmt.Errorf("failed to refresh tier list hot score: %w", err)
+	}
+	return nil
+}
+
+// RefreshTrendingScores はトレンドスコアを直近7日間の日別閲覧数から再計算し、更新したティアリストの件数を返す
+func (r *TierListViewRepository) RefreshTrendingScores(ctx context.Context) (int64, error) {
+	updated, err := r.queries.RefreshTierListTrendingScores(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh tier list trending scores: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteExpiredViewers は重複の判定に使わなくなった前日以前の閲覧者の記録を削除し、削除した件数を返す
+func (r *TierListViewRepository) DeleteExpiredViewers(ctx context.Context) (int64, error) {
+	deleted, err := r.queries.DeleteExpiredTierListViewers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired tier list viewers: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/infrastructure/repository/tier_list_view_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/infrastructure/repository/tier_list_view_repository.go -destination=./apps/tierlist/internal/infrastructure/repository/tier_list_view_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTierListViewQuerier is a mock of TierListViewQuerier interface.
+type MockTierListViewQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierListViewQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockTierListViewQuerierMockRecorder is the mock recorder for MockTierListViewQuerier.
+type MockTierListViewQuerierMockRecorder struct {
+	mock *MockTierListViewQuerier
+}
+
+// NewMockTierListViewQuerier creates a new mock instance.
+func NewMockTierListViewQuerier(ctrl *gomock.Controller) *MockTierListViewQuerier {
+	mock := &MockTierListViewQuerier{ctrl: ctrl}
+	mock.recorder = &MockTierListViewQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTierListViewQuerier) EXPECT() *MockTierListViewQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateTierListViewer mocks base method.
+func (m *MockTierListViewQuerier) CreateTierListViewer(ctx context.Context, arg db.CreateTierListViewerParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTierListViewer", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTierListViewer indicates an expected call of CreateTierListViewer.
+func (mr *MockTierListViewQuerierMockRecorder) CreateTierListViewer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTierListViewer", reflect.TypeOf((*MockTierListViewQuerier)(nil).CreateTierListViewer), ctx, arg)
+}
+
+// DeleteExpiredTierListViewers mocks base method.
+func (m *MockTierListViewQuerier) DeleteExpiredTierListViewers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredTierListViewers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredTierListViewers indicates an expected call of DeleteExpiredTierListViewers.
+func (mr *MockTierListViewQuerierMockRecorder) DeleteExpiredTierListViewers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredTierListViewers", reflect.TypeOf((*MockTierListViewQuerier)(nil).DeleteExpiredTierListViewers), ctx)
+}
+
+// IncrementTierListDailyViewCount mocks base method.
+func (m *MockTierListViewQuerier) IncrementTierListDailyViewCount(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTierListDailyViewCount", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementTierListDailyViewCount indicates an expected call of IncrementTierListDailyViewCount.
+func (mr *MockTierListViewQuerierMockRecorder) IncrementTierListDailyViewCount(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTierListDailyViewCount", reflect.TypeOf((*MockTierListViewQuerier)(nil).IncrementTierListDailyViewCount), ctx, tierListID)
+}
+
+// IncrementTierListViewCount mocks base method.
+func (m *MockTierListViewQuerier) IncrementTierListViewCount(ctx context.Context, tierListID pgtype.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTierListViewCount", ctx, tierListID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementTierListViewCount indicates an expected call of IncrementTierListViewCount.
+func (mr *MockTierListViewQuerierMockRecorder) IncrementTierListViewCount(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTierListViewCount", reflect.TypeOf((*MockTierListViewQuerier)(nil).IncrementTierListViewCount), ctx, tierListID)
+}
+
+// RefreshTierListHotScore mocks base method.
+func (m *MockTierListViewQuerier) RefreshTierListHotScore(ctx context.Context, tierListID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTierListHotScore", ctx, tierListID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTierListHotScore indicates an expected call of RefreshTierListHotScore.
+func (mr *MockTierListViewQuerierMockRecorder) RefreshTierListHotScore(ctx, tierListID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTierListHotScore", reflect.TypeOf((*MockTierListViewQuerier)(nil).RefreshTierListHotScore), ctx, tierListID)
+}
+
+// RefreshTierListTrendingScores mocks base method.
+func (m *MockTierListViewQuerier) RefreshTierListTrendingScores(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTierListTrendingScores", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTierListTrendingScores indicates an expected call of RefreshTierListTrendingScores.
+func (mr *MockTierListViewQuerierMockRecorder) RefreshTierListTrendingScores(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTierListTrendingScores", reflect.TypeOf((*MockTierListViewQuerier)(nil).RefreshTierListTrendingScores), ctx)
+}
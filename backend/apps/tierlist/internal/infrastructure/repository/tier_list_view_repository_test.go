@@ -0,0 +1,164 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/tierlist/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestTierListViewRepository_Record(t *testing.T) {
+	t.Parallel()
+
+	tierListID := id.NewTierListID()
+	pgTierListID := pgtype.UUID{Bytes: tierListID.UUID(), Valid: true}
+	viewerParams := db.CreateTierListViewerParams{
+		TierListID: pgTierListID,
+		ViewerKey:  "ip:192.0.2.1",
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockTierListViewQuerier)
+		expectError  bool
+		wantNotFound bool
+	}{
+		{
+			caseName: "正常系: その日に初めて閲覧した場合、閲覧数と日別閲覧数が増え、ホットスコアが再計算される事",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(1), nil),
+					mockQuerier.EXPECT().IncrementTierListViewCount(gomock.Any(), pgTierListID).Return(int64(1), nil),
+					mockQuerier.EXPECT().IncrementTierListDailyViewCount(gomock.Any(), pgTierListID).Return(nil),
+					mockQuerier.EXPECT().RefreshTierListHotScore(gomock.Any(), pgTierListID).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "正常系: その日に閲覧済みの場合、閲覧数が増えない事",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(0), nil)
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、NotFoundエラーを返す事",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			expectError:  true,
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: ティアリストが非表示の場合、NotFoundエラーを返す事",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(1), nil)
+				mockQuerier.EXPECT().IncrementTierListViewCount(gomock.Any(), pgTierListID).Return(int64(0), nil)
+			},
+			expectError:  true,
+			wantNotFound: true,
+		},
+		{
+			caseName: "異常系: 日別閲覧数の更新でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(1), nil)
+				mockQuerier.EXPECT().IncrementTierListViewCount(gomock.Any(), pgTierListID).Return(int64(1), nil)
+				mockQuerier.EXPECT().IncrementTierListDailyViewCount(gomock.Any(), pgTierListID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: ホットスコアの再計算でDBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().CreateTierListViewer(gomock.Any(), viewerParams).Return(int64(1), nil)
+				mockQuerier.EXPECT().IncrementTierListViewCount(gomock.Any(), pgTierListID).Return(int64(1), nil)
+				mockQuerier.EXPECT().IncrementTierListDailyViewCount(gomock.Any(), pgTierListID).Return(nil)
+				mockQuerier.EXPECT().RefreshTierListHotScore(gomock.Any(), pgTierListID).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListViewQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListViewRepository(mockQuerier)
+
+			// Act
+			err := repo.Record(context.Background(), tierListID, "ip:192.0.2.1")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantNotFound, errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound, "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestTierListViewRepository_RefreshTrendingScores(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockTierListViewQuerier)
+		want        int64
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 更新したティアリストの件数を返す事",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().RefreshTierListTrendingScores(gomock.Any()).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockTierListViewQuerier) {
+				mockQuerier.EXPECT().RefreshTierListTrendingScores(gomock.Any()).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockTierListViewQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewTierListViewRepository(mockQuerier)
+
+			// Act
+			got, err := repo.RefreshTrendingScores(context.Background())
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "updated count does not match")
+		})
+	}
+}
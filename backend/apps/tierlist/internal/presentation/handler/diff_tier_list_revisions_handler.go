@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DiffTierListRevisionsHandler struct {
+	uc DiffTierListRevisionsUseCase
+}
+
+type DiffTierListRevisionsUseCase interface {
+	Execute(ctx context.Context, params usecase.DiffTierListRevisionsParams) (*usecase.DiffTierListRevisionsResult, error)
+}
+
+func NewDiffTierListRevisionsHandler(uc DiffTierListRevisionsUseCase) *DiffTierListRevisionsHandler {
+	return &DiffTierListRevisionsHandler{
+		uc: uc,
+	}
+}
+
+func (h *DiffTierListRevisionsHandler) Handle(ctx *gin.Context) {
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.DiffTierListRevisionsParams{
+		TierListID:   ctx.Param("tier_list_id"),
+		FromRevision: ctx.Param("revision_number"),
+		ToRevision:   ctx.Param("to_revision_number"),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewDiffTierListRevisionsResponse(result))
+}
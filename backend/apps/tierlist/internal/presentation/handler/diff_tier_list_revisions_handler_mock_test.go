@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/diff_tier_list_revisions_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/diff_tier_list_revisions_handler.go -destination=./apps/tierlist/internal/presentation/handler/diff_tier_list_revisions_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiffTierListRevisionsUseCase is a mock of DiffTierListRevisionsUseCase interface.
+type MockDiffTierListRevisionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiffTierListRevisionsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDiffTierListRevisionsUseCaseMockRecorder is the mock recorder for MockDiffTierListRevisionsUseCase.
+type MockDiffTierListRevisionsUseCaseMockRecorder struct {
+	mock *MockDiffTierListRevisionsUseCase
+}
+
+// NewMockDiffTierListRevisionsUseCase creates a new mock instance.
+func NewMockDiffTierListRevisionsUseCase(ctrl *gomock.Controller) *MockDiffTierListRevisionsUseCase {
+	mock := &MockDiffTierListRevisionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockDiffTierListRevisionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiffTierListRevisionsUseCase) EXPECT() *MockDiffTierListRevisionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDiffTierListRevisionsUseCase) Execute(ctx context.Context, params usecase.DiffTierListRevisionsParams) (*usecase.DiffTierListRevisionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.DiffTierListRevisionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDiffTierListRevisionsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDiffTierListRevisionsUseCase)(nil).Execute), ctx, params)
+}
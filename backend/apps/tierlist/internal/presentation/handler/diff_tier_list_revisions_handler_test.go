@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffTierListRevisionsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		caseName       string
+		mockSetup      func(*MockDiffTierListRevisionsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスパラメータがユースケースに渡り、変更前後の配置と要約が返される",
+			mockSetup: func(mockUC *MockDiffTierListRevisionsUseCase) {
+				expectedParams := usecase.DiffTierListRevisionsParams{
+					TierListID:   "tier-list-1",
+					FromRevision: "1",
+					ToRevision:   "2",
+				}
+				result := &usecase.DiffTierListRevisionsResult{
+					FromRevision: 1,
+					ToRevision:   2,
+					Changes: []usecase.DTRChange{
+						{DeckID: "deck-1", Nickname: "リザニンフ", Type: "moved", FromTierRank: "A", ToTierRank: "S", FromPosition: intPtr(0), ToPosition: intPtr(0), Summary: "リザニンフ: A → S"},
+						{DeckID: "deck-2", Nickname: "セレビィex", Type: "added", ToTierRank: "B", ToPosition: intPtr(1), Summary: "セレビィex: - → B"},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.DiffTierListRevisionsResponse{
+				FromRevision: 1,
+				ToRevision:   2,
+				Changes: []response.DTRChange{
+					{DeckID: "deck-1", Nickname: "リザニンフ", Type: "moved", From: &response.DTRPlacement{TierRank: "A", Position: 0}, To: &response.DTRPlacement{TierRank: "S", Position: 0}, Summary: "リザニンフ: A → S"},
+					{DeckID: "deck-2", Nickname: "セレビィex", Type: "added", To: &response.DTRPlacement{TierRank: "B", Position: 1}, Summary: "セレビィex: - → B"},
+				},
+			},
+		},
+		{
+			caseName: "異常系: 不正なリビジョン番号の場合、400が返される",
+			mockSetup: func(mockUC *MockDiffTierListRevisionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewValidationError("invalid revision_number", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockDiffTierListRevisionsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewDiffTierListRevisionsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/tier-lists/tier-list-1/revisions/1/diff/2", nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{
+				{Key: "tier_list_id", Value: "tier-list-1"},
+				{Key: "revision_number", Value: "1"},
+				{Key: "to_revision_number", Value: "2"},
+			}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
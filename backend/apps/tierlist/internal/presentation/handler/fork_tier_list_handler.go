@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/request"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ForkTierListHandler struct {
+	uc ForkTierListUseCase
+}
+
+type ForkTierListUseCase interface {
+	Execute(ctx context.Context, params usecase.ForkTierListParams) (*usecase.ForkTierListResult, error)
+}
+
+func NewForkTierListHandler(uc ForkTierListUseCase) *ForkTierListHandler {
+	return &ForkTierListHandler{
+		uc: uc,
+	}
+}
+
+func (h *ForkTierListHandler) Handle(ctx *gin.Context) {
+	// ボディは任意のため、空の場合はフォーク元の設定を引き継ぐ
+	var req request.ForkTierListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	params := usecase.ForkTierListParams{
+		TierListID: ctx.Param("tier_list_id"),
+		SeasonID:   req.SeasonID,
+		Title:      req.Title,
+		AuthorName: req.AuthorName,
+		AuthorIP:   ctx.ClientIP(),
+	}
+	// ログイン中の場合はフォーク先の作成者として記録する
+	if userID, ok := auth.UserIDFromContext(ctx.Request.Context()); ok {
+		params.AuthorUserID = &userID
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), params)
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewForkTierListResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/fork_tier_list_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/fork_tier_list_handler.go -destination=./apps/tierlist/internal/presentation/handler/fork_tier_list_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForkTierListUseCase is a mock of ForkTierListUseCase interface.
+type MockForkTierListUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockForkTierListUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockForkTierListUseCaseMockRecorder is the mock recorder for MockForkTierListUseCase.
+type MockForkTierListUseCaseMockRecorder struct {
+	mock *MockForkTierListUseCase
+}
+
+// NewMockForkTierListUseCase creates a new mock instance.
+func NewMockForkTierListUseCase(ctrl *gomock.Controller) *MockForkTierListUseCase {
+	mock := &MockForkTierListUseCase{ctrl: ctrl}
+	mock.recorder = &MockForkTierListUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForkTierListUseCase) EXPECT() *MockForkTierListUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockForkTierListUseCase) Execute(ctx context.Context, params usecase.ForkTierListParams) (*usecase.ForkTierListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ForkTierListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockForkTierListUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockForkTierListUseCase)(nil).Execute), ctx, params)
+}
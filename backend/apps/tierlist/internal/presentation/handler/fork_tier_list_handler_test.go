@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestForkTierListHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	result := &usecase.ForkTierListResult{
+		TierList: usecase.FTLTierList{
+			TierListID:           "tier-list-2",
+			SeasonID:             "season-2",
+			ForkedFromTierListID: "tier-list-1",
+			Title:                "B1環境ティアリスト",
+			AuthorName:           "視聴者B",
+			Placements: []usecase.FTLPlacement{
+				{DeckID: "deck-1", TierRank: "SS", Position: 0},
+			},
+			CreatedAt: createdAt,
+		},
+		DroppedDecks: []usecase.FTLDroppedDeck{
+			{DeckID: "deck-2", Nickname: "ピカチュウex"},
+		},
+	}
+	expectedResponse := response.ForkTierListResponse{
+		TierList: response.FTLTierList{
+			TierListID:           "tier-list-2",
+			SeasonID:             "season-2",
+			ForkedFromTierListID: "tier-list-1",
+			Title:                "B1環境ティアリスト",
+			AuthorName:           "視聴者B",
+			Placements: []response.FTLPlacement{
+				{DeckID: "deck-1", TierRank: "SS", Position: 0},
+			},
+			CreatedAt: createdAt,
+		},
+		DroppedDecks: []response.FTLDroppedDeck{
+			{DeckID: "deck-2", Nickname: "ピカチュウex"},
+		},
+	}
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockForkTierListUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: リクエストボディがユースケースに渡り、フォークしたティアリストと除外されたデッキが返される",
+			body:     `{"season_id":"season-2","title":"B1環境ティアリスト","author_name":"視聴者B"}`,
+			mockSetup: func(mockUC *MockForkTierListUseCase) {
+				expectedParams := usecase.ForkTierListParams{
+					TierListID: "tier-list-1",
+					SeasonID:   "season-2",
+					Title:      "B1環境ティアリスト",
+					AuthorName: "視聴者B",
+					AuthorIP:   "192.0.2.1",
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   expectedResponse,
+		},
+		{
+			caseName: "正常系: リクエストボディが空の場合、フォーク元の設定でフォークされる",
+			body:     "",
+			mockSetup: func(mockUC *MockForkTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ForkTierListParams{TierListID: "tier-list-1", AuthorIP: "192.0.2.1"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   expectedResponse,
+		},
+		{
+			caseName:       "異常系: タイトルが上限を超える場合、400が返される",
+			body:           `{"title":"` + strings.Repeat("a", 101) + `"}`,
+			mockSetup:      func(mockUC *MockForkTierListUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: フォーク元が存在しない場合、404が返される",
+			body:     "",
+			mockSetup: func(mockUC *MockForkTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			body:     "",
+			mockSetup: func(mockUC *MockForkTierListUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockForkTierListUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewForkTierListHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/tier-lists/tier-list-1/fork", strings.NewReader(tt.body))
+			c.Request = c.Request.WithContext(context.Background())
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetTierListImageHandler struct {
+	uc GetTierListImageUseCase
+}
+
+type GetTierListImageUseCase interface {
+	Execute(ctx context.Context, params usecase.GetTierListImageParams) (*usecase.GetTierListImageResult, error)
+}
+
+func NewGetTierListImageHandler(uc GetTierListImageUseCase) *GetTierListImageHandler {
+	return &GetTierListImageHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetTierListImageHandler) Handle(ctx *gin.Context) {
+	tierListID := ctx.Param("tier_list_id")
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetTierListImageParams{
+		TierListID: tierListID,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	// 代替のタイルを含む画像はクライアントにもキャッシュさせず、次回のリクエストで描画し直す
+	if !result.Complete {
+		ctx.Header("Cache-Control", "no-store")
+		ctx.Data(http.StatusOK, "image/png", result.PNG)
+		return
+	}
+
+	// 画像はリビジョンごとに変わるため、リビジョン番号をETagとして再取得を省略できるようにする
+	etag := fmt.Sprintf(`"%s-r%d"`, tierListID, result.Version)
+	ctx.Header("ETag", etag)
+	ctx.Header("Cache-Control", "public, max-age=300")
+	if ctx.GetHeader("If-None-Match") == etag {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "image/png", result.PNG)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/get_tier_list_image_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/get_tier_list_image_handler.go -destination=./apps/tierlist/internal/presentation/handler/get_tier_list_image_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetTierListImageUseCase is a mock of GetTierListImageUseCase interface.
+type MockGetTierListImageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTierListImageUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTierListImageUseCaseMockRecorder is the mock recorder for MockGetTierListImageUseCase.
+type MockGetTierListImageUseCaseMockRecorder struct {
+	mock *MockGetTierListImageUseCase
+}
+
+// NewMockGetTierListImageUseCase creates a new mock instance.
+func NewMockGetTierListImageUseCase(ctrl *gomock.Controller) *MockGetTierListImageUseCase {
+	mock := &MockGetTierListImageUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetTierListImageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTierListImageUseCase) EXPECT() *MockGetTierListImageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetTierListImageUseCase) Execute(ctx context.Context, params usecase.GetTierListImageParams) (*usecase.GetTierListImageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetTierListImageResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetTierListImageUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetTierListImageUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,151 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTierListImageHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	result := &usecase.GetTierListImageResult{PNG: []byte("\x89PNG"), Version: 3, Complete: true}
+	incomplete := &usecase.GetTierListImageResult{PNG: []byte("\x89PNG"), Version: 3, Complete: false}
+
+	tests := []struct {
+		caseName       string
+		ifNoneMatch    string
+		mockSetup      func(*MockGetTierListImageUseCase)
+		expectedStatus int
+		expectedType   string
+		expectedBody   []byte
+		expectedETag   string
+		expectedCache  string
+		expectedError  *errs.ErrorResponse
+	}{
+		{
+			caseName: "正常系: パスパラメータがユースケースに渡り、PNG画像が返される",
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.GetTierListImageParams{TierListID: "tier-list-1"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "image/png",
+			expectedBody:   []byte("\x89PNG"),
+			expectedETag:   `"tier-list-1-r3"`,
+			expectedCache:  "public, max-age=300",
+		},
+		{
+			caseName:    "正常系: ETagが一致する場合、304が返される",
+			ifNoneMatch: `"tier-list-1-r3"`,
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(result, nil)
+			},
+			expectedStatus: http.StatusNotModified,
+			expectedETag:   `"tier-list-1-r3"`,
+			expectedCache:  "public, max-age=300",
+		},
+		{
+			caseName:    "正常系: 代替のタイルを含む画像の場合、ETagを付けずキャッシュを禁止して返される",
+			ifNoneMatch: `"tier-list-1-r3"`,
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(incomplete, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "image/png",
+			expectedBody:   []byte("\x89PNG"),
+			expectedCache:  "no-store",
+		},
+		{
+			caseName:    "正常系: リビジョンが更新されETagが一致しない場合、PNG画像が返される",
+			ifNoneMatch: `"tier-list-1-r2"`,
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "image/png",
+			expectedBody:   []byte("\x89PNG"),
+			expectedETag:   `"tier-list-1-r3"`,
+			expectedCache:  "public, max-age=300",
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、404が返される",
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			mockSetup: func(mockUC *MockGetTierListImageUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockGetTierListImageUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewGetTierListImageHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/tier-lists/tier-list-1/image", nil)
+			c.Request = c.Request.WithContext(context.Background())
+			if tt.ifNoneMatch != "" {
+				c.Request.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+			c.Writer.WriteHeaderNow()
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			if tt.expectedError != nil {
+				var actualBody errs.ErrorResponse
+				err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+				assert.NoError(t, err, "response body should be valid JSON")
+				assert.Equal(t, *tt.expectedError, actualBody, "response body should match expected")
+				return
+			}
+
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"), "ETag should match expected")
+			assert.Equal(t, tt.expectedCache, w.Header().Get("Cache-Control"), "Cache-Control should match expected")
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"), "content type should match expected")
+			assert.Equal(t, string(tt.expectedBody), w.Body.String(), "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/request"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListTierListForksHandler struct {
+	uc ListTierListForksUseCase
+}
+
+type ListTierListForksUseCase interface {
+	Execute(ctx context.Context, params usecase.ListTierListForksParams) (*usecase.ListTierListForksResult, error)
+}
+
+func NewListTierListForksHandler(uc ListTierListForksUseCase) *ListTierListForksHandler {
+	return &ListTierListForksHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListTierListForksHandler) Handle(ctx *gin.Context) {
+	var req request.ListTierListForksRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListTierListForksParams{
+		TierListID: ctx.Param("tier_list_id"),
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListTierListForksResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/list_tier_list_forks_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/list_tier_list_forks_handler.go -destination=./apps/tierlist/internal/presentation/handler/list_tier_list_forks_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListTierListForksUseCase is a mock of ListTierListForksUseCase interface.
+type MockListTierListForksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTierListForksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListTierListForksUseCaseMockRecorder is the mock recorder for MockListTierListForksUseCase.
+type MockListTierListForksUseCaseMockRecorder struct {
+	mock *MockListTierListForksUseCase
+}
+
+// NewMockListTierListForksUseCase creates a new mock instance.
+func NewMockListTierListForksUseCase(ctrl *gomock.Controller) *MockListTierListForksUseCase {
+	mock := &MockListTierListForksUseCase{ctrl: ctrl}
+	mock.recorder = &MockListTierListForksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTierListForksUseCase) EXPECT() *MockListTierListForksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListTierListForksUseCase) Execute(ctx context.Context, params usecase.ListTierListForksParams) (*usecase.ListTierListForksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListTierListForksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListTierListForksUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListTierListForksUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTierListForksHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	nextCursor := "eyJrIjoxMDAsImlkIjoiMDE5ODlhMDAifQ"
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockListTierListForksUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスとクエリパラメータがユースケースに渡り、フォーク数とフォーク一覧が返される",
+			target:   "/tier-lists/tier-list-1/forks?cursor=abc&limit=1",
+			mockSetup: func(mockUC *MockListTierListForksUseCase) {
+				expectedParams := usecase.ListTierListForksParams{
+					TierListID: "tier-list-1",
+					Cursor:     "abc",
+					Limit:      1,
+				}
+				result := &usecase.ListTierListForksResult{
+					ForkCount: 2,
+					TierLists: []usecase.LTFTierList{
+						{
+							TierListID: "tier-list-2",
+							SeasonID:   "season-1",
+							Title:      "A4環境ティアリスト",
+							AuthorName: "視聴者B",
+							CreatedAt:  createdAt,
+						},
+					},
+					NextCursor: nextCursor,
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListTierListForksResponse{
+				ForkCount: 2,
+				TierLists: []response.LTFTierList{
+					{
+						TierListID: "tier-list-2",
+						SeasonID:   "season-1",
+						Title:      "A4環境ティアリスト",
+						AuthorName: "視聴者B",
+						CreatedAt:  createdAt,
+					},
+				},
+				NextCursor: &nextCursor,
+			},
+		},
+		{
+			caseName:       "異常系: limitが上限を超える場合、400が返される",
+			target:         "/tier-lists/tier-list-1/forks?limit=101",
+			mockSetup:      func(mockUC *MockListTierListForksUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: フォーク元が存在しない場合、404が返される",
+			target:   "/tier-lists/tier-list-1/forks",
+			mockSetup: func(mockUC *MockListTierListForksUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/tier-lists/tier-list-1/forks",
+			mockSetup: func(mockUC *MockListTierListForksUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListTierListForksUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListTierListForksHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListTierListRevisionsHandler struct {
+	uc ListTierListRevisionsUseCase
+}
+
+type ListTierListRevisionsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListTierListRevisionsParams) (*usecase.ListTierListRevisionsResult, error)
+}
+
+func NewListTierListRevisionsHandler(uc ListTierListRevisionsUseCase) *ListTierListRevisionsHandler {
+	return &ListTierListRevisionsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListTierListRevisionsHandler) Handle(ctx *gin.Context) {
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListTierListRevisionsParams{
+		TierListID: ctx.Param("tier_list_id"),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListTierListRevisionsResponse(result))
+}
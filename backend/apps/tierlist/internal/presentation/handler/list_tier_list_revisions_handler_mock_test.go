@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/list_tier_list_revisions_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/list_tier_list_revisions_handler.go -destination=./apps/tierlist/internal/presentation/handler/list_tier_list_revisions_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListTierListRevisionsUseCase is a mock of ListTierListRevisionsUseCase interface.
+type MockListTierListRevisionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTierListRevisionsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListTierListRevisionsUseCaseMockRecorder is the mock recorder for MockListTierListRevisionsUseCase.
+type MockListTierListRevisionsUseCaseMockRecorder struct {
+	mock *MockListTierListRevisionsUseCase
+}
+
+// NewMockListTierListRevisionsUseCase creates a new mock instance.
+func NewMockListTierListRevisionsUseCase(ctrl *gomock.Controller) *MockListTierListRevisionsUseCase {
+	mock := &MockListTierListRevisionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListTierListRevisionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTierListRevisionsUseCase) EXPECT() *MockListTierListRevisionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListTierListRevisionsUseCase) Execute(ctx context.Context, params usecase.ListTierListRevisionsParams) (*usecase.ListTierListRevisionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListTierListRevisionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListTierListRevisionsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListTierListRevisionsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTierListRevisionsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	restoredFrom := 1
+
+	tests := []struct {
+		caseName       string
+		mockSetup      func(*MockListTierListRevisionsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスパラメータがユースケースに渡り、リビジョン一覧が返される",
+			mockSetup: func(mockUC *MockListTierListRevisionsUseCase) {
+				result := &usecase.ListTierListRevisionsResult{
+					Revisions: []usecase.LTRRevision{
+						{RevisionNumber: 2, ChangeCount: 1, PlacementCount: 3, RestoredFromRevision: &restoredFrom, CreatedAt: createdAt.Add(time.Hour)},
+						{RevisionNumber: 1, ChangeCount: 3, PlacementCount: 3, CreatedAt: createdAt},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListTierListRevisionsParams{TierListID: "tier-list-1"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListTierListRevisionsResponse{
+				Revisions: []response.LTRRevision{
+					{RevisionNumber: 2, ChangeCount: 1, PlacementCount: 3, RestoredFromRevision: &restoredFrom, CreatedAt: createdAt.Add(time.Hour)},
+					{RevisionNumber: 1, ChangeCount: 3, PlacementCount: 3, CreatedAt: createdAt},
+				},
+			},
+		},
+		{
+			caseName: "異常系: ティアリストが存在しない場合、404が返される",
+			mockSetup: func(mockUC *MockListTierListRevisionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("tier list not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListTierListRevisionsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListTierListRevisionsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/tier-lists/tier-list-1/revisions", nil)
+			c.Request = c.Request.WithContext(context.Background())
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/request"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListTierListsHandler struct {
+	uc ListTierListsUseCase
+}
+
+type ListTierListsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListTierListsParams) (*usecase.ListTierListsResult, error)
+}
+
+func NewListTierListsHandler(uc ListTierListsUseCase) *ListTierListsHandler {
+	return &ListTierListsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListTierListsHandler) Handle(ctx *gin.Context) {
+	var req request.ListTierListsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid query parameters", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListTierListsParams{
+		SeasonID: req.SeasonID,
+		Author:   req.Author,
+		Sort:     req.Sort,
+		Cursor:   req.Cursor,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListTierListsResponse(result))
+}
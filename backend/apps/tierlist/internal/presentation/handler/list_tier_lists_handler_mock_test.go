@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/list_tier_lists_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/list_tier_lists_handler.go -destination=./apps/tierlist/internal/presentation/handler/list_tier_lists_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListTierListsUseCase is a mock of ListTierListsUseCase interface.
+type MockListTierListsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTierListsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListTierListsUseCaseMockRecorder is the mock recorder for MockListTierListsUseCase.
+type MockListTierListsUseCaseMockRecorder struct {
+	mock *MockListTierListsUseCase
+}
+
+// NewMockListTierListsUseCase creates a new mock instance.
+func NewMockListTierListsUseCase(ctrl *gomock.Controller) *MockListTierListsUseCase {
+	mock := &MockListTierListsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListTierListsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTierListsUseCase) EXPECT() *MockListTierListsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListTierListsUseCase) Execute(ctx context.Context, params usecase.ListTierListsParams) (*usecase.ListTierListsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListTierListsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListTierListsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListTierListsUseCase)(nil).Execute), ctx, params)
+}
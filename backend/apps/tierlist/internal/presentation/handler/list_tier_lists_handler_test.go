@@ -0,0 +1,173 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTierListsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	nextCursor := "eyJrIjoxMDAsImlkIjoiMDE5ODlhMDAifQ"
+
+	tests := []struct {
+		caseName       string
+		target         string
+		mockSetup      func(*MockListTierListsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: クエリパラメータがユースケースに渡り、一覧と次ページのカーソルが返される",
+			target:   "/tier-lists?season_id=season-1&author=配信者A&sort=newest&cursor=abc&limit=1",
+			mockSetup: func(mockUC *MockListTierListsUseCase) {
+				expectedParams := usecase.ListTierListsParams{
+					SeasonID: "season-1",
+					Author:   "配信者A",
+					Sort:     "newest",
+					Cursor:   "abc",
+					Limit:    1,
+				}
+				result := &usecase.ListTierListsResult{
+					TierLists: []usecase.LTLTierList{
+						{
+							TierListID:    "tier-list-1",
+							SeasonID:      "season-1",
+							Title:         "A4環境ティアリスト",
+							Description:   "新弾環境での評価",
+							AuthorName:    "配信者A",
+							ViewCount:     100,
+							FavoriteCount: 12,
+							CreatedAt:     createdAt,
+						},
+					},
+					NextCursor: nextCursor,
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListTierListsResponse{
+				TierLists: []response.LTLTierList{
+					{
+						TierListID:    "tier-list-1",
+						SeasonID:      "season-1",
+						Title:         "A4環境ティアリスト",
+						Description:   "新弾環境での評価",
+						AuthorName:    "配信者A",
+						ViewCount:     100,
+						FavoriteCount: 12,
+						CreatedAt:     createdAt,
+					},
+				},
+				NextCursor: &nextCursor,
+			},
+		},
+		{
+			caseName: "正常系: 最終ページの場合、next_cursorがnullで返される",
+			target:   "/tier-lists",
+			mockSetup: func(mockUC *MockListTierListsUseCase) {
+				result := &usecase.ListTierListsResult{
+					TierLists: []usecase.LTLTierList{},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListTierListsParams{}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListTierListsResponse{
+				TierLists:  []response.LTLTierList{},
+				NextCursor: nil,
+			},
+		},
+		{
+			caseName:       "異常系: limitが上限を超える場合、400が返される",
+			target:         "/tier-lists?limit=101",
+			mockSetup:      func(mockUC *MockListTierListsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでバリデーションエラーが発生した場合、400が返される",
+			target:   "/tier-lists?sort=oldest",
+			mockSetup: func(mockUC *MockListTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewValidationError("invalid sort", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			target:   "/tier-lists",
+			mockSetup: func(mockUC *MockListTierListsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListTierListsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListTierListsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			c.Request = c.Request.WithContext(context.Background())
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecordTierListViewHandler struct {
+	uc RecordTierListViewUseCase
+}
+
+type RecordTierListViewUseCase interface {
+	Execute(ctx context.Context, params usecase.RecordTierListViewParams) error
+}
+
+func NewRecordTierListViewHandler(uc RecordTierListViewUseCase) *RecordTierListViewHandler {
+	return &RecordTierListViewHandler{
+		uc: uc,
+	}
+}
+
+func (h *RecordTierListViewHandler) Handle(ctx *gin.Context) {
+	params := usecase.RecordTierListViewParams{
+		TierListID: ctx.Param("tier_list_id"),
+		ViewerIP:   ctx.ClientIP(),
+	}
+	// ログイン中の場合はIPアドレスではなくユーザーで閲覧者を識別する
+	if userID, ok := auth.UserIDFromContext(ctx.Request.Context()); ok {
+		params.ViewerUserID = &userID
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), params); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
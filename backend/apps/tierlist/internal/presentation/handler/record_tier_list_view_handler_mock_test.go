@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/record_tier_list_view_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/record_tier_list_view_handler.go -destination=./apps/tierlist/internal/presentation/handler/record_tier_list_view_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecordTierListViewUseCase is a mock of RecordTierListViewUseCase interface.
+type MockRecordTierListViewUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordTierListViewUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordTierListViewUseCaseMockRecorder is the mock recorder for MockRecordTierListViewUseCase.
+type MockRecordTierListViewUseCaseMockRecorder struct {
+	mock *MockRecordTierListViewUseCase
+}
+
+// NewMockRecordTierListViewUseCase creates a new mock instance.
+func NewMockRecordTierListViewUseCase(ctrl *gomock.Controller) *MockRecordTierListViewUseCase {
+	mock := &MockRecordTierListViewUseCase{ctrl: ctrl}
+	mock.recorder = &MockRecordTierListViewUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordTierListViewUseCase) EXPECT() *MockRecordTierListViewUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRecordTierListViewUseCase) Execute(ctx context.Context, params usecase.RecordTierListViewParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRecordTierListViewUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRecordTierListViewUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RestoreTierListRevisionHandler struct {
+	uc RestoreTierListRevisionUseCase
+}
+
+type RestoreTierListRevisionUseCase interface {
+	Execute(ctx context.Context, params usecase.RestoreTierListRevisionParams) (*usecase.RestoreTierListRevisionResult, error)
+}
+
+func NewRestoreTierListRevisionHandler(uc RestoreTierListRevisionUseCase) *RestoreTierListRevisionHandler {
+	return &RestoreTierListRevisionHandler{
+		uc: uc,
+	}
+}
+
+func (h *RestoreTierListRevisionHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.RestoreTierListRevisionParams{
+		UserID:         userID,
+		TierListID:     ctx.Param("tier_list_id"),
+		RevisionNumber: ctx.Param("revision_number"),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewRestoreTierListRevisionResponse(result))
+}
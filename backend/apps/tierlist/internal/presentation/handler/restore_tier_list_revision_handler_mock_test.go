@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/restore_tier_list_revision_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/restore_tier_list_revision_handler.go -destination=./apps/tierlist/internal/presentation/handler/restore_tier_list_revision_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRestoreTierListRevisionUseCase is a mock of RestoreTierListRevisionUseCase interface.
+type MockRestoreTierListRevisionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreTierListRevisionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRestoreTierListRevisionUseCaseMockRecorder is the mock recorder for MockRestoreTierListRevisionUseCase.
+type MockRestoreTierListRevisionUseCaseMockRecorder struct {
+	mock *MockRestoreTierListRevisionUseCase
+}
+
+// NewMockRestoreTierListRevisionUseCase creates a new mock instance.
+func NewMockRestoreTierListRevisionUseCase(ctrl *gomock.Controller) *MockRestoreTierListRevisionUseCase {
+	mock := &MockRestoreTierListRevisionUseCase{ctrl: ctrl}
+	mock.recorder = &MockRestoreTierListRevisionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreTierListRevisionUseCase) EXPECT() *MockRestoreTierListRevisionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRestoreTierListRevisionUseCase) Execute(ctx context.Context, params usecase.RestoreTierListRevisionParams) (*usecase.RestoreTierListRevisionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.RestoreTierListRevisionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRestoreTierListRevisionUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRestoreTierListRevisionUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,136 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestoreTierListRevisionHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		mockSetup      func(*MockRestoreTierListRevisionUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: パスパラメータがユースケースに渡り、復元で作成されたリビジョンが返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockRestoreTierListRevisionUseCase) {
+				expectedParams := usecase.RestoreTierListRevisionParams{
+					UserID:         userID,
+					TierListID:     "tier-list-1",
+					RevisionNumber: "1",
+				}
+				result := &usecase.RestoreTierListRevisionResult{RevisionNumber: 5, RestoredFromRevision: 1, ChangeCount: 2}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   response.RestoreTierListRevisionResponse{RevisionNumber: 5, RestoredFromRevision: 1, ChangeCount: 2},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockRestoreTierListRevisionUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: 作成者以外の場合、403が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockRestoreTierListRevisionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewForbiddenError("only the author can edit the tier list", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Forbidden",
+				Status: http.StatusForbidden,
+				Detail: "You do not have permission to perform this action.",
+			},
+		},
+		{
+			caseName: "異常系: リビジョンが存在しない場合、404が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockRestoreTierListRevisionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("revision not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockRestoreTierListRevisionUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewRestoreTierListRevisionHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/tier-lists/tier-list-1/revisions/1/restore", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{
+				{Key: "tier_list_id", Value: "tier-list-1"},
+				{Key: "revision_number", Value: "1"},
+			}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/request"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SaveTierListPlacementsHandler struct {
+	uc SaveTierListPlacementsUseCase
+}
+
+type SaveTierListPlacementsUseCase interface {
+	Execute(ctx context.Context, params usecase.SaveTierListPlacementsParams) (*usecase.SaveTierListPlacementsResult, error)
+}
+
+func NewSaveTierListPlacementsHandler(uc SaveTierListPlacementsUseCase) *SaveTierListPlacementsHandler {
+	return &SaveTierListPlacementsHandler{
+		uc: uc,
+	}
+}
+
+func (h *SaveTierListPlacementsHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.SaveTierListPlacementsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	placements := make([]usecase.STPPlacement, len(req.Placements))
+	for i, p := range req.Placements {
+		placements[i] = usecase.STPPlacement{
+			DeckID:   p.DeckID,
+			TierRank: p.TierRank,
+			Position: p.Position,
+		}
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.SaveTierListPlacementsParams{
+		UserID:     userID,
+		TierListID: ctx.Param("tier_list_id"),
+		Placements: placements,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewSaveTierListPlacementsResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/handler/save_tier_list_placements_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/handler/save_tier_list_placements_handler.go -destination=./apps/tierlist/internal/presentation/handler/save_tier_list_placements_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSaveTierListPlacementsUseCase is a mock of SaveTierListPlacementsUseCase interface.
+type MockSaveTierListPlacementsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaveTierListPlacementsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSaveTierListPlacementsUseCaseMockRecorder is the mock recorder for MockSaveTierListPlacementsUseCase.
+type MockSaveTierListPlacementsUseCaseMockRecorder struct {
+	mock *MockSaveTierListPlacementsUseCase
+}
+
+// NewMockSaveTierListPlacementsUseCase creates a new mock instance.
+func NewMockSaveTierListPlacementsUseCase(ctrl *gomock.Controller) *MockSaveTierListPlacementsUseCase {
+	mock := &MockSaveTierListPlacementsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSaveTierListPlacementsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaveTierListPlacementsUseCase) EXPECT() *MockSaveTierListPlacementsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSaveTierListPlacementsUseCase) Execute(ctx context.Context, params usecase.SaveTierListPlacementsParams) (*usecase.SaveTierListPlacementsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.SaveTierListPlacementsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSaveTierListPlacementsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSaveTierListPlacementsUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,166 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/handler"
+	"poketier/apps/tierlist/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSaveTierListPlacementsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+
+	badRequest := errs.ErrorResponse{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "The request is invalid.",
+	}
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		body           string
+		mockSetup      func(*MockSaveTierListPlacementsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: リクエストボディがユースケースに渡り、作成されたリビジョン番号が返される",
+			loggedIn: true,
+			body:     `{"placements":[{"deck_id":"deck-1","tier_rank":"S","position":0},{"deck_id":"deck-2","tier_rank":"A","position":1}]}`,
+			mockSetup: func(mockUC *MockSaveTierListPlacementsUseCase) {
+				expectedParams := usecase.SaveTierListPlacementsParams{
+					UserID:     userID,
+					TierListID: "tier-list-1",
+					Placements: []usecase.STPPlacement{
+						{DeckID: "deck-1", TierRank: "S", Position: 0},
+						{DeckID: "deck-2", TierRank: "A", Position: 1},
+					},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(&usecase.SaveTierListPlacementsResult{RevisionNumber: 3, ChangeCount: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   response.SaveTierListPlacementsResponse{RevisionNumber: 3, ChangeCount: 2},
+		},
+		{
+			caseName: "正常系: 空配列の場合、全ての配置の解除としてユースケースに渡る",
+			loggedIn: true,
+			body:     `{"placements":[]}`,
+			mockSetup: func(mockUC *MockSaveTierListPlacementsUseCase) {
+				expectedParams := usecase.SaveTierListPlacementsParams{
+					UserID:     userID,
+					TierListID: "tier-list-1",
+					Placements: []usecase.STPPlacement{},
+				}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(&usecase.SaveTierListPlacementsResult{RevisionNumber: 4, ChangeCount: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   response.SaveTierListPlacementsResponse{RevisionNumber: 4, ChangeCount: 2},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			body:           `{"placements":[]}`,
+			mockSetup:      func(mockUC *MockSaveTierListPlacementsUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: placementsが指定されていない場合、400が返される",
+			loggedIn:       true,
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockSaveTierListPlacementsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName:       "異常系: positionが負の値の場合、400が返される",
+			loggedIn:       true,
+			body:           `{"placements":[{"deck_id":"deck-1","tier_rank":"S","position":-1}]}`,
+			mockSetup:      func(mockUC *MockSaveTierListPlacementsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			body:     `{"placements":[]}`,
+			mockSetup: func(mockUC *MockSaveTierListPlacementsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockSaveTierListPlacementsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewSaveTierListPlacementsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPut, "/tier-lists/tier-list-1/placements", strings.NewReader(tt.body))
+			c.Request = c.Request.WithContext(ctx)
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "tier_list_id", Value: "tier-list-1"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
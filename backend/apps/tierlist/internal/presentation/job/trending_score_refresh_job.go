@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/pkg/log"
+)
+
+// TrendingScoreRefreshInterval はトレンドスコアを再計算する間隔
+const TrendingScoreRefreshInterval = time.Hour
+
+type RefreshTrendingScoresUseCase interface {
+	Execute(ctx context.Context) (*usecase.RefreshTrendingScoresResult, error)
+}
+
+// TrendingScoreRefreshJob はティアリストのトレンドスコアを定期的に再計算するバックグラウンドジョブ
+type TrendingScoreRefreshJob struct {
+	uc       RefreshTrendingScoresUseCase
+	logger   log.Logger
+	interval time.Duration
+}
+
+func NewTrendingScoreRefreshJob(uc RefreshTrendingScoresUseCase, logger log.Logger) *TrendingScoreRefreshJob {
+	return &TrendingScoreRefreshJob{
+		uc:       uc,
+		logger:   logger,
+		interval: TrendingScoreRefreshInterval,
+	}
+}
+
+// Run は起動直後に1回再計算し、以降は interval ごとに再計算する。ctx がキャンセルされるまで戻らない
+// 日付が変わってから次の再計算までは、集計期間から外れた日の閲覧がトレンドスコアに残る
+func (j *TrendingScoreRefreshJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce はトレンドスコアを1回再計算する。失敗しても次回の再計算は継続する
+func (j *TrendingScoreRefreshJob) runOnce(ctx context.Context) {
+	result, err := j.uc.Execute(ctx)
+	if err != nil {
+		j.logger.Error("Failed to refresh trending scores", "error", err)
+		return
+	}
+	j.logger.Info("Refreshed trending scores",
+		"updated_count", result.UpdatedCount,
+		"deleted_viewer_count", result.DeletedViewerCount,
+	)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/tierlist/internal/presentation/job/trending_score_refresh_job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/tierlist/internal/presentation/job/trending_score_refresh_job.go -destination=./apps/tierlist/internal/presentation/job/trending_score_refresh_job_mock_test.go -package=job_test
+//
+
+// Package job_test is a generated GoMock package.
+package job_test
+
+import (
+	context "context"
+	usecase "poketier/apps/tierlist/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTrendingScoresUseCase is a mock of RefreshTrendingScoresUseCase interface.
+type MockRefreshTrendingScoresUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTrendingScoresUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTrendingScoresUseCaseMockRecorder is the mock recorder for MockRefreshTrendingScoresUseCase.
+type MockRefreshTrendingScoresUseCaseMockRecorder struct {
+	mock *MockRefreshTrendingScoresUseCase
+}
+
+// NewMockRefreshTrendingScoresUseCase creates a new mock instance.
+func NewMockRefreshTrendingScoresUseCase(ctrl *gomock.Controller) *MockRefreshTrendingScoresUseCase {
+	mock := &MockRefreshTrendingScoresUseCase{ctrl: ctrl}
+	mock.recorder = &MockRefreshTrendingScoresUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTrendingScoresUseCase) EXPECT() *MockRefreshTrendingScoresUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRefreshTrendingScoresUseCase) Execute(ctx context.Context) (*usecase.RefreshTrendingScoresResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(*usecase.RefreshTrendingScoresResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRefreshTrendingScoresUseCaseMockRecorder) Execute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRefreshTrendingScoresUseCase)(nil).Execute), ctx)
+}
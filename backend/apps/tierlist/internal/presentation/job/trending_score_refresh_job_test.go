@@ -0,0 +1,70 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/tierlist/internal/application/usecase"
+	"poketier/apps/tierlist/internal/presentation/job"
+	"poketier/pkg/log"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTrendingScoreRefreshJob_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		result   *usecase.RefreshTrendingScoresResult
+		err      error
+	}{
+		{
+			caseName: "正常系: 起動直後に再計算され、キャンセルされると終了する",
+			result:   &usecase.RefreshTrendingScoresResult{UpdatedCount: 12, DeletedViewerCount: 340},
+		},
+		{
+			caseName: "異常系: 再計算に失敗してもジョブは停止せず、キャンセルされると終了する",
+			err:      errors.New("usecase error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mockUC := NewMockRefreshTrendingScoresUseCase(ctrl)
+			mockUC.EXPECT().Execute(gomock.Any()).DoAndReturn(
+				func(context.Context) (*usecase.RefreshTrendingScoresResult, error) {
+					cancel()
+					return tt.result, tt.err
+				},
+			)
+
+			refreshJob := job.NewTrendingScoreRefreshJob(mockUC, log.NewStartupLogger("info", true))
+
+			// Act
+			done := make(chan struct{})
+			go func() {
+				refreshJob.Run(ctx)
+				close(done)
+			}()
+
+			// Assert
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("job should stop after context is cancelled")
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package request
+
+// ForkTierListRequest はティアリストのフォークのリクエストボディ（全項目任意）
+type ForkTierListRequest struct {
+	SeasonID   string `json:"season_id"`
+	Title      string `json:"title" binding:"omitempty,max=100"`
+	AuthorName string `json:"author_name" binding:"omitempty,max=30"`
+}
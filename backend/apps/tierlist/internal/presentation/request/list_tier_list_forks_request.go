@@ -0,0 +1,7 @@
+package request
+
+// ListTierListForksRequest はフォーク一覧取得のクエリパラメータ
+type ListTierListForksRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,10 @@
+package request
+
+// ListTierListsRequest はティアリスト一覧取得のクエリパラメータ
+type ListTierListsRequest struct {
+	SeasonID string `form:"season_id"`
+	Author   string `form:"author"`
+	Sort     string `form:"sort"`
+	Cursor   string `form:"cursor"`
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
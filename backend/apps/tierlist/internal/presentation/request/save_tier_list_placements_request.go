@@ -0,0 +1,13 @@
+package request
+
+// SaveTierListPlacementsRequest はティアリストの配置保存のリクエストボディ
+// placements は保存後の全ての配置で、空配列の場合は全ての配置を解除する
+type SaveTierListPlacementsRequest struct {
+	Placements []STPPlacement `json:"placements" binding:"required,dive"`
+}
+
+type STPPlacement struct {
+	DeckID   string `json:"deck_id" binding:"required"`
+	TierRank string `json:"tier_rank" binding:"required"`
+	Position int    `json:"position" binding:"min=0"`
+}
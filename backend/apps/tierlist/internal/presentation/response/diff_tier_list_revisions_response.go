@@ -0,0 +1,54 @@
+package response
+
+import "poketier/apps/tierlist/internal/application/usecase"
+
+type DiffTierListRevisionsResponse struct {
+	FromRevision int         `json:"from_revision"`
+	ToRevision   int         `json:"to_revision"`
+	Changes      []DTRChange `json:"changes"`
+}
+
+type DTRChange struct {
+	DeckID   string        `json:"deck_id"`
+	Nickname string        `json:"nickname"`
+	Type     string        `json:"type"`
+	From     *DTRPlacement `json:"from"`
+	To       *DTRPlacement `json:"to"`
+	Summary  string        `json:"summary"`
+}
+
+type DTRPlacement struct {
+	TierRank string `json:"tier_rank"`
+	Position int    `json:"position"`
+}
+
+func NewDiffTierListRevisionsResponse(result *usecase.DiffTierListRevisionsResult) DiffTierListRevisionsResponse {
+	changes := make([]DTRChange, len(result.Changes))
+	for i, c := range result.Changes {
+		changes[i] = DTRChange{
+			DeckID:   c.DeckID,
+			Nickname: c.Nickname,
+			Type:     c.Type,
+			From:     toDTRPlacement(c.FromTierRank, c.FromPosition),
+			To:       toDTRPlacement(c.ToTierRank, c.ToPosition),
+			Summary:  c.Summary,
+		}
+	}
+
+	return DiffTierListRevisionsResponse{
+		FromRevision: result.FromRevision,
+		ToRevision:   result.ToRevision,
+		Changes:      changes,
+	}
+}
+
+// toDTRPlacement は変更前後の配置を変換し、配置がない場合はnullとする
+func toDTRPlacement(tierRank string, position *int) *DTRPlacement {
+	if position == nil {
+		return nil
+	}
+	return &DTRPlacement{
+		TierRank: tierRank,
+		Position: *position,
+	}
+}
@@ -0,0 +1,67 @@
+package response
+
+import (
+	"poketier/apps/tierlist/internal/application/usecase"
+	"time"
+)
+
+type ForkTierListResponse struct {
+	TierList     FTLTierList      `json:"tier_list"`
+	DroppedDecks []FTLDroppedDeck `json:"dropped_decks"`
+}
+
+type FTLTierList struct {
+	TierListID           string         `json:"tier_list_id"`
+	SeasonID             string         `json:"season_id"`
+	ForkedFromTierListID string         `json:"forked_from_tier_list_id"`
+	Title                string         `json:"title"`
+	Description          string         `json:"description"`
+	AuthorName           string         `json:"author_name"`
+	Placements           []FTLPlacement `json:"placements"`
+	CreatedAt            time.Time      `json:"created_at"`
+}
+
+type FTLPlacement struct {
+	DeckID   string `json:"deck_id"`
+	TierRank string `json:"tier_rank"`
+	Position int    `json:"position"`
+}
+
+type FTLDroppedDeck struct {
+	DeckID   string `json:"deck_id"`
+	Nickname string `json:"nickname"`
+}
+
+func NewForkTierListResponse(result *usecase.ForkTierListResult) ForkTierListResponse {
+	placements := make([]FTLPlacement, len(result.TierList.Placements))
+	for i, p := range result.TierList.Placements {
+		placements[i] = FTLPlacement{
+			DeckID:   p.DeckID,
+			TierRank: p.TierRank,
+			Position: p.Position,
+		}
+	}
+
+	droppedDecks := make([]FTLDroppedDeck, len(result.DroppedDecks))
+	for i, d := range result.DroppedDecks {
+		droppedDecks[i] = FTLDroppedDeck{
+			DeckID:   d.DeckID,
+			Nickname: d.Nickname,
+		}
+	}
+
+	tl := result.TierList
+	return ForkTierListResponse{
+		TierList: FTLTierList{
+			TierListID:           tl.TierListID,
+			SeasonID:             tl.SeasonID,
+			ForkedFromTierListID: tl.ForkedFromTierListID,
+			Title:                tl.Title,
+			Description:          tl.Description,
+			AuthorName:           tl.AuthorName,
+			Placements:           placements,
+			CreatedAt:            tl.CreatedAt,
+		},
+		DroppedDecks: droppedDecks,
+	}
+}
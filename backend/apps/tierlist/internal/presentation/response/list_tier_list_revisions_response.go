@@ -0,0 +1,35 @@
+package response
+
+import (
+	"poketier/apps/tierlist/internal/application/usecase"
+	"time"
+)
+
+type ListTierListRevisionsResponse struct {
+	Revisions []LTRRevision `json:"revisions"`
+}
+
+type LTRRevision struct {
+	RevisionNumber       int       `json:"revision_number"`
+	ChangeCount          int       `json:"change_count"`
+	PlacementCount       int       `json:"placement_count"`
+	RestoredFromRevision *int      `json:"restored_from_revision"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+func NewListTierListRevisionsResponse(result *usecase.ListTierListRevisionsResult) ListTierListRevisionsResponse {
+	revisions := make([]LTRRevision, len(result.Revisions))
+	for i, r := range result.Revisions {
+		revisions[i] = LTRRevision{
+			RevisionNumber:       r.RevisionNumber,
+			ChangeCount:          r.ChangeCount,
+			PlacementCount:       r.PlacementCount,
+			RestoredFromRevision: r.RestoredFromRevision,
+			CreatedAt:            r.CreatedAt,
+		}
+	}
+
+	return ListTierListRevisionsResponse{
+		Revisions: revisions,
+	}
+}
@@ -0,0 +1,52 @@
+package response
+
+import (
+	"poketier/apps/tierlist/internal/application/usecase"
+	"time"
+)
+
+type ListTierListsResponse struct {
+	TierLists  []LTLTierList `json:"tier_lists"`
+	NextCursor *string       `json:"next_cursor"`
+}
+
+type LTLTierList struct {
+	TierListID    string    `json:"tier_list_id"`
+	SeasonID      string    `json:"season_id"`
+	Title         string    `json:"title"`
+	Description   string    `json:"description"`
+	AuthorName    string    `json:"author_name"`
+	ViewCount     int       `json:"view_count"`
+	ForkCount     int       `json:"fork_count"`
+	FavoriteCount int       `json:"favorite_count"`
+	LikeCount     int       `json:"like_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewListTierListsResponse(result *usecase.ListTierListsResult) ListTierListsResponse {
+	tierLists := make([]LTLTierList, len(result.TierLists))
+	for i, tl := range result.TierLists {
+		tierLists[i] = LTLTierList{
+			TierListID:    tl.TierListID,
+			SeasonID:      tl.SeasonID,
+			Title:         tl.Title,
+			Description:   tl.Description,
+			AuthorName:    tl.AuthorName,
+			ViewCount:     tl.ViewCount,
+			ForkCount:     tl.ForkCount,
+			FavoriteCount: tl.FavoriteCount,
+			LikeCount:     tl.LikeCount,
+			CreatedAt:     tl.CreatedAt,
+		}
+	}
+
+	var nextCursor *string
+	if result.NextCursor != "" {
+		nextCursor = &result.NextCursor
+	}
+
+	return ListTierListsResponse{
+		TierLists:  tierLists,
+		NextCursor: nextCursor,
+	}
+}
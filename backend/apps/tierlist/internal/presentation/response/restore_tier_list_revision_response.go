@@ -0,0 +1,17 @@
+package response
+
+import "poketier/apps/tierlist/internal/application/usecase"
+
+type RestoreTierListRevisionResponse struct {
+	RevisionNumber       int `json:"revision_number"`
+	RestoredFromRevision int `json:"restored_from_revision"`
+	ChangeCount          int `json:"change_count"`
+}
+
+func NewRestoreTierListRevisionResponse(result *usecase.RestoreTierListRevisionResult) RestoreTierListRevisionResponse {
+	return RestoreTierListRevisionResponse{
+		RevisionNumber:       result.RevisionNumber,
+		RestoredFromRevision: result.RestoredFromRevision,
+		ChangeCount:          result.ChangeCount,
+	}
+}
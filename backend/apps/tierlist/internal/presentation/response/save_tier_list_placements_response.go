@@ -0,0 +1,15 @@
+package response
+
+import "poketier/apps/tierlist/internal/application/usecase"
+
+type SaveTierListPlacementsResponse struct {
+	RevisionNumber int `json:"revision_number"`
+	ChangeCount    int `json:"change_count"`
+}
+
+func NewSaveTierListPlacementsResponse(result *usecase.SaveTierListPlacementsResult) SaveTierListPlacementsResponse {
+	return SaveTierListPlacementsResponse{
+		RevisionNumber: result.RevisionNumber,
+		ChangeCount:    result.ChangeCount,
+	}
+}
@@ -0,0 +1,15 @@
+package user
+
+import (
+	"poketier/apps/user/internal/infrastructure/notifier"
+	"poketier/pkg/mail"
+)
+
+// AccountMailer はアカウントの確認・パスワード再設定のメールを送信する
+// サーバー内で1つのインスタンスを共有する
+type AccountMailer = notifier.AccountMailer
+
+// NewAccountMailer はメール送信の実装と、メール内のリンクに使用するフロントエンドの公開URLを指定してAccountMailerを作成します
+func NewAccountMailer(mailer mail.Mailer, publicURL string) *AccountMailer {
+	return notifier.NewAccountMailer(mailer, publicURL)
+}
@@ -0,0 +1,359 @@
+//go:build wireinject
+// +build wireinject
+
+package user
+
+import (
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/infrastructure/notifier"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/oidc"
+	"poketier/pkg/password"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/google/wire"
+)
+
+// InitializeGetMeHandler はGetMeHandlerとその依存関係を初期化します
+func InitializeGetMeHandler(queries db.Querier) *handler.GetMeHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		wire.Bind(new(usecase.GMUserRepository), new(*repository.UserRepository)),
+
+		// Usecase provider
+		usecase.NewGetMeUsecase,
+		wire.Bind(new(handler.GetMeUseCase), new(*usecase.GetMeUsecase)),
+
+		// Handler provider
+		handler.NewGetMeHandler,
+	)
+	return &handler.GetMeHandler{}
+}
+
+// InitializeUpdateMeHandler はUpdateMeHandlerとその依存関係を初期化します
+func InitializeUpdateMeHandler(queries db.Querier) *handler.UpdateMeHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		wire.Bind(new(usecase.UMUserRepository), new(*repository.UserRepository)),
+
+		// Usecase provider
+		usecase.NewUpdateMeUsecase,
+		wire.Bind(new(handler.UpdateMeUseCase), new(*usecase.UpdateMeUsecase)),
+
+		// Handler provider
+		handler.NewUpdateMeHandler,
+	)
+	return &handler.UpdateMeHandler{}
+}
+
+// InitializeSignUpHandler はSignUpHandlerとその依存関係を初期化します
+func InitializeSignUpHandler(queries db.Querier, txManager *sqlc.TxManager, hasher *password.Hasher, accountMailer *notifier.AccountMailer) *handler.SignUpHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.AccountTokenQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		repository.NewCredentialRepository,
+		repository.NewAccountTokenRepository,
+		wire.Bind(new(usecase.SUUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(usecase.SUCredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.SUAccountTokenRepository), new(*repository.AccountTokenRepository)),
+		wire.Bind(new(usecase.SUPasswordHasher), new(*password.Hasher)),
+		wire.Bind(new(usecase.SUAccountMailer), new(*notifier.AccountMailer)),
+		wire.Bind(new(usecase.SUTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewSignUpUsecase,
+		wire.Bind(new(handler.SignUpUseCase), new(*usecase.SignUpUsecase)),
+
+		// Handler provider
+		handler.NewSignUpHandler,
+	)
+	return &handler.SignUpHandler{}
+}
+
+// InitializeVerifyEmailHandler はVerifyEmailHandlerとその依存関係を初期化します
+func InitializeVerifyEmailHandler(queries db.Querier, txManager *sqlc.TxManager) *handler.VerifyEmailHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.AccountTokenQuerier), new(db.Querier)),
+		repository.NewCredentialRepository,
+		repository.NewAccountTokenRepository,
+		wire.Bind(new(usecase.VEAccountTokenRepository), new(*repository.AccountTokenRepository)),
+		wire.Bind(new(usecase.VECredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.VETxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewVerifyEmailUsecase,
+		wire.Bind(new(handler.VerifyEmailUseCase), new(*usecase.VerifyEmailUsecase)),
+
+		// Handler provider
+		handler.NewVerifyEmailHandler,
+	)
+	return &handler.VerifyEmailHandler{}
+}
+
+// InitializeResendVerificationEmailHandler はResendVerificationEmailHandlerとその依存関係を初期化します
+func InitializeResendVerificationEmailHandler(queries db.Querier, accountMailer *notifier.AccountMailer) *handler.ResendVerificationEmailHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.AccountTokenQuerier), new(db.Querier)),
+		repository.NewCredentialRepository,
+		repository.NewAccountTokenRepository,
+		wire.Bind(new(usecase.RVCredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.RVAccountTokenRepository), new(*repository.AccountTokenRepository)),
+		wire.Bind(new(usecase.RVAccountMailer), new(*notifier.AccountMailer)),
+
+		// Usecase provider
+		usecase.NewResendVerificationEmailUsecase,
+		wire.Bind(new(handler.ResendVerificationEmailUseCase), new(*usecase.ResendVerificationEmailUsecase)),
+
+		// Handler provider
+		handler.NewResendVerificationEmailHandler,
+	)
+	return &handler.ResendVerificationEmailHandler{}
+}
+
+// InitializeLogInHandler はLogInHandlerとその依存関係を初期化します
+func InitializeLogInHandler(queries db.Querier, txManager *sqlc.TxManager, hasher *password.Hasher, signer *auth.Signer) *handler.LogInHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.RefreshTokenQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		repository.NewCredentialRepository,
+		repository.NewSessionRepository,
+		repository.NewRefreshTokenRepository,
+		wire.Bind(new(usecase.LICredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.LIUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(usecase.LIPasswordHasher), new(*password.Hasher)),
+		wire.Bind(new(usecase.LITokenSigner), new(*auth.Signer)),
+		wire.Bind(new(usecase.LISessionRepository), new(*repository.SessionRepository)),
+		wire.Bind(new(usecase.LIRefreshTokenRepository), new(*repository.RefreshTokenRepository)),
+		wire.Bind(new(usecase.LITxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewLogInUsecase,
+		wire.Bind(new(handler.LogInUseCase), new(*usecase.LogInUsecase)),
+
+		// Handler provider
+		handler.NewLogInHandler,
+	)
+	return &handler.LogInHandler{}
+}
+
+// InitializeRequestPasswordResetHandler はRequestPasswordResetHandlerとその依存関係を初期化します
+func InitializeRequestPasswordResetHandler(queries db.Querier, accountMailer *notifier.AccountMailer) *handler.RequestPasswordResetHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.AccountTokenQuerier), new(db.Querier)),
+		repository.NewCredentialRepository,
+		repository.NewAccountTokenRepository,
+		wire.Bind(new(usecase.RPRCredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.RPRAccountTokenRepository), new(*repository.AccountTokenRepository)),
+		wire.Bind(new(usecase.RPRAccountMailer), new(*notifier.AccountMailer)),
+
+		// Usecase provider
+		usecase.NewRequestPasswordResetUsecase,
+		wire.Bind(new(handler.RequestPasswordResetUseCase), new(*usecase.RequestPasswordResetUsecase)),
+
+		// Handler provider
+		handler.NewRequestPasswordResetHandler,
+	)
+	return &handler.RequestPasswordResetHandler{}
+}
+
+// InitializeResetPasswordHandler はResetPasswordHandlerとその依存関係を初期化します
+func InitializeResetPasswordHandler(queries db.Querier, txManager *sqlc.TxManager, hasher *password.Hasher) *handler.ResetPasswordHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.CredentialQuerier), new(db.Querier)),
+		wire.Bind(new(repository.AccountTokenQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		repository.NewCredentialRepository,
+		repository.NewAccountTokenRepository,
+		repository.NewSessionRepository,
+		wire.Bind(new(usecase.RSPAccountTokenRepository), new(*repository.AccountTokenRepository)),
+		wire.Bind(new(usecase.RSPCredentialRepository), new(*repository.CredentialRepository)),
+		wire.Bind(new(usecase.RSPPasswordHasher), new(*password.Hasher)),
+		wire.Bind(new(usecase.RSPSessionRepository), new(*repository.SessionRepository)),
+		wire.Bind(new(usecase.RSPTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewResetPasswordUsecase,
+		wire.Bind(new(handler.ResetPasswordUseCase), new(*usecase.ResetPasswordUsecase)),
+
+		// Handler provider
+		handler.NewResetPasswordHandler,
+	)
+	return &handler.ResetPasswordHandler{}
+}
+
+// InitializeStartOIDCLogInHandler はStartOIDCLogInHandlerとその依存関係を初期化します
+func InitializeStartOIDCLogInHandler(queries db.Querier, idp *oidc.Registry) *handler.StartOIDCLogInHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.OIDCAuthRequestQuerier), new(db.Querier)),
+		repository.NewOIDCAuthRequestRepository,
+		wire.Bind(new(usecase.SOLAuthRequestRepository), new(*repository.OIDCAuthRequestRepository)),
+		wire.Bind(new(usecase.SOLIdentityProvider), new(*oidc.Registry)),
+
+		// Usecase provider
+		usecase.NewStartOIDCLogInUsecase,
+		wire.Bind(new(handler.StartOIDCLogInUseCase), new(*usecase.StartOIDCLogInUsecase)),
+
+		// Handler provider
+		handler.NewStartOIDCLogInHandler,
+	)
+	return &handler.StartOIDCLogInHandler{}
+}
+
+// InitializeCompleteOIDCLogInHandler はCompleteOIDCLogInHandlerとその依存関係を初期化します
+func InitializeCompleteOIDCLogInHandler(queries db.Querier, txManager *sqlc.TxManager, idp *oidc.Registry, signer *auth.Signer) *handler.CompleteOIDCLogInHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.OIDCAuthRequestQuerier), new(db.Querier)),
+		wire.Bind(new(repository.IdentityQuerier), new(db.Querier)),
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.RefreshTokenQuerier), new(db.Querier)),
+		repository.NewOIDCAuthRequestRepository,
+		repository.NewIdentityRepository,
+		repository.NewUserRepository,
+		repository.NewSessionRepository,
+		repository.NewRefreshTokenRepository,
+		wire.Bind(new(usecase.COLAuthRequestRepository), new(*repository.OIDCAuthRequestRepository)),
+		wire.Bind(new(usecase.COLIdentityProvider), new(*oidc.Registry)),
+		wire.Bind(new(usecase.COLIdentityRepository), new(*repository.IdentityRepository)),
+		wire.Bind(new(usecase.COLUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(usecase.COLTokenSigner), new(*auth.Signer)),
+		wire.Bind(new(usecase.COLTxManager), new(*sqlc.TxManager)),
+		wire.Bind(new(usecase.COLSessionRepository), new(*repository.SessionRepository)),
+		wire.Bind(new(usecase.COLRefreshTokenRepository), new(*repository.RefreshTokenRepository)),
+
+		// Usecase provider
+		usecase.NewCompleteOIDCLogInUsecase,
+		wire.Bind(new(handler.CompleteOIDCLogInUseCase), new(*usecase.CompleteOIDCLogInUsecase)),
+
+		// Handler provider
+		handler.NewCompleteOIDCLogInHandler,
+	)
+	return &handler.CompleteOIDCLogInHandler{}
+}
+
+// InitializeRefreshSessionHandler はRefreshSessionHandlerとその依存関係を初期化します
+func InitializeRefreshSessionHandler(queries db.Querier, txManager *sqlc.TxManager, signer *auth.Signer) *handler.RefreshSessionHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.RefreshTokenQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		repository.NewRefreshTokenRepository,
+		repository.NewSessionRepository,
+		repository.NewUserRepository,
+		wire.Bind(new(usecase.RFSRefreshTokenRepository), new(*repository.RefreshTokenRepository)),
+		wire.Bind(new(usecase.RFSSessionRepository), new(*repository.SessionRepository)),
+		wire.Bind(new(usecase.RFSUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(usecase.RFSTokenSigner), new(*auth.Signer)),
+		wire.Bind(new(usecase.RFSTxManager), new(*sqlc.TxManager)),
+
+		// Usecase provider
+		usecase.NewRefreshSessionUsecase,
+		wire.Bind(new(handler.RefreshSessionUseCase), new(*usecase.RefreshSessionUsecase)),
+
+		// Handler provider
+		handler.NewRefreshSessionHandler,
+	)
+	return &handler.RefreshSessionHandler{}
+}
+
+// InitializeLogOutHandler はLogOutHandlerとその依存関係を初期化します
+func InitializeLogOutHandler(queries db.Querier) *handler.LogOutHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.RefreshTokenQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		repository.NewRefreshTokenRepository,
+		repository.NewSessionRepository,
+		wire.Bind(new(usecase.LORefreshTokenRepository), new(*repository.RefreshTokenRepository)),
+		wire.Bind(new(usecase.LOSessionRepository), new(*repository.SessionRepository)),
+
+		// Usecase provider
+		usecase.NewLogOutUsecase,
+		wire.Bind(new(handler.LogOutUseCase), new(*usecase.LogOutUsecase)),
+
+		// Handler provider
+		handler.NewLogOutHandler,
+	)
+	return &handler.LogOutHandler{}
+}
+
+// InitializeListSessionsHandler はListSessionsHandlerとその依存関係を初期化します
+func InitializeListSessionsHandler(queries db.Querier) *handler.ListSessionsHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		repository.NewSessionRepository,
+		wire.Bind(new(usecase.LSSessionRepository), new(*repository.SessionRepository)),
+
+		// Usecase provider
+		usecase.NewListSessionsUsecase,
+		wire.Bind(new(handler.ListSessionsUseCase), new(*usecase.ListSessionsUsecase)),
+
+		// Handler provider
+		handler.NewListSessionsHandler,
+	)
+	return &handler.ListSessionsHandler{}
+}
+
+// InitializeLogOutEverywhereHandler はLogOutEverywhereHandlerとその依存関係を初期化します
+func InitializeLogOutEverywhereHandler(queries db.Querier) *handler.LogOutEverywhereHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		repository.NewSessionRepository,
+		wire.Bind(new(usecase.LOESessionRepository), new(*repository.SessionRepository)),
+
+		// Usecase provider
+		usecase.NewLogOutEverywhereUsecase,
+		wire.Bind(new(handler.LogOutEverywhereUseCase), new(*usecase.LogOutEverywhereUsecase)),
+
+		// Handler provider
+		handler.NewLogOutEverywhereHandler,
+	)
+	return &handler.LogOutEverywhereHandler{}
+}
+
+// InitializeForceLogOutHandler はForceLogOutHandlerとその依存関係を初期化します
+func InitializeForceLogOutHandler(queries db.Querier) *handler.ForceLogOutHandler {
+	wire.Build(
+		// Repository provider
+		wire.Bind(new(repository.UserQuerier), new(db.Querier)),
+		wire.Bind(new(repository.SessionQuerier), new(db.Querier)),
+		repository.NewUserRepository,
+		repository.NewSessionRepository,
+		wire.Bind(new(usecase.FLOUserRepository), new(*repository.UserRepository)),
+		wire.Bind(new(usecase.FLOSessionRepository), new(*repository.SessionRepository)),
+
+		// Usecase provider
+		usecase.NewForceLogOutUsecase,
+		wire.Bind(new(handler.ForceLogOutUseCase), new(*usecase.ForceLogOutUsecase)),
+
+		// Handler provider
+		handler.NewForceLogOutHandler,
+	)
+	return &handler.ForceLogOutHandler{}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+)
+
+// accountTokenFinder はハッシュからワンタイムトークンを取得する
+type accountTokenFinder interface {
+	FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error)
+}
+
+// findUsableAccountToken はメールで送ったトークン文字列から、指定した用途で使用できるトークンを取得して使用済みにする
+// 存在しない・用途が異なる・使用済み・期限切れの場合は、理由を区別せずにバリデーションエラーを返す
+func findUsableAccountToken(ctx context.Context, repo accountTokenFinder, raw string, purpose entity.TokenPurpose, now time.Time) (*entity.AccountToken, error) {
+	token, err := repo.FindByHash(ctx, entity.HashAccountToken(raw))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errs.NewValidationError("invalid or expired token", err)
+		}
+		return nil, fmt.Errorf("failed to find account token: %w", err)
+	}
+
+	if err := token.Use(purpose, now); err != nil {
+		return nil, errs.NewValidationError("invalid or expired token", err)
+	}
+	return token, nil
+}
+
+// isNotFound はリソースが見つからないことを表すエラーかどうかを返す
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
+
+// isConflict はリソースが競合したことを表すエラーかどうかを返す
+func isConflict(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrConflict
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/oidc"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// CompleteOIDCLogInParams は外部IDプロバイダーからのコールバックで受け取った値
+type CompleteOIDCLogInParams struct {
+	Provider  string
+	Code      string
+	State     string
+	UserAgent string
+	IPAddress string
+}
+
+type COLAuthRequestRepository interface {
+	Consume(ctx context.Context, stateHash string) (*entity.OIDCAuthRequest, error)
+}
+
+type COLIdentityProvider interface {
+	Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*oidc.Identity, error)
+}
+
+type COLIdentityRepository interface {
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.Identity, error)
+	Create(ctx context.Context, identity *entity.Identity) error
+}
+
+type COLUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+	Create(ctx context.Context, user *entity.User) error
+}
+
+type COLTokenSigner interface {
+	Sign(userID id.UserID, userRole role.Role) (string, time.Time, error)
+}
+
+type COLSessionRepository interface {
+	Create(ctx context.Context, session *entity.Session) error
+}
+
+type COLRefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+}
+
+type COLTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type CompleteOIDCLogInUsecase struct {
+	authRequestRepo COLAuthRequestRepository
+	idp             COLIdentityProvider
+	identityRepo    COLIdentityRepository
+	userRepo        COLUserRepository
+	txManager       COLTxManager
+	sessions        sessionStarter
+}
+
+func NewCompleteOIDCLogInUsecase(
+	authRequestRepo COLAuthRequestRepository,
+	idp COLIdentityProvider,
+	identityRepo COLIdentityRepository,
+	userRepo COLUserRepository,
+	signer COLTokenSigner,
+	txManager COLTxManager,
+	sessionRepo COLSessionRepository,
+	refreshTokenRepo COLRefreshTokenRepository,
+) *CompleteOIDCLogInUsecase {
+	return &CompleteOIDCLogInUsecase{
+		authRequestRepo: authRequestRepo,
+		idp:             idp,
+		identityRepo:    identityRepo,
+		userRepo:        userRepo,
+		txManager:       txManager,
+		sessions: sessionStarter{
+			sessionRepo:      sessionRepo,
+			refreshTokenRepo: refreshTokenRepo,
+			txManager:        txManager,
+			signer:           signer,
+		},
+	}
+}
+
+// Execute は認可コードを交換してIDトークンを検証し、紐付いたユーザーのセッションを開始してアクセストークンとリフレッシュトークンを発行
+// 初めてログインするアカウントの場合は、新しいユーザーを作成して紐付ける
+// 同じメールアドレスのユーザーが登録済みでも自動では紐付けない（プロバイダーのメールアドレスの確認を信頼しないため）
+func (u *CompleteOIDCLogInUsecase) Execute(ctx context.Context, params CompleteOIDCLogInParams) (*LogInResult, error) {
+	// state は1回のみ使用できるよう、検証の前に削除する
+	authRequest, err := u.authRequestRepo.Consume(ctx, entity.HashOIDCState(params.State))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errs.NewValidationError("invalid or expired state", err)
+		}
+		return nil, fmt.Errorf("failed to consume oidc auth request: %w", err)
+	}
+	if err := authRequest.Verify(params.Provider, time.Now()); err != nil {
+		return nil, errs.NewValidationError("invalid or expired state", err)
+	}
+
+	external, err := u.idp.Exchange(ctx, params.Provider, params.Code, authRequest.CodeVerifier(), authRequest.Nonce())
+	if err != nil {
+		switch {
+		case errors.Is(err, oidc.ErrInvalidGrant), errors.Is(err, oidc.ErrInvalidIDToken):
+			return nil, errs.NewUnauthorizedError("failed to authenticate with the identity provider", err)
+		case errors.Is(err, oidc.ErrUnknownProvider):
+			return nil, errs.NewNotFoundError("identity provider not found", err)
+		default:
+			return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+		}
+	}
+
+	userID, err := u.findOrCreateUser(ctx, params.Provider, external)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user.IsDisabled() {
+		return nil, errs.NewForbiddenError("user is disabled", nil)
+	}
+
+	return u.sessions.start(ctx, user, params.UserAgent, params.IPAddress, time.Now())
+}
+
+// findOrCreateUser はアカウントに紐付いたユーザーのIDを返す。紐付いていない場合はユーザーを作成して紐付ける
+// 同じアカウントで同時にログインして紐付けが競合した場合は、先に作成された紐付けを使用する
+func (u *CompleteOIDCLogInUsecase) findOrCreateUser(ctx context.Context, provider string, external *oidc.Identity) (id.UserID, error) {
+	identity, err := u.identityRepo.FindByProviderSubject(ctx, provider, external.Subject)
+	if err == nil {
+		return identity.UserID(), nil
+	}
+	if !isNotFound(err) {
+		return id.UserID{}, fmt.Errorf("failed to find identity: %w", err)
+	}
+
+	user, err := entity.NewUser(id.NewUserID(), entity.ExternalDisplayName(external.Name, external.Email))
+	if err != nil {
+		return id.UserID{}, fmt.Errorf("failed to create user entity: %w", err)
+	}
+	identity, err = entity.NewIdentity(provider, external.Subject, user.ID(), external.Email)
+	if err != nil {
+		return id.UserID{}, errs.NewUnauthorizedError("failed to authenticate with the identity provider", err)
+	}
+
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.userRepo.Create(ctx, user); err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		return u.identityRepo.Create(ctx, identity)
+	})
+	if err == nil {
+		return user.ID(), nil
+	}
+	if !isConflict(err) {
+		return id.UserID{}, fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	identity, err = u.identityRepo.FindByProviderSubject(ctx, provider, external.Subject)
+	if err != nil {
+		return id.UserID{}, fmt.Errorf("failed to find identity: %w", err)
+	}
+	return identity.UserID(), nil
+}
@@ -0,0 +1,360 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/complete_oidc_log_in_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/complete_oidc_log_in_usecase.go -destination=./apps/user/internal/application/usecase/complete_oidc_log_in_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	oidc "poketier/pkg/oidc"
+	id "poketier/pkg/vo/id"
+	role "poketier/pkg/vo/role"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCOLAuthRequestRepository is a mock of COLAuthRequestRepository interface.
+type MockCOLAuthRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLAuthRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLAuthRequestRepositoryMockRecorder is the mock recorder for MockCOLAuthRequestRepository.
+type MockCOLAuthRequestRepositoryMockRecorder struct {
+	mock *MockCOLAuthRequestRepository
+}
+
+// NewMockCOLAuthRequestRepository creates a new mock instance.
+func NewMockCOLAuthRequestRepository(ctrl *gomock.Controller) *MockCOLAuthRequestRepository {
+	mock := &MockCOLAuthRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockCOLAuthRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLAuthRequestRepository) EXPECT() *MockCOLAuthRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockCOLAuthRequestRepository) Consume(ctx context.Context, stateHash string) (*entity.OIDCAuthRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, stateHash)
+	ret0, _ := ret[0].(*entity.OIDCAuthRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockCOLAuthRequestRepositoryMockRecorder) Consume(ctx, stateHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockCOLAuthRequestRepository)(nil).Consume), ctx, stateHash)
+}
+
+// MockCOLIdentityProvider is a mock of COLIdentityProvider interface.
+type MockCOLIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLIdentityProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLIdentityProviderMockRecorder is the mock recorder for MockCOLIdentityProvider.
+type MockCOLIdentityProviderMockRecorder struct {
+	mock *MockCOLIdentityProvider
+}
+
+// NewMockCOLIdentityProvider creates a new mock instance.
+func NewMockCOLIdentityProvider(ctrl *gomock.Controller) *MockCOLIdentityProvider {
+	mock := &MockCOLIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockCOLIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLIdentityProvider) EXPECT() *MockCOLIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// Exchange mocks base method.
+func (m *MockCOLIdentityProvider) Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*oidc.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, provider, code, codeVerifier, nonce)
+	ret0, _ := ret[0].(*oidc.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockCOLIdentityProviderMockRecorder) Exchange(ctx, provider, code, codeVerifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockCOLIdentityProvider)(nil).Exchange), ctx, provider, code, codeVerifier, nonce)
+}
+
+// MockCOLIdentityRepository is a mock of COLIdentityRepository interface.
+type MockCOLIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLIdentityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLIdentityRepositoryMockRecorder is the mock recorder for MockCOLIdentityRepository.
+type MockCOLIdentityRepositoryMockRecorder struct {
+	mock *MockCOLIdentityRepository
+}
+
+// NewMockCOLIdentityRepository creates a new mock instance.
+func NewMockCOLIdentityRepository(ctrl *gomock.Controller) *MockCOLIdentityRepository {
+	mock := &MockCOLIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockCOLIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLIdentityRepository) EXPECT() *MockCOLIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCOLIdentityRepository) Create(ctx context.Context, identity *entity.Identity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCOLIdentityRepositoryMockRecorder) Create(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCOLIdentityRepository)(nil).Create), ctx, identity)
+}
+
+// FindByProviderSubject mocks base method.
+func (m *MockCOLIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProviderSubject", ctx, provider, subject)
+	ret0, _ := ret[0].(*entity.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProviderSubject indicates an expected call of FindByProviderSubject.
+func (mr *MockCOLIdentityRepositoryMockRecorder) FindByProviderSubject(ctx, provider, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProviderSubject", reflect.TypeOf((*MockCOLIdentityRepository)(nil).FindByProviderSubject), ctx, provider, subject)
+}
+
+// MockCOLUserRepository is a mock of COLUserRepository interface.
+type MockCOLUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLUserRepositoryMockRecorder is the mock recorder for MockCOLUserRepository.
+type MockCOLUserRepositoryMockRecorder struct {
+	mock *MockCOLUserRepository
+}
+
+// NewMockCOLUserRepository creates a new mock instance.
+func NewMockCOLUserRepository(ctrl *gomock.Controller) *MockCOLUserRepository {
+	mock := &MockCOLUserRepository{ctrl: ctrl}
+	mock.recorder = &MockCOLUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLUserRepository) EXPECT() *MockCOLUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCOLUserRepository) Create(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCOLUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCOLUserRepository)(nil).Create), ctx, user)
+}
+
+// FindByID mocks base method.
+func (m *MockCOLUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCOLUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCOLUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// MockCOLTokenSigner is a mock of COLTokenSigner interface.
+type MockCOLTokenSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLTokenSignerMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLTokenSignerMockRecorder is the mock recorder for MockCOLTokenSigner.
+type MockCOLTokenSignerMockRecorder struct {
+	mock *MockCOLTokenSigner
+}
+
+// NewMockCOLTokenSigner creates a new mock instance.
+func NewMockCOLTokenSigner(ctrl *gomock.Controller) *MockCOLTokenSigner {
+	mock := &MockCOLTokenSigner{ctrl: ctrl}
+	mock.recorder = &MockCOLTokenSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLTokenSigner) EXPECT() *MockCOLTokenSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockCOLTokenSigner) Sign(userID id.UserID, userRole role.Role) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", userID, userRole)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockCOLTokenSignerMockRecorder) Sign(userID, userRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockCOLTokenSigner)(nil).Sign), userID, userRole)
+}
+
+// MockCOLSessionRepository is a mock of COLSessionRepository interface.
+type MockCOLSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLSessionRepositoryMockRecorder is the mock recorder for MockCOLSessionRepository.
+type MockCOLSessionRepositoryMockRecorder struct {
+	mock *MockCOLSessionRepository
+}
+
+// NewMockCOLSessionRepository creates a new mock instance.
+func NewMockCOLSessionRepository(ctrl *gomock.Controller) *MockCOLSessionRepository {
+	mock := &MockCOLSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockCOLSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLSessionRepository) EXPECT() *MockCOLSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCOLSessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCOLSessionRepositoryMockRecorder) Create(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCOLSessionRepository)(nil).Create), ctx, session)
+}
+
+// MockCOLRefreshTokenRepository is a mock of COLRefreshTokenRepository interface.
+type MockCOLRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLRefreshTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLRefreshTokenRepositoryMockRecorder is the mock recorder for MockCOLRefreshTokenRepository.
+type MockCOLRefreshTokenRepositoryMockRecorder struct {
+	mock *MockCOLRefreshTokenRepository
+}
+
+// NewMockCOLRefreshTokenRepository creates a new mock instance.
+func NewMockCOLRefreshTokenRepository(ctrl *gomock.Controller) *MockCOLRefreshTokenRepository {
+	mock := &MockCOLRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockCOLRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLRefreshTokenRepository) EXPECT() *MockCOLRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCOLRefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCOLRefreshTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCOLRefreshTokenRepository)(nil).Create), ctx, token)
+}
+
+// MockCOLTxManager is a mock of COLTxManager interface.
+type MockCOLTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCOLTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCOLTxManagerMockRecorder is the mock recorder for MockCOLTxManager.
+type MockCOLTxManagerMockRecorder struct {
+	mock *MockCOLTxManager
+}
+
+// NewMockCOLTxManager creates a new mock instance.
+func NewMockCOLTxManager(ctrl *gomock.Controller) *MockCOLTxManager {
+	mock := &MockCOLTxManager{ctrl: ctrl}
+	mock.recorder = &MockCOLTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCOLTxManager) EXPECT() *MockCOLTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockCOLTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockCOLTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockCOLTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,263 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/oidc"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompleteOIDCLogInUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		authRequestRepo  *MockCOLAuthRequestRepository
+		idp              *MockCOLIdentityProvider
+		identityRepo     *MockCOLIdentityRepository
+		userRepo         *MockCOLUserRepository
+		signer           *MockCOLTokenSigner
+		txManager        *MockCOLTxManager
+		sessionRepo      *MockCOLSessionRepository
+		refreshTokenRepo *MockCOLRefreshTokenRepository
+	}
+
+	// runInTx はトランザクション内の処理をそのまま実行させる
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+	// startSession はログインしたユーザーのセッションとリフレッシュトークンが保存されることを期待する
+	startSession := func(m mocks) {
+		runInTx(m)
+		m.sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session *entity.Session) error {
+			assert.Equal(t, "Mozilla/5.0", session.UserAgent(), "user agent does not match")
+			assert.Equal(t, "198.51.100.7", session.IPAddress(), "ip address does not match")
+			return nil
+		})
+		m.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	params := usecase.CompleteOIDCLogInParams{Provider: "mock", Code: "code", State: "state", UserAgent: "Mozilla/5.0", IPAddress: "198.51.100.7"}
+	stateHash := entity.HashOIDCState("state")
+	validRequest := func() *entity.OIDCAuthRequest {
+		return entity.ReconstructOIDCAuthRequest(stateHash, "mock", "verifier", "nonce", time.Now().Add(time.Minute))
+	}
+	external := &oidc.Identity{Subject: "ash", Email: "ash@example.com", EmailVerified: true, Name: "サトシ"}
+	userID := id.NewUserID()
+	user, err := entity.ReconstructUser(userID, "サトシ", role.User, nil, time.Now(), time.Now())
+	require.NoError(t, err, "failed to create user")
+	disabledAt := time.Now()
+	disabledUser, err := entity.ReconstructUser(userID, "サトシ", role.User, &disabledAt, time.Now(), time.Now())
+	require.NoError(t, err, "failed to create user")
+	linked := entity.ReconstructIdentity("mock", "ash", userID, "ash@example.com")
+	expiresAt := time.Date(2025, 8, 1, 12, 15, 0, 0, time.UTC)
+	notFound := errs.NewNotFoundError("identity not found", nil)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: 紐付け済みのアカウントの場合、紐付いたユーザーのセッションを開始してトークンを発行する",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(external, nil)
+				m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(linked, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(user, nil)
+				startSession(m)
+				m.signer.EXPECT().Sign(userID, role.User).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "正常系: 初めてのアカウントの場合、ユーザーを作成して紐付ける",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(external, nil)
+				m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(nil, notFound)
+				runInTx(m)
+				var createdID id.UserID
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, created *entity.User) error {
+					createdID = created.ID()
+					assert.Equal(t, "サトシ", created.DisplayName(), "display name should come from the name claim")
+					assert.Equal(t, role.User, created.Role(), "role should be user")
+					return nil
+				})
+				m.identityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, identity *entity.Identity) error {
+					assert.Equal(t, createdID, identity.UserID(), "identity should be linked to the created user")
+					assert.Equal(t, "mock", identity.Provider(), "provider does not match")
+					assert.Equal(t, "ash", identity.Subject(), "subject does not match")
+					assert.Equal(t, "ash@example.com", identity.Email(), "email does not match")
+					return nil
+				})
+				m.userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, got id.UserID) (*entity.User, error) {
+					assert.Equal(t, createdID, got, "should find the created user")
+					return entity.ReconstructUser(got, "サトシ", role.User, nil, time.Now(), time.Now())
+				})
+				startSession(m)
+				m.signer.EXPECT().Sign(gomock.Any(), role.User).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "正常系: 同時ログインで紐付けが競合した場合、先に作成された紐付けを使用する",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(external, nil)
+				gomock.InOrder(
+					m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(nil, notFound),
+					m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(linked, nil),
+				)
+				runInTx(m)
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.identityRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errs.NewConflictError("identity is already linked", nil))
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(user, nil)
+				startSession(m)
+				m.signer.EXPECT().Sign(userID, role.User).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "異常系: state が存在しない・使用済みの場合、バリデーションエラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(nil, errs.NewNotFoundError("oidc auth request not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired state",
+		},
+		{
+			caseName: "異常系: state が期限切れの場合、バリデーションエラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(
+					entity.ReconstructOIDCAuthRequest(stateHash, "mock", "verifier", "nonce", time.Now().Add(-time.Second)), nil,
+				)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired state",
+		},
+		{
+			caseName: "異常系: 別のプロバイダーで開始した state の場合、バリデーションエラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(
+					entity.ReconstructOIDCAuthRequest(stateHash, "google", "verifier", "nonce", time.Now().Add(time.Minute)), nil,
+				)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired state",
+		},
+		{
+			caseName: "異常系: 認可コードが不正な場合、認証エラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(nil, oidc.ErrInvalidGrant)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "failed to authenticate with the identity provider",
+		},
+		{
+			caseName: "異常系: IDトークンの検証に失敗した場合、認証エラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(nil, fmt.Errorf("%w: nonce does not match", oidc.ErrInvalidIDToken))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "failed to authenticate with the identity provider",
+		},
+		{
+			caseName: "異常系: プロバイダーとの通信に失敗した場合、エラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(nil, errors.New("connection refused"))
+			},
+			wantErr:     true,
+			errContains: "failed to exchange authorization code",
+		},
+		{
+			caseName: "異常系: ユーザーが無効化されている場合、Forbiddenエラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(external, nil)
+				m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(linked, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(disabledUser, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+			errContains: "user is disabled",
+		},
+		{
+			caseName: "異常系: ユーザーの作成に失敗した場合、エラーを返す",
+			setupMock: func(m mocks) {
+				m.authRequestRepo.EXPECT().Consume(gomock.Any(), stateHash).Return(validRequest(), nil)
+				m.idp.EXPECT().Exchange(gomock.Any(), "mock", "code", "verifier", "nonce").Return(external, nil)
+				m.identityRepo.EXPECT().FindByProviderSubject(gomock.Any(), "mock", "ash").Return(nil, notFound)
+				runInTx(m)
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to link identity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				authRequestRepo:  NewMockCOLAuthRequestRepository(ctrl),
+				idp:              NewMockCOLIdentityProvider(ctrl),
+				identityRepo:     NewMockCOLIdentityRepository(ctrl),
+				userRepo:         NewMockCOLUserRepository(ctrl),
+				signer:           NewMockCOLTokenSigner(ctrl),
+				txManager:        NewMockCOLTxManager(ctrl),
+				sessionRepo:      NewMockCOLSessionRepository(ctrl),
+				refreshTokenRepo: NewMockCOLRefreshTokenRepository(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewCompleteOIDCLogInUsecase(m.authRequestRepo, m.idp, m.identityRepo, m.userRepo, m.signer, m.txManager, m.sessionRepo, m.refreshTokenRepo)
+
+			// Act
+			got, err := uc.Execute(context.Background(), params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "access-token", got.AccessToken, "access token does not match")
+			assert.Equal(t, expiresAt, got.ExpiresAt, "expires at does not match")
+			assert.NotEmpty(t, got.RefreshToken, "refresh token should be issued")
+			assert.True(t, got.RefreshTokenExpiresAt.After(time.Now()), "refresh token should not be expired")
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// ForceLogOutParams は管理者による強制ログアウトの入力
+// ActorRole は操作する利用者の権限で、管理者でなければ実行できない
+type ForceLogOutParams struct {
+	UserID    string
+	ActorRole role.Role
+}
+
+type FLOUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+}
+
+type FLOSessionRepository interface {
+	RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error)
+}
+
+type ForceLogOutUsecase struct {
+	userRepo    FLOUserRepository
+	sessionRepo FLOSessionRepository
+}
+
+func NewForceLogOutUsecase(userRepo FLOUserRepository, sessionRepo FLOSessionRepository) *ForceLogOutUsecase {
+	return &ForceLogOutUsecase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute は指定したユーザーのセッションをすべて失効させる
+func (u *ForceLogOutUsecase) Execute(ctx context.Context, params ForceLogOutParams) error {
+	if err := policy.Authorize(params.ActorRole, policy.ManageUsers); err != nil {
+		return err
+	}
+
+	userID, err := id.UserIDFromString(params.UserID)
+	if err != nil {
+		return errs.NewValidationError("invalid user id", err)
+	}
+
+	if _, err := u.userRepo.FindByID(ctx, userID); err != nil {
+		if isNotFound(err) {
+			return err
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if _, err := u.sessionRepo.RevokeAllByUserID(ctx, userID, entity.SessionRevokeReasonAdmin, time.Now()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/force_log_out_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/force_log_out_usecase.go -destination=./apps/user/internal/application/usecase/force_log_out_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFLOUserRepository is a mock of FLOUserRepository interface.
+type MockFLOUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFLOUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFLOUserRepositoryMockRecorder is the mock recorder for MockFLOUserRepository.
+type MockFLOUserRepositoryMockRecorder struct {
+	mock *MockFLOUserRepository
+}
+
+// NewMockFLOUserRepository creates a new mock instance.
+func NewMockFLOUserRepository(ctrl *gomock.Controller) *MockFLOUserRepository {
+	mock := &MockFLOUserRepository{ctrl: ctrl}
+	mock.recorder = &MockFLOUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFLOUserRepository) EXPECT() *MockFLOUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockFLOUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockFLOUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockFLOUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// MockFLOSessionRepository is a mock of FLOSessionRepository interface.
+type MockFLOSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFLOSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFLOSessionRepositoryMockRecorder is the mock recorder for MockFLOSessionRepository.
+type MockFLOSessionRepositoryMockRecorder struct {
+	mock *MockFLOSessionRepository
+}
+
+// NewMockFLOSessionRepository creates a new mock instance.
+func NewMockFLOSessionRepository(ctrl *gomock.Controller) *MockFLOSessionRepository {
+	mock := &MockFLOSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockFLOSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFLOSessionRepository) EXPECT() *MockFLOSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RevokeAllByUserID mocks base method.
+func (m *MockFLOSessionRepository) RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserID", ctx, userID, reason, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllByUserID indicates an expected call of RevokeAllByUserID.
+func (mr *MockFLOSessionRepositoryMockRecorder) RevokeAllByUserID(ctx, userID, reason, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserID", reflect.TypeOf((*MockFLOSessionRepository)(nil).RevokeAllByUserID), ctx, userID, reason, now)
+}
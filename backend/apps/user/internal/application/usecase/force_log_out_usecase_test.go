@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestForceLogOutUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		userRepo    *MockFLOUserRepository
+		sessionRepo *MockFLOSessionRepository
+	}
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName    string
+		params      usecase.ForceLogOutParams
+		setupMock   func(t *testing.T, m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: 管理者は指定したユーザーのセッションをすべて失効させられる",
+			params:   usecase.ForceLogOutParams{UserID: userID.String(), ActorRole: role.Admin},
+			setupMock: func(t *testing.T, m mocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				m.sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonAdmin, gomock.Any()).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName:    "異常系: モデレーターの場合、Forbiddenエラーを返す",
+			params:      usecase.ForceLogOutParams{UserID: userID.String(), ActorRole: role.Moderator},
+			setupMock:   func(t *testing.T, m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+		},
+		{
+			caseName:    "異常系: ユーザーIDの形式が不正な場合、バリデーションエラーを返す",
+			params:      usecase.ForceLogOutParams{UserID: "not-a-uuid", ActorRole: role.Admin},
+			setupMock:   func(t *testing.T, m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid user id",
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、NotFoundエラーを返す",
+			params:   usecase.ForceLogOutParams{UserID: userID.String(), ActorRole: role.Admin},
+			setupMock: func(t *testing.T, m mocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(nil, errs.NewNotFoundError("user not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+			errContains: "user not found",
+		},
+		{
+			caseName: "異常系: セッションの失効に失敗した場合、エラーを返す",
+			params:   usecase.ForceLogOutParams{UserID: userID.String(), ActorRole: role.Admin},
+			setupMock: func(t *testing.T, m mocks) {
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				m.sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonAdmin, gomock.Any()).Return(int64(0), errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to revoke sessions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				userRepo:    NewMockFLOUserRepository(ctrl),
+				sessionRepo: NewMockFLOSessionRepository(ctrl),
+			}
+			tt.setupMock(t, m)
+			uc := usecase.NewForceLogOutUsecase(m.userRepo, m.sessionRepo)
+
+			// Act
+			err := uc.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
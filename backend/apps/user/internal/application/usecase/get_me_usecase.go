@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// GetMeParams はログイン中のユーザーの取得の入力
+type GetMeParams struct {
+	UserID id.UserID
+}
+
+// GetMeResult はログイン中のユーザーのアカウント情報
+type GetMeResult struct {
+	UserID      string
+	DisplayName string
+	Role        string
+	CreatedAt   time.Time
+}
+
+type GMUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+}
+
+type GetMeUsecase struct {
+	userRepo GMUserRepository
+}
+
+func NewGetMeUsecase(userRepo GMUserRepository) *GetMeUsecase {
+	return &GetMeUsecase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute はログイン中のユーザーを取得。無効化されたユーザーは利用できない
+func (u *GetMeUsecase) Execute(ctx context.Context, params GetMeParams) (*GetMeResult, error) {
+	user, err := u.userRepo.FindByID(ctx, params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if user.IsDisabled() {
+		return nil, errs.NewForbiddenError("user is disabled", nil)
+	}
+
+	return &GetMeResult{
+		UserID:      user.ID().String(),
+		DisplayName: user.DisplayName(),
+		Role:        user.Role().String(),
+		CreatedAt:   user.CreatedAt(),
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/get_me_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/get_me_usecase.go -destination=./apps/user/internal/application/usecase/get_me_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGMUserRepository is a mock of GMUserRepository interface.
+type MockGMUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGMUserRepositoryMockRecorder is the mock recorder for MockGMUserRepository.
+type MockGMUserRepositoryMockRecorder struct {
+	mock *MockGMUserRepository
+}
+
+// NewMockGMUserRepository creates a new mock instance.
+func NewMockGMUserRepository(ctrl *gomock.Controller) *MockGMUserRepository {
+	mock := &MockGMUserRepository{ctrl: ctrl}
+	mock.recorder = &MockGMUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMUserRepository) EXPECT() *MockGMUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGMUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGMUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGMUserRepository)(nil).FindByID), ctx, userID)
+}
@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testCreatedAt  = time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	testDisabledAt = time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+)
+
+// newTestUser はテスト用のユーザーを作成する
+func newTestUser(t *testing.T, userID id.UserID, disabledAt *time.Time) *entity.User {
+	t.Helper()
+	user, err := entity.ReconstructUser(userID, "配信者A", role.Moderator, disabledAt, testCreatedAt, testCreatedAt)
+	assert.NoError(t, err, "failed to create user")
+	return user
+}
+
+func TestGetMeUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(t *testing.T, userRepo *MockGMUserRepository)
+		want        *usecase.GetMeResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: ログイン中のユーザーが返される",
+			setupMock: func(t *testing.T, userRepo *MockGMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+			},
+			want: &usecase.GetMeResult{
+				UserID:      userID.String(),
+				DisplayName: "配信者A",
+				Role:        "moderator",
+				CreatedAt:   testCreatedAt,
+			},
+		},
+		{
+			caseName: "異常系: 無効化されたユーザーの場合、Forbiddenエラーを返す",
+			setupMock: func(t *testing.T, userRepo *MockGMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, &testDisabledAt), nil)
+			},
+			wantErr:     true,
+			errContains: "user is disabled",
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、NotFoundエラーを返す",
+			setupMock: func(t *testing.T, userRepo *MockGMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(nil, errs.NewNotFoundError("user not found", nil))
+			},
+			wantErr:     true,
+			errContains: "user not found",
+		},
+		{
+			caseName: "異常系: ユーザーの取得でエラーが発生した場合、エラーを返す",
+			setupMock: func(t *testing.T, userRepo *MockGMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(nil, errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to find user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo := NewMockGMUserRepository(ctrl)
+			tt.setupMock(t, userRepo)
+
+			uc := usecase.NewGetMeUsecase(userRepo)
+
+			// Act
+			got, err := uc.Execute(context.Background(), usecase.GetMeParams{UserID: userID})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
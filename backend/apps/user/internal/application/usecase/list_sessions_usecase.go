@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+// ListSessionsParams はログイン中のユーザーのセッション一覧の取得の入力
+type ListSessionsParams struct {
+	UserID id.UserID
+}
+
+// SessionSummary は有効なセッションの情報
+type SessionSummary struct {
+	SessionID  string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// ListSessionsResult は有効なセッションを最後に使用した順に並べたもの
+type ListSessionsResult struct {
+	Sessions []SessionSummary
+}
+
+type LSSessionRepository interface {
+	ListActiveByUserID(ctx context.Context, userID id.UserID, now time.Time) ([]*entity.Session, error)
+}
+
+type ListSessionsUsecase struct {
+	sessionRepo LSSessionRepository
+}
+
+func NewListSessionsUsecase(sessionRepo LSSessionRepository) *ListSessionsUsecase {
+	return &ListSessionsUsecase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute はログイン中のユーザーの失効しておらず期限切れでもないセッションを取得
+func (u *ListSessionsUsecase) Execute(ctx context.Context, params ListSessionsParams) (*ListSessionsResult, error) {
+	sessions, err := u.sessionRepo.ListActiveByUserID(ctx, params.UserID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	summaries := make([]SessionSummary, 0, len(sessions))
+	for _, session := range sessions {
+		summaries = append(summaries, SessionSummary{
+			SessionID:  session.ID().String(),
+			UserAgent:  session.UserAgent(),
+			IPAddress:  session.IPAddress(),
+			CreatedAt:  session.CreatedAt(),
+			LastUsedAt: session.LastUsedAt(),
+			ExpiresAt:  session.ExpiresAt(),
+		})
+	}
+
+	return &ListSessionsResult{
+		Sessions: summaries,
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/list_sessions_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/list_sessions_usecase.go -destination=./apps/user/internal/application/usecase/list_sessions_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLSSessionRepository is a mock of LSSessionRepository interface.
+type MockLSSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLSSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLSSessionRepositoryMockRecorder is the mock recorder for MockLSSessionRepository.
+type MockLSSessionRepositoryMockRecorder struct {
+	mock *MockLSSessionRepository
+}
+
+// NewMockLSSessionRepository creates a new mock instance.
+func NewMockLSSessionRepository(ctrl *gomock.Controller) *MockLSSessionRepository {
+	mock := &MockLSSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLSSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLSSessionRepository) EXPECT() *MockLSSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListActiveByUserID mocks base method.
+func (m *MockLSSessionRepository) ListActiveByUserID(ctx context.Context, userID id.UserID, now time.Time) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUserID", ctx, userID, now)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUserID indicates an expected call of ListActiveByUserID.
+func (mr *MockLSSessionRepositoryMockRecorder) ListActiveByUserID(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUserID", reflect.TypeOf((*MockLSSessionRepository)(nil).ListActiveByUserID), ctx, userID, now)
+}
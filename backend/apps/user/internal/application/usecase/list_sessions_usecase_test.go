@@ -0,0 +1,96 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSessionsUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	sessionID := id.NewSessionID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	lastUsedAt := createdAt.Add(time.Hour)
+	expiresAt := lastUsedAt.Add(entity.SessionTTL)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(sessionRepo *MockLSSessionRepository)
+		want        *usecase.ListSessionsResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 有効なセッションが返される",
+			setupMock: func(sessionRepo *MockLSSessionRepository) {
+				sessionRepo.EXPECT().ListActiveByUserID(gomock.Any(), userID, gomock.Any()).Return([]*entity.Session{
+					entity.ReconstructSession(sessionID, userID, "Mozilla/5.0", "198.51.100.7", createdAt, lastUsedAt, expiresAt, nil, ""),
+				}, nil)
+			},
+			want: &usecase.ListSessionsResult{
+				Sessions: []usecase.SessionSummary{
+					{
+						SessionID:  sessionID.String(),
+						UserAgent:  "Mozilla/5.0",
+						IPAddress:  "198.51.100.7",
+						CreatedAt:  createdAt,
+						LastUsedAt: lastUsedAt,
+						ExpiresAt:  expiresAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "正常系: セッションがない場合、空の一覧が返される",
+			setupMock: func(sessionRepo *MockLSSessionRepository) {
+				sessionRepo.EXPECT().ListActiveByUserID(gomock.Any(), userID, gomock.Any()).Return(nil, nil)
+			},
+			want: &usecase.ListSessionsResult{Sessions: []usecase.SessionSummary{}},
+		},
+		{
+			caseName: "異常系: セッションの取得でエラーが発生した場合、エラーを返す",
+			setupMock: func(sessionRepo *MockLSSessionRepository) {
+				sessionRepo.EXPECT().ListActiveByUserID(gomock.Any(), userID, gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to list sessions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sessionRepo := NewMockLSSessionRepository(ctrl)
+			tt.setupMock(sessionRepo)
+			uc := usecase.NewListSessionsUsecase(sessionRepo)
+
+			// Act
+			got, err := uc.Execute(context.Background(), usecase.ListSessionsParams{UserID: userID})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// dummyPassword は未登録のメールアドレスでも照合にかかる時間を揃えるためのパスワード
+const dummyPassword = "poketier-dummy-password"
+
+// LogInParams はメールアドレス・パスワードでのログインの入力
+type LogInParams struct {
+	Email     string
+	Password  string
+	UserAgent string
+	IPAddress string
+}
+
+// LogInResult は発行したアクセストークンとリフレッシュトークン
+// リフレッシュトークンの有効期限はセッションの有効期限
+type LogInResult struct {
+	AccessToken           string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+type LICredentialRepository interface {
+	FindByEmail(ctx context.Context, email string) (*entity.Credential, error)
+	RecordLoginFailure(ctx context.Context, userID id.UserID, now time.Time) error
+	ResetLoginFailures(ctx context.Context, userID id.UserID) error
+}
+
+type LIUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+}
+
+type LIPasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+}
+
+type LITokenSigner interface {
+	Sign(userID id.UserID, userRole role.Role) (string, time.Time, error)
+}
+
+type LISessionRepository interface {
+	Create(ctx context.Context, session *entity.Session) error
+}
+
+type LIRefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+}
+
+type LITxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type LogInUsecase struct {
+	credentialRepo LICredentialRepository
+	userRepo       LIUserRepository
+	hasher         LIPasswordHasher
+	sessions       sessionStarter
+
+	dummyHashOnce sync.Once
+	dummyHash     string
+	dummyHashErr  error
+}
+
+func NewLogInUsecase(
+	credentialRepo LICredentialRepository,
+	userRepo LIUserRepository,
+	hasher LIPasswordHasher,
+	signer LITokenSigner,
+	sessionRepo LISessionRepository,
+	refreshTokenRepo LIRefreshTokenRepository,
+	txManager LITxManager,
+) *LogInUsecase {
+	return &LogInUsecase{
+		credentialRepo: credentialRepo,
+		userRepo:       userRepo,
+		hasher:         hasher,
+		sessions: sessionStarter{
+			sessionRepo:      sessionRepo,
+			refreshTokenRepo: refreshTokenRepo,
+			txManager:        txManager,
+			signer:           signer,
+		},
+	}
+}
+
+// Execute はメールアドレスとパスワードを照合し、セッションを開始してアクセストークンとリフレッシュトークンを発行
+// 連続して失敗した場合は一定期間ロックし、ロック中はパスワードが正しくてもログインできない
+// ロック中であることから登録済みのメールアドレスを推測されないよう、ロック中も未登録と同じエラーを返す
+// ログイン失敗の記録・リセットは失敗回数とロックの列のみを更新し、パスワードのハッシュは書き込まない
+// メールアドレスが未確認・ユーザーが無効化されている場合は、パスワードが正しくてもログインできない
+func (u *LogInUsecase) Execute(ctx context.Context, params LogInParams) (*LogInResult, error) {
+	email, err := entity.NormalizeEmail(params.Email)
+	if err != nil {
+		return nil, errs.NewUnauthorizedError("invalid email or password", err)
+	}
+
+	credential, err := u.credentialRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if isNotFound(err) {
+			// 応答時間から登録済みのメールアドレスを推測されないよう、未登録でもハッシュを照合する
+			if err := u.verifyDummy(params.Password); err != nil {
+				return nil, err
+			}
+			return nil, errs.NewUnauthorizedError("invalid email or password", nil)
+		}
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	now := time.Now()
+	if credential.IsLocked(now) {
+		// 応答時間からロック中であることを推測されないよう、未登録と同様にダミーのハッシュを照合する
+		if err := u.verifyDummy(params.Password); err != nil {
+			return nil, err
+		}
+		return nil, errs.NewUnauthorizedError("invalid email or password", nil)
+	}
+
+	ok, err := u.hasher.Verify(params.Password, credential.PasswordHash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !ok {
+		if err := u.credentialRepo.RecordLoginFailure(ctx, credential.UserID(), now); err != nil {
+			return nil, fmt.Errorf("failed to record login failure: %w", err)
+		}
+		return nil, errs.NewUnauthorizedError("invalid email or password", nil)
+	}
+
+	if credential.FailedLoginCount() > 0 || credential.LockedUntil() != nil {
+		if err := u.credentialRepo.ResetLoginFailures(ctx, credential.UserID()); err != nil {
+			return nil, fmt.Errorf("failed to record login success: %w", err)
+		}
+	}
+
+	if !credential.IsEmailVerified() {
+		return nil, errs.NewForbiddenError("email is not verified", nil)
+	}
+
+	user, err := u.userRepo.FindByID(ctx, credential.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user.IsDisabled() {
+		return nil, errs.NewForbiddenError("user is disabled", nil)
+	}
+
+	return u.sessions.start(ctx, user, params.UserAgent, params.IPAddress, now)
+}
+
+// verifyDummy はダミーのハッシュとパスワードを照合する（結果は使用しない）
+func (u *LogInUsecase) verifyDummy(password string) error {
+	u.dummyHashOnce.Do(func() {
+		u.dummyHash, u.dummyHashErr = u.hasher.Hash(dummyPassword)
+	})
+	if u.dummyHashErr != nil {
+		return fmt.Errorf("failed to hash dummy password: %w", u.dummyHashErr)
+	}
+
+	if _, err := u.hasher.Verify(password, u.dummyHash); err != nil {
+		return fmt.Errorf("failed to verify password: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/log_in_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/log_in_usecase.go -destination=./apps/user/internal/application/usecase/log_in_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	role "poketier/pkg/vo/role"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLICredentialRepository is a mock of LICredentialRepository interface.
+type MockLICredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLICredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLICredentialRepositoryMockRecorder is the mock recorder for MockLICredentialRepository.
+type MockLICredentialRepositoryMockRecorder struct {
+	mock *MockLICredentialRepository
+}
+
+// NewMockLICredentialRepository creates a new mock instance.
+func NewMockLICredentialRepository(ctrl *gomock.Controller) *MockLICredentialRepository {
+	mock := &MockLICredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockLICredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLICredentialRepository) EXPECT() *MockLICredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByEmail mocks base method.
+func (m *MockLICredentialRepository) FindByEmail(ctx context.Context, email string) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockLICredentialRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockLICredentialRepository)(nil).FindByEmail), ctx, email)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockLICredentialRepository) RecordLoginFailure(ctx context.Context, userID id.UserID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockLICredentialRepositoryMockRecorder) RecordLoginFailure(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockLICredentialRepository)(nil).RecordLoginFailure), ctx, userID, now)
+}
+
+// ResetLoginFailures mocks base method.
+func (m *MockLICredentialRepository) ResetLoginFailures(ctx context.Context, userID id.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginFailures", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginFailures indicates an expected call of ResetLoginFailures.
+func (mr *MockLICredentialRepositoryMockRecorder) ResetLoginFailures(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginFailures", reflect.TypeOf((*MockLICredentialRepository)(nil).ResetLoginFailures), ctx, userID)
+}
+
+// MockLIUserRepository is a mock of LIUserRepository interface.
+type MockLIUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLIUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLIUserRepositoryMockRecorder is the mock recorder for MockLIUserRepository.
+type MockLIUserRepositoryMockRecorder struct {
+	mock *MockLIUserRepository
+}
+
+// NewMockLIUserRepository creates a new mock instance.
+func NewMockLIUserRepository(ctrl *gomock.Controller) *MockLIUserRepository {
+	mock := &MockLIUserRepository{ctrl: ctrl}
+	mock.recorder = &MockLIUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLIUserRepository) EXPECT() *MockLIUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockLIUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLIUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLIUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// MockLIPasswordHasher is a mock of LIPasswordHasher interface.
+type MockLIPasswordHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockLIPasswordHasherMockRecorder
+	isgomock struct{}
+}
+
+// MockLIPasswordHasherMockRecorder is the mock recorder for MockLIPasswordHasher.
+type MockLIPasswordHasherMockRecorder struct {
+	mock *MockLIPasswordHasher
+}
+
+// NewMockLIPasswordHasher creates a new mock instance.
+func NewMockLIPasswordHasher(ctrl *gomock.Controller) *MockLIPasswordHasher {
+	mock := &MockLIPasswordHasher{ctrl: ctrl}
+	mock.recorder = &MockLIPasswordHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLIPasswordHasher) EXPECT() *MockLIPasswordHasherMockRecorder {
+	return m.recorder
+}
+
+// Hash mocks base method.
+func (m *MockLIPasswordHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockLIPasswordHasherMockRecorder) Hash(password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockLIPasswordHasher)(nil).Hash), password)
+}
+
+// Verify mocks base method.
+func (m *MockLIPasswordHasher) Verify(password, encoded string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", password, encoded)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockLIPasswordHasherMockRecorder) Verify(password, encoded any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockLIPasswordHasher)(nil).Verify), password, encoded)
+}
+
+// MockLITokenSigner is a mock of LITokenSigner interface.
+type MockLITokenSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockLITokenSignerMockRecorder
+	isgomock struct{}
+}
+
+// MockLITokenSignerMockRecorder is the mock recorder for MockLITokenSigner.
+type MockLITokenSignerMockRecorder struct {
+	mock *MockLITokenSigner
+}
+
+// NewMockLITokenSigner creates a new mock instance.
+func NewMockLITokenSigner(ctrl *gomock.Controller) *MockLITokenSigner {
+	mock := &MockLITokenSigner{ctrl: ctrl}
+	mock.recorder = &MockLITokenSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLITokenSigner) EXPECT() *MockLITokenSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockLITokenSigner) Sign(userID id.UserID, userRole role.Role) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", userID, userRole)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockLITokenSignerMockRecorder) Sign(userID, userRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockLITokenSigner)(nil).Sign), userID, userRole)
+}
+
+// MockLISessionRepository is a mock of LISessionRepository interface.
+type MockLISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLISessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLISessionRepositoryMockRecorder is the mock recorder for MockLISessionRepository.
+type MockLISessionRepositoryMockRecorder struct {
+	mock *MockLISessionRepository
+}
+
+// NewMockLISessionRepository creates a new mock instance.
+func NewMockLISessionRepository(ctrl *gomock.Controller) *MockLISessionRepository {
+	mock := &MockLISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLISessionRepository) EXPECT() *MockLISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLISessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLISessionRepositoryMockRecorder) Create(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLISessionRepository)(nil).Create), ctx, session)
+}
+
+// MockLIRefreshTokenRepository is a mock of LIRefreshTokenRepository interface.
+type MockLIRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLIRefreshTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLIRefreshTokenRepositoryMockRecorder is the mock recorder for MockLIRefreshTokenRepository.
+type MockLIRefreshTokenRepositoryMockRecorder struct {
+	mock *MockLIRefreshTokenRepository
+}
+
+// NewMockLIRefreshTokenRepository creates a new mock instance.
+func NewMockLIRefreshTokenRepository(ctrl *gomock.Controller) *MockLIRefreshTokenRepository {
+	mock := &MockLIRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockLIRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLIRefreshTokenRepository) EXPECT() *MockLIRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLIRefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLIRefreshTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLIRefreshTokenRepository)(nil).Create), ctx, token)
+}
+
+// MockLITxManager is a mock of LITxManager interface.
+type MockLITxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockLITxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockLITxManagerMockRecorder is the mock recorder for MockLITxManager.
+type MockLITxManagerMockRecorder struct {
+	mock *MockLITxManager
+}
+
+// NewMockLITxManager creates a new mock instance.
+func NewMockLITxManager(ctrl *gomock.Controller) *MockLITxManager {
+	mock := &MockLITxManager{ctrl: ctrl}
+	mock.recorder = &MockLITxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLITxManager) EXPECT() *MockLITxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockLITxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockLITxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockLITxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,199 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogInUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		credentialRepo   *MockLICredentialRepository
+		userRepo         *MockLIUserRepository
+		hasher           *MockLIPasswordHasher
+		signer           *MockLITokenSigner
+		sessionRepo      *MockLISessionRepository
+		refreshTokenRepo *MockLIRefreshTokenRepository
+		txManager        *MockLITxManager
+	}
+
+	userID := id.NewUserID()
+	verifiedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 8, 1, 12, 15, 0, 0, time.UTC)
+	params := usecase.LogInParams{Email: "Ash@Example.com", Password: "pikachu-2025", UserAgent: "Mozilla/5.0", IPAddress: "198.51.100.7"}
+
+	// startSession はログインしたユーザーのセッションとリフレッシュトークンが同一トランザクションで保存されることを期待する
+	startSession := func(t *testing.T, m mocks) {
+		var sessionID id.SessionID
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+		m.sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session *entity.Session) error {
+			sessionID = session.ID()
+			assert.Equal(t, userID, session.UserID(), "session should belong to the user")
+			assert.Equal(t, "Mozilla/5.0", session.UserAgent(), "user agent does not match")
+			assert.Equal(t, "198.51.100.7", session.IPAddress(), "ip address does not match")
+			return nil
+		})
+		m.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *entity.RefreshToken) error {
+			assert.Equal(t, sessionID, token.SessionID(), "refresh token should belong to the session")
+			return nil
+		})
+	}
+
+	credential := func(verifiedAt *time.Time, failedLoginCount int, lockedUntil *time.Time) *entity.Credential {
+		return entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", verifiedAt, failedLoginCount, lockedUntil)
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(t *testing.T, m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: パスワードが一致した場合、セッションを開始してトークンを発行する",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, 0, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(true, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				startSession(t, m)
+				m.signer.EXPECT().Sign(userID, role.Moderator).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "正常系: 以前にログインに失敗していた場合、失敗回数をリセットする",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, 3, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(true, nil)
+				m.credentialRepo.EXPECT().ResetLoginFailures(gomock.Any(), userID).Return(nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				startSession(t, m)
+				m.signer.EXPECT().Sign(userID, role.Moderator).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "異常系: パスワードが一致しない場合、失敗を記録してUnauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, 1, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(false, nil)
+				m.credentialRepo.EXPECT().RecordLoginFailure(gomock.Any(), userID, gomock.Any()).Return(nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid email or password",
+		},
+		{
+			caseName: "異常系: 失敗の記録でエラーが発生した場合、エラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, entity.MaxFailedLogins-1, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(false, nil)
+				m.credentialRepo.EXPECT().RecordLoginFailure(gomock.Any(), userID, gomock.Any()).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to record login failure",
+		},
+		{
+			caseName: "異常系: ロック中の場合、パスワードを照合せずにダミーのハッシュと照合し、未登録と同じUnauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				lockedUntil := time.Now().Add(time.Minute)
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, 0, &lockedUntil), nil)
+				m.hasher.EXPECT().Hash(gomock.Any()).Return("$argon2id$dummy", nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$dummy").Return(true, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid email or password",
+		},
+		{
+			caseName: "異常系: 未登録のメールアドレスの場合、ダミーのハッシュと照合してUnauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, errs.NewNotFoundError("credential not found", nil))
+				m.hasher.EXPECT().Hash(gomock.Any()).Return("$argon2id$dummy", nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$dummy").Return(false, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid email or password",
+		},
+		{
+			caseName: "異常系: メールアドレスが未確認の場合、Forbiddenエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(nil, 0, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(true, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+			errContains: "email is not verified",
+		},
+		{
+			caseName: "異常系: 無効化されたユーザーの場合、Forbiddenエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(credential(&verifiedAt, 0, nil), nil)
+				m.hasher.EXPECT().Verify("pikachu-2025", "$argon2id$hash").Return(true, nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, &testDisabledAt), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+			errContains: "user is disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				credentialRepo:   NewMockLICredentialRepository(ctrl),
+				userRepo:         NewMockLIUserRepository(ctrl),
+				hasher:           NewMockLIPasswordHasher(ctrl),
+				signer:           NewMockLITokenSigner(ctrl),
+				sessionRepo:      NewMockLISessionRepository(ctrl),
+				refreshTokenRepo: NewMockLIRefreshTokenRepository(ctrl),
+				txManager:        NewMockLITxManager(ctrl),
+			}
+			tt.setupMock(t, m)
+			uc := usecase.NewLogInUsecase(m.credentialRepo, m.userRepo, m.hasher, m.signer, m.sessionRepo, m.refreshTokenRepo, m.txManager)
+
+			// Act
+			got, err := uc.Execute(context.Background(), params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "access-token", got.AccessToken, "access token does not match")
+			assert.Equal(t, expiresAt, got.ExpiresAt, "expires at does not match")
+			assert.NotEmpty(t, got.RefreshToken, "refresh token should be issued")
+			assert.True(t, got.RefreshTokenExpiresAt.After(time.Now()), "refresh token should not be expired")
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+// LogOutEverywhereParams はすべての端末からのログアウトの入力
+type LogOutEverywhereParams struct {
+	UserID id.UserID
+}
+
+type LOESessionRepository interface {
+	RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error)
+}
+
+type LogOutEverywhereUsecase struct {
+	sessionRepo LOESessionRepository
+}
+
+func NewLogOutEverywhereUsecase(sessionRepo LOESessionRepository) *LogOutEverywhereUsecase {
+	return &LogOutEverywhereUsecase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute はログイン中のユーザーのセッションをすべて失効させる
+// 発行済みのアクセストークンは有効期限まで使用できる
+func (u *LogOutEverywhereUsecase) Execute(ctx context.Context, params LogOutEverywhereParams) error {
+	if _, err := u.sessionRepo.RevokeAllByUserID(ctx, params.UserID, entity.SessionRevokeReasonLogoutAll, time.Now()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/log_out_everywhere_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/log_out_everywhere_usecase.go -destination=./apps/user/internal/application/usecase/log_out_everywhere_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLOESessionRepository is a mock of LOESessionRepository interface.
+type MockLOESessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLOESessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLOESessionRepositoryMockRecorder is the mock recorder for MockLOESessionRepository.
+type MockLOESessionRepositoryMockRecorder struct {
+	mock *MockLOESessionRepository
+}
+
+// NewMockLOESessionRepository creates a new mock instance.
+func NewMockLOESessionRepository(ctrl *gomock.Controller) *MockLOESessionRepository {
+	mock := &MockLOESessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLOESessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLOESessionRepository) EXPECT() *MockLOESessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RevokeAllByUserID mocks base method.
+func (m *MockLOESessionRepository) RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserID", ctx, userID, reason, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllByUserID indicates an expected call of RevokeAllByUserID.
+func (mr *MockLOESessionRepositoryMockRecorder) RevokeAllByUserID(ctx, userID, reason, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserID", reflect.TypeOf((*MockLOESessionRepository)(nil).RevokeAllByUserID), ctx, userID, reason, now)
+}
@@ -0,0 +1,68 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogOutEverywhereUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(sessionRepo *MockLOESessionRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: ユーザーのセッションをすべて失効させる",
+			setupMock: func(sessionRepo *MockLOESessionRepository) {
+				sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonLogoutAll, gomock.Any()).Return(int64(2), nil)
+			},
+		},
+		{
+			caseName: "異常系: セッションの失効に失敗した場合、エラーを返す",
+			setupMock: func(sessionRepo *MockLOESessionRepository) {
+				sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonLogoutAll, gomock.Any()).Return(int64(0), errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to revoke sessions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sessionRepo := NewMockLOESessionRepository(ctrl)
+			tt.setupMock(sessionRepo)
+			uc := usecase.NewLogOutEverywhereUsecase(sessionRepo)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.LogOutEverywhereParams{UserID: userID})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+)
+
+// LogOutParams はログアウトの入力
+type LogOutParams struct {
+	RefreshToken string
+}
+
+type LORefreshTokenRepository interface {
+	FindByHash(ctx context.Context, hash string) (*entity.RefreshToken, error)
+}
+
+type LOSessionRepository interface {
+	FindByID(ctx context.Context, sessionID id.SessionID) (*entity.Session, error)
+	Revoke(ctx context.Context, session *entity.Session) error
+}
+
+type LogOutUsecase struct {
+	refreshTokenRepo LORefreshTokenRepository
+	sessionRepo      LOSessionRepository
+}
+
+func NewLogOutUsecase(refreshTokenRepo LORefreshTokenRepository, sessionRepo LOSessionRepository) *LogOutUsecase {
+	return &LogOutUsecase{
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+	}
+}
+
+// Execute はリフレッシュトークンのセッションを失効させる
+// 発行済みのアクセストークンは有効期限まで使用できる
+// 存在しないトークンや失効済みのセッションの場合も成功として扱う（何度実行しても同じ結果になる）
+func (u *LogOutUsecase) Execute(ctx context.Context, params LogOutParams) error {
+	if params.RefreshToken == "" {
+		return nil
+	}
+
+	token, err := u.refreshTokenRepo.FindByHash(ctx, entity.HashRefreshToken(params.RefreshToken))
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	session, err := u.sessionRepo.FindByID(ctx, token.SessionID())
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find session: %w", err)
+	}
+	if session.RevokedAt() != nil {
+		return nil
+	}
+
+	session.Revoke(entity.SessionRevokeReasonLogout, time.Now())
+	if err := u.sessionRepo.Revoke(ctx, session); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
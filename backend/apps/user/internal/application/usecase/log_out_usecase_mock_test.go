@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/log_out_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/log_out_usecase.go -destination=./apps/user/internal/application/usecase/log_out_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLORefreshTokenRepository is a mock of LORefreshTokenRepository interface.
+type MockLORefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLORefreshTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLORefreshTokenRepositoryMockRecorder is the mock recorder for MockLORefreshTokenRepository.
+type MockLORefreshTokenRepositoryMockRecorder struct {
+	mock *MockLORefreshTokenRepository
+}
+
+// NewMockLORefreshTokenRepository creates a new mock instance.
+func NewMockLORefreshTokenRepository(ctrl *gomock.Controller) *MockLORefreshTokenRepository {
+	mock := &MockLORefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockLORefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLORefreshTokenRepository) EXPECT() *MockLORefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByHash mocks base method.
+func (m *MockLORefreshTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockLORefreshTokenRepositoryMockRecorder) FindByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockLORefreshTokenRepository)(nil).FindByHash), ctx, hash)
+}
+
+// MockLOSessionRepository is a mock of LOSessionRepository interface.
+type MockLOSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLOSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLOSessionRepositoryMockRecorder is the mock recorder for MockLOSessionRepository.
+type MockLOSessionRepositoryMockRecorder struct {
+	mock *MockLOSessionRepository
+}
+
+// NewMockLOSessionRepository creates a new mock instance.
+func NewMockLOSessionRepository(ctrl *gomock.Controller) *MockLOSessionRepository {
+	mock := &MockLOSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLOSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLOSessionRepository) EXPECT() *MockLOSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockLOSessionRepository) FindByID(ctx context.Context, sessionID id.SessionID) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, sessionID)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLOSessionRepositoryMockRecorder) FindByID(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLOSessionRepository)(nil).FindByID), ctx, sessionID)
+}
+
+// Revoke mocks base method.
+func (m *MockLOSessionRepository) Revoke(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockLOSessionRepositoryMockRecorder) Revoke(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockLOSessionRepository)(nil).Revoke), ctx, session)
+}
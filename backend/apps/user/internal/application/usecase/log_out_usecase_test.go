@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogOutUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		refreshTokenRepo *MockLORefreshTokenRepository
+		sessionRepo      *MockLOSessionRepository
+	}
+
+	userID := id.NewUserID()
+	sessionID := id.NewSessionID()
+	hash := entity.HashRefreshToken("raw-refresh-token")
+	createdAt := time.Now().Add(-time.Hour)
+	revokedAt := time.Now().Add(-time.Minute)
+	session := func(revokedAt *time.Time, reason entity.SessionRevokeReason) *entity.Session {
+		return entity.ReconstructSession(sessionID, userID, "", "", createdAt, createdAt, createdAt.Add(entity.SessionTTL), revokedAt, reason)
+	}
+
+	tests := []struct {
+		caseName     string
+		refreshToken string
+		setupMock    func(m mocks)
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			caseName:     "正常系: リフレッシュトークンのセッションを失効させる",
+			refreshToken: "raw-refresh-token",
+			setupMock: func(m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(entity.ReconstructRefreshToken(hash, sessionID, nil), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(session(nil, ""), nil)
+				m.sessionRepo.EXPECT().Revoke(gomock.Any(), gomock.Cond(func(session *entity.Session) bool {
+					return session.RevokedAt() != nil && session.RevokedReason() == entity.SessionRevokeReasonLogout
+				})).Return(nil)
+			},
+		},
+		{
+			caseName:     "正常系: 既に失効しているセッションの場合、何もしない",
+			refreshToken: "raw-refresh-token",
+			setupMock: func(m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(entity.ReconstructRefreshToken(hash, sessionID, nil), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(session(&revokedAt, entity.SessionRevokeReasonLogout), nil)
+			},
+		},
+		{
+			caseName:     "正常系: トークンが存在しない場合、何もしない",
+			refreshToken: "raw-refresh-token",
+			setupMock: func(m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(nil, errs.NewNotFoundError("refresh token not found", nil))
+			},
+		},
+		{
+			caseName:     "正常系: トークンが空の場合、何もしない",
+			refreshToken: "",
+			setupMock:    func(m mocks) {},
+		},
+		{
+			caseName:     "異常系: セッションの失効に失敗した場合、エラーを返す",
+			refreshToken: "raw-refresh-token",
+			setupMock: func(m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(entity.ReconstructRefreshToken(hash, sessionID, nil), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(session(nil, ""), nil)
+				m.sessionRepo.EXPECT().Revoke(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to revoke session",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				refreshTokenRepo: NewMockLORefreshTokenRepository(ctrl),
+				sessionRepo:      NewMockLOSessionRepository(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewLogOutUsecase(m.refreshTokenRepo, m.sessionRepo)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.LogOutParams{RefreshToken: tt.refreshToken})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// RefreshSessionParams はリフレッシュトークンの交換の入力
+type RefreshSessionParams struct {
+	RefreshToken string
+	UserAgent    string
+	IPAddress    string
+}
+
+type RFSRefreshTokenRepository interface {
+	FindByHash(ctx context.Context, hash string) (*entity.RefreshToken, error)
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	MarkUsed(ctx context.Context, token *entity.RefreshToken) error
+}
+
+type RFSSessionRepository interface {
+	FindByID(ctx context.Context, sessionID id.SessionID) (*entity.Session, error)
+	Touch(ctx context.Context, session *entity.Session) error
+	Revoke(ctx context.Context, session *entity.Session) error
+}
+
+type RFSUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+}
+
+type RFSTokenSigner interface {
+	Sign(userID id.UserID, userRole role.Role) (string, time.Time, error)
+}
+
+type RFSTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type RefreshSessionUsecase struct {
+	refreshTokenRepo RFSRefreshTokenRepository
+	sessionRepo      RFSSessionRepository
+	userRepo         RFSUserRepository
+	signer           RFSTokenSigner
+	txManager        RFSTxManager
+}
+
+func NewRefreshSessionUsecase(
+	refreshTokenRepo RFSRefreshTokenRepository,
+	sessionRepo RFSSessionRepository,
+	userRepo RFSUserRepository,
+	signer RFSTokenSigner,
+	txManager RFSTxManager,
+) *RefreshSessionUsecase {
+	return &RefreshSessionUsecase{
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		userRepo:         userRepo,
+		signer:           signer,
+		txManager:        txManager,
+	}
+}
+
+// Execute はリフレッシュトークンを新しいものに交換し、アクセストークンを再発行する
+// 交換済みのトークンが使用された場合は漏洩したものとみなし、同じセッション（トークンファミリー）を失効させる
+// 存在しない・失効済み・期限切れの場合は、理由を区別せずに認証エラーを返す
+func (u *RefreshSessionUsecase) Execute(ctx context.Context, params RefreshSessionParams) (*LogInResult, error) {
+	if params.RefreshToken == "" {
+		return nil, errs.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	token, err := u.refreshTokenRepo.FindByHash(ctx, entity.HashRefreshToken(params.RefreshToken))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errs.NewUnauthorizedError("invalid refresh token", err)
+		}
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	session, err := u.sessionRepo.FindByID(ctx, token.SessionID())
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errs.NewUnauthorizedError("invalid refresh token", err)
+		}
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+
+	now := time.Now()
+	if err := token.Use(now); err != nil {
+		return nil, u.revokeOnReuse(ctx, session, now, err)
+	}
+	if err := session.Refresh(params.UserAgent, params.IPAddress, now); err != nil {
+		return nil, errs.NewUnauthorizedError("invalid refresh token", err)
+	}
+
+	user, err := u.userRepo.FindByID(ctx, session.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user.IsDisabled() {
+		return nil, errs.NewForbiddenError("user is disabled", nil)
+	}
+
+	newToken, rawNewToken, err := entity.IssueRefreshToken(session.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue refresh token: %w", err)
+	}
+
+	// 交換済みにする・新しいトークンを保存する・セッションを延長する処理は同一トランザクションで行う
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.refreshTokenRepo.MarkUsed(ctx, token); err != nil {
+			if isNotFound(err) {
+				// 同時に同じトークンで交換された
+				return entity.ErrRefreshTokenReused
+			}
+			return fmt.Errorf("failed to mark refresh token as used: %w", err)
+		}
+		if err := u.refreshTokenRepo.Create(ctx, newToken); err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		if err := u.sessionRepo.Touch(ctx, session); err != nil {
+			if isNotFound(err) {
+				return errs.NewUnauthorizedError("invalid refresh token", err)
+			}
+			return fmt.Errorf("failed to touch session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenReused) {
+			return nil, u.revokeOnReuse(ctx, session, now, err)
+		}
+		return nil, err
+	}
+
+	return signLogInResult(u.signer, user, session, rawNewToken)
+}
+
+// revokeOnReuse はトークンの再使用を検知したセッションを失効させ、呼び出し元に返す認証エラーを作成する
+func (u *RefreshSessionUsecase) revokeOnReuse(ctx context.Context, session *entity.Session, now time.Time, cause error) error {
+	session.Revoke(entity.SessionRevokeReasonTokenReuse, now)
+	if err := u.sessionRepo.Revoke(ctx, session); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return errs.NewUnauthorizedError("refresh token reuse detected", cause)
+}
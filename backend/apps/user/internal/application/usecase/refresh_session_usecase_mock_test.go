@@ -0,0 +1,272 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/refresh_session_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/refresh_session_usecase.go -destination=./apps/user/internal/application/usecase/refresh_session_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	role "poketier/pkg/vo/role"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRFSRefreshTokenRepository is a mock of RFSRefreshTokenRepository interface.
+type MockRFSRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRFSRefreshTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRFSRefreshTokenRepositoryMockRecorder is the mock recorder for MockRFSRefreshTokenRepository.
+type MockRFSRefreshTokenRepositoryMockRecorder struct {
+	mock *MockRFSRefreshTokenRepository
+}
+
+// NewMockRFSRefreshTokenRepository creates a new mock instance.
+func NewMockRFSRefreshTokenRepository(ctrl *gomock.Controller) *MockRFSRefreshTokenRepository {
+	mock := &MockRFSRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRFSRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRFSRefreshTokenRepository) EXPECT() *MockRFSRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRFSRefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRFSRefreshTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRFSRefreshTokenRepository)(nil).Create), ctx, token)
+}
+
+// FindByHash mocks base method.
+func (m *MockRFSRefreshTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockRFSRefreshTokenRepositoryMockRecorder) FindByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockRFSRefreshTokenRepository)(nil).FindByHash), ctx, hash)
+}
+
+// MarkUsed mocks base method.
+func (m *MockRFSRefreshTokenRepository) MarkUsed(ctx context.Context, token *entity.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockRFSRefreshTokenRepositoryMockRecorder) MarkUsed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockRFSRefreshTokenRepository)(nil).MarkUsed), ctx, token)
+}
+
+// MockRFSSessionRepository is a mock of RFSSessionRepository interface.
+type MockRFSSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRFSSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRFSSessionRepositoryMockRecorder is the mock recorder for MockRFSSessionRepository.
+type MockRFSSessionRepositoryMockRecorder struct {
+	mock *MockRFSSessionRepository
+}
+
+// NewMockRFSSessionRepository creates a new mock instance.
+func NewMockRFSSessionRepository(ctrl *gomock.Controller) *MockRFSSessionRepository {
+	mock := &MockRFSSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockRFSSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRFSSessionRepository) EXPECT() *MockRFSSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockRFSSessionRepository) FindByID(ctx context.Context, sessionID id.SessionID) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, sessionID)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRFSSessionRepositoryMockRecorder) FindByID(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRFSSessionRepository)(nil).FindByID), ctx, sessionID)
+}
+
+// Revoke mocks base method.
+func (m *MockRFSSessionRepository) Revoke(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRFSSessionRepositoryMockRecorder) Revoke(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRFSSessionRepository)(nil).Revoke), ctx, session)
+}
+
+// Touch mocks base method.
+func (m *MockRFSSessionRepository) Touch(ctx context.Context, session *entity.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockRFSSessionRepositoryMockRecorder) Touch(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockRFSSessionRepository)(nil).Touch), ctx, session)
+}
+
+// MockRFSUserRepository is a mock of RFSUserRepository interface.
+type MockRFSUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRFSUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRFSUserRepositoryMockRecorder is the mock recorder for MockRFSUserRepository.
+type MockRFSUserRepositoryMockRecorder struct {
+	mock *MockRFSUserRepository
+}
+
+// NewMockRFSUserRepository creates a new mock instance.
+func NewMockRFSUserRepository(ctrl *gomock.Controller) *MockRFSUserRepository {
+	mock := &MockRFSUserRepository{ctrl: ctrl}
+	mock.recorder = &MockRFSUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRFSUserRepository) EXPECT() *MockRFSUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockRFSUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRFSUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRFSUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// MockRFSTokenSigner is a mock of RFSTokenSigner interface.
+type MockRFSTokenSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockRFSTokenSignerMockRecorder
+	isgomock struct{}
+}
+
+// MockRFSTokenSignerMockRecorder is the mock recorder for MockRFSTokenSigner.
+type MockRFSTokenSignerMockRecorder struct {
+	mock *MockRFSTokenSigner
+}
+
+// NewMockRFSTokenSigner creates a new mock instance.
+func NewMockRFSTokenSigner(ctrl *gomock.Controller) *MockRFSTokenSigner {
+	mock := &MockRFSTokenSigner{ctrl: ctrl}
+	mock.recorder = &MockRFSTokenSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRFSTokenSigner) EXPECT() *MockRFSTokenSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockRFSTokenSigner) Sign(userID id.UserID, userRole role.Role) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", userID, userRole)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockRFSTokenSignerMockRecorder) Sign(userID, userRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockRFSTokenSigner)(nil).Sign), userID, userRole)
+}
+
+// MockRFSTxManager is a mock of RFSTxManager interface.
+type MockRFSTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRFSTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRFSTxManagerMockRecorder is the mock recorder for MockRFSTxManager.
+type MockRFSTxManagerMockRecorder struct {
+	mock *MockRFSTxManager
+}
+
+// NewMockRFSTxManager creates a new mock instance.
+func NewMockRFSTxManager(ctrl *gomock.Controller) *MockRFSTxManager {
+	mock := &MockRFSTxManager{ctrl: ctrl}
+	mock.recorder = &MockRFSTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRFSTxManager) EXPECT() *MockRFSTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRFSTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRFSTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRFSTxManager)(nil).RunInTx), ctx, fn)
+}
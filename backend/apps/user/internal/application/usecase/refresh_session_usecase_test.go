@@ -0,0 +1,248 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshSessionUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		refreshTokenRepo *MockRFSRefreshTokenRepository
+		sessionRepo      *MockRFSSessionRepository
+		userRepo         *MockRFSUserRepository
+		signer           *MockRFSTokenSigner
+		txManager        *MockRFSTxManager
+	}
+
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	userID := id.NewUserID()
+	sessionID := id.NewSessionID()
+	expiresAt := time.Date(2025, 8, 1, 12, 15, 0, 0, time.UTC)
+	usedAt := time.Now().Add(-time.Minute)
+	params := usecase.RefreshSessionParams{RefreshToken: "raw-refresh-token", UserAgent: "Mozilla/5.0", IPAddress: "198.51.100.7"}
+	hash := entity.HashRefreshToken("raw-refresh-token")
+
+	unusedToken := func() *entity.RefreshToken {
+		return entity.ReconstructRefreshToken(hash, sessionID, nil)
+	}
+	session := func(expiresAt time.Time, revokedAt *time.Time) *entity.Session {
+		createdAt := time.Now().Add(-time.Hour)
+		var reason entity.SessionRevokeReason
+		if revokedAt != nil {
+			reason = entity.SessionRevokeReasonLogout
+		}
+		return entity.ReconstructSession(sessionID, userID, "curl/8.0", "203.0.113.1", createdAt, createdAt, expiresAt, revokedAt, reason)
+	}
+	activeSession := func() *entity.Session {
+		return session(time.Now().Add(time.Hour), nil)
+	}
+	// expectRevokedByReuse はトークンの再使用を検知してセッションが失効することを期待する
+	expectRevokedByReuse := func(m mocks) {
+		m.sessionRepo.EXPECT().Revoke(gomock.Any(), gomock.Cond(func(session *entity.Session) bool {
+			return session.RevokedAt() != nil && session.RevokedReason() == entity.SessionRevokeReasonTokenReuse
+		})).Return(nil)
+	}
+
+	tests := []struct {
+		caseName    string
+		params      *usecase.RefreshSessionParams
+		setupMock   func(t *testing.T, m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: トークンを交換済みにして新しいトークンを発行し、セッションを延長する",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				runInTx(m)
+				m.refreshTokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Cond(func(token *entity.RefreshToken) bool {
+					return token.Hash() == hash && token.UsedAt() != nil
+				})).Return(nil)
+				m.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *entity.RefreshToken) error {
+					assert.NotEqual(t, hash, token.Hash(), "new token should differ from the used token")
+					assert.Equal(t, sessionID, token.SessionID(), "new token should belong to the same session")
+					return nil
+				})
+				m.sessionRepo.EXPECT().Touch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session *entity.Session) error {
+					assert.Equal(t, "Mozilla/5.0", session.UserAgent(), "user agent should be updated")
+					assert.Equal(t, "198.51.100.7", session.IPAddress(), "ip address should be updated")
+					assert.WithinDuration(t, time.Now().Add(entity.SessionTTL), session.ExpiresAt(), time.Minute, "session should be extended")
+					return nil
+				})
+				m.signer.EXPECT().Sign(userID, role.Moderator).Return("access-token", expiresAt, nil)
+			},
+		},
+		{
+			caseName: "異常系: トークンが空の場合、Unauthorizedエラーを返す",
+			params:   &usecase.RefreshSessionParams{},
+			setupMock: func(t *testing.T, m mocks) {
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid refresh token",
+		},
+		{
+			caseName: "異常系: トークンが存在しない場合、Unauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(nil, errs.NewNotFoundError("refresh token not found", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid refresh token",
+		},
+		{
+			caseName: "異常系: 交換済みのトークンが使用された場合、セッションを失効させてUnauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(entity.ReconstructRefreshToken(hash, sessionID, &usedAt), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				expectRevokedByReuse(m)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "refresh token reuse detected",
+		},
+		{
+			caseName: "異常系: 同時に同じトークンで交換された場合、セッションを失効させてUnauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				runInTx(m)
+				m.refreshTokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("refresh token not found or already used", nil))
+				expectRevokedByReuse(m)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "refresh token reuse detected",
+		},
+		{
+			caseName: "異常系: セッションが失効している場合、Unauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				revokedAt := time.Now().Add(-time.Minute)
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(session(time.Now().Add(time.Hour), &revokedAt), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid refresh token",
+		},
+		{
+			caseName: "異常系: セッションが期限切れの場合、Unauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(session(time.Now().Add(-time.Second), nil), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid refresh token",
+		},
+		{
+			caseName: "異常系: 交換中にセッションが失効した場合、Unauthorizedエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				runInTx(m)
+				m.refreshTokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Any()).Return(nil)
+				m.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.sessionRepo.EXPECT().Touch(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("session not found or revoked", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrUnauthorized,
+			errContains: "invalid refresh token",
+		},
+		{
+			caseName: "異常系: ユーザーが無効化されている場合、Forbiddenエラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, &testDisabledAt), nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrForbidden,
+			errContains: "user is disabled",
+		},
+		{
+			caseName: "異常系: 新しいトークンの保存に失敗した場合、エラーを返す",
+			setupMock: func(t *testing.T, m mocks) {
+				m.refreshTokenRepo.EXPECT().FindByHash(gomock.Any(), hash).Return(unusedToken(), nil)
+				m.sessionRepo.EXPECT().FindByID(gomock.Any(), sessionID).Return(activeSession(), nil)
+				m.userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				runInTx(m)
+				m.refreshTokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Any()).Return(nil)
+				m.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to create refresh token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				refreshTokenRepo: NewMockRFSRefreshTokenRepository(ctrl),
+				sessionRepo:      NewMockRFSSessionRepository(ctrl),
+				userRepo:         NewMockRFSUserRepository(ctrl),
+				signer:           NewMockRFSTokenSigner(ctrl),
+				txManager:        NewMockRFSTxManager(ctrl),
+			}
+			tt.setupMock(t, m)
+			uc := usecase.NewRefreshSessionUsecase(m.refreshTokenRepo, m.sessionRepo, m.userRepo, m.signer, m.txManager)
+			input := params
+			if tt.params != nil {
+				input = *tt.params
+			}
+
+			// Act
+			got, err := uc.Execute(context.Background(), input)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "access-token", got.AccessToken, "access token does not match")
+			assert.Equal(t, expiresAt, got.ExpiresAt, "expires at does not match")
+			assert.NotEmpty(t, got.RefreshToken, "refresh token should be issued")
+			assert.NotEqual(t, params.RefreshToken, got.RefreshToken, "refresh token should be rotated")
+			assert.WithinDuration(t, time.Now().Add(entity.SessionTTL), got.RefreshTokenExpiresAt, time.Minute, "refresh token expiry should follow the session")
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+)
+
+// RequestPasswordResetParams はパスワード再設定の受付の入力
+type RequestPasswordResetParams struct {
+	Email string
+}
+
+type RPRCredentialRepository interface {
+	FindByEmail(ctx context.Context, email string) (*entity.Credential, error)
+}
+
+type RPRAccountTokenRepository interface {
+	Replace(ctx context.Context, token *entity.AccountToken) error
+}
+
+type RPRAccountMailer interface {
+	SendPasswordReset(ctx context.Context, to, token string) error
+}
+
+type RequestPasswordResetUsecase struct {
+	credentialRepo RPRCredentialRepository
+	tokenRepo      RPRAccountTokenRepository
+	mailer         RPRAccountMailer
+}
+
+func NewRequestPasswordResetUsecase(credentialRepo RPRCredentialRepository, tokenRepo RPRAccountTokenRepository, mailer RPRAccountMailer) *RequestPasswordResetUsecase {
+	return &RequestPasswordResetUsecase{
+		credentialRepo: credentialRepo,
+		tokenRepo:      tokenRepo,
+		mailer:         mailer,
+	}
+}
+
+// Execute はパスワード再設定のリンクを送信。以前に送ったリンクは無効になる
+// 登録されているメールアドレスかどうかを推測されないよう、未登録の場合も成功として扱う
+func (u *RequestPasswordResetUsecase) Execute(ctx context.Context, params RequestPasswordResetParams) error {
+	email, err := entity.NormalizeEmail(params.Email)
+	if err != nil {
+		return errs.NewValidationError("invalid email", err)
+	}
+
+	credential, err := u.credentialRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	token, rawToken, err := entity.IssueAccountToken(credential.UserID(), entity.TokenPurposePasswordReset, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to issue password reset token: %w", err)
+	}
+	if err := u.tokenRepo.Replace(ctx, token); err != nil {
+		return fmt.Errorf("failed to save password reset token: %w", err)
+	}
+
+	if err := u.mailer.SendPasswordReset(ctx, credential.Email(), rawToken); err != nil {
+		return fmt.Errorf("failed to send password reset: %w", err)
+	}
+	return nil
+}
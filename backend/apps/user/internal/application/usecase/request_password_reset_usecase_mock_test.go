@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/request_password_reset_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/request_password_reset_usecase.go -destination=./apps/user/internal/application/usecase/request_password_reset_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRPRCredentialRepository is a mock of RPRCredentialRepository interface.
+type MockRPRCredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRPRCredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRPRCredentialRepositoryMockRecorder is the mock recorder for MockRPRCredentialRepository.
+type MockRPRCredentialRepositoryMockRecorder struct {
+	mock *MockRPRCredentialRepository
+}
+
+// NewMockRPRCredentialRepository creates a new mock instance.
+func NewMockRPRCredentialRepository(ctrl *gomock.Controller) *MockRPRCredentialRepository {
+	mock := &MockRPRCredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockRPRCredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRPRCredentialRepository) EXPECT() *MockRPRCredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByEmail mocks base method.
+func (m *MockRPRCredentialRepository) FindByEmail(ctx context.Context, email string) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockRPRCredentialRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockRPRCredentialRepository)(nil).FindByEmail), ctx, email)
+}
+
+// MockRPRAccountTokenRepository is a mock of RPRAccountTokenRepository interface.
+type MockRPRAccountTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRPRAccountTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRPRAccountTokenRepositoryMockRecorder is the mock recorder for MockRPRAccountTokenRepository.
+type MockRPRAccountTokenRepositoryMockRecorder struct {
+	mock *MockRPRAccountTokenRepository
+}
+
+// NewMockRPRAccountTokenRepository creates a new mock instance.
+func NewMockRPRAccountTokenRepository(ctrl *gomock.Controller) *MockRPRAccountTokenRepository {
+	mock := &MockRPRAccountTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRPRAccountTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRPRAccountTokenRepository) EXPECT() *MockRPRAccountTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Replace mocks base method.
+func (m *MockRPRAccountTokenRepository) Replace(ctx context.Context, token *entity.AccountToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockRPRAccountTokenRepositoryMockRecorder) Replace(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockRPRAccountTokenRepository)(nil).Replace), ctx, token)
+}
+
+// MockRPRAccountMailer is a mock of RPRAccountMailer interface.
+type MockRPRAccountMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRPRAccountMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockRPRAccountMailerMockRecorder is the mock recorder for MockRPRAccountMailer.
+type MockRPRAccountMailerMockRecorder struct {
+	mock *MockRPRAccountMailer
+}
+
+// NewMockRPRAccountMailer creates a new mock instance.
+func NewMockRPRAccountMailer(ctrl *gomock.Controller) *MockRPRAccountMailer {
+	mock := &MockRPRAccountMailer{ctrl: ctrl}
+	mock.recorder = &MockRPRAccountMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRPRAccountMailer) EXPECT() *MockRPRAccountMailerMockRecorder {
+	return m.recorder
+}
+
+// SendPasswordReset mocks base method.
+func (m *MockRPRAccountMailer) SendPasswordReset(ctx context.Context, to, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordReset", ctx, to, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordReset indicates an expected call of SendPasswordReset.
+func (mr *MockRPRAccountMailerMockRecorder) SendPasswordReset(ctx, to, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordReset", reflect.TypeOf((*MockRPRAccountMailer)(nil).SendPasswordReset), ctx, to, token)
+}
@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestPasswordResetUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		credentialRepo *MockRPRCredentialRepository
+		tokenRepo      *MockRPRAccountTokenRepository
+		mailer         *MockRPRAccountMailer
+	}
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName    string
+		email       string
+		setupMock   func(m mocks)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 新しいトークンを保存して再設定メールを送信する",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 0, nil), nil)
+				var tokenHash string
+				m.tokenRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *entity.AccountToken) error {
+					tokenHash = token.Hash()
+					assert.Equal(t, entity.TokenPurposePasswordReset, token.Purpose(), "token purpose does not match")
+					return nil
+				})
+				m.mailer.EXPECT().SendPasswordReset(gomock.Any(), "ash@example.com", gomock.Any()).DoAndReturn(func(ctx context.Context, to, token string) error {
+					assert.Equal(t, tokenHash, entity.HashAccountToken(token), "mailed token should match the saved hash")
+					return nil
+				})
+			},
+		},
+		{
+			caseName: "正常系: 未登録のメールアドレスの場合は何もせずに成功する",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, errs.NewNotFoundError("credential not found", nil))
+			},
+		},
+		{
+			caseName:    "異常系: メールアドレスの形式が不正な場合、バリデーションエラーを返す",
+			email:       "",
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 認証情報の取得でエラーが発生した場合、エラーを返す",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				credentialRepo: NewMockRPRCredentialRepository(ctrl),
+				tokenRepo:      NewMockRPRAccountTokenRepository(ctrl),
+				mailer:         NewMockRPRAccountMailer(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewRequestPasswordResetUsecase(m.credentialRepo, m.tokenRepo, m.mailer)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.RequestPasswordResetParams{Email: tt.email})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+)
+
+// ResendVerificationEmailParams はメールアドレス確認のリンクの再送の入力
+type ResendVerificationEmailParams struct {
+	Email string
+}
+
+type RVCredentialRepository interface {
+	FindByEmail(ctx context.Context, email string) (*entity.Credential, error)
+}
+
+type RVAccountTokenRepository interface {
+	Replace(ctx context.Context, token *entity.AccountToken) error
+}
+
+type RVAccountMailer interface {
+	SendEmailVerification(ctx context.Context, to, token string) error
+}
+
+type ResendVerificationEmailUsecase struct {
+	credentialRepo RVCredentialRepository
+	tokenRepo      RVAccountTokenRepository
+	mailer         RVAccountMailer
+}
+
+func NewResendVerificationEmailUsecase(credentialRepo RVCredentialRepository, tokenRepo RVAccountTokenRepository, mailer RVAccountMailer) *ResendVerificationEmailUsecase {
+	return &ResendVerificationEmailUsecase{
+		credentialRepo: credentialRepo,
+		tokenRepo:      tokenRepo,
+		mailer:         mailer,
+	}
+}
+
+// Execute はメールアドレスが未確認の場合に確認のリンクを再送。以前に送ったリンクは無効になる
+// 登録されているメールアドレスかどうかを推測されないよう、未登録・確認済みの場合も成功として扱う
+func (u *ResendVerificationEmailUsecase) Execute(ctx context.Context, params ResendVerificationEmailParams) error {
+	email, err := entity.NormalizeEmail(params.Email)
+	if err != nil {
+		return errs.NewValidationError("invalid email", err)
+	}
+
+	credential, err := u.credentialRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+	if credential.IsEmailVerified() {
+		return nil
+	}
+
+	token, rawToken, err := entity.IssueAccountToken(credential.UserID(), entity.TokenPurposeEmailVerification, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to issue email verification token: %w", err)
+	}
+	if err := u.tokenRepo.Replace(ctx, token); err != nil {
+		return fmt.Errorf("failed to save email verification token: %w", err)
+	}
+
+	if err := u.mailer.SendEmailVerification(ctx, credential.Email(), rawToken); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/resend_verification_email_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/resend_verification_email_usecase.go -destination=./apps/user/internal/application/usecase/resend_verification_email_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRVCredentialRepository is a mock of RVCredentialRepository interface.
+type MockRVCredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRVCredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRVCredentialRepositoryMockRecorder is the mock recorder for MockRVCredentialRepository.
+type MockRVCredentialRepositoryMockRecorder struct {
+	mock *MockRVCredentialRepository
+}
+
+// NewMockRVCredentialRepository creates a new mock instance.
+func NewMockRVCredentialRepository(ctrl *gomock.Controller) *MockRVCredentialRepository {
+	mock := &MockRVCredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockRVCredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRVCredentialRepository) EXPECT() *MockRVCredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByEmail mocks base method.
+func (m *MockRVCredentialRepository) FindByEmail(ctx context.Context, email string) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockRVCredentialRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockRVCredentialRepository)(nil).FindByEmail), ctx, email)
+}
+
+// MockRVAccountTokenRepository is a mock of RVAccountTokenRepository interface.
+type MockRVAccountTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRVAccountTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRVAccountTokenRepositoryMockRecorder is the mock recorder for MockRVAccountTokenRepository.
+type MockRVAccountTokenRepositoryMockRecorder struct {
+	mock *MockRVAccountTokenRepository
+}
+
+// NewMockRVAccountTokenRepository creates a new mock instance.
+func NewMockRVAccountTokenRepository(ctrl *gomock.Controller) *MockRVAccountTokenRepository {
+	mock := &MockRVAccountTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRVAccountTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRVAccountTokenRepository) EXPECT() *MockRVAccountTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Replace mocks base method.
+func (m *MockRVAccountTokenRepository) Replace(ctx context.Context, token *entity.AccountToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockRVAccountTokenRepositoryMockRecorder) Replace(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockRVAccountTokenRepository)(nil).Replace), ctx, token)
+}
+
+// MockRVAccountMailer is a mock of RVAccountMailer interface.
+type MockRVAccountMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRVAccountMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockRVAccountMailerMockRecorder is the mock recorder for MockRVAccountMailer.
+type MockRVAccountMailerMockRecorder struct {
+	mock *MockRVAccountMailer
+}
+
+// NewMockRVAccountMailer creates a new mock instance.
+func NewMockRVAccountMailer(ctrl *gomock.Controller) *MockRVAccountMailer {
+	mock := &MockRVAccountMailer{ctrl: ctrl}
+	mock.recorder = &MockRVAccountMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRVAccountMailer) EXPECT() *MockRVAccountMailerMockRecorder {
+	return m.recorder
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockRVAccountMailer) SendEmailVerification(ctx context.Context, to, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", ctx, to, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockRVAccountMailerMockRecorder) SendEmailVerification(ctx, to, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockRVAccountMailer)(nil).SendEmailVerification), ctx, to, token)
+}
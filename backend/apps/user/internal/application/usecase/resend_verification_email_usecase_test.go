@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResendVerificationEmailUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		credentialRepo *MockRVCredentialRepository
+		tokenRepo      *MockRVAccountTokenRepository
+		mailer         *MockRVAccountMailer
+	}
+
+	userID := id.NewUserID()
+	verifiedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		email       string
+		setupMock   func(m mocks)
+		wantErr     bool
+		wantErrType error
+	}{
+		{
+			caseName: "正常系: 未確認の場合は新しいトークンを保存して確認メールを送信する",
+			email:    "Ash@Example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 0, nil), nil)
+				m.tokenRepo.EXPECT().Replace(gomock.Any(), gomock.Cond(func(token *entity.AccountToken) bool {
+					return token.UserID() == userID && token.Purpose() == entity.TokenPurposeEmailVerification
+				})).Return(nil)
+				m.mailer.EXPECT().SendEmailVerification(gomock.Any(), "ash@example.com", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			caseName: "正常系: 未登録のメールアドレスの場合は何もせずに成功する",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, errs.NewNotFoundError("credential not found", nil))
+			},
+		},
+		{
+			caseName: "正常系: 確認済みの場合は何もせずに成功する",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", &verifiedAt, 0, nil), nil)
+			},
+		},
+		{
+			caseName:    "異常系: メールアドレスの形式が不正な場合、バリデーションエラーを返す",
+			email:       "ash",
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 送信に失敗した場合、エラーを返す",
+			email:    "ash@example.com",
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 0, nil), nil)
+				m.tokenRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Return(nil)
+				m.mailer.EXPECT().SendEmailVerification(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				credentialRepo: NewMockRVCredentialRepository(ctrl),
+				tokenRepo:      NewMockRVAccountTokenRepository(ctrl),
+				mailer:         NewMockRVAccountMailer(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewResendVerificationEmailUsecase(m.credentialRepo, m.tokenRepo, m.mailer)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.ResendVerificationEmailParams{Email: tt.email})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
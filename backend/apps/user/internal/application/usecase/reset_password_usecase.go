@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// ResetPasswordParams はパスワード再設定の入力
+type ResetPasswordParams struct {
+	Token       string
+	NewPassword string
+}
+
+type RSPAccountTokenRepository interface {
+	FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error)
+	MarkUsed(ctx context.Context, token *entity.AccountToken) error
+}
+
+type RSPCredentialRepository interface {
+	FindByUserID(ctx context.Context, userID id.UserID) (*entity.Credential, error)
+	Update(ctx context.Context, credential *entity.Credential) error
+}
+
+type RSPPasswordHasher interface {
+	Hash(password string) (string, error)
+}
+
+type RSPSessionRepository interface {
+	RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error)
+}
+
+type RSPTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ResetPasswordUsecase struct {
+	tokenRepo      RSPAccountTokenRepository
+	credentialRepo RSPCredentialRepository
+	hasher         RSPPasswordHasher
+	sessionRepo    RSPSessionRepository
+	txManager      RSPTxManager
+}
+
+func NewResetPasswordUsecase(
+	tokenRepo RSPAccountTokenRepository,
+	credentialRepo RSPCredentialRepository,
+	hasher RSPPasswordHasher,
+	sessionRepo RSPSessionRepository,
+	txManager RSPTxManager,
+) *ResetPasswordUsecase {
+	return &ResetPasswordUsecase{
+		tokenRepo:      tokenRepo,
+		credentialRepo: credentialRepo,
+		hasher:         hasher,
+		sessionRepo:    sessionRepo,
+		txManager:      txManager,
+	}
+}
+
+// Execute はメールで送ったトークンを使用してパスワードを再設定し、ログインのロックを解除する
+// リンクを受け取れたことでメールアドレスの所有も確認できるため、未確認の場合は確認済みにする
+// 漏洩したパスワードで開始されたセッションを残さないよう、ユーザーのセッションはすべて失効させる
+func (u *ResetPasswordUsecase) Execute(ctx context.Context, params ResetPasswordParams) error {
+	if err := entity.ValidatePassword(params.NewPassword); err != nil {
+		return errs.NewValidationError("invalid password", err)
+	}
+
+	now := time.Now()
+	token, err := findUsableAccountToken(ctx, u.tokenRepo, params.Token, entity.TokenPurposePasswordReset, now)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := u.hasher.Hash(params.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// トークンの使用・パスワードの変更・セッションの失効は同一トランザクションで行う
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.tokenRepo.MarkUsed(ctx, token); err != nil {
+			if isNotFound(err) {
+				return errs.NewValidationError("invalid or expired token", err)
+			}
+			return fmt.Errorf("failed to mark token as used: %w", err)
+		}
+
+		credential, err := u.credentialRepo.FindByUserID(ctx, token.UserID())
+		if err != nil {
+			return fmt.Errorf("failed to find credential: %w", err)
+		}
+		if err := credential.ChangePassword(passwordHash); err != nil {
+			return fmt.Errorf("failed to change password: %w", err)
+		}
+		credential.VerifyEmail(now)
+		if err := u.credentialRepo.Update(ctx, credential); err != nil {
+			return fmt.Errorf("failed to update credential: %w", err)
+		}
+		if _, err := u.sessionRepo.RevokeAllByUserID(ctx, token.UserID(), entity.SessionRevokeReasonPasswordReset, now); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+		return nil
+	})
+}
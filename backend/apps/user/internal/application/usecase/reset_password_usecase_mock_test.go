@@ -0,0 +1,242 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/reset_password_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/reset_password_usecase.go -destination=./apps/user/internal/application/usecase/reset_password_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRSPAccountTokenRepository is a mock of RSPAccountTokenRepository interface.
+type MockRSPAccountTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRSPAccountTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRSPAccountTokenRepositoryMockRecorder is the mock recorder for MockRSPAccountTokenRepository.
+type MockRSPAccountTokenRepositoryMockRecorder struct {
+	mock *MockRSPAccountTokenRepository
+}
+
+// NewMockRSPAccountTokenRepository creates a new mock instance.
+func NewMockRSPAccountTokenRepository(ctrl *gomock.Controller) *MockRSPAccountTokenRepository {
+	mock := &MockRSPAccountTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRSPAccountTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRSPAccountTokenRepository) EXPECT() *MockRSPAccountTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByHash mocks base method.
+func (m *MockRSPAccountTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.AccountToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockRSPAccountTokenRepositoryMockRecorder) FindByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockRSPAccountTokenRepository)(nil).FindByHash), ctx, hash)
+}
+
+// MarkUsed mocks base method.
+func (m *MockRSPAccountTokenRepository) MarkUsed(ctx context.Context, token *entity.AccountToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockRSPAccountTokenRepositoryMockRecorder) MarkUsed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockRSPAccountTokenRepository)(nil).MarkUsed), ctx, token)
+}
+
+// MockRSPCredentialRepository is a mock of RSPCredentialRepository interface.
+type MockRSPCredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRSPCredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRSPCredentialRepositoryMockRecorder is the mock recorder for MockRSPCredentialRepository.
+type MockRSPCredentialRepositoryMockRecorder struct {
+	mock *MockRSPCredentialRepository
+}
+
+// NewMockRSPCredentialRepository creates a new mock instance.
+func NewMockRSPCredentialRepository(ctrl *gomock.Controller) *MockRSPCredentialRepository {
+	mock := &MockRSPCredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockRSPCredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRSPCredentialRepository) EXPECT() *MockRSPCredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockRSPCredentialRepository) FindByUserID(ctx context.Context, userID id.UserID) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockRSPCredentialRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockRSPCredentialRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockRSPCredentialRepository) Update(ctx context.Context, credential *entity.Credential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRSPCredentialRepositoryMockRecorder) Update(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRSPCredentialRepository)(nil).Update), ctx, credential)
+}
+
+// MockRSPPasswordHasher is a mock of RSPPasswordHasher interface.
+type MockRSPPasswordHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockRSPPasswordHasherMockRecorder
+	isgomock struct{}
+}
+
+// MockRSPPasswordHasherMockRecorder is the mock recorder for MockRSPPasswordHasher.
+type MockRSPPasswordHasherMockRecorder struct {
+	mock *MockRSPPasswordHasher
+}
+
+// NewMockRSPPasswordHasher creates a new mock instance.
+func NewMockRSPPasswordHasher(ctrl *gomock.Controller) *MockRSPPasswordHasher {
+	mock := &MockRSPPasswordHasher{ctrl: ctrl}
+	mock.recorder = &MockRSPPasswordHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRSPPasswordHasher) EXPECT() *MockRSPPasswordHasherMockRecorder {
+	return m.recorder
+}
+
+// Hash mocks base method.
+func (m *MockRSPPasswordHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockRSPPasswordHasherMockRecorder) Hash(password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockRSPPasswordHasher)(nil).Hash), password)
+}
+
+// MockRSPSessionRepository is a mock of RSPSessionRepository interface.
+type MockRSPSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRSPSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRSPSessionRepositoryMockRecorder is the mock recorder for MockRSPSessionRepository.
+type MockRSPSessionRepositoryMockRecorder struct {
+	mock *MockRSPSessionRepository
+}
+
+// NewMockRSPSessionRepository creates a new mock instance.
+func NewMockRSPSessionRepository(ctrl *gomock.Controller) *MockRSPSessionRepository {
+	mock := &MockRSPSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockRSPSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRSPSessionRepository) EXPECT() *MockRSPSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RevokeAllByUserID mocks base method.
+func (m *MockRSPSessionRepository) RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserID", ctx, userID, reason, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllByUserID indicates an expected call of RevokeAllByUserID.
+func (mr *MockRSPSessionRepositoryMockRecorder) RevokeAllByUserID(ctx, userID, reason, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserID", reflect.TypeOf((*MockRSPSessionRepository)(nil).RevokeAllByUserID), ctx, userID, reason, now)
+}
+
+// MockRSPTxManager is a mock of RSPTxManager interface.
+type MockRSPTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRSPTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockRSPTxManagerMockRecorder is the mock recorder for MockRSPTxManager.
+type MockRSPTxManagerMockRecorder struct {
+	mock *MockRSPTxManager
+}
+
+// NewMockRSPTxManager creates a new mock instance.
+func NewMockRSPTxManager(ctrl *gomock.Controller) *MockRSPTxManager {
+	mock := &MockRSPTxManager{ctrl: ctrl}
+	mock.recorder = &MockRSPTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRSPTxManager) EXPECT() *MockRSPTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockRSPTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockRSPTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockRSPTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResetPasswordUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		tokenRepo      *MockRSPAccountTokenRepository
+		credentialRepo *MockRSPCredentialRepository
+		hasher         *MockRSPPasswordHasher
+		sessionRepo    *MockRSPSessionRepository
+		txManager      *MockRSPTxManager
+	}
+
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	userID := id.NewUserID()
+	issue := func(t *testing.T, purpose entity.TokenPurpose, issuedAt time.Time) (*entity.AccountToken, string) {
+		t.Helper()
+		token, raw, err := entity.IssueAccountToken(userID, purpose, issuedAt)
+		require.NoError(t, err, "failed to issue token")
+		return token, raw
+	}
+
+	tests := []struct {
+		caseName    string
+		newPassword string
+		setupMock   func(t *testing.T, m mocks) string
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName:    "正常系: パスワードを変更し、ロックを解除してメールアドレスを確認済みにし、セッションを失効させる",
+			newPassword: "raichu-2025",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposePasswordReset, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), entity.HashAccountToken(raw)).Return(token, nil)
+				m.hasher.EXPECT().Hash("raichu-2025").Return("$argon2id$new", nil)
+				runInTx(m)
+				m.tokenRepo.EXPECT().MarkUsed(gomock.Any(), token).Return(nil)
+				lockedUntil := time.Now().Add(time.Minute)
+				m.credentialRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$old", nil, 3, &lockedUntil), nil)
+				m.credentialRepo.EXPECT().Update(gomock.Any(), gomock.Cond(func(credential *entity.Credential) bool {
+					return credential.PasswordHash() == "$argon2id$new" &&
+						!credential.IsLocked(time.Now()) &&
+						credential.FailedLoginCount() == 0 &&
+						credential.IsEmailVerified()
+				})).Return(nil)
+				m.sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonPasswordReset, gomock.Any()).Return(int64(2), nil)
+				return raw
+			},
+		},
+		{
+			caseName:    "異常系: セッションの失効に失敗した場合、エラーを返す",
+			newPassword: "raichu-2025",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposePasswordReset, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				m.hasher.EXPECT().Hash("raichu-2025").Return("$argon2id$new", nil)
+				runInTx(m)
+				m.tokenRepo.EXPECT().MarkUsed(gomock.Any(), token).Return(nil)
+				m.credentialRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$old", nil, 0, nil), nil)
+				m.credentialRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				m.sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), userID, entity.SessionRevokeReasonPasswordReset, gomock.Any()).Return(int64(0), errors.New("db error"))
+				return raw
+			},
+			wantErr:     true,
+			errContains: "failed to revoke sessions",
+		},
+		{
+			caseName:    "異常系: 新しいパスワードが短い場合、トークンを使用せずにバリデーションエラーを返す",
+			newPassword: "raichu",
+			setupMock: func(t *testing.T, m mocks) string {
+				return "raw"
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid password",
+		},
+		{
+			caseName:    "異常系: 期限切れのトークンの場合、バリデーションエラーを返す",
+			newPassword: "raichu-2025",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposePasswordReset, time.Now().Add(-2*time.Hour))
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return raw
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired token",
+		},
+		{
+			caseName:    "異常系: メールアドレス確認のトークンの場合、バリデーションエラーを返す",
+			newPassword: "raichu-2025",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposeEmailVerification, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return raw
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				tokenRepo:      NewMockRSPAccountTokenRepository(ctrl),
+				credentialRepo: NewMockRSPCredentialRepository(ctrl),
+				hasher:         NewMockRSPPasswordHasher(ctrl),
+				sessionRepo:    NewMockRSPSessionRepository(ctrl),
+				txManager:      NewMockRSPTxManager(ctrl),
+			}
+			raw := tt.setupMock(t, m)
+			uc := usecase.NewResetPasswordUsecase(m.tokenRepo, m.credentialRepo, m.hasher, m.sessionRepo, m.txManager)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.ResetPasswordParams{Token: raw, NewPassword: tt.newPassword})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
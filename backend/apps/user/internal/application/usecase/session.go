@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// sessionCreator はセッションを保存する
+type sessionCreator interface {
+	Create(ctx context.Context, session *entity.Session) error
+}
+
+// refreshTokenCreator はリフレッシュトークンを保存する
+type refreshTokenCreator interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+}
+
+// txRunner はトランザクション内で処理を実行する
+type txRunner interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// accessTokenSigner はアクセストークンを発行する
+type accessTokenSigner interface {
+	Sign(userID id.UserID, userRole role.Role) (string, time.Time, error)
+}
+
+// sessionStarter はログインしたユーザーのセッションを開始するための依存関係
+type sessionStarter struct {
+	sessionRepo      sessionCreator
+	refreshTokenRepo refreshTokenCreator
+	txManager        txRunner
+	signer           accessTokenSigner
+}
+
+// start はセッションと最初のリフレッシュトークンを保存し、アクセストークンとともに返す
+func (s sessionStarter) start(ctx context.Context, user *entity.User, userAgent, ipAddress string, now time.Time) (*LogInResult, error) {
+	session := entity.NewSession(user.ID(), userAgent, ipAddress, now)
+	refreshToken, rawRefreshToken, err := entity.IssueRefreshToken(session.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue refresh token: %w", err)
+	}
+
+	err = s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		if err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signLogInResult(s.signer, user, session, rawRefreshToken)
+}
+
+// signLogInResult はアクセストークンを発行し、リフレッシュトークンとともにログイン結果を作成する
+func signLogInResult(signer accessTokenSigner, user *entity.User, session *entity.Session, rawRefreshToken string) (*LogInResult, error) {
+	accessToken, expiresAt, err := signer.Sign(user.ID(), user.Role())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return &LogInResult{
+		AccessToken:           accessToken,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          rawRefreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt(),
+	}, nil
+}
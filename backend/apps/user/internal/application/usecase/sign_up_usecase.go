@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// SignUpParams はメールアドレス・パスワードでのユーザー登録の入力
+type SignUpParams struct {
+	Email       string
+	Password    string
+	DisplayName string
+}
+
+// SignUpResult は登録したユーザー。メールアドレスを確認するまでログインできない
+type SignUpResult struct {
+	UserID string
+	Email  string
+}
+
+type SUUserRepository interface {
+	Create(ctx context.Context, user *entity.User) error
+}
+
+type SUCredentialRepository interface {
+	FindByEmail(ctx context.Context, email string) (*entity.Credential, error)
+	Create(ctx context.Context, credential *entity.Credential) error
+}
+
+type SUAccountTokenRepository interface {
+	Replace(ctx context.Context, token *entity.AccountToken) error
+}
+
+type SUPasswordHasher interface {
+	Hash(password string) (string, error)
+}
+
+type SUAccountMailer interface {
+	SendEmailVerification(ctx context.Context, to, token string) error
+}
+
+type SUTxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type SignUpUsecase struct {
+	userRepo       SUUserRepository
+	credentialRepo SUCredentialRepository
+	tokenRepo      SUAccountTokenRepository
+	hasher         SUPasswordHasher
+	mailer         SUAccountMailer
+	txManager      SUTxManager
+}
+
+func NewSignUpUsecase(
+	userRepo SUUserRepository,
+	credentialRepo SUCredentialRepository,
+	tokenRepo SUAccountTokenRepository,
+	hasher SUPasswordHasher,
+	mailer SUAccountMailer,
+	txManager SUTxManager,
+) *SignUpUsecase {
+	return &SignUpUsecase{
+		userRepo:       userRepo,
+		credentialRepo: credentialRepo,
+		tokenRepo:      tokenRepo,
+		hasher:         hasher,
+		mailer:         mailer,
+		txManager:      txManager,
+	}
+}
+
+// Execute はユーザーと認証情報を登録し、メールアドレス確認のリンクを送信
+func (u *SignUpUsecase) Execute(ctx context.Context, params SignUpParams) (*SignUpResult, error) {
+	email, err := entity.NormalizeEmail(params.Email)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid email", err)
+	}
+	if err := entity.ValidatePassword(params.Password); err != nil {
+		return nil, errs.NewValidationError("invalid password", err)
+	}
+
+	user, err := entity.NewUser(id.NewUserID(), params.DisplayName)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid display_name", err)
+	}
+
+	if _, err := u.credentialRepo.FindByEmail(ctx, email); err == nil {
+		return nil, errs.NewConflictError("email is already registered", nil)
+	} else if !isNotFound(err) {
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	passwordHash, err := u.hasher.Hash(params.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	credential, err := entity.NewCredential(user.ID(), email, passwordHash)
+	if err != nil {
+		return nil, errs.NewValidationError("invalid email", err)
+	}
+
+	token, rawToken, err := entity.IssueAccountToken(user.ID(), entity.TokenPurposeEmailVerification, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue email verification token: %w", err)
+	}
+
+	// 確認メールを送信できなかった場合は登録を取り消し、同じメールアドレスで登録し直せるようにする
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.userRepo.Create(ctx, user); err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		if err := u.credentialRepo.Create(ctx, credential); err != nil {
+			return fmt.Errorf("failed to create credential: %w", err)
+		}
+		if err := u.tokenRepo.Replace(ctx, token); err != nil {
+			return fmt.Errorf("failed to save email verification token: %w", err)
+		}
+		if err := u.mailer.SendEmailVerification(ctx, email, rawToken); err != nil {
+			return fmt.Errorf("failed to send email verification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignUpResult{
+		UserID: user.ID().String(),
+		Email:  email,
+	}, nil
+}
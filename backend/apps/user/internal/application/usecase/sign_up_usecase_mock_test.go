@@ -0,0 +1,262 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/sign_up_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/sign_up_usecase.go -destination=./apps/user/internal/application/usecase/sign_up_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSUUserRepository is a mock of SUUserRepository interface.
+type MockSUUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSUUserRepositoryMockRecorder is the mock recorder for MockSUUserRepository.
+type MockSUUserRepositoryMockRecorder struct {
+	mock *MockSUUserRepository
+}
+
+// NewMockSUUserRepository creates a new mock instance.
+func NewMockSUUserRepository(ctrl *gomock.Controller) *MockSUUserRepository {
+	mock := &MockSUUserRepository{ctrl: ctrl}
+	mock.recorder = &MockSUUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUUserRepository) EXPECT() *MockSUUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSUUserRepository) Create(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSUUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSUUserRepository)(nil).Create), ctx, user)
+}
+
+// MockSUCredentialRepository is a mock of SUCredentialRepository interface.
+type MockSUCredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUCredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSUCredentialRepositoryMockRecorder is the mock recorder for MockSUCredentialRepository.
+type MockSUCredentialRepositoryMockRecorder struct {
+	mock *MockSUCredentialRepository
+}
+
+// NewMockSUCredentialRepository creates a new mock instance.
+func NewMockSUCredentialRepository(ctrl *gomock.Controller) *MockSUCredentialRepository {
+	mock := &MockSUCredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockSUCredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUCredentialRepository) EXPECT() *MockSUCredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSUCredentialRepository) Create(ctx context.Context, credential *entity.Credential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSUCredentialRepositoryMockRecorder) Create(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSUCredentialRepository)(nil).Create), ctx, credential)
+}
+
+// FindByEmail mocks base method.
+func (m *MockSUCredentialRepository) FindByEmail(ctx context.Context, email string) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockSUCredentialRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockSUCredentialRepository)(nil).FindByEmail), ctx, email)
+}
+
+// MockSUAccountTokenRepository is a mock of SUAccountTokenRepository interface.
+type MockSUAccountTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUAccountTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSUAccountTokenRepositoryMockRecorder is the mock recorder for MockSUAccountTokenRepository.
+type MockSUAccountTokenRepositoryMockRecorder struct {
+	mock *MockSUAccountTokenRepository
+}
+
+// NewMockSUAccountTokenRepository creates a new mock instance.
+func NewMockSUAccountTokenRepository(ctrl *gomock.Controller) *MockSUAccountTokenRepository {
+	mock := &MockSUAccountTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockSUAccountTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUAccountTokenRepository) EXPECT() *MockSUAccountTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Replace mocks base method.
+func (m *MockSUAccountTokenRepository) Replace(ctx context.Context, token *entity.AccountToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockSUAccountTokenRepositoryMockRecorder) Replace(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockSUAccountTokenRepository)(nil).Replace), ctx, token)
+}
+
+// MockSUPasswordHasher is a mock of SUPasswordHasher interface.
+type MockSUPasswordHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUPasswordHasherMockRecorder
+	isgomock struct{}
+}
+
+// MockSUPasswordHasherMockRecorder is the mock recorder for MockSUPasswordHasher.
+type MockSUPasswordHasherMockRecorder struct {
+	mock *MockSUPasswordHasher
+}
+
+// NewMockSUPasswordHasher creates a new mock instance.
+func NewMockSUPasswordHasher(ctrl *gomock.Controller) *MockSUPasswordHasher {
+	mock := &MockSUPasswordHasher{ctrl: ctrl}
+	mock.recorder = &MockSUPasswordHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUPasswordHasher) EXPECT() *MockSUPasswordHasherMockRecorder {
+	return m.recorder
+}
+
+// Hash mocks base method.
+func (m *MockSUPasswordHasher) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockSUPasswordHasherMockRecorder) Hash(password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockSUPasswordHasher)(nil).Hash), password)
+}
+
+// MockSUAccountMailer is a mock of SUAccountMailer interface.
+type MockSUAccountMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUAccountMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockSUAccountMailerMockRecorder is the mock recorder for MockSUAccountMailer.
+type MockSUAccountMailerMockRecorder struct {
+	mock *MockSUAccountMailer
+}
+
+// NewMockSUAccountMailer creates a new mock instance.
+func NewMockSUAccountMailer(ctrl *gomock.Controller) *MockSUAccountMailer {
+	mock := &MockSUAccountMailer{ctrl: ctrl}
+	mock.recorder = &MockSUAccountMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUAccountMailer) EXPECT() *MockSUAccountMailerMockRecorder {
+	return m.recorder
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockSUAccountMailer) SendEmailVerification(ctx context.Context, to, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", ctx, to, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockSUAccountMailerMockRecorder) SendEmailVerification(ctx, to, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockSUAccountMailer)(nil).SendEmailVerification), ctx, to, token)
+}
+
+// MockSUTxManager is a mock of SUTxManager interface.
+type MockSUTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockSUTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockSUTxManagerMockRecorder is the mock recorder for MockSUTxManager.
+type MockSUTxManagerMockRecorder struct {
+	mock *MockSUTxManager
+}
+
+// NewMockSUTxManager creates a new mock instance.
+func NewMockSUTxManager(ctrl *gomock.Controller) *MockSUTxManager {
+	mock := &MockSUTxManager{ctrl: ctrl}
+	mock.recorder = &MockSUTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSUTxManager) EXPECT() *MockSUTxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockSUTxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockSUTxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockSUTxManager)(nil).RunInTx), ctx, fn)
+}
@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignUpUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		userRepo       *MockSUUserRepository
+		credentialRepo *MockSUCredentialRepository
+		tokenRepo      *MockSUAccountTokenRepository
+		hasher         *MockSUPasswordHasher
+		mailer         *MockSUAccountMailer
+		txManager      *MockSUTxManager
+	}
+
+	// runInTx はトランザクション内の処理をそのまま実行させる
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	validParams := usecase.SignUpParams{Email: " Ash@Example.com ", Password: "pikachu-2025", DisplayName: "サトシ"}
+	notFound := errs.NewNotFoundError("credential not found", nil)
+
+	tests := []struct {
+		caseName    string
+		params      usecase.SignUpParams
+		setupMock   func(m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: ユーザー・認証情報・確認トークンを保存し、確認メールを送信する",
+			params:   validParams,
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, notFound)
+				m.hasher.EXPECT().Hash("pikachu-2025").Return("$argon2id$hash", nil)
+				runInTx(m)
+				var userID string
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, user *entity.User) error {
+					userID = user.ID().String()
+					assert.Equal(t, "サトシ", user.DisplayName(), "display name does not match")
+					return nil
+				})
+				m.credentialRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, credential *entity.Credential) error {
+					assert.Equal(t, userID, credential.UserID().String(), "credential should belong to the created user")
+					assert.Equal(t, "ash@example.com", credential.Email(), "email should be normalized")
+					assert.Equal(t, "$argon2id$hash", credential.PasswordHash(), "password hash does not match")
+					return nil
+				})
+				var tokenHash string
+				m.tokenRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *entity.AccountToken) error {
+					tokenHash = token.Hash()
+					assert.Equal(t, entity.TokenPurposeEmailVerification, token.Purpose(), "token purpose does not match")
+					return nil
+				})
+				m.mailer.EXPECT().SendEmailVerification(gomock.Any(), "ash@example.com", gomock.Any()).DoAndReturn(func(ctx context.Context, to, token string) error {
+					assert.Equal(t, tokenHash, entity.HashAccountToken(token), "mailed token should match the saved hash")
+					return nil
+				})
+			},
+		},
+		{
+			caseName:    "異常系: メールアドレスの形式が不正な場合、バリデーションエラーを返す",
+			params:      usecase.SignUpParams{Email: "ash", Password: "pikachu-2025", DisplayName: "サトシ"},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid email",
+		},
+		{
+			caseName:    "異常系: パスワードが短い場合、バリデーションエラーを返す",
+			params:      usecase.SignUpParams{Email: "ash@example.com", Password: "pika", DisplayName: "サトシ"},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid password",
+		},
+		{
+			caseName:    "異常系: 表示名が空の場合、バリデーションエラーを返す",
+			params:      usecase.SignUpParams{Email: "ash@example.com", Password: "pikachu-2025", DisplayName: " "},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid display_name",
+		},
+		{
+			caseName: "異常系: メールアドレスが登録済みの場合、Conflictエラーを返す",
+			params:   validParams,
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(&entity.Credential{}, nil)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+			errContains: "email is already registered",
+		},
+		{
+			caseName: "異常系: 確認メールの送信に失敗した場合、エラーを返す（登録はロールバックされる）",
+			params:   validParams,
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, notFound)
+				m.hasher.EXPECT().Hash("pikachu-2025").Return("$argon2id$hash", nil)
+				runInTx(m)
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.credentialRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.tokenRepo.EXPECT().Replace(gomock.Any(), gomock.Any()).Return(nil)
+				m.mailer.EXPECT().SendEmailVerification(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+			},
+			wantErr:     true,
+			errContains: "failed to send email verification",
+		},
+		{
+			caseName: "異常系: 同時に登録されて保存でConflictエラーになった場合、そのまま返す",
+			params:   validParams,
+			setupMock: func(m mocks) {
+				m.credentialRepo.EXPECT().FindByEmail(gomock.Any(), "ash@example.com").Return(nil, notFound)
+				m.hasher.EXPECT().Hash("pikachu-2025").Return("$argon2id$hash", nil)
+				runInTx(m)
+				m.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.credentialRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errs.NewConflictError("email is already registered", nil))
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				userRepo:       NewMockSUUserRepository(ctrl),
+				credentialRepo: NewMockSUCredentialRepository(ctrl),
+				tokenRepo:      NewMockSUAccountTokenRepository(ctrl),
+				hasher:         NewMockSUPasswordHasher(ctrl),
+				mailer:         NewMockSUAccountMailer(ctrl),
+				txManager:      NewMockSUTxManager(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewSignUpUsecase(m.userRepo, m.credentialRepo, m.tokenRepo, m.hasher, m.mailer, m.txManager)
+
+			// Act
+			got, err := uc.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.NotEmpty(t, got.UserID, "user id should be returned")
+			assert.Equal(t, "ash@example.com", got.Email, "email should be normalized")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/oidc"
+)
+
+// StartOIDCLogInParams は外部IDプロバイダーでのログインの開始の入力
+// LoginHint はプロバイダーのログイン画面に渡すヒント（任意）
+type StartOIDCLogInParams struct {
+	Provider  string
+	LoginHint string
+}
+
+// StartOIDCLogInResult はユーザーをリダイレクトさせる認可エンドポイントのURL
+// クライアントは State を保存しておき、コールバックで受け取った state と一致することを確認してから完了のAPIを呼び出す
+type StartOIDCLogInResult struct {
+	AuthorizationURL string
+	State            string
+	ExpiresAt        time.Time
+}
+
+type SOLAuthRequestRepository interface {
+	Create(ctx context.Context, req *entity.OIDCAuthRequest) error
+}
+
+type SOLIdentityProvider interface {
+	AuthCodeURL(ctx context.Context, provider string, req oidc.AuthRequest) (string, error)
+}
+
+type StartOIDCLogInUsecase struct {
+	authRequestRepo SOLAuthRequestRepository
+	idp             SOLIdentityProvider
+}
+
+func NewStartOIDCLogInUsecase(authRequestRepo SOLAuthRequestRepository, idp SOLIdentityProvider) *StartOIDCLogInUsecase {
+	return &StartOIDCLogInUsecase{
+		authRequestRepo: authRequestRepo,
+		idp:             idp,
+	}
+}
+
+// Execute は state・nonce・code_verifier を生成して保存し、認可エンドポイントのURLを返す
+func (u *StartOIDCLogInUsecase) Execute(ctx context.Context, params StartOIDCLogInParams) (*StartOIDCLogInResult, error) {
+	authRequest, state, err := entity.NewOIDCAuthRequest(params.Provider, time.Now())
+	if err != nil {
+		return nil, errs.NewValidationError("invalid provider", err)
+	}
+
+	authorizationURL, err := u.idp.AuthCodeURL(ctx, params.Provider, oidc.AuthRequest{
+		State:         state,
+		Nonce:         authRequest.Nonce(),
+		CodeChallenge: authRequest.CodeChallenge(),
+		LoginHint:     params.LoginHint,
+	})
+	if err != nil {
+		if errors.Is(err, oidc.ErrUnknownProvider) {
+			return nil, errs.NewNotFoundError("identity provider not found", err)
+		}
+		return nil, fmt.Errorf("failed to build authorization url: %w", err)
+	}
+
+	if err := u.authRequestRepo.Create(ctx, authRequest); err != nil {
+		return nil, fmt.Errorf("failed to save oidc auth request: %w", err)
+	}
+
+	return &StartOIDCLogInResult{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		ExpiresAt:        authRequest.ExpiresAt(),
+	}, nil
+}
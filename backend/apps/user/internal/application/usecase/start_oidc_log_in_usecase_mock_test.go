@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/start_oidc_log_in_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/start_oidc_log_in_usecase.go -destination=./apps/user/internal/application/usecase/start_oidc_log_in_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	oidc "poketier/pkg/oidc"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSOLAuthRequestRepository is a mock of SOLAuthRequestRepository interface.
+type MockSOLAuthRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSOLAuthRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSOLAuthRequestRepositoryMockRecorder is the mock recorder for MockSOLAuthRequestRepository.
+type MockSOLAuthRequestRepositoryMockRecorder struct {
+	mock *MockSOLAuthRequestRepository
+}
+
+// NewMockSOLAuthRequestRepository creates a new mock instance.
+func NewMockSOLAuthRequestRepository(ctrl *gomock.Controller) *MockSOLAuthRequestRepository {
+	mock := &MockSOLAuthRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockSOLAuthRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSOLAuthRequestRepository) EXPECT() *MockSOLAuthRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSOLAuthRequestRepository) Create(ctx context.Context, req *entity.OIDCAuthRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSOLAuthRequestRepositoryMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSOLAuthRequestRepository)(nil).Create), ctx, req)
+}
+
+// MockSOLIdentityProvider is a mock of SOLIdentityProvider interface.
+type MockSOLIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSOLIdentityProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockSOLIdentityProviderMockRecorder is the mock recorder for MockSOLIdentityProvider.
+type MockSOLIdentityProviderMockRecorder struct {
+	mock *MockSOLIdentityProvider
+}
+
+// NewMockSOLIdentityProvider creates a new mock instance.
+func NewMockSOLIdentityProvider(ctrl *gomock.Controller) *MockSOLIdentityProvider {
+	mock := &MockSOLIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockSOLIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSOLIdentityProvider) EXPECT() *MockSOLIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockSOLIdentityProvider) AuthCodeURL(ctx context.Context, provider string, req oidc.AuthRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, provider, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockSOLIdentityProviderMockRecorder) AuthCodeURL(ctx, provider, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockSOLIdentityProvider)(nil).AuthCodeURL), ctx, provider, req)
+}
@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/oidc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStartOIDCLogInUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		authRequestRepo *MockSOLAuthRequestRepository
+		idp             *MockSOLIdentityProvider
+	}
+
+	tests := []struct {
+		caseName    string
+		params      usecase.StartOIDCLogInParams
+		setupMock   func(m mocks)
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: 認可リクエストを保存し、state と code_challenge を付けたURLを返す",
+			params:   usecase.StartOIDCLogInParams{Provider: "mock", LoginHint: "ash"},
+			setupMock: func(m mocks) {
+				var sent oidc.AuthRequest
+				m.idp.EXPECT().AuthCodeURL(gomock.Any(), "mock", gomock.Any()).DoAndReturn(func(ctx context.Context, provider string, req oidc.AuthRequest) (string, error) {
+					sent = req
+					assert.Equal(t, "ash", req.LoginHint, "login hint does not match")
+					return "https://idp.example.com/authorize?state=" + req.State, nil
+				})
+				m.authRequestRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *entity.OIDCAuthRequest) error {
+					assert.Equal(t, "mock", req.Provider(), "provider does not match")
+					assert.Equal(t, entity.HashOIDCState(sent.State), req.StateHash(), "saved state hash should match the sent state")
+					assert.Equal(t, sent.Nonce, req.Nonce(), "saved nonce should match the sent nonce")
+					assert.Equal(t, sent.CodeChallenge, oidc.CodeChallengeS256(req.CodeVerifier()), "code challenge should be derived from the saved code verifier")
+					return nil
+				})
+			},
+		},
+		{
+			caseName:    "異常系: プロバイダー名が空の場合、バリデーションエラーを返す",
+			params:      usecase.StartOIDCLogInParams{},
+			setupMock:   func(m mocks) {},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid provider",
+		},
+		{
+			caseName: "異常系: 登録されていないプロバイダーの場合、NotFoundエラーを返す",
+			params:   usecase.StartOIDCLogInParams{Provider: "unknown"},
+			setupMock: func(m mocks) {
+				m.idp.EXPECT().AuthCodeURL(gomock.Any(), "unknown", gomock.Any()).Return("", oidc.ErrUnknownProvider)
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrNotFound,
+			errContains: "identity provider not found",
+		},
+		{
+			caseName: "異常系: プロバイダーのメタデータの取得に失敗した場合、エラーを返す",
+			params:   usecase.StartOIDCLogInParams{Provider: "mock"},
+			setupMock: func(m mocks) {
+				m.idp.EXPECT().AuthCodeURL(gomock.Any(), "mock", gomock.Any()).Return("", fmt.Errorf("failed to fetch provider metadata: %w", errors.New("connection refused")))
+			},
+			wantErr:     true,
+			errContains: "failed to build authorization url",
+		},
+		{
+			caseName: "異常系: 認可リクエストの保存に失敗した場合、エラーを返す",
+			params:   usecase.StartOIDCLogInParams{Provider: "mock"},
+			setupMock: func(m mocks) {
+				m.idp.EXPECT().AuthCodeURL(gomock.Any(), "mock", gomock.Any()).Return("https://idp.example.com/authorize", nil)
+				m.authRequestRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to save oidc auth request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				authRequestRepo: NewMockSOLAuthRequestRepository(ctrl),
+				idp:             NewMockSOLIdentityProvider(ctrl),
+			}
+			tt.setupMock(m)
+			uc := usecase.NewStartOIDCLogInUsecase(m.authRequestRepo, m.idp)
+
+			// Act
+			got, err := uc.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "https://idp.example.com/authorize?state="+got.State, got.AuthorizationURL, "authorization url does not match")
+			assert.NotEmpty(t, got.State, "state should be returned")
+			assert.False(t, got.ExpiresAt.IsZero(), "expires at should be returned")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// UpdateMeParams はログイン中のユーザーの更新の入力
+// DisplayName が nil の場合は表示名を変更しない
+type UpdateMeParams struct {
+	UserID      id.UserID
+	DisplayName *string
+}
+
+// UpdateMeResult は更新後のログイン中のユーザーのアカウント情報
+type UpdateMeResult struct {
+	UserID      string
+	DisplayName string
+	Role        string
+	CreatedAt   time.Time
+}
+
+type UMUserRepository interface {
+	FindByID(ctx context.Context, userID id.UserID) (*entity.User, error)
+	Update(ctx context.Context, user *entity.User) error
+}
+
+type UpdateMeUsecase struct {
+	userRepo UMUserRepository
+}
+
+func NewUpdateMeUsecase(userRepo UMUserRepository) *UpdateMeUsecase {
+	return &UpdateMeUsecase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute はログイン中のユーザーのプロフィールを更新。無効化されたユーザーは利用できない
+func (u *UpdateMeUsecase) Execute(ctx context.Context, params UpdateMeParams) (*UpdateMeResult, error) {
+	user, err := u.userRepo.FindByID(ctx, params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if user.IsDisabled() {
+		return nil, errs.NewForbiddenError("user is disabled", nil)
+	}
+
+	if params.DisplayName != nil {
+		if err := user.Rename(*params.DisplayName); err != nil {
+			return nil, errs.NewValidationError("invalid display_name", err)
+		}
+
+		if err := u.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	return &UpdateMeResult{
+		UserID:      user.ID().String(),
+		DisplayName: user.DisplayName(),
+		Role:        user.Role().String(),
+		CreatedAt:   user.CreatedAt(),
+	}, nil
+}
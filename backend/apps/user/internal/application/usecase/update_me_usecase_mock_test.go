@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/update_me_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/update_me_usecase.go -destination=./apps/user/internal/application/usecase/update_me_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUMUserRepository is a mock of UMUserRepository interface.
+type MockUMUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUMUserRepositoryMockRecorder is the mock recorder for MockUMUserRepository.
+type MockUMUserRepositoryMockRecorder struct {
+	mock *MockUMUserRepository
+}
+
+// NewMockUMUserRepository creates a new mock instance.
+func NewMockUMUserRepository(ctrl *gomock.Controller) *MockUMUserRepository {
+	mock := &MockUMUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUMUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMUserRepository) EXPECT() *MockUMUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockUMUserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockUMUserRepositoryMockRecorder) FindByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUMUserRepository)(nil).FindByID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockUMUserRepository) Update(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUMUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUMUserRepository)(nil).Update), ctx, user)
+}
@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateMeUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	displayName := " 解説花子 "
+	emptyName := ""
+
+	tests := []struct {
+		caseName    string
+		params      usecase.UpdateMeParams
+		setupMock   func(t *testing.T, userRepo *MockUMUserRepository)
+		want        *usecase.UpdateMeResult
+		wantErr     bool
+		errContains string
+	}{
+		{
+			caseName: "正常系: 表示名が変更されて保存される",
+			params:   usecase.UpdateMeParams{UserID: userID, DisplayName: &displayName},
+			setupMock: func(t *testing.T, userRepo *MockUMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Cond(func(user *entity.User) bool {
+					return user.DisplayName() == "解説花子"
+				})).Return(nil)
+			},
+			want: &usecase.UpdateMeResult{
+				UserID:      userID.String(),
+				DisplayName: "解説花子",
+				Role:        "moderator",
+				CreatedAt:   testCreatedAt,
+			},
+		},
+		{
+			caseName: "正常系: 変更する項目がない場合は保存せずに現在の情報を返す",
+			params:   usecase.UpdateMeParams{UserID: userID},
+			setupMock: func(t *testing.T, userRepo *MockUMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+			},
+			want: &usecase.UpdateMeResult{
+				UserID:      userID.String(),
+				DisplayName: "配信者A",
+				Role:        "moderator",
+				CreatedAt:   testCreatedAt,
+			},
+		},
+		{
+			caseName: "異常系: 空の表示名が指定された場合、バリデーションエラーを返す",
+			params:   usecase.UpdateMeParams{UserID: userID, DisplayName: &emptyName},
+			setupMock: func(t *testing.T, userRepo *MockUMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+			},
+			wantErr:     true,
+			errContains: "invalid display_name",
+		},
+		{
+			caseName: "異常系: 無効化されたユーザーの場合、Forbiddenエラーを返す",
+			params:   usecase.UpdateMeParams{UserID: userID, DisplayName: &displayName},
+			setupMock: func(t *testing.T, userRepo *MockUMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, &testDisabledAt), nil)
+			},
+			wantErr:     true,
+			errContains: "user is disabled",
+		},
+		{
+			caseName: "異常系: 保存でエラーが発生した場合、エラーを返す",
+			params:   usecase.UpdateMeParams{UserID: userID, DisplayName: &displayName},
+			setupMock: func(t *testing.T, userRepo *MockUMUserRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(newTestUser(t, userID, nil), nil)
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("repository error"))
+			},
+			wantErr:     true,
+			errContains: "failed to update user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo := NewMockUMUserRepository(ctrl)
+			tt.setupMock(t, userRepo)
+
+			usecase := usecase.NewUpdateMeUsecase(userRepo)
+
+			// Act
+			got, err := usecase.Execute(context.Background(), tt.params)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
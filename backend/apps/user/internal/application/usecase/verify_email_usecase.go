@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+)
+
+// VerifyEmailParams はメールアドレス確認の入力
+type VerifyEmailParams struct {
+	Token string
+}
+
+type VEAccountTokenRepository interface {
+	FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error)
+	MarkUsed(ctx context.Context, token *entity.AccountToken) error
+}
+
+type VECredentialRepository interface {
+	FindByUserID(ctx context.Context, userID id.UserID) (*entity.Credential, error)
+	Update(ctx context.Context, credential *entity.Credential) error
+}
+
+type VETxManager interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type VerifyEmailUsecase struct {
+	tokenRepo      VEAccountTokenRepository
+	credentialRepo VECredentialRepository
+	txManager      VETxManager
+}
+
+func NewVerifyEmailUsecase(tokenRepo VEAccountTokenRepository, credentialRepo VECredentialRepository, txManager VETxManager) *VerifyEmailUsecase {
+	return &VerifyEmailUsecase{
+		tokenRepo:      tokenRepo,
+		credentialRepo: credentialRepo,
+		txManager:      txManager,
+	}
+}
+
+// Execute はメールで送ったトークンを使用してメールアドレスを確認済みにする
+func (u *VerifyEmailUsecase) Execute(ctx context.Context, params VerifyEmailParams) error {
+	now := time.Now()
+	token, err := findUsableAccountToken(ctx, u.tokenRepo, params.Token, entity.TokenPurposeEmailVerification, now)
+	if err != nil {
+		return err
+	}
+
+	// トークンの使用とメールアドレスの確認は同一トランザクションで行う
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.tokenRepo.MarkUsed(ctx, token); err != nil {
+			if isNotFound(err) {
+				return errs.NewValidationError("invalid or expired token", err)
+			}
+			return fmt.Errorf("failed to mark token as used: %w", err)
+		}
+
+		credential, err := u.credentialRepo.FindByUserID(ctx, token.UserID())
+		if err != nil {
+			return fmt.Errorf("failed to find credential: %w", err)
+		}
+		credential.VerifyEmail(now)
+		if err := u.credentialRepo.Update(ctx, credential); err != nil {
+			return fmt.Errorf("failed to update credential: %w", err)
+		}
+		return nil
+	})
+}
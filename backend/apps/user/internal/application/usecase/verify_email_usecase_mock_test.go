@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/application/usecase/verify_email_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/application/usecase/verify_email_usecase.go -destination=./apps/user/internal/application/usecase/verify_email_usecase_mock_test.go -package=usecase_test
+//
+
+// Package usecase_test is a generated GoMock package.
+package usecase_test
+
+import (
+	context "context"
+	entity "poketier/apps/user/internal/domain/entity"
+	id "poketier/pkg/vo/id"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVEAccountTokenRepository is a mock of VEAccountTokenRepository interface.
+type MockVEAccountTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVEAccountTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVEAccountTokenRepositoryMockRecorder is the mock recorder for MockVEAccountTokenRepository.
+type MockVEAccountTokenRepositoryMockRecorder struct {
+	mock *MockVEAccountTokenRepository
+}
+
+// NewMockVEAccountTokenRepository creates a new mock instance.
+func NewMockVEAccountTokenRepository(ctrl *gomock.Controller) *MockVEAccountTokenRepository {
+	mock := &MockVEAccountTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockVEAccountTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVEAccountTokenRepository) EXPECT() *MockVEAccountTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByHash mocks base method.
+func (m *MockVEAccountTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, hash)
+	ret0, _ := ret[0].(*entity.AccountToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockVEAccountTokenRepositoryMockRecorder) FindByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockVEAccountTokenRepository)(nil).FindByHash), ctx, hash)
+}
+
+// MarkUsed mocks base method.
+func (m *MockVEAccountTokenRepository) MarkUsed(ctx context.Context, token *entity.AccountToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockVEAccountTokenRepositoryMockRecorder) MarkUsed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockVEAccountTokenRepository)(nil).MarkUsed), ctx, token)
+}
+
+// MockVECredentialRepository is a mock of VECredentialRepository interface.
+type MockVECredentialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVECredentialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVECredentialRepositoryMockRecorder is the mock recorder for MockVECredentialRepository.
+type MockVECredentialRepositoryMockRecorder struct {
+	mock *MockVECredentialRepository
+}
+
+// NewMockVECredentialRepository creates a new mock instance.
+func NewMockVECredentialRepository(ctrl *gomock.Controller) *MockVECredentialRepository {
+	mock := &MockVECredentialRepository{ctrl: ctrl}
+	mock.recorder = &MockVECredentialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVECredentialRepository) EXPECT() *MockVECredentialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockVECredentialRepository) FindByUserID(ctx context.Context, userID id.UserID) (*entity.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockVECredentialRepositoryMockRecorder) FindByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockVECredentialRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockVECredentialRepository) Update(ctx context.Context, credential *entity.Credential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, credential)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVECredentialRepositoryMockRecorder) Update(ctx, credential any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVECredentialRepository)(nil).Update), ctx, credential)
+}
+
+// MockVETxManager is a mock of VETxManager interface.
+type MockVETxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockVETxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockVETxManagerMockRecorder is the mock recorder for MockVETxManager.
+type MockVETxManagerMockRecorder struct {
+	mock *MockVETxManager
+}
+
+// NewMockVETxManager creates a new mock instance.
+func NewMockVETxManager(ctrl *gomock.Controller) *MockVETxManager {
+	mock := &MockVETxManager{ctrl: ctrl}
+	mock.recorder = &MockVETxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVETxManager) EXPECT() *MockVETxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockVETxManager) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockVETxManagerMockRecorder) RunInTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockVETxManager)(nil).RunInTx), ctx, fn)
+}
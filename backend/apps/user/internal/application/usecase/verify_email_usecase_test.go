@@ -0,0 +1,155 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/errs/errstest"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyEmailUsecase_Execute(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		tokenRepo      *MockVEAccountTokenRepository
+		credentialRepo *MockVECredentialRepository
+		txManager      *MockVETxManager
+	}
+
+	runInTx := func(m mocks) {
+		m.txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			},
+		)
+	}
+
+	userID := id.NewUserID()
+	issue := func(t *testing.T, purpose entity.TokenPurpose, issuedAt time.Time) (*entity.AccountToken, string) {
+		t.Helper()
+		token, raw, err := entity.IssueAccountToken(userID, purpose, issuedAt)
+		require.NoError(t, err, "failed to issue token")
+		return token, raw
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(t *testing.T, m mocks) string
+		wantErr     bool
+		wantErrType error
+		errContains string
+	}{
+		{
+			caseName: "正常系: トークンを使用済みにしてメールアドレスを確認済みにする",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposeEmailVerification, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), entity.HashAccountToken(raw)).Return(token, nil)
+				runInTx(m)
+				m.tokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Cond(func(token *entity.AccountToken) bool {
+					return token.UsedAt() != nil
+				})).Return(nil)
+				m.credentialRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(
+					entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 0, nil), nil)
+				m.credentialRepo.EXPECT().Update(gomock.Any(), gomock.Cond(func(credential *entity.Credential) bool {
+					return credential.IsEmailVerified()
+				})).Return(nil)
+				return raw
+			},
+		},
+		{
+			caseName: "異常系: トークンが存在しない場合、バリデーションエラーを返す",
+			setupMock: func(t *testing.T, m mocks) string {
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("account token not found", nil))
+				return "unknown"
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired token",
+		},
+		{
+			caseName: "異常系: 期限切れのトークンの場合、バリデーションエラーを返す",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposeEmailVerification, time.Now().Add(-25*time.Hour))
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return raw
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+			errContains: "invalid or expired token",
+		},
+		{
+			caseName: "異常系: パスワード再設定のトークンの場合、バリデーションエラーを返す",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposePasswordReset, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				return raw
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: 同時に使用されて使用済みになっていた場合、バリデーションエラーを返す",
+			setupMock: func(t *testing.T, m mocks) string {
+				token, raw := issue(t, entity.TokenPurposeEmailVerification, time.Now())
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(token, nil)
+				runInTx(m)
+				m.tokenRepo.EXPECT().MarkUsed(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("account token not found or already used", nil))
+				return raw
+			},
+			wantErr:     true,
+			wantErrType: errs.ErrBadRequest,
+		},
+		{
+			caseName: "異常系: トークンの取得でエラーが発生した場合、エラーを返す",
+			setupMock: func(t *testing.T, m mocks) string {
+				m.tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+				return "raw"
+			},
+			wantErr:     true,
+			errContains: "failed to find account token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks{
+				tokenRepo:      NewMockVEAccountTokenRepository(ctrl),
+				credentialRepo: NewMockVECredentialRepository(ctrl),
+				txManager:      NewMockVETxManager(ctrl),
+			}
+			raw := tt.setupMock(t, m)
+			uc := usecase.NewVerifyEmailUsecase(m.tokenRepo, m.credentialRepo, m.txManager)
+
+			// Act
+			err := uc.Execute(context.Background(), usecase.VerifyEmailParams{Token: raw})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				if tt.wantErrType != nil {
+					errstest.AssertType(t, err, tt.wantErrType)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains, "error message does not contain expected text")
+				}
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/pkg/vo/id"
+)
+
+// ErrInvalidAccountToken はトークンが用途違い・使用済み・期限切れのいずれかであることを表す
+var ErrInvalidAccountToken = errors.New("invalid or expired token")
+
+// TokenPurpose はワンタイムトークンの用途
+type TokenPurpose string
+
+const (
+	// TokenPurposeEmailVerification はメールアドレスの確認
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+	// TokenPurposePasswordReset はパスワードの再設定
+	TokenPurposePasswordReset TokenPurpose = "password_reset"
+)
+
+// ParseTokenPurpose は文字列をTokenPurposeに変換する
+func ParseTokenPurpose(s string) (TokenPurpose, error) {
+	purpose := TokenPurpose(s)
+	switch purpose {
+	case TokenPurposeEmailVerification, TokenPurposePasswordReset:
+		return purpose, nil
+	default:
+		return "", fmt.Errorf("invalid token purpose: %q", s)
+	}
+}
+
+// TTL は用途ごとのトークンの有効期間を返す
+func (p TokenPurpose) TTL() time.Duration {
+	if p == TokenPurposePasswordReset {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// String はTokenPurposeの文字列表現を返す
+func (p TokenPurpose) String() string {
+	return string(p)
+}
+
+// AccountToken はメールで送るワンタイムトークン
+// トークン自体は保存せず、ハッシュのみを保持する
+type AccountToken struct {
+	hash      string
+	userID    id.UserID
+	purpose   TokenPurpose
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+// IssueAccountToken は新しいトークンを発行し、AccountTokenとメールで送るトークン文字列を返す
+func IssueAccountToken(userID id.UserID, purpose TokenPurpose, now time.Time) (*AccountToken, string, error) {
+	raw, err := generateRawToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &AccountToken{
+		hash:      HashAccountToken(raw),
+		userID:    userID,
+		purpose:   purpose,
+		expiresAt: now.Add(purpose.TTL()),
+	}, raw, nil
+}
+
+// ReconstructAccountToken は永続化されたデータからAccountTokenを復元する
+func ReconstructAccountToken(hash string, userID id.UserID, purpose TokenPurpose, expiresAt time.Time, usedAt *time.Time) *AccountToken {
+	return &AccountToken{
+		hash:      hash,
+		userID:    userID,
+		purpose:   purpose,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+// HashAccountToken はトークン文字列のハッシュ（SHA-256の16進数）を返す
+func HashAccountToken(raw string) string {
+	return hashRawToken(raw)
+}
+
+// generateRawToken は推測できない32バイトの乱数をbase64url（パディングなし）でエンコードしたトークン文字列を返す
+func generateRawToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashRawToken はトークン文字列のSHA-256ハッシュを16進数で返す
+func hashRawToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Hash はトークンのハッシュを返す
+func (t *AccountToken) Hash() string {
+	return t.hash
+}
+
+// UserID はトークンを発行したユーザーのIDを返す
+func (t *AccountToken) UserID() id.UserID {
+	return t.userID
+}
+
+// Purpose はトークンの用途を返す
+func (t *AccountToken) Purpose() TokenPurpose {
+	return t.purpose
+}
+
+// ExpiresAt はトークンの有効期限を返す
+func (t *AccountToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// UsedAt はトークンを使用した日時を返す。未使用の場合は nil
+func (t *AccountToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+// Use はトークンを使用済みにする
+// 用途が異なる・使用済み・期限切れの場合は ErrInvalidAccountToken を返す
+func (t *AccountToken) Use(purpose TokenPurpose, now time.Time) error {
+	if t.purpose != purpose || t.usedAt != nil || !now.Before(t.expiresAt) {
+		return ErrInvalidAccountToken
+	}
+	t.usedAt = &now
+	return nil
+}
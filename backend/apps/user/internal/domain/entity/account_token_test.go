@@ -0,0 +1,108 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAccountToken(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName      string
+		purpose       entity.TokenPurpose
+		wantExpiresAt time.Time
+	}{
+		{
+			caseName:      "正常系: メールアドレス確認のトークンは24時間有効",
+			purpose:       entity.TokenPurposeEmailVerification,
+			wantExpiresAt: now.Add(24 * time.Hour),
+		},
+		{
+			caseName:      "正常系: パスワード再設定のトークンは1時間有効",
+			purpose:       entity.TokenPurposePasswordReset,
+			wantExpiresAt: now.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			token, raw, err := entity.IssueAccountToken(id.NewUserID(), tt.purpose, now)
+
+			// Assert
+			require.NoError(t, err, "IssueAccountToken should not return error")
+			assert.NotEmpty(t, raw, "raw token should not be empty")
+			assert.NotEqual(t, raw, token.Hash(), "raw token should not be stored")
+			assert.Equal(t, entity.HashAccountToken(raw), token.Hash(), "hash should be derived from raw token")
+			assert.Equal(t, tt.wantExpiresAt, token.ExpiresAt(), "expires at should match")
+			assert.Nil(t, token.UsedAt(), "new token should not be used")
+		})
+	}
+}
+
+func TestAccountToken_Use(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	usedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		caseName string
+		token    *entity.AccountToken
+		purpose  entity.TokenPurpose
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 未使用で有効期限内のトークン",
+			token:    entity.ReconstructAccountToken("hash", id.NewUserID(), entity.TokenPurposePasswordReset, now.Add(time.Minute), nil),
+			purpose:  entity.TokenPurposePasswordReset,
+		},
+		{
+			caseName: "異常系: 用途が異なる",
+			token:    entity.ReconstructAccountToken("hash", id.NewUserID(), entity.TokenPurposeEmailVerification, now.Add(time.Minute), nil),
+			purpose:  entity.TokenPurposePasswordReset,
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 使用済み",
+			token:    entity.ReconstructAccountToken("hash", id.NewUserID(), entity.TokenPurposePasswordReset, now.Add(time.Minute), &usedAt),
+			purpose:  entity.TokenPurposePasswordReset,
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 有効期限切れ",
+			token:    entity.ReconstructAccountToken("hash", id.NewUserID(), entity.TokenPurposePasswordReset, now, nil),
+			purpose:  entity.TokenPurposePasswordReset,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := tt.token.Use(tt.purpose, now)
+
+			// Assert
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidAccountToken, "Use should return ErrInvalidAccountToken")
+				return
+			}
+			require.NoError(t, err, "Use should not return error")
+			require.NotNil(t, tt.token.UsedAt(), "token should be marked as used")
+			assert.Equal(t, now, *tt.token.UsedAt(), "used at should be now")
+		})
+	}
+}
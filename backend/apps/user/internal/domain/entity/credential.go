@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"poketier/pkg/vo/id"
+)
+
+const (
+	maxEmailLength    = 254
+	minPasswordLength = 8
+	maxPasswordLength = 128
+
+	// MaxFailedLogins はアカウントをロックするまでに許容する連続したログイン失敗回数
+	MaxFailedLogins = 5
+	// LockoutDuration はログイン失敗が続いた場合にアカウントをロックする期間
+	LockoutDuration = 15 * time.Minute
+)
+
+// Credential はメールアドレス・パスワードでログインするユーザーの認証情報
+// ソーシャルログインのみのユーザーは Credential を持たない
+type Credential struct {
+	userID           id.UserID
+	email            string
+	passwordHash     string
+	emailVerifiedAt  *time.Time
+	failedLoginCount int
+	lockedUntil      *time.Time
+}
+
+// NewCredential はメールアドレスが未確認の新しいCredentialを作成する
+// email は NormalizeEmail で正規化して保存する
+func NewCredential(userID id.UserID, email, passwordHash string) (*Credential, error) {
+	normalized, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if passwordHash == "" {
+		return nil, errors.New("password hash cannot be empty")
+	}
+
+	return &Credential{
+		userID:       userID,
+		email:        normalized,
+		passwordHash: passwordHash,
+	}, nil
+}
+
+// ReconstructCredential は永続化されたデータからCredentialを復元する
+func ReconstructCredential(
+	userID id.UserID,
+	email, passwordHash string,
+	emailVerifiedAt *time.Time,
+	failedLoginCount int,
+	lockedUntil *time.Time,
+) *Credential {
+	return &Credential{
+		userID:           userID,
+		email:            email,
+		passwordHash:     passwordHash,
+		emailVerifiedAt:  emailVerifiedAt,
+		failedLoginCount: failedLoginCount,
+		lockedUntil:      lockedUntil,
+	}
+}
+
+// UserID は認証情報を持つユーザーのIDを返す
+func (c *Credential) UserID() id.UserID {
+	return c.userID
+}
+
+// Email は正規化されたメールアドレスを返す
+func (c *Credential) Email() string {
+	return c.email
+}
+
+// PasswordHash はパスワードのハッシュを返す
+func (c *Credential) PasswordHash() string {
+	return c.passwordHash
+}
+
+// EmailVerifiedAt はメールアドレスを確認した日時を返す。未確認の場合は nil
+func (c *Credential) EmailVerifiedAt() *time.Time {
+	return c.emailVerifiedAt
+}
+
+// IsEmailVerified はメールアドレスを確認済みかどうかを返す
+func (c *Credential) IsEmailVerified() bool {
+	return c.emailVerifiedAt != nil
+}
+
+// FailedLoginCount は連続したログイン失敗回数を返す
+func (c *Credential) FailedLoginCount() int {
+	return c.failedLoginCount
+}
+
+// LockedUntil はログインのロックが解除される日時を返す。ロックされたことがない場合は nil
+func (c *Credential) LockedUntil() *time.Time {
+	return c.lockedUntil
+}
+
+// IsLocked は指定した時刻にログインがロックされているかどうかを返す
+func (c *Credential) IsLocked(now time.Time) bool {
+	return c.lockedUntil != nil && now.Before(*c.lockedUntil)
+}
+
+// VerifyEmail はメールアドレスを確認済みにする。確認済みの場合は確認日時を変更しない
+func (c *Credential) VerifyEmail(at time.Time) {
+	if c.IsEmailVerified() {
+		return
+	}
+	c.emailVerifiedAt = &at
+}
+
+// RecordLoginFailure はログインの失敗を記録する
+// 連続した失敗が MaxFailedLogins に達した場合は LockoutDuration の間ロックし、失敗回数を数え直す
+func (c *Credential) RecordLoginFailure(now time.Time) {
+	c.failedLoginCount++
+	if c.failedLoginCount < MaxFailedLogins {
+		return
+	}
+
+	lockedUntil := now.Add(LockoutDuration)
+	c.lockedUntil = &lockedUntil
+	c.failedLoginCount = 0
+}
+
+// RecordLoginSuccess はログインの成功を記録し、失敗回数とロックをリセットする
+func (c *Credential) RecordLoginSuccess() {
+	c.failedLoginCount = 0
+	c.lockedUntil = nil
+}
+
+// ChangePassword はパスワードのハッシュを変更し、失敗回数とロックをリセットする
+func (c *Credential) ChangePassword(passwordHash string) error {
+	if passwordHash == "" {
+		return errors.New("password hash cannot be empty")
+	}
+
+	c.passwordHash = passwordHash
+	c.RecordLoginSuccess()
+	return nil
+}
+
+// NormalizeEmail は前後の空白を取り除いて小文字にしたメールアドレスを返す
+// 表示名付きの形式（"Ash <ash@example.com>"）は受け付けない
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return "", errors.New("email cannot be empty")
+	}
+	if len(email) > maxEmailLength {
+		return "", errors.New("email must be 254 characters or less")
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || addr.Name != "" {
+		return "", errors.New("email is invalid")
+	}
+	return email, nil
+}
+
+// ValidatePassword はパスワードの長さを検証する
+func ValidatePassword(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < minPasswordLength {
+		return errors.New("password must be at least 8 characters")
+	}
+	if length > maxPasswordLength {
+		return errors.New("password must be 128 characters or less")
+	}
+	return nil
+}
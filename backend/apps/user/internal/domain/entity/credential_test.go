@@ -0,0 +1,210 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredential(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName     string
+		email        string
+		passwordHash string
+		wantEmail    string
+		wantErr      bool
+	}{
+		{
+			caseName:     "正常系: メールアドレスは小文字に正規化される",
+			email:        "  Ash.Ketchum@Example.COM ",
+			passwordHash: "$argon2id$hash",
+			wantEmail:    "ash.ketchum@example.com",
+		},
+		{
+			caseName:     "異常系: メールアドレスが空",
+			email:        " ",
+			passwordHash: "$argon2id$hash",
+			wantErr:      true,
+		},
+		{
+			caseName:     "異常系: メールアドレスの形式が不正",
+			email:        "ash@",
+			passwordHash: "$argon2id$hash",
+			wantErr:      true,
+		},
+		{
+			caseName:     "異常系: 表示名付きのメールアドレス",
+			email:        "Ash <ash@example.com>",
+			passwordHash: "$argon2id$hash",
+			wantErr:      true,
+		},
+		{
+			caseName:     "異常系: メールアドレスが254文字を超える",
+			email:        strings.Repeat("a", 64) + "@" + strings.Repeat("b", 186) + ".com",
+			passwordHash: "$argon2id$hash",
+			wantErr:      true,
+		},
+		{
+			caseName:     "異常系: パスワードのハッシュが空",
+			email:        "ash@example.com",
+			passwordHash: "",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.NewCredential(id.NewUserID(), tt.email, tt.passwordHash)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantEmail, got.Email(), "email does not match")
+			assert.False(t, got.IsEmailVerified(), "new credential should not be verified")
+			assert.Equal(t, 0, got.FailedLoginCount(), "failed login count should be zero")
+		})
+	}
+}
+
+func TestCredential_RecordLoginFailure(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 連続した失敗が上限に達するとロックされ、期間を過ぎると解除される", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		credential := entity.ReconstructCredential(id.NewUserID(), "ash@example.com", "$argon2id$hash", nil, 0, nil)
+
+		// Act
+		for range entity.MaxFailedLogins - 1 {
+			credential.RecordLoginFailure(now)
+		}
+		lockedBeforeLimit := credential.IsLocked(now)
+		credential.RecordLoginFailure(now)
+
+		// Assert
+		assert.False(t, lockedBeforeLimit, "credential should not be locked before reaching the limit")
+		assert.True(t, credential.IsLocked(now), "credential should be locked after reaching the limit")
+		assert.True(t, credential.IsLocked(now.Add(entity.LockoutDuration-time.Second)), "credential should be locked during lockout")
+		assert.False(t, credential.IsLocked(now.Add(entity.LockoutDuration)), "credential should be unlocked after lockout")
+		assert.Equal(t, 0, credential.FailedLoginCount(), "failed login count should be reset on lock")
+	})
+
+	t.Run("正常系: ログインに成功すると失敗回数とロックがリセットされる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		lockedUntil := now.Add(time.Minute)
+		credential := entity.ReconstructCredential(id.NewUserID(), "ash@example.com", "$argon2id$hash", nil, 3, &lockedUntil)
+
+		// Act
+		credential.RecordLoginSuccess()
+
+		// Assert
+		assert.Equal(t, 0, credential.FailedLoginCount(), "failed login count should be reset")
+		assert.False(t, credential.IsLocked(now), "credential should be unlocked")
+	})
+}
+
+func TestCredential_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: パスワードを変更するとロックが解除される", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		lockedUntil := now.Add(time.Minute)
+		credential := entity.ReconstructCredential(id.NewUserID(), "ash@example.com", "$argon2id$old", nil, 2, &lockedUntil)
+
+		// Act
+		err := credential.ChangePassword("$argon2id$new")
+
+		// Assert
+		require.NoError(t, err, "ChangePassword should not return error")
+		assert.Equal(t, "$argon2id$new", credential.PasswordHash(), "password hash should be changed")
+		assert.False(t, credential.IsLocked(now), "credential should be unlocked")
+	})
+
+	t.Run("異常系: ハッシュが空", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		credential := entity.ReconstructCredential(id.NewUserID(), "ash@example.com", "$argon2id$old", nil, 0, nil)
+
+		// Act
+		err := credential.ChangePassword("")
+
+		// Assert
+		assert.Error(t, err, "ChangePassword should return error")
+		assert.Equal(t, "$argon2id$old", credential.PasswordHash(), "password hash should not be changed")
+	})
+}
+
+func TestCredential_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 確認済みの場合は確認日時を変更しない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		first := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+		credential := entity.ReconstructCredential(id.NewUserID(), "ash@example.com", "$argon2id$hash", nil, 0, nil)
+
+		// Act
+		credential.VerifyEmail(first)
+		credential.VerifyEmail(first.Add(time.Hour))
+
+		// Assert
+		require.NotNil(t, credential.EmailVerifiedAt(), "email should be verified")
+		assert.Equal(t, first, *credential.EmailVerifiedAt(), "verified at should not change")
+	})
+}
+
+func TestValidatePassword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		password string
+		wantErr  bool
+	}{
+		{caseName: "正常系: 8文字", password: "abcdefgh"},
+		{caseName: "正常系: 128文字", password: strings.Repeat("あ", 128)},
+		{caseName: "異常系: 7文字", password: "abcdefg", wantErr: true},
+		{caseName: "異常系: 129文字", password: strings.Repeat("a", 129), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := entity.ValidatePassword(tt.password)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"poketier/pkg/vo/id"
+)
+
+const (
+	maxIdentitySubjectLength = 255
+
+	// defaultExternalDisplayName はプロバイダーから名前もメールアドレスも受け取れなかった場合の表示名
+	defaultExternalDisplayName = "ユーザー"
+)
+
+// Identity は外部IDプロバイダーのアカウントとユーザーの紐付け
+// プロバイダー内でアカウントを一意に識別する subject で照合し、メールアドレスは参考情報として保持する
+type Identity struct {
+	provider string
+	subject  string
+	userID   id.UserID
+	email    string
+}
+
+// NewIdentity は新しいIdentityインスタンスを作成する
+func NewIdentity(provider, subject string, userID id.UserID, email string) (*Identity, error) {
+	identity := &Identity{
+		provider: provider,
+		subject:  subject,
+		userID:   userID,
+		email:    email,
+	}
+
+	if err := identity.validate(); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// ReconstructIdentity は永続化されたデータからIdentityを復元する
+func ReconstructIdentity(provider, subject string, userID id.UserID, email string) *Identity {
+	return &Identity{
+		provider: provider,
+		subject:  subject,
+		userID:   userID,
+		email:    email,
+	}
+}
+
+// Provider はプロバイダー名を返す
+func (i *Identity) Provider() string {
+	return i.provider
+}
+
+// Subject はプロバイダー内のアカウントの識別子を返す
+func (i *Identity) Subject() string {
+	return i.subject
+}
+
+// UserID は紐付いたユーザーのIDを返す
+func (i *Identity) UserID() id.UserID {
+	return i.userID
+}
+
+// Email はプロバイダーから受け取ったメールアドレスを返す。受け取っていない場合は空文字
+func (i *Identity) Email() string {
+	return i.email
+}
+
+// validate は全体のバリデーションを実行する
+func (i *Identity) validate() error {
+	if i.provider == "" {
+		return errors.New("provider cannot be empty")
+	}
+	if i.subject == "" {
+		return errors.New("subject cannot be empty")
+	}
+	if len(i.subject) > maxIdentitySubjectLength {
+		return errors.New("subject must be 255 bytes or less")
+	}
+	return nil
+}
+
+// ExternalDisplayName はプロバイダーから受け取った名前から、新しいユーザーの表示名を決める
+// 名前が空の場合はメールアドレスの@より前を使用し、表示名の上限を超える部分は切り詰める
+func ExternalDisplayName(name, email string) string {
+	displayName := strings.TrimSpace(name)
+	if displayName == "" {
+		local, _, _ := strings.Cut(email, "@")
+		displayName = strings.TrimSpace(local)
+	}
+	if displayName == "" {
+		return defaultExternalDisplayName
+	}
+
+	if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+		displayName = strings.TrimSpace(string([]rune(displayName)[:maxDisplayNameLength]))
+	}
+	return displayName
+}
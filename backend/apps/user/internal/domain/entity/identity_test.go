@@ -0,0 +1,111 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdentity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		provider string
+		subject  string
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: プロバイダーと subject を指定",
+			provider: "google",
+			subject:  "1234567890",
+		},
+		{
+			caseName: "異常系: プロバイダーが空",
+			subject:  "1234567890",
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: subject が空",
+			provider: "google",
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: subject が255バイトを超える",
+			provider: "google",
+			subject:  strings.Repeat("a", 256),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			userID := id.NewUserID()
+
+			// Act
+			identity, err := entity.NewIdentity(tt.provider, tt.subject, userID, "ash@example.com")
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "NewIdentity should return error")
+				return
+			}
+			assert.NoError(t, err, "NewIdentity should not return error")
+			assert.Equal(t, tt.provider, identity.Provider(), "provider should match")
+			assert.Equal(t, tt.subject, identity.Subject(), "subject should match")
+			assert.Equal(t, userID, identity.UserID(), "user id should match")
+			assert.Equal(t, "ash@example.com", identity.Email(), "email should match")
+		})
+	}
+}
+
+func TestExternalDisplayName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		name     string
+		email    string
+		want     string
+	}{
+		{
+			caseName: "正常系: 名前の前後の空白を取り除く",
+			name:     "  サトシ  ",
+			email:    "ash@example.com",
+			want:     "サトシ",
+		},
+		{
+			caseName: "正常系: 名前が空の場合はメールアドレスの@より前",
+			email:    "ash@example.com",
+			want:     "ash",
+		},
+		{
+			caseName: "正常系: 名前もメールアドレスも空の場合は既定の表示名",
+			want:     "ユーザー",
+		},
+		{
+			caseName: "正常系: 30文字を超える名前は切り詰める",
+			name:     strings.Repeat("あ", 31),
+			want:     strings.Repeat("あ", 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := entity.ExternalDisplayName(tt.name, tt.email)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "display name should match")
+		})
+	}
+}
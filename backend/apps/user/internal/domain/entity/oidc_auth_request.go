@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/pkg/oidc"
+)
+
+// OIDCAuthRequestTTL は認可リクエストを開始してからコールバックまでの有効期間
+const OIDCAuthRequestTTL = 10 * time.Minute
+
+// ErrInvalidOIDCState は state がプロバイダー違い・期限切れのいずれかであることを表す
+var ErrInvalidOIDCState = errors.New("invalid or expired state")
+
+// OIDCAuthRequest は外部IDプロバイダーへの認可リクエスト（認可コードフロー + PKCE）
+// state 自体は保存せず、ハッシュのみを保持する。code_verifier と nonce はコールバックでの検証に使用する
+type OIDCAuthRequest struct {
+	stateHash    string
+	provider     string
+	codeVerifier string
+	nonce        string
+	expiresAt    time.Time
+}
+
+// NewOIDCAuthRequest は新しい認可リクエストを作成し、OIDCAuthRequestとプロバイダーに渡す state を返す
+func NewOIDCAuthRequest(provider string, now time.Time) (*OIDCAuthRequest, string, error) {
+	if provider == "" {
+		return nil, "", errors.New("provider cannot be empty")
+	}
+
+	state, err := oidc.NewRandomValue()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	codeVerifier, err := oidc.NewRandomValue()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+	nonce, err := oidc.NewRandomValue()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return &OIDCAuthRequest{
+		stateHash:    HashOIDCState(state),
+		provider:     provider,
+		codeVerifier: codeVerifier,
+		nonce:        nonce,
+		expiresAt:    now.Add(OIDCAuthRequestTTL),
+	}, state, nil
+}
+
+// ReconstructOIDCAuthRequest は永続化されたデータからOIDCAuthRequestを復元する
+func ReconstructOIDCAuthRequest(stateHash, provider, codeVerifier, nonce string, expiresAt time.Time) *OIDCAuthRequest {
+	return &OIDCAuthRequest{
+		stateHash:    stateHash,
+		provider:     provider,
+		codeVerifier: codeVerifier,
+		nonce:        nonce,
+		expiresAt:    expiresAt,
+	}
+}
+
+// HashOIDCState は state のハッシュ（SHA-256の16進数）を返す
+func HashOIDCState(state string) string {
+	return HashAccountToken(state)
+}
+
+// StateHash は state のハッシュを返す
+func (r *OIDCAuthRequest) StateHash() string {
+	return r.stateHash
+}
+
+// Provider はプロバイダー名を返す
+func (r *OIDCAuthRequest) Provider() string {
+	return r.provider
+}
+
+// CodeVerifier は PKCE の code_verifier を返す
+func (r *OIDCAuthRequest) CodeVerifier() string {
+	return r.codeVerifier
+}
+
+// CodeChallenge は code_verifier から計算した S256 方式の code_challenge を返す
+func (r *OIDCAuthRequest) CodeChallenge() string {
+	return oidc.CodeChallengeS256(r.codeVerifier)
+}
+
+// Nonce はIDトークンに含まれるべき nonce を返す
+func (r *OIDCAuthRequest) Nonce() string {
+	return r.nonce
+}
+
+// ExpiresAt は認可リクエストの有効期限を返す
+func (r *OIDCAuthRequest) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// Verify はコールバックを受けたプロバイダーで、有効期限内に使用されているかを検証する
+// プロバイダーが異なる・期限切れの場合は ErrInvalidOIDCState を返す
+func (r *OIDCAuthRequest) Verify(provider string, now time.Time) error {
+	if r.provider != provider || !now.Before(r.expiresAt) {
+		return ErrInvalidOIDCState
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/oidc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOIDCAuthRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: state はハッシュのみを保持し、10分間有効", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		req, state, err := entity.NewOIDCAuthRequest("mock", now)
+
+		// Assert
+		require.NoError(t, err, "NewOIDCAuthRequest should not return error")
+		assert.NotEmpty(t, state, "state should not be empty")
+		assert.Equal(t, entity.HashOIDCState(state), req.StateHash(), "state hash should be derived from state")
+		assert.Equal(t, "mock", req.Provider(), "provider should match")
+		assert.NotEmpty(t, req.Nonce(), "nonce should not be empty")
+		assert.NotEqual(t, state, req.Nonce(), "nonce should differ from state")
+		assert.Equal(t, oidc.CodeChallengeS256(req.CodeVerifier()), req.CodeChallenge(), "code challenge should be derived from code verifier")
+		assert.Equal(t, now.Add(10*time.Minute), req.ExpiresAt(), "expires at should match")
+	})
+
+	t.Run("異常系: プロバイダー名が空", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		_, _, err := entity.NewOIDCAuthRequest("", now)
+
+		// Assert
+		assert.Error(t, err, "NewOIDCAuthRequest should return error")
+	})
+}
+
+func TestOIDCAuthRequest_Verify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	req := entity.ReconstructOIDCAuthRequest("hash", "mock", "verifier", "nonce", now.Add(time.Minute))
+
+	tests := []struct {
+		caseName string
+		provider string
+		now      time.Time
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 同じプロバイダーで有効期限内",
+			provider: "mock",
+			now:      now,
+		},
+		{
+			caseName: "異常系: プロバイダーが異なる",
+			provider: "google",
+			now:      now,
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 有効期限ちょうど",
+			provider: "mock",
+			now:      now.Add(time.Minute),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := req.Verify(tt.provider, tt.now)
+
+			// Assert
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidOIDCState, "Verify should return ErrInvalidOIDCState")
+				return
+			}
+			assert.NoError(t, err, "Verify should not return error")
+		})
+	}
+}
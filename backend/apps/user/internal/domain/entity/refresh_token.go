@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"poketier/pkg/vo/id"
+)
+
+// ErrRefreshTokenReused は交換済みのリフレッシュトークンが再び使用されたことを表す
+var ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+// RefreshToken はセッションで発行したリフレッシュトークン
+// 1回使用すると新しいトークンに交換し、交換済みのトークンが再び使用された場合はセッションごと失効させる
+// トークン自体は保存せず、ハッシュのみを保持する
+type RefreshToken struct {
+	hash      string
+	sessionID id.SessionID
+	usedAt    *time.Time
+}
+
+// IssueRefreshToken はセッションの新しいリフレッシュトークンを発行し、RefreshTokenとクライアントに返すトークン文字列を返す
+func IssueRefreshToken(sessionID id.SessionID) (*RefreshToken, string, error) {
+	raw, err := generateRawToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &RefreshToken{
+		hash:      HashRefreshToken(raw),
+		sessionID: sessionID,
+	}, raw, nil
+}
+
+// ReconstructRefreshToken は永続化されたデータからRefreshTokenを復元する
+func ReconstructRefreshToken(hash string, sessionID id.SessionID, usedAt *time.Time) *RefreshToken {
+	return &RefreshToken{
+		hash:      hash,
+		sessionID: sessionID,
+		usedAt:    usedAt,
+	}
+}
+
+// HashRefreshToken はトークン文字列のハッシュ（SHA-256の16進数）を返す
+func HashRefreshToken(raw string) string {
+	return hashRawToken(raw)
+}
+
+// Hash はトークンのハッシュを返す
+func (t *RefreshToken) Hash() string {
+	return t.hash
+}
+
+// SessionID はトークンを発行したセッションのIDを返す
+func (t *RefreshToken) SessionID() id.SessionID {
+	return t.sessionID
+}
+
+// UsedAt はトークンを交換した日時を返す。未使用の場合は nil
+func (t *RefreshToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+// Use はトークンを交換済みにする。既に交換済みの場合は ErrRefreshTokenReused を返す
+// 有効期限はセッションで管理する
+func (t *RefreshToken) Use(now time.Time) error {
+	if t.usedAt != nil {
+		return ErrRefreshTokenReused
+	}
+	t.usedAt = &now
+	return nil
+}
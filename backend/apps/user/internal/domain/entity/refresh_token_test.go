@@ -0,0 +1,73 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: トークン文字列は保存せず、ハッシュのみを保持する", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		sessionID := id.NewSessionID()
+
+		// Act
+		token, raw, err := entity.IssueRefreshToken(sessionID)
+
+		// Assert
+		require.NoError(t, err, "IssueRefreshToken should not return error")
+		assert.NotEmpty(t, raw, "raw token should not be empty")
+		assert.Equal(t, entity.HashRefreshToken(raw), token.Hash(), "hash should be derived from raw token")
+		assert.Equal(t, sessionID, token.SessionID(), "session id should match")
+		assert.Nil(t, token.UsedAt(), "new token should not be used")
+	})
+}
+
+func TestRefreshToken_Use(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	usedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		caseName string
+		token    *entity.RefreshToken
+		wantErr  error
+	}{
+		{
+			caseName: "正常系: 未使用のトークン",
+			token:    entity.ReconstructRefreshToken("hash", id.NewSessionID(), nil),
+		},
+		{
+			caseName: "異常系: 交換済みのトークン",
+			token:    entity.ReconstructRefreshToken("hash", id.NewSessionID(), &usedAt),
+			wantErr:  entity.ErrRefreshTokenReused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := tt.token.Use(now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "Use should return expected error")
+				return
+			}
+			assert.NoError(t, err, "Use should not return error")
+			assert.Equal(t, &now, tt.token.UsedAt(), "used at should be now")
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/pkg/vo/id"
+)
+
+const (
+	// SessionTTL はリフレッシュトークンを最後に使用してからセッションが期限切れになるまでの期間
+	SessionTTL = 30 * 24 * time.Hour
+
+	maxUserAgentLength = 512
+	maxIPAddressLength = 45
+)
+
+// ErrSessionInactive はセッションが失効済みまたは期限切れであることを表す
+var ErrSessionInactive = errors.New("session is revoked or expired")
+
+// SessionRevokeReason はセッションを失効させた理由
+type SessionRevokeReason string
+
+const (
+	// SessionRevokeReasonLogout はログアウト
+	SessionRevokeReasonLogout SessionRevokeReason = "logout"
+	// SessionRevokeReasonLogoutAll は全端末からのログアウト
+	SessionRevokeReasonLogoutAll SessionRevokeReason = "logout_all"
+	// SessionRevokeReasonTokenReuse は交換済みのリフレッシュトークンの再使用（トークンの漏洩の疑い）
+	SessionRevokeReasonTokenReuse SessionRevokeReason = "token_reuse"
+	// SessionRevokeReasonAdmin は管理者による強制ログアウト
+	SessionRevokeReasonAdmin SessionRevokeReason = "admin"
+	// SessionRevokeReasonPasswordReset はパスワードの再設定
+	SessionRevokeReasonPasswordReset SessionRevokeReason = "password_reset"
+)
+
+// ParseSessionRevokeReason は文字列をSessionRevokeReasonに変換する
+func ParseSessionRevokeReason(s string) (SessionRevokeReason, error) {
+	reason := SessionRevokeReason(s)
+	switch reason {
+	case SessionRevokeReasonLogout, SessionRevokeReasonLogoutAll, SessionRevokeReasonTokenReuse,
+		SessionRevokeReasonAdmin, SessionRevokeReasonPasswordReset:
+		return reason, nil
+	default:
+		return "", fmt.Errorf("invalid session revoke reason: %q", s)
+	}
+}
+
+// String はSessionRevokeReasonの文字列表現を返す
+func (r SessionRevokeReason) String() string {
+	return string(r)
+}
+
+// Session はログインごとのセッション（リフレッシュトークンのファミリー）
+// リフレッシュトークンを交換するたびに有効期限を延長し、失効させたセッションのリフレッシュトークンはすべて使用できなくなる
+type Session struct {
+	id            id.SessionID
+	userID        id.UserID
+	userAgent     string
+	ipAddress     string
+	createdAt     time.Time
+	lastUsedAt    time.Time
+	expiresAt     time.Time
+	revokedAt     *time.Time
+	revokedReason SessionRevokeReason
+}
+
+// NewSession はログインしたユーザーの新しいセッションを作成する
+// User-Agent とIPアドレスはセッション一覧の表示用で、保存できる長さに切り詰める
+func NewSession(userID id.UserID, userAgent, ipAddress string, now time.Time) *Session {
+	return &Session{
+		id:         id.NewSessionID(),
+		userID:     userID,
+		userAgent:  truncateRunes(userAgent, maxUserAgentLength),
+		ipAddress:  truncateRunes(ipAddress, maxIPAddressLength),
+		createdAt:  now,
+		lastUsedAt: now,
+		expiresAt:  now.Add(SessionTTL),
+	}
+}
+
+// ReconstructSession は永続化されたデータからSessionを復元する
+func ReconstructSession(
+	sessionID id.SessionID,
+	userID id.UserID,
+	userAgent, ipAddress string,
+	createdAt, lastUsedAt, expiresAt time.Time,
+	revokedAt *time.Time,
+	revokedReason SessionRevokeReason,
+) *Session {
+	return &Session{
+		id:            sessionID,
+		userID:        userID,
+		userAgent:     userAgent,
+		ipAddress:     ipAddress,
+		createdAt:     createdAt,
+		lastUsedAt:    lastUsedAt,
+		expiresAt:     expiresAt,
+		revokedAt:     revokedAt,
+		revokedReason: revokedReason,
+	}
+}
+
+// ID はセッションIDを返す
+func (s *Session) ID() id.SessionID {
+	return s.id
+}
+
+// UserID はセッションのユーザーのIDを返す
+func (s *Session) UserID() id.UserID {
+	return s.userID
+}
+
+// UserAgent は最後にリフレッシュトークンを使用したクライアントの User-Agent を返す
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+// IPAddress は最後にリフレッシュトークンを使用したクライアントのIPアドレスを返す
+func (s *Session) IPAddress() string {
+	return s.ipAddress
+}
+
+// CreatedAt はログインした日時を返す
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// LastUsedAt は最後にリフレッシュトークンを使用した日時を返す
+func (s *Session) LastUsedAt() time.Time {
+	return s.lastUsedAt
+}
+
+// ExpiresAt はセッションの有効期限を返す
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// RevokedAt はセッションを失効させた日時を返す。失効していない場合は nil
+func (s *Session) RevokedAt() *time.Time {
+	return s.revokedAt
+}
+
+// RevokedReason はセッションを失効させた理由を返す。失効していない場合は空文字
+func (s *Session) RevokedReason() SessionRevokeReason {
+	return s.revokedReason
+}
+
+// IsActive はセッションが失効しておらず、期限切れでもないかどうかを返す
+func (s *Session) IsActive(now time.Time) bool {
+	return s.revokedAt == nil && now.Before(s.expiresAt)
+}
+
+// Refresh はリフレッシュトークンの交換を記録し、有効期限を延長する
+// 失効済み・期限切れの場合は ErrSessionInactive を返す
+func (s *Session) Refresh(userAgent, ipAddress string, now time.Time) error {
+	if !s.IsActive(now) {
+		return ErrSessionInactive
+	}
+	s.userAgent = truncateRunes(userAgent, maxUserAgentLength)
+	s.ipAddress = truncateRunes(ipAddress, maxIPAddressLength)
+	s.lastUsedAt = now
+	s.expiresAt = now.Add(SessionTTL)
+	return nil
+}
+
+// Revoke はセッションを失効させる。既に失効している場合は最初の理由を残す
+func (s *Session) Revoke(reason SessionRevokeReason, now time.Time) {
+	if s.revokedAt != nil {
+		return
+	}
+	s.revokedAt = &now
+	s.revokedReason = reason
+}
+
+// truncateRunes は文字列を最大 limit 文字に切り詰める
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit])
+}
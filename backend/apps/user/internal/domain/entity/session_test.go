@@ -0,0 +1,178 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSession(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName      string
+		userAgent     string
+		wantUserAgent string
+	}{
+		{
+			caseName:      "正常系: 最後に使用した日時はログインした日時で、30日間有効",
+			userAgent:     "Mozilla/5.0",
+			wantUserAgent: "Mozilla/5.0",
+		},
+		{
+			caseName:      "正常系: 長すぎる User-Agent は512文字に切り詰める",
+			userAgent:     strings.Repeat("あ", 600),
+			wantUserAgent: strings.Repeat("あ", 512),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			userID := id.NewUserID()
+
+			// Act
+			session := entity.NewSession(userID, tt.userAgent, "198.51.100.7", now)
+
+			// Assert
+			assert.Equal(t, userID, session.UserID(), "user id should match")
+			assert.Equal(t, tt.wantUserAgent, session.UserAgent(), "user agent should match")
+			assert.Equal(t, "198.51.100.7", session.IPAddress(), "ip address should match")
+			assert.Equal(t, now, session.CreatedAt(), "created at should be now")
+			assert.Equal(t, now, session.LastUsedAt(), "last used at should be now")
+			assert.Equal(t, now.Add(entity.SessionTTL), session.ExpiresAt(), "expires at should be now + ttl")
+			assert.True(t, session.IsActive(now), "new session should be active")
+		})
+	}
+}
+
+func TestSession_Refresh(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-24 * time.Hour)
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		caseName string
+		session  *entity.Session
+		wantErr  error
+	}{
+		{
+			caseName: "正常系: 有効なセッションは最後に使用した日時から有効期限を延長する",
+			session:  entity.ReconstructSession(id.NewSessionID(), id.NewUserID(), "old", "192.0.2.1", createdAt, createdAt, now.Add(time.Hour), nil, ""),
+		},
+		{
+			caseName: "異常系: 期限切れ",
+			session:  entity.ReconstructSession(id.NewSessionID(), id.NewUserID(), "old", "192.0.2.1", createdAt, createdAt, now, nil, ""),
+			wantErr:  entity.ErrSessionInactive,
+		},
+		{
+			caseName: "異常系: 失効済み",
+			session:  entity.ReconstructSession(id.NewSessionID(), id.NewUserID(), "old", "192.0.2.1", createdAt, createdAt, now.Add(time.Hour), &revokedAt, entity.SessionRevokeReasonLogout),
+			wantErr:  entity.ErrSessionInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := tt.session.Refresh("Mozilla/5.0", "198.51.100.7", now)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "Refresh should return expected error")
+				assert.Equal(t, createdAt, tt.session.LastUsedAt(), "last used at should not change")
+				return
+			}
+			require.NoError(t, err, "Refresh should not return error")
+			assert.Equal(t, "Mozilla/5.0", tt.session.UserAgent(), "user agent should be updated")
+			assert.Equal(t, "198.51.100.7", tt.session.IPAddress(), "ip address should be updated")
+			assert.Equal(t, now, tt.session.LastUsedAt(), "last used at should be now")
+			assert.Equal(t, now.Add(entity.SessionTTL), tt.session.ExpiresAt(), "expires at should be extended")
+		})
+	}
+}
+
+func TestSession_Revoke(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 失効させたセッションは有効期限内でも無効になる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		session := entity.NewSession(id.NewUserID(), "", "", now)
+
+		// Act
+		session.Revoke(entity.SessionRevokeReasonLogout, now)
+
+		// Assert
+		assert.False(t, session.IsActive(now), "revoked session should not be active")
+		assert.Equal(t, &now, session.RevokedAt(), "revoked at should be now")
+		assert.Equal(t, entity.SessionRevokeReasonLogout, session.RevokedReason(), "reason should match")
+	})
+
+	t.Run("正常系: 既に失効している場合は最初の理由を残す", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		session := entity.NewSession(id.NewUserID(), "", "", now)
+		session.Revoke(entity.SessionRevokeReasonTokenReuse, now)
+
+		// Act
+		session.Revoke(entity.SessionRevokeReasonLogout, now.Add(time.Minute))
+
+		// Assert
+		assert.Equal(t, &now, session.RevokedAt(), "revoked at should not change")
+		assert.Equal(t, entity.SessionRevokeReasonTokenReuse, session.RevokedReason(), "reason should not change")
+	})
+}
+
+func TestParseSessionRevokeReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		value    string
+		want     entity.SessionRevokeReason
+		wantErr  bool
+	}{
+		{caseName: "正常系: logout", value: "logout", want: entity.SessionRevokeReasonLogout},
+		{caseName: "正常系: logout_all", value: "logout_all", want: entity.SessionRevokeReasonLogoutAll},
+		{caseName: "正常系: token_reuse", value: "token_reuse", want: entity.SessionRevokeReasonTokenReuse},
+		{caseName: "正常系: admin", value: "admin", want: entity.SessionRevokeReasonAdmin},
+		{caseName: "正常系: password_reset", value: "password_reset", want: entity.SessionRevokeReasonPasswordReset},
+		{caseName: "異常系: 未定義の理由", value: "expired", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.ParseSessionRevokeReason(tt.value)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "ParseSessionRevokeReason should return error")
+				return
+			}
+			assert.NoError(t, err, "ParseSessionRevokeReason should not return error")
+			assert.Equal(t, tt.want, got, "reason should match")
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+const maxDisplayNameLength = 30
+
+// User はシステム利用者のアカウントを表す集約ルート
+// ゲスト（未ログインの利用者）はUserとして永続化しない
+type User struct {
+	id          id.UserID
+	displayName string
+	role        role.Role
+	disabledAt  *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewUser は一般ユーザー権限の新しいUserインスタンスを作成する
+func NewUser(id id.UserID, displayName string) (*User, error) {
+	now := time.Now()
+	user := &User{
+		id:          id,
+		displayName: strings.TrimSpace(displayName),
+		role:        role.User,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	if err := user.validate(); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ReconstructUser は永続化されたデータからUserを復元する
+// disabledAt は無効化されていない場合 nil を渡す
+func ReconstructUser(
+	id id.UserID,
+	displayName string,
+	userRole role.Role,
+	disabledAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (*User, error) {
+	user := &User{
+		id:          id,
+		displayName: displayName,
+		role:        userRole,
+		disabledAt:  disabledAt,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+
+	if err := user.validate(); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ID はUserのIDを返す
+func (u *User) ID() id.UserID {
+	return u.id
+}
+
+// DisplayName は表示名を返す
+func (u *User) DisplayName() string {
+	return u.displayName
+}
+
+// Role は権限を返す
+func (u *User) Role() role.Role {
+	return u.role
+}
+
+// DisabledAt は無効化された日時を返す。無効化されていない場合は nil
+func (u *User) DisabledAt() *time.Time {
+	return u.disabledAt
+}
+
+// IsDisabled は無効化されているかどうかを返す
+func (u *User) IsDisabled() bool {
+	return u.disabledAt != nil
+}
+
+// CreatedAt は作成日時を返す
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// Rename は表示名を変更する。前後の空白は取り除く
+func (u *User) Rename(displayName string) error {
+	displayName = strings.TrimSpace(displayName)
+	if err := validDisplayName(displayName); err != nil {
+		return err
+	}
+
+	u.displayName = displayName
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeRole は権限を変更する
+func (u *User) ChangeRole(userRole role.Role) error {
+	if err := validRole(userRole); err != nil {
+		return err
+	}
+
+	u.role = userRole
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// Disable はユーザーを無効化する。無効化済みの場合は無効化日時を変更しない
+func (u *User) Disable(at time.Time) {
+	if u.IsDisabled() {
+		return
+	}
+	u.disabledAt = &at
+	u.updatedAt = time.Now()
+}
+
+// Enable は無効化されたユーザーを有効に戻す
+func (u *User) Enable() {
+	if !u.IsDisabled() {
+		return
+	}
+	u.disabledAt = nil
+	u.updatedAt = time.Now()
+}
+
+// validate は全体のバリデーションを実行する
+func (u *User) validate() error {
+	if err := validDisplayName(u.displayName); err != nil {
+		return err
+	}
+
+	if err := validRole(u.role); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validDisplayName は表示名のバリデーションを行う
+func validDisplayName(displayName string) error {
+	if displayName == "" {
+		return errors.New("display name cannot be empty")
+	}
+	if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+		return errors.New("display name must be 30 characters or less")
+	}
+	return nil
+}
+
+// validRole は権限のバリデーションを行う（ゲストは永続化されたユーザーに割り当てない）
+func validRole(userRole role.Role) error {
+	if !userRole.IsValid() {
+		return errors.New("role is invalid")
+	}
+	if userRole == role.Guest {
+		return errors.New("role cannot be guest")
+	}
+	return nil
+}
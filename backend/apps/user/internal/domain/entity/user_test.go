@@ -0,0 +1,229 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName        string
+		displayName     string
+		wantDisplayName string
+		wantErr         bool
+	}{
+		{
+			caseName:        "正常系: 一般ユーザー権限のUserが作成される",
+			displayName:     "配信者A",
+			wantDisplayName: "配信者A",
+		},
+		{
+			caseName:        "正常系: 表示名の前後の空白は取り除かれる",
+			displayName:     "  配信者A  ",
+			wantDisplayName: "配信者A",
+		},
+		{
+			caseName:        "正常系: 30文字の表示名が渡された場合",
+			displayName:     strings.Repeat("あ", 30),
+			wantDisplayName: strings.Repeat("あ", 30),
+		},
+		{
+			caseName:    "異常系: 空白のみの表示名が渡された場合",
+			displayName: "   ",
+			wantErr:     true,
+		},
+		{
+			caseName:    "異常系: 30文字を超える表示名が渡された場合",
+			displayName: strings.Repeat("あ", 31),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.NewUser(id.NewUserID(), tt.displayName)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.wantDisplayName, got.DisplayName(), "display name does not match")
+			assert.Equal(t, role.User, got.Role(), "role should be user")
+			assert.False(t, got.IsDisabled(), "new user should not be disabled")
+		})
+	}
+}
+
+func TestReconstructUser(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	disabledAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName   string
+		role       role.Role
+		disabledAt *time.Time
+		wantErr    bool
+	}{
+		{
+			caseName: "正常系: モデレーター権限のUserが復元される",
+			role:     role.Moderator,
+		},
+		{
+			caseName:   "正常系: 無効化されたUserが復元される",
+			role:       role.User,
+			disabledAt: &disabledAt,
+		},
+		{
+			caseName: "異常系: ゲスト権限のUserは復元できない",
+			role:     role.Guest,
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 未定義の権限のUserは復元できない",
+			role:     role.Role("owner"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := entity.ReconstructUser(id.NewUserID(), "配信者A", tt.role, tt.disabledAt, createdAt, createdAt)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.role, got.Role(), "role does not match")
+			assert.Equal(t, tt.disabledAt, got.DisabledAt(), "disabled at does not match")
+			assert.Equal(t, tt.disabledAt != nil, got.IsDisabled(), "disabled state does not match")
+		})
+	}
+}
+
+func TestUser_Rename(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName        string
+		displayName     string
+		wantDisplayName string
+		wantErr         bool
+	}{
+		{
+			caseName:        "正常系: 表示名が変更される",
+			displayName:     " 解説花子 ",
+			wantDisplayName: "解説花子",
+		},
+		{
+			caseName:        "異常系: 空の表示名には変更できず、元の表示名のままになる",
+			displayName:     "",
+			wantDisplayName: "配信者A",
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			user, err := entity.NewUser(id.NewUserID(), "配信者A")
+			assert.NoError(t, err, "failed to create user")
+
+			// Act
+			err = user.Rename(tt.displayName)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+			} else {
+				assert.NoError(t, err, "unexpected error occurred")
+			}
+			assert.Equal(t, tt.wantDisplayName, user.DisplayName(), "display name does not match")
+		})
+	}
+}
+
+func TestUser_Disable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 無効化と有効化ができ、無効化済みの場合は無効化日時を変更しない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		user, err := entity.NewUser(id.NewUserID(), "配信者A")
+		assert.NoError(t, err, "failed to create user")
+		first := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+		second := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+		// Act
+		user.Disable(first)
+		user.Disable(second)
+
+		// Assert
+		assert.True(t, user.IsDisabled(), "user should be disabled")
+		assert.Equal(t, first, *user.DisabledAt(), "disabled at should keep the first time")
+
+		// Act
+		user.Enable()
+
+		// Assert
+		assert.False(t, user.IsDisabled(), "user should be enabled")
+	})
+}
+
+func TestUser_ChangeRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		role     role.Role
+		wantRole role.Role
+		wantErr  bool
+	}{
+		{caseName: "正常系: 管理者権限に変更される", role: role.Admin, wantRole: role.Admin},
+		{caseName: "異常系: ゲスト権限には変更できない", role: role.Guest, wantRole: role.User, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			user, err := entity.NewUser(id.NewUserID(), "配信者A")
+			assert.NoError(t, err, "failed to create user")
+
+			// Act
+			err = user.ChangeRole(tt.role)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+			} else {
+				assert.NoError(t, err, "unexpected error occurred")
+			}
+			assert.Equal(t, tt.wantRole, user.Role(), "role does not match")
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"poketier/pkg/mail"
+)
+
+// Mailer はメールを送信するインターフェース
+type Mailer interface {
+	Send(ctx context.Context, msg mail.Message) error
+}
+
+// AccountMailer はアカウントの確認・パスワード再設定のメールを作成して送信する
+// メール内のリンクはフロントエンドの画面を指し、画面からトークンをAPIに送信する
+type AccountMailer struct {
+	mailer    Mailer
+	publicURL string
+}
+
+// NewAccountMailer はメール送信の実装と、リンクに使用するフロントエンドの公開URLを指定してAccountMailerを作成
+func NewAccountMailer(mailer Mailer, publicURL string) *AccountMailer {
+	return &AccountMailer{
+		mailer:    mailer,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}
+}
+
+// SendEmailVerification はメールアドレス確認のリンクを送信
+func (m *AccountMailer) SendEmailVerification(ctx context.Context, to, token string) error {
+	body := fmt.Sprintf(
+		"PokeTierへのご登録ありがとうございます。\n\n以下のリンクからメールアドレスを確認してください（24時間有効）。\n%s\n\nお心当たりがない場合は、このメールを破棄してください。\n",
+		m.link("/verify-email", token),
+	)
+	if err := m.mailer.Send(ctx, mail.Message{To: to, Subject: "[PokeTier] メールアドレスの確認", Body: body}); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+	return nil
+}
+
+// SendPasswordReset はパスワード再設定のリンクを送信
+func (m *AccountMailer) SendPasswordReset(ctx context.Context, to, token string) error {
+	body := fmt.Sprintf(
+		"パスワードの再設定を受け付けました。\n\n以下のリンクから新しいパスワードを設定してください（1時間有効）。\n%s\n\nお心当たりがない場合は、このメールを破棄してください。パスワードは変更されません。\n",
+		m.link("/reset-password", token),
+	)
+	if err := m.mailer.Send(ctx, mail.Message{To: to, Subject: "[PokeTier] パスワードの再設定", Body: body}); err != nil {
+		return fmt.Errorf("failed to send password reset: %w", err)
+	}
+	return nil
+}
+
+// link はトークンをクエリパラメータに含むフロントエンドのURLを返す
+func (m *AccountMailer) link(path, token string) string {
+	return m.publicURL + path + "?" + url.Values{"token": {token}}.Encode()
+}
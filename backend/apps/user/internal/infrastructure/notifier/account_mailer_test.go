@@ -0,0 +1,91 @@
+package notifier_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/infrastructure/notifier"
+	"poketier/pkg/mail"
+)
+
+func TestAccountMailer_SendEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockMailer *MockMailer)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 確認用のリンクを含むメールが送信される事",
+			setupMock: func(mockMailer *MockMailer) {
+				mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg mail.Message) error {
+					assert.Equal(t, "ash@example.com", msg.To, "recipient does not match")
+					assert.Equal(t, "[PokeTier] メールアドレスの確認", msg.Subject, "subject does not match")
+					assert.Contains(t, msg.Body, "https://poketier.example/verify-email?token=abc-_123", "body should contain verification link")
+					return nil
+				})
+			},
+		},
+		{
+			caseName: "異常系: 送信に失敗した場合",
+			setupMock: func(mockMailer *MockMailer) {
+				mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockMailer := NewMockMailer(ctrl)
+			tt.setupMock(mockMailer)
+			accountMailer := notifier.NewAccountMailer(mockMailer, "https://poketier.example/")
+
+			// Act
+			err := accountMailer.SendEmailVerification(context.Background(), "ash@example.com", "abc-_123")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestAccountMailer_SendPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 再設定用のリンクを含むメールが送信される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockMailer := NewMockMailer(ctrl)
+		mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg mail.Message) error {
+			assert.Equal(t, "ash@example.com", msg.To, "recipient does not match")
+			assert.Equal(t, "[PokeTier] パスワードの再設定", msg.Subject, "subject does not match")
+			assert.Contains(t, msg.Body, "https://poketier.example/reset-password?token=abc-_123", "body should contain reset link")
+			return nil
+		})
+		accountMailer := notifier.NewAccountMailer(mockMailer, "https://poketier.example")
+
+		// Act
+		err := accountMailer.SendPasswordReset(context.Background(), "ash@example.com", "abc-_123")
+
+		// Assert
+		assert.NoError(t, err, "unexpected error occurred")
+	})
+}
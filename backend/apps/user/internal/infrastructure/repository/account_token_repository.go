@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// AccountTokenQuerier はデータベースクエリを定義するインターフェース
+type AccountTokenQuerier interface {
+	GetUserAccountToken(ctx context.Context, tokenHash string) (db.UserAccountToken, error)
+	CreateUserAccountToken(ctx context.Context, arg db.CreateUserAccountTokenParams) error
+	UseUserAccountToken(ctx context.Context, arg db.UseUserAccountTokenParams) (int64, error)
+	DeleteUnusedUserAccountTokens(ctx context.Context, arg db.DeleteUnusedUserAccountTokensParams) error
+}
+
+// AccountTokenRepository はAccountTokenRepositoryの実装
+type AccountTokenRepository struct {
+	queries AccountTokenQuerier
+}
+
+// NewAccountTokenRepository は新しいAccountTokenRepositoryを作成
+func NewAccountTokenRepository(queries AccountTokenQuerier) *AccountTokenRepository {
+	return &AccountTokenRepository{
+		queries: queries,
+	}
+}
+
+// FindByHash は指定したハッシュのトークンを取得
+func (r *AccountTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.AccountToken, error) {
+	row, err := r.queries.GetUserAccountToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("account token not found", err)
+		}
+		return nil, fmt.Errorf("failed to get account token: %w", err)
+	}
+
+	purpose, err := entity.ParseTokenPurpose(row.Purpose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token purpose: %w", err)
+	}
+
+	return entity.ReconstructAccountToken(
+		row.TokenHash,
+		id.UserIDFromUUID(row.UserID.Bytes),
+		purpose,
+		row.ExpiresAt.Time,
+		fromTimestamptz(row.UsedAt),
+	), nil
+}
+
+// Replace は同じユーザー・用途の未使用のトークンを無効にしてから、新しいトークンを保存
+// 再送したメールのリンクだけが有効になる
+func (r *AccountTokenRepository) Replace(ctx context.Context, token *entity.AccountToken) error {
+	userID := pgtype.UUID{Bytes: token.UserID().UUID(), Valid: true}
+
+	if err := r.queries.DeleteUnusedUserAccountTokens(ctx, db.DeleteUnusedUserAccountTokensParams{
+		UserID:  userID,
+		Purpose: token.Purpose().String(),
+	}); err != nil {
+		return fmt.Errorf("failed to delete unused account tokens: %w", err)
+	}
+
+	if err := r.queries.CreateUserAccountToken(ctx, db.CreateUserAccountTokenParams{
+		TokenHash: token.Hash(),
+		UserID:    userID,
+		Purpose:   token.Purpose().String(),
+		ExpiresAt: pgtype.Timestamptz{Time: token.ExpiresAt(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to create account token: %w", err)
+	}
+	return nil
+}
+
+// MarkUsed はトークンを使用済みとして保存
+// 同時に使用されて既に使用済みになっていた場合はNotFoundエラーを返す
+func (r *AccountTokenRepository) MarkUsed(ctx context.Context, token *entity.AccountToken) error {
+	usedAt := time.Now()
+	if token.UsedAt() != nil {
+		usedAt = *token.UsedAt()
+	}
+
+	affected, err := r.queries.UseUserAccountToken(ctx, db.UseUserAccountTokenParams{
+		TokenHash: token.Hash(),
+		UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark account token as used: %w", err)
+	}
+	if affected == 0 {
+		return errs.NewNotFoundError("account token not found or already used", nil)
+	}
+	return nil
+}
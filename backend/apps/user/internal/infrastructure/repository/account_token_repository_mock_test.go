@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/account_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/account_token_repository.go -destination=./apps/user/internal/infrastructure/repository/account_token_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountTokenQuerier is a mock of AccountTokenQuerier interface.
+type MockAccountTokenQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountTokenQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountTokenQuerierMockRecorder is the mock recorder for MockAccountTokenQuerier.
+type MockAccountTokenQuerierMockRecorder struct {
+	mock *MockAccountTokenQuerier
+}
+
+// NewMockAccountTokenQuerier creates a new mock instance.
+func NewMockAccountTokenQuerier(ctrl *gomock.Controller) *MockAccountTokenQuerier {
+	mock := &MockAccountTokenQuerier{ctrl: ctrl}
+	mock.recorder = &MockAccountTokenQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountTokenQuerier) EXPECT() *MockAccountTokenQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateUserAccountToken mocks base method.
+func (m *MockAccountTokenQuerier) CreateUserAccountToken(ctx context.Context, arg db.CreateUserAccountTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserAccountToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserAccountToken indicates an expected call of CreateUserAccountToken.
+func (mr *MockAccountTokenQuerierMockRecorder) CreateUserAccountToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAccountToken", reflect.TypeOf((*MockAccountTokenQuerier)(nil).CreateUserAccountToken), ctx, arg)
+}
+
+// DeleteUnusedUserAccountTokens mocks base method.
+func (m *MockAccountTokenQuerier) DeleteUnusedUserAccountTokens(ctx context.Context, arg db.DeleteUnusedUserAccountTokensParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnusedUserAccountTokens", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnusedUserAccountTokens indicates an expected call of DeleteUnusedUserAccountTokens.
+func (mr *MockAccountTokenQuerierMockRecorder) DeleteUnusedUserAccountTokens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedUserAccountTokens", reflect.TypeOf((*MockAccountTokenQuerier)(nil).DeleteUnusedUserAccountTokens), ctx, arg)
+}
+
+// GetUserAccountToken mocks base method.
+func (m *MockAccountTokenQuerier) GetUserAccountToken(ctx context.Context, tokenHash string) (db.UserAccountToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccountToken", ctx, tokenHash)
+	ret0, _ := ret[0].(db.UserAccountToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccountToken indicates an expected call of GetUserAccountToken.
+func (mr *MockAccountTokenQuerierMockRecorder) GetUserAccountToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccountToken", reflect.TypeOf((*MockAccountTokenQuerier)(nil).GetUserAccountToken), ctx, tokenHash)
+}
+
+// UseUserAccountToken mocks base method.
+func (m *MockAccountTokenQuerier) UseUserAccountToken(ctx context.Context, arg db.UseUserAccountTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseUserAccountToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseUserAccountToken indicates an expected call of UseUserAccountToken.
+func (mr *MockAccountTokenQuerierMockRecorder) UseUserAccountToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseUserAccountToken", reflect.TypeOf((*MockAccountTokenQuerier)(nil).UseUserAccountToken), ctx, arg)
+}
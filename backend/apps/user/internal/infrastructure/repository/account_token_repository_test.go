@@ -0,0 +1,212 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestAccountTokenRepository_FindByHash(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	expiresAt := time.Date(2025, 8, 1, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockAccountTokenQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: トークンが取得できる事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().GetUserAccountToken(gomock.Any(), "hash").Return(db.UserAccountToken{
+					TokenHash: "hash",
+					UserID:    pgUserID,
+					Purpose:   "password_reset",
+					ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+				}, nil)
+			},
+		},
+		{
+			caseName: "異常系: トークンが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().GetUserAccountToken(gomock.Any(), "hash").Return(db.UserAccountToken{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: 未定義の用途が保存されている場合",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().GetUserAccountToken(gomock.Any(), "hash").Return(db.UserAccountToken{
+					TokenHash: "hash",
+					UserID:    pgUserID,
+					Purpose:   "magic_link",
+				}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockAccountTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewAccountTokenRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByHash(context.Background(), "hash")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, userID, got.UserID(), "user ID does not match")
+			assert.Equal(t, entity.TokenPurposePasswordReset, got.Purpose(), "purpose does not match")
+			assert.Equal(t, expiresAt, got.ExpiresAt(), "expires at does not match")
+			assert.Nil(t, got.UsedAt(), "used at should be nil")
+		})
+	}
+}
+
+func TestAccountTokenRepository_Replace(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	expiresAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+	token := entity.ReconstructAccountToken("hash", userID, entity.TokenPurposeEmailVerification, expiresAt, nil)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockAccountTokenQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 未使用のトークンを無効にしてから保存される事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().DeleteUnusedUserAccountTokens(gomock.Any(), db.DeleteUnusedUserAccountTokensParams{
+						UserID:  pgUserID,
+						Purpose: "email_verification",
+					}).Return(nil),
+					mockQuerier.EXPECT().CreateUserAccountToken(gomock.Any(), db.CreateUserAccountTokenParams{
+						TokenHash: "hash",
+						UserID:    pgUserID,
+						Purpose:   "email_verification",
+						ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+					}).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: 無効化に失敗した場合は保存しない事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().DeleteUnusedUserAccountTokens(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockAccountTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewAccountTokenRepository(mockQuerier)
+
+			// Act
+			err := repo.Replace(context.Background(), token)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestAccountTokenRepository_MarkUsed(t *testing.T) {
+	t.Parallel()
+
+	usedAt := time.Date(2025, 8, 1, 12, 30, 0, 0, time.UTC)
+	token := entity.ReconstructAccountToken("hash", id.NewUserID(), entity.TokenPurposePasswordReset, usedAt.Add(time.Hour), &usedAt)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockAccountTokenQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 使用日時が保存される事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().UseUserAccountToken(gomock.Any(), db.UseUserAccountTokenParams{
+					TokenHash: "hash",
+					UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+				}).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "異常系: 既に使用済みの場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockAccountTokenQuerier) {
+				mockQuerier.EXPECT().UseUserAccountToken(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockAccountTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewAccountTokenRepository(mockQuerier)
+
+			// Act
+			err := repo.MarkUsed(context.Background(), token)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// uniqueViolation は一意制約違反のSQLSTATE
+const uniqueViolation = "23505"
+
+// CredentialQuerier はデータベースクエリを定義するインターフェース
+type CredentialQuerier interface {
+	GetUserCredential(ctx context.Context, userID pgtype.UUID) (db.UserCredential, error)
+	GetUserCredentialByEmail(ctx context.Context, email string) (db.UserCredential, error)
+	CreateUserCredential(ctx context.Context, arg db.CreateUserCredentialParams) (db.UserCredential, error)
+	UpdateUserCredential(ctx context.Context, arg db.UpdateUserCredentialParams) (db.UserCredential, error)
+	RecordCredentialLoginFailure(ctx context.Context, arg db.RecordCredentialLoginFailureParams) (db.UserCredential, error)
+	ResetCredentialLoginFailures(ctx context.Context, userID pgtype.UUID) error
+}
+
+// CredentialRepository はCredentialRepositoryの実装
+type CredentialRepository struct {
+	queries CredentialQuerier
+}
+
+// NewCredentialRepository は新しいCredentialRepositoryを作成
+func NewCredentialRepository(queries CredentialQuerier) *CredentialRepository {
+	return &CredentialRepository{
+		queries: queries,
+	}
+}
+
+// FindByUserID は指定したユーザーの認証情報を取得
+func (r *CredentialRepository) FindByUserID(ctx context.Context, userID id.UserID) (*entity.Credential, error) {
+	row, err := r.queries.GetUserCredential(ctx, pgtype.UUID{Bytes: userID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("credential not found", err)
+		}
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	return r.toEntity(row), nil
+}
+
+// FindByEmail は指定したメールアドレス（正規化済み）の認証情報を取得
+func (r *CredentialRepository) FindByEmail(ctx context.Context, email string) (*entity.Credential, error) {
+	row, err := r.queries.GetUserCredentialByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("credential not found", err)
+		}
+		return nil, fmt.Errorf("failed to get credential by email: %w", err)
+	}
+
+	return r.toEntity(row), nil
+}
+
+// Create は認証情報を保存。メールアドレスが登録済みの場合はConflictエラーを返す
+func (r *CredentialRepository) Create(ctx context.Context, credential *entity.Credential) error {
+	if _, err := r.queries.CreateUserCredential(ctx, db.CreateUserCredentialParams{
+		UserID:       pgtype.UUID{Bytes: credential.UserID().UUID(), Valid: true},
+		Email:        credential.Email(),
+		PasswordHash: credential.PasswordHash(),
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return errs.NewConflictError("email is already registered", err)
+		}
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+	return nil
+}
+
+// Update はパスワード・メールアドレスの確認状態・ログイン失敗の状態を保存
+func (r *CredentialRepository) Update(ctx context.Context, credential *entity.Credential) error {
+	if _, err := r.queries.UpdateUserCredential(ctx, db.UpdateUserCredentialParams{
+		UserID:           pgtype.UUID{Bytes: credential.UserID().UUID(), Valid: true},
+		PasswordHash:     credential.PasswordHash(),
+		EmailVerifiedAt:  toTimestamptz(credential.EmailVerifiedAt()),
+		FailedLoginCount: int32(credential.FailedLoginCount()), // #nosec G115 -- 失敗回数はロックの上限回数未満
+		LockedUntil:      toTimestamptz(credential.LockedUntil()),
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NewNotFoundError("credential not found", err)
+		}
+		return fmt.Errorf("failed to update credential: %w", err)
+	}
+	return nil
+}
+
+// RecordLoginFailure はログインの失敗をデータベース上で1回加算し、連続した失敗が上限に達した場合はロックする
+// 同時に失敗したログインの加算が失われず、パスワードなど他の列は更新しない
+func (r *CredentialRepository) RecordLoginFailure(ctx context.Context, userID id.UserID, now time.Time) error {
+	if _, err := r.queries.RecordCredentialLoginFailure(ctx, db.RecordCredentialLoginFailureParams{
+		MaxFailedLogins: entity.MaxFailedLogins,
+		LockedUntil:     pgtype.Timestamptz{Time: now.Add(entity.LockoutDuration), Valid: true},
+		UserID:          pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NewNotFoundError("credential not found", err)
+		}
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return nil
+}
+
+// ResetLoginFailures はログインの失敗回数とロックをリセットする。パスワードなど他の列は更新しない
+func (r *CredentialRepository) ResetLoginFailures(ctx context.Context, userID id.UserID) error {
+	if err := r.queries.ResetCredentialLoginFailures(ctx, pgtype.UUID{Bytes: userID.UUID(), Valid: true}); err != nil {
+		return fmt.Errorf("failed to reset login failures: %w", err)
+	}
+	return nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *CredentialRepository) toEntity(row db.UserCredential) *entity.Credential {
+	return entity.ReconstructCredential(
+		id.UserIDFromUUID(row.UserID.Bytes),
+		row.Email,
+		row.PasswordHash,
+		fromTimestamptz(row.EmailVerifiedAt),
+		int(row.FailedLoginCount),
+		fromTimestamptz(row.LockedUntil),
+	)
+}
+
+// toTimestamptz は nil を NULL とするタイムスタンプに変換
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// fromTimestamptz は NULL を nil とするタイムスタンプに変換
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
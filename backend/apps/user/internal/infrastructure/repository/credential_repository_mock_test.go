@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/credential_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/credential_repository.go -destination=./apps/user/internal/infrastructure/repository/credential_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCredentialQuerier is a mock of CredentialQuerier interface.
+type MockCredentialQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockCredentialQuerierMockRecorder is the mock recorder for MockCredentialQuerier.
+type MockCredentialQuerierMockRecorder struct {
+	mock *MockCredentialQuerier
+}
+
+// NewMockCredentialQuerier creates a new mock instance.
+func NewMockCredentialQuerier(ctrl *gomock.Controller) *MockCredentialQuerier {
+	mock := &MockCredentialQuerier{ctrl: ctrl}
+	mock.recorder = &MockCredentialQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialQuerier) EXPECT() *MockCredentialQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateUserCredential mocks base method.
+func (m *MockCredentialQuerier) CreateUserCredential(ctx context.Context, arg db.CreateUserCredentialParams) (db.UserCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserCredential", ctx, arg)
+	ret0, _ := ret[0].(db.UserCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserCredential indicates an expected call of CreateUserCredential.
+func (mr *MockCredentialQuerierMockRecorder) CreateUserCredential(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserCredential", reflect.TypeOf((*MockCredentialQuerier)(nil).CreateUserCredential), ctx, arg)
+}
+
+// GetUserCredential mocks base method.
+func (m *MockCredentialQuerier) GetUserCredential(ctx context.Context, userID pgtype.UUID) (db.UserCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCredential", ctx, userID)
+	ret0, _ := ret[0].(db.UserCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCredential indicates an expected call of GetUserCredential.
+func (mr *MockCredentialQuerierMockRecorder) GetUserCredential(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCredential", reflect.TypeOf((*MockCredentialQuerier)(nil).GetUserCredential), ctx, userID)
+}
+
+// GetUserCredentialByEmail mocks base method.
+func (m *MockCredentialQuerier) GetUserCredentialByEmail(ctx context.Context, email string) (db.UserCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCredentialByEmail", ctx, email)
+	ret0, _ := ret[0].(db.UserCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCredentialByEmail indicates an expected call of GetUserCredentialByEmail.
+func (mr *MockCredentialQuerierMockRecorder) GetUserCredentialByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCredentialByEmail", reflect.TypeOf((*MockCredentialQuerier)(nil).GetUserCredentialByEmail), ctx, email)
+}
+
+// RecordCredentialLoginFailure mocks base method.
+func (m *MockCredentialQuerier) RecordCredentialLoginFailure(ctx context.Context, arg db.RecordCredentialLoginFailureParams) (db.UserCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCredentialLoginFailure", ctx, arg)
+	ret0, _ := ret[0].(db.UserCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordCredentialLoginFailure indicates an expected call of RecordCredentialLoginFailure.
+func (mr *MockCredentialQuerierMockRecorder) RecordCredentialLoginFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCredentialLoginFailure", reflect.TypeOf((*MockCredentialQuerier)(nil).RecordCredentialLoginFailure), ctx, arg)
+}
+
+// ResetCredentialLoginFailures mocks base method.
+func (m *MockCredentialQuerier) ResetCredentialLoginFailures(ctx context.Context, userID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetCredentialLoginFailures", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetCredentialLoginFailures indicates an expected call of ResetCredentialLoginFailures.
+func (mr *MockCredentialQuerierMockRecorder) ResetCredentialLoginFailures(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCredentialLoginFailures", reflect.TypeOf((*MockCredentialQuerier)(nil).ResetCredentialLoginFailures), ctx, userID)
+}
+
+// UpdateUserCredential mocks base method.
+func (m *MockCredentialQuerier) UpdateUserCredential(ctx context.Context, arg db.UpdateUserCredentialParams) (db.UserCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserCredential", ctx, arg)
+	ret0, _ := ret[0].(db.UserCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserCredential indicates an expected call of UpdateUserCredential.
+func (mr *MockCredentialQuerierMockRecorder) UpdateUserCredential(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserCredential", reflect.TypeOf((*MockCredentialQuerier)(nil).UpdateUserCredential), ctx, arg)
+}
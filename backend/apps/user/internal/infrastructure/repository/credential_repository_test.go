@@ -0,0 +1,312 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestCredentialRepository_FindByEmail(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	verifiedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	lockedUntil := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName         string
+		setupMock        func(mockQuerier *MockCredentialQuerier)
+		wantVerifiedAt   *time.Time
+		wantLockedUntil  *time.Time
+		wantFailedLogins int
+		wantNotFound     bool
+		expectError      bool
+	}{
+		{
+			caseName: "正常系: 認証情報が取得できる事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().GetUserCredentialByEmail(gomock.Any(), "ash@example.com").Return(db.UserCredential{
+					UserID:           pgUserID,
+					Email:            "ash@example.com",
+					PasswordHash:     "$argon2id$hash",
+					EmailVerifiedAt:  pgtype.Timestamptz{Time: verifiedAt, Valid: true},
+					FailedLoginCount: 2,
+					LockedUntil:      pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+				}, nil)
+			},
+			wantVerifiedAt:   &verifiedAt,
+			wantLockedUntil:  &lockedUntil,
+			wantFailedLogins: 2,
+		},
+		{
+			caseName: "正常系: 未確認・未ロックの認証情報が取得できる事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().GetUserCredentialByEmail(gomock.Any(), "ash@example.com").Return(db.UserCredential{
+					UserID:       pgUserID,
+					Email:        "ash@example.com",
+					PasswordHash: "$argon2id$hash",
+				}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 認証情報が存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().GetUserCredentialByEmail(gomock.Any(), "ash@example.com").Return(db.UserCredential{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().GetUserCredentialByEmail(gomock.Any(), "ash@example.com").Return(db.UserCredential{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCredentialQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCredentialRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByEmail(context.Background(), "ash@example.com")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, userID, got.UserID(), "user ID does not match")
+			assert.Equal(t, "ash@example.com", got.Email(), "email does not match")
+			assert.Equal(t, "$argon2id$hash", got.PasswordHash(), "password hash does not match")
+			assert.Equal(t, tt.wantVerifiedAt, got.EmailVerifiedAt(), "email verified at does not match")
+			assert.Equal(t, tt.wantLockedUntil, got.LockedUntil(), "locked until does not match")
+			assert.Equal(t, tt.wantFailedLogins, got.FailedLoginCount(), "failed login count does not match")
+		})
+	}
+}
+
+func TestCredentialRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	params := db.CreateUserCredentialParams{
+		UserID:       pgUserID,
+		Email:        "ash@example.com",
+		PasswordHash: "$argon2id$hash",
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockCredentialQuerier)
+		wantConflict bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 認証情報が保存される事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().CreateUserCredential(gomock.Any(), params).Return(db.UserCredential{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: メールアドレスが登録済みの場合、Conflictエラーになる事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().CreateUserCredential(gomock.Any(), params).Return(db.UserCredential{}, &pgconn.PgError{Code: "23505"})
+			},
+			wantConflict: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().CreateUserCredential(gomock.Any(), params).Return(db.UserCredential{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCredentialQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCredentialRepository(mockQuerier)
+			credential, err := entity.NewCredential(userID, "ash@example.com", "$argon2id$hash")
+			require.NoError(t, err, "failed to create credential")
+
+			// Act
+			err = repo.Create(context.Background(), credential)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantConflict, errors.As(err, &domainErr) && domainErr.Type == errs.ErrConflict, "conflict error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestCredentialRepository_Update(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	verifiedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	lockedUntil := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		credential   *entity.Credential
+		setupMock    func(mockQuerier *MockCredentialQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName:   "正常系: 確認日時とロック期限が保存される事",
+			credential: entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", &verifiedAt, 0, &lockedUntil),
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().UpdateUserCredential(gomock.Any(), db.UpdateUserCredentialParams{
+					UserID:          pgUserID,
+					PasswordHash:    "$argon2id$hash",
+					EmailVerifiedAt: pgtype.Timestamptz{Time: verifiedAt, Valid: true},
+					LockedUntil:     pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+				}).Return(db.UserCredential{}, nil)
+			},
+		},
+		{
+			caseName:   "正常系: 未確認・未ロックの場合はNULLで保存される事",
+			credential: entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 3, nil),
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().UpdateUserCredential(gomock.Any(), db.UpdateUserCredentialParams{
+					UserID:           pgUserID,
+					PasswordHash:     "$argon2id$hash",
+					FailedLoginCount: 3,
+				}).Return(db.UserCredential{}, nil)
+			},
+		},
+		{
+			caseName:   "異常系: 認証情報が存在しない場合、NotFoundエラーになる事",
+			credential: entity.ReconstructCredential(userID, "ash@example.com", "$argon2id$hash", nil, 0, nil),
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().UpdateUserCredential(gomock.Any(), gomock.Any()).Return(db.UserCredential{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCredentialQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCredentialRepository(mockQuerier)
+
+			// Act
+			err := repo.Update(context.Background(), tt.credential)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestCredentialRepository_RecordLoginFailure(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	params := db.RecordCredentialLoginFailureParams{
+		MaxFailedLogins: entity.MaxFailedLogins,
+		LockedUntil:     pgtype.Timestamptz{Time: now.Add(entity.LockoutDuration), Valid: true},
+		UserID:          pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+	}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockCredentialQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: ロックの上限回数とロック期限を渡して失敗が加算される事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().RecordCredentialLoginFailure(gomock.Any(), params).Return(db.UserCredential{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 認証情報が存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockCredentialQuerier) {
+				mockQuerier.EXPECT().RecordCredentialLoginFailure(gomock.Any(), params).Return(db.UserCredential{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockCredentialQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewCredentialRepository(mockQuerier)
+
+			// Act
+			err := repo.RecordLoginFailure(context.Background(), userID, now)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
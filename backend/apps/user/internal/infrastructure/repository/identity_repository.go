@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// IdentityQuerier はデータベースクエリを定義するインターフェース
+type IdentityQuerier interface {
+	GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (db.UserIdentity, error)
+}
+
+// IdentityRepository はIdentityRepositoryの実装
+type IdentityRepository struct {
+	queries IdentityQuerier
+}
+
+// NewIdentityRepository は新しいIdentityRepositoryを作成
+func NewIdentityRepository(queries IdentityQuerier) *IdentityRepository {
+	return &IdentityRepository{
+		queries: queries,
+	}
+}
+
+// FindByProviderSubject は指定したプロバイダーのアカウントの紐付けを取得
+func (r *IdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.Identity, error) {
+	row, err := r.queries.GetUserIdentity(ctx, db.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("identity not found", err)
+		}
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	return entity.ReconstructIdentity(
+		row.Provider,
+		row.Subject,
+		id.UserIDFromUUID(row.UserID.Bytes),
+		row.Email.String,
+	), nil
+}
+
+// Create は紐付けを保存。同じプロバイダーのアカウントが紐付け済みの場合はConflictエラーを返す
+func (r *IdentityRepository) Create(ctx context.Context, identity *entity.Identity) error {
+	if _, err := r.queries.CreateUserIdentity(ctx, db.CreateUserIdentityParams{
+		Provider: identity.Provider(),
+		Subject:  identity.Subject(),
+		UserID:   pgtype.UUID{Bytes: identity.UserID().UUID(), Valid: true},
+		Email:    pgtype.Text{String: identity.Email(), Valid: identity.Email() != ""},
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return errs.NewConflictError("identity is already linked", err)
+		}
+		return fmt.Errorf("failed to create identity: %w", err)
+	}
+	return nil
+}
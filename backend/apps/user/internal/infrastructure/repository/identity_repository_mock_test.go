@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/identity_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/identity_repository.go -destination=./apps/user/internal/infrastructure/repository/identity_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdentityQuerier is a mock of IdentityQuerier interface.
+type MockIdentityQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityQuerierMockRecorder is the mock recorder for MockIdentityQuerier.
+type MockIdentityQuerierMockRecorder struct {
+	mock *MockIdentityQuerier
+}
+
+// NewMockIdentityQuerier creates a new mock instance.
+func NewMockIdentityQuerier(ctrl *gomock.Controller) *MockIdentityQuerier {
+	mock := &MockIdentityQuerier{ctrl: ctrl}
+	mock.recorder = &MockIdentityQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityQuerier) EXPECT() *MockIdentityQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateUserIdentity mocks base method.
+func (m *MockIdentityQuerier) CreateUserIdentity(ctx context.Context, arg db.CreateUserIdentityParams) (db.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", ctx, arg)
+	ret0, _ := ret[0].(db.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *MockIdentityQuerierMockRecorder) CreateUserIdentity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*MockIdentityQuerier)(nil).CreateUserIdentity), ctx, arg)
+}
+
+// GetUserIdentity mocks base method.
+func (m *MockIdentityQuerier) GetUserIdentity(ctx context.Context, arg db.GetUserIdentityParams) (db.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", ctx, arg)
+	ret0, _ := ret[0].(db.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *MockIdentityQuerierMockRecorder) GetUserIdentity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*MockIdentityQuerier)(nil).GetUserIdentity), ctx, arg)
+}
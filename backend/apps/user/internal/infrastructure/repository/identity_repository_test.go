@@ -0,0 +1,180 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestIdentityRepository_FindByProviderSubject(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	params := db.GetUserIdentityParams{Provider: "google", Subject: "1234567890"}
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockIdentityQuerier)
+		wantEmail    string
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 紐付けが取得できる事",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().GetUserIdentity(gomock.Any(), params).Return(db.UserIdentity{
+					Provider: "google",
+					Subject:  "1234567890",
+					UserID:   pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					Email:    pgtype.Text{String: "ash@example.com", Valid: true},
+				}, nil)
+			},
+			wantEmail: "ash@example.com",
+		},
+		{
+			caseName: "正常系: メールアドレスが NULL の場合は空文字になる事",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().GetUserIdentity(gomock.Any(), params).Return(db.UserIdentity{
+					Provider: "google",
+					Subject:  "1234567890",
+					UserID:   pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+				}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 紐付けが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().GetUserIdentity(gomock.Any(), params).Return(db.UserIdentity{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().GetUserIdentity(gomock.Any(), params).Return(db.UserIdentity{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockIdentityQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewIdentityRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByProviderSubject(context.Background(), "google", "1234567890")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, userID, got.UserID(), "user ID does not match")
+			assert.Equal(t, "google", got.Provider(), "provider does not match")
+			assert.Equal(t, "1234567890", got.Subject(), "subject does not match")
+			assert.Equal(t, tt.wantEmail, got.Email(), "email does not match")
+		})
+	}
+}
+
+func TestIdentityRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName     string
+		email        string
+		setupMock    func(mockQuerier *MockIdentityQuerier)
+		wantConflict bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 紐付けが保存される事",
+			email:    "ash@example.com",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().CreateUserIdentity(gomock.Any(), db.CreateUserIdentityParams{
+					Provider: "google",
+					Subject:  "1234567890",
+					UserID:   pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					Email:    pgtype.Text{String: "ash@example.com", Valid: true},
+				}).Return(db.UserIdentity{}, nil)
+			},
+		},
+		{
+			caseName: "正常系: メールアドレスがない場合は NULL で保存される事",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().CreateUserIdentity(gomock.Any(), db.CreateUserIdentityParams{
+					Provider: "google",
+					Subject:  "1234567890",
+					UserID:   pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+				}).Return(db.UserIdentity{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 紐付け済みの場合、Conflictエラーになる事",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().CreateUserIdentity(gomock.Any(), gomock.Any()).Return(db.UserIdentity{}, &pgconn.PgError{Code: "23505"})
+			},
+			wantConflict: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockIdentityQuerier) {
+				mockQuerier.EXPECT().CreateUserIdentity(gomock.Any(), gomock.Any()).Return(db.UserIdentity{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockIdentityQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewIdentityRepository(mockQuerier)
+			identity, err := entity.NewIdentity("google", "1234567890", userID, tt.email)
+			require.NoError(t, err, "failed to create identity")
+
+			// Act
+			err = repo.Create(context.Background(), identity)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				var domainErr *errs.DomainError
+				assert.Equal(t, tt.wantConflict, errors.As(err, &domainErr) && domainErr.Type == errs.ErrConflict, "conflict error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
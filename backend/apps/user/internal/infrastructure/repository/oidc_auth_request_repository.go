@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/sqlc/db"
+)
+
+// OIDCAuthRequestQuerier はデータベースクエリを定義するインターフェース
+type OIDCAuthRequestQuerier interface {
+	CreateUserOIDCAuthRequest(ctx context.Context, arg db.CreateUserOIDCAuthRequestParams) error
+	ConsumeUserOIDCAuthRequest(ctx context.Context, stateHash string) (db.UserOidcAuthRequest, error)
+	DeleteExpiredUserOIDCAuthRequests(ctx context.Context, expiresAt pgtype.Timestamptz) error
+}
+
+// OIDCAuthRequestRepository はOIDCAuthRequestRepositoryの実装
+type OIDCAuthRequestRepository struct {
+	queries OIDCAuthRequestQuerier
+}
+
+// NewOIDCAuthRequestRepository は新しいOIDCAuthRequestRepositoryを作成
+func NewOIDCAuthRequestRepository(queries OIDCAuthRequestQuerier) *OIDCAuthRequestRepository {
+	return &OIDCAuthRequestRepository{
+		queries: queries,
+	}
+}
+
+// Create は期限切れの認可リクエストを削除してから、新しい認可リクエストを保存
+// コールバックされなかった認可リクエストが溜まらないよう、作成のたびに掃除する
+func (r *OIDCAuthRequestRepository) Create(ctx context.Context, req *entity.OIDCAuthRequest) error {
+	if err := r.queries.DeleteExpiredUserOIDCAuthRequests(ctx, pgtype.Timestamptz{Time: time.Now(), Valid: true}); err != nil {
+		return fmt.Errorf("failed to delete expired oidc auth requests: %w", err)
+	}
+
+	if err := r.queries.CreateUserOIDCAuthRequest(ctx, db.CreateUserOIDCAuthRequestParams{
+		StateHash:    req.StateHash(),
+		Provider:     req.Provider(),
+		CodeVerifier: req.CodeVerifier(),
+		Nonce:        req.Nonce(),
+		ExpiresAt:    pgtype.Timestamptz{Time: req.ExpiresAt(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to create oidc auth request: %w", err)
+	}
+	return nil
+}
+
+// Consume は指定したハッシュの認可リクエストを削除して返す
+// 存在しない・既に使用された場合はNotFoundエラーを返す
+func (r *OIDCAuthRequestRepository) Consume(ctx context.Context, stateHash string) (*entity.OIDCAuthRequest, error) {
+	row, err := r.queries.ConsumeUserOIDCAuthRequest(ctx, stateHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("oidc auth request not found", err)
+		}
+		return nil, fmt.Errorf("failed to consume oidc auth request: %w", err)
+	}
+
+	return entity.ReconstructOIDCAuthRequest(
+		row.StateHash,
+		row.Provider,
+		row.CodeVerifier,
+		row.Nonce,
+		row.ExpiresAt.Time,
+	), nil
+}
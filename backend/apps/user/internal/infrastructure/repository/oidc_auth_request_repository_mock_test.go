@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/oidc_auth_request_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/oidc_auth_request_repository.go -destination=./apps/user/internal/infrastructure/repository/oidc_auth_request_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCAuthRequestQuerier is a mock of OIDCAuthRequestQuerier interface.
+type MockOIDCAuthRequestQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCAuthRequestQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCAuthRequestQuerierMockRecorder is the mock recorder for MockOIDCAuthRequestQuerier.
+type MockOIDCAuthRequestQuerierMockRecorder struct {
+	mock *MockOIDCAuthRequestQuerier
+}
+
+// NewMockOIDCAuthRequestQuerier creates a new mock instance.
+func NewMockOIDCAuthRequestQuerier(ctrl *gomock.Controller) *MockOIDCAuthRequestQuerier {
+	mock := &MockOIDCAuthRequestQuerier{ctrl: ctrl}
+	mock.recorder = &MockOIDCAuthRequestQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCAuthRequestQuerier) EXPECT() *MockOIDCAuthRequestQuerierMockRecorder {
+	return m.recorder
+}
+
+// ConsumeUserOIDCAuthRequest mocks base method.
+func (m *MockOIDCAuthRequestQuerier) ConsumeUserOIDCAuthRequest(ctx context.Context, stateHash string) (db.UserOidcAuthRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeUserOIDCAuthRequest", ctx, stateHash)
+	ret0, _ := ret[0].(db.UserOidcAuthRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeUserOIDCAuthRequest indicates an expected call of ConsumeUserOIDCAuthRequest.
+func (mr *MockOIDCAuthRequestQuerierMockRecorder) ConsumeUserOIDCAuthRequest(ctx, stateHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeUserOIDCAuthRequest", reflect.TypeOf((*MockOIDCAuthRequestQuerier)(nil).ConsumeUserOIDCAuthRequest), ctx, stateHash)
+}
+
+// CreateUserOIDCAuthRequest mocks base method.
+func (m *MockOIDCAuthRequestQuerier) CreateUserOIDCAuthRequest(ctx context.Context, arg db.CreateUserOIDCAuthRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserOIDCAuthRequest", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserOIDCAuthRequest indicates an expected call of CreateUserOIDCAuthRequest.
+func (mr *MockOIDCAuthRequestQuerierMockRecorder) CreateUserOIDCAuthRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserOIDCAuthRequest", reflect.TypeOf((*MockOIDCAuthRequestQuerier)(nil).CreateUserOIDCAuthRequest), ctx, arg)
+}
+
+// DeleteExpiredUserOIDCAuthRequests mocks base method.
+func (m *MockOIDCAuthRequestQuerier) DeleteExpiredUserOIDCAuthRequests(ctx context.Context, expiresAt pgtype.Timestamptz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredUserOIDCAuthRequests", ctx, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredUserOIDCAuthRequests indicates an expected call of DeleteExpiredUserOIDCAuthRequests.
+func (mr *MockOIDCAuthRequestQuerierMockRecorder) DeleteExpiredUserOIDCAuthRequests(ctx, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredUserOIDCAuthRequests", reflect.TypeOf((*MockOIDCAuthRequestQuerier)(nil).DeleteExpiredUserOIDCAuthRequests), ctx, expiresAt)
+}
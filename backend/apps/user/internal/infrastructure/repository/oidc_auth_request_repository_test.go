@@ -0,0 +1,155 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/sqlc/db"
+)
+
+func TestOIDCAuthRequestRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2025, 8, 1, 12, 10, 0, 0, time.UTC)
+	req := entity.ReconstructOIDCAuthRequest("hash", "mock", "verifier", "nonce", expiresAt)
+	params := db.CreateUserOIDCAuthRequestParams{
+		StateHash:    "hash",
+		Provider:     "mock",
+		CodeVerifier: "verifier",
+		Nonce:        "nonce",
+		ExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockOIDCAuthRequestQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 期限切れの認可リクエストを削除してから保存される事",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				gomock.InOrder(
+					mockQuerier.EXPECT().DeleteExpiredUserOIDCAuthRequests(gomock.Any(), gomock.Any()).Return(nil),
+					mockQuerier.EXPECT().CreateUserOIDCAuthRequest(gomock.Any(), params).Return(nil),
+				)
+			},
+		},
+		{
+			caseName: "異常系: 期限切れの認可リクエストの削除に失敗",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				mockQuerier.EXPECT().DeleteExpiredUserOIDCAuthRequests(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			caseName: "異常系: 保存に失敗",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				mockQuerier.EXPECT().DeleteExpiredUserOIDCAuthRequests(gomock.Any(), gomock.Any()).Return(nil)
+				mockQuerier.EXPECT().CreateUserOIDCAuthRequest(gomock.Any(), params).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockOIDCAuthRequestQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewOIDCAuthRequestRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), req)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestOIDCAuthRequestRepository_Consume(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2025, 8, 1, 12, 10, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockOIDCAuthRequestQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 認可リクエストが取得できる事",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				mockQuerier.EXPECT().ConsumeUserOIDCAuthRequest(gomock.Any(), "hash").Return(db.UserOidcAuthRequest{
+					StateHash:    "hash",
+					Provider:     "mock",
+					CodeVerifier: "verifier",
+					Nonce:        "nonce",
+					ExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+				}, nil)
+			},
+		},
+		{
+			caseName: "異常系: 存在しない・使用済みの場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				mockQuerier.EXPECT().ConsumeUserOIDCAuthRequest(gomock.Any(), "hash").Return(db.UserOidcAuthRequest{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockOIDCAuthRequestQuerier) {
+				mockQuerier.EXPECT().ConsumeUserOIDCAuthRequest(gomock.Any(), "hash").Return(db.UserOidcAuthRequest{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockOIDCAuthRequestQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewOIDCAuthRequestRepository(mockQuerier)
+
+			// Act
+			got, err := repo.Consume(context.Background(), "hash")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "mock", got.Provider(), "provider does not match")
+			assert.Equal(t, "verifier", got.CodeVerifier(), "code verifier does not match")
+			assert.Equal(t, "nonce", got.Nonce(), "nonce does not match")
+			assert.Equal(t, expiresAt, got.ExpiresAt(), "expires at does not match")
+		})
+	}
+}
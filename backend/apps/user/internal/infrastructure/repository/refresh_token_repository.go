@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// RefreshTokenQuerier はデータベースクエリを定義するインターフェース
+type RefreshTokenQuerier interface {
+	GetUserRefreshToken(ctx context.Context, tokenHash string) (db.UserRefreshToken, error)
+	CreateUserRefreshToken(ctx context.Context, arg db.CreateUserRefreshTokenParams) error
+	UseUserRefreshToken(ctx context.Context, arg db.UseUserRefreshTokenParams) (int64, error)
+}
+
+// RefreshTokenRepository はRefreshTokenRepositoryの実装
+type RefreshTokenRepository struct {
+	queries RefreshTokenQuerier
+}
+
+// NewRefreshTokenRepository は新しいRefreshTokenRepositoryを作成
+func NewRefreshTokenRepository(queries RefreshTokenQuerier) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		queries: queries,
+	}
+}
+
+// FindByHash は指定したハッシュのリフレッシュトークンを取得
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, hash string) (*entity.RefreshToken, error) {
+	row, err := r.queries.GetUserRefreshToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("refresh token not found", err)
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return entity.ReconstructRefreshToken(
+		row.TokenHash,
+		id.SessionIDFromUUID(row.SessionID.Bytes),
+		fromTimestamptz(row.UsedAt),
+	), nil
+}
+
+// Create はリフレッシュトークンを保存
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	if err := r.queries.CreateUserRefreshToken(ctx, db.CreateUserRefreshTokenParams{
+		TokenHash: token.Hash(),
+		SessionID: pgtype.UUID{Bytes: token.SessionID().UUID(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+// MarkUsed はリフレッシュトークンを交換済みとして保存
+// 同時に交換されて既に交換済みになっていた場合はNotFoundエラーを返す
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, token *entity.RefreshToken) error {
+	usedAt := time.Now()
+	if token.UsedAt() != nil {
+		usedAt = *token.UsedAt()
+	}
+
+	affected, err := r.queries.UseUserRefreshToken(ctx, db.UseUserRefreshTokenParams{
+		TokenHash: token.Hash(),
+		UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark refresh token as used: %w", err)
+	}
+	if affected == 0 {
+		return errs.NewNotFoundError("refresh token not found or already used", nil)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/refresh_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/refresh_token_repository.go -destination=./apps/user/internal/infrastructure/repository/refresh_token_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenQuerier is a mock of RefreshTokenQuerier interface.
+type MockRefreshTokenQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenQuerierMockRecorder is the mock recorder for MockRefreshTokenQuerier.
+type MockRefreshTokenQuerierMockRecorder struct {
+	mock *MockRefreshTokenQuerier
+}
+
+// NewMockRefreshTokenQuerier creates a new mock instance.
+func NewMockRefreshTokenQuerier(ctrl *gomock.Controller) *MockRefreshTokenQuerier {
+	mock := &MockRefreshTokenQuerier{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenQuerier) EXPECT() *MockRefreshTokenQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateUserRefreshToken mocks base method.
+func (m *MockRefreshTokenQuerier) CreateUserRefreshToken(ctx context.Context, arg db.CreateUserRefreshTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserRefreshToken indicates an expected call of CreateUserRefreshToken.
+func (mr *MockRefreshTokenQuerierMockRecorder) CreateUserRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserRefreshToken", reflect.TypeOf((*MockRefreshTokenQuerier)(nil).CreateUserRefreshToken), ctx, arg)
+}
+
+// GetUserRefreshToken mocks base method.
+func (m *MockRefreshTokenQuerier) GetUserRefreshToken(ctx context.Context, tokenHash string) (db.UserRefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRefreshToken", ctx, tokenHash)
+	ret0, _ := ret[0].(db.UserRefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRefreshToken indicates an expected call of GetUserRefreshToken.
+func (mr *MockRefreshTokenQuerierMockRecorder) GetUserRefreshToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRefreshToken", reflect.TypeOf((*MockRefreshTokenQuerier)(nil).GetUserRefreshToken), ctx, tokenHash)
+}
+
+// UseUserRefreshToken mocks base method.
+func (m *MockRefreshTokenQuerier) UseUserRefreshToken(ctx context.Context, arg db.UseUserRefreshTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseUserRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseUserRefreshToken indicates an expected call of UseUserRefreshToken.
+func (mr *MockRefreshTokenQuerierMockRecorder) UseUserRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseUserRefreshToken", reflect.TypeOf((*MockRefreshTokenQuerier)(nil).UseUserRefreshToken), ctx, arg)
+}
@@ -0,0 +1,197 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestRefreshTokenRepository_FindByHash(t *testing.T) {
+	t.Parallel()
+
+	sessionID := id.NewSessionID()
+	usedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockRefreshTokenQuerier)
+		wantUsedAt   *time.Time
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 交換済みのトークンが取得できる事",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().GetUserRefreshToken(gomock.Any(), "hash").Return(db.UserRefreshToken{
+					TokenHash: "hash",
+					SessionID: pgtype.UUID{Bytes: sessionID.UUID(), Valid: true},
+					UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+				}, nil)
+			},
+			wantUsedAt: &usedAt,
+		},
+		{
+			caseName: "異常系: トークンが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().GetUserRefreshToken(gomock.Any(), "hash").Return(db.UserRefreshToken{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().GetUserRefreshToken(gomock.Any(), "hash").Return(db.UserRefreshToken{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockRefreshTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewRefreshTokenRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByHash(context.Background(), "hash")
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, "hash", got.Hash(), "hash does not match")
+			assert.Equal(t, sessionID, got.SessionID(), "session ID does not match")
+			assert.Equal(t, tt.wantUsedAt, got.UsedAt(), "used at does not match")
+		})
+	}
+}
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	sessionID := id.NewSessionID()
+	token := entity.ReconstructRefreshToken("hash", sessionID, nil)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockRefreshTokenQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: トークンのハッシュとセッションが保存される事",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().CreateUserRefreshToken(gomock.Any(), db.CreateUserRefreshTokenParams{
+					TokenHash: "hash",
+					SessionID: pgtype.UUID{Bytes: sessionID.UUID(), Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().CreateUserRefreshToken(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockRefreshTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewRefreshTokenRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), token)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestRefreshTokenRepository_MarkUsed(t *testing.T) {
+	t.Parallel()
+
+	usedAt := time.Date(2025, 8, 1, 12, 30, 0, 0, time.UTC)
+	token := entity.ReconstructRefreshToken("hash", id.NewSessionID(), &usedAt)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockRefreshTokenQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 交換日時が保存される事",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().UseUserRefreshToken(gomock.Any(), db.UseUserRefreshTokenParams{
+					TokenHash: "hash",
+					UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+				}).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "異常系: 同時に交換されて既に交換済みの場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockRefreshTokenQuerier) {
+				mockQuerier.EXPECT().UseUserRefreshToken(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockRefreshTokenQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewRefreshTokenRepository(mockQuerier)
+
+			// Act
+			err := repo.MarkUsed(context.Background(), token)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
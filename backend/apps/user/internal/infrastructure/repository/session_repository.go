@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+// SessionQuerier はデータベースクエリを定義するインターフェース
+type SessionQuerier interface {
+	GetUserSession(ctx context.Context, sessionID pgtype.UUID) (db.UserSession, error)
+	ListActiveUserSessions(ctx context.Context, arg db.ListActiveUserSessionsParams) ([]db.UserSession, error)
+	CreateUserSession(ctx context.Context, arg db.CreateUserSessionParams) error
+	TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) (int64, error)
+	RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) error
+	RevokeUserSessionsByUser(ctx context.Context, arg db.RevokeUserSessionsByUserParams) (int64, error)
+}
+
+// SessionRepository はSessionRepositoryの実装
+type SessionRepository struct {
+	queries SessionQuerier
+}
+
+// NewSessionRepository は新しいSessionRepositoryを作成
+func NewSessionRepository(queries SessionQuerier) *SessionRepository {
+	return &SessionRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのセッションを取得
+func (r *SessionRepository) FindByID(ctx context.Context, sessionID id.SessionID) (*entity.Session, error) {
+	row, err := r.queries.GetUserSession(ctx, pgtype.UUID{Bytes: sessionID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("session not found", err)
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return r.toEntity(row)
+}
+
+// ListActiveByUserID はユーザーの有効なセッションを最後に使用した順に取得
+func (r *SessionRepository) ListActiveByUserID(ctx context.Context, userID id.UserID, now time.Time) ([]*entity.Session, error) {
+	rows, err := r.queries.ListActiveUserSessions(ctx, db.ListActiveUserSessionsParams{
+		UserID:    pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active sessions: %w", err)
+	}
+
+	sessions := make([]*entity.Session, 0, len(rows))
+	for _, row := range rows {
+		session, err := r.toEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// Create はセッションを保存
+func (r *SessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	if err := r.queries.CreateUserSession(ctx, db.CreateUserSessionParams{
+		SessionID:  pgtype.UUID{Bytes: session.ID().UUID(), Valid: true},
+		UserID:     pgtype.UUID{Bytes: session.UserID().UUID(), Valid: true},
+		UserAgent:  session.UserAgent(),
+		IpAddress:  session.IPAddress(),
+		ExpiresAt:  pgtype.Timestamptz{Time: session.ExpiresAt(), Valid: true},
+		LastUsedAt: pgtype.Timestamptz{Time: session.LastUsedAt(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// Touch はリフレッシュトークンの交換で更新した最終使用日時と有効期限を保存
+// 同時に失効していた場合はNotFoundエラーを返す
+func (r *SessionRepository) Touch(ctx context.Context, session *entity.Session) error {
+	affected, err := r.queries.TouchUserSession(ctx, db.TouchUserSessionParams{
+		SessionID:  pgtype.UUID{Bytes: session.ID().UUID(), Valid: true},
+		UserAgent:  session.UserAgent(),
+		IpAddress:  session.IPAddress(),
+		ExpiresAt:  pgtype.Timestamptz{Time: session.ExpiresAt(), Valid: true},
+		LastUsedAt: pgtype.Timestamptz{Time: session.LastUsedAt(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to touch session: %w", err)
+	}
+	if affected == 0 {
+		return errs.NewNotFoundError("session not found or revoked", nil)
+	}
+	return nil
+}
+
+// Revoke はセッションの失効を保存。既に失効している場合は何もしない
+func (r *SessionRepository) Revoke(ctx context.Context, session *entity.Session) error {
+	if session.RevokedAt() == nil {
+		return errors.New("session is not revoked")
+	}
+
+	if err := r.queries.RevokeUserSession(ctx, db.RevokeUserSessionParams{
+		SessionID:     pgtype.UUID{Bytes: session.ID().UUID(), Valid: true},
+		RevokedAt:     toTimestamptz(session.RevokedAt()),
+		RevokedReason: pgtype.Text{String: session.RevokedReason().String(), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllByUserID はユーザーの失効していないセッションをすべて失効させ、失効させた件数を返す
+func (r *SessionRepository) RevokeAllByUserID(ctx context.Context, userID id.UserID, reason entity.SessionRevokeReason, now time.Time) (int64, error) {
+	affected, err := r.queries.RevokeUserSessionsByUser(ctx, db.RevokeUserSessionsByUserParams{
+		UserID:        pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+		RevokedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+		RevokedReason: pgtype.Text{String: reason.String(), Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return affected, nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *SessionRepository) toEntity(row db.UserSession) (*entity.Session, error) {
+	var reason entity.SessionRevokeReason
+	if row.RevokedReason.Valid {
+		parsed, err := entity.ParseSessionRevokeReason(row.RevokedReason.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse session revoke reason: %w", err)
+		}
+		reason = parsed
+	}
+
+	return entity.ReconstructSession(
+		id.SessionIDFromUUID(row.SessionID.Bytes),
+		id.UserIDFromUUID(row.UserID.Bytes),
+		row.UserAgent,
+		row.IpAddress,
+		row.CreatedAt.Time,
+		row.LastUsedAt.Time,
+		row.ExpiresAt.Time,
+		fromTimestamptz(row.RevokedAt),
+		reason,
+	), nil
+}
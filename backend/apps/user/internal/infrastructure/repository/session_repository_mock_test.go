@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/infrastructure/repository/session_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/infrastructure/repository/session_repository.go -destination=./apps/user/internal/infrastructure/repository/session_repository_mock_test.go -package=repository_test
+//
+
+// Package repository_test is a generated GoMock package.
+package repository_test
+
+import (
+	context "context"
+	db "poketier/sqlc/db"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionQuerier is a mock of SessionQuerier interface.
+type MockSessionQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionQuerierMockRecorder is the mock recorder for MockSessionQuerier.
+type MockSessionQuerierMockRecorder struct {
+	mock *MockSessionQuerier
+}
+
+// NewMockSessionQuerier creates a new mock instance.
+func NewMockSessionQuerier(ctrl *gomock.Controller) *MockSessionQuerier {
+	mock := &MockSessionQuerier{ctrl: ctrl}
+	mock.recorder = &MockSessionQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionQuerier) EXPECT() *MockSessionQuerierMockRecorder {
+	return m.recorder
+}
+
+// CreateUserSession mocks base method.
+func (m *MockSessionQuerier) CreateUserSession(ctx context.Context, arg db.CreateUserSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserSession indicates an expected call of CreateUserSession.
+func (mr *MockSessionQuerierMockRecorder) CreateUserSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSession", reflect.TypeOf((*MockSessionQuerier)(nil).CreateUserSession), ctx, arg)
+}
+
+// GetUserSession mocks base method.
+func (m *MockSessionQuerier) GetUserSession(ctx context.Context, sessionID pgtype.UUID) (db.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSession", ctx, sessionID)
+	ret0, _ := ret[0].(db.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSession indicates an expected call of GetUserSession.
+func (mr *MockSessionQuerierMockRecorder) GetUserSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSession", reflect.TypeOf((*MockSessionQuerier)(nil).GetUserSession), ctx, sessionID)
+}
+
+// ListActiveUserSessions mocks base method.
+func (m *MockSessionQuerier) ListActiveUserSessions(ctx context.Context, arg db.ListActiveUserSessionsParams) ([]db.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveUserSessions", ctx, arg)
+	ret0, _ := ret[0].([]db.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveUserSessions indicates an expected call of ListActiveUserSessions.
+func (mr *MockSessionQuerierMockRecorder) ListActiveUserSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveUserSessions", reflect.TypeOf((*MockSessionQuerier)(nil).ListActiveUserSessions), ctx, arg)
+}
+
+// RevokeUserSession mocks base method.
+func (m *MockSessionQuerier) RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSession indicates an expected call of RevokeUserSession.
+func (mr *MockSessionQuerierMockRecorder) RevokeUserSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSession", reflect.TypeOf((*MockSessionQuerier)(nil).RevokeUserSession), ctx, arg)
+}
+
+// RevokeUserSessionsByUser mocks base method.
+func (m *MockSessionQuerier) RevokeUserSessionsByUser(ctx context.Context, arg db.RevokeUserSessionsByUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessionsByUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserSessionsByUser indicates an expected call of RevokeUserSessionsByUser.
+func (mr *MockSessionQuerierMockRecorder) RevokeUserSessionsByUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessionsByUser", reflect.TypeOf((*MockSessionQuerier)(nil).RevokeUserSessionsByUser), ctx, arg)
+}
+
+// TouchUserSession mocks base method.
+func (m *MockSessionQuerier) TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserSession", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchUserSession indicates an expected call of TouchUserSession.
+func (mr *MockSessionQuerierMockRecorder) TouchUserSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserSession", reflect.TypeOf((*MockSessionQuerier)(nil).TouchUserSession), ctx, arg)
+}
@@ -0,0 +1,398 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/vo/id"
+	"poketier/sqlc/db"
+)
+
+func TestSessionRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	sessionID, userID := id.NewSessionID(), id.NewUserID()
+	pgSessionID := pgtype.UUID{Bytes: sessionID.UUID(), Valid: true}
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockSessionQuerier)
+		wantReason   entity.SessionRevokeReason
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 失効したセッションが理由とともに取得できる事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().GetUserSession(gomock.Any(), pgSessionID).Return(db.UserSession{
+					SessionID:     pgSessionID,
+					UserID:        pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					UserAgent:     "Mozilla/5.0",
+					IpAddress:     "198.51.100.7",
+					ExpiresAt:     pgtype.Timestamptz{Time: createdAt.Add(entity.SessionTTL), Valid: true},
+					LastUsedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+					RevokedAt:     pgtype.Timestamptz{Time: revokedAt, Valid: true},
+					RevokedReason: pgtype.Text{String: "token_reuse", Valid: true},
+					CreatedAt:     pgtype.Timestamptz{Time: createdAt, Valid: true},
+				}, nil)
+			},
+			wantReason: entity.SessionRevokeReasonTokenReuse,
+		},
+		{
+			caseName: "異常系: セッションが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().GetUserSession(gomock.Any(), pgSessionID).Return(db.UserSession{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: 未定義の失効理由が保存されている場合",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().GetUserSession(gomock.Any(), pgSessionID).Return(db.UserSession{
+					SessionID:     pgSessionID,
+					UserID:        pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					RevokedAt:     pgtype.Timestamptz{Time: revokedAt, Valid: true},
+					RevokedReason: pgtype.Text{String: "expired", Valid: true},
+				}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), sessionID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, sessionID, got.ID(), "session ID does not match")
+			assert.Equal(t, userID, got.UserID(), "user ID does not match")
+			assert.Equal(t, "Mozilla/5.0", got.UserAgent(), "user agent does not match")
+			assert.Equal(t, "198.51.100.7", got.IPAddress(), "ip address does not match")
+			assert.Equal(t, &revokedAt, got.RevokedAt(), "revoked at does not match")
+			assert.Equal(t, tt.wantReason, got.RevokedReason(), "revoked reason does not match")
+		})
+	}
+}
+
+func TestSessionRepository_ListActiveByUserID(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	sessionIDs := []id.SessionID{id.NewSessionID(), id.NewSessionID()}
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSessionQuerier)
+		want        []id.SessionID
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 有効なセッションが取得した順に返される事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().ListActiveUserSessions(gomock.Any(), db.ListActiveUserSessionsParams{
+					UserID:    pgUserID,
+					ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+				}).Return([]db.UserSession{
+					{SessionID: pgtype.UUID{Bytes: sessionIDs[0].UUID(), Valid: true}, UserID: pgUserID},
+					{SessionID: pgtype.UUID{Bytes: sessionIDs[1].UUID(), Valid: true}, UserID: pgUserID},
+				}, nil)
+			},
+			want: sessionIDs,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().ListActiveUserSessions(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			got, err := repo.ListActiveByUserID(context.Background(), userID, now)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			gotIDs := make([]id.SessionID, 0, len(got))
+			for _, session := range got {
+				gotIDs = append(gotIDs, session.ID())
+			}
+			assert.Equal(t, tt.want, gotIDs, "session IDs do not match")
+		})
+	}
+}
+
+func TestSessionRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	session := entity.NewSession(id.NewUserID(), "Mozilla/5.0", "198.51.100.7", now)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSessionQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: セッションが保存される事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().CreateUserSession(gomock.Any(), db.CreateUserSessionParams{
+					SessionID:  pgtype.UUID{Bytes: session.ID().UUID(), Valid: true},
+					UserID:     pgtype.UUID{Bytes: session.UserID().UUID(), Valid: true},
+					UserAgent:  "Mozilla/5.0",
+					IpAddress:  "198.51.100.7",
+					ExpiresAt:  pgtype.Timestamptz{Time: now.Add(entity.SessionTTL), Valid: true},
+					LastUsedAt: pgtype.Timestamptz{Time: now, Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().CreateUserSession(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			err := repo.Create(context.Background(), session)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestSessionRepository_Touch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	session := entity.NewSession(id.NewUserID(), "Mozilla/5.0", "198.51.100.7", now)
+
+	tests := []struct {
+		caseName     string
+		setupMock    func(mockQuerier *MockSessionQuerier)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 最終使用日時と有効期限が保存される事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().TouchUserSession(gomock.Any(), db.TouchUserSessionParams{
+					SessionID:  pgtype.UUID{Bytes: session.ID().UUID(), Valid: true},
+					UserAgent:  "Mozilla/5.0",
+					IpAddress:  "198.51.100.7",
+					ExpiresAt:  pgtype.Timestamptz{Time: now.Add(entity.SessionTTL), Valid: true},
+					LastUsedAt: pgtype.Timestamptz{Time: now, Valid: true},
+				}).Return(int64(1), nil)
+			},
+		},
+		{
+			caseName: "異常系: 同時に失効していた場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().TouchUserSession(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			err := repo.Touch(context.Background(), session)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestSessionRepository_Revoke(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	revoked := entity.NewSession(id.NewUserID(), "", "", now)
+	revoked.Revoke(entity.SessionRevokeReasonLogout, now)
+
+	tests := []struct {
+		caseName    string
+		session     *entity.Session
+		setupMock   func(mockQuerier *MockSessionQuerier)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: 失効日時と理由が保存される事",
+			session:  revoked,
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().RevokeUserSession(gomock.Any(), db.RevokeUserSessionParams{
+					SessionID:     pgtype.UUID{Bytes: revoked.ID().UUID(), Valid: true},
+					RevokedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+					RevokedReason: pgtype.Text{String: "logout", Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			caseName:    "異常系: 失効させていないセッションは保存しない事",
+			session:     entity.NewSession(id.NewUserID(), "", "", now),
+			setupMock:   func(mockQuerier *MockSessionQuerier) {},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			err := repo.Revoke(context.Background(), tt.session)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestSessionRepository_RevokeAllByUserID(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockSessionQuerier)
+		want        int64
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ユーザーのセッションを失効させ、件数が返される事",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().RevokeUserSessionsByUser(gomock.Any(), db.RevokeUserSessionsByUserParams{
+					UserID:        pgtype.UUID{Bytes: userID.UUID(), Valid: true},
+					RevokedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+					RevokedReason: pgtype.Text{String: "admin", Valid: true},
+				}).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+		{
+			caseName: "異常系: データベースエラー",
+			setupMock: func(mockQuerier *MockSessionQuerier) {
+				mockQuerier.EXPECT().RevokeUserSessionsByUser(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockSessionQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewSessionRepository(mockQuerier)
+
+			// Act
+			got, err := repo.RevokeAllByUserID(context.Background(), userID, entity.SessionRevokeReasonAdmin, now)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			require.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "revoked count does not match")
+		})
+	}
+}
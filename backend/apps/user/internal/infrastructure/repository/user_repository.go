@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"poketier/sqlc/db"
+)
+
+// UserQuerier はデータベースクエリを定義するインターフェース
+type UserQuerier interface {
+	GetUser(ctx context.Context, userID pgtype.UUID) (db.User, error)
+	CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error)
+	UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error)
+}
+
+// UserRepository はUserRepositoryの実装
+type UserRepository struct {
+	queries UserQuerier
+}
+
+// NewUserRepository は新しいUserRepositoryを作成
+func NewUserRepository(queries UserQuerier) *UserRepository {
+	return &UserRepository{
+		queries: queries,
+	}
+}
+
+// FindByID は指定したIDのユーザーを取得
+func (r *UserRepository) FindByID(ctx context.Context, userID id.UserID) (*entity.User, error) {
+	row, err := r.queries.GetUser(ctx, pgtype.UUID{Bytes: userID.UUID(), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errs.NewNotFoundError("user not found", err)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return r.toEntity(row)
+}
+
+// Create はユーザーを保存
+func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	if _, err := r.queries.CreateUser(ctx, db.CreateUserParams{
+		UserID:      pgtype.UUID{Bytes: user.ID().UUID(), Valid: true},
+		DisplayName: user.DisplayName(),
+		Role:        user.Role().String(),
+	}); err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	return nil
+}
+
+// Update はユーザーの表示名・権限・無効化状態を保存
+func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
+	params := db.UpdateUserParams{
+		UserID:      pgtype.UUID{Bytes: user.ID().UUID(), Valid: true},
+		DisplayName: user.DisplayName(),
+		Role:        user.Role().String(),
+	}
+	if disabledAt := user.DisabledAt(); disabledAt != nil {
+		params.DisabledAt = pgtype.Timestamptz{Time: *disabledAt, Valid: true}
+	}
+
+	if _, err := r.queries.UpdateUser(ctx, params); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errs.NewNotFoundError("user not found", err)
+		}
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+// toEntity はデータベースモデルからエンティティに変換
+func (r *UserRepository) toEntity(row db.User) (*entity.User, error) {
+	userRole, err := role.ParseRole(row.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user role: %w", err)
+	}
+
+	var disabledAt *time.Time
+	if row.DisabledAt.Valid {
+		disabledAt = &row.DisabledAt.Time
+	}
+
+	user, err := entity.ReconstructUser(
+		id.UserIDFromUUID(row.UserID.Bytes),
+		row.DisplayName,
+		userRole,
+		disabledAt,
+		row.CreatedAt.Time,
+		row.UpdatedAt.Time,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user entity: %w", err)
+	}
+
+	return user, nil
+}
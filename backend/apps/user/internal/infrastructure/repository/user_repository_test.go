@@ -0,0 +1,248 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"poketier/apps/user/internal/domain/entity"
+	"poketier/apps/user/internal/infrastructure/repository"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"poketier/sqlc/db"
+)
+
+func TestUserRepository_FindByID(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	pgUserID := pgtype.UUID{Bytes: userID.UUID(), Valid: true}
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	disabledAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName       string
+		setupMock      func(mockQuerier *MockUserQuerier)
+		wantRole       role.Role
+		wantDisabledAt *time.Time
+		wantNotFound   bool
+		expectError    bool
+	}{
+		{
+			caseName: "正常系: ユーザーが取得できる事",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().GetUser(gomock.Any(), pgUserID).Return(db.User{
+					UserID:      pgUserID,
+					DisplayName: "配信者A",
+					Role:        "moderator",
+					CreatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+					UpdatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+				}, nil)
+			},
+			wantRole: role.Moderator,
+		},
+		{
+			caseName: "正常系: 無効化されたユーザーが無効化日時を含めて取得できる事",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().GetUser(gomock.Any(), pgUserID).Return(db.User{
+					UserID:      pgUserID,
+					DisplayName: "配信者A",
+					Role:        "user",
+					DisabledAt:  pgtype.Timestamptz{Time: disabledAt, Valid: true},
+					CreatedAt:   pgtype.Timestamptz{Time: createdAt, Valid: true},
+					UpdatedAt:   pgtype.Timestamptz{Time: disabledAt, Valid: true},
+				}, nil)
+			},
+			wantRole:       role.User,
+			wantDisabledAt: &disabledAt,
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().GetUser(gomock.Any(), pgUserID).Return(db.User{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+		{
+			caseName: "異常系: 未定義の権限が保存されている場合",
+			setupMock: func(mockQuerier *MockUserQuerier) {
+				mockQuerier.EXPECT().GetUser(gomock.Any(), pgUserID).Return(db.User{
+					UserID:      pgUserID,
+					DisplayName: "配信者A",
+					Role:        "owner",
+				}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockUserQuerier(ctrl)
+			tt.setupMock(mockQuerier)
+			repo := repository.NewUserRepository(mockQuerier)
+
+			// Act
+			got, err := repo.FindByID(context.Background(), userID)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, userID, got.ID(), "user ID does not match")
+			assert.Equal(t, "配信者A", got.DisplayName(), "display name does not match")
+			assert.Equal(t, tt.wantRole, got.Role(), "role does not match")
+			assert.Equal(t, tt.wantDisabledAt, got.DisabledAt(), "disabled at does not match")
+		})
+	}
+}
+
+func TestUserRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName    string
+		setupMock   func(mockQuerier *MockUserQuerier, user *entity.User)
+		expectError bool
+	}{
+		{
+			caseName: "正常系: ユーザーが一般ユーザー権限で保存される事",
+			setupMock: func(mockQuerier *MockUserQuerier, user *entity.User) {
+				mockQuerier.EXPECT().CreateUser(gomock.Any(), db.CreateUserParams{
+					UserID:      pgtype.UUID{Bytes: user.ID().UUID(), Valid: true},
+					DisplayName: "配信者A",
+					Role:        "user",
+				}).Return(db.User{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: DBエラーが発生した場合",
+			setupMock: func(mockQuerier *MockUserQuerier, user *entity.User) {
+				mockQuerier.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(db.User{}, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			user, err := entity.NewUser(id.NewUserID(), "配信者A")
+			assert.NoError(t, err, "failed to create user")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockUserQuerier(ctrl)
+			tt.setupMock(mockQuerier, user)
+			repo := repository.NewUserRepository(mockQuerier)
+
+			// Act
+			err = repo.Create(context.Background(), user)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestUserRepository_Update(t *testing.T) {
+	t.Parallel()
+
+	disabledAt := time.Date(2025, 8, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		caseName     string
+		disable      bool
+		setupMock    func(mockQuerier *MockUserQuerier, user *entity.User)
+		wantNotFound bool
+		expectError  bool
+	}{
+		{
+			caseName: "正常系: 表示名と権限が保存される事",
+			setupMock: func(mockQuerier *MockUserQuerier, user *entity.User) {
+				mockQuerier.EXPECT().UpdateUser(gomock.Any(), db.UpdateUserParams{
+					UserID:      pgtype.UUID{Bytes: user.ID().UUID(), Valid: true},
+					DisplayName: "配信者A",
+					Role:        "user",
+				}).Return(db.User{}, nil)
+			},
+		},
+		{
+			caseName: "正常系: 無効化日時が保存される事",
+			disable:  true,
+			setupMock: func(mockQuerier *MockUserQuerier, user *entity.User) {
+				mockQuerier.EXPECT().UpdateUser(gomock.Any(), db.UpdateUserParams{
+					UserID:      pgtype.UUID{Bytes: user.ID().UUID(), Valid: true},
+					DisplayName: "配信者A",
+					Role:        "user",
+					DisabledAt:  pgtype.Timestamptz{Time: disabledAt, Valid: true},
+				}).Return(db.User{}, nil)
+			},
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、NotFoundエラーになる事",
+			setupMock: func(mockQuerier *MockUserQuerier, user *entity.User) {
+				mockQuerier.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(db.User{}, pgx.ErrNoRows)
+			},
+			wantNotFound: true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			user, err := entity.NewUser(id.NewUserID(), "配信者A")
+			assert.NoError(t, err, "failed to create user")
+			if tt.disable {
+				user.Disable(disabledAt)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockQuerier := NewMockUserQuerier(ctrl)
+			tt.setupMock(mockQuerier, user)
+			repo := repository.NewUserRepository(mockQuerier)
+
+			// Act
+			err = repo.Update(context.Background(), user)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err, "expected error but got none")
+				assert.Equal(t, tt.wantNotFound, isNotFound(err), "not found error does not match")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func isNotFound(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrNotFound
+}
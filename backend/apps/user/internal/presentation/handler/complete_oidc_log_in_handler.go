@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompleteOIDCLogInHandler struct {
+	uc CompleteOIDCLogInUseCase
+}
+
+type CompleteOIDCLogInUseCase interface {
+	Execute(ctx context.Context, params usecase.CompleteOIDCLogInParams) (*usecase.LogInResult, error)
+}
+
+func NewCompleteOIDCLogInHandler(uc CompleteOIDCLogInUseCase) *CompleteOIDCLogInHandler {
+	return &CompleteOIDCLogInHandler{
+		uc: uc,
+	}
+}
+
+func (h *CompleteOIDCLogInHandler) Handle(ctx *gin.Context) {
+	var req request.CompleteOIDCLogInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.CompleteOIDCLogInParams{
+		Provider:  ctx.Param("provider"),
+		Code:      req.Code,
+		State:     req.State,
+		UserAgent: ctx.Request.UserAgent(),
+		IPAddress: ctx.ClientIP(),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	// アクセストークンをキャッシュさせない（RFC 6749 5.1）
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, response.NewLogInResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/complete_oidc_log_in_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/complete_oidc_log_in_handler.go -destination=./apps/user/internal/presentation/handler/complete_oidc_log_in_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompleteOIDCLogInUseCase is a mock of CompleteOIDCLogInUseCase interface.
+type MockCompleteOIDCLogInUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompleteOIDCLogInUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCompleteOIDCLogInUseCaseMockRecorder is the mock recorder for MockCompleteOIDCLogInUseCase.
+type MockCompleteOIDCLogInUseCaseMockRecorder struct {
+	mock *MockCompleteOIDCLogInUseCase
+}
+
+// NewMockCompleteOIDCLogInUseCase creates a new mock instance.
+func NewMockCompleteOIDCLogInUseCase(ctrl *gomock.Controller) *MockCompleteOIDCLogInUseCase {
+	mock := &MockCompleteOIDCLogInUseCase{ctrl: ctrl}
+	mock.recorder = &MockCompleteOIDCLogInUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompleteOIDCLogInUseCase) EXPECT() *MockCompleteOIDCLogInUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCompleteOIDCLogInUseCase) Execute(ctx context.Context, params usecase.CompleteOIDCLogInParams) (*usecase.LogInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.LogInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCompleteOIDCLogInUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCompleteOIDCLogInUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompleteOIDCLogInHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	expiresAt := time.Date(2025, 8, 1, 12, 15, 0, 0, time.UTC)
+	refreshExpiresAt := time.Date(2025, 8, 31, 12, 0, 0, 0, time.UTC)
+	body := `{"code":"code","state":"state"}`
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockCompleteOIDCLogInUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 発行したアクセストークンが返される",
+			body:     body,
+			mockSetup: func(mockUC *MockCompleteOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.CompleteOIDCLogInParams{Provider: "mock", Code: "code", State: "state", UserAgent: "Mozilla/5.0", IPAddress: "192.0.2.1"}).
+					Return(&usecase.LogInResult{AccessToken: "access-token", ExpiresAt: expiresAt, RefreshToken: "refresh-token", RefreshTokenExpiresAt: refreshExpiresAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.AccessTokenResponse{
+				AccessToken:           "access-token",
+				TokenType:             "Bearer",
+				ExpiresAt:             expiresAt,
+				RefreshToken:          "refresh-token",
+				RefreshTokenExpiresAt: refreshExpiresAt,
+			},
+		},
+		{
+			caseName:       "異常系: state がない場合、400が返される",
+			body:           `{"code":"code"}`,
+			mockSetup:      func(mockUC *MockCompleteOIDCLogInUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: state が期限切れの場合、400が返される",
+			body:     body,
+			mockSetup: func(mockUC *MockCompleteOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewValidationError("invalid or expired state", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: 認可コードが不正な場合、401が返される",
+			body:     body,
+			mockSetup: func(mockUC *MockCompleteOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewUnauthorizedError("failed to authenticate with the identity provider", nil))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: ユーザーが無効化されている場合、403が返される",
+			body:     body,
+			mockSetup: func(mockUC *MockCompleteOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewForbiddenError("user is disabled", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Forbidden",
+				Status: http.StatusForbidden,
+				Detail: "You do not have permission to perform this action.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockCompleteOIDCLogInUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewCompleteOIDCLogInHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/oidc/mock/callback", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request.Header.Set("User-Agent", "Mozilla/5.0")
+			c.Params = gin.Params{{Key: "provider", Value: "mock"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "no-store", w.Header().Get("Cache-Control"), "access token response should not be cached")
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
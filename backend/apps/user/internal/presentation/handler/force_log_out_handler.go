@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ForceLogOutHandler struct {
+	uc ForceLogOutUseCase
+}
+
+type ForceLogOutUseCase interface {
+	Execute(ctx context.Context, params usecase.ForceLogOutParams) error
+}
+
+func NewForceLogOutHandler(uc ForceLogOutUseCase) *ForceLogOutHandler {
+	return &ForceLogOutHandler{
+		uc: uc,
+	}
+}
+
+func (h *ForceLogOutHandler) Handle(ctx *gin.Context) {
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.ForceLogOutParams{
+		UserID:    ctx.Param("user_id"),
+		ActorRole: auth.RoleFromContext(ctx.Request.Context()),
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/force_log_out_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/force_log_out_handler.go -destination=./apps/user/internal/presentation/handler/force_log_out_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForceLogOutUseCase is a mock of ForceLogOutUseCase interface.
+type MockForceLogOutUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockForceLogOutUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockForceLogOutUseCaseMockRecorder is the mock recorder for MockForceLogOutUseCase.
+type MockForceLogOutUseCaseMockRecorder struct {
+	mock *MockForceLogOutUseCase
+}
+
+// NewMockForceLogOutUseCase creates a new mock instance.
+func NewMockForceLogOutUseCase(ctrl *gomock.Controller) *MockForceLogOutUseCase {
+	mock := &MockForceLogOutUseCase{ctrl: ctrl}
+	mock.recorder = &MockForceLogOutUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForceLogOutUseCase) EXPECT() *MockForceLogOutUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockForceLogOutUseCase) Execute(ctx context.Context, params usecase.ForceLogOutParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockForceLogOutUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockForceLogOutUseCase)(nil).Execute), ctx, params)
+}
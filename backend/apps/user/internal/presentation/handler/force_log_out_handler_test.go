@@ -0,0 +1,86 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestForceLogOutHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	targetID := id.NewUserID()
+
+	tests := []struct {
+		caseName       string
+		mockSetup      func(*MockForceLogOutUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 対象のユーザーIDと操作者の権限がユースケースに渡り、204が返される",
+			mockSetup: func(mockUC *MockForceLogOutUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ForceLogOutParams{UserID: targetID.String(), ActorRole: role.Admin}).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			caseName: "異常系: ユーザーが存在しない場合、404が返される",
+			mockSetup: func(mockUC *MockForceLogOutUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("user not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockForceLogOutUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewForceLogOutHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := auth.WithUser(context.Background(), id.NewUserID(), role.Admin)
+			c.Request = httptest.NewRequest(http.MethodDelete, "/admin/users/"+targetID.String()+"/sessions", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "user_id", Value: targetID.String()}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetMeHandler struct {
+	uc GetMeUseCase
+}
+
+type GetMeUseCase interface {
+	Execute(ctx context.Context, params usecase.GetMeParams) (*usecase.GetMeResult, error)
+}
+
+func NewGetMeHandler(uc GetMeUseCase) *GetMeHandler {
+	return &GetMeHandler{
+		uc: uc,
+	}
+}
+
+func (h *GetMeHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.GetMeParams{
+		UserID: userID,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewGetMeResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/get_me_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/get_me_handler.go -destination=./apps/user/internal/presentation/handler/get_me_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetMeUseCase is a mock of GetMeUseCase interface.
+type MockGetMeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMeUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetMeUseCaseMockRecorder is the mock recorder for MockGetMeUseCase.
+type MockGetMeUseCaseMockRecorder struct {
+	mock *MockGetMeUseCase
+}
+
+// NewMockGetMeUseCase creates a new mock instance.
+func NewMockGetMeUseCase(ctrl *gomock.Controller) *MockGetMeUseCase {
+	mock := &MockGetMeUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetMeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMeUseCase) EXPECT() *MockGetMeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetMeUseCase) Execute(ctx context.Context, params usecase.GetMeParams) (*usecase.GetMeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.GetMeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetMeUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetMeUseCase)(nil).Execute), ctx, params)
+}
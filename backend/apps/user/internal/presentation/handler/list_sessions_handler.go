@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListSessionsHandler struct {
+	uc ListSessionsUseCase
+}
+
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, params usecase.ListSessionsParams) (*usecase.ListSessionsResult, error)
+}
+
+func NewListSessionsHandler(uc ListSessionsUseCase) *ListSessionsHandler {
+	return &ListSessionsHandler{
+		uc: uc,
+	}
+}
+
+func (h *ListSessionsHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.ListSessionsParams{
+		UserID: userID,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewListSessionsResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/list_sessions_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/list_sessions_handler.go -destination=./apps/user/internal/presentation/handler/list_sessions_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListSessionsUseCase is a mock of ListSessionsUseCase interface.
+type MockListSessionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSessionsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListSessionsUseCaseMockRecorder is the mock recorder for MockListSessionsUseCase.
+type MockListSessionsUseCaseMockRecorder struct {
+	mock *MockListSessionsUseCase
+}
+
+// NewMockListSessionsUseCase creates a new mock instance.
+func NewMockListSessionsUseCase(ctrl *gomock.Controller) *MockListSessionsUseCase {
+	mock := &MockListSessionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListSessionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSessionsUseCase) EXPECT() *MockListSessionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListSessionsUseCase) Execute(ctx context.Context, params usecase.ListSessionsParams) (*usecase.ListSessionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ListSessionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListSessionsUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListSessionsUseCase)(nil).Execute), ctx, params)
+}
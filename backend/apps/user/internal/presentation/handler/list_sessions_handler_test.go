@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSessionsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	sessionID := id.NewSessionID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	lastUsedAt := createdAt.Add(time.Hour)
+	expiresAt := lastUsedAt.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		mockSetup      func(*MockListSessionsUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: ログイン中のユーザーの有効なセッションが返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListSessionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ListSessionsParams{UserID: userID}).Return(&usecase.ListSessionsResult{
+					Sessions: []usecase.SessionSummary{
+						{
+							SessionID:  sessionID.String(),
+							UserAgent:  "Mozilla/5.0",
+							IPAddress:  "198.51.100.7",
+							CreatedAt:  createdAt,
+							LastUsedAt: lastUsedAt,
+							ExpiresAt:  expiresAt,
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.ListSessionsResponse{
+				Sessions: []response.SessionResponse{
+					{
+						SessionID:  sessionID.String(),
+						UserAgent:  "Mozilla/5.0",
+						IPAddress:  "198.51.100.7",
+						CreatedAt:  createdAt,
+						LastUsedAt: lastUsedAt,
+						ExpiresAt:  expiresAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "正常系: セッションがない場合、空の配列が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListSessionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&usecase.ListSessionsResult{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   response.ListSessionsResponse{Sessions: []response.SessionResponse{}},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			mockSetup:      func(mockUC *MockListSessionsUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			mockSetup: func(mockUC *MockListSessionsUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockListSessionsUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewListSessionsHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, "/users/me/sessions", nil)
+			c.Request = c.Request.WithContext(ctx)
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LogInHandler struct {
+	uc LogInUseCase
+}
+
+type LogInUseCase interface {
+	Execute(ctx context.Context, params usecase.LogInParams) (*usecase.LogInResult, error)
+}
+
+func NewLogInHandler(uc LogInUseCase) *LogInHandler {
+	return &LogInHandler{
+		uc: uc,
+	}
+}
+
+func (h *LogInHandler) Handle(ctx *gin.Context) {
+	var req request.LogInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.LogInParams{
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: ctx.Request.UserAgent(),
+		IPAddress: ctx.ClientIP(),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	// アクセストークンをキャッシュさせない（RFC 6749 5.1）
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, response.NewLogInResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/log_in_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/log_in_handler.go -destination=./apps/user/internal/presentation/handler/log_in_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogInUseCase is a mock of LogInUseCase interface.
+type MockLogInUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogInUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLogInUseCaseMockRecorder is the mock recorder for MockLogInUseCase.
+type MockLogInUseCaseMockRecorder struct {
+	mock *MockLogInUseCase
+}
+
+// NewMockLogInUseCase creates a new mock instance.
+func NewMockLogInUseCase(ctrl *gomock.Controller) *MockLogInUseCase {
+	mock := &MockLogInUseCase{ctrl: ctrl}
+	mock.recorder = &MockLogInUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogInUseCase) EXPECT() *MockLogInUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogInUseCase) Execute(ctx context.Context, params usecase.LogInParams) (*usecase.LogInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.LogInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogInUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogInUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LogOutEverywhereHandler struct {
+	uc LogOutEverywhereUseCase
+}
+
+type LogOutEverywhereUseCase interface {
+	Execute(ctx context.Context, params usecase.LogOutEverywhereParams) error
+}
+
+func NewLogOutEverywhereHandler(uc LogOutEverywhereUseCase) *LogOutEverywhereHandler {
+	return &LogOutEverywhereHandler{
+		uc: uc,
+	}
+}
+
+func (h *LogOutEverywhereHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.LogOutEverywhereParams{
+		UserID: userID,
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
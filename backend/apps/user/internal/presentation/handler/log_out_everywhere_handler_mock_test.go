@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/log_out_everywhere_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/log_out_everywhere_handler.go -destination=./apps/user/internal/presentation/handler/log_out_everywhere_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogOutEverywhereUseCase is a mock of LogOutEverywhereUseCase interface.
+type MockLogOutEverywhereUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogOutEverywhereUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLogOutEverywhereUseCaseMockRecorder is the mock recorder for MockLogOutEverywhereUseCase.
+type MockLogOutEverywhereUseCaseMockRecorder struct {
+	mock *MockLogOutEverywhereUseCase
+}
+
+// NewMockLogOutEverywhereUseCase creates a new mock instance.
+func NewMockLogOutEverywhereUseCase(ctrl *gomock.Controller) *MockLogOutEverywhereUseCase {
+	mock := &MockLogOutEverywhereUseCase{ctrl: ctrl}
+	mock.recorder = &MockLogOutEverywhereUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogOutEverywhereUseCase) EXPECT() *MockLogOutEverywhereUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogOutEverywhereUseCase) Execute(ctx context.Context, params usecase.LogOutEverywhereParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogOutEverywhereUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogOutEverywhereUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LogOutHandler struct {
+	uc LogOutUseCase
+}
+
+type LogOutUseCase interface {
+	Execute(ctx context.Context, params usecase.LogOutParams) error
+}
+
+func NewLogOutHandler(uc LogOutUseCase) *LogOutHandler {
+	return &LogOutHandler{
+		uc: uc,
+	}
+}
+
+func (h *LogOutHandler) Handle(ctx *gin.Context) {
+	var req request.RefreshTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.LogOutParams{
+		RefreshToken: req.RefreshToken,
+	}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
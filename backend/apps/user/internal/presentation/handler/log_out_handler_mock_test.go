@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/log_out_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/log_out_handler.go -destination=./apps/user/internal/presentation/handler/log_out_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogOutUseCase is a mock of LogOutUseCase interface.
+type MockLogOutUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogOutUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLogOutUseCaseMockRecorder is the mock recorder for MockLogOutUseCase.
+type MockLogOutUseCaseMockRecorder struct {
+	mock *MockLogOutUseCase
+}
+
+// NewMockLogOutUseCase creates a new mock instance.
+func NewMockLogOutUseCase(ctrl *gomock.Controller) *MockLogOutUseCase {
+	mock := &MockLogOutUseCase{ctrl: ctrl}
+	mock.recorder = &MockLogOutUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogOutUseCase) EXPECT() *MockLogOutUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogOutUseCase) Execute(ctx context.Context, params usecase.LogOutParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogOutUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogOutUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RefreshSessionHandler struct {
+	uc RefreshSessionUseCase
+}
+
+type RefreshSessionUseCase interface {
+	Execute(ctx context.Context, params usecase.RefreshSessionParams) (*usecase.LogInResult, error)
+}
+
+func NewRefreshSessionHandler(uc RefreshSessionUseCase) *RefreshSessionHandler {
+	return &RefreshSessionHandler{
+		uc: uc,
+	}
+}
+
+func (h *RefreshSessionHandler) Handle(ctx *gin.Context) {
+	var req request.RefreshTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.RefreshSessionParams{
+		RefreshToken: req.RefreshToken,
+		UserAgent:    ctx.Request.UserAgent(),
+		IPAddress:    ctx.ClientIP(),
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	// アクセストークンをキャッシュさせない（RFC 6749 5.1）
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, response.NewLogInResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/refresh_session_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/refresh_session_handler.go -destination=./apps/user/internal/presentation/handler/refresh_session_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshSessionUseCase is a mock of RefreshSessionUseCase interface.
+type MockRefreshSessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshSessionUseCaseMockRecorder is the mock recorder for MockRefreshSessionUseCase.
+type MockRefreshSessionUseCaseMockRecorder struct {
+	mock *MockRefreshSessionUseCase
+}
+
+// NewMockRefreshSessionUseCase creates a new mock instance.
+func NewMockRefreshSessionUseCase(ctrl *gomock.Controller) *MockRefreshSessionUseCase {
+	mock := &MockRefreshSessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionUseCase) EXPECT() *MockRefreshSessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRefreshSessionUseCase) Execute(ctx context.Context, params usecase.RefreshSessionParams) (*usecase.LogInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.LogInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRefreshSessionUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRefreshSessionUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshSessionHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	expiresAt := time.Date(2025, 8, 1, 12, 15, 0, 0, time.UTC)
+	refreshExpiresAt := time.Date(2025, 8, 31, 12, 0, 0, 0, time.UTC)
+	body := `{"refresh_token":"old-refresh-token"}`
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockRefreshSessionUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 再発行したアクセストークンと新しいリフレッシュトークンが返される",
+			body:     body,
+			mockSetup: func(mockUC *MockRefreshSessionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.RefreshSessionParams{RefreshToken: "old-refresh-token", UserAgent: "Mozilla/5.0", IPAddress: "192.0.2.1"}).
+					Return(&usecase.LogInResult{AccessToken: "access-token", ExpiresAt: expiresAt, RefreshToken: "new-refresh-token", RefreshTokenExpiresAt: refreshExpiresAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.AccessTokenResponse{
+				AccessToken:           "access-token",
+				TokenType:             "Bearer",
+				ExpiresAt:             expiresAt,
+				RefreshToken:          "new-refresh-token",
+				RefreshTokenExpiresAt: refreshExpiresAt,
+			},
+		},
+		{
+			caseName:       "異常系: リフレッシュトークンがない場合、400が返される",
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockRefreshSessionUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: 交換済みのトークンが使用された場合、401が返される",
+			body:     body,
+			mockSetup: func(mockUC *MockRefreshSessionUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewUnauthorizedError("refresh token reuse detected", nil))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockRefreshSessionUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewRefreshSessionHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request.Header.Set("User-Agent", "Mozilla/5.0")
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "no-store", w.Header().Get("Cache-Control"), "access token response should not be cached")
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/request_password_reset_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/request_password_reset_handler.go -destination=./apps/user/internal/presentation/handler/request_password_reset_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestPasswordResetUseCase is a mock of RequestPasswordResetUseCase interface.
+type MockRequestPasswordResetUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestPasswordResetUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestPasswordResetUseCaseMockRecorder is the mock recorder for MockRequestPasswordResetUseCase.
+type MockRequestPasswordResetUseCaseMockRecorder struct {
+	mock *MockRequestPasswordResetUseCase
+}
+
+// NewMockRequestPasswordResetUseCase creates a new mock instance.
+func NewMockRequestPasswordResetUseCase(ctrl *gomock.Controller) *MockRequestPasswordResetUseCase {
+	mock := &MockRequestPasswordResetUseCase{ctrl: ctrl}
+	mock.recorder = &MockRequestPasswordResetUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestPasswordResetUseCase) EXPECT() *MockRequestPasswordResetUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRequestPasswordResetUseCase) Execute(ctx context.Context, params usecase.RequestPasswordResetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRequestPasswordResetUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRequestPasswordResetUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestPasswordResetHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	badRequest := errs.ErrorResponse{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "The request is invalid.",
+	}
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockRequestPasswordResetUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: メールアドレスがユースケースに渡り、202が返される",
+			body:     `{"email":"ash@example.com"}`,
+			mockSetup: func(mockUC *MockRequestPasswordResetUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.RequestPasswordResetParams{Email: "ash@example.com"}).Return(nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			caseName:       "異常系: 必須項目がない場合、400が返される",
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockRequestPasswordResetUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			body:     `{"email":"ash@example.com"}`,
+			mockSetup: func(mockUC *MockRequestPasswordResetUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockRequestPasswordResetUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewRequestPasswordResetHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/password-reset", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
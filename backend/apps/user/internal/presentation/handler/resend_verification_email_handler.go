@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ResendVerificationEmailHandler struct {
+	uc ResendVerificationEmailUseCase
+}
+
+type ResendVerificationEmailUseCase interface {
+	Execute(ctx context.Context, params usecase.ResendVerificationEmailParams) error
+}
+
+func NewResendVerificationEmailHandler(uc ResendVerificationEmailUseCase) *ResendVerificationEmailHandler {
+	return &ResendVerificationEmailHandler{
+		uc: uc,
+	}
+}
+
+// Handle は登録済みかどうかに関わらず202を返す
+func (h *ResendVerificationEmailHandler) Handle(ctx *gin.Context) {
+	var req request.EmailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.ResendVerificationEmailParams{Email: req.Email}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
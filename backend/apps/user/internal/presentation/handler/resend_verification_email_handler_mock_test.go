@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/resend_verification_email_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/resend_verification_email_handler.go -destination=./apps/user/internal/presentation/handler/resend_verification_email_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResendVerificationEmailUseCase is a mock of ResendVerificationEmailUseCase interface.
+type MockResendVerificationEmailUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockResendVerificationEmailUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockResendVerificationEmailUseCaseMockRecorder is the mock recorder for MockResendVerificationEmailUseCase.
+type MockResendVerificationEmailUseCaseMockRecorder struct {
+	mock *MockResendVerificationEmailUseCase
+}
+
+// NewMockResendVerificationEmailUseCase creates a new mock instance.
+func NewMockResendVerificationEmailUseCase(ctrl *gomock.Controller) *MockResendVerificationEmailUseCase {
+	mock := &MockResendVerificationEmailUseCase{ctrl: ctrl}
+	mock.recorder = &MockResendVerificationEmailUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResendVerificationEmailUseCase) EXPECT() *MockResendVerificationEmailUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResendVerificationEmailUseCase) Execute(ctx context.Context, params usecase.ResendVerificationEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResendVerificationEmailUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResendVerificationEmailUseCase)(nil).Execute), ctx, params)
+}
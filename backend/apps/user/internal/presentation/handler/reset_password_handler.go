@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ResetPasswordHandler struct {
+	uc ResetPasswordUseCase
+}
+
+type ResetPasswordUseCase interface {
+	Execute(ctx context.Context, params usecase.ResetPasswordParams) error
+}
+
+func NewResetPasswordHandler(uc ResetPasswordUseCase) *ResetPasswordHandler {
+	return &ResetPasswordHandler{
+		uc: uc,
+	}
+}
+
+func (h *ResetPasswordHandler) Handle(ctx *gin.Context) {
+	var req request.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.ResetPasswordParams{Token: req.Token, NewPassword: req.NewPassword}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/reset_password_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/reset_password_handler.go -destination=./apps/user/internal/presentation/handler/reset_password_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResetPasswordUseCase is a mock of ResetPasswordUseCase interface.
+type MockResetPasswordUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockResetPasswordUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockResetPasswordUseCaseMockRecorder is the mock recorder for MockResetPasswordUseCase.
+type MockResetPasswordUseCaseMockRecorder struct {
+	mock *MockResetPasswordUseCase
+}
+
+// NewMockResetPasswordUseCase creates a new mock instance.
+func NewMockResetPasswordUseCase(ctrl *gomock.Controller) *MockResetPasswordUseCase {
+	mock := &MockResetPasswordUseCase{ctrl: ctrl}
+	mock.recorder = &MockResetPasswordUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResetPasswordUseCase) EXPECT() *MockResetPasswordUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResetPasswordUseCase) Execute(ctx context.Context, params usecase.ResetPasswordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResetPasswordUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResetPasswordUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResetPasswordHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	badRequest := errs.ErrorResponse{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "The request is invalid.",
+	}
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockResetPasswordUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: トークンと新しいパスワードがユースケースに渡り、204が返される",
+			body:     `{"token":"raw-token","new_password":"raichu-2025"}`,
+			mockSetup: func(mockUC *MockResetPasswordUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.ResetPasswordParams{Token: "raw-token", NewPassword: "raichu-2025"}).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			caseName: "異常系: パスワードが短い場合、400が返される",
+			body:     `{"token":"raw-token","new_password":"raichu"}`,
+			mockSetup: func(mockUC *MockResetPasswordUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errs.NewValidationError("invalid password", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName:       "異常系: 必須項目がない場合、400が返される",
+			body:           `{}`,
+			mockSetup:      func(mockUC *MockResetPasswordUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			body:     `{"token":"raw-token","new_password":"raichu-2025"}`,
+			mockSetup: func(mockUC *MockResetPasswordUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockResetPasswordUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewResetPasswordHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/password-reset/confirm", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status(), "status code should match expected")
+			if tt.expectedBody == nil {
+				assert.Empty(t, w.Body.String(), "response body should be empty")
+				return
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SignUpHandler struct {
+	uc SignUpUseCase
+}
+
+type SignUpUseCase interface {
+	Execute(ctx context.Context, params usecase.SignUpParams) (*usecase.SignUpResult, error)
+}
+
+func NewSignUpHandler(uc SignUpUseCase) *SignUpHandler {
+	return &SignUpHandler{
+		uc: uc,
+	}
+}
+
+func (h *SignUpHandler) Handle(ctx *gin.Context) {
+	var req request.SignUpRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.SignUpParams{
+		Email:       req.Email,
+		Password:    req.Password,
+		DisplayName: req.DisplayName,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.NewSignUpResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/sign_up_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/sign_up_handler.go -destination=./apps/user/internal/presentation/handler/sign_up_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignUpUseCase is a mock of SignUpUseCase interface.
+type MockSignUpUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSignUpUseCaseMockRecorder is the mock recorder for MockSignUpUseCase.
+type MockSignUpUseCaseMockRecorder struct {
+	mock *MockSignUpUseCase
+}
+
+// NewMockSignUpUseCase creates a new mock instance.
+func NewMockSignUpUseCase(ctrl *gomock.Controller) *MockSignUpUseCase {
+	mock := &MockSignUpUseCase{ctrl: ctrl}
+	mock.recorder = &MockSignUpUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpUseCase) EXPECT() *MockSignUpUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSignUpUseCase) Execute(ctx context.Context, params usecase.SignUpParams) (*usecase.SignUpResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.SignUpResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSignUpUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignUpUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,131 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignUpHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockSignUpUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 登録したユーザーが201で返される",
+			body:     `{"email":"ash@example.com","password":"pikachu-2025","display_name":"サトシ"}`,
+			mockSetup: func(mockUC *MockSignUpUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.SignUpParams{
+					Email:       "ash@example.com",
+					Password:    "pikachu-2025",
+					DisplayName: "サトシ",
+				}).Return(&usecase.SignUpResult{UserID: "0198a0c4-0000-7000-8000-000000000001", Email: "ash@example.com"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: response.SignUpResponse{
+				UserID: "0198a0c4-0000-7000-8000-000000000001",
+				Email:  "ash@example.com",
+			},
+		},
+		{
+			caseName:       "異常系: 必須項目がない場合、400が返される",
+			body:           `{"email":"ash@example.com","password":"pikachu-2025"}`,
+			mockSetup:      func(mockUC *MockSignUpUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: メールアドレスが登録済みの場合、409が返される",
+			body:     `{"email":"ash@example.com","password":"pikachu-2025","display_name":"サトシ"}`,
+			mockSetup: func(mockUC *MockSignUpUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewConflictError("email is already registered", nil))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Conflict",
+				Status: http.StatusConflict,
+				Detail: "A resource conflict occurred.",
+			},
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			body:     `{"email":"ash@example.com","password":"pikachu-2025","display_name":"サトシ"}`,
+			mockSetup: func(mockUC *MockSignUpUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockSignUpUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewSignUpHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/signup", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
+
+// assertJSONBody はレスポンスボディが期待値をJSONにしたものと一致するかを検証する
+func assertJSONBody(t *testing.T, expected interface{}, actual []byte) {
+	t.Helper()
+
+	var actualBody interface{}
+	err := json.Unmarshal(actual, &actualBody)
+	assert.NoError(t, err, "response body should be valid JSON")
+
+	expectedJSON, err := json.Marshal(expected)
+	assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+	var expectedBody interface{}
+	err = json.Unmarshal(expectedJSON, &expectedBody)
+	assert.NoError(t, err, "expected body should be valid JSON")
+
+	assert.Equal(t, expectedBody, actualBody, "response body should match expected")
+}
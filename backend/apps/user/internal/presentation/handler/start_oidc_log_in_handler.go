@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StartOIDCLogInHandler struct {
+	uc StartOIDCLogInUseCase
+}
+
+type StartOIDCLogInUseCase interface {
+	Execute(ctx context.Context, params usecase.StartOIDCLogInParams) (*usecase.StartOIDCLogInResult, error)
+}
+
+func NewStartOIDCLogInHandler(uc StartOIDCLogInUseCase) *StartOIDCLogInHandler {
+	return &StartOIDCLogInHandler{
+		uc: uc,
+	}
+}
+
+func (h *StartOIDCLogInHandler) Handle(ctx *gin.Context) {
+	// リクエストボディは省略できる
+	var req request.StartOIDCLogInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.StartOIDCLogInParams{
+		Provider:  ctx.Param("provider"),
+		LoginHint: req.LoginHint,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	// state はコールバックの照合に使用するためキャッシュさせない
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, response.NewOIDCAuthorizationResponse(result))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/start_oidc_log_in_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/start_oidc_log_in_handler.go -destination=./apps/user/internal/presentation/handler/start_oidc_log_in_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStartOIDCLogInUseCase is a mock of StartOIDCLogInUseCase interface.
+type MockStartOIDCLogInUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartOIDCLogInUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockStartOIDCLogInUseCaseMockRecorder is the mock recorder for MockStartOIDCLogInUseCase.
+type MockStartOIDCLogInUseCaseMockRecorder struct {
+	mock *MockStartOIDCLogInUseCase
+}
+
+// NewMockStartOIDCLogInUseCase creates a new mock instance.
+func NewMockStartOIDCLogInUseCase(ctrl *gomock.Controller) *MockStartOIDCLogInUseCase {
+	mock := &MockStartOIDCLogInUseCase{ctrl: ctrl}
+	mock.recorder = &MockStartOIDCLogInUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartOIDCLogInUseCase) EXPECT() *MockStartOIDCLogInUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockStartOIDCLogInUseCase) Execute(ctx context.Context, params usecase.StartOIDCLogInParams) (*usecase.StartOIDCLogInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.StartOIDCLogInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockStartOIDCLogInUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartOIDCLogInUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/errs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStartOIDCLogInHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	expiresAt := time.Date(2025, 8, 1, 12, 10, 0, 0, time.UTC)
+	result := &usecase.StartOIDCLogInResult{
+		AuthorizationURL: "https://idp.example.com/authorize?state=state",
+		State:            "state",
+		ExpiresAt:        expiresAt,
+	}
+
+	tests := []struct {
+		caseName       string
+		body           string
+		mockSetup      func(*MockStartOIDCLogInUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: 認可エンドポイントのURLと state が返される",
+			body:     `{"login_hint":"ash"}`,
+			mockSetup: func(mockUC *MockStartOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.StartOIDCLogInParams{Provider: "mock", LoginHint: "ash"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.OIDCAuthorizationResponse{
+				AuthorizationURL: "https://idp.example.com/authorize?state=state",
+				State:            "state",
+				ExpiresAt:        expiresAt,
+			},
+		},
+		{
+			caseName: "正常系: リクエストボディを省略できる",
+			body:     "",
+			mockSetup: func(mockUC *MockStartOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.StartOIDCLogInParams{Provider: "mock"}).Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.OIDCAuthorizationResponse{
+				AuthorizationURL: "https://idp.example.com/authorize?state=state",
+				State:            "state",
+				ExpiresAt:        expiresAt,
+			},
+		},
+		{
+			caseName:       "異常系: リクエストボディが不正な場合、400が返される",
+			body:           `{"login_hint":`,
+			mockSetup:      func(mockUC *MockStartOIDCLogInUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "The request is invalid.",
+			},
+		},
+		{
+			caseName: "異常系: 登録されていないプロバイダーの場合、404が返される",
+			body:     "",
+			mockSetup: func(mockUC *MockStartOIDCLogInUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errs.NewNotFoundError("identity provider not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "The requested resource was not found.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockStartOIDCLogInUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewStartOIDCLogInHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/oidc/mock/authorize", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "provider", Value: "mock"}}
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "no-store", w.Header().Get("Cache-Control"), "state should not be cached")
+			}
+			assertJSONBody(t, tt.expectedBody, w.Body.Bytes())
+		})
+	}
+}
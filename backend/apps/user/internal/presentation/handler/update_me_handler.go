@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateMeHandler struct {
+	uc UpdateMeUseCase
+}
+
+type UpdateMeUseCase interface {
+	Execute(ctx context.Context, params usecase.UpdateMeParams) (*usecase.UpdateMeResult, error)
+}
+
+func NewUpdateMeHandler(uc UpdateMeUseCase) *UpdateMeHandler {
+	return &UpdateMeHandler{
+		uc: uc,
+	}
+}
+
+func (h *UpdateMeHandler) Handle(ctx *gin.Context) {
+	userID, ok := auth.UserIDFromContext(ctx.Request.Context())
+	if !ok {
+		errs.HandleError(ctx, errs.NewUnauthorizedError("login required", nil))
+		return
+	}
+
+	var req request.UpdateMeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	result, err := h.uc.Execute(ctx.Request.Context(), usecase.UpdateMeParams{
+		UserID:      userID,
+		DisplayName: req.DisplayName,
+	})
+	if err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.NewUpdateMeResponse(result))
+}
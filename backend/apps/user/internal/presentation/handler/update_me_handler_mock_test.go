@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/update_me_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/update_me_handler.go -destination=./apps/user/internal/presentation/handler/update_me_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUpdateMeUseCase is a mock of UpdateMeUseCase interface.
+type MockUpdateMeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateMeUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateMeUseCaseMockRecorder is the mock recorder for MockUpdateMeUseCase.
+type MockUpdateMeUseCaseMockRecorder struct {
+	mock *MockUpdateMeUseCase
+}
+
+// NewMockUpdateMeUseCase creates a new mock instance.
+func NewMockUpdateMeUseCase(ctrl *gomock.Controller) *MockUpdateMeUseCase {
+	mock := &MockUpdateMeUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateMeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateMeUseCase) EXPECT() *MockUpdateMeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateMeUseCase) Execute(ctx context.Context, params usecase.UpdateMeParams) (*usecase.UpdateMeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.UpdateMeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateMeUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateMeUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,176 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/handler"
+	"poketier/apps/user/internal/presentation/response"
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateMeHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	createdAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	displayName := "解説花子"
+
+	badRequest := errs.ErrorResponse{
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "The request is invalid.",
+	}
+
+	tests := []struct {
+		caseName       string
+		loggedIn       bool
+		body           string
+		mockSetup      func(*MockUpdateMeUseCase)
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			caseName: "正常系: リクエストボディがユースケースに渡り、更新後のユーザーが返される",
+			loggedIn: true,
+			body:     `{"display_name":"解説花子"}`,
+			mockSetup: func(mockUC *MockUpdateMeUseCase) {
+				expectedParams := usecase.UpdateMeParams{UserID: userID, DisplayName: &displayName}
+				mockUC.EXPECT().Execute(gomock.Any(), expectedParams).Return(&usecase.UpdateMeResult{
+					UserID:      userID.String(),
+					DisplayName: "解説花子",
+					Role:        "user",
+					CreatedAt:   createdAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.UserResponse{
+				UserID:      userID.String(),
+				DisplayName: "解説花子",
+				Role:        "user",
+				CreatedAt:   createdAt,
+			},
+		},
+		{
+			caseName: "正常系: 項目を指定しない場合、変更なしとしてユースケースに渡る",
+			loggedIn: true,
+			body:     `{}`,
+			mockSetup: func(mockUC *MockUpdateMeUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), usecase.UpdateMeParams{UserID: userID}).Return(&usecase.UpdateMeResult{
+					UserID:      userID.String(),
+					DisplayName: "配信者A",
+					Role:        "user",
+					CreatedAt:   createdAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: response.UserResponse{
+				UserID:      userID.String(),
+				DisplayName: "配信者A",
+				Role:        "user",
+				CreatedAt:   createdAt,
+			},
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、401が返される",
+			loggedIn:       false,
+			body:           `{"display_name":"解説花子"}`,
+			mockSetup:      func(mockUC *MockUpdateMeUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Unauthorized",
+				Status: http.StatusUnauthorized,
+				Detail: "Authentication is required.",
+			},
+		},
+		{
+			caseName:       "異常系: 表示名が30文字を超える場合、400が返される",
+			loggedIn:       true,
+			body:           `{"display_name":"` + strings.Repeat("あ", 31) + `"}`,
+			mockSetup:      func(mockUC *MockUpdateMeUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName:       "異常系: 不正なJSONの場合、400が返される",
+			loggedIn:       true,
+			body:           `{"display_name":`,
+			mockSetup:      func(mockUC *MockUpdateMeUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   badRequest,
+		},
+		{
+			caseName: "異常系: UseCaseでエラーが発生した場合、500が返される",
+			loggedIn: true,
+			body:     `{"display_name":"解説花子"}`,
+			mockSetup: func(mockUC *MockUpdateMeUseCase) {
+				mockUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: errs.ErrorResponse{
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "An internal server error occurred.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUC := NewMockUpdateMeUseCase(ctrl)
+			tt.mockSetup(mockUC)
+
+			handler := handler.NewUpdateMeHandler(mockUC)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			ctx := context.Background()
+			if tt.loggedIn {
+				ctx = auth.WithUser(ctx, userID, role.User)
+			}
+			c.Request = httptest.NewRequest(http.MethodPatch, "/users/me", strings.NewReader(tt.body))
+			c.Request = c.Request.WithContext(ctx)
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			// Act
+			handler.Handle(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+
+			var actualBody interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &actualBody)
+			assert.NoError(t, err, "response body should be valid JSON")
+
+			expectedJSON, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err, "expected body should be marshallable to JSON")
+
+			var expectedBodyMap interface{}
+			err = json.Unmarshal(expectedJSON, &expectedBodyMap)
+			assert.NoError(t, err, "expected body should be valid JSON")
+
+			assert.Equal(t, expectedBodyMap, actualBody, "response body should match expected")
+		})
+	}
+}
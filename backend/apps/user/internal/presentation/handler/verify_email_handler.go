@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"poketier/apps/user/internal/application/usecase"
+	"poketier/apps/user/internal/presentation/request"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VerifyEmailHandler struct {
+	uc VerifyEmailUseCase
+}
+
+type VerifyEmailUseCase interface {
+	Execute(ctx context.Context, params usecase.VerifyEmailParams) error
+}
+
+func NewVerifyEmailHandler(uc VerifyEmailUseCase) *VerifyEmailHandler {
+	return &VerifyEmailHandler{
+		uc: uc,
+	}
+}
+
+func (h *VerifyEmailHandler) Handle(ctx *gin.Context) {
+	var req request.VerifyEmailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errs.HandleError(ctx, errs.NewValidationError("invalid request body", err))
+		return
+	}
+
+	if err := h.uc.Execute(ctx.Request.Context(), usecase.VerifyEmailParams{Token: req.Token}); err != nil {
+		errs.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
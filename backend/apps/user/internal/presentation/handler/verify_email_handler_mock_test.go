@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/user/internal/presentation/handler/verify_email_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/user/internal/presentation/handler/verify_email_handler.go -destination=./apps/user/internal/presentation/handler/verify_email_handler_mock_test.go -package=handler_test
+//
+
+// Package handler_test is a generated GoMock package.
+package handler_test
+
+import (
+	context "context"
+	usecase "poketier/apps/user/internal/application/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVerifyEmailUseCase is a mock of VerifyEmailUseCase interface.
+type MockVerifyEmailUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyEmailUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockVerifyEmailUseCaseMockRecorder is the mock recorder for MockVerifyEmailUseCase.
+type MockVerifyEmailUseCaseMockRecorder struct {
+	mock *MockVerifyEmailUseCase
+}
+
+// NewMockVerifyEmailUseCase creates a new mock instance.
+func NewMockVerifyEmailUseCase(ctrl *gomock.Controller) *MockVerifyEmailUseCase {
+	mock := &MockVerifyEmailUseCase{ctrl: ctrl}
+	mock.recorder = &MockVerifyEmailUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyEmailUseCase) EXPECT() *MockVerifyEmailUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVerifyEmailUseCase) Execute(ctx context.Context, params usecase.VerifyEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVerifyEmailUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyEmailUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,47 @@
+package request
+
+// SignUpRequest はメールアドレス・パスワードでのユーザー登録のリクエストボディ
+// メールアドレスの形式とパスワードの長さはユースケースで検証する
+type SignUpRequest struct {
+	Email       string `json:"email" binding:"required,max=254"`
+	Password    string `json:"password" binding:"required"`
+	DisplayName string `json:"display_name" binding:"required,max=30"`
+}
+
+// LogInRequest はメールアドレス・パスワードでのログインのリクエストボディ
+type LogInRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// VerifyEmailRequest はメールアドレス確認のリクエストボディ
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// EmailRequest は確認メールの再送・パスワード再設定の受付のリクエストボディ
+type EmailRequest struct {
+	Email string `json:"email" binding:"required,max=254"`
+}
+
+// ResetPasswordRequest はパスワード再設定のリクエストボディ
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// StartOIDCLogInRequest は外部IDプロバイダーでのログインの開始のリクエストボディ（省略可）
+type StartOIDCLogInRequest struct {
+	LoginHint string `json:"login_hint" binding:"max=254"`
+}
+
+// CompleteOIDCLogInRequest は外部IDプロバイダーからのコールバックで受け取った値を送るリクエストボディ
+type CompleteOIDCLogInRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+// RefreshTokenRequest はリフレッシュトークンの交換・ログアウトのリクエストボディ
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
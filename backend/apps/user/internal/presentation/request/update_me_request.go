@@ -0,0 +1,7 @@
+package request
+
+// UpdateMeRequest はログイン中のユーザーの更新のリクエストボディ
+// 指定しなかった項目は変更しない
+type UpdateMeRequest struct {
+	DisplayName *string `json:"display_name" binding:"omitempty,max=30"`
+}
@@ -0,0 +1,53 @@
+package response
+
+import (
+	"poketier/apps/user/internal/application/usecase"
+	"time"
+)
+
+// SignUpResponse は登録したユーザー
+type SignUpResponse struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// AccessTokenResponse は発行したアクセストークンとリフレッシュトークン
+type AccessTokenResponse struct {
+	AccessToken           string    `json:"access_token"`
+	TokenType             string    `json:"token_type"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+// OIDCAuthorizationResponse は外部IDプロバイダーの認可エンドポイントのURL
+type OIDCAuthorizationResponse struct {
+	AuthorizationURL string    `json:"authorization_url"`
+	State            string    `json:"state"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+func NewSignUpResponse(result *usecase.SignUpResult) SignUpResponse {
+	return SignUpResponse{
+		UserID: result.UserID,
+		Email:  result.Email,
+	}
+}
+
+func NewLogInResponse(result *usecase.LogInResult) AccessTokenResponse {
+	return AccessTokenResponse{
+		AccessToken:           result.AccessToken,
+		TokenType:             "Bearer",
+		ExpiresAt:             result.ExpiresAt,
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: result.RefreshTokenExpiresAt,
+	}
+}
+
+func NewOIDCAuthorizationResponse(result *usecase.StartOIDCLogInResult) OIDCAuthorizationResponse {
+	return OIDCAuthorizationResponse{
+		AuthorizationURL: result.AuthorizationURL,
+		State:            result.State,
+		ExpiresAt:        result.ExpiresAt,
+	}
+}
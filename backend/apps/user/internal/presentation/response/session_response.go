@@ -0,0 +1,38 @@
+package response
+
+import (
+	"poketier/apps/user/internal/application/usecase"
+	"time"
+)
+
+// SessionResponse はログイン中のユーザーの有効なセッション
+type SessionResponse struct {
+	SessionID  string    `json:"session_id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ListSessionsResponse は有効なセッションの一覧
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+func NewListSessionsResponse(result *usecase.ListSessionsResult) ListSessionsResponse {
+	sessions := make([]SessionResponse, 0, len(result.Sessions))
+	for _, session := range result.Sessions {
+		sessions = append(sessions, SessionResponse{
+			SessionID:  session.SessionID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+		})
+	}
+	return ListSessionsResponse{
+		Sessions: sessions,
+	}
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"poketier/apps/user/internal/application/usecase"
+	"time"
+)
+
+// UserResponse はログイン中のユーザーのアカウント情報
+type UserResponse struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewGetMeResponse(result *usecase.GetMeResult) UserResponse {
+	return UserResponse{
+		UserID:      result.UserID,
+		DisplayName: result.DisplayName,
+		Role:        result.Role,
+		CreatedAt:   result.CreatedAt,
+	}
+}
+
+func NewUpdateMeResponse(result *usecase.UpdateMeResult) UserResponse {
+	return UserResponse{
+		UserID:      result.UserID,
+		DisplayName: result.DisplayName,
+		Role:        result.Role,
+		CreatedAt:   result.CreatedAt,
+	}
+}
@@ -2,17 +2,40 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"poketier/apps/comment"
+	"poketier/apps/favorite"
+	"poketier/apps/follow"
+	"poketier/apps/like"
+	"poketier/apps/moderation"
 	"poketier/apps/season"
+	"poketier/apps/statistics"
+	"poketier/apps/tierlist"
+	"poketier/apps/user"
 	"poketier/env"
+	"poketier/pkg/admin"
+	"poketier/pkg/auth"
+	"poketier/pkg/blob"
 	corsConf "poketier/pkg/cors"
 	"poketier/pkg/log"
+	"poketier/pkg/mail"
+	"poketier/pkg/oidc"
+	"poketier/pkg/password"
+	"poketier/pkg/policy"
 	"poketier/sqlc"
 	"poketier/sqlc/db"
+	"strings"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
+// mockOIDCClientID は開発・テスト用のモックOIDCプロバイダーに登録するクライアントID
+const mockOIDCClientID = "poketier-local"
+
 func startServer() {
 	// 環境変数を読み込み
 	envConfig := env.NewEnv()
@@ -24,11 +47,112 @@ func startServer() {
 	}
 	defer pool.Close()
 
-	// Querierを作成
-	queries := db.New(pool)
+	// Querierを作成（TxManagerのトランザクション内ではトランザクション経由でクエリを実行する）
+	queries := db.New(sqlc.NewContextDBTX(pool))
+	txManager := sqlc.NewTxManager(pool)
+
+	// 生成した画像のキャッシュに使用するBlobストア
+	blobStore := blob.NewLocalStore(envConfig.BLOB_STORE_DIR)
+
+	// アクセストークン（JWT）の検証
+	verifier, err := auth.NewVerifier(auth.Config{
+		HS256Secret:    envConfig.JWT_HS256_SECRET,
+		RS256PublicKey: envConfig.JWT_RS256_PUBLIC_KEY,
+		JWKSFile:       envConfig.JWT_JWKS_FILE,
+		Issuer:         envConfig.JWT_ISSUER,
+		Audience:       envConfig.JWT_AUDIENCE,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// メールアドレス・パスワードでログインしたユーザーへのアクセストークンの発行
+	signer, err := auth.NewSigner(auth.SignerConfig{
+		HS256Secret: envConfig.JWT_HS256_SECRET,
+		Issuer:      envConfig.JWT_ISSUER,
+		Audience:    envConfig.JWT_AUDIENCE,
+		TTL:         envConfig.JWT_ACCESS_TOKEN_TTL,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// 外部IDプロバイダー（OIDC）でのログイン
+	oidcRegistry, err := newOIDCRegistry(envConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	startupLogger := log.NewStartupLogger(envConfig.LOG_LEVEL, envConfig.IS_SILENT_LOG)
+
+	// アカウントの確認・パスワード再設定のメール送信
+	mailer, err := newMailer(envConfig, startupLogger)
+	if err != nil {
+		panic(err)
+	}
+	accountMailer := user.NewAccountMailer(mailer, envConfig.APP_PUBLIC_URL)
+
+	// 集計ティアリストのキャッシュ（ティアリストの配置変更時に該当シーズンを無効化する）
+	consensusCache := statistics.NewConsensusCache(envConfig.CONSENSUS_CACHE_TTL, envConfig.CONSENSUS_CACHE_STALE_TTL)
+
+	r, err := newRouter(routerDeps{
+		envConfig:      envConfig,
+		queries:        queries,
+		txManager:      txManager,
+		blobStore:      blobStore,
+		verifier:       verifier,
+		signer:         signer,
+		accountMailer:  accountMailer,
+		consensusCache: consensusCache,
+		oidcRegistry:   oidcRegistry,
+		logger:         startupLogger,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// デッキの推移を日次で記録するバックグラウンドジョブを起動
+	go statistics.InitializeDeckTrendSnapshotJob(queries, startupLogger).Run(context.Background())
+
+	// ティアリストの信頼度を定期的に評価してティア統計の重みに反映するバックグラウンドジョブを起動
+	go statistics.InitializeTrustEvaluationJob(queries, txManager, consensusCache, startupLogger).Run(context.Background())
+
+	// ティアリストのトレンドスコアを直近7日間の閲覧数で定期的に再計算するバックグラウンドジョブを起動
+	go tierlist.InitializeTrendingScoreRefreshJob(queries, startupLogger).Run(context.Background())
+
+	// サーバー起動
+	startupLogger.Info("Starting server", "port", envConfig.APP_PORT)
+	if err := r.Run(":" + envConfig.APP_PORT); err != nil {
+		startupLogger.Error("Failed to start server", "error", err)
+	}
+}
+
+// routerDeps はルーティングで各ハンドラーに渡す依存
+type routerDeps struct {
+	envConfig      *env.Env
+	queries        *db.Queries
+	txManager      *sqlc.TxManager
+	blobStore      *blob.LocalStore
+	verifier       *auth.Verifier
+	signer         *auth.Signer
+	accountMailer  *user.AccountMailer
+	consensusCache *statistics.ConsensusCache
+	oidcRegistry   *oidc.Registry
+	logger         log.Logger
+}
+
+// newRouter はミドルウェアとエンドポイントを登録したルーターを作成する
+// 権限が必要なエンドポイントは auth で認証した後に policy で認可する（route_test.go で全エンドポイントの権限を検証している）
+func newRouter(deps routerDeps) (*gin.Engine, error) {
+	envConfig := deps.envConfig
 
 	r := gin.Default()
 
+	// ログイン試行の記録や重複投稿の判定に使うクライアントIPを偽装されないよう、信頼するプロキシを限定する
+	if err := r.SetTrustedProxies(envConfig.TRUSTED_PROXIES); err != nil {
+		return nil, fmt.Errorf("failed to set trusted proxies: %w", err)
+	}
+
 	// CORSミドルウェアを設定
 	corsConfig := corsConf.GetCORSConfig(envConfig.ALLOW_ORIGINS, envConfig.APP_ENV)
 	r.Use(cors.New(corsConfig))
@@ -41,17 +165,56 @@ func startServer() {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
 
+	// 開発・テスト用のモックOIDCプロバイダー
+	if envConfig.OIDC_MOCK_ENABLED {
+		if err := mountMockOIDCProvider(r, envConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	v1 := r.Group("/v1")
 
+	// アクセストークンがあればログイン中のユーザーとして扱い、なければゲストとして匿名での閲覧を許可する
+	// 管理者向けエンドポイントは管理用トークンも Bearer で受け取るため、このグループには含めない
+	api := v1.Group("", auth.NewOptionalMiddleware(deps.verifier))
+
 	// WireでDIされたハンドラーを使用
-	newSeasonHandler(v1, queries)
+	newSeasonHandler(api, deps.queries)
+	newTierListHandler(api, deps.queries, deps.txManager, deps.blobStore, deps.consensusCache, deps.logger)
+	newStatisticsHandler(api, deps.queries, deps.consensusCache)
+	newCommentHandler(api, deps.queries)
 
-	// サーバー起動
-	startupLogger := log.NewStartupLogger(envConfig.LOG_LEVEL, envConfig.IS_SILENT_LOG)
-	startupLogger.Info("Starting server", "port", envConfig.APP_PORT)
-	if err := r.Run(":" + envConfig.APP_PORT); err != nil {
-		startupLogger.Error("Failed to start server", "error", err)
-	}
+	// メールアドレス・パスワード、外部IDプロバイダーでのユーザー登録・ログイン
+	newAuthHandler(api.Group("/auth"), deps.queries, deps.txManager, password.NewHasher(password.DefaultParams), deps.signer, deps.accountMailer, deps.oidcRegistry)
+
+	// ログインが必要なエンドポイント
+	member := api.Group("", auth.NewRequiredMiddleware(deps.verifier), policy.NewMiddleware(policy.ManageOwnAccount))
+	newUserHandler(member, deps.queries)
+	newFavoriteHandler(member, deps.queries, deps.txManager)
+	newFollowHandler(member, deps.queries)
+
+	// ティアリストの配置の保存・リビジョンの復元はログインが必要（作成者かどうかはユースケースで確認する）
+	editor := api.Group("", auth.NewRequiredMiddleware(deps.verifier), policy.NewMiddleware(policy.EditOwnTierLists))
+	newTierListEditHandler(editor, deps.queries, deps.txManager, deps.consensusCache)
+
+	// コメントの投稿・編集・削除はログインが必要（閲覧はゲストにも公開する）
+	commenter := api.Group("", auth.NewRequiredMiddleware(deps.verifier), policy.NewMiddleware(policy.PostComments))
+	newCommentWriteHandler(commenter, deps.queries)
+
+	// ティアリスト・コメントへのいいねはログインが必要（いいね数は一覧でゲストにも公開する）
+	reactor := api.Group("", auth.NewRequiredMiddleware(deps.verifier), policy.NewMiddleware(policy.React))
+	newLikeHandler(reactor, deps.queries, deps.txManager)
+
+	// ティアリスト・コメント・デッキの通報はログインが必要
+	reporter := api.Group("", auth.NewRequiredMiddleware(deps.verifier), policy.NewMiddleware(policy.ReportContent))
+	newReportHandler(reporter, deps.queries)
+
+	// 管理者・モデレーター向けエンドポイントは管理用トークン（管理者として扱う）またはアクセストークンで認証し、
+	// エンドポイントごとに必要な権限を policy で確認する
+	adminGroup := v1.Group("/admin", admin.NewMiddleware(envConfig.ADMIN_API_TOKEN), auth.NewRequiredMiddleware(deps.verifier))
+	newAdminHandler(adminGroup, deps.queries, deps.txManager, deps.consensusCache)
+
+	return r, nil
 }
 
 func newSeasonHandler(engine *gin.RouterGroup, queries *db.Queries) {
@@ -61,3 +224,259 @@ func newSeasonHandler(engine *gin.RouterGroup, queries *db.Queries) {
 	// シーズン関連のエンドポイントを登録
 	engine.GET("/seasons", seasonHandler.Handle)
 }
+
+func newTierListHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager, blobStore *blob.LocalStore, consensusCache *statistics.ConsensusCache, logger log.Logger) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	listTierListsHandler := tierlist.InitializeListTierListsHandler(queries)
+	forkTierListHandler := tierlist.InitializeForkTierListHandler(queries, txManager, consensusCache)
+	listTierListForksHandler := tierlist.InitializeListTierListForksHandler(queries)
+	listTierListRevisionsHandler := tierlist.InitializeListTierListRevisionsHandler(queries)
+	diffTierListRevisionsHandler := tierlist.InitializeDiffTierListRevisionsHandler(queries)
+	getTierListImageHandler := tierlist.InitializeGetTierListImageHandler(queries, txManager, blobStore, logger)
+	recordTierListViewHandler := tierlist.InitializeRecordTierListViewHandler(queries, txManager)
+
+	// ティアリスト関連のエンドポイントを登録
+	engine.GET("/tier-lists", listTierListsHandler.Handle)
+	engine.POST("/tier-lists/:tier_list_id/fork", forkTierListHandler.Handle)
+	engine.GET("/tier-lists/:tier_list_id/forks", listTierListForksHandler.Handle)
+	engine.GET("/tier-lists/:tier_list_id/revisions", listTierListRevisionsHandler.Handle)
+	engine.GET("/tier-lists/:tier_list_id/revisions/:revision_number/diff/:to_revision_number", diffTierListRevisionsHandler.Handle)
+	engine.GET("/tier-lists/:tier_list_id/image", getTierListImageHandler.Handle)
+	engine.POST("/tier-lists/:tier_list_id/views", recordTierListViewHandler.Handle)
+}
+
+func newTierListEditHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager, consensusCache *statistics.ConsensusCache) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	saveTierListPlacementsHandler := tierlist.InitializeSaveTierListPlacementsHandler(queries, txManager, consensusCache)
+	restoreTierListRevisionHandler := tierlist.InitializeRestoreTierListRevisionHandler(queries, txManager, consensusCache)
+
+	// ティアリスト編集のエンドポイントを登録
+	engine.PUT("/tier-lists/:tier_list_id/placements", saveTierListPlacementsHandler.Handle)
+	engine.POST("/tier-lists/:tier_list_id/revisions/:revision_number/restore", restoreTierListRevisionHandler.Handle)
+}
+
+func newStatisticsHandler(engine *gin.RouterGroup, queries *db.Queries, consensusCache *statistics.ConsensusCache) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	getConsensusTierListHandler := statistics.InitializeGetConsensusTierListHandler(queries, consensusCache)
+	getDeckTrendHandler := statistics.InitializeGetDeckTrendHandler(queries)
+	listDeckMoversHandler := statistics.InitializeListDeckMoversHandler(queries)
+	compareSeasonConsensusHandler := statistics.InitializeCompareSeasonConsensusHandler(queries, consensusCache)
+	getDeckTierStatisticsHandler := statistics.InitializeGetDeckTierStatisticsHandler(queries)
+	getTierListAgreementHandler := statistics.InitializeGetTierListAgreementHandler(queries, consensusCache)
+
+	// 統計・集計関連のエンドポイントを登録
+	engine.GET("/consensus/:season_id", getConsensusTierListHandler.Handle)
+	engine.GET("/statistics/trends", getDeckTrendHandler.Handle)
+	engine.GET("/statistics/movers", listDeckMoversHandler.Handle)
+	engine.GET("/statistics/season-comparison", compareSeasonConsensusHandler.Handle)
+	engine.GET("/statistics/tier/:deck_id", getDeckTierStatisticsHandler.Handle)
+	engine.GET("/tier-lists/:tier_list_id/agreement", getTierListAgreementHandler.Handle)
+}
+
+func newUserHandler(engine *gin.RouterGroup, queries *db.Queries) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	getMeHandler := user.InitializeGetMeHandler(queries)
+	updateMeHandler := user.InitializeUpdateMeHandler(queries)
+	listSessionsHandler := user.InitializeListSessionsHandler(queries)
+	logOutEverywhereHandler := user.InitializeLogOutEverywhereHandler(queries)
+
+	// ログイン中のユーザー関連のエンドポイントを登録
+	engine.GET("/users/me", getMeHandler.Handle)
+	engine.PATCH("/users/me", updateMeHandler.Handle)
+	engine.GET("/users/me/sessions", listSessionsHandler.Handle)
+	engine.DELETE("/users/me/sessions", logOutEverywhereHandler.Handle)
+}
+
+func newFavoriteHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	addTierListFavoriteHandler := favorite.InitializeAddTierListFavoriteHandler(queries, txManager)
+	removeTierListFavoriteHandler := favorite.InitializeRemoveTierListFavoriteHandler(queries, txManager)
+	listFavoriteTierListsHandler := favorite.InitializeListFavoriteTierListsHandler(queries)
+	addDeckFavoriteHandler := favorite.InitializeAddDeckFavoriteHandler(queries, txManager)
+	removeDeckFavoriteHandler := favorite.InitializeRemoveDeckFavoriteHandler(queries, txManager)
+	listFavoriteDecksHandler := favorite.InitializeListFavoriteDecksHandler(queries)
+
+	// お気に入り関連のエンドポイントを登録
+	engine.PUT("/tier-lists/:tier_list_id/favorite", addTierListFavoriteHandler.Handle)
+	engine.DELETE("/tier-lists/:tier_list_id/favorite", removeTierListFavoriteHandler.Handle)
+	engine.GET("/users/me/favorites/tier-lists", listFavoriteTierListsHandler.Handle)
+	engine.PUT("/decks/:deck_id/favorite", addDeckFavoriteHandler.Handle)
+	engine.DELETE("/decks/:deck_id/favorite", removeDeckFavoriteHandler.Handle)
+	engine.GET("/users/me/favorites/decks", listFavoriteDecksHandler.Handle)
+}
+
+func newFollowHandler(engine *gin.RouterGroup, queries *db.Queries) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	followUserHandler := follow.InitializeFollowUserHandler(queries)
+	unfollowUserHandler := follow.InitializeUnfollowUserHandler(queries)
+	listFeedHandler := follow.InitializeListFeedHandler(queries)
+
+	// フォロー・フィード関連のエンドポイントを登録
+	engine.PUT("/users/:user_id/follow", followUserHandler.Handle)
+	engine.DELETE("/users/:user_id/follow", unfollowUserHandler.Handle)
+	engine.GET("/feed", listFeedHandler.Handle)
+}
+
+func newCommentHandler(engine *gin.RouterGroup, queries *db.Queries) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	listCommentsHandler := comment.InitializeListCommentsHandler(queries)
+	listCommentRepliesHandler := comment.InitializeListCommentRepliesHandler(queries)
+
+	// コメント閲覧のエンドポイントを登録
+	engine.GET("/tier-lists/:tier_list_id/comments", listCommentsHandler.Handle)
+	engine.GET("/comments/:comment_id/replies", listCommentRepliesHandler.Handle)
+}
+
+func newCommentWriteHandler(engine *gin.RouterGroup, queries *db.Queries) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	postCommentHandler := comment.InitializePostCommentHandler(queries)
+	editCommentHandler := comment.InitializeEditCommentHandler(queries)
+	deleteCommentHandler := comment.InitializeDeleteCommentHandler(queries)
+
+	// コメント投稿・編集・削除のエンドポイントを登録
+	engine.POST("/tier-lists/:tier_list_id/comments", postCommentHandler.Handle)
+	engine.PATCH("/comments/:comment_id", editCommentHandler.Handle)
+	engine.DELETE("/comments/:comment_id", deleteCommentHandler.Handle)
+}
+
+func newLikeHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	likeTierListHandler := like.InitializeLikeTierListHandler(queries, txManager)
+	unlikeTierListHandler := like.InitializeUnlikeTierListHandler(queries, txManager)
+	likeCommentHandler := like.InitializeLikeCommentHandler(queries)
+	unlikeCommentHandler := like.InitializeUnlikeCommentHandler(queries)
+
+	// いいね関連のエンドポイントを登録
+	engine.PUT("/tier-lists/:tier_list_id/like", likeTierListHandler.Handle)
+	engine.DELETE("/tier-lists/:tier_list_id/like", unlikeTierListHandler.Handle)
+	engine.PUT("/comments/:comment_id/like", likeCommentHandler.Handle)
+	engine.DELETE("/comments/:comment_id/like", unlikeCommentHandler.Handle)
+}
+
+func newReportHandler(engine *gin.RouterGroup, queries *db.Queries) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	reportContentHandler := moderation.InitializeReportContentHandler(queries)
+
+	// 通報のエンドポイントを登録
+	engine.POST("/reports", reportContentHandler.Handle)
+}
+
+func newAuthHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager, hasher *password.Hasher, signer *auth.Signer, accountMailer *user.AccountMailer, oidcRegistry *oidc.Registry) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	signUpHandler := user.InitializeSignUpHandler(queries, txManager, hasher, accountMailer)
+	verifyEmailHandler := user.InitializeVerifyEmailHandler(queries, txManager)
+	resendVerificationEmailHandler := user.InitializeResendVerificationEmailHandler(queries, accountMailer)
+	logInHandler := user.InitializeLogInHandler(queries, txManager, hasher, signer)
+	requestPasswordResetHandler := user.InitializeRequestPasswordResetHandler(queries, accountMailer)
+	resetPasswordHandler := user.InitializeResetPasswordHandler(queries, txManager, hasher)
+	startOIDCLogInHandler := user.InitializeStartOIDCLogInHandler(queries, oidcRegistry)
+	completeOIDCLogInHandler := user.InitializeCompleteOIDCLogInHandler(queries, txManager, oidcRegistry, signer)
+	refreshSessionHandler := user.InitializeRefreshSessionHandler(queries, txManager, signer)
+	logOutHandler := user.InitializeLogOutHandler(queries)
+
+	// ユーザー登録・ログイン関連のエンドポイントを登録
+	engine.POST("/signup", signUpHandler.Handle)
+	engine.POST("/verify-email", verifyEmailHandler.Handle)
+	engine.POST("/verify-email/resend", resendVerificationEmailHandler.Handle)
+	engine.POST("/login", logInHandler.Handle)
+	engine.POST("/password-reset", requestPasswordResetHandler.Handle)
+	engine.POST("/password-reset/confirm", resetPasswordHandler.Handle)
+	engine.POST("/oidc/:provider/authorize", startOIDCLogInHandler.Handle)
+	engine.POST("/oidc/:provider/callback", completeOIDCLogInHandler.Handle)
+	engine.POST("/refresh", refreshSessionHandler.Handle)
+	engine.POST("/logout", logOutHandler.Handle)
+}
+
+func newAdminHandler(engine *gin.RouterGroup, queries *db.Queries, txManager *sqlc.TxManager, consensusCache *statistics.ConsensusCache) {
+	// Wireで生成されたDIコードを使用してハンドラーを初期化
+	listFlaggedTierListsHandler := statistics.InitializeListFlaggedTierListsHandler(queries)
+	listReportQueueHandler := moderation.InitializeListReportQueueHandler(queries)
+	takeModerationActionHandler := moderation.InitializeTakeModerationActionHandler(queries, txManager, consensusCache)
+	listModerationActionsHandler := moderation.InitializeListModerationActionsHandler(queries)
+	forceLogOutHandler := user.InitializeForceLogOutHandler(queries)
+
+	// モデレーション関連のエンドポイントを登録
+	engine.GET("/flagged-tier-lists", policy.NewMiddleware(policy.ModerateTierLists), listFlaggedTierListsHandler.Handle)
+	engine.GET("/reports", policy.NewMiddleware(policy.ModerateContent), listReportQueueHandler.Handle)
+	engine.POST("/moderation-actions", policy.NewMiddleware(policy.ModerateContent), takeModerationActionHandler.Handle)
+	engine.GET("/moderation-actions", policy.NewMiddleware(policy.ModerateContent), listModerationActionsHandler.Handle)
+
+	// ユーザー管理関連のエンドポイントを登録
+	engine.DELETE("/users/:user_id/sessions", policy.NewMiddleware(policy.ManageUsers), forceLogOutHandler.Handle)
+}
+
+// newMailer は環境変数で指定された送信方法のメール送信の実装を作成する
+func newMailer(envConfig *env.Env, logger log.Logger) (mail.Mailer, error) {
+	switch envConfig.MAILER {
+	case "log":
+		return mail.NewLogMailer(envConfig.MAIL_FROM, logger), nil
+	case "file":
+		return mail.NewFileMailer(envConfig.MAIL_FROM, envConfig.MAIL_FILE_DIR), nil
+	default:
+		return nil, fmt.Errorf("unsupported mailer: %q", envConfig.MAILER)
+	}
+}
+
+// newOIDCRegistry は環境変数でクライアントIDが設定された外部IDプロバイダーを登録する
+// コールバックのリダイレクト先はフロントエンドの /auth/callback/<プロバイダー名>
+func newOIDCRegistry(envConfig *env.Env) (*oidc.Registry, error) {
+	registry := oidc.NewRegistry()
+	redirectURL := func(provider string) string {
+		return strings.TrimSuffix(envConfig.APP_PUBLIC_URL, "/") + "/auth/callback/" + provider
+	}
+
+	if envConfig.OIDC_GOOGLE_CLIENT_ID != "" {
+		client, err := oidc.NewClient(oidc.Config{
+			Issuer:       "https://accounts.google.com",
+			ClientID:     envConfig.OIDC_GOOGLE_CLIENT_ID,
+			ClientSecret: envConfig.OIDC_GOOGLE_CLIENT_SECRET,
+			RedirectURL:  redirectURL("google"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create google oidc client: %w", err)
+		}
+		registry.Register("google", client)
+	}
+
+	if envConfig.OIDC_MOCK_ENABLED {
+		client, err := oidc.NewClient(oidc.Config{
+			Issuer:      envConfig.OIDC_MOCK_ISSUER,
+			ClientID:    mockOIDCClientID,
+			RedirectURL: redirectURL("mock"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mock oidc client: %w", err)
+		}
+		registry.Register("mock", client)
+	}
+
+	return registry, nil
+}
+
+// mountMockOIDCProvider は開発・テスト用のモックOIDCプロバイダーを OIDC_MOCK_ISSUER のパスで公開する
+// 誰でも任意のアカウントでログインできるため、本番環境ではエラーにする
+func mountMockOIDCProvider(r *gin.Engine, envConfig *env.Env) error {
+	if envConfig.APP_ENV == "production" {
+		return errors.New("mock oidc provider cannot be enabled in production")
+	}
+
+	issuerURL, err := url.Parse(envConfig.OIDC_MOCK_ISSUER)
+	if err != nil {
+		return fmt.Errorf("invalid mock oidc issuer: %w", err)
+	}
+	basePath := strings.TrimSuffix(issuerURL.Path, "/")
+	if basePath == "" {
+		return errors.New("mock oidc issuer must have a path (e.g. /mock-oidc)")
+	}
+
+	provider, err := oidc.NewMockProvider(oidc.MockProviderConfig{
+		Issuer:   envConfig.OIDC_MOCK_ISSUER,
+		ClientID: mockOIDCClientID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create mock oidc provider: %w", err)
+	}
+
+	r.Any(basePath+"/*path", gin.WrapH(http.StripPrefix(basePath, provider)))
+	return nil
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"poketier/apps/statistics"
+	"poketier/apps/user"
+	"poketier/env"
+	"poketier/pkg/auth"
+	"poketier/pkg/blob"
+	"poketier/pkg/log"
+	"poketier/pkg/mail"
+	"poketier/pkg/oidc"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJWTSecret     = "route-test-secret-route-test-secret"
+	testAdminAPIToken = "route-test-admin-token"
+)
+
+// errDatabaseUnavailable はテスト用の接続プールが返すエラー
+var errDatabaseUnavailable = errors.New("database is not available in route tests")
+
+// unavailablePool はすべてのクエリ・トランザクションをエラーにする接続プール
+// 認可を通過したリクエストはハンドラーまで到達し、データベースに触れた時点でエラーになる
+type unavailablePool struct{}
+
+func (unavailablePool) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, errDatabaseUnavailable
+}
+
+func (unavailablePool) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, errDatabaseUnavailable
+}
+
+func (unavailablePool) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return unavailableRow{}
+}
+
+func (unavailablePool) CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error) {
+	return 0, errDatabaseUnavailable
+}
+
+func (unavailablePool) BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error) {
+	return nil, errDatabaseUnavailable
+}
+
+type unavailableRow struct{}
+
+func (unavailableRow) Scan(...any) error {
+	return errDatabaseUnavailable
+}
+
+// newTestRouter はデータベースに接続せずに本番と同じルーティングのルーターを作成する
+func newTestRouter(t *testing.T) (*gin.Engine, *auth.Signer) {
+	t.Helper()
+
+	envConfig := &env.Env{
+		APP_ENV:              "test",
+		ALLOW_ORIGINS:        "*",
+		ADMIN_API_TOKEN:      testAdminAPIToken,
+		JWT_HS256_SECRET:     testJWTSecret,
+		JWT_ACCESS_TOKEN_TTL: 15 * time.Minute,
+		APP_PUBLIC_URL:       "http://localhost:3000",
+		LOG_LEVEL:            "error",
+		IS_SILENT_LOG:        true,
+	}
+
+	verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testJWTSecret})
+	require.NoError(t, err, "failed to create verifier")
+	signer, err := auth.NewSigner(auth.SignerConfig{HS256Secret: testJWTSecret, TTL: envConfig.JWT_ACCESS_TOKEN_TTL})
+	require.NoError(t, err, "failed to create signer")
+
+	pool := unavailablePool{}
+	r, err := newRouter(routerDeps{
+		envConfig:      envConfig,
+		queries:        db.New(sqlc.NewContextDBTX(pool)),
+		txManager:      sqlc.NewTxManager(pool),
+		blobStore:      blob.NewLocalStore(t.TempDir()),
+		verifier:       verifier,
+		signer:         signer,
+		accountMailer:  user.NewAccountMailer(mail.NewLogMailer("noreply@poketier.local", log.NewStartupLogger("error", true)), envConfig.APP_PUBLIC_URL),
+		consensusCache: statistics.NewConsensusCache(time.Minute, time.Minute),
+		oidcRegistry:   oidc.NewRegistry(),
+		logger:         log.NewStartupLogger("error", true),
+	})
+	require.NoError(t, err, "failed to create router")
+	return r, signer
+}
+
+// routeRule はエンドポイントに必要な権限。permission が空の場合はゲストにも公開する
+type routeRule struct {
+	method     string
+	path       string
+	permission policy.Permission
+}
+
+// routeRules は route.go で登録する全エンドポイントの権限
+// エンドポイントを追加した場合はここにも追加する（TestRouter_Authorization で過不足を検出する）
+var routeRules = []routeRule{
+	{method: http.MethodGet, path: "/health"},
+
+	{method: http.MethodGet, path: "/v1/seasons"},
+
+	{method: http.MethodGet, path: "/v1/tier-lists"},
+	{method: http.MethodPost, path: "/v1/tier-lists/:tier_list_id/fork"},
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/forks"},
+	{method: http.MethodPut, path: "/v1/tier-lists/:tier_list_id/placements", permission: policy.EditOwnTierLists},
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/revisions"},
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/revisions/:revision_number/diff/:to_revision_number"},
+	{method: http.MethodPost, path: "/v1/tier-lists/:tier_list_id/revisions/:revision_number/restore", permission: policy.EditOwnTierLists},
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/image"},
+	{method: http.MethodPost, path: "/v1/tier-lists/:tier_list_id/views"},
+
+	{method: http.MethodGet, path: "/v1/consensus/:season_id"},
+	{method: http.MethodGet, path: "/v1/statistics/trends"},
+	{method: http.MethodGet, path: "/v1/statistics/movers"},
+	{method: http.MethodGet, path: "/v1/statistics/season-comparison"},
+	{method: http.MethodGet, path: "/v1/statistics/tier/:deck_id"},
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/agreement"},
+
+	{method: http.MethodGet, path: "/v1/tier-lists/:tier_list_id/comments"},
+	{method: http.MethodGet, path: "/v1/comments/:comment_id/replies"},
+	{method: http.MethodPost, path: "/v1/tier-lists/:tier_list_id/comments", permission: policy.PostComments},
+	{method: http.MethodPatch, path: "/v1/comments/:comment_id", permission: policy.PostComments},
+	{method: http.MethodDelete, path: "/v1/comments/:comment_id", permission: policy.PostComments},
+	{method: http.MethodPut, path: "/v1/tier-lists/:tier_list_id/like", permission: policy.React},
+	{method: http.MethodDelete, path: "/v1/tier-lists/:tier_list_id/like", permission: policy.React},
+	{method: http.MethodPut, path: "/v1/comments/:comment_id/like", permission: policy.React},
+	{method: http.MethodDelete, path: "/v1/comments/:comment_id/like", permission: policy.React},
+	{method: http.MethodPost, path: "/v1/reports", permission: policy.ReportContent},
+
+	{method: http.MethodPost, path: "/v1/auth/signup"},
+	{method: http.MethodPost, path: "/v1/auth/verify-email"},
+	{method: http.MethodPost, path: "/v1/auth/verify-email/resend"},
+	{method: http.MethodPost, path: "/v1/auth/login"},
+	{method: http.MethodPost, path: "/v1/auth/password-reset"},
+	{method: http.MethodPost, path: "/v1/auth/password-reset/confirm"},
+	{method: http.MethodPost, path: "/v1/auth/oidc/:provider/authorize"},
+	{method: http.MethodPost, path: "/v1/auth/oidc/:provider/callback"},
+	{method: http.MethodPost, path: "/v1/auth/refresh"},
+	{method: http.MethodPost, path: "/v1/auth/logout"},
+
+	{method: http.MethodGet, path: "/v1/users/me", permission: policy.ManageOwnAccount},
+	{method: http.MethodPatch, path: "/v1/users/me", permission: policy.ManageOwnAccount},
+	{method: http.MethodGet, path: "/v1/users/me/sessions", permission: policy.ManageOwnAccount},
+	{method: http.MethodDelete, path: "/v1/users/me/sessions", permission: policy.ManageOwnAccount},
+
+	{method: http.MethodPut, path: "/v1/tier-lists/:tier_list_id/favorite", permission: policy.ManageOwnAccount},
+	{method: http.MethodDelete, path: "/v1/tier-lists/:tier_list_id/favorite", permission: policy.ManageOwnAccount},
+	{method: http.MethodGet, path: "/v1/users/me/favorites/tier-lists", permission: policy.ManageOwnAccount},
+	{method: http.MethodPut, path: "/v1/decks/:deck_id/favorite", permission: policy.ManageOwnAccount},
+	{method: http.MethodDelete, path: "/v1/decks/:deck_id/favorite", permission: policy.ManageOwnAccount},
+	{method: http.MethodGet, path: "/v1/users/me/favorites/decks", permission: policy.ManageOwnAccount},
+	{method: http.MethodPut, path: "/v1/users/:user_id/follow", permission: policy.ManageOwnAccount},
+	{method: http.MethodDelete, path: "/v1/users/:user_id/follow", permission: policy.ManageOwnAccount},
+	{method: http.MethodGet, path: "/v1/feed", permission: policy.ManageOwnAccount},
+
+	{method: http.MethodGet, path: "/v1/admin/flagged-tier-lists", permission: policy.ModerateTierLists},
+	{method: http.MethodGet, path: "/v1/admin/reports", permission: policy.ModerateContent},
+	{method: http.MethodPost, path: "/v1/admin/moderation-actions", permission: policy.ModerateContent},
+	{method: http.MethodGet, path: "/v1/admin/moderation-actions", permission: policy.ModerateContent},
+	{method: http.MethodDelete, path: "/v1/admin/users/:user_id/sessions", permission: policy.ManageUsers},
+}
+
+// pathParam はルートのパスパラメータ（:name）
+var pathParam = regexp.MustCompile(`:[a-z_]+`)
+
+func TestRouter_Authorization(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	gin.DefaultWriter = io.Discard
+	gin.DefaultErrorWriter = io.Discard
+
+	r, signer := newTestRouter(t)
+
+	t.Run("正常系: route.go で登録した全エンドポイントの権限が定義されている事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		defined := make(map[string]bool, len(routeRules))
+		for _, rule := range routeRules {
+			defined[rule.method+" "+rule.path] = true
+		}
+
+		// Act
+		registered := make(map[string]bool)
+		for _, route := range r.Routes() {
+			registered[route.Method+" "+route.Path] = true
+		}
+
+		// Assert
+		for route := range registered {
+			assert.True(t, defined[route], "permission of %s should be defined in routeRules", route)
+		}
+		for route := range defined {
+			assert.True(t, registered[route], "%s in routeRules should be registered", route)
+		}
+	})
+
+	// principals は各権限の利用者のAuthorizationヘッダー（ゲストはヘッダーなし）
+	principals := []struct {
+		role          role.Role
+		authorization string
+	}{
+		{role: role.Guest},
+		{role: role.User, authorization: "Bearer " + signTestToken(t, signer, role.User)},
+		{role: role.Moderator, authorization: "Bearer " + signTestToken(t, signer, role.Moderator)},
+		{role: role.Admin, authorization: "Bearer " + signTestToken(t, signer, role.Admin)},
+	}
+
+	for _, rule := range routeRules {
+		for _, principal := range principals {
+			t.Run(rule.method+" "+rule.path+"/"+principal.role.String(), func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest(rule.method, pathParam.ReplaceAllString(rule.path, "1"), nil)
+				if principal.authorization != "" {
+					req.Header.Set("Authorization", principal.authorization)
+				}
+
+				// Act
+				r.ServeHTTP(w, req)
+
+				// Assert
+				switch {
+				case rule.permission == "" || policy.Allows(principal.role, rule.permission):
+					assert.NotContains(t, []int{http.StatusUnauthorized, http.StatusForbidden}, w.Code, "request should be authorized")
+				case principal.role == role.Guest:
+					assert.Equal(t, http.StatusUnauthorized, w.Code, "guest should be asked to log in")
+				default:
+					assert.Equal(t, http.StatusForbidden, w.Code, "request should be forbidden")
+				}
+			})
+		}
+	}
+
+	t.Run("正常系: 管理用トークンの場合、管理者として管理者向けエンドポイントにアクセスできる事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/flagged-tier-lists", nil)
+		req.Header.Set("Authorization", "Bearer "+testAdminAPIToken)
+
+		// Act
+		r.ServeHTTP(w, req)
+
+		// Assert
+		assert.NotContains(t, []int{http.StatusUnauthorized, http.StatusForbidden}, w.Code, "request should be authorized")
+	})
+
+	t.Run("異常系: 不正なトークンの場合、管理者向けエンドポイントは401が返される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/flagged-tier-lists", nil)
+		req.Header.Set("Authorization", "Bearer wrong-token")
+
+		// Act
+		r.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "request should be unauthorized")
+	})
+}
+
+// signTestToken は指定した権限のユーザーのアクセストークンを発行する
+func signTestToken(t *testing.T, signer *auth.Signer, userRole role.Role) string {
+	t.Helper()
+
+	token, _, err := signer.Sign(id.NewUserID(), userRole)
+	require.NoError(t, err, "failed to sign access token")
+	return token
+}
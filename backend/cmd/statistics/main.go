@@ -0,0 +1,52 @@
+// Package main はティア統計の保守コマンドのエントリーポイントです
+//
+// 使い方:
+//
+//	go run ./cmd/statistics rebuild [-season <season_id>]  ティア統計を配置から作り直す
+//	go run ./cmd/statistics check [-season <season_id>]    差分更新されたティア統計を再計算結果と比較する
+//	go run ./cmd/statistics evaluate-trust [-season <season_id>]  ティアリストの信頼度を評価する（サーバーでも1時間ごとに実行される）
+//
+// evaluate-trust はサーバーのジョブとシーズンごとのアドバイザリロックを共有するため、同じシーズンを同時に評価しない
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"poketier/apps/statistics"
+	"poketier/env"
+	"poketier/sqlc"
+	"poketier/sqlc/db"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	// 環境変数を読み込み
+	envConfig := env.NewEnv()
+
+	ctx := context.Background()
+
+	// データベース接続プールを初期化
+	pool, err := sqlc.NewPgxPool(ctx, envConfig)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	queries := db.New(sqlc.NewContextDBTX(pool))
+	txManager := sqlc.NewTxManager(pool)
+
+	// コマンドはサーバーとは別プロセスのため、サーバーの集計ティアリストのキャッシュは無効化できない
+	// 信頼度の評価による重みの変更は、サーバーのキャッシュの有効期限切れ後に反映される
+	consensusCache := statistics.NewConsensusCache(envConfig.CONSENSUS_CACHE_TTL, envConfig.CONSENSUS_CACHE_STALE_TTL)
+
+	// Wireで生成されたDIコードを使用してコマンドを初期化
+	command := statistics.InitializeTierStatisticsCommand(queries, txManager, consensusCache)
+	return command.Run(ctx, os.Args[1:], os.Stdout)
+}
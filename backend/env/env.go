@@ -2,6 +2,7 @@ package env
 
 import (
 	"fmt"
+	"time"
 
 	envpkg "github.com/caarlos0/env/v11"
 )
@@ -10,6 +11,9 @@ type Env struct {
 	APP_PORT      string `env:"APP_PORT" envDefault:"8080"`
 	APP_ENV       string `env:"APP_ENV" envDefault:"local"`
 	ALLOW_ORIGINS string `env:"ALLOW_ORIGINS" envDefault:"*"`
+	// クライアントIPの判定で X-Forwarded-For を信頼するリバースプロキシのIPアドレスまたはCIDR（カンマ区切り）
+	// 未設定の場合はどのプロキシも信頼せず、接続元のIPアドレスをクライアントIPとする
+	TRUSTED_PROXIES []string `env:"TRUSTED_PROXIES" envSeparator:","`
 
 	POSTGRES_HOST     string `env:"POSTGRES_HOST" envDefault:"postgres"`
 	POSTGRES_DBNAME   string `env:"POSTGRES_DBNAME" envDefault:"poketierlocal"`
@@ -18,6 +22,41 @@ type Env struct {
 	POSTGRES_PORT     string `env:"POSTGRES_PORT" envDefault:"5432"`
 	POSTGRES_SSLMODE  string `env:"POSTGRES_SSLMODE" envDefault:"disable"`
 
+	BLOB_STORE_DIR string `env:"BLOB_STORE_DIR" envDefault:"/tmp/poketier/blob"`
+
+	// 集計ティアリストのキャッシュの有効期限と、期限切れ後に古い集計結果を返しつつ再計算する期間
+	CONSENSUS_CACHE_TTL       time.Duration `env:"CONSENSUS_CACHE_TTL" envDefault:"5m"`
+	CONSENSUS_CACHE_STALE_TTL time.Duration `env:"CONSENSUS_CACHE_STALE_TTL" envDefault:"1h"`
+
+	ADMIN_API_TOKEN string `env:"ADMIN_API_TOKEN" envDefault:""`
+
+	// アクセストークン（JWT）の検証鍵。HS256の共通鍵、RS256の公開鍵（PEM）、JWKSファイルのパスのいずれか（併用可）
+	// いずれも未設定の場合、アクセストークンは全て拒否される
+	JWT_HS256_SECRET     string `env:"JWT_HS256_SECRET" envDefault:""`
+	JWT_RS256_PUBLIC_KEY string `env:"JWT_RS256_PUBLIC_KEY" envDefault:""`
+	JWT_JWKS_FILE        string `env:"JWT_JWKS_FILE" envDefault:""`
+	// アクセストークンの発行者（iss）と対象者（aud）。空の場合は検証しない
+	JWT_ISSUER   string `env:"JWT_ISSUER" envDefault:""`
+	JWT_AUDIENCE string `env:"JWT_AUDIENCE" envDefault:""`
+	// メールアドレス・パスワードでログインしたユーザーに発行するアクセストークンの有効期間（JWT_HS256_SECRET で署名する）
+	JWT_ACCESS_TOKEN_TTL time.Duration `env:"JWT_ACCESS_TOKEN_TTL" envDefault:"15m"`
+
+	// メールの送信方法。log はログに出力し、file は MAIL_FILE_DIR に .eml ファイルとして書き出す
+	MAILER        string `env:"MAILER" envDefault:"log"`
+	MAIL_FROM     string `env:"MAIL_FROM" envDefault:"noreply@poketier.local"`
+	MAIL_FILE_DIR string `env:"MAIL_FILE_DIR" envDefault:"/tmp/poketier/mail"`
+	// メール内のリンク（メールアドレス確認・パスワード再設定）に使用するフロントエンドの公開URL
+	APP_PUBLIC_URL string `env:"APP_PUBLIC_URL" envDefault:"http://localhost:3000"`
+
+	// 外部IDプロバイダー（OIDC）でのログイン。クライアントIDを設定したプロバイダーのみ有効になる
+	// コールバックのリダイレクト先は APP_PUBLIC_URL/auth/callback/<プロバイダー名>
+	// X は OpenID Connect（IDトークン）に対応していないため、このフローでは扱わない
+	OIDC_GOOGLE_CLIENT_ID     string `env:"OIDC_GOOGLE_CLIENT_ID" envDefault:""`
+	OIDC_GOOGLE_CLIENT_SECRET string `env:"OIDC_GOOGLE_CLIENT_SECRET" envDefault:""`
+	// 開発・テスト用のモックプロバイダー（プロバイダー名 mock）を OIDC_MOCK_ISSUER のパスで公開する。本番環境では有効にできない
+	OIDC_MOCK_ENABLED bool   `env:"OIDC_MOCK_ENABLED" envDefault:"false"`
+	OIDC_MOCK_ISSUER  string `env:"OIDC_MOCK_ISSUER" envDefault:"http://localhost:8080/mock-oidc"`
+
 	LOG_LEVEL     string `env:"LOG_LEVEL" envDefault:"debug"`
 	IS_SILENT_LOG bool   `env:"IS_SILENT_LOG" envDefault:"false"`
 }
@@ -2,6 +2,7 @@ package env_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -18,40 +19,65 @@ func TestNewEnv(t *testing.T) {
 			caseName: "正常系: 環境変数が設定されていない場合デフォルト値が使用される",
 			envVars:  map[string]string{},
 			want: &env.Env{
-				APP_PORT:          "8080",
-				POSTGRES_HOST:     "postgres",
-				POSTGRES_DBNAME:   "poketierlocal",
-				POSTGRES_USER:     "dbuser",
-				POSTGRES_PASSWORD: "Password123",
-				POSTGRES_PORT:     "5432",
-				POSTGRES_SSLMODE:  "disable",
-				LOG_LEVEL:         "debug",
-				IS_SILENT_LOG:     false,
+				APP_PORT:                  "8080",
+				APP_ENV:                   "local",
+				ALLOW_ORIGINS:             "*",
+				POSTGRES_HOST:             "postgres",
+				POSTGRES_DBNAME:           "poketierlocal",
+				POSTGRES_USER:             "dbuser",
+				POSTGRES_PASSWORD:         "Password123",
+				POSTGRES_PORT:             "5432",
+				POSTGRES_SSLMODE:          "disable",
+				BLOB_STORE_DIR:            "/tmp/poketier/blob",
+				CONSENSUS_CACHE_TTL:       5 * time.Minute,
+				CONSENSUS_CACHE_STALE_TTL: time.Hour,
+				JWT_ACCESS_TOKEN_TTL:      15 * time.Minute,
+				MAILER:                    "log",
+				MAIL_FROM:                 "noreply@poketier.local",
+				MAIL_FILE_DIR:             "/tmp/poketier/mail",
+				APP_PUBLIC_URL:            "http://localhost:3000",
+				OIDC_MOCK_ISSUER:          "http://localhost:8080/mock-oidc",
+				LOG_LEVEL:                 "debug",
+				IS_SILENT_LOG:             false,
 			},
 		},
 		{
 			caseName: "正常系: 環境変数で設定した値が正しく取得される",
 			envVars: map[string]string{
 				"APP_PORT":          "9000",
+				"TRUSTED_PROXIES":   "10.0.0.1,192.168.0.0/16",
 				"POSTGRES_HOST":     "localhost",
 				"POSTGRES_DBNAME":   "test_db",
 				"POSTGRES_USER":     "test_user",
 				"POSTGRES_PASSWORD": "test_password",
 				"POSTGRES_PORT":     "5433",
 				"POSTGRES_SSLMODE":  "require",
+				"BLOB_STORE_DIR":    "/var/lib/poketier/blob",
 				"LOG_LEVEL":         "info",
 				"IS_SILENT_LOG":     "true",
 			},
 			want: &env.Env{
-				APP_PORT:          "9000",
-				POSTGRES_HOST:     "localhost",
-				POSTGRES_DBNAME:   "test_db",
-				POSTGRES_USER:     "test_user",
-				POSTGRES_PASSWORD: "test_password",
-				POSTGRES_PORT:     "5433",
-				POSTGRES_SSLMODE:  "require",
-				LOG_LEVEL:         "info",
-				IS_SILENT_LOG:     true,
+				APP_PORT:                  "9000",
+				APP_ENV:                   "local",
+				ALLOW_ORIGINS:             "*",
+				TRUSTED_PROXIES:           []string{"10.0.0.1", "192.168.0.0/16"},
+				POSTGRES_HOST:             "localhost",
+				POSTGRES_DBNAME:           "test_db",
+				POSTGRES_USER:             "test_user",
+				POSTGRES_PASSWORD:         "test_password",
+				POSTGRES_PORT:             "5433",
+				POSTGRES_SSLMODE:          "require",
+				BLOB_STORE_DIR:            "/var/lib/poketier/blob",
+				CONSENSUS_CACHE_TTL:       5 * time.Minute,
+				CONSENSUS_CACHE_STALE_TTL: time.Hour,
+				JWT_ACCESS_TOKEN_TTL:      15 * time.Minute,
+				MAILER:                    "log",
+				MAIL_FROM:                 "noreply@poketier.local",
+				MAIL_FILE_DIR:             "/tmp/poketier/mail",
+				APP_PUBLIC_URL:            "http://localhost:3000",
+				OIDC_MOCK_ISSUER:          "http://localhost:8080/mock-oidc",
+				LOG_LEVEL:                 "info",
+				IS_SILENT_LOG:             true,
 			},
 		},
 		{
@@ -61,15 +87,26 @@ func TestNewEnv(t *testing.T) {
 				"POSTGRES_DBNAME": "custom_db",
 			},
 			want: &env.Env{
-				APP_PORT:          "3000",
-				POSTGRES_HOST:     "postgres",
-				POSTGRES_DBNAME:   "custom_db",
-				POSTGRES_USER:     "dbuser",
-				POSTGRES_PASSWORD: "Password123",
-				POSTGRES_PORT:     "5432",
-				POSTGRES_SSLMODE:  "disable",
-				LOG_LEVEL:         "debug",
-				IS_SILENT_LOG:     false,
+				APP_PORT:                  "3000",
+				APP_ENV:                   "local",
+				ALLOW_ORIGINS:             "*",
+				POSTGRES_HOST:             "postgres",
+				POSTGRES_DBNAME:           "custom_db",
+				POSTGRES_USER:             "dbuser",
+				POSTGRES_PASSWORD:         "Password123",
+				POSTGRES_PORT:             "5432",
+				POSTGRES_SSLMODE:          "disable",
+				BLOB_STORE_DIR:            "/tmp/poketier/blob",
+				CONSENSUS_CACHE_TTL:       5 * time.Minute,
+				CONSENSUS_CACHE_STALE_TTL: time.Hour,
+				JWT_ACCESS_TOKEN_TTL:      15 * time.Minute,
+				MAILER:                    "log",
+				MAIL_FROM:                 "noreply@poketier.local",
+				MAIL_FILE_DIR:             "/tmp/poketier/mail",
+				APP_PUBLIC_URL:            "http://localhost:3000",
+				OIDC_MOCK_ISSUER:          "http://localhost:8080/mock-oidc",
+				LOG_LEVEL:                 "debug",
+				IS_SILENT_LOG:             false,
 			},
 		},
 	}
@@ -103,6 +140,15 @@ func TestNewEnv_DefaultValues(t *testing.T) {
 		assert.Equal(t, "Password123", got.POSTGRES_PASSWORD, "POSTGRES_PASSWORD default value is incorrect")
 		assert.Equal(t, "5432", got.POSTGRES_PORT, "POSTGRES_PORT default value is incorrect")
 		assert.Equal(t, "disable", got.POSTGRES_SSLMODE, "POSTGRES_SSLMODE default value is incorrect")
+		assert.Equal(t, "/tmp/poketier/blob", got.BLOB_STORE_DIR, "BLOB_STORE_DIR default value is incorrect")
+		assert.Empty(t, got.TRUSTED_PROXIES, "TRUSTED_PROXIES default value is incorrect")
+		assert.Equal(t, 5*time.Minute, got.CONSENSUS_CACHE_TTL, "CONSENSUS_CACHE_TTL default value is incorrect")
+		assert.Equal(t, time.Hour, got.CONSENSUS_CACHE_STALE_TTL, "CONSENSUS_CACHE_STALE_TTL default value is incorrect")
+		assert.Equal(t, 15*time.Minute, got.JWT_ACCESS_TOKEN_TTL, "JWT_ACCESS_TOKEN_TTL default value is incorrect")
+		assert.Equal(t, "log", got.MAILER, "MAILER default value is incorrect")
+		assert.Equal(t, "/tmp/poketier/mail", got.MAIL_FILE_DIR, "MAIL_FILE_DIR default value is incorrect")
+		assert.Equal(t, false, got.OIDC_MOCK_ENABLED, "OIDC_MOCK_ENABLED default value is incorrect")
+		assert.Equal(t, "http://localhost:8080/mock-oidc", got.OIDC_MOCK_ISSUER, "OIDC_MOCK_ISSUER default value is incorrect")
 		assert.Equal(t, "debug", got.LOG_LEVEL, "LOG_LEVEL default value is incorrect")
 		assert.Equal(t, false, got.IS_SILENT_LOG, "IS_SILENT_LOG default value is incorrect")
 	})
@@ -118,6 +164,7 @@ func TestNewEnv_EnvironmentVariables(t *testing.T) {
 		t.Setenv("POSTGRES_PASSWORD", "test-secret")
 		t.Setenv("POSTGRES_PORT", "5555")
 		t.Setenv("POSTGRES_SSLMODE", "verify-full")
+		t.Setenv("TRUSTED_PROXIES", "10.0.0.1,192.168.0.0/16")
 		t.Setenv("LOG_LEVEL", "error")
 		t.Setenv("IS_SILENT_LOG", "true")
 
@@ -132,6 +179,7 @@ func TestNewEnv_EnvironmentVariables(t *testing.T) {
 		assert.Equal(t, "test-secret", got.POSTGRES_PASSWORD, "POSTGRES_PASSWORD environment variable is not set correctly")
 		assert.Equal(t, "5555", got.POSTGRES_PORT, "POSTGRES_PORT environment variable is not set correctly")
 		assert.Equal(t, "verify-full", got.POSTGRES_SSLMODE, "POSTGRES_SSLMODE environment variable is not set correctly")
+		assert.Equal(t, []string{"10.0.0.1", "192.168.0.0/16"}, got.TRUSTED_PROXIES, "TRUSTED_PROXIES environment variable is not set correctly")
 		assert.Equal(t, "error", got.LOG_LEVEL, "LOG_LEVEL environment variable is not set correctly")
 		assert.Equal(t, true, got.IS_SILENT_LOG, "IS_SILENT_LOG environment variable is not set correctly")
 	})
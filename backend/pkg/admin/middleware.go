@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/vo/role"
+
+	"github.com/gin-gonic/gin"
+)
+
+const bearerPrefix = "Bearer "
+
+// NewMiddleware は管理用トークンで管理者として認証するミドルウェアを生成します。
+// Authorizationヘッダーの Bearer トークンを token と定数時間で比較し、一致した場合は管理者の権限をcontextに格納します。
+// 一致しない場合や token が未設定の場合は何もせず、後続のアクセストークンでの認証（auth.NewRequiredMiddleware）に委ねます。
+// 操作の可否は policy.NewMiddleware で判定します。
+func NewMiddleware(token string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if token == "" {
+			ctx.Next()
+			return
+		}
+
+		header := ctx.GetHeader("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			ctx.Next()
+			return
+		}
+
+		given := strings.TrimPrefix(header, bearerPrefix)
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1 {
+			ctx.Request = ctx.Request.WithContext(auth.WithService(ctx.Request.Context(), role.Admin))
+		}
+
+		ctx.Next()
+	}
+}
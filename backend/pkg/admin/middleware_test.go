@@ -0,0 +1,75 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"poketier/pkg/admin"
+	"poketier/pkg/auth"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		caseName      string
+		token         string
+		authorization string
+		expectedRole  string
+	}{
+		{
+			caseName:      "正常系: トークンが一致する場合、管理者として後続のハンドラーが実行される",
+			token:         "secret",
+			authorization: "Bearer secret",
+			expectedRole:  "admin",
+		},
+		{
+			caseName:      "正常系: トークンが一致しない場合、認証せずに後続の処理に委ねる",
+			token:         "secret",
+			authorization: "Bearer wrong",
+			expectedRole:  "guest",
+		},
+		{
+			caseName:      "正常系: Authorizationヘッダーがない場合、認証せずに後続の処理に委ねる",
+			token:         "secret",
+			authorization: "",
+			expectedRole:  "guest",
+		},
+		{
+			caseName:      "正常系: トークンが未設定の場合、空のトークンでも管理者として扱わない",
+			token:         "",
+			authorization: "Bearer ",
+			expectedRole:  "guest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			r := gin.New()
+			r.GET("/admin", admin.NewMiddleware(tt.token), func(c *gin.Context) {
+				c.String(http.StatusOK, auth.RoleFromContext(c.Request.Context()).String())
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			// Act
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+			assert.Equal(t, tt.expectedRole, w.Body.String(), "role should match expected")
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Package auth はリクエストの認証情報を扱う機能を提供します
+package auth
+
+import (
+	"context"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// principalKey はcontextにログイン中のユーザーを格納するキー
+type principalKey struct{}
+
+// principal はアクセストークンまたは管理用トークンで認証された利用者
+// service が true の場合は管理用トークンでの認証で、ユーザーには紐づかない
+type principal struct {
+	userID  id.UserID
+	role    role.Role
+	service bool
+}
+
+// WithUser はログイン中のユーザーIDと権限を格納したcontextを返す
+func WithUser(ctx context.Context, userID id.UserID, userRole role.Role) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal{userID: userID, role: userRole})
+}
+
+// WithService はユーザーに紐づかない管理用トークンで認証した権限を格納したcontextを返す
+func WithService(ctx context.Context, serviceRole role.Role) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal{role: serviceRole, service: true})
+}
+
+// UserIDFromContext はcontextからログイン中のユーザーIDを取り出す
+// 未ログイン（ゲスト）の場合と管理用トークンで認証した場合は false を返す
+func UserIDFromContext(ctx context.Context) (id.UserID, bool) {
+	p, ok := ctx.Value(principalKey{}).(principal)
+	if !ok || p.service {
+		return id.UserID{}, false
+	}
+	return p.userID, true
+}
+
+// IsAuthenticated はアクセストークンまたは管理用トークンで認証済みかどうかを返す
+func IsAuthenticated(ctx context.Context) bool {
+	_, ok := ctx.Value(principalKey{}).(principal)
+	return ok
+}
+
+// RoleFromContext はcontextからログイン中のユーザーの権限を取り出す
+// 未ログインの場合はゲストを返す
+func RoleFromContext(ctx context.Context) role.Role {
+	p, ok := ctx.Value(principalKey{}).(principal)
+	if !ok {
+		return role.Guest
+	}
+	return p.role
+}
@@ -0,0 +1,62 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserFromContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 格納したユーザーIDと権限が取り出せる事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		userID := id.NewUserID()
+		ctx := auth.WithUser(context.Background(), userID, role.Moderator)
+
+		// Act
+		got, ok := auth.UserIDFromContext(ctx)
+		gotRole := auth.RoleFromContext(ctx)
+
+		// Assert
+		assert.True(t, ok, "user id should be found")
+		assert.Equal(t, userID, got, "user id does not match")
+		assert.Equal(t, role.Moderator, gotRole, "role does not match")
+	})
+
+	t.Run("正常系: 未ログインの場合はfalseとゲスト権限が返される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		_, ok := auth.UserIDFromContext(context.Background())
+		gotRole := auth.RoleFromContext(context.Background())
+
+		// Assert
+		assert.False(t, ok, "user id should not be found")
+		assert.Equal(t, role.Guest, gotRole, "role should be guest")
+		assert.False(t, auth.IsAuthenticated(context.Background()), "guest should not be authenticated")
+	})
+
+	t.Run("正常系: 管理用トークンで認証した場合は権限のみが取り出せる事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctx := auth.WithService(context.Background(), role.Admin)
+
+		// Act
+		_, ok := auth.UserIDFromContext(ctx)
+		gotRole := auth.RoleFromContext(ctx)
+
+		// Assert
+		assert.False(t, ok, "user id should not be found")
+		assert.Equal(t, role.Admin, gotRole, "role does not match")
+		assert.True(t, auth.IsAuthenticated(ctx), "service should be authenticated")
+	})
+}
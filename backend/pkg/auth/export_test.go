@@ -0,0 +1,13 @@
+package auth
+
+import "time"
+
+// SetNow はテストで現在時刻を差し替える
+func (v *Verifier) SetNow(now func() time.Time) {
+	v.now = now
+}
+
+// SetNow はテストで現在時刻を差し替える
+func (s *Signer) SetNow(now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// jwks はJWK Set（RFC 7517 5）
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk はJSON Web Key。RSA公開鍵（kty=RSA）と共通鍵（kty=oct）に対応する
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// loadJWKSFile はローカルのJWKSファイルから検証用の鍵を読み込む
+// 暗号化用（use=enc）の鍵は読み飛ばす
+func loadJWKSFile(path string) ([]verificationKey, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- パスは環境変数で指定された設定ファイル
+	if err != nil {
+		return nil, err
+	}
+
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for i, k := range set.Keys {
+		if k.Use == "enc" {
+			continue
+		}
+		key, err := k.toVerificationKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key at index %d: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// toVerificationKey はJWKを検証用の鍵に変換する
+func (k jwk) toVerificationKey() (verificationKey, error) {
+	switch k.Kty {
+	case "RSA":
+		if k.Alg != "" && k.Alg != algRS256 {
+			return verificationKey{}, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, k.Alg)
+		}
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return verificationKey{}, errors.New("invalid exponent")
+		}
+		return verificationKey{
+			kid:       k.Kid,
+			alg:       algRS256,
+			publicKey: &rsa.PublicKey{N: n, E: int(e.Int64())},
+		}, nil
+	case "oct":
+		if k.Alg != "" && k.Alg != algHS256 {
+			return verificationKey{}, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, k.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid key value: %w", err)
+		}
+		if len(secret) < minHMACKeyLength {
+			return verificationKey{}, fmt.Errorf("hs256 key must be at least %d bytes", minHMACKeyLength)
+		}
+		return verificationKey{kid: k.Kid, alg: algHS256, hmacKey: secret}, nil
+	default:
+		return verificationKey{}, fmt.Errorf("unsupported key type: %q", k.Kty)
+	}
+}
+
+// decodeBigInt はbase64url（パディングなし）でエンコードされた符号なし整数をデコードする
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// parseRSAPublicKeyPEM はPEM形式（PKIX または PKCS#1）のRSA公開鍵を読み込む
+func parseRSAPublicKeyPEM(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no pem block found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		publicKey, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("public key is not an rsa key")
+		}
+		return publicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem block type: %q", block.Type)
+	}
+}
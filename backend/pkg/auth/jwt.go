@@ -0,0 +1,271 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+
+	// minHMACKeyLength はHS256の共通鍵の最小バイト数（RFC 7518 3.2: ハッシュ長以上）
+	minHMACKeyLength = 32
+
+	// clockSkew はトークンの有効期間の判定で許容するサーバー間の時刻のずれ
+	clockSkew = 30 * time.Second
+)
+
+var (
+	// ErrMalformedToken はトークンの形式が不正であることを表す
+	ErrMalformedToken = errors.New("malformed token")
+	// ErrUnsupportedAlgorithm は対応していない署名アルゴリズムであることを表す
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	// ErrUnknownKey は署名の検証に使用する鍵が見つからないことを表す
+	ErrUnknownKey = errors.New("unknown signing key")
+	// ErrInvalidSignature は署名が一致しないことを表す
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrInvalidClaims はクレームが不正（期限切れ・発行者や対象者の不一致など）であることを表す
+	ErrInvalidClaims = errors.New("invalid claims")
+)
+
+// Config はアクセストークンの検証設定
+// 鍵は HS256 の共通鍵、RS256 の公開鍵（PEM）、JWKSファイルのいずれか（併用可）で指定する
+// Issuer / Audience が空の場合はそれぞれの検証を行わない
+type Config struct {
+	HS256Secret    string
+	RS256PublicKey string
+	JWKSFile       string
+	Issuer         string
+	Audience       string
+}
+
+// Claims は検証済みのアクセストークンのクレーム
+type Claims struct {
+	UserID    id.UserID
+	Role      role.Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Verifier はアクセストークン（JWT）の署名とクレームを検証する
+type Verifier struct {
+	keys     []verificationKey
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// verificationKey は署名の検証に使用する鍵（kid が空の場合はトークンの kid を問わない）
+type verificationKey struct {
+	kid       string
+	alg       string
+	hmacKey   []byte
+	publicKey *rsa.PublicKey
+}
+
+// header はJWTのJOSEヘッダー
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// rawClaims はJWTのペイロード
+type rawClaims struct {
+	Subject   string   `json:"sub"`
+	Role      string   `json:"role"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *int64   `json:"exp"`
+	NotBefore *int64   `json:"nbf"`
+	IssuedAt  *int64   `json:"iat"`
+}
+
+// audience は文字列または文字列の配列で表される aud クレーム（RFC 7519 4.1.3）
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// NewVerifier は設定からVerifierを作成する
+// 鍵が1つも設定されていない場合は、全てのトークンを拒否するVerifierを返す
+func NewVerifier(cfg Config) (*Verifier, error) {
+	v := &Verifier{
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		now:      time.Now,
+	}
+
+	if cfg.HS256Secret != "" {
+		if len(cfg.HS256Secret) < minHMACKeyLength {
+			return nil, fmt.Errorf("hs256 secret must be at least %d bytes", minHMACKeyLength)
+		}
+		v.keys = append(v.keys, verificationKey{alg: algHS256, hmacKey: []byte(cfg.HS256Secret)})
+	}
+
+	if cfg.RS256PublicKey != "" {
+		publicKey, err := parseRSAPublicKeyPEM([]byte(cfg.RS256PublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rs256 public key: %w", err)
+		}
+		v.keys = append(v.keys, verificationKey{alg: algRS256, publicKey: publicKey})
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load jwks file: %w", err)
+		}
+		v.keys = append(v.keys, keys...)
+	}
+
+	return v, nil
+}
+
+// Verify はトークンの署名とクレームを検証し、クレームを返す
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrMalformedToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrMalformedToken, err)
+	}
+
+	if err := v.verifySignature(h, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var raw rawClaims
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrMalformedToken, err)
+	}
+
+	return v.validateClaims(raw)
+}
+
+// verifySignature はヘッダーのアルゴリズムと一致する種類の鍵で署名を検証する
+// アルゴリズムの取り違えを防ぐため、HS256 は共通鍵、RS256 は公開鍵でのみ検証する
+func (v *Verifier) verifySignature(h header, signingInput string, signature []byte) error {
+	if h.Alg != algHS256 && h.Alg != algRS256 {
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, h.Alg)
+	}
+
+	candidates := make([]verificationKey, 0, len(v.keys))
+	for _, key := range v.keys {
+		if key.alg != h.Alg {
+			continue
+		}
+		if h.Kid != "" && key.kid != "" && key.kid != h.Kid {
+			continue
+		}
+		candidates = append(candidates, key)
+	}
+	if len(candidates) == 0 {
+		return ErrUnknownKey
+	}
+
+	digest := sha256.Sum256([]byte(signingInput))
+	for _, key := range candidates {
+		switch key.alg {
+		case algHS256:
+			mac := hmac.New(sha256.New, key.hmacKey)
+			mac.Write([]byte(signingInput))
+			if hmac.Equal(mac.Sum(nil), signature) {
+				return nil
+			}
+		case algRS256:
+			if rsa.VerifyPKCS1v15(key.publicKey, crypto.SHA256, digest[:], signature) == nil {
+				return nil
+			}
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// validateClaims は有効期間・発行者・対象者を検証し、ユーザーIDと権限を取り出す
+// role が省略された場合は一般ユーザーとして扱う
+func (v *Verifier) validateClaims(raw rawClaims) (*Claims, error) {
+	now := v.now()
+
+	if raw.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: exp is required", ErrInvalidClaims)
+	}
+	expiresAt := time.Unix(*raw.ExpiresAt, 0)
+	if !now.Before(expiresAt.Add(clockSkew)) {
+		return nil, fmt.Errorf("%w: token is expired", ErrInvalidClaims)
+	}
+	if raw.NotBefore != nil && now.Add(clockSkew).Before(time.Unix(*raw.NotBefore, 0)) {
+		return nil, fmt.Errorf("%w: token is not valid yet", ErrInvalidClaims)
+	}
+
+	if v.issuer != "" && raw.Issuer != v.issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidClaims)
+	}
+	if v.audience != "" && !slices.Contains(raw.Audience, v.audience) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidClaims)
+	}
+
+	userID, err := id.UserIDFromString(raw.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: sub must be a user id", ErrInvalidClaims)
+	}
+
+	userRole := role.User
+	if raw.Role != "" {
+		userRole, err = role.ParseRole(raw.Role)
+		if err != nil || userRole == role.Guest {
+			return nil, fmt.Errorf("%w: unexpected role", ErrInvalidClaims)
+		}
+	}
+
+	claims := &Claims{
+		UserID:    userID,
+		Role:      userRole,
+		ExpiresAt: expiresAt,
+	}
+	if raw.IssuedAt != nil {
+		claims.IssuedAt = time.Unix(*raw.IssuedAt, 0)
+	}
+	return claims, nil
+}
+
+// decodeSegment はbase64url（パディングなし）でエンコードされたJSONをデコードする
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,348 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+var (
+	// testNow はテストで使用する現在時刻
+	testNow = time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	// testRSAKey はテストで使用するRSA鍵（生成に時間がかかるため共有する）
+	testRSAKey = mustGenerateRSAKey()
+)
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		cfg      func(t *testing.T) auth.Config
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 鍵が設定されていない場合も作成できる事",
+			cfg:      func(t *testing.T) auth.Config { return auth.Config{} },
+		},
+		{
+			caseName: "正常系: 全ての種類の鍵を併用できる事",
+			cfg: func(t *testing.T) auth.Config {
+				return auth.Config{
+					HS256Secret:    testSecret,
+					RS256PublicKey: publicKeyPEM(t, &testRSAKey.PublicKey),
+					JWKSFile:       writeJWKSFile(t, "key-1", &testRSAKey.PublicKey),
+				}
+			},
+		},
+		{
+			caseName: "異常系: HS256の共通鍵が32バイト未満の場合",
+			cfg:      func(t *testing.T) auth.Config { return auth.Config{HS256Secret: "short"} },
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: RS256の公開鍵がPEM形式でない場合",
+			cfg:      func(t *testing.T) auth.Config { return auth.Config{RS256PublicKey: "not a pem"} },
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: JWKSファイルが存在しない場合",
+			cfg: func(t *testing.T) auth.Config {
+				return auth.Config{JWKSFile: filepath.Join(t.TempDir(), "missing.json")}
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "異常系: JWKSファイルに未対応の種類の鍵が含まれる場合",
+			cfg: func(t *testing.T) auth.Config {
+				path := filepath.Join(t.TempDir(), "jwks.json")
+				assert.NoError(t, os.WriteFile(path, []byte(`{"keys":[{"kty":"EC","kid":"ec-1"}]}`), 0o600), "failed to write jwks")
+				return auth.Config{JWKSFile: path}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := auth.NewVerifier(tt.cfg(t))
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+		})
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+	otherKey := mustGenerateRSAKey()
+	hs256 := map[string]any{"alg": "HS256", "typ": "JWT"}
+	rs256 := map[string]any{"alg": "RS256", "typ": "JWT", "kid": "key-1"}
+	validClaims := func(overrides map[string]any) map[string]any {
+		claims := map[string]any{
+			"sub": userID.String(),
+			"iss": "https://auth.poketier.example",
+			"aud": "poketier-api",
+			"iat": testNow.Add(-time.Minute).Unix(),
+			"exp": testNow.Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return claims
+	}
+
+	tests := []struct {
+		caseName string
+		token    func(t *testing.T) string
+		wantRole role.Role
+		wantErr  error
+	}{
+		{
+			caseName: "正常系: HS256で署名されたトークンが検証され、roleが省略された場合は一般ユーザーになる事",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(nil))
+			},
+			wantRole: role.User,
+		},
+		{
+			caseName: "正常系: RS256で署名されたトークンがJWKSの鍵で検証され、権限が取り出せる事",
+			token: func(t *testing.T) string {
+				return signRS256(t, testRSAKey, rs256, validClaims(map[string]any{"role": "moderator"}))
+			},
+			wantRole: role.Moderator,
+		},
+		{
+			caseName: "正常系: audが配列の場合も対象者に含まれていれば検証される事",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"aud": []string{"other", "poketier-api"}}))
+			},
+			wantRole: role.User,
+		},
+		{
+			caseName: "正常系: 許容する時刻のずれの範囲内であれば期限切れにならない事",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"exp": testNow.Add(-10 * time.Second).Unix()}))
+			},
+			wantRole: role.User,
+		},
+		{
+			caseName: "異常系: 形式が不正なトークン",
+			token:    func(t *testing.T) string { return "not-a-jwt" },
+			wantErr:  auth.ErrMalformedToken,
+		},
+		{
+			caseName: "異常系: 署名なし（alg=none）のトークン",
+			token: func(t *testing.T) string {
+				return encodeSegment(t, map[string]any{"alg": "none"}) + "." + encodeSegment(t, validClaims(nil)) + "."
+			},
+			wantErr: auth.ErrUnsupportedAlgorithm,
+		},
+		{
+			caseName: "異常系: 公開鍵を共通鍵としてHS256で署名したトークン（アルゴリズムの取り違え）",
+			token: func(t *testing.T) string {
+				return signHS256(t, publicKeyPEM(t, &testRSAKey.PublicKey), map[string]any{"alg": "HS256", "kid": "key-1"}, validClaims(nil))
+			},
+			wantErr: auth.ErrInvalidSignature,
+		},
+		{
+			caseName: "異常系: 未知のkidのトークン",
+			token: func(t *testing.T) string {
+				return signRS256(t, testRSAKey, map[string]any{"alg": "RS256", "kid": "unknown"}, validClaims(nil))
+			},
+			wantErr: auth.ErrUnknownKey,
+		},
+		{
+			caseName: "異常系: 別の鍵で署名されたトークン",
+			token: func(t *testing.T) string {
+				return signRS256(t, otherKey, rs256, validClaims(nil))
+			},
+			wantErr: auth.ErrInvalidSignature,
+		},
+		{
+			caseName: "異常系: 期限切れのトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"exp": testNow.Add(-time.Minute).Unix()}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: 有効期限のないトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"exp": nil}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: 有効期間の開始前のトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"nbf": testNow.Add(time.Minute).Unix()}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: 発行者が一致しないトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"iss": "https://evil.example"}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: 対象者が一致しないトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"aud": "other-api"}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: subがユーザーIDでないトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"sub": "user-1"}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+		{
+			caseName: "異常系: ゲスト権限のトークン",
+			token: func(t *testing.T) string {
+				return signHS256(t, testSecret, hs256, validClaims(map[string]any{"role": "guest"}))
+			},
+			wantErr: auth.ErrInvalidClaims,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			verifier, err := auth.NewVerifier(auth.Config{
+				HS256Secret: testSecret,
+				JWKSFile:    writeJWKSFile(t, "key-1", &testRSAKey.PublicKey),
+				Issuer:      "https://auth.poketier.example",
+				Audience:    "poketier-api",
+			})
+			assert.NoError(t, err, "failed to create verifier")
+			verifier.SetNow(func() time.Time { return testNow })
+
+			// Act
+			got, err := verifier.Verify(tt.token(t))
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "error should be %v but got %v", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, userID, got.UserID, "user id does not match")
+			assert.Equal(t, tt.wantRole, got.Role, "role does not match")
+			assert.Equal(t, testNow.Add(-time.Minute).Unix(), got.IssuedAt.Unix(), "issued at does not match")
+		})
+	}
+}
+
+func mustGenerateRSAKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// signHS256 はテスト用にHS256で署名したトークンを作成する
+func signHS256(t *testing.T, secret string, header, claims map[string]any) string {
+	t.Helper()
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signRS256 はテスト用にRS256で署名したトークンを作成する
+func signRS256(t *testing.T, key *rsa.PrivateKey, header, claims map[string]any) string {
+	t.Helper()
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// publicKeyPEM はRSA公開鍵をPKIX形式のPEMにエンコードする
+func publicKeyPEM(t *testing.T, key *rsa.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// writeJWKSFile はRSA公開鍵を含むJWKSファイルを一時ディレクトリに作成する
+func writeJWKSFile(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	set := map[string]any{
+		"keys": []map[string]any{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("failed to marshal jwks: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write jwks: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"strings"
+
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+const bearerPrefix = "Bearer "
+
+// NewOptionalMiddleware はアクセストークンがあればログイン中のユーザーとして認証するミドルウェアを生成します。
+// Authorizationヘッダーがない場合はゲストとして後続の処理を実行し、匿名での閲覧を妨げません。
+// ヘッダーがあってもトークンが不正な場合は、ゲスト扱いにはせず401を返します。
+func NewOptionalMiddleware(verifier *Verifier) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		if header == "" {
+			ctx.Next()
+			return
+		}
+
+		if !authenticate(ctx, verifier, header) {
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// NewRequiredMiddleware はログインを必須とするミドルウェアを生成します。
+// NewOptionalMiddleware や管理用トークンで認証済みの場合はトークンを検証し直さずに後続の処理を実行します。
+func NewRequiredMiddleware(verifier *Verifier) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if IsAuthenticated(ctx.Request.Context()) {
+			ctx.Next()
+			return
+		}
+
+		header := ctx.GetHeader("Authorization")
+		if header == "" {
+			reject(ctx, `Bearer realm="poketier"`, errs.NewUnauthorizedError("missing access token", nil))
+			return
+		}
+
+		if !authenticate(ctx, verifier, header) {
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// authenticate はAuthorizationヘッダーのトークンを検証し、ユーザーをリクエストのcontextに格納します。
+// 検証に失敗した場合は401を返し、false を返します。
+func authenticate(ctx *gin.Context, verifier *Verifier, header string) bool {
+	if !strings.HasPrefix(header, bearerPrefix) {
+		reject(ctx, `Bearer realm="poketier", error="invalid_request"`, errs.NewUnauthorizedError("authorization header must be a bearer token", nil))
+		return false
+	}
+
+	claims, err := verifier.Verify(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		reject(ctx, `Bearer realm="poketier", error="invalid_token"`, errs.NewUnauthorizedError("invalid access token", err))
+		return false
+	}
+
+	ctx.Request = ctx.Request.WithContext(WithUser(ctx.Request.Context(), claims.UserID, claims.Role))
+	return true
+}
+
+// reject はWWW-Authenticateヘッダー（RFC 6750 3）を付けて401を返し、後続の処理を中断します。
+func reject(ctx *gin.Context, challenge string, err error) {
+	ctx.Header("WWW-Authenticate", challenge)
+	errs.HandleError(ctx, err)
+	ctx.Abort()
+}
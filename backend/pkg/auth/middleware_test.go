@@ -0,0 +1,211 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/id"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOptionalMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+
+	tests := []struct {
+		caseName          string
+		authorization     func(t *testing.T) string
+		expectedStatus    int
+		expectedUser      string
+		expectedChallenge string
+	}{
+		{
+			caseName: "正常系: 有効なトークンの場合、ログイン中のユーザーとして後続のハンドラーが実行される",
+			authorization: func(t *testing.T) string {
+				return "Bearer " + signHS256(t, testSecret, map[string]any{"alg": "HS256"}, map[string]any{
+					"sub":  userID.String(),
+					"role": "admin",
+					"exp":  time.Now().Add(time.Hour).Unix(),
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedUser:   userID.String() + ":admin",
+		},
+		{
+			caseName:       "正常系: Authorizationヘッダーがない場合、ゲストとして後続のハンドラーが実行される",
+			authorization:  func(t *testing.T) string { return "" },
+			expectedStatus: http.StatusOK,
+			expectedUser:   "guest",
+		},
+		{
+			caseName:          "異常系: トークンが不正な場合、ゲスト扱いにせず401が返される",
+			authorization:     func(t *testing.T) string { return "Bearer invalid" },
+			expectedStatus:    http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="poketier", error="invalid_token"`,
+		},
+		{
+			caseName:          "異常系: Bearer形式でない場合、401が返される",
+			authorization:     func(t *testing.T) string { return "Basic dXNlcjpwYXNz" },
+			expectedStatus:    http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="poketier", error="invalid_request"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testSecret})
+			assert.NoError(t, err, "failed to create verifier")
+
+			r := gin.New()
+			r.GET("/tier-lists", auth.NewOptionalMiddleware(verifier), whoAmI)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/tier-lists", nil)
+			if authorization := tt.authorization(t); authorization != "" {
+				req.Header.Set("Authorization", authorization)
+			}
+
+			// Act
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedUser, w.Body.String(), "authenticated user does not match")
+				return
+			}
+			assert.Equal(t, tt.expectedChallenge, w.Header().Get("WWW-Authenticate"), "challenge does not match")
+			assertUnauthorizedBody(t, w)
+		})
+	}
+}
+
+func TestNewRequiredMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	userID := id.NewUserID()
+	validToken := func(t *testing.T) string {
+		return "Bearer " + signHS256(t, testSecret, map[string]any{"alg": "HS256"}, map[string]any{
+			"sub": userID.String(),
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+	}
+
+	tests := []struct {
+		caseName          string
+		withOptional      bool
+		authorization     func(t *testing.T) string
+		expectedStatus    int
+		expectedUser      string
+		expectedChallenge string
+	}{
+		{
+			caseName:       "正常系: 有効なトークンの場合、後続のハンドラーが実行される",
+			authorization:  validToken,
+			expectedStatus: http.StatusOK,
+			expectedUser:   userID.String() + ":user",
+		},
+		{
+			caseName:       "正常系: 任意認証のミドルウェアで認証済みの場合も後続のハンドラーが実行される",
+			withOptional:   true,
+			authorization:  validToken,
+			expectedStatus: http.StatusOK,
+			expectedUser:   userID.String() + ":user",
+		},
+		{
+			caseName:          "異常系: Authorizationヘッダーがない場合、401が返される",
+			authorization:     func(t *testing.T) string { return "" },
+			expectedStatus:    http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="poketier"`,
+		},
+		{
+			caseName:          "異常系: 任意認証のミドルウェアを通ったゲストの場合、401が返される",
+			withOptional:      true,
+			authorization:     func(t *testing.T) string { return "" },
+			expectedStatus:    http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="poketier"`,
+		},
+		{
+			caseName: "異常系: 期限切れのトークンの場合、401が返される",
+			authorization: func(t *testing.T) string {
+				return "Bearer " + signHS256(t, testSecret, map[string]any{"alg": "HS256"}, map[string]any{
+					"sub": userID.String(),
+					"exp": time.Now().Add(-time.Hour).Unix(),
+				})
+			},
+			expectedStatus:    http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="poketier", error="invalid_token"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testSecret})
+			assert.NoError(t, err, "failed to create verifier")
+
+			r := gin.New()
+			if tt.withOptional {
+				r.Use(auth.NewOptionalMiddleware(verifier))
+			}
+			r.GET("/users/me", auth.NewRequiredMiddleware(verifier), whoAmI)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+			if authorization := tt.authorization(t); authorization != "" {
+				req.Header.Set("Authorization", authorization)
+			}
+
+			// Act
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedUser, w.Body.String(), "authenticated user does not match")
+				return
+			}
+			assert.Equal(t, tt.expectedChallenge, w.Header().Get("WWW-Authenticate"), "challenge does not match")
+			assertUnauthorizedBody(t, w)
+		})
+	}
+}
+
+// whoAmI はリクエストのcontextに格納されたユーザーを「ユーザーID:権限」（ゲストの場合は guest）で返す
+func whoAmI(c *gin.Context) {
+	userID, ok := auth.UserIDFromContext(c.Request.Context())
+	if !ok {
+		c.String(http.StatusOK, "guest")
+		return
+	}
+	c.String(http.StatusOK, userID.String()+":"+auth.RoleFromContext(c.Request.Context()).String())
+}
+
+// assertUnauthorizedBody はRFC 9457形式の401のレスポンスボディであることを検証する
+func assertUnauthorizedBody(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+	var body errs.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body), "response body should be valid JSON")
+	assert.Equal(t, errs.ErrorResponse{
+		Title:  "Unauthorized",
+		Status: http.StatusUnauthorized,
+		Detail: "Authentication is required.",
+	}, body, "response body should match expected")
+}
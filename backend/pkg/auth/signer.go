@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+)
+
+// ErrSigningKeyNotConfigured はアクセストークンの署名鍵が設定されていないことを表す
+var ErrSigningKeyNotConfigured = errors.New("signing key is not configured")
+
+// SignerConfig はアクセストークンの発行設定
+// Issuer / Audience が空の場合はそれぞれのクレームを含めない
+type SignerConfig struct {
+	HS256Secret string
+	Issuer      string
+	Audience    string
+	TTL         time.Duration
+}
+
+// Signer はローカルアカウントでログインしたユーザーにアクセストークン（HS256のJWT）を発行する
+// 発行したトークンは同じ共通鍵を設定したVerifierで検証できる
+type Signer struct {
+	secret   []byte
+	issuer   string
+	audience string
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// NewSigner は設定からSignerを作成する
+// 共通鍵が設定されていない場合は、トークンの発行を全て拒否するSignerを返す
+func NewSigner(cfg SignerConfig) (*Signer, error) {
+	if cfg.HS256Secret != "" && len(cfg.HS256Secret) < minHMACKeyLength {
+		return nil, fmt.Errorf("hs256 secret must be at least %d bytes", minHMACKeyLength)
+	}
+	if cfg.TTL <= 0 {
+		return nil, errors.New("access token ttl must be positive")
+	}
+
+	return &Signer{
+		secret:   []byte(cfg.HS256Secret),
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		ttl:      cfg.TTL,
+		now:      time.Now,
+	}, nil
+}
+
+// signedClaims は発行するJWTのペイロード
+type signedClaims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	Issuer    string `json:"iss,omitempty"`
+	Audience  string `json:"aud,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Sign はユーザーIDと権限を含むアクセストークンを発行し、トークンと有効期限を返す
+func (s *Signer) Sign(userID id.UserID, userRole role.Role) (string, time.Time, error) {
+	if len(s.secret) == 0 {
+		return "", time.Time{}, ErrSigningKeyNotConfigured
+	}
+
+	now := s.now()
+	expiresAt := now.Add(s.ttl)
+
+	headerSegment, err := encodeJSONSegment(header{Alg: algHS256, Typ: "JWT"})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	claimsSegment, err := encodeJSONSegment(signedClaims{
+		Subject:   userID.String(),
+		Role:      userRole.String(),
+		Issuer:    s.issuer,
+		Audience:  s.audience,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	signingInput := headerSegment + "." + claimsSegment
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), expiresAt, nil
+}
+
+// encodeJSONSegment はJSONをbase64url（パディングなし）でエンコードする
+func encodeJSONSegment(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token segment: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
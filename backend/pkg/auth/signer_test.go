@@ -0,0 +1,124 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSigner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		cfg      auth.SignerConfig
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 共通鍵と有効期間を設定",
+			cfg:      auth.SignerConfig{HS256Secret: testSecret, TTL: time.Minute},
+		},
+		{
+			caseName: "正常系: 共通鍵が未設定",
+			cfg:      auth.SignerConfig{TTL: time.Minute},
+		},
+		{
+			caseName: "異常系: 共通鍵が短すぎる",
+			cfg:      auth.SignerConfig{HS256Secret: "short", TTL: time.Minute},
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: 有効期間が0",
+			cfg:      auth.SignerConfig{HS256Secret: testSecret},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			signer, err := auth.NewSigner(tt.cfg)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "NewSigner should return error")
+				return
+			}
+			assert.NoError(t, err, "NewSigner should not return error")
+			assert.NotNil(t, signer, "signer should not be nil")
+		})
+	}
+}
+
+func TestSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	userID := id.NewUserID()
+
+	t.Run("正常系: 発行したトークンを同じ設定のVerifierで検証できる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		signer, err := auth.NewSigner(auth.SignerConfig{HS256Secret: testSecret, Issuer: "poketier", Audience: "poketier-web", TTL: 15 * time.Minute})
+		require.NoError(t, err, "NewSigner should not return error")
+		signer.SetNow(func() time.Time { return testNow })
+		verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testSecret, Issuer: "poketier", Audience: "poketier-web"})
+		require.NoError(t, err, "NewVerifier should not return error")
+		verifier.SetNow(func() time.Time { return testNow.Add(time.Minute) })
+
+		// Act
+		token, expiresAt, err := signer.Sign(userID, role.Moderator)
+
+		// Assert
+		require.NoError(t, err, "Sign should not return error")
+		assert.Equal(t, testNow.Add(15*time.Minute), expiresAt, "expiresAt should be now + ttl")
+		claims, err := verifier.Verify(token)
+		require.NoError(t, err, "signed token should be verified")
+		assert.Equal(t, userID, claims.UserID, "sub should be user id")
+		assert.Equal(t, role.Moderator, claims.Role, "role should match")
+		assert.Equal(t, testNow.Unix(), claims.IssuedAt.Unix(), "iat should be now")
+	})
+
+	t.Run("正常系: 有効期限を過ぎたトークンは検証に失敗する", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		signer, err := auth.NewSigner(auth.SignerConfig{HS256Secret: testSecret, TTL: time.Minute})
+		require.NoError(t, err, "NewSigner should not return error")
+		signer.SetNow(func() time.Time { return testNow })
+		verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testSecret})
+		require.NoError(t, err, "NewVerifier should not return error")
+		verifier.SetNow(func() time.Time { return testNow.Add(time.Hour) })
+
+		// Act
+		token, _, err := signer.Sign(userID, role.User)
+
+		// Assert
+		require.NoError(t, err, "Sign should not return error")
+		_, err = verifier.Verify(token)
+		assert.ErrorIs(t, err, auth.ErrInvalidClaims, "expired token should be rejected")
+	})
+
+	t.Run("異常系: 共通鍵が未設定の場合は発行しない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		signer, err := auth.NewSigner(auth.SignerConfig{TTL: time.Minute})
+		require.NoError(t, err, "NewSigner should not return error")
+
+		// Act
+		token, _, err := signer.Sign(userID, role.User)
+
+		// Assert
+		assert.ErrorIs(t, err, auth.ErrSigningKeyNotConfigured, "Sign should return ErrSigningKeyNotConfigured")
+		assert.Empty(t, token, "token should be empty")
+	})
+}
@@ -0,0 +1,85 @@
+// Package blob は生成した画像などのバイナリを保存するBlobストアを提供します
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound は指定したキーのオブジェクトが存在しない場合のエラー
+var ErrNotFound = errors.New("blob not found")
+
+// LocalStore はローカルファイルシステムをBlobストアとして扱う実装
+// キーは "/" 区切りの相対パスで、ルートディレクトリ配下のファイルに対応する
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore はルートディレクトリを指定してLocalStoreを作成する
+func NewLocalStore(root string) *LocalStore {
+	return &LocalStore{root: root}
+}
+
+// Get はキーに対応するオブジェクトを取得する
+// 存在しない場合は ErrNotFound を返す
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	p, err := s.pathOf(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	return data, nil
+}
+
+// Put はキーに対応するオブジェクトを保存する
+// 一時ファイルに書き込んでからリネームするため、読み込み側が書き込み途中のデータを読むことはない
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte) error {
+	p, err := s.pathOf(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		return fmt.Errorf("failed to rename blob: %w", err)
+	}
+	return nil
+}
+
+// pathOf はキーをファイルパスに変換する
+// ルートディレクトリの外を指すキーは受け付けない
+func (s *LocalStore) pathOf(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned == "/" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,96 @@
+package blob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"poketier/pkg/blob"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore_PutGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		key      string
+		puts     [][]byte
+		want     []byte
+	}{
+		{
+			caseName: "正常系: 保存したオブジェクトが取得できる事",
+			key:      "tier-lists/01989a00/image-r1.png",
+			puts:     [][]byte{[]byte("png")},
+			want:     []byte("png"),
+		},
+		{
+			caseName: "正常系: 同じキーに保存した場合、後から保存した内容で上書きされる事",
+			key:      "tier-lists/01989a00/image-r1.png",
+			puts:     [][]byte{[]byte("old"), []byte("new")},
+			want:     []byte("new"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			store := blob.NewLocalStore(t.TempDir())
+			for _, data := range tt.puts {
+				assert.NoError(t, store.Put(context.Background(), tt.key, data), "failed to put blob")
+			}
+
+			// Act
+			got, err := store.Get(context.Background(), tt.key)
+
+			// Assert
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "blob content does not match")
+		})
+	}
+}
+
+func TestLocalStore_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		key      string
+		wantErr  func(err error) bool
+	}{
+		{
+			caseName: "異常系: 存在しないキーの場合、ErrNotFoundを返す事",
+			key:      "tier-lists/unknown.png",
+			wantErr:  func(err error) bool { return errors.Is(err, blob.ErrNotFound) },
+		},
+		{
+			caseName: "異常系: ルートディレクトリの外を指すキーの場合、エラーを返す事",
+			key:      "../secret.png",
+			wantErr:  func(err error) bool { return err != nil && !errors.Is(err, blob.ErrNotFound) },
+		},
+		{
+			caseName: "異常系: 空のキーの場合、エラーを返す事",
+			key:      "",
+			wantErr:  func(err error) bool { return err != nil && !errors.Is(err, blob.ErrNotFound) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			store := blob.NewLocalStore(t.TempDir())
+
+			// Act
+			got, err := store.Get(context.Background(), tt.key)
+
+			// Assert
+			assert.Nil(t, got, "blob should be nil")
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+		})
+	}
+}
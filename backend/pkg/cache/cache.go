@@ -0,0 +1,156 @@
+// Package cache はプロセス内で計算結果を保持するキャッシュを提供します
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache は有効期限付きのプロセス内キャッシュ
+// 有効期限（ttl）を過ぎた値は、さらに staleTTL の間は古い値を返しつつバックグラウンドで再計算する（stale-while-revalidate）
+// 同じキーの計算が同時に要求された場合は1回の計算にまとめる（single-flight）
+type Cache[K comparable, V any] struct {
+	ttl      time.Duration
+	staleTTL time.Duration
+	now      func() time.Time
+	group    singleflight.Group
+
+	mu      sync.Mutex
+	entries map[K]entry[V]
+	// generations はキーごとの無効化の世代（値を保持しているキーと計算中のキーのみ保持する）
+	generations map[K]uint64
+	// loading はキーごとの実行中の計算の数
+	loading map[K]int
+}
+
+type entry[V any] struct {
+	value    V
+	loadedAt time.Time
+	// stale は無効化された値であることを表す（有効期限内でも再計算の対象になる）
+	stale bool
+}
+
+// New は有効期限と、期限切れ後に古い値を返す期間を指定してCacheを作成する
+func New[K comparable, V any](ttl, staleTTL time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:         ttl,
+		staleTTL:    staleTTL,
+		now:         time.Now,
+		entries:     make(map[K]entry[V]),
+		generations: make(map[K]uint64),
+		loading:     make(map[K]int),
+	}
+}
+
+// Get はキーに対応する値を返す
+// 有効期限内の値はそのまま返し、期限切れまたは無効化された値は古い値を返しつつバックグラウンドで load を実行する
+// 値がない場合や古い値を返す期間も過ぎた場合は load の完了を待つ（load のエラーはキャッシュしない）
+func (c *Cache[K, V]) Get(ctx context.Context, key K, load func(ctx context.Context) (V, error)) (V, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	generation := c.generations[key]
+	c.mu.Unlock()
+
+	if ok {
+		age := c.now().Sub(e.loadedAt)
+		if !e.stale && age < c.ttl {
+			return e.value, nil
+		}
+		if age < c.ttl+c.staleTTL {
+			// 結果を待たないため、チャネルは受信しない（バッファがあるため送信側はブロックしない）
+			c.group.DoChan(c.flightKey(key, generation), c.loader(ctx, key, generation, load))
+			return e.value, nil
+		}
+	}
+
+	ch := c.group.DoChan(c.flightKey(key, generation), c.loader(ctx, key, generation, load))
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			var zero V
+			return zero, res.Err
+		}
+		return res.Val.(V), nil
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Invalidate は match に一致するキーの値を無効化する
+// 無効化された値は次の Get で再計算され、再計算が終わるまでは古い値を返す
+// 無効化の前に開始した計算の結果も無効化された値として保存する（一致しないキーの計算には影響しない）
+func (c *Cache[K, V]) Invalidate(match func(key K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if match(key) {
+			e.stale = true
+			c.entries[key] = e
+			c.generations[key]++
+		}
+	}
+	// 値をまだ保持していない計算中のキー
+	for key := range c.loading {
+		if _, ok := c.entries[key]; !ok && match(key) {
+			c.generations[key]++
+		}
+	}
+}
+
+// loader は load を実行して結果を保存する関数を返す
+// 呼び出し元のキャンセルで同じ計算を待つ他の呼び出しが失敗しないよう、キャンセルを伝播しないコンテキストで実行する
+func (c *Cache[K, V]) loader(ctx context.Context, key K, generation uint64, load func(ctx context.Context) (V, error)) func() (any, error) {
+	return func() (any, error) {
+		c.mu.Lock()
+		c.loading[key]++
+		c.mu.Unlock()
+
+		value, err := load(context.WithoutCancel(ctx))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.loading[key]--
+		if c.loading[key] == 0 {
+			delete(c.loading, key)
+		}
+		if err != nil {
+			c.forget(key)
+			return nil, err
+		}
+
+		now := c.now()
+		c.entries[key] = entry[V]{
+			value:    value,
+			loadedAt: now,
+			stale:    c.generations[key] != generation,
+		}
+		// 古い値を返す期間も過ぎた値を削除する
+		for k, e := range c.entries {
+			if now.Sub(e.loadedAt) >= c.ttl+c.staleTTL {
+				delete(c.entries, k)
+				c.forget(k)
+			}
+		}
+		return value, nil
+	}
+}
+
+// forget は値も計算中の計算もなくなったキーの世代を削除する（c.mu を取得して呼び出す）
+func (c *Cache[K, V]) forget(key K) {
+	_, cached := c.entries[key]
+	if !cached && c.loading[key] == 0 {
+		delete(c.generations, key)
+	}
+}
+
+// flightKey は同時に実行する計算をまとめるためのキー
+// 無効化の後に開始した計算が、無効化の前に開始した計算の結果を受け取らないよう世代を含める
+func (c *Cache[K, V]) flightKey(key K, generation uint64) string {
+	return fmt.Sprintf("%d/%v", generation, key)
+}
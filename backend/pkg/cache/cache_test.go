@@ -0,0 +1,291 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"poketier/pkg/cache"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clock はテスト用の進められる時計
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newCache(t *testing.T) (*cache.Cache[string, int], *clock) {
+	t.Helper()
+	clk := &clock{now: time.Unix(1691513600, 0)}
+	c := cache.New[string, int](time.Minute, time.Hour)
+	c.SetNow(clk.Now)
+	return c, clk
+}
+
+// counter は呼び出し回数を値として返す load
+func counter() (func(ctx context.Context) (int, error), *atomic.Int32) {
+	var calls atomic.Int32
+	return func(ctx context.Context) (int, error) {
+		return int(calls.Add(1)), nil
+	}, &calls
+}
+
+// waitFor はバックグラウンドの再計算が反映されるまで待つ
+func waitFor(t *testing.T, c *cache.Cache[string, int], key string, want int) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		got, err := c.Get(context.Background(), key, func(ctx context.Context) (int, error) {
+			return 0, errors.New("unexpected load")
+		})
+		return err == nil && got == want
+	}, time.Second, time.Millisecond, "background refresh should be stored")
+}
+
+func TestCache_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 有効期限内は再計算せずに保存した値を返す事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, clk := newCache(t)
+		load, calls := counter()
+
+		// Act
+		first, err1 := c.Get(context.Background(), "season-1", load)
+		clk.Advance(59 * time.Second)
+		second, err2 := c.Get(context.Background(), "season-1", load)
+
+		// Assert
+		require.NoError(t, err1, "unexpected error occurred")
+		require.NoError(t, err2, "unexpected error occurred")
+		assert.Equal(t, 1, first, "first value should be loaded")
+		assert.Equal(t, 1, second, "cached value should be returned")
+		assert.Equal(t, int32(1), calls.Load(), "load should be called once")
+	})
+
+	t.Run("正常系: 有効期限切れの場合は古い値を返しつつバックグラウンドで再計算する事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, clk := newCache(t)
+		load, _ := counter()
+		_, err := c.Get(context.Background(), "season-1", load)
+		require.NoError(t, err, "unexpected error occurred")
+		clk.Advance(2 * time.Minute)
+
+		// Act
+		got, err := c.Get(context.Background(), "season-1", load)
+
+		// Assert
+		require.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, 1, got, "stale value should be returned")
+		waitFor(t, c, "season-1", 2)
+	})
+
+	t.Run("正常系: 古い値を返す期間も過ぎた場合は再計算を待つ事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, clk := newCache(t)
+		load, _ := counter()
+		_, err := c.Get(context.Background(), "season-1", load)
+		require.NoError(t, err, "unexpected error occurred")
+		clk.Advance(time.Minute + time.Hour)
+
+		// Act
+		got, err := c.Get(context.Background(), "season-1", load)
+
+		// Assert
+		require.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, 2, got, "value should be reloaded")
+	})
+
+	t.Run("正常系: 同じキーの同時の計算は1回にまとめられる事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		release := make(chan struct{})
+		var calls atomic.Int32
+		load := func(ctx context.Context) (int, error) {
+			calls.Add(1)
+			<-release
+			return 42, nil
+		}
+
+		// Act
+		const callers = 10
+		var wg sync.WaitGroup
+		results := make([]int, callers)
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = c.Get(context.Background(), "season-1", load)
+			}()
+		}
+		assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond, "load should start")
+		// 全ての呼び出しが計算を待つまで待機してから計算を完了させる
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		// Assert
+		assert.Equal(t, int32(1), calls.Load(), "concurrent misses should be collapsed")
+		for _, got := range results {
+			assert.Equal(t, 42, got, "every caller should receive the loaded value")
+		}
+	})
+
+	t.Run("異常系: 計算に失敗した場合はエラーを返し、結果を保存しない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		load, calls := counter()
+
+		// Act
+		_, err := c.Get(context.Background(), "season-1", func(ctx context.Context) (int, error) {
+			return 0, errors.New("load error")
+		})
+		got, err2 := c.Get(context.Background(), "season-1", load)
+
+		// Assert
+		assert.Error(t, err, "expected error but got none")
+		require.NoError(t, err2, "unexpected error occurred")
+		assert.Equal(t, 1, got, "failed load should not be cached")
+		assert.Equal(t, int32(1), calls.Load(), "load should be called after failure")
+	})
+
+	t.Run("異常系: 呼び出し元がキャンセルされた場合は計算の完了を待たない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		release := make(chan struct{})
+		defer close(release)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		_, err := c.Get(ctx, "season-1", func(ctx context.Context) (int, error) {
+			<-release
+			return 1, nil
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled, "canceled error should be returned")
+	})
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 無効化された値は古い値を返しつつ再計算され、一致しないキーは影響を受けない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		load1, _ := counter()
+		load2, calls2 := counter()
+		_, err := c.Get(context.Background(), "season-1", load1)
+		require.NoError(t, err, "unexpected error occurred")
+		_, err = c.Get(context.Background(), "season-2", load2)
+		require.NoError(t, err, "unexpected error occurred")
+
+		// Act
+		c.Invalidate(func(key string) bool { return key == "season-1" })
+		got, err := c.Get(context.Background(), "season-1", load1)
+		other, err2 := c.Get(context.Background(), "season-2", load2)
+
+		// Assert
+		require.NoError(t, err, "unexpected error occurred")
+		require.NoError(t, err2, "unexpected error occurred")
+		assert.Equal(t, 1, got, "invalidated value should be returned until refreshed")
+		waitFor(t, c, "season-1", 2)
+		assert.Equal(t, 1, other, "unmatched key should keep cached value")
+		assert.Equal(t, int32(1), calls2.Load(), "unmatched key should not be reloaded")
+	})
+
+	t.Run("正常系: 無効化の前に開始した計算の結果は無効化された値として保存される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = c.Get(context.Background(), "season-1", func(ctx context.Context) (int, error) {
+				close(started)
+				<-release
+				return 1, nil
+			})
+		}()
+		<-started
+
+		// Act
+		c.Invalidate(func(key string) bool { return true })
+		close(release)
+		<-done
+
+		// Assert
+		got, err := c.Get(context.Background(), "season-1", func(ctx context.Context) (int, error) {
+			return 2, nil
+		})
+		require.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, 1, got, "value loaded before invalidation should be returned as stale")
+		waitFor(t, c, "season-1", 2)
+	})
+
+	t.Run("正常系: 一致しないキーの無効化は計算中の結果に影響しない事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c, _ := newCache(t)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = c.Get(context.Background(), "season-1", func(ctx context.Context) (int, error) {
+				close(started)
+				<-release
+				return 1, nil
+			})
+		}()
+		<-started
+
+		// Act
+		c.Invalidate(func(key string) bool { return key == "season-2" })
+		close(release)
+		<-done
+
+		// Assert
+		reload, calls := counter()
+		got, err := c.Get(context.Background(), "season-1", reload)
+		require.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, 1, got, "value loaded during unrelated invalidation should be returned")
+		assert.Never(t, func() bool { return calls.Load() > 0 }, 50*time.Millisecond, time.Millisecond, "value should not be reloaded")
+	})
+}
@@ -0,0 +1,8 @@
+package cache
+
+import "time"
+
+// SetNow はテストで現在時刻を差し替える
+func (c *Cache[K, V]) SetNow(now func() time.Time) {
+	c.now = now
+}
@@ -22,6 +22,9 @@ var (
 	// 競合状態（409）
 	ErrConflict = errors.New("conflict")
 
+	// リクエスト過多（429）
+	ErrTooManyRequests = errors.New("too many requests")
+
 	// 内部サーバーエラー（500）
 	ErrInternal = errors.New("internal server error")
 )
@@ -91,3 +94,11 @@ func NewConflictError(message string, cause error) *DomainError {
 		Cause:   cause,
 	}
 }
+
+func NewTooManyRequestsError(message string, cause error) *DomainError {
+	return &DomainError{
+		Type:    ErrTooManyRequests,
+		Message: message,
+		Cause:   cause,
+	}
+}
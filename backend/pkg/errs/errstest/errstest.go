@@ -0,0 +1,20 @@
+// Package errstest はドメインエラーを検証するテスト用のヘルパーを提供する
+package errstest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"poketier/pkg/errs"
+)
+
+// AssertType はエラーがドメインエラーであり、その種類が want と一致することを検証する
+func AssertType(t *testing.T, err error, want error) bool {
+	t.Helper()
+	var domainErr *errs.DomainError
+	if !assert.ErrorAs(t, err, &domainErr, "error should be a domain error") {
+		return false
+	}
+	return assert.Equal(t, want, domainErr.Type, "domain error type does not match")
+}
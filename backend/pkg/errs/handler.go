@@ -22,12 +22,13 @@ var errorMappings = map[error]struct {
 	title  string
 	detail string
 }{
-	ErrBadRequest:   {http.StatusBadRequest, "Bad Request", "The request is invalid."},
-	ErrUnauthorized: {http.StatusUnauthorized, "Unauthorized", "Authentication is required."},
-	ErrForbidden:    {http.StatusForbidden, "Forbidden", "You do not have permission to perform this action."},
-	ErrNotFound:     {http.StatusNotFound, "Not Found", "The requested resource was not found."},
-	ErrTimeout:      {http.StatusRequestTimeout, "Request Timeout", "The request timed out."},
-	ErrConflict:     {http.StatusConflict, "Conflict", "A resource conflict occurred."},
+	ErrBadRequest:      {http.StatusBadRequest, "Bad Request", "The request is invalid."},
+	ErrUnauthorized:    {http.StatusUnauthorized, "Unauthorized", "Authentication is required."},
+	ErrForbidden:       {http.StatusForbidden, "Forbidden", "You do not have permission to perform this action."},
+	ErrNotFound:        {http.StatusNotFound, "Not Found", "The requested resource was not found."},
+	ErrTimeout:         {http.StatusRequestTimeout, "Request Timeout", "The request timed out."},
+	ErrConflict:        {http.StatusConflict, "Conflict", "A resource conflict occurred."},
+	ErrTooManyRequests: {http.StatusTooManyRequests, "Too Many Requests", "Too many requests. Please try again later."},
 }
 
 // HandleError はエラーを受け取り、適切なHTTPレスポンスを返します
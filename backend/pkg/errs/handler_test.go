@@ -98,6 +98,18 @@ func TestHandleError(t *testing.T) {
 				Detail: "A resource conflict occurred.",
 			},
 		},
+		{
+			caseName: "正常系_TooManyRequestsドメインエラー",
+			setupError: func() error {
+				return errs.NewTooManyRequestsError("account is locked", nil)
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedResponse: errs.ErrorResponse{
+				Title:  "Too Many Requests",
+				Status: http.StatusTooManyRequests,
+				Detail: "Too many requests. Please try again later.",
+			},
+		},
 		{
 			caseName: "正常系_未知のドメインエラー",
 			setupError: func() error {
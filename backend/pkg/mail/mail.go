@@ -0,0 +1,108 @@
+// Package mail はメール送信の抽象と、外部サービスを使わずに確認できる実装を提供します
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"poketier/pkg/log"
+)
+
+// Message は送信するメール（本文はプレーンテキスト）
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer はメールを送信する
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogMailer はメールを送信せずにログへ出力する実装（ローカル開発用）
+// 本文に確認用のリンクなどが含まれるため、本番では使用しない
+type LogMailer struct {
+	from   string
+	logger log.Logger
+}
+
+// NewLogMailer は送信元アドレスとロガーを指定してLogMailerを作成する
+func NewLogMailer(from string, logger log.Logger) *LogMailer {
+	return &LogMailer{
+		from:   from,
+		logger: logger,
+	}
+}
+
+// Send はメールの内容をログに出力する
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.Info("Mail sent", "from", m.from, "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
+
+// FileMailer はメールを1通ずつ .eml ファイルとしてディレクトリに書き出す実装（開発・テスト用）
+type FileMailer struct {
+	from string
+	dir  string
+	now  func() time.Time
+}
+
+// NewFileMailer は送信元アドレスと書き出し先のディレクトリを指定してFileMailerを作成する
+func NewFileMailer(from, dir string) *FileMailer {
+	return &FileMailer{
+		from: from,
+		dir:  dir,
+		now:  time.Now,
+	}
+}
+
+// Send はメールをRFC 5322形式のファイルとして書き出す
+// ファイル名は送信日時とランダムな接尾辞で、名前順に並べると送信順になる
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := validHeaderValue(msg.To); err != nil {
+		return err
+	}
+	if err := validHeaderValue(msg.Subject); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("failed to generate mail file name: %w", err)
+	}
+	now := m.now().UTC()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000Z"), hex.EncodeToString(suffix))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
+
+// validHeaderValue はヘッダーインジェクションを防ぐため、改行を含む値を拒否する
+func validHeaderValue(value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("mail header must not contain line breaks: %q", value)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package mail_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"poketier/pkg/log"
+	"poketier/pkg/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger は出力されたログを記録するテスト用のロガー
+type recordingLogger struct {
+	log.Logger
+	msg  string
+	args []any
+}
+
+func (l *recordingLogger) Info(msg string, args ...any) {
+	l.msg = msg
+	l.args = args
+}
+
+func TestLogMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: メールの内容をログに出力する", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		logger := &recordingLogger{}
+		mailer := mail.NewLogMailer("noreply@poketier.example", logger)
+
+		// Act
+		err := mailer.Send(context.Background(), mail.Message{To: "ash@example.com", Subject: "Hello", Body: "body"})
+
+		// Assert
+		require.NoError(t, err, "Send should not return error")
+		assert.Equal(t, "Mail sent", logger.msg, "log message should match")
+		assert.Equal(t, []any{"from", "noreply@poketier.example", "to", "ash@example.com", "subject", "Hello", "body", "body"}, logger.args, "log args should contain the message")
+	})
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: メールを1通ずつファイルに書き出す", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		dir := filepath.Join(t.TempDir(), "mails")
+		mailer := mail.NewFileMailer("noreply@poketier.example", dir)
+
+		// Act
+		require.NoError(t, mailer.Send(context.Background(), mail.Message{To: "ash@example.com", Subject: "First", Body: "line1\nline2"}), "Send should not return error")
+		require.NoError(t, mailer.Send(context.Background(), mail.Message{To: "misty@example.com", Subject: "Second", Body: "body"}), "Send should not return error")
+
+		// Assert
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err, "mail directory should exist")
+		require.Len(t, entries, 2, "each message should be written to its own file")
+		assert.True(t, strings.HasSuffix(entries[0].Name(), ".eml"), "mail file should have .eml extension")
+
+		data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+		require.NoError(t, err, "mail file should be readable")
+		content := string(data)
+		assert.Contains(t, content, "From: noreply@poketier.example\r\n", "mail should contain From header")
+		assert.Contains(t, content, "To: ash@example.com\r\n", "mail should contain To header")
+		assert.Contains(t, content, "Subject: First\r\n", "mail should contain Subject header")
+		assert.True(t, strings.HasSuffix(content, "\r\n\r\nline1\r\nline2"), "mail body should follow headers with CRLF line endings")
+	})
+
+	t.Run("異常系: ヘッダーに改行を含む場合は書き出さない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		dir := t.TempDir()
+		mailer := mail.NewFileMailer("noreply@poketier.example", dir)
+
+		// Act
+		err := mailer.Send(context.Background(), mail.Message{To: "ash@example.com\r\nBcc: evil@example.com", Subject: "Hello", Body: "body"})
+
+		// Assert
+		assert.Error(t, err, "Send should reject header injection")
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries, "no mail file should be written")
+	})
+}
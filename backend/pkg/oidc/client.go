@@ -0,0 +1,249 @@
+// Package oidc は外部IDプロバイダー（OpenID Connect）での認可コードフロー + PKCE のクライアントと、
+// 開発・テスト用のモックプロバイダーを提供します
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// discoveryPath はプロバイダーのメタデータの取得先（OpenID Connect Discovery 1.0 4）
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// maxResponseSize はプロバイダーのレスポンスとして読み込む最大バイト数
+	maxResponseSize = 1 << 20
+
+	defaultHTTPTimeout = 10 * time.Second
+)
+
+var (
+	// ErrInvalidGrant は認可コードが不正・期限切れ・使用済みであるか、code_verifier が一致しないことを表す
+	ErrInvalidGrant = errors.New("invalid authorization grant")
+	// ErrInvalidIDToken はIDトークンの署名またはクレーム（発行者・対象者・有効期限・nonce）が不正であることを表す
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+// defaultScopes はスコープが指定されていない場合に要求するスコープ
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// Config は外部IDプロバイダーのクライアント設定
+// ClientSecret が空の場合はパブリッククライアントとして、PKCE のみで認可コードを交換する
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	HTTPClient   *http.Client
+}
+
+// AuthRequest は認可リクエストのパラメータ
+// LoginHint はプロバイダーのログイン画面に渡すヒント（任意）
+type AuthRequest struct {
+	State         string
+	Nonce         string
+	CodeChallenge string
+	LoginHint     string
+}
+
+// Identity はIDトークンで確認した外部IDプロバイダーのアカウント
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Client は1つの外部IDプロバイダーに対する Relying Party
+// プロバイダーのメタデータと署名鍵は初回の使用時に取得してキャッシュする
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu       sync.Mutex
+	metadata *providerMetadata
+	keys     *keySet
+	// keysFetchedAt は署名鍵の取得を最後に試みた時刻
+	keysFetchedAt time.Time
+}
+
+// providerMetadata はプロバイダーのメタデータのうち、認可コードフローで使用する項目
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// tokenResponse はトークンエンドポイントのレスポンス（RFC 6749 5.1 / 5.2）
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+	Error   string `json:"error"`
+}
+
+// NewClient は設定からClientを作成する
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("issuer is required")
+	}
+	if cfg.ClientID == "" {
+		return nil, errors.New("client id is required")
+	}
+	redirectURL, err := url.Parse(cfg.RedirectURL)
+	if err != nil || !redirectURL.IsAbs() {
+		return nil, fmt.Errorf("redirect url must be an absolute url: %q", cfg.RedirectURL)
+	}
+
+	cfg.Issuer = strings.TrimSuffix(cfg.Issuer, "/")
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaultScopes
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+
+	return &Client{
+		cfg:        cfg,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// AuthCodeURL はユーザーをリダイレクトさせる認可エンドポイントのURLを返す
+func (c *Client) AuthCodeURL(ctx context.Context, req AuthRequest) (string, error) {
+	metadata, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.cfg.ClientID)
+	query.Set("redirect_uri", c.cfg.RedirectURL)
+	query.Set("scope", strings.Join(c.cfg.Scopes, " "))
+	query.Set("state", req.State)
+	query.Set("nonce", req.Nonce)
+	query.Set("code_challenge", req.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+	if req.LoginHint != "" {
+		query.Set("login_hint", req.LoginHint)
+	}
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange は認可コードをトークンエンドポイントで交換し、IDトークンを検証してアカウントを返す
+// nonce は認可リクエストで送ったものと一致する必要がある
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	metadata, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.cfg.RedirectURL)
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.ClientSecret != "" {
+		// RFC 6749 2.3.1: クライアントIDとシークレットはフォームエンコードしてからBasic認証に使用する
+		req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+	}
+
+	var body tokenResponse
+	status, err := c.doJSON(req, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	if status != http.StatusOK {
+		if body.Error == "invalid_grant" {
+			return nil, ErrInvalidGrant
+		}
+		return nil, fmt.Errorf("token endpoint returned status %d: %s", status, body.Error)
+	}
+	if body.IDToken == "" {
+		return nil, fmt.Errorf("%w: id_token is missing", ErrInvalidIDToken)
+	}
+
+	return c.verifyIDToken(ctx, metadata, body.IDToken, nonce)
+}
+
+// discover はプロバイダーのメタデータを取得する。取得に成功した場合はキャッシュする
+func (c *Client) discover(ctx context.Context) (*providerMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.metadata != nil {
+		return c.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.Issuer+discoveryPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	var metadata providerMetadata
+	status, err := c.doJSON(req, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch provider metadata: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch provider metadata: status %d", status)
+	}
+
+	// OpenID Connect Discovery 1.0 4.3: メタデータの issuer は設定した発行者と一致しなければならない
+	if metadata.Issuer != c.cfg.Issuer {
+		return nil, fmt.Errorf("provider metadata issuer %q does not match %q", metadata.Issuer, c.cfg.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("provider metadata is missing required endpoints")
+	}
+
+	c.metadata = &metadata
+	return c.metadata, nil
+}
+
+// doJSON はリクエストを送信し、レスポンスのJSONをデコードしてステータスコードを返す
+// エラーレスポンスのJSONもデコードする
+func (c *Client) doJSON(req *http.Request, v any) (int, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
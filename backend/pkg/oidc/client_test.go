@@ -0,0 +1,384 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"poketier/pkg/oidc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID    = "poketier-test"
+	testRedirectURL = "http://localhost:3000/auth/callback/mock"
+)
+
+// newTestProvider はモックプロバイダーをテスト用のHTTPサーバーで起動する
+func newTestProvider(t *testing.T) (*oidc.MockProvider, *httptest.Server) {
+	t.Helper()
+
+	var provider *oidc.MockProvider
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provider.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := oidc.NewMockProvider(oidc.MockProviderConfig{Issuer: server.URL, ClientID: testClientID})
+	require.NoError(t, err, "NewMockProvider should not return error")
+	return provider, server
+}
+
+// newTestClient はモックプロバイダーに接続するClientを作成する
+func newTestClient(t *testing.T, issuer string) *oidc.Client {
+	t.Helper()
+
+	client, err := oidc.NewClient(oidc.Config{Issuer: issuer, ClientID: testClientID, RedirectURL: testRedirectURL})
+	require.NoError(t, err, "NewClient should not return error")
+	return client
+}
+
+// authorize はブラウザの代わりに認可エンドポイントにアクセスし、リダイレクト先の認可コードと state を返す
+func authorize(t *testing.T, authURL string) (string, string) {
+	t.Helper()
+
+	httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := httpClient.Get(authURL)
+	require.NoError(t, err, "authorization request should not fail")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode, "authorization endpoint should redirect")
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err, "location should be a url")
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		cfg      oidc.Config
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: 必須項目を設定",
+			cfg:      oidc.Config{Issuer: "https://accounts.example.com", ClientID: testClientID, RedirectURL: testRedirectURL},
+		},
+		{
+			caseName: "異常系: 発行者が未設定",
+			cfg:      oidc.Config{ClientID: testClientID, RedirectURL: testRedirectURL},
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: クライアントIDが未設定",
+			cfg:      oidc.Config{Issuer: "https://accounts.example.com", RedirectURL: testRedirectURL},
+			wantErr:  true,
+		},
+		{
+			caseName: "異常系: リダイレクトURLが相対URL",
+			cfg:      oidc.Config{Issuer: "https://accounts.example.com", ClientID: testClientID, RedirectURL: "/auth/callback"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			client, err := oidc.NewClient(tt.cfg)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "NewClient should return error")
+				return
+			}
+			assert.NoError(t, err, "NewClient should not return error")
+			assert.NotNil(t, client, "client should not be nil")
+		})
+	}
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 認可コードフロー + PKCE のパラメータを付けたURLを返す", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		_, server := newTestProvider(t)
+		client := newTestClient(t, server.URL)
+
+		// Act
+		authURL, err := client.AuthCodeURL(context.Background(), oidc.AuthRequest{
+			State:         "state-value",
+			Nonce:         "nonce-value",
+			CodeChallenge: "challenge-value",
+			LoginHint:     "ash",
+		})
+
+		// Assert
+		require.NoError(t, err, "AuthCodeURL should not return error")
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err, "authorization url should be a url")
+		assert.Equal(t, server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path, "endpoint should match the discovered one")
+		query := parsed.Query()
+		assert.Equal(t, "code", query.Get("response_type"), "response_type should be code")
+		assert.Equal(t, testClientID, query.Get("client_id"), "client_id should match")
+		assert.Equal(t, testRedirectURL, query.Get("redirect_uri"), "redirect_uri should match")
+		assert.Equal(t, "openid email profile", query.Get("scope"), "scope should be the default scopes")
+		assert.Equal(t, "state-value", query.Get("state"), "state should match")
+		assert.Equal(t, "nonce-value", query.Get("nonce"), "nonce should match")
+		assert.Equal(t, "challenge-value", query.Get("code_challenge"), "code_challenge should match")
+		assert.Equal(t, "S256", query.Get("code_challenge_method"), "code_challenge_method should be S256")
+		assert.Equal(t, "ash", query.Get("login_hint"), "login_hint should match")
+	})
+
+	t.Run("異常系: メタデータの発行者が設定と一致しない", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		_, server := newTestProvider(t)
+		client := newTestClient(t, server.URL+"/other")
+
+		// Act
+		_, err := client.AuthCodeURL(context.Background(), oidc.AuthRequest{State: "s", Nonce: "n", CodeChallenge: "c"})
+
+		// Assert
+		assert.Error(t, err, "AuthCodeURL should return error")
+	})
+}
+
+func TestClient_Exchange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		// loginHint は認可リクエストの login_hint
+		loginHint string
+		// exchange は認可コードと code_verifier で交換を実行する（省略時は認可リクエストと同じ値で1回交換する）
+		exchange func(client *oidc.Client, code, codeVerifier string) (*oidc.Identity, error)
+		// setup は認可後・交換前にプロバイダーやクライアントの時刻を差し替える
+		setup   func(provider *oidc.MockProvider, client *oidc.Client)
+		want    *oidc.Identity
+		wantErr error
+	}{
+		{
+			caseName:  "正常系: IDトークンを検証してアカウントを返す",
+			loginHint: "ash",
+			want:      &oidc.Identity{Subject: "ash", Email: "ash@example.com", EmailVerified: true, Name: "ash"},
+		},
+		{
+			caseName:  "正常系: login_hint がメールアドレスの場合はそのメールアドレスを返す",
+			loginHint: "misty@example.org",
+			want:      &oidc.Identity{Subject: "misty@example.org", Email: "misty@example.org", EmailVerified: true, Name: "misty"},
+		},
+		{
+			caseName: "正常系: login_hint を省略した場合は mock-user でログインする",
+			want:     &oidc.Identity{Subject: "mock-user", Email: "mock-user@example.com", EmailVerified: true, Name: "mock-user"},
+		},
+		{
+			caseName: "異常系: code_verifier が一致しない",
+			exchange: func(client *oidc.Client, code, _ string) (*oidc.Identity, error) {
+				return client.Exchange(context.Background(), code, "wrong-verifier-wrong-verifier-wrong-verifier", "nonce-value")
+			},
+			wantErr: oidc.ErrInvalidGrant,
+		},
+		{
+			caseName: "異常系: 認可コードを2回使用する",
+			exchange: func(client *oidc.Client, code, codeVerifier string) (*oidc.Identity, error) {
+				if _, err := client.Exchange(context.Background(), code, codeVerifier, "nonce-value"); err != nil {
+					return nil, err
+				}
+				return client.Exchange(context.Background(), code, codeVerifier, "nonce-value")
+			},
+			wantErr: oidc.ErrInvalidGrant,
+		},
+		{
+			caseName: "異常系: 認可コードの有効期限切れ",
+			setup: func(provider *oidc.MockProvider, _ *oidc.Client) {
+				provider.SetNow(func() time.Time { return time.Now().Add(2 * time.Minute) })
+			},
+			wantErr: oidc.ErrInvalidGrant,
+		},
+		{
+			caseName: "異常系: nonce が一致しない",
+			exchange: func(client *oidc.Client, code, codeVerifier string) (*oidc.Identity, error) {
+				return client.Exchange(context.Background(), code, codeVerifier, "other-nonce")
+			},
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			caseName: "異常系: IDトークンの有効期限切れ",
+			setup: func(_ *oidc.MockProvider, client *oidc.Client) {
+				client.SetNow(func() time.Time { return time.Now().Add(time.Hour) })
+			},
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			provider, server := newTestProvider(t)
+			client := newTestClient(t, server.URL)
+			codeVerifier, err := oidc.NewRandomValue()
+			require.NoError(t, err, "NewRandomValue should not return error")
+			authURL, err := client.AuthCodeURL(context.Background(), oidc.AuthRequest{
+				State:         "state-value",
+				Nonce:         "nonce-value",
+				CodeChallenge: oidc.CodeChallengeS256(codeVerifier),
+				LoginHint:     tt.loginHint,
+			})
+			require.NoError(t, err, "AuthCodeURL should not return error")
+			code, state := authorize(t, authURL)
+			require.Equal(t, "state-value", state, "state should be returned as is")
+			if tt.setup != nil {
+				tt.setup(provider, client)
+			}
+			exchange := tt.exchange
+			if exchange == nil {
+				exchange = func(client *oidc.Client, code, codeVerifier string) (*oidc.Identity, error) {
+					return client.Exchange(context.Background(), code, codeVerifier, "nonce-value")
+				}
+			}
+
+			// Act
+			identity, err := exchange(client, code, codeVerifier)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "error should be %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err, "Exchange should not return error")
+			assert.Equal(t, tt.want, identity, "identity should match")
+		})
+	}
+}
+
+func TestClient_SigningKey(t *testing.T) {
+	t.Parallel()
+
+	type call struct {
+		// elapsed は最初の呼び出しからの経過時間
+		elapsed time.Duration
+		kid     string
+		wantErr error
+	}
+
+	tests := []struct {
+		caseName    string
+		calls       []call
+		wantFetches int
+	}{
+		{
+			caseName: "正常系: 既知の kid は取得済みの鍵を返し、再取得しない",
+			calls: []call{
+				{kid: "mock-oidc-key"},
+				{elapsed: time.Minute, kid: "mock-oidc-key"},
+			},
+			wantFetches: 1,
+		},
+		{
+			caseName: "異常系: 未知の kid でも前回の取得から30秒以内は再取得しない",
+			calls: []call{
+				{kid: "mock-oidc-key"},
+				{elapsed: time.Second, kid: "unknown-key", wantErr: oidc.ErrInvalidIDToken},
+				{elapsed: 29 * time.Second, kid: "unknown-key", wantErr: oidc.ErrInvalidIDToken},
+			},
+			wantFetches: 1,
+		},
+		{
+			caseName: "異常系: 未知の kid で前回の取得から30秒経過している場合は1回だけ再取得する",
+			calls: []call{
+				{kid: "mock-oidc-key"},
+				{elapsed: 30 * time.Second, kid: "unknown-key", wantErr: oidc.ErrInvalidIDToken},
+				{elapsed: 31 * time.Second, kid: "unknown-key", wantErr: oidc.ErrInvalidIDToken},
+			},
+			wantFetches: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var provider *oidc.MockProvider
+			var fetches atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/jwks" {
+					fetches.Add(1)
+				}
+				provider.ServeHTTP(w, r)
+			}))
+			t.Cleanup(server.Close)
+			provider, err := oidc.NewMockProvider(oidc.MockProviderConfig{Issuer: server.URL, ClientID: testClientID})
+			require.NoError(t, err, "NewMockProvider should not return error")
+			client := newTestClient(t, server.URL)
+			start := time.Now()
+
+			for _, c := range tt.calls {
+				client.SetNow(func() time.Time { return start.Add(c.elapsed) })
+
+				// Act
+				err := client.SigningKey(context.Background(), c.kid)
+
+				// Assert
+				if c.wantErr != nil {
+					assert.ErrorIs(t, err, c.wantErr, "SigningKey should return %v", c.wantErr)
+					continue
+				}
+				assert.NoError(t, err, "SigningKey should not return error")
+			}
+			assert.Equal(t, tt.wantFetches, int(fetches.Load()), "jwks fetch count does not match")
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 登録したプロバイダー名を昇順で返す", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		registry := oidc.NewRegistry()
+		registry.Register("mock", newTestClient(t, "https://mock.example.com"))
+		registry.Register("google", newTestClient(t, "https://accounts.google.com"))
+
+		// Act
+		providers := registry.Providers()
+
+		// Assert
+		assert.Equal(t, []string{"google", "mock"}, providers, "providers should be sorted")
+	})
+
+	t.Run("異常系: 登録されていないプロバイダー", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		registry := oidc.NewRegistry()
+
+		// Act
+		_, urlErr := registry.AuthCodeURL(context.Background(), "unknown", oidc.AuthRequest{})
+		_, exchangeErr := registry.Exchange(context.Background(), "unknown", "code", "verifier", "nonce")
+
+		// Assert
+		assert.ErrorIs(t, urlErr, oidc.ErrUnknownProvider, "AuthCodeURL should return ErrUnknownProvider")
+		assert.ErrorIs(t, exchangeErr, oidc.ErrUnknownProvider, "Exchange should return ErrUnknownProvider")
+	})
+}
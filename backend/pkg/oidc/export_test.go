@@ -0,0 +1,26 @@
+package oidc
+
+import (
+	"context"
+	"time"
+)
+
+// SetNow はテストで現在時刻を差し替える
+func (c *Client) SetNow(now func() time.Time) {
+	c.now = now
+}
+
+// SetNow はテストで現在時刻を差し替える
+func (p *MockProvider) SetNow(now func() time.Time) {
+	p.now = now
+}
+
+// SigningKey はテストで kid に一致する署名鍵を取得する
+func (c *Client) SigningKey(ctx context.Context, kid string) error {
+	metadata, err := c.discover(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = c.signingKey(ctx, metadata, kid)
+	return err
+}
@@ -0,0 +1,290 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	algRS256 = "RS256"
+
+	// clockSkew はIDトークンの有効期間の判定で許容するプロバイダーとの時刻のずれ
+	clockSkew = 30 * time.Second
+
+	// keyRefreshInterval は署名鍵を再取得する最小の間隔
+	// 未知の kid を含むIDトークンでプロバイダーへの取得を繰り返し発生させられないよう、間隔内は再取得しない
+	keyRefreshInterval = 30 * time.Second
+)
+
+// keySet はプロバイダーの署名鍵（kid ごとのRSA公開鍵）
+type keySet struct {
+	keys map[string]*rsa.PublicKey
+}
+
+// jwks はJWK Set（RFC 7517 5）
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk はJSON Web Key。IDトークンの検証にはRSA公開鍵（kty=RSA）のみを使用する
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// idTokenHeader はIDトークンのJOSEヘッダー
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// idTokenClaims はIDトークンのペイロード（OpenID Connect Core 1.0 2 / 5.1）
+type idTokenClaims struct {
+	Issuer          string       `json:"iss"`
+	Subject         string       `json:"sub"`
+	Audience        audience     `json:"aud"`
+	AuthorizedParty string       `json:"azp"`
+	ExpiresAt       *int64       `json:"exp"`
+	IssuedAt        *int64       `json:"iat"`
+	Nonce           string       `json:"nonce"`
+	Email           string       `json:"email"`
+	EmailVerified   flexibleBool `json:"email_verified"`
+	Name            string       `json:"name"`
+}
+
+// audience は文字列または文字列の配列で表される aud クレーム
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// flexibleBool は真偽値または "true" / "false" の文字列で表されるクレーム
+// プロバイダーによっては email_verified を文字列で返すため両方を受け付ける
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value bool
+	if err := json.Unmarshal(data, &value); err == nil {
+		*b = flexibleBool(value)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*b = flexibleBool(s == "true")
+	return nil
+}
+
+// verifyIDToken はIDトークンの署名とクレームを検証し、アカウントを返す
+func (c *Client) verifyIDToken(ctx context.Context, metadata *providerMetadata, token, nonce string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidIDToken)
+	}
+
+	var h idTokenHeader
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidIDToken, err)
+	}
+	// アルゴリズムの取り違え（none や共通鍵での署名）を防ぐため RS256 のみを受け付ける
+	if h.Alg != algRS256 {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidIDToken, h.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrInvalidIDToken, err)
+	}
+
+	publicKey, err := c.signingKey(ctx, metadata, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidIDToken)
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrInvalidIDToken, err)
+	}
+
+	if err := c.validateClaims(metadata, claims, nonce); err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// validateClaims は発行者・対象者・有効期限・nonce を検証する（OpenID Connect Core 1.0 3.1.3.7）
+func (c *Client) validateClaims(metadata *providerMetadata, claims idTokenClaims, nonce string) error {
+	if claims.Issuer != metadata.Issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidIDToken)
+	}
+	if !slices.Contains(claims.Audience, c.cfg.ClientID) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidIDToken)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != c.cfg.ClientID {
+		return fmt.Errorf("%w: unexpected authorized party", ErrInvalidIDToken)
+	}
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("%w: exp is required", ErrInvalidIDToken)
+	}
+	if !c.now().Before(time.Unix(*claims.ExpiresAt, 0).Add(clockSkew)) {
+		return fmt.Errorf("%w: token is expired", ErrInvalidIDToken)
+	}
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return fmt.Errorf("%w: nonce does not match", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return fmt.Errorf("%w: sub is required", ErrInvalidIDToken)
+	}
+	return nil
+}
+
+// signingKey は kid に一致する署名鍵を返す
+// キャッシュに見つからない場合は、プロバイダーの鍵のローテーションに備えて1回だけ再取得する
+// 前回の取得から keyRefreshInterval が経過していない場合は再取得せず、未知の鍵として扱う
+func (c *Client) signingKey(ctx context.Context, metadata *providerMetadata, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if c.keys != nil {
+		if key, ok := c.keys.find(kid); ok {
+			return key, nil
+		}
+	}
+	if !c.keysFetchedAt.IsZero() && now.Sub(c.keysFetchedAt) < keyRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidIDToken, kid)
+	}
+
+	// 取得に失敗した場合もプロバイダーへの取得を繰り返さないよう、取得を試みた時刻を記録する
+	c.keysFetchedAt = now
+	keys, err := c.fetchKeys(ctx, metadata.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	c.keys = keys
+
+	key, ok := keys.find(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidIDToken, kid)
+	}
+	return key, nil
+}
+
+// find は kid に一致する鍵を返す。kid が空の場合は鍵が1つだけのときにその鍵を返す
+func (s *keySet) find(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" {
+		if len(s.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// fetchKeys はプロバイダーのJWKSから署名用のRSA公開鍵を取得する
+// 暗号化用（use=enc）やRSA以外の鍵は読み飛ばす
+func (c *Client) fetchKeys(ctx context.Context, jwksURI string) (*keySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwks request: %w", err)
+	}
+
+	var set jwks
+	status, err := c.doJSON(req, &set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: status %d", status)
+	}
+
+	keys := &keySet{keys: make(map[string]*rsa.PublicKey, len(set.Keys))}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || k.Use == "enc" || (k.Alg != "" && k.Alg != algRS256) {
+			continue
+		}
+		publicKey, err := k.toPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", k.Kid, err)
+		}
+		keys.keys[k.Kid] = publicKey
+	}
+	return keys, nil
+}
+
+// toPublicKey はJWKをRSA公開鍵に変換する
+func (k jwk) toPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := decodeBigInt(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+// decodeBigInt はbase64url（パディングなし）でエンコードされた符号なし整数をデコードする
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// decodeSegment はbase64url（パディングなし）でエンコードされたJSONをデコードする
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,294 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// mockKeyID はモックプロバイダーの署名鍵の kid
+	mockKeyID = "mock-oidc-key"
+
+	// mockDefaultSubject は login_hint が指定されない場合にログインするアカウント
+	mockDefaultSubject = "mock-user"
+
+	mockCodeTTL    = time.Minute
+	mockIDTokenTTL = 5 * time.Minute
+)
+
+// MockProviderConfig はモックプロバイダーの設定
+// Issuer はモックプロバイダーを公開するURL（例: http://localhost:8080/mock-oidc）
+type MockProviderConfig struct {
+	Issuer   string
+	ClientID string
+}
+
+// MockProvider は開発・テスト用のOIDCプロバイダー
+// 認可エンドポイントはログイン画面を表示せずに、login_hint のアカウント（省略時は mock-user）で即座に認可する
+// login_hint にメールアドレスを指定した場合はそのメールアドレスを、それ以外は <login_hint>@example.com を確認済みのメールアドレスとして返す
+// 本番環境では使用しない
+type MockProvider struct {
+	issuer   string
+	clientID string
+	key      *rsa.PrivateKey
+	now      func() time.Time
+
+	mu    sync.Mutex
+	codes map[string]mockAuthorization
+}
+
+// mockAuthorization は発行した認可コードに紐づく認可リクエスト
+type mockAuthorization struct {
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	subject       string
+	expiresAt     time.Time
+}
+
+// NewMockProvider は設定からMockProviderを作成する。署名鍵は起動ごとに生成する
+func NewMockProvider(cfg MockProviderConfig) (*MockProvider, error) {
+	issuerURL, err := url.Parse(cfg.Issuer)
+	if err != nil || !issuerURL.IsAbs() {
+		return nil, fmt.Errorf("issuer must be an absolute url: %q", cfg.Issuer)
+	}
+	if cfg.ClientID == "" {
+		return nil, errors.New("client id is required")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	return &MockProvider{
+		issuer:   strings.TrimSuffix(cfg.Issuer, "/"),
+		clientID: cfg.ClientID,
+		key:      key,
+		now:      time.Now,
+		codes:    make(map[string]mockAuthorization),
+	}, nil
+}
+
+// ServeHTTP はモックプロバイダーのエンドポイントを処理する
+// パスは Issuer からの相対パスで受け取るため、サブパスで公開する場合は http.StripPrefix と組み合わせる
+func (p *MockProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case discoveryPath:
+		p.handleDiscovery(w, r)
+	case "/authorize":
+		p.handleAuthorize(w, r)
+	case "/token":
+		p.handleToken(w, r)
+	case "/jwks":
+		p.handleJWKS(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleDiscovery はプロバイダーのメタデータを返す
+func (p *MockProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{algRS256},
+		"scopes_supported":                      defaultScopes,
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"none"},
+	})
+}
+
+// handleAuthorize は認可リクエストを検証し、認可コードを付けて redirect_uri にリダイレクトする
+func (p *MockProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if query.Get("client_id") != p.clientID {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+	if query.Get("response_type") != "code" {
+		http.Error(w, "unsupported response_type", http.StatusBadRequest)
+		return
+	}
+	if !slices.Contains(strings.Fields(query.Get("scope")), "openid") {
+		http.Error(w, "scope must include openid", http.StatusBadRequest)
+		return
+	}
+	if query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "code_challenge with S256 is required", http.StatusBadRequest)
+		return
+	}
+
+	subject := query.Get("login_hint")
+	if subject == "" {
+		subject = mockDefaultSubject
+	}
+
+	code, err := NewRandomValue()
+	if err != nil {
+		http.Error(w, "failed to issue code", http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.codes[code] = mockAuthorization{
+		redirectURI:   redirectURI.String(),
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+		subject:       subject,
+		expiresAt:     p.now().Add(mockCodeTTL),
+	}
+	p.mu.Unlock()
+
+	callback := redirectURI.Query()
+	callback.Set("code", code)
+	if state := query.Get("state"); state != "" {
+		callback.Set("state", state)
+	}
+	redirectURI.RawQuery = callback.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// handleToken は認可コードを検証し、IDトークンを発行する
+// 認可コードは1回のみ使用でき、redirect_uri と code_verifier が認可リクエストと一致する必要がある
+func (p *MockProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	if r.PostForm.Get("client_id") != p.clientID {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	authorization, ok := p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+
+	challenge := CodeChallengeS256(r.PostForm.Get("code_verifier"))
+	if !ok ||
+		!p.now().Before(authorization.expiresAt) ||
+		r.PostForm.Get("redirect_uri") != authorization.redirectURI ||
+		subtle.ConstantTimeCompare([]byte(challenge), []byte(authorization.codeChallenge)) != 1 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	idToken, err := p.signIDToken(authorization)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	accessToken, err := NewRandomValue()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(mockIDTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// handleJWKS は署名鍵の公開鍵を返す
+func (p *MockProvider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	publicKey := p.key.PublicKey
+	writeJSON(w, http.StatusOK, jwks{Keys: []jwk{{
+		Kty: "RSA",
+		Kid: mockKeyID,
+		Alg: algRS256,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}}})
+}
+
+// signIDToken は認可リクエストのアカウントのIDトークンを RS256 で署名する
+func (p *MockProvider) signIDToken(authorization mockAuthorization) (string, error) {
+	now := p.now()
+
+	email := authorization.subject
+	if !strings.Contains(email, "@") {
+		email += "@example.com"
+	}
+	name, _, _ := strings.Cut(authorization.subject, "@")
+
+	claims := map[string]any{
+		"iss":            p.issuer,
+		"sub":            authorization.subject,
+		"aud":            p.clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(mockIDTokenTTL).Unix(),
+		"email":          email,
+		"email_verified": true,
+		"name":           name,
+	}
+	if authorization.nonce != "" {
+		claims["nonce"] = authorization.nonce
+	}
+
+	headerJSON, err := json.Marshal(map[string]string{"alg": algRS256, "kid": mockKeyID, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign id token: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// writeJSON はステータスコードとJSONのレスポンスを書き込む
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
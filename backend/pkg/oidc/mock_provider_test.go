@@ -0,0 +1,84 @@
+package oidc_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockProvider_Authorize(t *testing.T) {
+	t.Parallel()
+
+	validQuery := func() url.Values {
+		return url.Values{
+			"response_type":         {"code"},
+			"client_id":             {testClientID},
+			"redirect_uri":          {testRedirectURL},
+			"scope":                 {"openid email"},
+			"state":                 {"state-value"},
+			"nonce":                 {"nonce-value"},
+			"code_challenge":        {"challenge-value"},
+			"code_challenge_method": {"S256"},
+		}
+	}
+
+	tests := []struct {
+		caseName   string
+		modify     func(q url.Values)
+		wantStatus int
+	}{
+		{
+			caseName:   "正常系: 認可コードを付けてリダイレクトする",
+			modify:     func(url.Values) {},
+			wantStatus: http.StatusFound,
+		},
+		{
+			caseName:   "異常系: 登録されていないクライアントID",
+			modify:     func(q url.Values) { q.Set("client_id", "unknown") },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			caseName:   "異常系: scope に openid が含まれない",
+			modify:     func(q url.Values) { q.Set("scope", "email") },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			caseName:   "異常系: code_challenge がない",
+			modify:     func(q url.Values) { q.Del("code_challenge") },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			caseName:   "異常系: code_challenge_method が plain",
+			modify:     func(q url.Values) { q.Set("code_challenge_method", "plain") },
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			provider, _ := newTestProvider(t)
+			query := validQuery()
+			tt.modify(query)
+			req := httptest.NewRequest(http.MethodGet, "/authorize?"+query.Encode(), nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			provider.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tt.wantStatus, w.Code, "status should match")
+			if tt.wantStatus == http.StatusFound {
+				location, err := url.Parse(w.Header().Get("Location"))
+				assert.NoError(t, err, "location should be a url")
+				assert.NotEmpty(t, location.Query().Get("code"), "code should be issued")
+				assert.Equal(t, "state-value", location.Query().Get("state"), "state should be returned as is")
+			}
+		})
+	}
+}
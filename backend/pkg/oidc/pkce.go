@@ -0,0 +1,24 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// NewRandomValue は state・nonce・code_verifier に使用する推測できない値を生成する
+// 32バイトの乱数をbase64url（パディングなし）でエンコードするため43文字になる（RFC 7636 4.1 の範囲内）
+func NewRandomValue() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallengeS256 は code_verifier から S256 方式の code_challenge を計算する（RFC 7636 4.2）
+func CodeChallengeS256(codeVerifier string) string {
+	digest := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
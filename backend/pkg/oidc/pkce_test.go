@@ -0,0 +1,43 @@
+package oidc_test
+
+import (
+	"testing"
+
+	"poketier/pkg/oidc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeChallengeS256(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: RFC 7636 Appendix B の例と一致する", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		challenge := oidc.CodeChallengeS256("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+
+		// Assert
+		assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", challenge, "challenge should match the RFC example")
+	})
+}
+
+func TestNewRandomValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 43文字のbase64url文字列を毎回異なる値で生成する", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		first, err := oidc.NewRandomValue()
+		require.NoError(t, err, "NewRandomValue should not return error")
+		second, err := oidc.NewRandomValue()
+		require.NoError(t, err, "NewRandomValue should not return error")
+
+		// Assert
+		assert.Len(t, first, 43, "value should be 43 characters")
+		assert.Regexp(t, `^[A-Za-z0-9_-]+$`, first, "value should be base64url without padding")
+		assert.NotEqual(t, first, second, "values should differ")
+	})
+}
@@ -0,0 +1,53 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// ErrUnknownProvider は指定された名前のプロバイダーが登録されていないことを表す
+var ErrUnknownProvider = errors.New("unknown identity provider")
+
+// Registry はプロバイダー名（例: google, mock）ごとのClient
+type Registry struct {
+	clients map[string]*Client
+}
+
+// NewRegistry は空のRegistryを作成する
+func NewRegistry() *Registry {
+	return &Registry{clients: make(map[string]*Client)}
+}
+
+// Register はプロバイダー名でClientを登録する。同じ名前で登録した場合は上書きする
+func (r *Registry) Register(name string, client *Client) {
+	r.clients[name] = client
+}
+
+// Providers は登録されているプロバイダー名を昇順で返す
+func (r *Registry) Providers() []string {
+	names := make([]string, 0, len(r.clients))
+	for name := range r.clients {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// AuthCodeURL は指定したプロバイダーの認可エンドポイントのURLを返す
+func (r *Registry) AuthCodeURL(ctx context.Context, provider string, req AuthRequest) (string, error) {
+	client, ok := r.clients[provider]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+	return client.AuthCodeURL(ctx, req)
+}
+
+// Exchange は指定したプロバイダーで認可コードを交換し、IDトークンで確認したアカウントを返す
+func (r *Registry) Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*Identity, error) {
+	client, ok := r.clients[provider]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return client.Exchange(ctx, code, codeVerifier, nonce)
+}
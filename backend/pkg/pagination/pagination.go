@@ -0,0 +1,60 @@
+// Package pagination はキーセットページネーションの共通処理を提供します
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"poketier/pkg/errs"
+)
+
+const (
+	// DefaultLimit は件数が指定されなかった場合の取得件数
+	DefaultLimit = 20
+	// MaxLimit は1ページで取得できる最大件数
+	MaxLimit = 100
+)
+
+// Cursor はキーセットページネーションにおける前ページ末尾の位置
+// SortKey は並び順のキー値、ID は同値の並びを一意にするためのタイブレーカー
+type Cursor struct {
+	SortKey int64  `json:"k"`
+	ID      string `json:"id"`
+}
+
+// EncodeCursor はカーソルをクライアントに返す不透明な文字列に変換する
+func EncodeCursor(c Cursor) string {
+	// Cursorは常にJSONへ変換可能なためエラーは発生しない
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor はクライアントから受け取った文字列をカーソルに復元する
+func DecodeCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, errs.NewValidationError("invalid cursor encoding", err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Cursor{}, errs.NewValidationError("invalid cursor format", err)
+	}
+	if c.ID == "" {
+		return Cursor{}, errs.NewValidationError("invalid cursor", errors.New("cursor id is empty"))
+	}
+
+	return c, nil
+}
+
+// NormalizeLimit は取得件数を 1〜MaxLimit の範囲に丸める。0以下はDefaultLimitとする
+func NormalizeLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
@@ -0,0 +1,114 @@
+package pagination_test
+
+import (
+	"errors"
+	"testing"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/pagination"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecodeCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		cursor   pagination.Cursor
+	}{
+		{
+			caseName: "正常系: 正のソートキーを持つカーソルが復元できる事",
+			cursor:   pagination.Cursor{SortKey: 1234, ID: "0198934b-2ec7-7e30-b80c-6d0734e34afe"},
+		},
+		{
+			caseName: "正常系: ソートキーが0のカーソルが復元できる事",
+			cursor:   pagination.Cursor{SortKey: 0, ID: "0198934b-2ec7-7e30-b80c-6d0734e34afe"},
+		},
+		{
+			caseName: "正常系: 負のソートキーを持つカーソルが復元できる事",
+			cursor:   pagination.Cursor{SortKey: -42, ID: "0198934b-2ec7-7e30-b80c-6d0734e34afe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			encoded := pagination.EncodeCursor(tt.cursor)
+			got, err := pagination.DecodeCursor(encoded)
+
+			// Assert
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.cursor, got, "decoded cursor does not match")
+		})
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		input    string
+	}{
+		{
+			caseName: "異常系: base64として不正な文字列の場合",
+			input:    "!!!",
+		},
+		{
+			caseName: "異常系: JSONとして不正な文字列の場合",
+			input:    "bm90LWpzb24",
+		},
+		{
+			caseName: "異常系: IDが空の場合",
+			input:    pagination.EncodeCursor(pagination.Cursor{SortKey: 1}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := pagination.DecodeCursor(tt.input)
+
+			// Assert
+			assert.Error(t, err, "expected error but got none")
+			assert.True(t, isBadRequest(err), "error should be a validation error")
+		})
+	}
+}
+
+func TestNormalizeLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		input    int
+		want     int
+	}{
+		{caseName: "正常系: 0の場合はデフォルト値", input: 0, want: pagination.DefaultLimit},
+		{caseName: "正常系: 負数の場合はデフォルト値", input: -1, want: pagination.DefaultLimit},
+		{caseName: "正常系: 範囲内の値はそのまま", input: 50, want: 50},
+		{caseName: "正常系: 上限を超える場合は上限値", input: 1000, want: pagination.MaxLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := pagination.NormalizeLimit(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "limit does not match")
+		})
+	}
+}
+
+func isBadRequest(err error) bool {
+	var domainErr *errs.DomainError
+	return errors.As(err, &domainErr) && domainErr.Type == errs.ErrBadRequest
+}
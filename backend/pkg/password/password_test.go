@@ -0,0 +1,123 @@
+package password_test
+
+import (
+	"strings"
+	"testing"
+
+	"poketier/pkg/password"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testParams はテストを高速にするための軽量なパラメータ
+var testParams = password.Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHasher_Hash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: PHC形式の文字列を返し、同じパスワードでもソルトにより異なるハッシュになる", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		hasher := password.NewHasher(testParams)
+
+		// Act
+		first, err := hasher.Hash("correct horse battery staple")
+		require.NoError(t, err, "Hash should not return error")
+		second, err := hasher.Hash("correct horse battery staple")
+		require.NoError(t, err, "Hash should not return error")
+
+		// Assert
+		assert.True(t, strings.HasPrefix(first, "$argon2id$v=19$m=64,t=1,p=1$"), "hash should be encoded in PHC format")
+		assert.NotEqual(t, first, second, "hashes should differ by salt")
+	})
+}
+
+func TestHasher_Verify(t *testing.T) {
+	t.Parallel()
+
+	hasher := password.NewHasher(testParams)
+	encoded, err := hasher.Hash("correct horse battery staple")
+	require.NoError(t, err, "Hash should not return error")
+
+	otherParams := testParams
+	otherParams.Iterations = 2
+	encodedWithOtherParams, err := password.NewHasher(otherParams).Hash("correct horse battery staple")
+	require.NoError(t, err, "Hash should not return error")
+
+	tests := []struct {
+		caseName string
+		password string
+		encoded  string
+		want     bool
+		wantErr  error
+	}{
+		{
+			caseName: "正常系: パスワードが一致する",
+			password: "correct horse battery staple",
+			encoded:  encoded,
+			want:     true,
+		},
+		{
+			caseName: "正常系: パスワードが一致しない",
+			password: "wrong password",
+			encoded:  encoded,
+			want:     false,
+		},
+		{
+			caseName: "正常系: ハッシュ文字列のパラメータで照合する",
+			password: "correct horse battery staple",
+			encoded:  encodedWithOtherParams,
+			want:     true,
+		},
+		{
+			caseName: "異常系: argon2id以外のハッシュ",
+			password: "correct horse battery staple",
+			encoded:  "$2a$10$abcdefghijklmnopqrstuv",
+			wantErr:  password.ErrInvalidHash,
+		},
+		{
+			caseName: "異常系: バージョンが異なる",
+			password: "correct horse battery staple",
+			encoded:  strings.Replace(encoded, "v=19", "v=16", 1),
+			wantErr:  password.ErrInvalidHash,
+		},
+		{
+			caseName: "異常系: パラメータが不正",
+			password: "correct horse battery staple",
+			encoded:  strings.Replace(encoded, "t=1", "t=0", 1),
+			wantErr:  password.ErrInvalidHash,
+		},
+		{
+			caseName: "異常系: 空文字列",
+			password: "correct horse battery staple",
+			encoded:  "",
+			wantErr:  password.ErrInvalidHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := hasher.Verify(tt.password, tt.encoded)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr, "Verify should return expected error")
+				assert.False(t, got, "Verify should not match on error")
+				return
+			}
+			require.NoError(t, err, "Verify should not return error")
+			assert.Equal(t, tt.want, got, "Verify result should match")
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package policy
+
+import (
+	"poketier/pkg/auth"
+	"poketier/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewMiddleware は p の操作を許可された権限の利用者のみに後続の処理を実行させるミドルウェアを生成します。
+// 権限はリクエストのcontextから取り出すため、auth のミドルウェアの後に登録します。
+// 許可されていない場合は403を返します。未ログインの利用者に401を返すのは auth.NewRequiredMiddleware の役割です。
+func NewMiddleware(p Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if err := Authorize(auth.RoleFromContext(ctx.Request.Context()), p); err != nil {
+			errs.HandleError(ctx, err)
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,75 @@
+package policy_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"poketier/pkg/auth"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/id"
+	"poketier/pkg/vo/role"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		caseName       string
+		authenticate   func(ctx *gin.Context)
+		expectedStatus int
+	}{
+		{
+			caseName: "正常系: 許可された権限のユーザーの場合、後続のハンドラーが実行される",
+			authenticate: func(ctx *gin.Context) {
+				ctx.Request = ctx.Request.WithContext(auth.WithUser(ctx.Request.Context(), id.NewUserID(), role.Moderator))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			caseName: "正常系: 管理用トークンで管理者として認証した場合、後続のハンドラーが実行される",
+			authenticate: func(ctx *gin.Context) {
+				ctx.Request = ctx.Request.WithContext(auth.WithService(ctx.Request.Context(), role.Admin))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			caseName: "異常系: 許可されていない権限のユーザーの場合、403が返される",
+			authenticate: func(ctx *gin.Context) {
+				ctx.Request = ctx.Request.WithContext(auth.WithUser(ctx.Request.Context(), id.NewUserID(), role.User))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			caseName:       "異常系: 未ログインの場合、403が返される",
+			authenticate:   func(ctx *gin.Context) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			r := gin.New()
+			r.GET("/admin/flagged-tier-lists", tt.authenticate, policy.NewMiddleware(policy.ModerateTierLists), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin/flagged-tier-lists", nil)
+
+			// Act
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code, "status code should match expected")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Package policy はユーザー権限（role）ごとに許可する操作を定義し、認可を行う機能を提供します
+package policy
+
+import (
+	"slices"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/vo/role"
+)
+
+// Permission は認可の対象となる操作
+type Permission string
+
+const (
+	// ManageOwnAccount はログイン中のユーザー自身のアカウント（プロフィール・セッション・お気に入り・フォロー）の参照・更新
+	ManageOwnAccount Permission = "account:manage_own"
+	// EditOwnTierLists はログイン中に作成した自身のティアリストの配置の保存とリビジョンの復元
+	EditOwnTierLists Permission = "tier_list:edit_own"
+	// PostComments はティアリストへのコメントの投稿と、自身のコメントの編集・削除
+	PostComments Permission = "comment:post"
+	// React はティアリスト・コメントへのいいねとその取り消し
+	React Permission = "reaction:react"
+	// ReportContent はティアリスト・コメント・デッキの通報
+	ReportContent Permission = "content:report"
+	// ModerateTierLists はフラグ付きティアリストの確認などのモデレーション
+	ModerateTierLists Permission = "tier_list:moderate"
+	// ModerateContent は通報の確認と、コンテンツの非表示・復元、通報の却下、ユーザーの利用停止
+	ModerateContent Permission = "content:moderate"
+	// ManageUsers は他のユーザーのセッションの強制失効などのユーザー管理
+	ManageUsers Permission = "user:manage"
+	// ManageSeasons はシーズンの作成・更新・削除
+	ManageSeasons Permission = "season:manage"
+	// ManageCards はカードの作成・更新・削除
+	ManageCards Permission = "card:manage"
+	// ManageExpansions は拡張パックの作成・更新・削除
+	ManageExpansions Permission = "expansion:manage"
+)
+
+// rolePermissions は権限ごとに許可する操作
+// 上位の権限は下位の権限の操作をすべて含む（guest < user < moderator < admin）
+// ティアリストの閲覧・フォークなどゲストにも許可する操作は定義しない
+var rolePermissions = map[role.Role][]Permission{
+	role.Guest: {},
+	role.User: {
+		ManageOwnAccount,
+		EditOwnTierLists,
+		PostComments,
+		React,
+		ReportContent,
+	},
+	role.Moderator: {
+		ManageOwnAccount,
+		EditOwnTierLists,
+		PostComments,
+		React,
+		ReportContent,
+		ModerateTierLists,
+		ModerateContent,
+	},
+	role.Admin: {
+		ManageOwnAccount,
+		EditOwnTierLists,
+		PostComments,
+		React,
+		ReportContent,
+		ModerateTierLists,
+		ModerateContent,
+		ManageUsers,
+		ManageSeasons,
+		ManageCards,
+		ManageExpansions,
+	},
+}
+
+// Allows は r が p の操作を許可されているかどうかを返す
+// 定義されていない権限はすべての操作を拒否する
+func Allows(r role.Role, p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
+
+// Authorize は r が p の操作を許可されていない場合に Forbidden エラーを返す
+func Authorize(r role.Role, p Permission) error {
+	if !Allows(r, p) {
+		return errs.NewForbiddenError("permission denied: "+string(p), nil)
+	}
+	return nil
+}
+
+// String は操作の文字列表現を返す
+func (p Permission) String() string {
+	return string(p)
+}
@@ -0,0 +1,110 @@
+package policy_test
+
+import (
+	"slices"
+	"testing"
+
+	"poketier/pkg/errs"
+	"poketier/pkg/policy"
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	// allowed は操作ごとに許可される権限（記載のない権限は拒否される）
+	tests := []struct {
+		caseName   string
+		permission policy.Permission
+		allowed    []role.Role
+	}{
+		{
+			caseName:   "自身のアカウントの管理はログイン中のユーザーに許可される",
+			permission: policy.ManageOwnAccount,
+			allowed:    []role.Role{role.User, role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "自身のティアリストの編集はログイン中のユーザーに許可される",
+			permission: policy.EditOwnTierLists,
+			allowed:    []role.Role{role.User, role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "コメントの投稿はログイン中のユーザーに許可される",
+			permission: policy.PostComments,
+			allowed:    []role.Role{role.User, role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "いいねはログイン中のユーザーに許可される",
+			permission: policy.React,
+			allowed:    []role.Role{role.User, role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "コンテンツの通報はログイン中のユーザーに許可される",
+			permission: policy.ReportContent,
+			allowed:    []role.Role{role.User, role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "ティアリストのモデレーションはモデレーター以上に許可される",
+			permission: policy.ModerateTierLists,
+			allowed:    []role.Role{role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "コンテンツのモデレーションはモデレーター以上に許可される",
+			permission: policy.ModerateContent,
+			allowed:    []role.Role{role.Moderator, role.Admin},
+		},
+		{
+			caseName:   "ユーザーの管理は管理者のみに許可される",
+			permission: policy.ManageUsers,
+			allowed:    []role.Role{role.Admin},
+		},
+		{
+			caseName:   "シーズンの管理は管理者のみに許可される",
+			permission: policy.ManageSeasons,
+			allowed:    []role.Role{role.Admin},
+		},
+		{
+			caseName:   "カードの管理は管理者のみに許可される",
+			permission: policy.ManageCards,
+			allowed:    []role.Role{role.Admin},
+		},
+		{
+			caseName:   "拡張パックの管理は管理者のみに許可される",
+			permission: policy.ManageExpansions,
+			allowed:    []role.Role{role.Admin},
+		},
+		{
+			caseName:   "定義されていない操作は誰にも許可されない",
+			permission: policy.Permission("unknown:manage"),
+		},
+	}
+
+	roles := []role.Role{role.Guest, role.User, role.Moderator, role.Admin, role.Role("superuser")}
+
+	for _, tt := range tests {
+		for _, r := range roles {
+			t.Run(tt.caseName+"/"+r.String(), func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				wantAllowed := slices.Contains(tt.allowed, r)
+
+				// Act
+				err := policy.Authorize(r, tt.permission)
+
+				// Assert
+				assert.Equal(t, wantAllowed, policy.Allows(r, tt.permission), "Allows should match the policy")
+				if wantAllowed {
+					assert.NoError(t, err, "Authorize should not return error")
+					return
+				}
+				var domainErr *errs.DomainError
+				if assert.ErrorAs(t, err, &domainErr, "error should be a domain error") {
+					assert.Equal(t, errs.ErrForbidden, domainErr.Type, "domain error type should be forbidden")
+				}
+			})
+		}
+	}
+}
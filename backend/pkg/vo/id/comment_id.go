@@ -0,0 +1,24 @@
+package id
+
+import "github.com/google/uuid"
+
+// commentEntity はCommentのマーカー型
+type commentEntity struct{}
+
+// CommentID はコメントの一意識別子
+type CommentID = ID[commentEntity]
+
+// NewCommentID は新しいCommentIDを生成
+func NewCommentID() CommentID {
+	return new[commentEntity]()
+}
+
+// CommentIDFromString は文字列からCommentIDを再作成
+func CommentIDFromString(s string) (CommentID, error) {
+	return fromString[commentEntity](s)
+}
+
+// CommentIDFromUUID はuuid.UUIDからCommentIDを作成
+func CommentIDFromUUID(u uuid.UUID) CommentID {
+	return fromUUID[commentEntity](u)
+}
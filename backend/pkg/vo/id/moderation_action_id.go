@@ -0,0 +1,24 @@
+package id
+
+import "github.com/google/uuid"
+
+// moderationActionEntity はModerationActionのマーカー型
+type moderationActionEntity struct{}
+
+// ModerationActionID はモデレーション操作の一意識別子
+type ModerationActionID = ID[moderationActionEntity]
+
+// NewModerationActionID は新しいModerationActionIDを生成
+func NewModerationActionID() ModerationActionID {
+	return new[moderationActionEntity]()
+}
+
+// ModerationActionIDFromString は文字列からModerationActionIDを再作成
+func ModerationActionIDFromString(s string) (ModerationActionID, error) {
+	return fromString[moderationActionEntity](s)
+}
+
+// ModerationActionIDFromUUID はuuid.UUIDからModerationActionIDを作成
+func ModerationActionIDFromUUID(u uuid.UUID) ModerationActionID {
+	return fromUUID[moderationActionEntity](u)
+}
@@ -0,0 +1,24 @@
+package id
+
+import "github.com/google/uuid"
+
+// reportEntity はReportのマーカー型
+type reportEntity struct{}
+
+// ReportID は通報の一意識別子
+type ReportID = ID[reportEntity]
+
+// NewReportID は新しいReportIDを生成
+func NewReportID() ReportID {
+	return new[reportEntity]()
+}
+
+// ReportIDFromString は文字列からReportIDを再作成
+func ReportIDFromString(s string) (ReportID, error) {
+	return fromString[reportEntity](s)
+}
+
+// ReportIDFromUUID はuuid.UUIDからReportIDを作成
+func ReportIDFromUUID(u uuid.UUID) ReportID {
+	return fromUUID[reportEntity](u)
+}
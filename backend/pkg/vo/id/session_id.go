@@ -0,0 +1,24 @@
+package id
+
+import "github.com/google/uuid"
+
+// sessionEntity はSessionのマーカー型
+type sessionEntity struct{}
+
+// SessionID はセッションの一意識別子
+type SessionID = ID[sessionEntity]
+
+// NewSessionID は新しいSessionIDを生成
+func NewSessionID() SessionID {
+	return new[sessionEntity]()
+}
+
+// SessionIDFromString は文字列からSessionIDを再作成
+func SessionIDFromString(s string) (SessionID, error) {
+	return fromString[sessionEntity](s)
+}
+
+// SessionIDFromUUID はuuid.UUIDからSessionIDを作成
+func SessionIDFromUUID(u uuid.UUID) SessionID {
+	return fromUUID[sessionEntity](u)
+}
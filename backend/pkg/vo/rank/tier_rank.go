@@ -0,0 +1,69 @@
+// Package rank はティアランクの値オブジェクトを提供します
+package rank
+
+import "fmt"
+
+// TierRank はティアリストでの強度ランク（SS=7 〜 E=1）
+type TierRank int
+
+const (
+	TierE  TierRank = 1
+	TierD  TierRank = 2
+	TierC  TierRank = 3
+	TierB  TierRank = 4
+	TierA  TierRank = 5
+	TierS  TierRank = 6
+	TierSS TierRank = 7
+)
+
+var tierRankLabels = map[TierRank]string{
+	TierSS: "SS",
+	TierS:  "S",
+	TierA:  "A",
+	TierB:  "B",
+	TierC:  "C",
+	TierD:  "D",
+	TierE:  "E",
+}
+
+// NewTierRank は数値からTierRankを作成する
+func NewTierRank(value int) (TierRank, error) {
+	r := TierRank(value)
+	if !r.IsValid() {
+		return 0, fmt.Errorf("tier rank must be between %d and %d: %d", TierE, TierSS, value)
+	}
+	return r, nil
+}
+
+// ParseTierRank はラベル（SS, S, A, B, C, D, E）からTierRankを作成する
+func ParseTierRank(label string) (TierRank, error) {
+	for r, l := range tierRankLabels {
+		if l == label {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown tier rank label: %s", label)
+}
+
+// AllTierRanks は全てのTierRankを強い順（SS → E）で返す
+func AllTierRanks() []TierRank {
+	return []TierRank{TierSS, TierS, TierA, TierB, TierC, TierD, TierE}
+}
+
+// IsValid は定義されたランクかどうかを返す
+func (r TierRank) IsValid() bool {
+	return r >= TierE && r <= TierSS
+}
+
+// Int はランクの数値表現を返す
+func (r TierRank) Int() int {
+	return int(r)
+}
+
+// String はランクのラベルを返す
+func (r TierRank) String() string {
+	if label, ok := tierRankLabels[r]; ok {
+		return label
+	}
+	return fmt.Sprintf("TierRank(%d)", int(r))
+}
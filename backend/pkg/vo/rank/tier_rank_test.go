@@ -0,0 +1,102 @@
+package rank_test
+
+import (
+	"testing"
+
+	"poketier/pkg/vo/rank"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTierRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		value    int
+		want     rank.TierRank
+		wantErr  bool
+	}{
+		{caseName: "正常系: 7はSSになる事", value: 7, want: rank.TierSS},
+		{caseName: "正常系: 1はEになる事", value: 1, want: rank.TierE},
+		{caseName: "異常系: 0は範囲外", value: 0, wantErr: true},
+		{caseName: "異常系: 8は範囲外", value: 8, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := rank.NewTierRank(tt.value)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "tier rank does not match")
+		})
+	}
+}
+
+func TestParseTierRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		label    string
+		want     rank.TierRank
+		wantErr  bool
+	}{
+		{caseName: "正常系: SSが解析できる事", label: "SS", want: rank.TierSS},
+		{caseName: "正常系: Aが解析できる事", label: "A", want: rank.TierA},
+		{caseName: "異常系: 未定義のラベル", label: "F", wantErr: true},
+		{caseName: "異常系: 小文字は解析できない", label: "ss", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := rank.ParseTierRank(tt.label)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "tier rank does not match")
+		})
+	}
+}
+
+func TestTierRank_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 全てのランクが強い順にラベル化される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		labels := make([]string, 0, 7)
+		for _, r := range rank.AllTierRanks() {
+			labels = append(labels, r.String())
+		}
+
+		// Assert
+		assert.Equal(t, []string{"SS", "S", "A", "B", "C", "D", "E"}, labels, "labels do not match")
+	})
+
+	t.Run("異常系: 範囲外のランクは数値付きで表現される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		got := rank.TierRank(9).String()
+
+		// Assert
+		assert.Equal(t, "TierRank(9)", got, "label does not match")
+	})
+}
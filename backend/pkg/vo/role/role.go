@@ -0,0 +1,51 @@
+// Package role はユーザー権限の値オブジェクトを提供します
+package role
+
+import "fmt"
+
+// Role はユーザー権限（guest < user < moderator < admin）
+type Role string
+
+const (
+	// Guest は未ログインの利用者。永続化されたユーザーに割り当てることはない
+	Guest     Role = "guest"
+	User      Role = "user"
+	Moderator Role = "moderator"
+	Admin     Role = "admin"
+)
+
+var roleLevels = map[Role]int{
+	Guest:     0,
+	User:      1,
+	Moderator: 2,
+	Admin:     3,
+}
+
+// ParseRole は文字列からRoleを作成する
+func ParseRole(value string) (Role, error) {
+	r := Role(value)
+	if !r.IsValid() {
+		return "", fmt.Errorf("unknown role: %s", value)
+	}
+	return r, nil
+}
+
+// IsValid は定義された権限かどうかを返す
+func (r Role) IsValid() bool {
+	_, ok := roleLevels[r]
+	return ok
+}
+
+// AtLeast は other 以上の権限を持つかどうかを返す
+func (r Role) AtLeast(other Role) bool {
+	level, ok := roleLevels[r]
+	if !ok {
+		return false
+	}
+	return level >= roleLevels[other]
+}
+
+// String は権限の文字列表現を返す
+func (r Role) String() string {
+	return string(r)
+}
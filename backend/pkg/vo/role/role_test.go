@@ -0,0 +1,71 @@
+package role_test
+
+import (
+	"testing"
+
+	"poketier/pkg/vo/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		value    string
+		want     role.Role
+		wantErr  bool
+	}{
+		{caseName: "正常系: userが解析できる事", value: "user", want: role.User},
+		{caseName: "正常系: adminが解析できる事", value: "admin", want: role.Admin},
+		{caseName: "異常系: 未定義の権限", value: "owner", wantErr: true},
+		{caseName: "異常系: 大文字は解析できない", value: "Admin", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := role.ParseRole(tt.value)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+				return
+			}
+			assert.NoError(t, err, "unexpected error occurred")
+			assert.Equal(t, tt.want, got, "role does not match")
+		})
+	}
+}
+
+func TestRole_AtLeast(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		role     role.Role
+		other    role.Role
+		want     bool
+	}{
+		{caseName: "正常系: 同じ権限は満たす事", role: role.Moderator, other: role.Moderator, want: true},
+		{caseName: "正常系: 上位の権限は下位の権限を満たす事", role: role.Admin, other: role.User, want: true},
+		{caseName: "正常系: 下位の権限は上位の権限を満たさない事", role: role.User, other: role.Moderator, want: false},
+		{caseName: "正常系: ゲストはユーザー権限を満たさない事", role: role.Guest, other: role.User, want: false},
+		{caseName: "異常系: 未定義の権限はゲスト権限も満たさない事", role: role.Role("owner"), other: role.Guest, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := tt.role.AtLeast(tt.other)
+
+			// Assert
+			assert.Equal(t, tt.want, got, "result does not match")
+		})
+	}
+}
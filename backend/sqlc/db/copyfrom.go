@@ -43,3 +43,39 @@ func (r iteratorForBulkCreateSeasons) Err() error {
 func (q *Queries) BulkCreateSeasons(ctx context.Context, arg []BulkCreateSeasonsParams) (int64, error) {
 	return q.db.CopyFrom(ctx, []string{"seasons"}, []string{"season_id", "name", "start_date", "end_date"}, &iteratorForBulkCreateSeasons{rows: arg})
 }
+
+// iteratorForBulkCreateTierPlacements implements pgx.CopyFromSource.
+type iteratorForBulkCreateTierPlacements struct {
+	rows                 []BulkCreateTierPlacementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkCreateTierPlacements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkCreateTierPlacements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TierPlacementID,
+		r.rows[0].TierListID,
+		r.rows[0].DeckID,
+		r.rows[0].TierRank,
+		r.rows[0].Position,
+	}, nil
+}
+
+func (r iteratorForBulkCreateTierPlacements) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkCreateTierPlacements(ctx context.Context, arg []BulkCreateTierPlacementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"tier_placements"}, []string{"tier_placement_id", "tier_list_id", "deck_id", "tier_rank", "position"}, &iteratorForBulkCreateTierPlacements{rows: arg})
+}
@@ -8,6 +8,71 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CommentLike struct {
+	CommentID pgtype.UUID        `json:"comment_id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type ContentReport struct {
+	ReportID       pgtype.UUID        `json:"report_id"`
+	TargetType     string             `json:"target_type"`
+	TargetID       pgtype.UUID        `json:"target_id"`
+	ReporterUserID pgtype.UUID        `json:"reporter_user_id"`
+	Reason         string             `json:"reason"`
+	Detail         string             `json:"detail"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+	Resolution     pgtype.Text        `json:"resolution"`
+}
+
+type Deck struct {
+	DeckID          pgtype.UUID        `json:"deck_id"`
+	SeasonID        pgtype.UUID        `json:"season_id"`
+	PrimaryCardID   pgtype.UUID        `json:"primary_card_id"`
+	SecondaryCardID pgtype.UUID        `json:"secondary_card_id"`
+	TertiaryCardID  pgtype.UUID        `json:"tertiary_card_id"`
+	Nickname        string             `json:"nickname"`
+	CardNames       string             `json:"card_names"`
+	ImageUrl        string             `json:"image_url"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ArchetypeKey    pgtype.Text        `json:"archetype_key"`
+	AuthorUserID    pgtype.UUID        `json:"author_user_id"`
+	HiddenAt        pgtype.Timestamptz `json:"hidden_at"`
+}
+
+type DeckFavorite struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	DeckID    pgtype.UUID        `json:"deck_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type DeckFavoriteCount struct {
+	DeckID        pgtype.UUID `json:"deck_id"`
+	Shard         int16       `json:"shard"`
+	FavoriteCount int32       `json:"favorite_count"`
+}
+
+type DeckTrendSnapshot struct {
+	SeasonID       pgtype.UUID        `json:"season_id"`
+	DeckID         pgtype.UUID        `json:"deck_id"`
+	SnapshotDate   pgtype.Date        `json:"snapshot_date"`
+	TierRank       float64            `json:"tier_rank"`
+	PlacementCount int32              `json:"placement_count"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type ModerationAction struct {
+	ActionID        pgtype.UUID        `json:"action_id"`
+	TargetType      string             `json:"target_type"`
+	TargetID        pgtype.UUID        `json:"target_id"`
+	Action          string             `json:"action"`
+	ModeratorUserID pgtype.UUID        `json:"moderator_user_id"`
+	Note            string             `json:"note"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
 type Season struct {
 	SeasonID  pgtype.UUID        `json:"season_id"`
 	Name      string             `json:"name"`
@@ -16,3 +81,183 @@ type Season struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
+
+type TierList struct {
+	TierListID           pgtype.UUID        `json:"tier_list_id"`
+	SeasonID             pgtype.UUID        `json:"season_id"`
+	Title                string             `json:"title"`
+	Description          string             `json:"description"`
+	AuthorName           string             `json:"author_name"`
+	ViewCount            int32              `json:"view_count"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	ForkedFromTierListID pgtype.UUID        `json:"forked_from_tier_list_id"`
+	ForkCount            int32              `json:"fork_count"`
+	AuthorIp             string             `json:"author_ip"`
+	AuthorUserID         pgtype.UUID        `json:"author_user_id"`
+	HiddenAt             pgtype.Timestamptz `json:"hidden_at"`
+	TrendingScore        int32              `json:"trending_score"`
+	HotScore             int64              `json:"hot_score"`
+}
+
+type TierListComment struct {
+	CommentID       pgtype.UUID        `json:"comment_id"`
+	TierListID      pgtype.UUID        `json:"tier_list_id"`
+	ParentCommentID pgtype.UUID        `json:"parent_comment_id"`
+	AuthorUserID    pgtype.UUID        `json:"author_user_id"`
+	DeckID          pgtype.UUID        `json:"deck_id"`
+	Body            string             `json:"body"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	EditedAt        pgtype.Timestamptz `json:"edited_at"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	HiddenAt        pgtype.Timestamptz `json:"hidden_at"`
+}
+
+type TierListDailyView struct {
+	TierListID pgtype.UUID `json:"tier_list_id"`
+	ViewDate   pgtype.Date `json:"view_date"`
+	ViewCount  int32       `json:"view_count"`
+}
+
+type TierListFavorite struct {
+	UserID     pgtype.UUID        `json:"user_id"`
+	TierListID pgtype.UUID        `json:"tier_list_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type TierListFavoriteCount struct {
+	TierListID    pgtype.UUID `json:"tier_list_id"`
+	Shard         int16       `json:"shard"`
+	FavoriteCount int32       `json:"favorite_count"`
+}
+
+type TierListLike struct {
+	TierListID pgtype.UUID        `json:"tier_list_id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type TierListLikeCount struct {
+	TierListID pgtype.UUID `json:"tier_list_id"`
+	Shard      int16       `json:"shard"`
+	LikeCount  int32       `json:"like_count"`
+}
+
+type TierListRevision struct {
+	TierListID           pgtype.UUID        `json:"tier_list_id"`
+	RevisionNumber       int32              `json:"revision_number"`
+	Placements           []byte             `json:"placements"`
+	Operations           []byte             `json:"operations"`
+	RestoredFromRevision pgtype.Int4        `json:"restored_from_revision"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+}
+
+type TierListTrustScore struct {
+	TierListID            pgtype.UUID        `json:"tier_list_id"`
+	SeasonID              pgtype.UUID        `json:"season_id"`
+	TrustWeight           float64            `json:"trust_weight"`
+	RankDistance          float64            `json:"rank_distance"`
+	ZScore                float64            `json:"z_score"`
+	DuplicateOfTierListID pgtype.UUID        `json:"duplicate_of_tier_list_id"`
+	FlagReasons           []string           `json:"flag_reasons"`
+	EvaluatedAt           pgtype.Timestamptz `json:"evaluated_at"`
+}
+
+type TierListViewer struct {
+	TierListID pgtype.UUID `json:"tier_list_id"`
+	ViewDate   pgtype.Date `json:"view_date"`
+	ViewerKey  string      `json:"viewer_key"`
+}
+
+type TierPlacement struct {
+	TierPlacementID pgtype.UUID        `json:"tier_placement_id"`
+	TierListID      pgtype.UUID        `json:"tier_list_id"`
+	DeckID          pgtype.UUID        `json:"deck_id"`
+	TierRank        int16              `json:"tier_rank"`
+	Position        int32              `json:"position"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type TierStatistic struct {
+	DeckID                pgtype.UUID        `json:"deck_id"`
+	SeasonID              pgtype.UUID        `json:"season_id"`
+	RankSum               int64              `json:"rank_sum"`
+	PlacementCount        int32              `json:"placement_count"`
+	CalculatedAt          pgtype.Timestamptz `json:"calculated_at"`
+	WeightedRankSum       float64            `json:"weighted_rank_sum"`
+	WeightSum             float64            `json:"weight_sum"`
+	TierRank              pgtype.Float8      `json:"tier_rank"`
+	WeightedRankSquareSum float64            `json:"weighted_rank_square_sum"`
+	WeightSquareSum       float64            `json:"weight_square_sum"`
+}
+
+type User struct {
+	UserID      pgtype.UUID        `json:"user_id"`
+	DisplayName string             `json:"display_name"`
+	Role        string             `json:"role"`
+	DisabledAt  pgtype.Timestamptz `json:"disabled_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserAccountToken struct {
+	TokenHash string             `json:"token_hash"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	Purpose   string             `json:"purpose"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserCredential struct {
+	UserID           pgtype.UUID        `json:"user_id"`
+	Email            string             `json:"email"`
+	PasswordHash     string             `json:"password_hash"`
+	EmailVerifiedAt  pgtype.Timestamptz `json:"email_verified_at"`
+	FailedLoginCount int32              `json:"failed_login_count"`
+	LockedUntil      pgtype.Timestamptz `json:"locked_until"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserFollow struct {
+	FollowerUserID pgtype.UUID        `json:"follower_user_id"`
+	FolloweeUserID pgtype.UUID        `json:"followee_user_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserIdentity struct {
+	Provider  string             `json:"provider"`
+	Subject   string             `json:"subject"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	Email     pgtype.Text        `json:"email"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserOidcAuthRequest struct {
+	StateHash    string             `json:"state_hash"`
+	Provider     string             `json:"provider"`
+	CodeVerifier string             `json:"code_verifier"`
+	Nonce        string             `json:"nonce"`
+	ExpiresAt    pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserRefreshToken struct {
+	TokenHash string             `json:"token_hash"`
+	SessionID pgtype.UUID        `json:"session_id"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserSession struct {
+	SessionID     pgtype.UUID        `json:"session_id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	UserAgent     string             `json:"user_agent"`
+	IpAddress     string             `json:"ip_address"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt    pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt     pgtype.Timestamptz `json:"revoked_at"`
+	RevokedReason pgtype.Text        `json:"revoked_reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
@@ -11,21 +11,267 @@ import (
 )
 
 type Querier interface {
+	// いいね済みの場合は何もせず0行を返す
+	AddCommentLike(ctx context.Context, arg AddCommentLikeParams) (int64, error)
+	// デッキのお気に入りとお気に入り数のカウンターの操作
+	// 登録済みの場合は何もせず0行を返す
+	AddDeckFavorite(ctx context.Context, arg AddDeckFavoriteParams) (int64, error)
+	// 指定したシャードのお気に入り数に差分を加算する
+	AddDeckFavoriteCount(ctx context.Context, arg AddDeckFavoriteCountParams) error
+	// ティアリストのお気に入りとお気に入り数のカウンターの操作
+	// 登録済みの場合は何もせず0行を返す
+	AddTierListFavorite(ctx context.Context, arg AddTierListFavoriteParams) (int64, error)
+	// 指定したシャードのお気に入り数に差分を加算する
+	AddTierListFavoriteCount(ctx context.Context, arg AddTierListFavoriteCountParams) error
+	// ティアリスト・コメントのいいねといいね数のカウンターの操作
+	// いいね済みの場合は何もせず0行を返す
+	AddTierListLike(ctx context.Context, arg AddTierListLikeParams) (int64, error)
+	// 指定したシャードのいいね数に差分を加算する
+	AddTierListLikeCount(ctx context.Context, arg AddTierListLikeCountParams) error
+	// ティア統計の操作
+	// ティアリストの配置を統計に加算する（ティアリストの作成・配置の保存後に呼び出す）
+	// 重み付きの累計にはティアリストの信頼度を使用する（評価前のティアリストは重み1）
+	// 二乗の累計は集計ティアリストの信頼区間の算出に使用する
+	// モデレーターが非表示にしたティアリストは加算しない（非表示にする前に減算し、復元した後に加算する）
+	AddTierListToStatistics(ctx context.Context, tierListID pgtype.UUID) error
 	BulkCreateSeasons(ctx context.Context, arg []BulkCreateSeasonsParams) (int64, error)
+	BulkCreateTierPlacements(ctx context.Context, arg []BulkCreateTierPlacementsParams) (int64, error)
 	// 指定したIDリストのシーズンを一括削除
 	BulkDeleteSeasons(ctx context.Context, dollar_1 []pgtype.UUID) error
+	// 認可リクエストを削除して返す。同じ state で2回コールバックされた場合、2回目は行を返さない
+	ConsumeUserOIDCAuthRequest(ctx context.Context, stateHash string) (UserOidcAuthRequest, error)
 	CountSeasons(ctx context.Context) (int64, error)
+	// 投稿数の制限のため、指定した日時以降にユーザーが投稿したコメント数を数える（削除済みも含む）
+	CountTierListCommentsByAuthorSince(ctx context.Context, arg CountTierListCommentsByAuthorSinceParams) (int64, error)
+	// シーズン内の集計ティアリストの入力となるティアリスト数を取得（非表示のティアリストを除く）
+	CountTierListsBySeason(ctx context.Context, seasonID pgtype.UUID) (int64, error)
+	// ティアリスト・コメント・デッキの通報と、モデレーションキューの取得
+	// 同じユーザーが同じ対象に未対応の通報をしている場合は一意制約違反となる
+	CreateContentReport(ctx context.Context, arg CreateContentReportParams) error
+	CreateModerationAction(ctx context.Context, arg CreateModerationActionParams) error
 	CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error)
+	CreateTierList(ctx context.Context, arg CreateTierListParams) (TierList, error)
+	CreateTierListComment(ctx context.Context, arg CreateTierListCommentParams) error
+	// ティアリストのリビジョン操作
+	CreateTierListRevision(ctx context.Context, arg CreateTierListRevisionParams) (TierListRevision, error)
+	// ティアリストの閲覧の記録とトレンドスコアの更新
+	// 閲覧者の当日の閲覧を記録する（同じ日に記録済みの場合は0件を返し、閲覧数を加算しない）
+	CreateTierListViewer(ctx context.Context, arg CreateTierListViewerParams) (int64, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserAccountToken(ctx context.Context, arg CreateUserAccountTokenParams) error
+	CreateUserCredential(ctx context.Context, arg CreateUserCredentialParams) (UserCredential, error)
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error)
+	// OIDCの認可リクエストの操作
+	CreateUserOIDCAuthRequest(ctx context.Context, arg CreateUserOIDCAuthRequestParams) error
+	CreateUserRefreshToken(ctx context.Context, arg CreateUserRefreshTokenParams) error
+	CreateUserSession(ctx context.Context, arg CreateUserSessionParams) error
 	// 開発・テスト用: 全シーズンを削除
 	DeleteAllSeasons(ctx context.Context) error
+	// 重複の判定に使わなくなった前日以前の閲覧者の記録を削除する
+	DeleteExpiredTierListViewers(ctx context.Context) (int64, error)
+	// コールバックされずに期限切れになった認可リクエストを削除する
+	DeleteExpiredUserOIDCAuthRequests(ctx context.Context, expiresAt pgtype.Timestamptz) error
 	DeleteSeason(ctx context.Context, seasonID pgtype.UUID) error
+	DeleteTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) error
+	// 統計を削除（season_id を省略した場合は全シーズン）
+	DeleteTierStatistics(ctx context.Context, seasonID pgtype.UUID) error
+	// 新しいトークンを発行する前に、同じ用途の未使用のトークンを無効にする
+	DeleteUnusedUserAccountTokens(ctx context.Context, arg DeleteUnusedUserAccountTokensParams) error
+	// ユーザー間のフォローと、フォロー中のユーザーの新着を集めたフィードの取得
+	// フォロー済みの場合は何もせず0行を返す
+	FollowUser(ctx context.Context, arg FollowUserParams) (int64, error)
 	GetActiveSeason(ctx context.Context) (Season, error)
+	GetDeck(ctx context.Context, deckID pgtype.UUID) (Deck, error)
+	// リビジョンが存在しない場合は0を返す
+	GetLatestTierListRevisionNumber(ctx context.Context, tierListID pgtype.UUID) (int32, error)
+	// モデレーターの操作の監査ログと、操作の対象の取得
+	// 通報・モデレーションの対象のティアリスト・コメント・デッキの作成者・シーズン・非表示にした日時を取得する
+	// コメントはシーズンを持たないため season_id は NULL となる
+	GetModerationTarget(ctx context.Context, arg GetModerationTargetParams) (GetModerationTargetRow, error)
+	// 非表示・復元するティアリストの行ロックを取得し、作成者・シーズン・非表示にした日時を取得する
+	// 同時に保存された配置と統計の減算・加算が食い違わないよう、ティア統計を更新する前に取得する（非表示のティアリストも取得する）
+	GetModerationTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (GetModerationTierListForUpdateRow, error)
 	GetSeason(ctx context.Context, seasonID pgtype.UUID) (Season, error)
+	// モデレーターが非表示にしたティアリストは存在しないものとして扱う
+	GetTierList(ctx context.Context, tierListID pgtype.UUID) (TierList, error)
+	// ティアリストへのコメントの操作
+	// モデレーターが非表示にしたティアリストへのコメントは存在しないものとして扱う
+	GetTierListComment(ctx context.Context, commentID pgtype.UUID) (TierListComment, error)
+	// 配置の保存・リビジョンの復元で、同じティアリストへの同時更新を直列化するために行ロックを取得する
+	// モデレーターが非表示にしたティアリストは存在しないものとして扱う
+	GetTierListForUpdate(ctx context.Context, tierListID pgtype.UUID) (TierList, error)
+	// モデレーターが非表示にしたティアリストのリビジョンは存在しないものとして扱う
+	GetTierListRevision(ctx context.Context, arg GetTierListRevisionParams) (TierListRevision, error)
+	// ティアリストの行をロックしてから保存済みの信頼度を取得する（評価前のティアリストは重み1）
+	// 配置の保存・削除・非表示と同じ行をロックし、信頼度の付け替え中にティア統計が更新されないようにする
+	GetTierListTrustWeightForUpdate(ctx context.Context, tierListID pgtype.UUID) (float64, error)
+	// ユーザーのCRUD操作
+	GetUser(ctx context.Context, userID pgtype.UUID) (User, error)
+	// メールアドレス確認・パスワード再設定のワンタイムトークンの操作
+	GetUserAccountToken(ctx context.Context, tokenHash string) (UserAccountToken, error)
+	// メールアドレス・パスワードの認証情報のCRUD操作
+	GetUserCredential(ctx context.Context, userID pgtype.UUID) (UserCredential, error)
+	GetUserCredentialByEmail(ctx context.Context, email string) (UserCredential, error)
+	// 外部IDプロバイダーのアカウントとユーザーの紐付けの操作
+	GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error)
+	// セッションで発行したリフレッシュトークンの操作
+	GetUserRefreshToken(ctx context.Context, tokenHash string) (UserRefreshToken, error)
+	// ログインごとのセッションの操作
+	GetUserSession(ctx context.Context, sessionID pgtype.UUID) (UserSession, error)
+	// 当日の日別閲覧数を1増やす
+	IncrementTierListDailyViewCount(ctx context.Context, tierListID pgtype.UUID) error
+	// フォークされた回数を1増やす
+	IncrementTierListForkCount(ctx context.Context, tierListID pgtype.UUID) error
+	// 累計閲覧数とトレンドスコア（直近7日間の閲覧数）を1増やす（非表示のティアリストは0件を返す）
+	IncrementTierListViewCount(ctx context.Context, tierListID pgtype.UUID) (int64, error)
+	// 失効しておらず期限切れでもないセッションを、最後に使用した順に取得する
+	ListActiveUserSessions(ctx context.Context, arg ListActiveUserSessionsParams) ([]UserSession, error)
+	// シャードを合計したお気に入り数。お気に入りされたことがないデッキは含まない
+	ListDeckFavoriteCounts(ctx context.Context, deckIds []pgtype.UUID) ([]ListDeckFavoriteCountsRow, error)
+	ListDeckTrendSnapshotDates(ctx context.Context, seasonID pgtype.UUID) ([]pgtype.Date, error)
+	ListDeckTrendSnapshotsByDate(ctx context.Context, arg ListDeckTrendSnapshotsByDateParams) ([]DeckTrendSnapshot, error)
+	ListDeckTrendSnapshotsByDeck(ctx context.Context, arg ListDeckTrendSnapshotsByDeckParams) ([]DeckTrendSnapshot, error)
+	// デッキの参照
+	ListDecksByIDs(ctx context.Context, deckIds []pgtype.UUID) ([]Deck, error)
+	// モデレーターが非表示にしたデッキは含めない
+	ListDecksBySeason(ctx context.Context, seasonID pgtype.UUID) ([]Deck, error)
+	// お気に入りの登録日時の新しい順。カーソルは (favorited_at, deck_id)
+	// モデレーターが非表示にしたデッキは含めない
+	ListFavoriteDecksByUser(ctx context.Context, arg ListFavoriteDecksByUserParams) ([]ListFavoriteDecksByUserRow, error)
+	// お気に入りの登録日時の新しい順。カーソルは (favorited_at, tier_list_id)
+	// モデレーターが非表示にしたティアリストは含めない
+	ListFavoriteTierListsByUser(ctx context.Context, arg ListFavoriteTierListsByUserParams) ([]ListFavoriteTierListsByUserRow, error)
+	// フォロー中のユーザーが作成したティアリスト・デッキの作成日時の新しい順。カーソルは (created_at, item_id)
+	// フォロー数が多くても読む行数が「フォロー数 × page_limit」に収まるよう、フォロー中のユーザーごとに
+	// 作成者別の新着順インデックスから先頭の page_limit 件だけを読み、それらをマージして並べる
+	// モデレーターが非表示にしたティアリスト・デッキは含めない
+	ListFeedItems(ctx context.Context, arg ListFeedItemsParams) ([]ListFeedItemsRow, error)
+	// フラグ付きのティアリストを信頼度の低い順で取得（season_id を省略した場合は全シーズン）
+	ListFlaggedTierLists(ctx context.Context, arg ListFlaggedTierListsParams) ([]ListFlaggedTierListsRow, error)
+	// 操作日時の新しい順。カーソルは (created_at, action_id)
+	// target_type・target_id を指定した場合はその対象への操作だけを取得する
+	ListModerationActions(ctx context.Context, arg ListModerationActionsParams) ([]ModerationAction, error)
+	// 未対応の通報を対象ごとに集約し、最初に通報された日時の古い順に取得する。カーソルは (first_reported_at, target_id)
+	// reason を指定した場合はその理由の通報だけを集約する。対象が削除されている場合、content は空文字列で author_user_id は NULL となる
+	ListOpenReportTargets(ctx context.Context, arg ListOpenReportTargetsParams) ([]ListOpenReportTargetsRow, error)
+	// 配置から統計を再計算した結果を取得（season_id を省略した場合は全シーズン）
+	ListRecomputedTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]ListRecomputedTierStatisticsRow, error)
+	// ホット順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+	ListSeasonTierListsByHot(ctx context.Context, arg ListSeasonTierListsByHotParams) ([]TierList, error)
+	// 新着順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+	ListSeasonTierListsByNewest(ctx context.Context, arg ListSeasonTierListsByNewestParams) ([]TierList, error)
+	// 人気順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+	ListSeasonTierListsByPopular(ctx context.Context, arg ListSeasonTierListsByPopularParams) ([]TierList, error)
+	// トレンド順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+	ListSeasonTierListsByTrending(ctx context.Context, arg ListSeasonTierListsByTrendingParams) ([]TierList, error)
 	ListSeasons(ctx context.Context) ([]Season, error)
+	// ティアリストの信頼度の操作
+	// シーズン内のティアリストの投稿者情報を取得（重複投稿の判定に使用）
+	ListTierListAuthorsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]ListTierListAuthorsBySeasonRow, error)
+	// コメントへの返信を古い順に取得する。カーソルは (created_at, comment_id)
+	// モデレーターが非表示にした返信は含めない
+	ListTierListCommentReplies(ctx context.Context, arg ListTierListCommentRepliesParams) ([]ListTierListCommentRepliesRow, error)
+	// トップレベルのコメントを新しい順に取得する。カーソルは (created_at, comment_id)
+	// 削除済みのコメントも返信のスレッドを残すため含める。モデレーターが非表示にしたコメントと返信は含めない
+	ListTierListComments(ctx context.Context, arg ListTierListCommentsParams) ([]ListTierListCommentsRow, error)
+	// シャードを合計したお気に入り数。お気に入りされたことがないティアリストは含まない
+	ListTierListFavoriteCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]ListTierListFavoriteCountsRow, error)
+	// シャードを合計したいいね数。いいねされたことがないティアリストは含まない
+	ListTierListLikeCounts(ctx context.Context, tierListIds []pgtype.UUID) ([]ListTierListLikeCountsRow, error)
+	// 新しいリビジョンから順に取得
+	ListTierListRevisions(ctx context.Context, tierListID pgtype.UUID) ([]TierListRevision, error)
+	// ホット順（いいね数・閲覧数に作成日時の新しさを加味したスコアの高い順）。カーソルは (hot_score, tier_list_id)
+	// hot_score は tier_list_hot_score() で算出し、いいね数・閲覧数の更新時に再計算して保存している
+	ListTierListsByHot(ctx context.Context, arg ListTierListsByHotParams) ([]TierList, error)
+	// 作成日時の新しい順。カーソルは (created_at, tier_list_id)
+	ListTierListsByNewest(ctx context.Context, arg ListTierListsByNewestParams) ([]TierList, error)
+	// ティアリストの一覧取得（キーセットページネーション）
+	// モデレーターが非表示にしたティアリストは一覧に含めない
+	// 任意指定の条件を IS NULL OR で書くとインデックスが使われないため、シーズンの指定有無でクエリを分ける
+	// 閲覧数の多い順。カーソルは (view_count, tier_list_id)
+	ListTierListsByPopular(ctx context.Context, arg ListTierListsByPopularParams) ([]TierList, error)
+	// 直近7日間の閲覧数（トレンドスコア）の多い順。カーソルは (trending_score, tier_list_id)
+	ListTierListsByTrending(ctx context.Context, arg ListTierListsByTrendingParams) ([]TierList, error)
+	// シーズン内の全ティアリストの配置を信頼度とともに取得（集計ティアリストの算出に使用）
+	// 信頼度が評価されていないティアリストは重み1として扱う
+	// モデレーターが非表示にしたティアリスト・デッキは含めない
+	ListTierPlacementsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]ListTierPlacementsBySeasonRow, error)
+	// ティア配置の操作
+	// ティアの強い順、ティア内の並び順で取得
+	ListTierPlacementsByTierList(ctx context.Context, tierListID pgtype.UUID) ([]TierPlacement, error)
+	// 統計を取得（season_id を省略した場合は全シーズン）
+	ListTierStatistics(ctx context.Context, seasonID pgtype.UUID) ([]TierStatistic, error)
+	// シーズン内の配置があるデッキの統計を取得（集計ティアリストの算出に使用）
+	// モデレーターが非表示にしたデッキは集計ティアリストに含めない
+	ListTierStatisticsBySeason(ctx context.Context, seasonID pgtype.UUID) ([]TierStatistic, error)
+	// ホットスコアの再計算の前にティアリストの行ロックを取得し、同時に更新されたいいね数・閲覧数を次の文で読めるようにする
+	// いいねの追加で外部キーの検査が取る共有ロックと競合しないよう FOR NO KEY UPDATE とする
+	LockTierListForHotScore(ctx context.Context, tierListID pgtype.UUID) error
+	// 統計の再作成中に差分更新（加算・減算）が割り込まないよう、トランザクションの終了まで統計への書き込みを待たせる
+	// 差分更新が取る ROW EXCLUSIVE ロックと競合し、参照（集計ティアリストの算出）は妨げない
+	LockTierStatistics(ctx context.Context) error
+	// 配置から統計を再作成（事前に DeleteTierStatistics で削除しておく）
+	RebuildTierStatistics(ctx context.Context, seasonID pgtype.UUID) error
+	// ログインの失敗を1回加算する。連続した失敗が max_failed_logins 回に達した場合は locked_until までロックし、失敗回数を数え直す
+	// 同時に失敗したログインの加算が失われないよう、読み込んだ値ではなく行の現在の値から加算する
+	RecordCredentialLoginFailure(ctx context.Context, arg RecordCredentialLoginFailureParams) (UserCredential, error)
+	// シャードを合計したいいね数と累計閲覧数からホットスコアを再計算する
+	RefreshTierListHotScore(ctx context.Context, tierListID pgtype.UUID) error
+	// トレンドスコアを直近7日間の日別閲覧数から再計算し、集計期間から外れた日の閲覧数を除く
+	// 閲覧のないティアリストのスコアは0のままのため、スコアが0より大きいティアリストのみを対象にする
+	// 実行中に記録された閲覧との差分は次回の再計算で解消される
+	RefreshTierListTrendingScores(ctx context.Context) (int64, error)
+	// いいねしていない場合は0行を返す
+	RemoveCommentLike(ctx context.Context, arg RemoveCommentLikeParams) (int64, error)
+	// 登録されていない場合は0行を返す
+	RemoveDeckFavorite(ctx context.Context, arg RemoveDeckFavoriteParams) (int64, error)
+	// 登録されていない場合は0行を返す
+	RemoveTierListFavorite(ctx context.Context, arg RemoveTierListFavoriteParams) (int64, error)
+	// いいねしていない場合は0行を返す
+	RemoveTierListLike(ctx context.Context, arg RemoveTierListLikeParams) (int64, error)
+	// ログインの成功時に失敗回数とロックをリセットする（パスワードなど他の列は更新しない）
+	ResetCredentialLoginFailures(ctx context.Context, userID pgtype.UUID) error
+	// 対象への未対応の通報をすべて対応済みにする。未対応の通報がない場合は0行を返す
+	ResolveContentReports(ctx context.Context, arg ResolveContentReportsParams) (int64, error)
+	// セッションを失効させる。既に失効している場合は最初の理由を残す
+	RevokeUserSession(ctx context.Context, arg RevokeUserSessionParams) error
+	// ユーザーの失効していないセッションをすべて失効させる
+	RevokeUserSessionsByUser(ctx context.Context, arg RevokeUserSessionsByUserParams) (int64, error)
 	// シーズンのCRUD操作
 	// Upsert: 存在する場合は更新、しない場合は挿入
 	SaveSeason(ctx context.Context, arg SaveSeasonParams) (Season, error)
+	// Upsert: 評価済みの場合は評価結果を置き換える
+	SaveTierListTrustScore(ctx context.Context, arg SaveTierListTrustScoreParams) error
+	// デッキの推移の操作
+	// シーズンのティア統計を指定日のスナップショットとして記録する
+	// 同じ日に再実行した場合は最新のティア統計で置き換える
+	SnapshotDeckTrends(ctx context.Context, arg SnapshotDeckTrendsParams) (int64, error)
+	// ティアリストの配置を統計から減算する（配置の削除前・信頼度の更新前に呼び出す）
+	// 非表示のティアリストは統計に含まれていないため減算しない
+	SubtractTierListFromStatistics(ctx context.Context, tierListID pgtype.UUID) error
+	// 配置の更新時に更新日時を進める
+	TouchTierList(ctx context.Context, tierListID pgtype.UUID) error
+	// リフレッシュトークンの交換時に最終使用日時と有効期限を更新する。失効済みの場合は0行を返す
+	TouchUserSession(ctx context.Context, arg TouchUserSessionParams) (int64, error)
+	// フォローしていない場合は0行を返す
+	UnfollowUser(ctx context.Context, arg UnfollowUserParams) (int64, error)
+	// モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+	UpdateDeckHiddenAt(ctx context.Context, arg UpdateDeckHiddenAtParams) error
 	UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Season, error)
+	// 編集・削除後の本文・言及したデッキ・日時を保存する
+	UpdateTierListComment(ctx context.Context, arg UpdateTierListCommentParams) error
+	// モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+	UpdateTierListCommentHiddenAt(ctx context.Context, arg UpdateTierListCommentHiddenAtParams) error
+	// モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+	UpdateTierListHiddenAt(ctx context.Context, arg UpdateTierListHiddenAtParams) error
+	// 表示名・権限・無効化状態を更新する
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	// パスワード・メールアドレスの確認状態・ログイン失敗の状態を更新する
+	UpdateUserCredential(ctx context.Context, arg UpdateUserCredentialParams) (UserCredential, error)
+	// 未使用のトークンを使用済みにする。既に使用済みの場合は0行を返す
+	UseUserAccountToken(ctx context.Context, arg UseUserAccountTokenParams) (int64, error)
+	// 未使用のトークンを交換済みにする。既に交換済みの場合は0行を返す
+	UseUserRefreshToken(ctx context.Context, arg UseUserRefreshTokenParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,7 @@
+-- トリガーを削除
+DROP TRIGGER IF EXISTS update_tier_lists_updated_at ON tier_lists;
+
+-- テーブルを削除
+DROP TABLE IF EXISTS tier_list_daily_views;
+DROP TABLE IF EXISTS tier_placements;
+DROP TABLE IF EXISTS tier_lists;
@@ -0,0 +1,49 @@
+-- ティアリスト集約テーブル
+CREATE TABLE tier_lists (
+    tier_list_id UUID PRIMARY KEY,
+    season_id UUID NOT NULL REFERENCES seasons(season_id),
+    title VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    author_name VARCHAR(30) NOT NULL DEFAULT '匿名ユーザー',
+    view_count INTEGER NOT NULL DEFAULT 0 CHECK (view_count >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ティア配置テーブル
+CREATE TABLE tier_placements (
+    tier_placement_id UUID PRIMARY KEY,
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    deck_id UUID NOT NULL,
+    tier_rank SMALLINT NOT NULL CHECK (tier_rank BETWEEN 1 AND 7),
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tier_list_id, deck_id)
+);
+
+-- 日別閲覧数テーブル（トレンド順の算出に使用）
+CREATE TABLE tier_list_daily_views (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    view_date DATE NOT NULL,
+    view_count INTEGER NOT NULL DEFAULT 0 CHECK (view_count >= 0),
+    PRIMARY KEY (tier_list_id, view_date)
+);
+
+-- 一覧のキーセットページネーション用の複合インデックス
+-- 並び順のキーに tier_list_id を加え、同値でも順序が一意に定まるようにする
+CREATE INDEX idx_tier_lists_popular ON tier_lists (view_count DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_season_popular ON tier_lists (season_id, view_count DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_newest ON tier_lists (created_at DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_season_newest ON tier_lists (season_id, created_at DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_author_newest ON tier_lists (author_name, created_at DESC, tier_list_id DESC);
+
+CREATE INDEX idx_tier_placements_deck ON tier_placements (deck_id);
+CREATE INDEX idx_tier_placements_rank ON tier_placements (tier_rank);
+
+-- 直近の閲覧数を日付範囲で集計するためのインデックス
+CREATE INDEX idx_tier_list_daily_views_date ON tier_list_daily_views (view_date, tier_list_id);
+
+CREATE TRIGGER update_tier_lists_updated_at
+    BEFORE UPDATE ON tier_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,8 @@
+-- 外部キーを削除
+ALTER TABLE tier_placements DROP CONSTRAINT IF EXISTS fk_tier_placements_deck;
+
+-- トリガーを削除
+DROP TRIGGER IF EXISTS update_decks_updated_at ON decks;
+
+-- テーブルを削除
+DROP TABLE IF EXISTS decks;
@@ -0,0 +1,27 @@
+-- デッキ集約テーブル（シーズン単位）
+CREATE TABLE decks (
+    deck_id UUID PRIMARY KEY,
+    season_id UUID NOT NULL REFERENCES seasons(season_id),
+    primary_card_id UUID NOT NULL,
+    secondary_card_id UUID,
+    tertiary_card_id UUID,
+    nickname VARCHAR(30) NOT NULL,
+    card_names TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_decks_season ON decks (season_id);
+CREATE INDEX idx_decks_primary_card ON decks (primary_card_id);
+CREATE INDEX idx_decks_nickname ON decks (nickname);
+
+CREATE TRIGGER update_decks_updated_at
+    BEFORE UPDATE ON decks
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ティア配置からデッキへの外部キーを追加
+ALTER TABLE tier_placements
+    ADD CONSTRAINT fk_tier_placements_deck
+    FOREIGN KEY (deck_id) REFERENCES decks(deck_id);
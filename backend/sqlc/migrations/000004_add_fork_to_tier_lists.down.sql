@@ -0,0 +1,7 @@
+-- インデックスを削除
+DROP INDEX IF EXISTS idx_tier_lists_forked_from_newest;
+
+-- カラムを削除
+ALTER TABLE tier_lists
+    DROP COLUMN IF EXISTS fork_count,
+    DROP COLUMN IF EXISTS forked_from_tier_list_id;
@@ -0,0 +1,10 @@
+-- フォーク元のティアリストとフォーク数を追加
+-- フォーク元が削除されてもフォークしたティアリストは残す
+ALTER TABLE tier_lists
+    ADD COLUMN forked_from_tier_list_id UUID REFERENCES tier_lists(tier_list_id) ON DELETE SET NULL,
+    ADD COLUMN fork_count INTEGER NOT NULL DEFAULT 0 CHECK (fork_count >= 0);
+
+-- 「このティアリストのフォーク」一覧を新着順で取得するためのインデックス
+CREATE INDEX idx_tier_lists_forked_from_newest
+    ON tier_lists (forked_from_tier_list_id, created_at DESC, tier_list_id DESC)
+    WHERE forked_from_tier_list_id IS NOT NULL;
@@ -0,0 +1,2 @@
+-- テーブルを削除
+DROP TABLE IF EXISTS tier_list_revisions;
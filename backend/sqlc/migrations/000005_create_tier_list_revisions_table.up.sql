@@ -0,0 +1,13 @@
+-- ティアリストのリビジョン（保存のたびに配置のスナップショットと操作を記録）
+CREATE TABLE tier_list_revisions (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL CHECK (revision_number >= 1),
+    -- 配置のスナップショット: [{"deck_id": "...", "tier_rank": 7, "position": 0}, ...]
+    placements JSONB NOT NULL,
+    -- 直前のリビジョンからの操作: [{"type": "moved", "deck_id": "...", "from_tier_rank": 5, ...}, ...]
+    operations JSONB NOT NULL,
+    -- 復元によって作成された場合の復元元リビジョン番号
+    restored_from_revision INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tier_list_id, revision_number)
+);
@@ -0,0 +1,24 @@
+-- ティア統計テーブル（デッキ×シーズンごとの配置ランクの累計）
+-- ティアリストの作成・配置の更新と同じトランザクションで差分を反映する
+CREATE TABLE tier_statistics (
+    deck_id UUID NOT NULL,
+    season_id UUID NOT NULL REFERENCES seasons(season_id),
+    rank_sum BIGINT NOT NULL DEFAULT 0 CHECK (rank_sum >= 0),
+    placement_count INTEGER NOT NULL DEFAULT 0 CHECK (placement_count >= 0),
+    -- 平均ティアランク（配置がない場合は0）
+    tier_rank DOUBLE PRECISION GENERATED ALWAYS AS (
+        CASE WHEN placement_count > 0 THEN rank_sum::double precision / placement_count ELSE 0 END
+    ) STORED,
+    calculated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (deck_id, season_id)
+);
+
+CREATE INDEX idx_tier_statistics_season ON tier_statistics (season_id);
+CREATE INDEX idx_tier_statistics_rank ON tier_statistics (tier_rank DESC);
+
+-- 既存の配置から初期値を作成
+INSERT INTO tier_statistics (deck_id, season_id, rank_sum, placement_count)
+SELECT tp.deck_id, tl.season_id, SUM(tp.tier_rank), COUNT(*)
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+GROUP BY tp.deck_id, tl.season_id;
@@ -0,0 +1,18 @@
+-- 平均ティアランクを重みなしの平均に戻す
+ALTER TABLE tier_statistics DROP COLUMN tier_rank;
+ALTER TABLE tier_statistics
+    DROP COLUMN IF EXISTS weighted_rank_sum,
+    DROP COLUMN IF EXISTS weight_sum;
+ALTER TABLE tier_statistics
+    ADD COLUMN tier_rank DOUBLE PRECISION GENERATED ALWAYS AS (
+        CASE WHEN placement_count > 0 THEN rank_sum::double precision / placement_count ELSE 0 END
+    ) STORED;
+
+CREATE INDEX idx_tier_statistics_rank ON tier_statistics (tier_rank DESC);
+
+-- テーブルを削除
+DROP TABLE IF EXISTS tier_list_trust_scores;
+
+-- カラムを削除
+ALTER TABLE tier_lists
+    DROP COLUMN IF EXISTS author_ip;
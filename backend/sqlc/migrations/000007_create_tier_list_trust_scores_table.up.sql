@@ -0,0 +1,44 @@
+-- 投稿元のIPアドレスを追加（荒らし検知で同一投稿者による重複投稿の判定に使用）
+ALTER TABLE tier_lists
+    ADD COLUMN author_ip VARCHAR(45) NOT NULL DEFAULT '';
+
+-- ティアリストの信頼度（集計ティアリストとの乖離や重複投稿から算出し、集計時の重みとして使用する）
+-- 評価前のティアリストは信頼度1として扱う
+CREATE TABLE tier_list_trust_scores (
+    tier_list_id UUID PRIMARY KEY REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    season_id UUID NOT NULL REFERENCES seasons(season_id),
+    trust_weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (trust_weight BETWEEN 0 AND 1),
+    -- 集計ティアリストとのランク差（二乗平均平方根）
+    rank_distance DOUBLE PRECISION NOT NULL DEFAULT 0,
+    -- シーズン内でのランク差の z スコア
+    z_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    -- 重複投稿と判定された場合の元のティアリスト
+    duplicate_of_tier_list_id UUID REFERENCES tier_lists(tier_list_id) ON DELETE SET NULL,
+    -- フラグの理由（outlier, near_duplicate）。空の場合はフラグなし
+    flag_reasons TEXT[] NOT NULL DEFAULT '{}',
+    evaluated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- モデレーター向けにフラグ付きのティアリストを信頼度の低い順で取得するためのインデックス
+CREATE INDEX idx_tier_list_trust_scores_flagged
+    ON tier_list_trust_scores (trust_weight ASC, tier_list_id ASC)
+    WHERE cardinality(flag_reasons) > 0;
+CREATE INDEX idx_tier_list_trust_scores_season ON tier_list_trust_scores (season_id);
+
+-- ティア統計に信頼度で重み付けした累計を追加（既存の配置はすべて重み1）
+ALTER TABLE tier_statistics
+    ADD COLUMN weighted_rank_sum DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN weight_sum DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+UPDATE tier_statistics
+SET weighted_rank_sum = rank_sum,
+    weight_sum = placement_count;
+
+-- 平均ティアランクを重み付き平均に置き換える
+ALTER TABLE tier_statistics DROP COLUMN tier_rank;
+ALTER TABLE tier_statistics
+    ADD COLUMN tier_rank DOUBLE PRECISION GENERATED ALWAYS AS (
+        CASE WHEN placement_count > 0 AND weight_sum > 0 THEN weighted_rank_sum / weight_sum ELSE 0 END
+    ) STORED;
+
+CREATE INDEX idx_tier_statistics_rank ON tier_statistics (tier_rank DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS deck_trend_snapshots;
@@ -0,0 +1,15 @@
+-- デッキの推移テーブル（シーズン内のデッキごとの集計ティアリストの日次スナップショット）
+-- ティア統計の平均ティアランクをバックグラウンドジョブで1日1回記録する
+CREATE TABLE deck_trend_snapshots (
+    season_id UUID NOT NULL REFERENCES seasons(season_id),
+    deck_id UUID NOT NULL,
+    snapshot_date DATE NOT NULL,
+    -- 記録時点の重み付き平均ティアランク（SS=7 〜 E=1）
+    tier_rank DOUBLE PRECISION NOT NULL,
+    placement_count INTEGER NOT NULL CHECK (placement_count >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (season_id, deck_id, snapshot_date)
+);
+
+-- 急上昇・急下降の算出で日付ごとに全デッキを取得するためのインデックス
+CREATE INDEX idx_deck_trend_snapshots_date ON deck_trend_snapshots (season_id, snapshot_date);
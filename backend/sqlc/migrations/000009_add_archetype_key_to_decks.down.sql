@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_decks_archetype_key;
+ALTER TABLE decks DROP COLUMN IF EXISTS archetype_key;
+DROP FUNCTION IF EXISTS deck_archetype_key(UUID, UUID, UUID);
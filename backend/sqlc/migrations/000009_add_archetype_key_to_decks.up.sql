@@ -0,0 +1,16 @@
+-- デッキのアーキタイプキー（シーズンをまたいで同じデッキを対応付けるための正規化したカード構成）
+-- デッキはシーズン単位のためシーズンごとに deck_id が異なるが、カード構成が同じであれば同じアーキタイプとみなす
+-- カードIDを昇順に並べてカンマ区切りにしたもので、カードの指定順序には依存しない
+CREATE OR REPLACE FUNCTION deck_archetype_key(primary_card_id UUID, secondary_card_id UUID, tertiary_card_id UUID)
+RETURNS TEXT AS $$
+    SELECT string_agg(card_id::text, ',' ORDER BY card_id::text)
+    FROM unnest(ARRAY[primary_card_id, secondary_card_id, tertiary_card_id]) AS card_id
+    WHERE card_id IS NOT NULL;
+$$ LANGUAGE sql IMMUTABLE;
+
+ALTER TABLE decks
+    ADD COLUMN archetype_key TEXT GENERATED ALWAYS AS (
+        deck_archetype_key(primary_card_id, secondary_card_id, tertiary_card_id)
+    ) STORED;
+
+CREATE INDEX idx_decks_archetype_key ON decks (archetype_key);
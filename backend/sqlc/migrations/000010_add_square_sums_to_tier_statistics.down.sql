@@ -0,0 +1,3 @@
+ALTER TABLE tier_statistics
+    DROP COLUMN IF EXISTS weighted_rank_square_sum,
+    DROP COLUMN IF EXISTS weight_square_sum;
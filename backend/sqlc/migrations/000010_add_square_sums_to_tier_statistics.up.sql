@@ -0,0 +1,23 @@
+-- ティア統計に二乗の累計を追加（集計ティアリストの平均ランクの信頼区間の算出に使用する）
+-- weighted_rank_square_sum は信頼度で重み付けしたランクの二乗の累計、weight_square_sum は信頼度の二乗の累計
+ALTER TABLE tier_statistics
+    ADD COLUMN weighted_rank_square_sum DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN weight_square_sum DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- 既存の配置から初期値を作成
+UPDATE tier_statistics ts
+SET weighted_rank_square_sum = agg.weighted_rank_square_sum,
+    weight_square_sum = agg.weight_square_sum
+FROM (
+    SELECT
+        tp.deck_id,
+        tl.season_id,
+        SUM(tp.tier_rank * tp.tier_rank * COALESCE(tr.trust_weight, 1)) AS weighted_rank_square_sum,
+        SUM(COALESCE(tr.trust_weight, 1) * COALESCE(tr.trust_weight, 1)) AS weight_square_sum
+    FROM tier_placements tp
+    INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+    LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+    GROUP BY tp.deck_id, tl.season_id
+) agg
+WHERE ts.deck_id = agg.deck_id
+  AND ts.season_id = agg.season_id;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP TABLE IF EXISTS users;
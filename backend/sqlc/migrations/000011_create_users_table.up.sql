@@ -0,0 +1,16 @@
+-- ユーザー集約テーブル
+-- 権限は user / moderator / admin のいずれか（guest は未ログインの利用者のため永続化しない）
+-- disabled_at が設定されたユーザーは無効化されている
+CREATE TABLE users (
+    user_id UUID PRIMARY KEY,
+    display_name VARCHAR(30) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator', 'admin')),
+    disabled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_users_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+-- インデックスを削除
+DROP INDEX IF EXISTS idx_decks_author_user;
+DROP INDEX IF EXISTS idx_tier_lists_author_user_newest;
+
+-- カラムを削除
+ALTER TABLE decks DROP COLUMN IF EXISTS author_user_id;
+ALTER TABLE tier_lists DROP COLUMN IF EXISTS author_user_id;
@@ -0,0 +1,16 @@
+-- ティアリストとデッキの作成者ユーザー（任意）を追加
+-- 匿名で作成されたものは NULL のまま。ユーザーが削除されても作成物は残す
+ALTER TABLE tier_lists
+    ADD COLUMN author_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL;
+
+ALTER TABLE decks
+    ADD COLUMN author_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL;
+
+-- 「このユーザーのティアリスト」一覧を新着順で取得するためのインデックス
+CREATE INDEX idx_tier_lists_author_user_newest
+    ON tier_lists (author_user_id, created_at DESC, tier_list_id DESC)
+    WHERE author_user_id IS NOT NULL;
+
+CREATE INDEX idx_decks_author_user
+    ON decks (author_user_id)
+    WHERE author_user_id IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_account_tokens;
+DROP TRIGGER IF EXISTS update_user_credentials_updated_at ON user_credentials;
+DROP TABLE IF EXISTS user_credentials;
@@ -0,0 +1,33 @@
+-- メールアドレス・パスワードでログインするユーザーの認証情報
+-- email は小文字に正規化して保存する
+-- password_hash は argon2id のPHC形式文字列
+-- failed_login_count は連続したログイン失敗回数、locked_until が未来の場合はログインをロックしている
+CREATE TABLE user_credentials (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(254) NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    email_verified_at TIMESTAMPTZ,
+    failed_login_count INTEGER NOT NULL DEFAULT 0 CHECK (failed_login_count >= 0),
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_user_credentials_updated_at
+    BEFORE UPDATE ON user_credentials
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- メールアドレス確認・パスワード再設定のワンタイムトークン
+-- トークン自体は保存せず、SHA-256ハッシュ（16進数）のみを保存する
+-- used_at が設定されたトークンは使用済み
+CREATE TABLE user_account_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    purpose VARCHAR(30) NOT NULL CHECK (purpose IN ('email_verification', 'password_reset')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_account_tokens_user_purpose ON user_account_tokens (user_id, purpose);
@@ -0,0 +1,27 @@
+-- 外部IDプロバイダー（OIDC）のアカウントとユーザーの紐付け
+-- subject はプロバイダー内でアカウントを一意に識別する sub クレーム
+-- email はログイン時にプロバイダーから受け取ったメールアドレス（参考情報。ログインの照合には使用しない）
+CREATE TABLE user_identities (
+    provider VARCHAR(32) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(254),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
+
+-- OIDCの認可リクエスト（認可コードフロー + PKCE）
+-- コールバックで state を照合し、code_verifier と nonce を取り出して1回のみ使用する
+-- state 自体は保存せず、SHA-256ハッシュ（16進数）のみを保存する
+CREATE TABLE user_oidc_auth_requests (
+    state_hash CHAR(64) PRIMARY KEY,
+    provider VARCHAR(32) NOT NULL,
+    code_verifier TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_oidc_auth_requests_expires_at ON user_oidc_auth_requests (expires_at);
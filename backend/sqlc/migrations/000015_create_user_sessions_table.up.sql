@@ -0,0 +1,29 @@
+-- ログインごとのセッション（リフレッシュトークンのファミリー）
+-- expires_at はリフレッシュトークンを使用するたびに延長する（最後の使用から一定期間使用されなければ期限切れ）
+-- revoked_at が設定されたセッションは失効しており、リフレッシュトークンを使用できない
+-- revoked_reason は失効の理由（ログアウト・全端末からのログアウト・リフレッシュトークンの再使用・管理者による強制ログアウト・パスワード再設定）
+CREATE TABLE user_sessions (
+    session_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    revoked_reason VARCHAR(30) CHECK (revoked_reason IN ('logout', 'logout_all', 'token_reuse', 'admin', 'password_reset')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_sessions_user_id ON user_sessions (user_id);
+
+-- セッションで発行したリフレッシュトークン
+-- トークン自体は保存せず、SHA-256ハッシュ（16進数）のみを保存する
+-- used_at が設定されたトークンは新しいトークンに交換済みで、再び使用された場合はセッションを失効させる
+CREATE TABLE user_refresh_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES user_sessions(session_id) ON DELETE CASCADE,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_refresh_tokens_session_id ON user_refresh_tokens (session_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS deck_favorite_counts;
+DROP TABLE IF EXISTS tier_list_favorite_counts;
+DROP TABLE IF EXISTS deck_favorites;
+DROP TABLE IF EXISTS tier_list_favorites;
@@ -0,0 +1,36 @@
+-- ユーザーがお気に入りに登録したティアリスト・デッキ
+-- 「自分のお気に入り」一覧を登録日時の新しい順に取得する
+CREATE TABLE tier_list_favorites (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, tier_list_id)
+);
+
+CREATE INDEX idx_tier_list_favorites_user_newest ON tier_list_favorites (user_id, created_at DESC, tier_list_id DESC);
+
+CREATE TABLE deck_favorites (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    deck_id UUID NOT NULL REFERENCES decks(deck_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, deck_id)
+);
+
+CREATE INDEX idx_deck_favorites_user_newest ON deck_favorites (user_id, created_at DESC, deck_id DESC);
+
+-- お気に入り数のカウンター
+-- 人気のティアリスト・デッキで同じ行への更新が集中しないよう、登録・解除のたびにランダムなシャードへ差分を加算し、
+-- 読み取り時にシャードを合計する。解除は登録と別のシャードから減算するため、シャード単体の値は負になり得る
+CREATE TABLE tier_list_favorite_counts (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    shard SMALLINT NOT NULL CHECK (shard >= 0),
+    favorite_count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (tier_list_id, shard)
+);
+
+CREATE TABLE deck_favorite_counts (
+    deck_id UUID NOT NULL REFERENCES decks(deck_id) ON DELETE CASCADE,
+    shard SMALLINT NOT NULL CHECK (shard >= 0),
+    favorite_count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (deck_id, shard)
+);
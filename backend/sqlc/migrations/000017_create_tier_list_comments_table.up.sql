@@ -0,0 +1,24 @@
+-- ティアリストへのコメント
+-- 返信は1階層のみで、parent_comment_id はトップレベルのコメント（parent_comment_id が NULL）を指す
+-- 削除は論理削除とし、返信のスレッドを残すため本文を空にして deleted_at を記録する（一覧には削除済みとして表示する）
+CREATE TABLE tier_list_comments (
+    comment_id UUID PRIMARY KEY,
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    parent_comment_id UUID REFERENCES tier_list_comments(comment_id) ON DELETE CASCADE,
+    author_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    -- 言及したデッキ（任意）。デッキが削除された場合は言及を外す
+    deck_id UUID REFERENCES decks(deck_id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+-- トップレベルのコメントは新しい順、返信は古い順に取得する
+CREATE INDEX idx_tier_list_comments_thread ON tier_list_comments (tier_list_id, created_at DESC, comment_id DESC)
+    WHERE parent_comment_id IS NULL;
+CREATE INDEX idx_tier_list_comments_replies ON tier_list_comments (parent_comment_id, created_at, comment_id)
+    WHERE parent_comment_id IS NOT NULL;
+
+-- ユーザーごとの投稿数の制限で直近の投稿数を数える
+CREATE INDEX idx_tier_list_comments_author_recent ON tier_list_comments (author_user_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tier_list_like_counts;
+DROP TABLE IF EXISTS comment_likes;
+DROP TABLE IF EXISTS tier_list_likes;
@@ -0,0 +1,24 @@
+-- ユーザーがティアリスト・コメントに付けたいいね
+-- いいね数はティアリスト・コメントごとに数えるため、主キーは対象のIDを先頭にする
+CREATE TABLE tier_list_likes (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tier_list_id, user_id)
+);
+
+CREATE TABLE comment_likes (
+    comment_id UUID NOT NULL REFERENCES tier_list_comments(comment_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, user_id)
+);
+
+-- ティアリストのいいね数のカウンター
+-- ホット順の並び替えで全ティアリストのいいね数を読むため、お気に入り数と同様にシャードへ差分を加算して読み取り時に合計する
+CREATE TABLE tier_list_like_counts (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    shard SMALLINT NOT NULL CHECK (shard >= 0),
+    like_count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (tier_list_id, shard)
+);
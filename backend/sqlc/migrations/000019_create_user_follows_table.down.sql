@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_decks_author_user_newest;
+
+CREATE INDEX idx_decks_author_user
+    ON decks (author_user_id)
+    WHERE author_user_id IS NOT NULL;
+
+DROP TABLE IF EXISTS user_follows;
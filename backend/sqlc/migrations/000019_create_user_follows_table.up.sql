@@ -0,0 +1,20 @@
+-- ユーザー間のフォロー
+-- フィードは読み取り時にフォロー中のユーザーの新着を集めて生成する
+CREATE TABLE user_follows (
+    follower_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    followee_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_user_id, followee_user_id),
+    CHECK (follower_user_id <> followee_user_id)
+);
+
+-- フォローされているユーザーの削除時に CASCADE で対象の行を探すためのインデックス
+CREATE INDEX idx_user_follows_followee ON user_follows (followee_user_id);
+
+-- フィードはフォロー中のユーザーごとに新着順で先頭の数件だけを読むため、
+-- デッキにもティアリスト（idx_tier_lists_author_user_newest）と同じ作成者別の新着順インデックスを用意する
+DROP INDEX IF EXISTS idx_decks_author_user;
+
+CREATE INDEX idx_decks_author_user_newest
+    ON decks (author_user_id, created_at DESC, deck_id DESC)
+    WHERE author_user_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS moderation_actions;
+DROP TABLE IF EXISTS content_reports;
+
+ALTER TABLE decks DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE tier_list_comments DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE tier_lists DROP COLUMN IF EXISTS hidden_at;
@@ -0,0 +1,46 @@
+-- モデレーターが非表示にしたティアリスト・コメント・デッキ
+-- 非表示のコンテンツは公開の一覧と集計ティアリストの入力から除外する（作成者の削除とは異なり、復元できるよう行は残す）
+ALTER TABLE tier_lists ADD COLUMN hidden_at TIMESTAMPTZ;
+ALTER TABLE tier_list_comments ADD COLUMN hidden_at TIMESTAMPTZ;
+ALTER TABLE decks ADD COLUMN hidden_at TIMESTAMPTZ;
+
+-- ユーザーによるティアリスト・コメント・デッキの通報
+-- 対象は種類ごとに別のテーブルのため外部キーは張らず、通報時に存在を確認する
+CREATE TABLE content_reports (
+    report_id UUID PRIMARY KEY,
+    target_type TEXT NOT NULL CHECK (target_type IN ('tier_list', 'comment', 'deck')),
+    target_id UUID NOT NULL,
+    reporter_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason TEXT NOT NULL CHECK (reason IN ('spam', 'harassment', 'inappropriate', 'other')),
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- モデレーターが対応した日時と対応結果（未対応の場合は NULL）
+    resolved_at TIMESTAMPTZ,
+    resolution TEXT CHECK (resolution IN ('hidden', 'dismissed')),
+    CHECK ((resolved_at IS NULL) = (resolution IS NULL))
+);
+
+-- 同じユーザーは未対応の通報を同じ対象に重ねて作成できない
+CREATE UNIQUE INDEX uq_content_reports_open_reporter
+    ON content_reports (target_type, target_id, reporter_user_id)
+    WHERE resolved_at IS NULL;
+
+-- モデレーションキューは未対応の通報を対象ごとに集約して古い順に読む
+CREATE INDEX idx_content_reports_open
+    ON content_reports (target_type, target_id, created_at)
+    WHERE resolved_at IS NULL;
+
+-- モデレーターの操作（非表示・復元・通報の却下・ユーザーの利用停止）の監査ログ
+-- 操作したユーザーが削除されても記録は残し、管理用トークンでの操作は moderator_user_id を NULL とする
+CREATE TABLE moderation_actions (
+    action_id UUID PRIMARY KEY,
+    target_type TEXT NOT NULL CHECK (target_type IN ('tier_list', 'comment', 'deck', 'user')),
+    target_id UUID NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('hide', 'restore', 'dismiss', 'ban')),
+    moderator_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_moderation_actions_newest ON moderation_actions (created_at DESC, action_id DESC);
+CREATE INDEX idx_moderation_actions_target ON moderation_actions (target_type, target_id, created_at DESC, action_id DESC);
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS update_tier_lists_updated_at ON tier_lists;
+CREATE TRIGGER update_tier_lists_updated_at
+    BEFORE UPDATE ON tier_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+DROP TABLE IF EXISTS tier_list_viewers;
+
+DROP INDEX IF EXISTS idx_tier_lists_season_trending;
+DROP INDEX IF EXISTS idx_tier_lists_trending;
+ALTER TABLE tier_lists DROP COLUMN IF EXISTS trending_score;
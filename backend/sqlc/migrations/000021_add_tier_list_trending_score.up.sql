@@ -0,0 +1,38 @@
+-- トレンド順（直近7日間の閲覧数の多い順）の並び替えに使うスコア
+-- 一覧の取得のたびに日別閲覧数を集計せずに済むよう、閲覧の記録時に加算し、集計期間から外れた日の閲覧数は定期的な再計算で除く
+ALTER TABLE tier_lists
+    ADD COLUMN trending_score INTEGER NOT NULL DEFAULT 0 CHECK (trending_score >= 0);
+
+UPDATE tier_lists tl
+SET trending_score = v.recent_view_count
+FROM (
+    SELECT tier_list_id, SUM(view_count)::int AS recent_view_count
+    FROM tier_list_daily_views
+    WHERE view_date >= CURRENT_DATE - 7
+    GROUP BY tier_list_id
+) v
+WHERE v.tier_list_id = tl.tier_list_id;
+
+-- 人気順・新着順と同様に、並び順のキーに tier_list_id を加えたキーセットページネーション用のインデックス
+CREATE INDEX idx_tier_lists_trending ON tier_lists (trending_score DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_season_trending ON tier_lists (season_id, trending_score DESC, tier_list_id DESC);
+
+-- 閲覧の重複を判定するための閲覧者の記録
+-- 同じ閲覧者（ログイン中のユーザーまたはIPアドレス）による同じ日の閲覧は1回として数える
+CREATE TABLE tier_list_viewers (
+    tier_list_id UUID NOT NULL REFERENCES tier_lists(tier_list_id) ON DELETE CASCADE,
+    view_date DATE NOT NULL,
+    viewer_key TEXT NOT NULL,
+    PRIMARY KEY (tier_list_id, view_date, viewer_key)
+);
+
+-- 前日以前の記録は重複の判定に使わないため、日付で削除する
+CREATE INDEX idx_tier_list_viewers_date ON tier_list_viewers (view_date);
+
+-- 閲覧数・スコアの更新では更新日時を進めないよう、トリガーの対象を内容の列に限定する
+DROP TRIGGER IF EXISTS update_tier_lists_updated_at ON tier_lists;
+CREATE TRIGGER update_tier_lists_updated_at
+    BEFORE UPDATE OF season_id, title, description, author_name, forked_from_tier_list_id, fork_count, author_ip, author_user_id, hidden_at
+    ON tier_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tier_lists_season_hot;
+DROP INDEX IF EXISTS idx_tier_lists_hot;
+
+ALTER TABLE tier_lists DROP COLUMN IF EXISTS hot_score;
+
+DROP FUNCTION IF EXISTS tier_list_hot_score(BIGINT, INTEGER, TIMESTAMPTZ);
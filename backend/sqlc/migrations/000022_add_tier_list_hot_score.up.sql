@@ -0,0 +1,31 @@
+-- ホットスコアの算出式（いいね数・閲覧数に作成日時の新しさを加味したスコア）
+-- log10(max(いいね数 + 閲覧数 / 10, 1)) + (作成日時 - 2025-01-01T00:00:00Z) / 12.5時間 を100万倍して整数にする
+-- 閲覧数10件をいいね1件と同じ重みとして扱い、作成日時が12.5時間新しいことをいいね数・閲覧数が10倍になるのと同じだけ評価する
+-- スコアは時間の経過では変わらず、新しいティアリストほど高い値から始まることで古いティアリストが相対的に沈む
+CREATE FUNCTION tier_list_hot_score(like_count BIGINT, view_count INTEGER, created_at TIMESTAMPTZ)
+RETURNS BIGINT
+LANGUAGE SQL
+IMMUTABLE
+AS $$
+    SELECT FLOOR((
+        LOG(GREATEST($1 + $2 / 10.0::float8, 1))
+        + (EXTRACT(EPOCH FROM $3)::float8 - 1735689600) / 45000
+    ) * 1000000)::bigint
+$$;
+
+-- ホット順の並び替えに使うスコア
+-- 一覧の取得のたびに全件のスコアを算出せずに済むよう、いいね数・閲覧数の更新時に再計算して保存する
+-- 作成時はいいね数・閲覧数が0のため、作成日時（NOW()）のみからスコアを算出する
+ALTER TABLE tier_lists
+    ADD COLUMN hot_score BIGINT NOT NULL DEFAULT tier_list_hot_score(0, 0, NOW());
+
+UPDATE tier_lists tl
+SET hot_score = tier_list_hot_score(
+    COALESCE((SELECT SUM(l.like_count) FROM tier_list_like_counts l WHERE l.tier_list_id = tl.tier_list_id), 0)::bigint,
+    tl.view_count,
+    tl.created_at
+);
+
+-- トレンド順と同様に、並び順のキーに tier_list_id を加えたキーセットページネーション用のインデックス
+CREATE INDEX idx_tier_lists_hot ON tier_lists (hot_score DESC, tier_list_id DESC);
+CREATE INDEX idx_tier_lists_season_hot ON tier_lists (season_id, hot_score DESC, tier_list_id DESC);
@@ -0,0 +1,60 @@
+-- ティアリスト・コメント・デッキの通報と、モデレーションキューの取得
+
+-- name: CreateContentReport :exec
+-- 同じユーザーが同じ対象に未対応の通報をしている場合は一意制約違反となる
+INSERT INTO content_reports (
+    report_id,
+    target_type,
+    target_id,
+    reporter_user_id,
+    reason,
+    detail,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: ListOpenReportTargets :many
+-- 未対応の通報を対象ごとに集約し、最初に通報された日時の古い順に取得する。カーソルは (first_reported_at, target_id)
+-- reason を指定した場合はその理由の通報だけを集約する。対象が削除されている場合、content は空文字列で author_user_id は NULL となる
+WITH open_reports AS (
+    SELECT
+        r.target_type,
+        r.target_id,
+        COUNT(*)::bigint AS report_count,
+        array_agg(DISTINCT r.reason ORDER BY r.reason)::text[] AS reasons,
+        MIN(r.created_at)::timestamptz AS first_reported_at,
+        MAX(r.created_at)::timestamptz AS last_reported_at
+    FROM content_reports r
+    WHERE r.resolved_at IS NULL
+      AND (sqlc.narg('target_type')::text IS NULL OR r.target_type = sqlc.narg('target_type')::text)
+      AND (sqlc.narg('reason')::text IS NULL OR r.reason = sqlc.narg('reason')::text)
+    GROUP BY r.target_type, r.target_id
+)
+SELECT
+    o.target_type,
+    o.target_id,
+    o.report_count,
+    o.reasons,
+    o.first_reported_at,
+    o.last_reported_at,
+    COALESCE(tl.title, c.body, d.nickname, '')::text AS content,
+    COALESCE(tl.author_user_id, c.author_user_id, d.author_user_id) AS author_user_id,
+    COALESCE(tl.hidden_at, c.hidden_at, d.hidden_at) AS hidden_at
+FROM open_reports o
+LEFT JOIN tier_lists tl ON o.target_type = 'tier_list' AND tl.tier_list_id = o.target_id
+LEFT JOIN tier_list_comments c ON o.target_type = 'comment' AND c.comment_id = o.target_id
+LEFT JOIN decks d ON o.target_type = 'deck' AND d.deck_id = o.target_id
+WHERE sqlc.narg('cursor_first_reported_at')::timestamptz IS NULL
+   OR (o.first_reported_at, o.target_id) > (sqlc.narg('cursor_first_reported_at')::timestamptz, sqlc.narg('cursor_target_id')::uuid)
+ORDER BY o.first_reported_at, o.target_id
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ResolveContentReports :execrows
+-- 対象への未対応の通報をすべて対応済みにする。未対応の通報がない場合は0行を返す
+UPDATE content_reports
+SET resolved_at = $4,
+    resolution = $3
+WHERE target_type = $1
+  AND target_id = $2
+  AND resolved_at IS NULL;
@@ -0,0 +1,57 @@
+-- デッキのお気に入りとお気に入り数のカウンターの操作
+
+-- name: AddDeckFavorite :execrows
+-- 登録済みの場合は何もせず0行を返す
+INSERT INTO deck_favorites (
+    user_id,
+    deck_id
+) VALUES (
+    $1, $2
+) ON CONFLICT (user_id, deck_id) DO NOTHING;
+
+-- name: RemoveDeckFavorite :execrows
+-- 登録されていない場合は0行を返す
+DELETE FROM deck_favorites
+WHERE user_id = $1
+  AND deck_id = $2;
+
+-- name: ListFavoriteDecksByUser :many
+-- お気に入りの登録日時の新しい順。カーソルは (favorited_at, deck_id)
+-- モデレーターが非表示にしたデッキは含めない
+SELECT
+    d.deck_id,
+    d.season_id,
+    d.nickname,
+    d.image_url,
+    f.created_at AS favorited_at
+FROM deck_favorites f
+INNER JOIN decks d ON d.deck_id = f.deck_id
+WHERE f.user_id = sqlc.arg('user_id')::uuid
+  AND d.hidden_at IS NULL
+  AND (sqlc.narg('season_id')::uuid IS NULL OR d.season_id = sqlc.narg('season_id')::uuid)
+  AND (
+    sqlc.narg('cursor_favorited_at')::timestamptz IS NULL
+    OR (f.created_at, f.deck_id) < (sqlc.narg('cursor_favorited_at')::timestamptz, sqlc.narg('cursor_deck_id')::uuid)
+  )
+ORDER BY f.created_at DESC, f.deck_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: AddDeckFavoriteCount :exec
+-- 指定したシャードのお気に入り数に差分を加算する
+INSERT INTO deck_favorite_counts (
+    deck_id,
+    shard,
+    favorite_count
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (deck_id, shard) DO UPDATE
+SET favorite_count = deck_favorite_counts.favorite_count + EXCLUDED.favorite_count;
+
+-- name: ListDeckFavoriteCounts :many
+-- シャードを合計したお気に入り数。お気に入りされたことがないデッキは含まない
+SELECT
+    deck_id,
+    SUM(favorite_count)::bigint AS favorite_count
+FROM deck_favorite_counts
+WHERE deck_id = ANY(sqlc.arg('deck_ids')::uuid[])
+GROUP BY deck_id;
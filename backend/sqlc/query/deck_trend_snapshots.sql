@@ -0,0 +1,36 @@
+-- デッキの推移の操作
+
+-- name: SnapshotDeckTrends :execrows
+-- シーズンのティア統計を指定日のスナップショットとして記録する
+-- 同じ日に再実行した場合は最新のティア統計で置き換える
+INSERT INTO deck_trend_snapshots (
+    season_id,
+    deck_id,
+    snapshot_date,
+    tier_rank,
+    placement_count
+)
+SELECT season_id, deck_id, $2, tier_rank, placement_count
+FROM tier_statistics
+WHERE season_id = $1
+  AND placement_count > 0
+  AND weight_sum > 0
+ON CONFLICT (season_id, deck_id, snapshot_date) DO UPDATE
+SET tier_rank = EXCLUDED.tier_rank,
+    placement_count = EXCLUDED.placement_count,
+    created_at = NOW();
+
+-- name: ListDeckTrendSnapshotsByDeck :many
+SELECT * FROM deck_trend_snapshots
+WHERE season_id = $1 AND deck_id = $2
+ORDER BY snapshot_date ASC;
+
+-- name: ListDeckTrendSnapshotDates :many
+SELECT DISTINCT snapshot_date
+FROM deck_trend_snapshots
+WHERE season_id = $1
+ORDER BY snapshot_date ASC;
+
+-- name: ListDeckTrendSnapshotsByDate :many
+SELECT * FROM deck_trend_snapshots
+WHERE season_id = $1 AND snapshot_date = $2;
@@ -0,0 +1,22 @@
+-- デッキの参照
+
+-- name: ListDecksByIDs :many
+SELECT * FROM decks
+WHERE deck_id = ANY(sqlc.arg('deck_ids')::uuid[]);
+
+-- name: ListDecksBySeason :many
+-- モデレーターが非表示にしたデッキは含めない
+SELECT * FROM decks
+WHERE season_id = $1
+  AND hidden_at IS NULL
+ORDER BY nickname ASC, deck_id ASC;
+
+-- name: GetDeck :one
+SELECT * FROM decks
+WHERE deck_id = $1;
+
+-- name: UpdateDeckHiddenAt :exec
+-- モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+UPDATE decks
+SET hidden_at = $2
+WHERE deck_id = $1;
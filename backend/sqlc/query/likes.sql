@@ -0,0 +1,51 @@
+-- ティアリスト・コメントのいいねといいね数のカウンターの操作
+
+-- name: AddTierListLike :execrows
+-- いいね済みの場合は何もせず0行を返す
+INSERT INTO tier_list_likes (
+    tier_list_id,
+    user_id
+) VALUES (
+    $1, $2
+) ON CONFLICT (tier_list_id, user_id) DO NOTHING;
+
+-- name: RemoveTierListLike :execrows
+-- いいねしていない場合は0行を返す
+DELETE FROM tier_list_likes
+WHERE tier_list_id = $1
+  AND user_id = $2;
+
+-- name: AddTierListLikeCount :exec
+-- 指定したシャードのいいね数に差分を加算する
+INSERT INTO tier_list_like_counts (
+    tier_list_id,
+    shard,
+    like_count
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (tier_list_id, shard) DO UPDATE
+SET like_count = tier_list_like_counts.like_count + EXCLUDED.like_count;
+
+-- name: ListTierListLikeCounts :many
+-- シャードを合計したいいね数。いいねされたことがないティアリストは含まない
+SELECT
+    tier_list_id,
+    SUM(like_count)::bigint AS like_count
+FROM tier_list_like_counts
+WHERE tier_list_id = ANY(sqlc.arg('tier_list_ids')::uuid[])
+GROUP BY tier_list_id;
+
+-- name: AddCommentLike :execrows
+-- いいね済みの場合は何もせず0行を返す
+INSERT INTO comment_likes (
+    comment_id,
+    user_id
+) VALUES (
+    $1, $2
+) ON CONFLICT (comment_id, user_id) DO NOTHING;
+
+-- name: RemoveCommentLike :execrows
+-- いいねしていない場合は0行を返す
+DELETE FROM comment_likes
+WHERE comment_id = $1
+  AND user_id = $2;
@@ -0,0 +1,48 @@
+-- モデレーターの操作の監査ログと、操作の対象の取得
+
+-- name: GetModerationTarget :one
+-- 通報・モデレーションの対象のティアリスト・コメント・デッキの作成者・シーズン・非表示にした日時を取得する
+-- コメントはシーズンを持たないため season_id は NULL となる
+SELECT t.author_user_id, t.season_id, t.hidden_at
+FROM (
+    SELECT 'tier_list'::text AS target_type, tier_list_id AS target_id, author_user_id, season_id, hidden_at FROM tier_lists
+    UNION ALL
+    SELECT 'comment'::text, comment_id, author_user_id, NULL::uuid, hidden_at FROM tier_list_comments
+    UNION ALL
+    SELECT 'deck'::text, deck_id, author_user_id, season_id, hidden_at FROM decks
+) t
+WHERE t.target_type = sqlc.arg('target_type')::text
+  AND t.target_id = sqlc.arg('target_id')::uuid;
+
+-- name: GetModerationTierListForUpdate :one
+-- 非表示・復元するティアリストの行ロックを取得し、作成者・シーズン・非表示にした日時を取得する
+-- 同時に保存された配置と統計の減算・加算が食い違わないよう、ティア統計を更新する前に取得する（非表示のティアリストも取得する）
+SELECT author_user_id, season_id, hidden_at FROM tier_lists
+WHERE tier_list_id = $1
+FOR UPDATE;
+
+-- name: CreateModerationAction :exec
+INSERT INTO moderation_actions (
+    action_id,
+    target_type,
+    target_id,
+    action,
+    moderator_user_id,
+    note,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: ListModerationActions :many
+-- 操作日時の新しい順。カーソルは (created_at, action_id)
+-- target_type・target_id を指定した場合はその対象への操作だけを取得する
+SELECT * FROM moderation_actions
+WHERE (sqlc.narg('target_type')::text IS NULL OR target_type = sqlc.narg('target_type')::text)
+  AND (sqlc.narg('target_id')::uuid IS NULL OR target_id = sqlc.narg('target_id')::uuid)
+  AND (
+    sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, action_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_action_id')::uuid)
+  )
+ORDER BY created_at DESC, action_id DESC
+LIMIT sqlc.arg('page_limit')::int;
@@ -0,0 +1,107 @@
+-- ティアリストへのコメントの操作
+
+-- name: GetTierListComment :one
+-- モデレーターが非表示にしたティアリストへのコメントは存在しないものとして扱う
+SELECT * FROM tier_list_comments
+WHERE comment_id = $1
+  AND EXISTS (
+    SELECT 1 FROM tier_lists tl
+    WHERE tl.tier_list_id = tier_list_comments.tier_list_id
+      AND tl.hidden_at IS NULL
+  );
+
+-- name: CreateTierListComment :exec
+INSERT INTO tier_list_comments (
+    comment_id,
+    tier_list_id,
+    parent_comment_id,
+    author_user_id,
+    deck_id,
+    body,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: UpdateTierListComment :exec
+-- 編集・削除後の本文・言及したデッキ・日時を保存する
+UPDATE tier_list_comments
+SET
+    body = $2,
+    deck_id = $3,
+    edited_at = $4,
+    deleted_at = $5
+WHERE comment_id = $1;
+
+-- name: ListTierListComments :many
+-- トップレベルのコメントを新しい順に取得する。カーソルは (created_at, comment_id)
+-- 削除済みのコメントも返信のスレッドを残すため含める。モデレーターが非表示にしたコメントと返信は含めない
+SELECT
+    c.comment_id,
+    c.tier_list_id,
+    c.parent_comment_id,
+    c.author_user_id,
+    u.display_name AS author_display_name,
+    c.deck_id,
+    d.nickname AS deck_nickname,
+    d.image_url AS deck_image_url,
+    c.body,
+    c.created_at,
+    c.edited_at,
+    c.deleted_at,
+    (SELECT COUNT(*) FROM tier_list_comments r WHERE r.parent_comment_id = c.comment_id AND r.hidden_at IS NULL)::bigint AS reply_count,
+    (SELECT COUNT(*) FROM comment_likes l WHERE l.comment_id = c.comment_id)::bigint AS like_count
+FROM tier_list_comments c
+INNER JOIN users u ON u.user_id = c.author_user_id
+LEFT JOIN decks d ON d.deck_id = c.deck_id
+WHERE c.tier_list_id = sqlc.arg('tier_list_id')::uuid
+  AND c.parent_comment_id IS NULL
+  AND c.hidden_at IS NULL
+  AND (
+    sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (c.created_at, c.comment_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_comment_id')::uuid)
+  )
+ORDER BY c.created_at DESC, c.comment_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListTierListCommentReplies :many
+-- コメントへの返信を古い順に取得する。カーソルは (created_at, comment_id)
+-- モデレーターが非表示にした返信は含めない
+SELECT
+    c.comment_id,
+    c.tier_list_id,
+    c.parent_comment_id,
+    c.author_user_id,
+    u.display_name AS author_display_name,
+    c.deck_id,
+    d.nickname AS deck_nickname,
+    d.image_url AS deck_image_url,
+    c.body,
+    c.created_at,
+    c.edited_at,
+    c.deleted_at,
+    (SELECT COUNT(*) FROM comment_likes l WHERE l.comment_id = c.comment_id)::bigint AS like_count
+FROM tier_list_comments c
+INNER JOIN users u ON u.user_id = c.author_user_id
+LEFT JOIN decks d ON d.deck_id = c.deck_id
+WHERE c.parent_comment_id = sqlc.arg('parent_comment_id')::uuid
+  AND c.hidden_at IS NULL
+  AND (
+    sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (c.created_at, c.comment_id) > (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_comment_id')::uuid)
+  )
+ORDER BY c.created_at, c.comment_id
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: CountTierListCommentsByAuthorSince :one
+-- 投稿数の制限のため、指定した日時以降にユーザーが投稿したコメント数を数える（削除済みも含む）
+SELECT COUNT(*)::bigint AS count
+FROM tier_list_comments
+WHERE author_user_id = $1
+  AND created_at >= $2;
+
+-- name: UpdateTierListCommentHiddenAt :exec
+-- モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+UPDATE tier_list_comments
+SET hidden_at = $2
+WHERE comment_id = $1;
@@ -0,0 +1,61 @@
+-- ティアリストのお気に入りとお気に入り数のカウンターの操作
+
+-- name: AddTierListFavorite :execrows
+-- 登録済みの場合は何もせず0行を返す
+INSERT INTO tier_list_favorites (
+    user_id,
+    tier_list_id
+) VALUES (
+    $1, $2
+) ON CONFLICT (user_id, tier_list_id) DO NOTHING;
+
+-- name: RemoveTierListFavorite :execrows
+-- 登録されていない場合は0行を返す
+DELETE FROM tier_list_favorites
+WHERE user_id = $1
+  AND tier_list_id = $2;
+
+-- name: ListFavoriteTierListsByUser :many
+-- お気に入りの登録日時の新しい順。カーソルは (favorited_at, tier_list_id)
+-- モデレーターが非表示にしたティアリストは含めない
+SELECT
+    tl.tier_list_id,
+    tl.season_id,
+    tl.title,
+    tl.description,
+    tl.author_name,
+    tl.view_count,
+    tl.fork_count,
+    tl.created_at,
+    f.created_at AS favorited_at
+FROM tier_list_favorites f
+INNER JOIN tier_lists tl ON tl.tier_list_id = f.tier_list_id
+WHERE f.user_id = sqlc.arg('user_id')::uuid
+  AND tl.hidden_at IS NULL
+  AND (sqlc.narg('season_id')::uuid IS NULL OR tl.season_id = sqlc.narg('season_id')::uuid)
+  AND (
+    sqlc.narg('cursor_favorited_at')::timestamptz IS NULL
+    OR (f.created_at, f.tier_list_id) < (sqlc.narg('cursor_favorited_at')::timestamptz, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY f.created_at DESC, f.tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: AddTierListFavoriteCount :exec
+-- 指定したシャードのお気に入り数に差分を加算する
+INSERT INTO tier_list_favorite_counts (
+    tier_list_id,
+    shard,
+    favorite_count
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (tier_list_id, shard) DO UPDATE
+SET favorite_count = tier_list_favorite_counts.favorite_count + EXCLUDED.favorite_count;
+
+-- name: ListTierListFavoriteCounts :many
+-- シャードを合計したお気に入り数。お気に入りされたことがないティアリストは含まない
+SELECT
+    tier_list_id,
+    SUM(favorite_count)::bigint AS favorite_count
+FROM tier_list_favorite_counts
+WHERE tier_list_id = ANY(sqlc.arg('tier_list_ids')::uuid[])
+GROUP BY tier_list_id;
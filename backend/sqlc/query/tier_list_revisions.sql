@@ -0,0 +1,34 @@
+-- ティアリストのリビジョン操作
+
+-- name: CreateTierListRevision :one
+INSERT INTO tier_list_revisions (
+    tier_list_id,
+    revision_number,
+    placements,
+    operations,
+    restored_from_revision
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetTierListRevision :one
+-- モデレーターが非表示にしたティアリストのリビジョンは存在しないものとして扱う
+SELECT * FROM tier_list_revisions
+WHERE tier_list_id = $1 AND revision_number = $2
+  AND EXISTS (
+    SELECT 1 FROM tier_lists tl
+    WHERE tl.tier_list_id = tier_list_revisions.tier_list_id
+      AND tl.hidden_at IS NULL
+  );
+
+-- name: ListTierListRevisions :many
+-- 新しいリビジョンから順に取得
+SELECT * FROM tier_list_revisions
+WHERE tier_list_id = $1
+ORDER BY revision_number DESC;
+
+-- name: GetLatestTierListRevisionNumber :one
+-- リビジョンが存在しない場合は0を返す
+SELECT COALESCE(MAX(revision_number), 0)::int AS revision_number
+FROM tier_list_revisions
+WHERE tier_list_id = $1;
@@ -0,0 +1,60 @@
+-- ティアリストの信頼度の操作
+
+-- name: ListTierListAuthorsBySeason :many
+-- シーズン内のティアリストの投稿者情報を取得（重複投稿の判定に使用）
+SELECT tier_list_id, author_user_id, author_name, author_ip, created_at
+FROM tier_lists
+WHERE season_id = $1;
+
+-- name: GetTierListTrustWeightForUpdate :one
+-- ティアリストの行をロックしてから保存済みの信頼度を取得する（評価前のティアリストは重み1）
+-- 配置の保存・削除・非表示と同じ行をロックし、信頼度の付け替え中にティア統計が更新されないようにする
+SELECT COALESCE(tr.trust_weight, 1)::double precision AS trust_weight
+FROM tier_lists tl
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tl.tier_list_id
+WHERE tl.tier_list_id = $1
+FOR UPDATE OF tl;
+
+-- name: SaveTierListTrustScore :exec
+-- Upsert: 評価済みの場合は評価結果を置き換える
+INSERT INTO tier_list_trust_scores (
+    tier_list_id,
+    season_id,
+    trust_weight,
+    rank_distance,
+    z_score,
+    duplicate_of_tier_list_id,
+    flag_reasons,
+    evaluated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, NOW()
+)
+ON CONFLICT (tier_list_id) DO UPDATE
+SET season_id = EXCLUDED.season_id,
+    trust_weight = EXCLUDED.trust_weight,
+    rank_distance = EXCLUDED.rank_distance,
+    z_score = EXCLUDED.z_score,
+    duplicate_of_tier_list_id = EXCLUDED.duplicate_of_tier_list_id,
+    flag_reasons = EXCLUDED.flag_reasons,
+    evaluated_at = EXCLUDED.evaluated_at;
+
+-- name: ListFlaggedTierLists :many
+-- フラグ付きのティアリストを信頼度の低い順で取得（season_id を省略した場合は全シーズン）
+SELECT
+    tr.tier_list_id,
+    tr.season_id,
+    tr.trust_weight,
+    tr.rank_distance,
+    tr.z_score,
+    tr.duplicate_of_tier_list_id,
+    tr.flag_reasons,
+    tr.evaluated_at,
+    tl.title,
+    tl.author_name,
+    tl.author_ip
+FROM tier_list_trust_scores tr
+INNER JOIN tier_lists tl ON tl.tier_list_id = tr.tier_list_id
+WHERE cardinality(tr.flag_reasons) > 0
+  AND (sqlc.narg('season_id')::uuid IS NULL OR tr.season_id = sqlc.narg('season_id')::uuid)
+ORDER BY tr.trust_weight ASC, tr.tier_list_id ASC
+LIMIT sqlc.arg('page_limit')::int;
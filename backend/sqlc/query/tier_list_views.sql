@@ -0,0 +1,46 @@
+-- ティアリストの閲覧の記録とトレンドスコアの更新
+
+-- name: CreateTierListViewer :execrows
+-- 閲覧者の当日の閲覧を記録する（同じ日に記録済みの場合は0件を返し、閲覧数を加算しない）
+INSERT INTO tier_list_viewers (tier_list_id, view_date, viewer_key)
+VALUES ($1, CURRENT_DATE, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementTierListDailyViewCount :exec
+-- 当日の日別閲覧数を1増やす
+INSERT INTO tier_list_daily_views (tier_list_id, view_date, view_count)
+VALUES ($1, CURRENT_DATE, 1)
+ON CONFLICT (tier_list_id, view_date) DO UPDATE
+SET view_count = tier_list_daily_views.view_count + 1;
+
+-- name: IncrementTierListViewCount :execrows
+-- 累計閲覧数とトレンドスコア（直近7日間の閲覧数）を1増やす（非表示のティアリストは0件を返す）
+UPDATE tier_lists
+SET view_count = view_count + 1,
+    trending_score = trending_score + 1
+WHERE tier_list_id = $1
+  AND hidden_at IS NULL;
+
+-- name: RefreshTierListTrendingScores :execrows
+-- トレンドスコアを直近7日間の日別閲覧数から再計算し、集計期間から外れた日の閲覧数を除く
+-- 閲覧のないティアリストのスコアは0のままのため、スコアが0より大きいティアリストのみを対象にする
+-- 実行中に記録された閲覧との差分は次回の再計算で解消される
+WITH recent AS (
+    SELECT tl.tier_list_id, COALESCE(SUM(v.view_count), 0)::int AS trending_score
+    FROM tier_lists tl
+    LEFT JOIN tier_list_daily_views v
+        ON v.tier_list_id = tl.tier_list_id
+        AND v.view_date >= CURRENT_DATE - 7
+    WHERE tl.trending_score > 0
+    GROUP BY tl.tier_list_id
+)
+UPDATE tier_lists tl
+SET trending_score = recent.trending_score
+FROM recent
+WHERE tl.tier_list_id = recent.tier_list_id
+  AND tl.trending_score <> recent.trending_score;
+
+-- name: DeleteExpiredTierListViewers :execrows
+-- 重複の判定に使わなくなった前日以前の閲覧者の記録を削除する
+DELETE FROM tier_list_viewers
+WHERE view_date < CURRENT_DATE;
@@ -0,0 +1,181 @@
+-- ティアリストの一覧取得（キーセットページネーション）
+-- モデレーターが非表示にしたティアリストは一覧に含めない
+-- 任意指定の条件を IS NULL OR で書くとインデックスが使われないため、シーズンの指定有無でクエリを分ける
+
+-- name: ListTierListsByPopular :many
+-- 閲覧数の多い順。カーソルは (view_count, tier_list_id)
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_view_count')::int IS NULL
+    OR (view_count, tier_list_id) < (sqlc.narg('cursor_view_count')::int, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY view_count DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListSeasonTierListsByPopular :many
+-- 人気順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND season_id = sqlc.arg('season_id')::uuid
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_view_count')::int IS NULL
+    OR (view_count, tier_list_id) < (sqlc.narg('cursor_view_count')::int, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY view_count DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListTierListsByNewest :many
+-- 作成日時の新しい順。カーソルは (created_at, tier_list_id)
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, tier_list_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY created_at DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListSeasonTierListsByNewest :many
+-- 新着順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND season_id = sqlc.arg('season_id')::uuid
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, tier_list_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY created_at DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListTierListsByTrending :many
+-- 直近7日間の閲覧数（トレンドスコア）の多い順。カーソルは (trending_score, tier_list_id)
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_trending_score')::int IS NULL
+    OR (trending_score, tier_list_id) < (sqlc.narg('cursor_trending_score')::int, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY trending_score DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListSeasonTierListsByTrending :many
+-- トレンド順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND season_id = sqlc.arg('season_id')::uuid
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_trending_score')::int IS NULL
+    OR (trending_score, tier_list_id) < (sqlc.narg('cursor_trending_score')::int, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY trending_score DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListTierListsByHot :many
+-- ホット順（いいね数・閲覧数に作成日時の新しさを加味したスコアの高い順）。カーソルは (hot_score, tier_list_id)
+-- hot_score は tier_list_hot_score() で算出し、いいね数・閲覧数の更新時に再計算して保存している
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_hot_score')::bigint IS NULL
+    OR (hot_score, tier_list_id) < (sqlc.narg('cursor_hot_score')::bigint, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY hot_score DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListSeasonTierListsByHot :many
+-- ホット順のシーズン指定版（シーズンごとのインデックスを使えるよう、シーズンの条件を任意指定にしない）
+SELECT * FROM tier_lists
+WHERE hidden_at IS NULL
+  AND season_id = sqlc.arg('season_id')::uuid
+  AND (sqlc.narg('author_name')::text IS NULL OR author_name = sqlc.narg('author_name')::text)
+  AND (sqlc.narg('forked_from_tier_list_id')::uuid IS NULL OR forked_from_tier_list_id = sqlc.narg('forked_from_tier_list_id')::uuid)
+  AND (
+    sqlc.narg('cursor_hot_score')::bigint IS NULL
+    OR (hot_score, tier_list_id) < (sqlc.narg('cursor_hot_score')::bigint, sqlc.narg('cursor_tier_list_id')::uuid)
+  )
+ORDER BY hot_score DESC, tier_list_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: GetTierList :one
+-- モデレーターが非表示にしたティアリストは存在しないものとして扱う
+SELECT * FROM tier_lists
+WHERE tier_list_id = $1
+  AND hidden_at IS NULL;
+
+-- name: GetTierListForUpdate :one
+-- 配置の保存・リビジョンの復元で、同じティアリストへの同時更新を直列化するために行ロックを取得する
+-- モデレーターが非表示にしたティアリストは存在しないものとして扱う
+SELECT * FROM tier_lists
+WHERE tier_list_id = $1
+  AND hidden_at IS NULL
+FOR UPDATE;
+
+-- name: CreateTierList :one
+INSERT INTO tier_lists (
+    tier_list_id,
+    season_id,
+    title,
+    description,
+    author_name,
+    forked_from_tier_list_id,
+    author_ip,
+    author_user_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: IncrementTierListForkCount :exec
+-- フォークされた回数を1増やす
+UPDATE tier_lists
+SET fork_count = fork_count + 1
+WHERE tier_list_id = $1;
+
+-- name: LockTierListForHotScore :exec
+-- ホットスコアの再計算の前にティアリストの行ロックを取得し、同時に更新されたいいね数・閲覧数を次の文で読めるようにする
+-- いいねの追加で外部キーの検査が取る共有ロックと競合しないよう FOR NO KEY UPDATE とする
+SELECT tier_list_id FROM tier_lists
+WHERE tier_list_id = $1
+FOR NO KEY UPDATE;
+
+-- name: RefreshTierListHotScore :exec
+-- シャードを合計したいいね数と累計閲覧数からホットスコアを再計算する
+UPDATE tier_lists tl
+SET hot_score = tier_list_hot_score(
+    COALESCE((SELECT SUM(l.like_count) FROM tier_list_like_counts l WHERE l.tier_list_id = tl.tier_list_id), 0)::bigint,
+    tl.view_count,
+    tl.created_at
+)
+WHERE tl.tier_list_id = $1;
+
+-- name: TouchTierList :exec
+-- 配置の更新時に更新日時を進める
+UPDATE tier_lists
+SET updated_at = NOW()
+WHERE tier_list_id = $1;
+
+-- name: CountTierListsBySeason :one
+-- シーズン内の集計ティアリストの入力となるティアリスト数を取得（非表示のティアリストを除く）
+SELECT COUNT(*) FROM tier_lists
+WHERE season_id = $1
+  AND hidden_at IS NULL;
+
+-- name: UpdateTierListHiddenAt :exec
+-- モデレーターによる非表示・復元（復元の場合は hidden_at に NULL を渡す）
+UPDATE tier_lists
+SET hidden_at = $2
+WHERE tier_list_id = $1;
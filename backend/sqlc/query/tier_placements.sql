@@ -0,0 +1,38 @@
+-- ティア配置の操作
+
+-- name: ListTierPlacementsByTierList :many
+-- ティアの強い順、ティア内の並び順で取得
+SELECT * FROM tier_placements
+WHERE tier_list_id = $1
+ORDER BY tier_rank DESC, position ASC;
+
+-- name: BulkCreateTierPlacements :copyfrom
+INSERT INTO tier_placements (
+    tier_placement_id,
+    tier_list_id,
+    deck_id,
+    tier_rank,
+    position
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: DeleteTierPlacementsByTierList :exec
+DELETE FROM tier_placements
+WHERE tier_list_id = $1;
+
+-- name: ListTierPlacementsBySeason :many
+-- シーズン内の全ティアリストの配置を信頼度とともに取得（集計ティアリストの算出に使用）
+-- 信頼度が評価されていないティアリストは重み1として扱う
+-- モデレーターが非表示にしたティアリスト・デッキは含めない
+SELECT tp.tier_list_id, tp.deck_id, tp.tier_rank, COALESCE(tr.trust_weight, 1)::double precision AS trust_weight
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+WHERE tl.season_id = $1
+  AND tl.hidden_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM decks d
+    WHERE d.deck_id = tp.deck_id
+      AND d.hidden_at IS NOT NULL
+  );
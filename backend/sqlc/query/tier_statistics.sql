@@ -0,0 +1,115 @@
+-- ティア統計の操作
+
+-- name: AddTierListToStatistics :exec
+-- ティアリストの配置を統計に加算する（ティアリストの作成・配置の保存後に呼び出す）
+-- 重み付きの累計にはティアリストの信頼度を使用する（評価前のティアリストは重み1）
+-- 二乗の累計は集計ティアリストの信頼区間の算出に使用する
+-- モデレーターが非表示にしたティアリストは加算しない（非表示にする前に減算し、復元した後に加算する）
+INSERT INTO tier_statistics (deck_id, season_id, rank_sum, placement_count, weighted_rank_sum, weight_sum, weighted_rank_square_sum, weight_square_sum, calculated_at)
+SELECT
+    tp.deck_id,
+    tl.season_id,
+    tp.tier_rank,
+    1,
+    tp.tier_rank * COALESCE(tr.trust_weight, 1),
+    COALESCE(tr.trust_weight, 1),
+    tp.tier_rank * tp.tier_rank * COALESCE(tr.trust_weight, 1),
+    COALESCE(tr.trust_weight, 1) * COALESCE(tr.trust_weight, 1),
+    NOW()
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+WHERE tp.tier_list_id = $1
+  AND tl.hidden_at IS NULL
+ON CONFLICT (deck_id, season_id) DO UPDATE
+SET rank_sum = tier_statistics.rank_sum + EXCLUDED.rank_sum,
+    placement_count = tier_statistics.placement_count + 1,
+    weighted_rank_sum = tier_statistics.weighted_rank_sum + EXCLUDED.weighted_rank_sum,
+    weight_sum = tier_statistics.weight_sum + EXCLUDED.weight_sum,
+    weighted_rank_square_sum = tier_statistics.weighted_rank_square_sum + EXCLUDED.weighted_rank_square_sum,
+    weight_square_sum = tier_statistics.weight_square_sum + EXCLUDED.weight_square_sum,
+    calculated_at = NOW();
+
+-- name: SubtractTierListFromStatistics :exec
+-- ティアリストの配置を統計から減算する（配置の削除前・信頼度の更新前に呼び出す）
+-- 非表示のティアリストは統計に含まれていないため減算しない
+UPDATE tier_statistics ts
+SET rank_sum = ts.rank_sum - tp.tier_rank,
+    placement_count = ts.placement_count - 1,
+    weighted_rank_sum = ts.weighted_rank_sum - tp.tier_rank * COALESCE(tr.trust_weight, 1),
+    weight_sum = ts.weight_sum - COALESCE(tr.trust_weight, 1),
+    weighted_rank_square_sum = ts.weighted_rank_square_sum - tp.tier_rank * tp.tier_rank * COALESCE(tr.trust_weight, 1),
+    weight_square_sum = ts.weight_square_sum - COALESCE(tr.trust_weight, 1) * COALESCE(tr.trust_weight, 1),
+    calculated_at = NOW()
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+WHERE tp.tier_list_id = $1
+  AND tl.hidden_at IS NULL
+  AND ts.deck_id = tp.deck_id
+  AND ts.season_id = tl.season_id;
+
+-- name: ListTierStatisticsBySeason :many
+-- シーズン内の配置があるデッキの統計を取得（集計ティアリストの算出に使用）
+-- モデレーターが非表示にしたデッキは集計ティアリストに含めない
+SELECT * FROM tier_statistics ts
+WHERE ts.season_id = $1
+  AND ts.placement_count > 0
+  AND NOT EXISTS (
+    SELECT 1 FROM decks d
+    WHERE d.deck_id = ts.deck_id
+      AND d.hidden_at IS NOT NULL
+  );
+
+-- name: ListTierStatistics :many
+-- 統計を取得（season_id を省略した場合は全シーズン）
+SELECT * FROM tier_statistics
+WHERE sqlc.narg('season_id')::uuid IS NULL OR season_id = sqlc.narg('season_id')::uuid;
+
+-- name: ListRecomputedTierStatistics :many
+-- 配置から統計を再計算した結果を取得（season_id を省略した場合は全シーズン）
+SELECT
+    tp.deck_id,
+    tl.season_id,
+    SUM(tp.tier_rank)::bigint AS rank_sum,
+    COUNT(*)::int AS placement_count,
+    SUM(tp.tier_rank * COALESCE(tr.trust_weight, 1))::double precision AS weighted_rank_sum,
+    SUM(COALESCE(tr.trust_weight, 1))::double precision AS weight_sum,
+    SUM(tp.tier_rank * tp.tier_rank * COALESCE(tr.trust_weight, 1))::double precision AS weighted_rank_square_sum,
+    SUM(COALESCE(tr.trust_weight, 1) * COALESCE(tr.trust_weight, 1))::double precision AS weight_square_sum
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+WHERE tl.hidden_at IS NULL
+  AND (sqlc.narg('season_id')::uuid IS NULL OR tl.season_id = sqlc.narg('season_id')::uuid)
+GROUP BY tp.deck_id, tl.season_id;
+
+-- name: LockTierStatistics :exec
+-- 統計の再作成中に差分更新（加算・減算）が割り込まないよう、トランザクションの終了まで統計への書き込みを待たせる
+-- 差分更新が取る ROW EXCLUSIVE ロックと競合し、参照（集計ティアリストの算出）は妨げない
+LOCK TABLE tier_statistics IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: DeleteTierStatistics :exec
+-- 統計を削除（season_id を省略した場合は全シーズン）
+DELETE FROM tier_statistics
+WHERE sqlc.narg('season_id')::uuid IS NULL OR season_id = sqlc.narg('season_id')::uuid;
+
+-- name: RebuildTierStatistics :exec
+-- 配置から統計を再作成（事前に DeleteTierStatistics で削除しておく）
+INSERT INTO tier_statistics (deck_id, season_id, rank_sum, placement_count, weighted_rank_sum, weight_sum, weighted_rank_square_sum, weight_square_sum, calculated_at)
+SELECT
+    tp.deck_id,
+    tl.season_id,
+    SUM(tp.tier_rank),
+    COUNT(*),
+    SUM(tp.tier_rank * COALESCE(tr.trust_weight, 1)),
+    SUM(COALESCE(tr.trust_weight, 1)),
+    SUM(tp.tier_rank * tp.tier_rank * COALESCE(tr.trust_weight, 1)),
+    SUM(COALESCE(tr.trust_weight, 1) * COALESCE(tr.trust_weight, 1)),
+    NOW()
+FROM tier_placements tp
+INNER JOIN tier_lists tl ON tl.tier_list_id = tp.tier_list_id
+LEFT JOIN tier_list_trust_scores tr ON tr.tier_list_id = tp.tier_list_id
+WHERE tl.hidden_at IS NULL
+  AND (sqlc.narg('season_id')::uuid IS NULL OR tl.season_id = sqlc.narg('season_id')::uuid)
+GROUP BY tp.deck_id, tl.season_id;
@@ -0,0 +1,29 @@
+-- メールアドレス確認・パスワード再設定のワンタイムトークンの操作
+
+-- name: GetUserAccountToken :one
+SELECT * FROM user_account_tokens
+WHERE token_hash = $1;
+
+-- name: CreateUserAccountToken :exec
+INSERT INTO user_account_tokens (
+    token_hash,
+    user_id,
+    purpose,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: UseUserAccountToken :execrows
+-- 未使用のトークンを使用済みにする。既に使用済みの場合は0行を返す
+UPDATE user_account_tokens
+SET used_at = $2
+WHERE token_hash = $1
+  AND used_at IS NULL;
+
+-- name: DeleteUnusedUserAccountTokens :exec
+-- 新しいトークンを発行する前に、同じ用途の未使用のトークンを無効にする
+DELETE FROM user_account_tokens
+WHERE user_id = $1
+  AND purpose = $2
+  AND used_at IS NULL;
@@ -0,0 +1,44 @@
+-- メールアドレス・パスワードの認証情報のCRUD操作
+
+-- name: GetUserCredential :one
+SELECT * FROM user_credentials
+WHERE user_id = $1;
+
+-- name: GetUserCredentialByEmail :one
+SELECT * FROM user_credentials
+WHERE email = $1;
+
+-- name: CreateUserCredential :one
+INSERT INTO user_credentials (
+    user_id,
+    email,
+    password_hash
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: UpdateUserCredential :one
+-- パスワード・メールアドレスの確認状態・ログイン失敗の状態を更新する
+UPDATE user_credentials
+SET password_hash = $2,
+    email_verified_at = $3,
+    failed_login_count = $4,
+    locked_until = $5
+WHERE user_id = $1
+RETURNING *;
+
+-- name: RecordCredentialLoginFailure :one
+-- ログインの失敗を1回加算する。連続した失敗が max_failed_logins 回に達した場合は locked_until までロックし、失敗回数を数え直す
+-- 同時に失敗したログインの加算が失われないよう、読み込んだ値ではなく行の現在の値から加算する
+UPDATE user_credentials
+SET failed_login_count = CASE WHEN failed_login_count + 1 >= sqlc.arg('max_failed_logins')::int THEN 0 ELSE failed_login_count + 1 END,
+    locked_until = CASE WHEN failed_login_count + 1 >= sqlc.arg('max_failed_logins')::int THEN sqlc.arg('locked_until')::timestamptz ELSE locked_until END
+WHERE user_id = sqlc.arg('user_id')
+RETURNING *;
+
+-- name: ResetCredentialLoginFailures :exec
+-- ログインの成功時に失敗回数とロックをリセットする（パスワードなど他の列は更新しない）
+UPDATE user_credentials
+SET failed_login_count = 0,
+    locked_until = NULL
+WHERE user_id = $1;
@@ -0,0 +1,88 @@
+-- ユーザー間のフォローと、フォロー中のユーザーの新着を集めたフィードの取得
+
+-- name: FollowUser :execrows
+-- フォロー済みの場合は何もせず0行を返す
+INSERT INTO user_follows (
+    follower_user_id,
+    followee_user_id
+) VALUES (
+    $1, $2
+) ON CONFLICT (follower_user_id, followee_user_id) DO NOTHING;
+
+-- name: UnfollowUser :execrows
+-- フォローしていない場合は0行を返す
+DELETE FROM user_follows
+WHERE follower_user_id = $1
+  AND followee_user_id = $2;
+
+-- name: ListFeedItems :many
+-- フォロー中のユーザーが作成したティアリスト・デッキの作成日時の新しい順。カーソルは (created_at, item_id)
+-- フォロー数が多くても読む行数が「フォロー数 × page_limit」に収まるよう、フォロー中のユーザーごとに
+-- 作成者別の新着順インデックスから先頭の page_limit 件だけを読み、それらをマージして並べる
+-- モデレーターが非表示にしたティアリスト・デッキは含めない
+WITH followees AS (
+    SELECT followee_user_id
+    FROM user_follows
+    WHERE follower_user_id = sqlc.arg('user_id')::uuid
+),
+items AS (
+    SELECT
+        'tier_list'::text AS item_type,
+        tl.tier_list_id AS item_id,
+        tl.season_id,
+        tl.title,
+        tl.description,
+        ''::text AS image_url,
+        tl.author_user_id,
+        tl.created_at
+    FROM followees f
+    CROSS JOIN LATERAL (
+        SELECT t.tier_list_id, t.season_id, t.title, t.description, t.author_user_id, t.created_at
+        FROM tier_lists t
+        WHERE t.author_user_id = f.followee_user_id
+          AND t.hidden_at IS NULL
+          AND (
+            sqlc.narg('cursor_created_at')::timestamptz IS NULL
+            OR (t.created_at, t.tier_list_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_item_id')::uuid)
+          )
+        ORDER BY t.created_at DESC, t.tier_list_id DESC
+        LIMIT sqlc.arg('page_limit')::int
+    ) tl
+    UNION ALL
+    SELECT
+        'deck'::text AS item_type,
+        d.deck_id AS item_id,
+        d.season_id,
+        d.nickname AS title,
+        ''::text AS description,
+        d.image_url,
+        d.author_user_id,
+        d.created_at
+    FROM followees f
+    CROSS JOIN LATERAL (
+        SELECT dk.deck_id, dk.season_id, dk.nickname, dk.image_url, dk.author_user_id, dk.created_at
+        FROM decks dk
+        WHERE dk.author_user_id = f.followee_user_id
+          AND dk.hidden_at IS NULL
+          AND (
+            sqlc.narg('cursor_created_at')::timestamptz IS NULL
+            OR (dk.created_at, dk.deck_id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_item_id')::uuid)
+          )
+        ORDER BY dk.created_at DESC, dk.deck_id DESC
+        LIMIT sqlc.arg('page_limit')::int
+    ) d
+)
+SELECT
+    i.item_type,
+    i.item_id,
+    i.season_id,
+    i.title,
+    i.description,
+    i.image_url,
+    i.author_user_id,
+    u.display_name AS author_display_name,
+    i.created_at
+FROM items i
+INNER JOIN users u ON u.user_id = i.author_user_id
+ORDER BY i.created_at DESC, i.item_id DESC
+LIMIT sqlc.arg('page_limit')::int;
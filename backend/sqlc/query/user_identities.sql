@@ -0,0 +1,17 @@
+-- 外部IDプロバイダーのアカウントとユーザーの紐付けの操作
+
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE provider = $1
+  AND subject = $2;
+
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    provider,
+    subject,
+    user_id,
+    email
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
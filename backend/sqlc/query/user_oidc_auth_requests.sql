@@ -0,0 +1,23 @@
+-- OIDCの認可リクエストの操作
+
+-- name: CreateUserOIDCAuthRequest :exec
+INSERT INTO user_oidc_auth_requests (
+    state_hash,
+    provider,
+    code_verifier,
+    nonce,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: ConsumeUserOIDCAuthRequest :one
+-- 認可リクエストを削除して返す。同じ state で2回コールバックされた場合、2回目は行を返さない
+DELETE FROM user_oidc_auth_requests
+WHERE state_hash = $1
+RETURNING *;
+
+-- name: DeleteExpiredUserOIDCAuthRequests :exec
+-- コールバックされずに期限切れになった認可リクエストを削除する
+DELETE FROM user_oidc_auth_requests
+WHERE expires_at < $1;
@@ -0,0 +1,20 @@
+-- セッションで発行したリフレッシュトークンの操作
+
+-- name: GetUserRefreshToken :one
+SELECT * FROM user_refresh_tokens
+WHERE token_hash = $1;
+
+-- name: CreateUserRefreshToken :exec
+INSERT INTO user_refresh_tokens (
+    token_hash,
+    session_id
+) VALUES (
+    $1, $2
+);
+
+-- name: UseUserRefreshToken :execrows
+-- 未使用のトークンを交換済みにする。既に交換済みの場合は0行を返す
+UPDATE user_refresh_tokens
+SET used_at = $2
+WHERE token_hash = $1
+  AND used_at IS NULL;
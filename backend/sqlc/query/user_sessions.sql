@@ -0,0 +1,51 @@
+-- ログインごとのセッションの操作
+
+-- name: GetUserSession :one
+SELECT * FROM user_sessions
+WHERE session_id = $1;
+
+-- name: ListActiveUserSessions :many
+-- 失効しておらず期限切れでもないセッションを、最後に使用した順に取得する
+SELECT * FROM user_sessions
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND expires_at > $2
+ORDER BY last_used_at DESC;
+
+-- name: CreateUserSession :exec
+INSERT INTO user_sessions (
+    session_id,
+    user_id,
+    user_agent,
+    ip_address,
+    expires_at,
+    last_used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: TouchUserSession :execrows
+-- リフレッシュトークンの交換時に最終使用日時と有効期限を更新する。失効済みの場合は0行を返す
+UPDATE user_sessions
+SET user_agent = $2,
+    ip_address = $3,
+    expires_at = $4,
+    last_used_at = $5
+WHERE session_id = $1
+  AND revoked_at IS NULL;
+
+-- name: RevokeUserSession :exec
+-- セッションを失効させる。既に失効している場合は最初の理由を残す
+UPDATE user_sessions
+SET revoked_at = $2,
+    revoked_reason = $3
+WHERE session_id = $1
+  AND revoked_at IS NULL;
+
+-- name: RevokeUserSessionsByUser :execrows
+-- ユーザーの失効していないセッションをすべて失効させる
+UPDATE user_sessions
+SET revoked_at = $2,
+    revoked_reason = $3
+WHERE user_id = $1
+  AND revoked_at IS NULL;
@@ -0,0 +1,23 @@
+-- ユーザーのCRUD操作
+
+-- name: GetUser :one
+SELECT * FROM users
+WHERE user_id = $1;
+
+-- name: CreateUser :one
+INSERT INTO users (
+    user_id,
+    display_name,
+    role
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: UpdateUser :one
+-- 表示名・権限・無効化状態を更新する
+UPDATE users
+SET display_name = $2,
+    role = $3,
+    disabled_at = $4
+WHERE user_id = $1
+RETURNING *;
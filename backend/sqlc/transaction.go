@@ -0,0 +1,145 @@
+package sqlc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"poketier/sqlc/db"
+)
+
+// txKey はコンテキストにトランザクションを格納するキー
+type txKey struct{}
+
+// Pool はクエリ実行とトランザクション開始ができるデータベース接続（*pgxpool.Pool が満たす）
+type Pool interface {
+	db.DBTX
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// ContextDBTX はコンテキストにトランザクションがあればそれを、なければ接続プールを使ってクエリを実行する
+// db.New に渡すことで、リポジトリはトランザクションを意識せずに TxManager の境界に参加できる
+type ContextDBTX struct {
+	pool Pool
+}
+
+// NewContextDBTX は新しいContextDBTXを作成
+func NewContextDBTX(pool Pool) *ContextDBTX {
+	return &ContextDBTX{
+		pool: pool,
+	}
+}
+
+func (d *ContextDBTX) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return d.conn(ctx).Exec(ctx, sql, args...)
+}
+
+func (d *ContextDBTX) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return d.conn(ctx).Query(ctx, sql, args...)
+}
+
+func (d *ContextDBTX) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return d.conn(ctx).QueryRow(ctx, sql, args...)
+}
+
+func (d *ContextDBTX) CopyFrom(
+	ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource,
+) (int64, error) {
+	return d.conn(ctx).CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+// conn はコンテキストに応じたクエリの実行先を返す
+func (d *ContextDBTX) conn(ctx context.Context) db.DBTX {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return d.pool
+}
+
+// TxManager はトランザクション境界を管理する
+type TxManager struct {
+	pool Pool
+}
+
+// NewTxManager は新しいTxManagerを作成
+func NewTxManager(pool Pool) *TxManager {
+	return &TxManager{
+		pool: pool,
+	}
+}
+
+// RunInTx は fn をトランザクション内で実行する
+// fn がエラーを返した場合はロールバックし、成功した場合はコミットする
+// 既にトランザクション内で呼ばれた場合は外側のトランザクションに参加する
+func (m *TxManager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.runInTx(ctx, pgx.TxOptions{}, fn)
+}
+
+// RunInReadOnlySnapshot は fn を REPEATABLE READ の読み取り専用トランザクション内で実行する
+// fn 内の複数のクエリが同じ時点のスナップショットを参照するため、実行中の更新による食い違いが起きない
+// 既にトランザクション内で呼ばれた場合は外側のトランザクションに参加する
+func (m *TxManager) RunInReadOnlySnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.runInTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, fn)
+}
+
+// RunWithAdvisoryLock は key のアドバイザリロックを取得してから fn を実行する
+// ロックはロック専用のトランザクションで pg_advisory_xact_lock により取得し、fn の終了後にロールバックして解放する
+// fn 内の RunInTx はロックを保持するトランザクションとは別のトランザクションを開始するため、処理を小さなトランザクションに分けられる
+// 既にトランザクション内で呼ばれた場合はそのトランザクションでロックを取得する
+func (m *TxManager) RunWithAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		if _, err := tx.Exec(ctx, advisoryLockQuery, key); err != nil {
+			return fmt.Errorf("failed to acquire advisory lock: %w", err)
+		}
+		return fn(ctx)
+	}
+
+	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// ロック専用のトランザクションは何も書き込まないため、常にロールバックしてロックを解放する
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, advisoryLockQuery, key); err != nil {
+		return fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+	return fn(ctx)
+}
+
+// advisoryLockQuery は文字列のキーをハッシュしてトランザクション終了まで保持するアドバイザリロックを取得する
+const advisoryLockQuery = "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))"
+
+// runInTx は指定したオプションでトランザクションを開始し、fn の結果に応じてコミットまたはロールバックする
+func (m *TxManager) runInTx(ctx context.Context, txOptions pgx.TxOptions, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.pool.BeginTx(ctx, txOptions)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("failed to rollback transaction: %w (cause: %w)", rbErr, err)
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
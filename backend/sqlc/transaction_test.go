@@ -0,0 +1,228 @@
+package sqlc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"poketier/sqlc"
+)
+
+// fakeTx はコミット・ロールバック・Execの呼び出しを記録するpgx.Tx
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	rolledBack bool
+	execCount  int
+}
+
+func (f *fakeTx) Commit(ctx context.Context) error {
+	f.committed = true
+	return nil
+}
+
+func (f *fakeTx) Rollback(ctx context.Context) error {
+	f.rolledBack = true
+	return nil
+}
+
+func (f *fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	f.execCount++
+	return pgconn.CommandTag{}, nil
+}
+
+// fakePool はトランザクションの開始回数とExecの呼び出しを記録するPool
+type fakePool struct {
+	sqlc.Pool
+	tx         *fakeTx
+	beginCount int
+	txOptions  pgx.TxOptions
+	execCount  int
+}
+
+func (f *fakePool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	f.beginCount++
+	f.txOptions = txOptions
+	return f.tx, nil
+}
+
+func (f *fakePool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	f.execCount++
+	return pgconn.CommandTag{}, nil
+}
+
+func TestTxManager_RunInTx(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName       string
+		fn             func(ctx context.Context, dbtx *sqlc.ContextDBTX, txm *sqlc.TxManager) error
+		wantErr        bool
+		wantCommitted  bool
+		wantRolledBack bool
+		wantBeginCount int
+		wantTxExec     int
+		wantPoolExec   int
+	}{
+		{
+			caseName: "正常系: 成功した場合はコミットされ、クエリはトランザクション上で実行される事",
+			fn: func(ctx context.Context, dbtx *sqlc.ContextDBTX, txm *sqlc.TxManager) error {
+				_, err := dbtx.Exec(ctx, "SELECT 1")
+				return err
+			},
+			wantCommitted:  true,
+			wantBeginCount: 1,
+			wantTxExec:     1,
+		},
+		{
+			caseName: "異常系: エラーが返された場合はロールバックされる事",
+			fn: func(ctx context.Context, dbtx *sqlc.ContextDBTX, txm *sqlc.TxManager) error {
+				return errors.New("fn error")
+			},
+			wantErr:        true,
+			wantRolledBack: true,
+			wantBeginCount: 1,
+		},
+		{
+			caseName: "正常系: 入れ子で呼ばれた場合は外側のトランザクションに参加する事",
+			fn: func(ctx context.Context, dbtx *sqlc.ContextDBTX, txm *sqlc.TxManager) error {
+				return txm.RunInTx(ctx, func(ctx context.Context) error {
+					_, err := dbtx.Exec(ctx, "SELECT 1")
+					return err
+				})
+			},
+			wantCommitted:  true,
+			wantBeginCount: 1,
+			wantTxExec:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			pool := &fakePool{tx: &fakeTx{}}
+			dbtx := sqlc.NewContextDBTX(pool)
+			txm := sqlc.NewTxManager(pool)
+
+			// Act
+			err := txm.RunInTx(context.Background(), func(ctx context.Context) error {
+				return tt.fn(ctx, dbtx, txm)
+			})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err, "expected error but got none")
+			} else {
+				assert.NoError(t, err, "unexpected error occurred")
+			}
+			assert.Equal(t, tt.wantCommitted, pool.tx.committed, "committed does not match")
+			assert.Equal(t, tt.wantRolledBack, pool.tx.rolledBack, "rolled back does not match")
+			assert.Equal(t, tt.wantBeginCount, pool.beginCount, "begin count does not match")
+			assert.Equal(t, tt.wantTxExec, pool.tx.execCount, "tx exec count does not match")
+			assert.Equal(t, tt.wantPoolExec, pool.execCount, "pool exec count does not match")
+			assert.Equal(t, pgx.TxOptions{}, pool.txOptions, "default transaction options should be used")
+		})
+	}
+}
+
+func TestTxManager_RunInReadOnlySnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: REPEATABLE READ の読み取り専用トランザクションでクエリが実行され、コミットされる事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		pool := &fakePool{tx: &fakeTx{}}
+		dbtx := sqlc.NewContextDBTX(pool)
+		txm := sqlc.NewTxManager(pool)
+
+		// Act
+		err := txm.RunInReadOnlySnapshot(context.Background(), func(ctx context.Context) error {
+			_, err := dbtx.Exec(ctx, "SELECT 1")
+			return err
+		})
+
+		// Assert
+		assert.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, pool.txOptions, "transaction options do not match")
+		assert.True(t, pool.tx.committed, "transaction should be committed")
+		assert.Equal(t, 1, pool.tx.execCount, "query should run in the transaction")
+		assert.Equal(t, 0, pool.execCount, "pool should not be used")
+	})
+}
+
+func TestTxManager_RunWithAdvisoryLock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		caseName string
+		fnErr    error
+		wantErr  bool
+	}{
+		{
+			caseName: "正常系: ロック専用のトランザクションでロックを取得し、fn のクエリはトランザクション外で実行される事",
+		},
+		{
+			caseName: "異常系: fn がエラーを返した場合、エラーを返してロックを解放する事",
+			fnErr:    errors.New("fn error"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			pool := &fakePool{tx: &fakeTx{}}
+			dbtx := sqlc.NewContextDBTX(pool)
+			txm := sqlc.NewTxManager(pool)
+
+			// Act
+			err := txm.RunWithAdvisoryLock(context.Background(), "lock-key", func(ctx context.Context) error {
+				if _, err := dbtx.Exec(ctx, "SELECT 1"); err != nil {
+					return err
+				}
+				return tt.fnErr
+			})
+
+			// Assert
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.fnErr, "error should be returned from fn")
+			} else {
+				assert.NoError(t, err, "unexpected error occurred")
+			}
+			assert.Equal(t, 1, pool.beginCount, "begin count does not match")
+			assert.Equal(t, 1, pool.tx.execCount, "lock should be acquired in the lock transaction")
+			assert.Equal(t, 1, pool.execCount, "fn should not run in the lock transaction")
+			assert.True(t, pool.tx.rolledBack, "lock transaction should be rolled back to release the lock")
+			assert.False(t, pool.tx.committed, "lock transaction should not be committed")
+		})
+	}
+}
+
+func TestContextDBTX_OutsideTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: トランザクション外では接続プールでクエリが実行される事", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		pool := &fakePool{tx: &fakeTx{}}
+		dbtx := sqlc.NewContextDBTX(pool)
+
+		// Act
+		_, err := dbtx.Exec(context.Background(), "SELECT 1")
+
+		// Assert
+		assert.NoError(t, err, "unexpected error occurred")
+		assert.Equal(t, 1, pool.execCount, "pool exec count does not match")
+		assert.Equal(t, 0, pool.tx.execCount, "tx should not be used")
+	})
+}